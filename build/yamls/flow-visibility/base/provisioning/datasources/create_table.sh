@@ -66,12 +66,21 @@ clickhouse client -n -h 127.0.0.1 <<-EOSQL
         throughputFromDestinationNode UInt64,
         reverseThroughputFromSourceNode UInt64,
         reverseThroughputFromDestinationNode UInt64,
+        egressName String,
+        egressIP String,
+        egressNodeName String,
+        destinationFQDN String,
         trusted UInt8 DEFAULT 0
     ) engine=MergeTree
     ORDER BY (timeInserted, flowEndSeconds)
     TTL timeInserted + INTERVAL 1 HOUR
     SETTINGS merge_with_ttl_timeout = 3600;
 
+    ALTER TABLE flows ADD COLUMN IF NOT EXISTS egressName String AFTER reverseThroughputFromDestinationNode;
+    ALTER TABLE flows ADD COLUMN IF NOT EXISTS egressIP String AFTER egressName;
+    ALTER TABLE flows ADD COLUMN IF NOT EXISTS egressNodeName String AFTER egressIP;
+    ALTER TABLE flows ADD COLUMN IF NOT EXISTS destinationFQDN String AFTER egressNodeName;
+
     CREATE MATERIALIZED VIEW IF NOT EXISTS flows_pod_view
     ENGINE = SummingMergeTree
     ORDER BY (
@@ -218,5 +227,23 @@ clickhouse client -n -h 127.0.0.1 <<-EOSQL
         yamls String
     ) engine=MergeTree
     ORDER BY (timeCreated);
+
+    CREATE TABLE IF NOT EXISTS dns_records (
+        timeInserted DateTime DEFAULT now(),
+        responseTime DateTime,
+        sourcePodName String,
+        sourcePodNamespace String,
+        sourceNodeName String,
+        sourceIP String,
+        destinationIP String,
+        queryName String,
+        queryType UInt16,
+        responseCode UInt8,
+        answers String,
+        latencyMicroseconds UInt32
+    ) engine=MergeTree
+    ORDER BY (timeInserted, responseTime)
+    TTL timeInserted + INTERVAL 1 HOUR
+    SETTINGS merge_with_ttl_timeout = 3600;
     
 EOSQL
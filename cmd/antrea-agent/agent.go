@@ -26,6 +26,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/informers"
 	coreinformers "k8s.io/client-go/informers/core/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
 
@@ -44,9 +45,11 @@ import (
 	"antrea.io/antrea/pkg/agent/controller/trafficcontrol"
 	"antrea.io/antrea/pkg/agent/flowexporter"
 	"antrea.io/antrea/pkg/agent/flowexporter/exporter"
+	"antrea.io/antrea/pkg/agent/flowexporter/filter"
 	"antrea.io/antrea/pkg/agent/interfacestore"
 	"antrea.io/antrea/pkg/agent/memberlist"
 	"antrea.io/antrea/pkg/agent/metrics"
+	"antrea.io/antrea/pkg/agent/monitortool"
 	"antrea.io/antrea/pkg/agent/multicast"
 	mcroute "antrea.io/antrea/pkg/agent/multicluster"
 	npl "antrea.io/antrea/pkg/agent/nodeportlocal"
@@ -60,6 +63,7 @@ import (
 	"antrea.io/antrea/pkg/agent/stats"
 	agenttypes "antrea.io/antrea/pkg/agent/types"
 	crdinformers "antrea.io/antrea/pkg/client/informers/externalversions"
+	crdv1alpha1informers "antrea.io/antrea/pkg/client/informers/externalversions/crd/v1alpha1"
 	"antrea.io/antrea/pkg/controller/externalippool"
 	"antrea.io/antrea/pkg/features"
 	"antrea.io/antrea/pkg/log"
@@ -67,6 +71,7 @@ import (
 	ofconfig "antrea.io/antrea/pkg/ovs/openflow"
 	"antrea.io/antrea/pkg/ovs/ovsconfig"
 	"antrea.io/antrea/pkg/ovs/ovsctl"
+	antreaquerier "antrea.io/antrea/pkg/querier"
 	"antrea.io/antrea/pkg/signals"
 	"antrea.io/antrea/pkg/util/channel"
 	"antrea.io/antrea/pkg/util/cipher"
@@ -145,6 +150,7 @@ func run(o *Options) error {
 		multicastEnabled,
 		features.DefaultFeatureGate.Enabled(features.TrafficControl),
 		features.DefaultFeatureGate.Enabled(features.Multicluster),
+		features.DefaultFeatureGate.Enabled(features.PodTrafficStats),
 	)
 
 	_, serviceCIDRNet, _ := net.ParseCIDR(o.config.ServiceCIDR)
@@ -155,10 +161,6 @@ func run(o *Options) error {
 
 	_, encapMode := config.GetTrafficEncapModeFromStr(o.config.TrafficEncapMode)
 	_, encryptionMode := config.GetTrafficEncryptionModeFromStr(o.config.TrafficEncryptionMode)
-	if o.config.EnableIPSecTunnel {
-		klog.InfoS("enableIPSecTunnel is deprecated, use trafficEncryptionMode instead.")
-		encryptionMode = config.TrafficEncryptionModeIPSec
-	}
 	_, ipsecAuthenticationMode := config.GetIPsecAuthenticationModeFromStr(o.config.IPsec.AuthenticationMode)
 	networkConfig := &config.NetworkConfig{
 		TunnelType:            ovsconfig.TunnelType(o.config.TunnelType),
@@ -435,11 +437,16 @@ func run(o *Options) error {
 
 	var traceflowController *traceflow.Controller
 	if features.DefaultFeatureGate.Enabled(features.Traceflow) {
+		var packetCaptureInformer crdv1alpha1informers.PacketCaptureInformer
+		if features.DefaultFeatureGate.Enabled(features.PacketCapture) {
+			packetCaptureInformer = crdInformerFactory.Crd().V1alpha1().PacketCaptures()
+		}
 		traceflowController = traceflow.NewTraceflowController(
 			k8sClient,
 			informerFactory,
 			crdClient,
 			traceflowInformer,
+			packetCaptureInformer,
 			ofClient,
 			networkPolicyController,
 			ovsBridgeClient,
@@ -467,16 +474,67 @@ func run(o *Options) error {
 		return err
 	}
 
+	enableNodePortLocal := features.DefaultFeatureGate.Enabled(features.NodePortLocal) && o.config.NodePortLocal.Enable
+	enableFlowExportPodFilter := features.DefaultFeatureGate.Enabled(features.FlowExporter) && o.config.FlowExportFilter.PodLabelSelector != ""
+
+	// Initialize localPodInformer for NPLAgent, AntreaIPAMController, secondary network controller,
+	// TrafficControl controller, Pod bandwidth controller and flow export filter.
+	var localPodInformer cache.SharedIndexInformer
+	if enableNodePortLocal || enableBridgingMode || enableFlowExportPodFilter ||
+		features.DefaultFeatureGate.Enabled(features.SecondaryNetwork) ||
+		features.DefaultFeatureGate.Enabled(features.TrafficControl) ||
+		features.DefaultFeatureGate.Enabled(features.PodBandwidth) {
+		listOptions := func(options *metav1.ListOptions) {
+			options.FieldSelector = fields.OneTermEqualSelector("spec.nodeName", nodeConfig.Name).String()
+		}
+		localPodInformer = coreinformers.NewFilteredPodInformer(
+			k8sClient,
+			metav1.NamespaceAll,
+			resyncPeriodDisabled,
+			cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, // NamespaceIndex is used in NPLController.
+			listOptions,
+		)
+	}
+
 	var flowExporter *exporter.FlowExporter
 	if features.DefaultFeatureGate.Enabled(features.FlowExporter) {
 		flowExporterOptions := &flowexporter.FlowExporterOptions{
 			FlowCollectorAddr:      o.flowCollectorAddr,
 			FlowCollectorProto:     o.flowCollectorProto,
+			FlowCollectorService:   o.flowCollectorService,
 			ActiveFlowTimeout:      o.activeFlowTimeout,
 			IdleFlowTimeout:        o.idleFlowTimeout,
 			StaleConnectionTimeout: o.staleConnectionTimeout,
 			PollInterval:           o.pollInterval,
-			ConnectUplinkToBridge:  connectUplinkToBridge}
+			ConnectUplinkToBridge:  connectUplinkToBridge,
+			EnableDNSVisibility:    o.config.EnableDNSVisibility}
+		var podLister corelisters.PodLister
+		if enableFlowExportPodFilter {
+			podLister = corelisters.NewPodLister(localPodInformer.GetIndexer())
+		}
+		var nodeIPs []net.IP
+		for _, nodeIP := range []*net.IPNet{nodeConfig.NodeIPv4Addr, nodeConfig.NodeIPv6Addr} {
+			if nodeIP != nil {
+				nodeIPs = append(nodeIPs, nodeIP.IP)
+			}
+		}
+		for _, gatewayIP := range []net.IP{nodeConfig.GatewayConfig.IPv4, nodeConfig.GatewayConfig.IPv6} {
+			if gatewayIP != nil {
+				nodeIPs = append(nodeIPs, gatewayIP)
+			}
+		}
+		connectionFilter, err := filter.NewConnectionFilter(o.config.FlowExportFilter, podLister, nodeIPs)
+		if err != nil {
+			return fmt.Errorf("error when creating flow export filter: %v", err)
+		}
+		// Do not set the interface to a nil pointer when no connection is filtered.
+		if connectionFilter != nil {
+			flowExporterOptions.ConnectionFilter = connectionFilter
+		}
+		var egressQuerier antreaquerier.EgressQuerier
+		if egressEnabled {
+			egressQuerier = egressController
+		}
 		flowExporter, err = exporter.NewFlowExporter(
 			ifaceStore,
 			proxier,
@@ -491,30 +549,16 @@ func run(o *Options) error {
 			ovsDatapathType,
 			features.DefaultFeatureGate.Enabled(features.AntreaProxy),
 			networkPolicyController,
+			egressQuerier,
 			flowExporterOptions)
 		if err != nil {
 			return fmt.Errorf("error when creating IPFIX flow exporter: %v", err)
 		}
 		networkPolicyController.SetDenyConnStore(flowExporter.GetDenyConnStore())
-	}
-
-	enableNodePortLocal := features.DefaultFeatureGate.Enabled(features.NodePortLocal) && o.config.NodePortLocal.Enable
-
-	// Initialize localPodInformer for NPLAgent, AntreaIPAMController, and secondary network controller.
-	var localPodInformer cache.SharedIndexInformer
-	if enableNodePortLocal || enableBridgingMode ||
-		features.DefaultFeatureGate.Enabled(features.SecondaryNetwork) ||
-		features.DefaultFeatureGate.Enabled(features.TrafficControl) {
-		listOptions := func(options *metav1.ListOptions) {
-			options.FieldSelector = fields.OneTermEqualSelector("spec.nodeName", nodeConfig.Name).String()
+		if o.config.EnableDNSVisibility {
+			// Only intercept the DNS responses of the Pods whose connections can be exported.
+			networkPolicyController.SetDNSRecordStore(flowExporter.GetDNSRecordStore(), connectionFilter.MatchNamespace)
 		}
-		localPodInformer = coreinformers.NewFilteredPodInformer(
-			k8sClient,
-			metav1.NamespaceAll,
-			resyncPeriodDisabled,
-			cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, // NamespaceIndex is used in NPLController.
-			listOptions,
-		)
 	}
 
 	log.StartLogFileNumberMonitor(stopCh)
@@ -590,6 +634,20 @@ func run(o *Options) error {
 		go tcController.Run(stopCh)
 	}
 
+	if features.DefaultFeatureGate.Enabled(features.PodBandwidth) {
+		podBandwidthController := cniserver.NewPodBandwidthController(
+			ifaceStore,
+			ovsBridgeClient,
+			localPodInformer,
+			podUpdateChannel)
+		go podBandwidthController.Run(stopCh)
+	}
+
+	if features.DefaultFeatureGate.Enabled(features.NodeLatencyMonitor) {
+		nodeLatencyMonitor := monitortool.NewNodeLatencyMonitor(nodeConfig.Name, nodeInformer, antreaClientProvider, v4Enabled, v6Enabled)
+		go nodeLatencyMonitor.Run(stopCh)
+	}
+
 	//  Start the localPodInformer
 	if localPodInformer != nil {
 		go localPodInformer.Run(stopCh)
@@ -665,9 +723,9 @@ func run(o *Options) error {
 	}
 
 	// statsCollector collects stats and reports to the antrea-controller periodically. For now it's only used for
-	// NetworkPolicy stats and Multicast stats.
+	// NetworkPolicy stats, Multicast stats and Pod traffic stats.
 	if features.DefaultFeatureGate.Enabled(features.NetworkPolicyStats) {
-		statsCollector := stats.NewCollector(antreaClientProvider, ofClient, networkPolicyController, mcastController)
+		statsCollector := stats.NewCollector(antreaClientProvider, ofClient, networkPolicyController, mcastController, ifaceStore, features.DefaultFeatureGate.Enabled(features.PodTrafficStats), *o.config.EnablePrometheusMetrics)
 		go statsCollector.Run(stopCh)
 	}
 	agentQuerier := querier.NewAgentQuerier(
@@ -18,39 +18,25 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net"
-	"strings"
 	"time"
 
 	"github.com/spf13/pflag"
 	"gopkg.in/yaml.v2"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/klog/v2"
 
-	"antrea.io/antrea/pkg/agent/config"
-	"antrea.io/antrea/pkg/apis"
-	"antrea.io/antrea/pkg/cni"
 	agentconfig "antrea.io/antrea/pkg/config/agent"
+	"antrea.io/antrea/pkg/config/validation"
 	"antrea.io/antrea/pkg/features"
-	"antrea.io/antrea/pkg/ovs/ovsconfig"
-	"antrea.io/antrea/pkg/util/env"
 	"antrea.io/antrea/pkg/util/flowexport"
 	"antrea.io/antrea/pkg/util/ip"
 )
 
 const (
-	defaultOVSBridge               = "br-int"
-	defaultHostGateway             = "antrea-gw0"
-	defaultHostProcPathPrefix      = "/host"
-	defaultServiceCIDR             = "10.96.0.0/12"
-	defaultTunnelType              = ovsconfig.GeneveTunnel
-	defaultFlowCollectorAddress    = "flow-aggregator.flow-aggregator.svc:4739:tls"
-	defaultFlowCollectorTransport  = "tls"
-	defaultFlowCollectorPort       = "4739"
-	defaultFlowPollInterval        = 5 * time.Second
 	defaultActiveFlowExportTimeout = 30 * time.Second
 	defaultIdleFlowExportTimeout   = 15 * time.Second
 	defaultIGMPQueryInterval       = 125 * time.Second
 	defaultStaleConnectionTimeout  = 5 * time.Minute
-	defaultNPLPortRange            = "61000-62000"
 )
 
 type Options struct {
@@ -62,6 +48,8 @@ type Options struct {
 	flowCollectorAddr string
 	// IPFIX flow collector protocol
 	flowCollectorProto string
+	// Flow Aggregator Service used to shard flow records, nil if sharding is disabled
+	flowCollectorService *k8stypes.NamespacedName
 	// Flow exporter poll interval
 	pollInterval time.Duration
 	// Active flow timeout to export records of active flows
@@ -109,107 +97,34 @@ func (o *Options) validate(args []string) error {
 		return fmt.Errorf("no positional arguments are supported")
 	}
 
-	if o.config.TunnelType != ovsconfig.VXLANTunnel && o.config.TunnelType != ovsconfig.GeneveTunnel &&
-		o.config.TunnelType != ovsconfig.GRETunnel && o.config.TunnelType != ovsconfig.STTTunnel {
-		return fmt.Errorf("tunnel type %s is invalid", o.config.TunnelType)
+	warnings, err := validation.ValidateAgentConfig(o.config, features.DefaultFeatureGate)
+	for _, w := range warnings {
+		klog.InfoS(w)
 	}
-	ok, encryptionMode := config.GetTrafficEncryptionModeFromStr(o.config.TrafficEncryptionMode)
-	if !ok {
-		return fmt.Errorf("TrafficEncryptionMode %s is unknown", o.config.TrafficEncryptionMode)
-	}
-	if o.config.OVSDatapathType != string(ovsconfig.OVSDatapathSystem) && o.config.OVSDatapathType != string(ovsconfig.OVSDatapathNetdev) {
-		return fmt.Errorf("OVS datapath type %s is not supported", o.config.OVSDatapathType)
-	}
-	if o.config.OVSDatapathType == string(ovsconfig.OVSDatapathNetdev) {
-		klog.Info("OVS 'netdev' datapath is not fully supported at the moment")
-	}
-	ok, encapMode := config.GetTrafficEncapModeFromStr(o.config.TrafficEncapMode)
-	if !ok {
-		return fmt.Errorf("TrafficEncapMode %s is unknown", o.config.TrafficEncapMode)
-	}
-	ok, ipsecAuthMode := config.GetIPsecAuthenticationModeFromStr(o.config.IPsec.AuthenticationMode)
-	if !ok {
-		return fmt.Errorf("IPsec AuthenticationMode %s is unknown", o.config.IPsec.AuthenticationMode)
-	}
-	if ipsecAuthMode == config.IPsecAuthenticationModeCert && !features.DefaultFeatureGate.Enabled(features.IPsecCertAuth) {
-		return fmt.Errorf("IPsec AuthenticationMode %s requires feature gate %s to be enabled", o.config.TrafficEncapMode, features.IPsecCertAuth)
+	if err != nil {
+		return err
 	}
-
 	// Check if the enabled features are supported on the OS.
 	if err := o.checkUnsupportedFeatures(); err != nil {
 		return err
 	}
-
-	if encapMode.SupportsNoEncap() {
-		// When using NoEncap traffic mode without AntreaProxy, Pod-to-Service traffic is handled by kube-proxy
-		// (iptables/ipvs) in the root netns. If the Endpoint is not local the DNATed traffic will be output to
-		// the physical network directly without going back to OVS for Egress NetworkPolicy enforcement, which
-		// breaks basic security functionality. Therefore, we usually do not allow the NoEncap traffic mode without
-		// AntreaProxy. But one can bypass this check and force this feature combination to be allowed, by defining
-		// the ALLOW_NO_ENCAP_WITHOUT_ANTREA_PROXY environment variable and setting it to true. This may lead to
-		// better performance when using NoEncap if Egress NetworkPolicy enforcement is not required.
-		if !features.DefaultFeatureGate.Enabled(features.AntreaProxy) {
-			if env.GetAllowNoEncapWithoutAntreaProxy() {
-				klog.InfoS("Disabling AntreaProxy in NoEncap mode will prevent Egress NetworkPolicy rules from being enforced correctly")
-			} else {
-				return fmt.Errorf("TrafficEncapMode %s requires AntreaProxy to be enabled", o.config.TrafficEncapMode)
-			}
-		}
-		if encryptionMode != config.TrafficEncryptionModeNone {
-			return fmt.Errorf("TrafficEncryptionMode %s may only be enabled in %s mode", encryptionMode, config.TrafficEncapModeEncap)
-		}
-	}
-	if o.config.NoSNAT && !(encapMode == config.TrafficEncapModeNoEncap || encapMode == config.TrafficEncapModeNetworkPolicyOnly) {
-		return fmt.Errorf("noSNAT is only applicable to the %s mode", config.TrafficEncapModeNoEncap)
-	}
-	if encapMode == config.TrafficEncapModeNetworkPolicyOnly {
-		// In the NetworkPolicyOnly mode, Antrea will not perform SNAT
-		// (but SNAT can be done by the primary CNI).
-		o.config.NoSNAT = true
-	}
-	if err := o.validateAntreaProxyConfig(); err != nil {
-		return fmt.Errorf("proxy config is invalid: %w", err)
-	}
-	if err := o.validateFlowExporterConfig(); err != nil {
+	if err := o.completeFlowExporterConfig(); err != nil {
 		return fmt.Errorf("failed to validate flow exporter config: %v", err)
 	}
-	if err := o.validateMulticastConfig(); err != nil {
-		return fmt.Errorf("failed to validate multicast config: %v", err)
-	}
-	if features.DefaultFeatureGate.Enabled(features.Egress) {
-		for _, cidr := range o.config.Egress.ExceptCIDRs {
-			_, _, err := net.ParseCIDR(cidr)
-			if err != nil {
-				return fmt.Errorf("Egress Except CIDR %s is invalid", cidr)
-			}
+	if features.DefaultFeatureGate.Enabled(features.Multicast) && o.config.Multicast.IGMPQueryInterval != "" {
+		o.igmpQueryInterval, err = time.ParseDuration(o.config.Multicast.IGMPQueryInterval)
+		if err != nil {
+			return fmt.Errorf("failed to validate multicast config: %v", err)
 		}
 	}
-	if (features.DefaultFeatureGate.Enabled(features.Multicluster) || o.config.Multicluster.Enable) &&
-		encapMode != config.TrafficEncapModeEncap {
-		// Only Encap mode is supported for Multi-cluster feature.
-		return fmt.Errorf("Multicluster is only applicable to the %s mode", config.TrafficEncapModeEncap)
-	}
 	if features.DefaultFeatureGate.Enabled(features.NodePortLocal) {
-		startPort, endPort, err := parsePortRange(o.config.NodePortLocal.PortRange)
+		o.nplStartPort, o.nplEndPort, err = validation.ParsePortRange(o.config.NodePortLocal.PortRange)
 		if err != nil {
 			return fmt.Errorf("NodePortLocal portRange is not valid: %v", err)
 		}
-		o.nplStartPort = startPort
-		o.nplEndPort = endPort
-	} else if o.config.NodePortLocal.Enable {
-		klog.InfoS("The nodePortLocal.enable config option is set to true, but it will be ignored because the NodePortLocal feature gate is disabled")
-	}
-	if err := o.validateAntreaIPAMConfig(); err != nil {
-		return fmt.Errorf("failed to validate AntreaIPAM config: %v", err)
 	}
-
 	if o.config.DNSServerOverride != "" {
-		hostPort := ip.AppendPortIfMissing(o.config.DNSServerOverride, "53")
-		_, _, err := net.SplitHostPort(hostPort)
-		if err != nil {
-			return fmt.Errorf("dnsServerOverride %s is invalid: %v", o.config.DNSServerOverride, err)
-		}
-		o.dnsServerOverride = hostPort
+		o.dnsServerOverride = ip.AppendPortIfMissing(o.config.DNSServerOverride, "53")
 	}
 	return nil
 }
@@ -224,66 +139,11 @@ func (o *Options) loadConfigFromFile() error {
 }
 
 func (o *Options) setDefaults() {
-	if o.config.CNISocket == "" {
-		o.config.CNISocket = cni.AntreaCNISocketAddr
-	}
-	if o.config.OVSBridge == "" {
-		o.config.OVSBridge = defaultOVSBridge
-	}
-	if o.config.OVSDatapathType == "" {
-		o.config.OVSDatapathType = string(ovsconfig.OVSDatapathSystem)
-	}
-	if o.config.OVSRunDir == "" {
-		o.config.OVSRunDir = ovsconfig.DefaultOVSRunDir
-	}
-	if o.config.HostGateway == "" {
-		o.config.HostGateway = defaultHostGateway
-	}
-	if o.config.TrafficEncapMode == "" {
-		o.config.TrafficEncapMode = config.TrafficEncapModeEncap.String()
-	}
-	if o.config.TrafficEncryptionMode == "" {
-		o.config.TrafficEncryptionMode = config.TrafficEncryptionModeNone.String()
-	}
-	if o.config.TunnelType == "" {
-		o.config.TunnelType = defaultTunnelType
-	}
-	if o.config.HostProcPathPrefix == "" {
-		o.config.HostProcPathPrefix = defaultHostProcPathPrefix
-	}
-	if features.DefaultFeatureGate.Enabled(features.AntreaProxy) {
-		if o.config.AntreaProxy.ProxyLoadBalancerIPs == nil {
-			o.config.AntreaProxy.ProxyLoadBalancerIPs = new(bool)
-			*o.config.AntreaProxy.ProxyLoadBalancerIPs = true
-		}
-	} else {
-		if o.config.ServiceCIDR == "" {
-			o.config.ServiceCIDR = defaultServiceCIDR
-		}
-	}
-	if o.config.APIPort == 0 {
-		o.config.APIPort = apis.AntreaAgentAPIPort
-	}
-	if o.config.ClusterMembershipPort == 0 {
-		o.config.ClusterMembershipPort = apis.AntreaAgentClusterMembershipPort
-	}
-	if o.config.EnablePrometheusMetrics == nil {
-		o.config.EnablePrometheusMetrics = new(bool)
-		*o.config.EnablePrometheusMetrics = true
-	}
-	if o.config.WireGuard.Port == 0 {
-		o.config.WireGuard.Port = apis.WireGuardListenPort
-	}
-	if o.config.IPsec.AuthenticationMode == "" {
-		o.config.IPsec.AuthenticationMode = config.IPsecAuthenticationModePSK.String()
-	}
+	validation.SetAgentConfigDefaults(o.config, features.DefaultFeatureGate)
 
 	if features.DefaultFeatureGate.Enabled(features.FlowExporter) {
-		if o.config.FlowCollectorAddr == "" {
-			o.config.FlowCollectorAddr = defaultFlowCollectorAddress
-		}
 		if o.config.FlowPollInterval == "" {
-			o.pollInterval = defaultFlowPollInterval
+			o.pollInterval = validation.DefaultFlowPollInterval
 		}
 		if o.config.ActiveFlowExportTimeout == "" {
 			o.activeFlowTimeout = defaultActiveFlowExportTimeout
@@ -293,17 +153,6 @@ func (o *Options) setDefaults() {
 		}
 	}
 
-	if features.DefaultFeatureGate.Enabled(features.NodePortLocal) {
-		switch {
-		case o.config.NodePortLocal.PortRange != "":
-		case o.config.NPLPortRange != "":
-			klog.InfoS("The nplPortRange option is deprecated, please use nodePortLocal.portRange instead")
-			o.config.NodePortLocal.PortRange = o.config.NPLPortRange
-		default:
-			o.config.NodePortLocal.PortRange = defaultNPLPortRange
-		}
-	}
-
 	if features.DefaultFeatureGate.Enabled(features.Multicast) {
 		if o.config.Multicast.IGMPQueryInterval == "" {
 			o.igmpQueryInterval = defaultIGMPQueryInterval
@@ -311,40 +160,23 @@ func (o *Options) setDefaults() {
 	}
 }
 
-func (o *Options) validateAntreaProxyConfig() error {
-	if !features.DefaultFeatureGate.Enabled(features.AntreaProxy) {
-		// Validate service CIDR configuration if AntreaProxy is not enabled.
-		if _, _, err := net.ParseCIDR(o.config.ServiceCIDR); err != nil {
-			return fmt.Errorf("Service CIDR %s is invalid", o.config.ServiceCIDR)
-		}
-		if o.config.ServiceCIDRv6 != "" {
-			if _, _, err := net.ParseCIDR(o.config.ServiceCIDRv6); err != nil {
-				return fmt.Errorf("Service CIDR v6 %s is invalid", o.config.ServiceCIDRv6)
-			}
-		}
-		if len(o.config.AntreaProxy.SkipServices) > 0 {
-			klog.InfoS("skipServices will be ignored because AntreaProxy is disabled", "skipServices", o.config.AntreaProxy.SkipServices)
-		}
-	}
-
-	if o.config.AntreaProxy.ProxyAll {
-		for _, nodePortAddress := range o.config.AntreaProxy.NodePortAddresses {
-			if _, _, err := net.ParseCIDR(nodePortAddress); err != nil {
-				return fmt.Errorf("invalid NodePort IP address `%s`: %w", nodePortAddress, err)
-			}
-		}
-	}
-	return nil
-}
-
-func (o *Options) validateFlowExporterConfig() error {
+// completeFlowExporterConfig computes the flow exporter parameters from the
+// configuration, which must have been validated.
+func (o *Options) completeFlowExporterConfig() error {
 	if features.DefaultFeatureGate.Enabled(features.FlowExporter) {
-		host, port, proto, err := flowexport.ParseFlowCollectorAddr(o.config.FlowCollectorAddr, defaultFlowCollectorPort, defaultFlowCollectorTransport)
+		host, port, proto, err := flowexport.ParseFlowCollectorAddr(o.config.FlowCollectorAddr, validation.DefaultFlowCollectorPort, validation.DefaultFlowCollectorTransport)
 		if err != nil {
 			return err
 		}
 		o.flowCollectorAddr = net.JoinHostPort(host, port)
 		o.flowCollectorProto = proto
+		if o.config.EnableFlowCollectorSharding {
+			namespace, name, err := flowexport.ParseFlowCollectorService(host)
+			if err != nil {
+				return fmt.Errorf("flow collector sharding requires a Service DNS name: %v", err)
+			}
+			o.flowCollectorService = &k8stypes.NamespacedName{Namespace: namespace, Name: name}
+		}
 
 		// Parse the given flowPollInterval config
 		if o.config.FlowPollInterval != "" {
@@ -362,7 +194,6 @@ func (o *Options) validateFlowExporterConfig() error {
 			}
 			if o.activeFlowTimeout < o.pollInterval {
 				o.activeFlowTimeout = o.pollInterval
-				klog.Warningf("ActiveFlowExportTimeout must be greater than or equal to FlowPollInterval")
 			}
 		}
 		// Parse the given inactiveFlowExportTimeout config
@@ -373,7 +204,6 @@ func (o *Options) validateFlowExporterConfig() error {
 			}
 			if o.idleFlowTimeout < o.pollInterval {
 				o.idleFlowTimeout = o.pollInterval
-				klog.Warningf("IdleFlowExportTimeout must be greater than or equal to FlowPollInterval")
 			}
 		}
 		if (o.activeFlowTimeout > defaultStaleConnectionTimeout) || (o.idleFlowTimeout > defaultStaleConnectionTimeout) {
@@ -388,44 +218,3 @@ func (o *Options) validateFlowExporterConfig() error {
 	}
 	return nil
 }
-
-func (o *Options) validateMulticastConfig() error {
-	if features.DefaultFeatureGate.Enabled(features.Multicast) {
-		var err error
-		if o.config.Multicast.IGMPQueryInterval != "" {
-			o.igmpQueryInterval, err = time.ParseDuration(o.config.Multicast.IGMPQueryInterval)
-			if err != nil {
-				return err
-			}
-		}
-		if len(o.config.Multicast.MulticastInterfaces) == 0 && len(o.config.MulticastInterfaces) > 0 {
-			klog.InfoS("The multicastInterfaces option is deprecated, please use multicast.multicastInterfaces instead")
-			o.config.Multicast.MulticastInterfaces = o.config.MulticastInterfaces
-		}
-	}
-	return nil
-}
-
-func (o *Options) validateAntreaIPAMConfig() error {
-	if !o.config.EnableBridgingMode {
-		return nil
-	}
-	if !features.DefaultFeatureGate.Enabled(features.AntreaIPAM) {
-		return fmt.Errorf("AntreaIPAM feature gate must be enabled to configure bridging mode")
-	}
-	// Bridging mode will connect uplink to OVS bridge, which is not compatible with OVSDatapathSystem 'netdev'.
-	if o.config.OVSDatapathType != string(ovsconfig.OVSDatapathSystem) {
-		return fmt.Errorf("Bridging mode requires 'system' OVSDatapathType, current: %s",
-			o.config.OVSDatapathType)
-	}
-	if !strings.EqualFold(o.config.TrafficEncapMode, config.TrafficEncapModeNoEncap.String()) {
-		return fmt.Errorf("Bridging mode requires 'noEncap' TrafficEncapMode, current: %s",
-			o.config.TrafficEncapMode)
-	}
-	// TODO(gran): support SNAT for Per-Node IPAM Pods
-	// SNAT needs to be updated to bypass traffic from AntreaIPAM Pod to Per-Node IPAM Pod
-	if !o.config.NoSNAT {
-		return fmt.Errorf("Bridging mode requires noSNAT")
-	}
-	return nil
-}
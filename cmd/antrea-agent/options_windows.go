@@ -18,48 +18,16 @@
 package main
 
 import (
-	"fmt"
-	"strings"
-
-	"k8s.io/component-base/featuregate"
 	"k8s.io/klog/v2"
 
-	"antrea.io/antrea/pkg/agent/config"
+	"antrea.io/antrea/pkg/config/validation"
 	"antrea.io/antrea/pkg/features"
-	"antrea.io/antrea/pkg/ovs/ovsconfig"
 )
 
 func (o *Options) checkUnsupportedFeatures() error {
-	var unsupported []string
-
-	// First check feature gates.
-	for f, enabled := range o.config.FeatureGates {
-		if enabled && !features.SupportedOnWindows(featuregate.Feature(f)) {
-			unsupported = append(unsupported, f)
-		}
-	}
-
-	if o.config.OVSDatapathType != string(ovsconfig.OVSDatapathSystem) {
-		unsupported = append(unsupported, "OVSDatapathType: "+o.config.OVSDatapathType)
-	}
-	_, encapMode := config.GetTrafficEncapModeFromStr(o.config.TrafficEncapMode)
-	if encapMode == config.TrafficEncapModeNetworkPolicyOnly {
-		unsupported = append(unsupported, "TrafficEncapMode: "+encapMode.String())
+	if err := validation.CheckUnsupportedFeaturesOnWindows(o.config); err != nil {
+		return err
 	}
-	if o.config.TunnelType == ovsconfig.GRETunnel {
-		unsupported = append(unsupported, "TunnelType: "+o.config.TunnelType)
-	}
-	_, encryptionMode := config.GetTrafficEncryptionModeFromStr(o.config.TrafficEncryptionMode)
-	if encryptionMode != config.TrafficEncryptionModeNone {
-		unsupported = append(unsupported, "TrafficEncryptionMode: "+encryptionMode.String())
-	}
-	if o.config.EnableBridgingMode {
-		unsupported = append(unsupported, "EnableBridgingMode")
-	}
-	if unsupported != nil {
-		return fmt.Errorf("unsupported features on Windows: {%s}", strings.Join(unsupported, ", "))
-	}
-
 	if !features.DefaultFeatureGate.Enabled(features.AntreaProxy) {
 		klog.Warning("AntreaProxy is not enabled. NetworkPolicies might not be enforced correctly for Service traffic!")
 	}
@@ -44,6 +44,7 @@ import (
 	"antrea.io/antrea/pkg/apiserver/openapi"
 	"antrea.io/antrea/pkg/apiserver/storage"
 	crdinformers "antrea.io/antrea/pkg/client/informers/externalversions"
+	crdv1alpha1informers "antrea.io/antrea/pkg/client/informers/externalversions/crd/v1alpha1"
 	"antrea.io/antrea/pkg/clusteridentity"
 	"antrea.io/antrea/pkg/controller/certificatesigningrequest"
 	"antrea.io/antrea/pkg/controller/egress"
@@ -68,6 +69,7 @@ import (
 	"antrea.io/antrea/pkg/version"
 	"antrea.io/antrea/third_party/ipam/nodeipam"
 	"antrea.io/antrea/third_party/ipam/nodeipam/ipam"
+	policyinformers "antrea.io/antrea/third_party/networkpolicyapi/pkg/client/informers/externalversions"
 )
 
 const (
@@ -116,8 +118,13 @@ func run(o *Options) error {
 	if err != nil {
 		return fmt.Errorf("error creating K8s clients: %v", err)
 	}
+	policyClient, err := k8s.CreateNetworkPolicyAPIClient(o.config.ClientConnection, "")
+	if err != nil {
+		return fmt.Errorf("error creating AdminNetworkPolicy API client: %v", err)
+	}
 	informerFactory := informers.NewSharedInformerFactory(client, informerDefaultResync)
 	crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, informerDefaultResync)
+	policyInformerFactory := policyinformers.NewSharedInformerFactory(policyClient, informerDefaultResync)
 	podInformer := informerFactory.Core().V1().Pods()
 	namespaceInformer := informerFactory.Core().V1().Namespaces()
 	serviceInformer := informerFactory.Core().V1().Services()
@@ -131,6 +138,8 @@ func run(o *Options) error {
 	cgInformer := crdInformerFactory.Crd().V1alpha3().ClusterGroups()
 	egressInformer := crdInformerFactory.Crd().V1alpha2().Egresses()
 	externalIPPoolInformer := crdInformerFactory.Crd().V1alpha2().ExternalIPPools()
+	adminNPInformer := policyInformerFactory.Policy().V1alpha1().AdminNetworkPolicies()
+	banpInformer := policyInformerFactory.Policy().V1alpha1().BaselineAdminNetworkPolicies()
 
 	clusterIdentityAllocator := clusteridentity.NewClusterIdentityAllocator(
 		env.GetAntreaNamespace(),
@@ -158,6 +167,8 @@ func run(o *Options) error {
 		anpInformer,
 		tierInformer,
 		cgInformer,
+		adminNPInformer,
+		banpInformer,
 		addressGroupStore,
 		appliedToGroupStore,
 		networkPolicyStore,
@@ -165,10 +176,11 @@ func run(o *Options) error {
 
 	var networkPolicyStatusController *networkpolicy.StatusController
 	if features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
-		networkPolicyStatusController = networkpolicy.NewStatusController(crdClient, networkPolicyStore, cnpInformer, anpInformer)
+		networkPolicyStatusController = networkpolicy.NewStatusController(crdClient, policyClient, networkPolicyStore, cnpInformer, anpInformer, adminNPInformer, banpInformer)
 	}
 
 	endpointQuerier := networkpolicy.NewEndpointQuerier(networkPolicyController)
+	policyVerdictQuerier := networkpolicy.NewPolicyVerdictQuerier(networkPolicyController, podInformer.Lister())
 
 	controllerQuerier := querier.NewControllerQuerier(networkPolicyController, o.config.APIPort)
 
@@ -209,14 +221,20 @@ func run(o *Options) error {
 
 	var traceflowController *traceflow.Controller
 	if features.DefaultFeatureGate.Enabled(features.Traceflow) {
-		traceflowController = traceflow.NewTraceflowController(crdClient, podInformer, tfInformer)
+		// PacketCapture requests are processed by the Traceflow controller as they share the
+		// data plane tags.
+		var pcInformer crdv1alpha1informers.PacketCaptureInformer
+		if features.DefaultFeatureGate.Enabled(features.PacketCapture) {
+			pcInformer = crdInformerFactory.Crd().V1alpha1().PacketCaptures()
+		}
+		traceflowController = traceflow.NewTraceflowController(crdClient, podInformer, tfInformer, pcInformer)
 	}
 
 	// statsAggregator takes stats summaries from antrea-agents, aggregates them, and serves the Stats APIs with the
-	// aggregated data. For now it's only used for NetworkPolicy stats.
+	// aggregated data. It's used for NetworkPolicy stats, and Pod traffic stats if PodTrafficStats is enabled.
 	var statsAggregator *stats.Aggregator
 	if features.DefaultFeatureGate.Enabled(features.NetworkPolicyStats) {
-		statsAggregator = stats.NewAggregator(networkPolicyInformer, cnpInformer, anpInformer)
+		statsAggregator = stats.NewAggregator(networkPolicyInformer, cnpInformer, anpInformer, podInformer, namespaceInformer)
 	}
 
 	cipherSuites, err := cipher.GenerateCipherSuitesList(o.config.TLSCipherSuites)
@@ -244,6 +262,7 @@ func run(o *Options) error {
 		egressGroupStore,
 		controllerQuerier,
 		endpointQuerier,
+		policyVerdictQuerier,
 		networkPolicyController,
 		networkPolicyStatusController,
 		egressController,
@@ -278,6 +297,11 @@ func run(o *Options) error {
 
 	informerFactory.Start(stopCh)
 	crdInformerFactory.Start(stopCh)
+	// The AdminNetworkPolicy API is provided by CRDs which are not installed by Antrea, only watch it when the
+	// feature is enabled.
+	if features.DefaultFeatureGate.Enabled(features.AntreaPolicy) && features.DefaultFeatureGate.Enabled(features.AdminNetworkPolicy) {
+		policyInformerFactory.Start(stopCh)
+	}
 
 	go clusterIdentityAllocator.Run(stopCh)
 
@@ -407,6 +431,7 @@ func createAPIServerConfig(kubeconfig string,
 	egressGroupStore storage.Interface,
 	controllerQuerier querier.ControllerQuerier,
 	endpointQuerier networkpolicy.EndpointQuerier,
+	policyVerdictQuerier networkpolicy.PolicyVerdictQuerier,
 	npController *networkpolicy.NetworkPolicyController,
 	networkPolicyStatusController *networkpolicy.StatusController,
 	egressController *egress.EgressController,
@@ -470,6 +495,7 @@ func createAPIServerConfig(kubeconfig string,
 		controllerQuerier,
 		networkPolicyStatusController,
 		endpointQuerier,
+		policyVerdictQuerier,
 		npController,
 		egressController), nil
 }
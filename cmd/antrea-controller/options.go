@@ -16,29 +16,17 @@ package main
 
 import (
 	"errors"
-	"fmt"
 	"io/ioutil"
-	"net"
 
 	"github.com/spf13/pflag"
 	"gopkg.in/yaml.v2"
 	"k8s.io/klog/v2"
-	netutils "k8s.io/utils/net"
 
-	"antrea.io/antrea/pkg/apis"
 	controllerconfig "antrea.io/antrea/pkg/config/controller"
+	"antrea.io/antrea/pkg/config/validation"
 	"antrea.io/antrea/pkg/features"
 )
 
-const (
-	ipamIPv4MaskLo      = 16
-	ipamIPv4MaskHi      = 30
-	ipamIPv4MaskDefault = 24
-	ipamIPv6MaskLo      = 64
-	ipamIPv6MaskHi      = 126
-	ipamIPv6MaskDefault = 64
-)
-
 type Options struct {
 	// The path of configuration file.
 	configFile string
@@ -64,7 +52,7 @@ func (o *Options) complete(args []string) error {
 			return err
 		}
 	}
-	o.setDefaults()
+	validation.SetControllerConfigDefaults(o.config)
 	return features.DefaultMutableFeatureGate.SetFromMap(o.config.FeatureGates)
 }
 
@@ -74,77 +62,11 @@ func (o *Options) validate(args []string) error {
 		return errors.New("no positional arguments are supported")
 	}
 
-	if o.config.NodeIPAM.EnableNodeIPAM {
-		err := o.validateNodeIPAMControllerOptions()
-		if err != nil {
-			return err
-		}
-	}
-
-	if o.config.LegacyCRDMirroring != nil {
-		klog.InfoS("The legacyCRDMirroring config option is deprecated and will be ignored (no CRD mirroring)")
+	warnings, err := validation.ValidateControllerConfig(o.config, features.DefaultFeatureGate)
+	for _, w := range warnings {
+		klog.InfoS(w)
 	}
-
-	return nil
-}
-
-func (o *Options) validateNodeIPAMControllerOptions() error {
-	// Validate ClusterCIDRs
-	cidrs, err := netutils.ParseCIDRs(o.config.NodeIPAM.ClusterCIDRs)
-	if err != nil {
-		return fmt.Errorf("cluster CIDRs %v is invalid", o.config.NodeIPAM.ClusterCIDRs)
-	}
-
-	if len(cidrs) == 0 {
-		return fmt.Errorf("at least one cluster CIDR must be specified")
-	}
-	if len(cidrs) > 2 {
-		return fmt.Errorf("at most two cluster CIDRs may be specified")
-	}
-
-	hasIP4, hasIP6 := false, false
-	for _, cidr := range cidrs {
-		if cidr.IP.To4() == nil {
-			hasIP6 = true
-		} else {
-			hasIP4 = true
-		}
-	}
-
-	dualStack := hasIP4 && hasIP6
-	if len(cidrs) > 1 && !dualStack {
-		return fmt.Errorf("at most one cluster CIDR may be specified for each IP family")
-	}
-
-	if hasIP4 {
-		if o.config.NodeIPAM.NodeCIDRMaskSizeIPv4 < ipamIPv4MaskLo || o.config.NodeIPAM.NodeCIDRMaskSizeIPv4 > ipamIPv4MaskHi {
-			return fmt.Errorf("node IPv4 CIDR mask size %d is invalid, should be between %d and %d",
-				o.config.NodeIPAM.NodeCIDRMaskSizeIPv4, ipamIPv4MaskLo, ipamIPv4MaskHi)
-		}
-	}
-
-	if hasIP6 {
-		if o.config.NodeIPAM.NodeCIDRMaskSizeIPv6 < ipamIPv6MaskLo || o.config.NodeIPAM.NodeCIDRMaskSizeIPv6 > ipamIPv6MaskHi {
-			return fmt.Errorf("node IPv6 CIDR mask size %d is invalid, should be between %d and %d",
-				o.config.NodeIPAM.NodeCIDRMaskSizeIPv6, ipamIPv6MaskLo, ipamIPv6MaskHi)
-		}
-	}
-
-	// Validate ServiceCIDR and ServiceCIDRv6. Service CIDRs can be empty when there is no overlap with ClusterCIDR
-	if o.config.NodeIPAM.ServiceCIDR != "" {
-		_, _, err = net.ParseCIDR(o.config.NodeIPAM.ServiceCIDR)
-		if err != nil {
-			return fmt.Errorf("service CIDR %s is invalid", o.config.NodeIPAM.ServiceCIDR)
-		}
-	}
-	if o.config.NodeIPAM.ServiceCIDRv6 != "" {
-		_, _, err = net.ParseCIDR(o.config.NodeIPAM.ServiceCIDRv6)
-		if err != nil {
-			return fmt.Errorf("secondary service CIDR %s is invalid", o.config.NodeIPAM.ServiceCIDRv6)
-		}
-	}
-
-	return nil
+	return err
 }
 
 func (o *Options) loadConfigFromFile() error {
@@ -155,32 +77,3 @@ func (o *Options) loadConfigFromFile() error {
 
 	return yaml.UnmarshalStrict(data, &o.config)
 }
-
-func (o *Options) setDefaults() {
-	if o.config.APIPort == 0 {
-		o.config.APIPort = apis.AntreaControllerAPIPort
-	}
-	if o.config.EnablePrometheusMetrics == nil {
-		o.config.EnablePrometheusMetrics = ptrBool(true)
-	}
-	if o.config.SelfSignedCert == nil {
-		o.config.SelfSignedCert = ptrBool(true)
-	}
-	if o.config.NodeIPAM.NodeCIDRMaskSizeIPv4 == 0 {
-		o.config.NodeIPAM.NodeCIDRMaskSizeIPv4 = ipamIPv4MaskDefault
-	}
-
-	if o.config.NodeIPAM.NodeCIDRMaskSizeIPv6 == 0 {
-		o.config.NodeIPAM.NodeCIDRMaskSizeIPv6 = ipamIPv6MaskDefault
-	}
-	if o.config.IPsecCSRSignerConfig.SelfSignedCA == nil {
-		o.config.IPsecCSRSignerConfig.SelfSignedCA = ptrBool(true)
-	}
-	if o.config.IPsecCSRSignerConfig.AutoApprove == nil {
-		o.config.IPsecCSRSignerConfig.AutoApprove = ptrBool(true)
-	}
-}
-
-func ptrBool(value bool) *bool {
-	return &value
-}
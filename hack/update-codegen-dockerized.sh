@@ -52,13 +52,13 @@ function generate_mocks {
     "pkg/agent/route Interface testing"
     "pkg/agent/ipassigner IPAssigner testing"
     "pkg/antctl AntctlClient ."
-    "pkg/controller/networkpolicy EndpointQuerier testing"
+    "pkg/controller/networkpolicy EndpointQuerier,PolicyVerdictQuerier testing"
     "pkg/controller/querier ControllerQuerier testing"
     "pkg/ipfix IPFIXExportingProcess,IPFIXRegistry,IPFIXCollectingProcess,IPFIXAggregationProcess testing"
     "pkg/ovs/openflow Bridge,Table,Flow,Action,CTAction,FlowBuilder testing"
     "pkg/ovs/ovsconfig OVSBridgeClient testing"
     "pkg/ovs/ovsctl OVSCtlClient testing"
-    "pkg/querier AgentNetworkPolicyInfoQuerier,AgentMulticastInfoQuerier testing"
+    "pkg/querier AgentNetworkPolicyInfoQuerier,AgentMulticastInfoQuerier,EgressQuerier testing"
     "third_party/proxy Provider testing"
   )
 
@@ -109,6 +109,9 @@ fi
 # Generate protobuf code for CNI gRPC service with protoc.
 protoc --go_out=plugins=grpc:. pkg/apis/cni/v1beta1/cni.proto
 
+# Generate protobuf code for the flow records exported by the Flow Aggregator.
+protoc --go_out=. pkg/apis/flow/v1alpha1/flow.proto
+
 # Generate clientset and apis code with K8s codegen tools.
 $GOPATH/bin/client-gen \
   --clientset-name versioned \
@@ -124,6 +127,9 @@ $GOPATH/bin/client-gen \
   --plural-exceptions "NetworkPolicyStats:NetworkPolicyStats" \
   --plural-exceptions "AntreaNetworkPolicyStats:AntreaNetworkPolicyStats" \
   --plural-exceptions "AntreaClusterNetworkPolicyStats:AntreaClusterNetworkPolicyStats" \
+  --plural-exceptions "PodTrafficStats:PodTrafficStats" \
+  --plural-exceptions "NamespaceTrafficStats:NamespaceTrafficStats" \
+  --plural-exceptions "NodeLatencyStats:NodeLatencyStats" \
   --plural-exceptions "ClusterGroupMembers:ClusterGroupMembers" \
   --go-header-file hack/boilerplate/license_header.go.txt
 
@@ -147,6 +153,28 @@ $GOPATH/bin/informer-gen \
   --output-package "${ANTREA_PKG}/pkg/client/informers" \
   --go-header-file hack/boilerplate/license_header.go.txt
 
+# Generate clientset, listers and informers for the AdminNetworkPolicy API types
+# copied from sigs.k8s.io/network-policy-api.
+NETWORK_POLICY_API_PKG="${ANTREA_PKG}/third_party/networkpolicyapi"
+$GOPATH/bin/client-gen \
+  --clientset-name versioned \
+  --input-base "${NETWORK_POLICY_API_PKG}/" \
+  --input "apis/v1alpha1" \
+  --output-package "${NETWORK_POLICY_API_PKG}/pkg/client/clientset" \
+  --go-header-file hack/boilerplate/license_header.go.txt
+
+$GOPATH/bin/lister-gen \
+  --input-dirs "${NETWORK_POLICY_API_PKG}/apis/v1alpha1" \
+  --output-package "${NETWORK_POLICY_API_PKG}/pkg/client/listers" \
+  --go-header-file hack/boilerplate/license_header.go.txt
+
+$GOPATH/bin/informer-gen \
+  --input-dirs "${NETWORK_POLICY_API_PKG}/apis/v1alpha1" \
+  --versioned-clientset-package "${NETWORK_POLICY_API_PKG}/pkg/client/clientset/versioned" \
+  --listers-package "${NETWORK_POLICY_API_PKG}/pkg/client/listers" \
+  --output-package "${NETWORK_POLICY_API_PKG}/pkg/client/informers" \
+  --go-header-file hack/boilerplate/license_header.go.txt
+
 $GOPATH/bin/deepcopy-gen \
   --input-dirs "${ANTREA_PKG}/pkg/apis/controlplane" \
   --input-dirs "${ANTREA_PKG}/pkg/apis/controlplane/v1beta2" \
@@ -157,6 +185,7 @@ $GOPATH/bin/deepcopy-gen \
   --input-dirs "${ANTREA_PKG}/pkg/apis/crd/v1beta1" \
   --input-dirs "${ANTREA_PKG}/pkg/apis/stats" \
   --input-dirs "${ANTREA_PKG}/pkg/apis/stats/v1alpha1" \
+  --input-dirs "${ANTREA_PKG}/third_party/networkpolicyapi/apis/v1alpha1" \
   -O zz_generated.deepcopy \
   --go-header-file hack/boilerplate/license_header.go.txt
 
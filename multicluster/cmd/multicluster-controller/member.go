@@ -97,6 +97,12 @@ func runMember(o *Options) error {
 		env.GetPodNamespace(),
 		commonAreaGetter)
 
+	// Resources are exported to the elected leader only, so they must be synced
+	// to the new leader when the previous one becomes unavailable.
+	clusterSetReconciler.AddLeaderChangeHandler(svcExportReconciler.OnLeaderChange)
+	clusterSetReconciler.AddLeaderChangeHandler(gwReconciler.OnLeaderChange)
+	clusterSetReconciler.AddLeaderChangeHandler(staleController.OnLeaderChange)
+
 	go staleController.Run(stopCh)
 	// Member runs ResourceImportReconciler from RemoteCommonArea only
 
@@ -124,6 +124,10 @@ func (r *ResourceImportReconciler) handleResImpDeleteForClusterNetworkPolicy(ctx
 		}
 		return ctrl.Result{}, err
 	}
+	if _, ok := acnp.Annotations[common.AntreaMCACNPAnnotation]; !ok {
+		klog.InfoS("ACNP is not created by Importer, skip deletion", "acnp", acnpName.String())
+		return ctrl.Result{}, nil
+	}
 	if err = r.localClusterClient.Delete(ctx, acnp, &client.DeleteOptions{}); err != nil {
 		return ctrl.Result{}, err
 	}
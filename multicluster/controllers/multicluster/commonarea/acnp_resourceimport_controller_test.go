@@ -170,7 +170,8 @@ func TestResourceImportReconciler_handleCopySpanACNPDeleteEvent(t *testing.T) {
 
 	existingACNP := &v1alpha1.ClusterNetworkPolicy{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: common.AntreaMCSPrefix + acnpImportName,
+			Name:        common.AntreaMCSPrefix + acnpImportName,
+			Annotations: map[string]string{common.AntreaMCACNPAnnotation: "true"},
 		},
 	}
 
@@ -54,11 +54,12 @@ func (c *fakeRemoteCommonArea) IsConnected() bool {
 	return true
 }
 
-func (c *fakeRemoteCommonArea) StartWatching() error {
+func (c *fakeRemoteCommonArea) StartWatching(installedResImports []multiclusterv1alpha1.ResourceImport) error {
 	return nil
 }
 
-func (c *fakeRemoteCommonArea) StopWatching() {
+func (c *fakeRemoteCommonArea) StopWatching() []multiclusterv1alpha1.ResourceImport {
+	return nil
 }
 
 func (c *fakeRemoteCommonArea) GetStatus() []multiclusterv1alpha1.ClusterCondition {
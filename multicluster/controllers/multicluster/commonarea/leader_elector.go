@@ -17,8 +17,7 @@ limitations under the License.
 package commonarea
 
 import (
-	"crypto/rand"
-	"math/big"
+	"sort"
 
 	"k8s.io/klog/v2"
 
@@ -42,7 +41,13 @@ import (
  * from the list of leader clusters in the ClusterSet spec.
  *
  * Leader election picks an elected-leader among "connected" leaders
- * by choosing one randomly.
+ * by following the order in which the leaders are defined in the ClusterSet
+ * spec: the first connected leader is elected. Because every member cluster
+ * applies the same order, all members which can reach the first leader
+ * converge on the same elected-leader, and therefore import the same set
+ * of resources. When the elected-leader becomes unreachable, the next
+ * connected leader is elected; when a leader with a higher priority becomes
+ * reachable again, the member fails back to it.
  * The member cluster periodically writes MemberClusterAnnounce into every leader
  * cluster and is considered "connected" if it can successfully write so.
  * After leader election is done, the result is also used to update the
@@ -63,6 +68,10 @@ func (r *remoteCommonAreaManager) RunLeaderElection() {
 				klog.InfoS("Disconnected leader", "Cluster",
 					r.electedLeaderCluster.GetClusterID())
 				r.setElectedLeader(nil)
+			} else if preferred := r.getPreferredLeader(); preferred != nil && preferred != r.electedLeaderCluster {
+				klog.InfoS("Leader with a higher priority is connected", "Cluster", preferred.GetClusterID(),
+					"ElectedLeader", r.electedLeaderCluster.GetClusterID())
+				r.needElection = true
 			}
 		}
 		if r.electedLeaderCluster == nil {
@@ -82,33 +91,62 @@ func (r *remoteCommonAreaManager) RunLeaderElection() {
 
 func (r *remoteCommonAreaManager) doLeaderElection() {
 	// We have written MemberClusterAnnounce at least once to all RemoteCommonArea.
-	// Pick one randomly if it is connected.
-	var connectedClusterIDs []common.ClusterID
-	for id, cluster := range r.remoteCommonAreas {
-		if cluster.IsConnected() {
-			klog.InfoS("Election: leader is connected", "Cluster", id)
-			connectedClusterIDs = append(connectedClusterIDs, cluster.GetClusterID())
-		} else {
-			klog.InfoS("Election: leader is not connected", "Cluster", id)
-		}
-	}
-	if len(connectedClusterIDs) > 0 {
-		nBig, err := rand.Int(rand.Reader, big.NewInt(int64(len(connectedClusterIDs))))
-		if err != nil {
-			klog.ErrorS(err, "Unable to perform leader election")
-			return
-		}
-		electedLeaderIndex := nBig.Int64()
+	// Pick the connected one with the highest priority.
+	electedLeader := r.getPreferredLeader()
+	if electedLeader != nil {
 		// election complete
-		electedLeaderClusterID := connectedClusterIDs[electedLeaderIndex]
-		klog.InfoS("Election completed", "ElectedLeader", electedLeaderClusterID)
-		r.setElectedLeader(r.remoteCommonAreas[electedLeaderClusterID])
+		klog.InfoS("Election completed", "ElectedLeader", electedLeader.GetClusterID())
+		r.setElectedLeader(electedLeader)
 		r.needElection = false
 		return
 	}
 	// Couldn't elect a leader, will try next interval.
 }
 
+// getPreferredLeader returns the connected RemoteCommonArea with the highest
+// priority, or nil if no RemoteCommonArea is connected.
+func (r *remoteCommonAreaManager) getPreferredLeader() RemoteCommonArea {
+	for _, id := range r.getOrderedLeaderIDs() {
+		cluster := r.remoteCommonAreas[id]
+		if cluster.IsConnected() {
+			klog.V(2).InfoS("Election: leader is connected", "Cluster", id)
+			return cluster
+		}
+		klog.V(2).InfoS("Election: leader is not connected", "Cluster", id)
+	}
+	return nil
+}
+
+// getOrderedLeaderIDs returns the IDs of all RemoteCommonAreas sorted by
+// priority. Leaders are ordered as configured with SetLeaderOrder; leaders
+// without a configured order come last and are sorted by ClusterID, so the
+// result is the same in all member clusters.
+func (r *remoteCommonAreaManager) getOrderedLeaderIDs() []common.ClusterID {
+	r.mutex.Lock()
+	priorities := make(map[common.ClusterID]int, len(r.leaderOrder))
+	for i, id := range r.leaderOrder {
+		priorities[id] = i
+	}
+	r.mutex.Unlock()
+
+	ids := make([]common.ClusterID, 0, len(r.remoteCommonAreas))
+	for id := range r.remoteCommonAreas {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		pi, iOrdered := priorities[ids[i]]
+		pj, jOrdered := priorities[ids[j]]
+		if iOrdered != jOrdered {
+			return iOrdered
+		}
+		if iOrdered && pi != pj {
+			return pi < pj
+		}
+		return ids[i] < ids[j]
+	})
+	return ids
+}
+
 func (r *remoteCommonAreaManager) setElectedLeader(cluster RemoteCommonArea) {
 	defer r.mutex.Unlock()
 	r.mutex.Lock()
@@ -117,13 +155,20 @@ func (r *remoteCommonAreaManager) setElectedLeader(cluster RemoteCommonArea) {
 		return
 	}
 
+	// The ResourceImports installed from the previous leader are handed over to
+	// the next elected leader, so the imported resources which no longer exist
+	// in the new leader are removed, and the others are updated from it. They
+	// are kept until a new leader is elected if the member is disconnected from
+	// all leaders.
 	if r.electedLeaderCluster != nil {
-		r.electedLeaderCluster.StopWatching()
+		r.installedResImports = append(r.installedResImports, r.electedLeaderCluster.StopWatching()...)
 	}
 	r.electedLeaderCluster = cluster
 	if cluster != nil {
-		if err := cluster.StartWatching(); err != nil {
+		if err := cluster.StartWatching(r.installedResImports); err != nil {
 			klog.ErrorS(err, "Failed to start watching events")
+			return
 		}
+		r.installedResImports = nil
 	}
 }
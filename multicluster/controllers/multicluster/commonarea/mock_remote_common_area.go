@@ -263,17 +263,17 @@ func (mr *MockRemoteCommonAreaMockRecorder) Start() *gomock.Call {
 }
 
 // StartWatching mocks base method.
-func (m *MockRemoteCommonArea) StartWatching() error {
+func (m *MockRemoteCommonArea) StartWatching(arg0 []v1alpha1.ResourceImport) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StartWatching")
+	ret := m.ctrl.Call(m, "StartWatching", arg0)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // StartWatching indicates an expected call of StartWatching.
-func (mr *MockRemoteCommonAreaMockRecorder) StartWatching() *gomock.Call {
+func (mr *MockRemoteCommonAreaMockRecorder) StartWatching(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartWatching", reflect.TypeOf((*MockRemoteCommonArea)(nil).StartWatching))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartWatching", reflect.TypeOf((*MockRemoteCommonArea)(nil).StartWatching), arg0)
 }
 
 // Status mocks base method.
@@ -305,9 +305,11 @@ func (mr *MockRemoteCommonAreaMockRecorder) Stop() *gomock.Call {
 }
 
 // StopWatching mocks base method.
-func (m *MockRemoteCommonArea) StopWatching() {
+func (m *MockRemoteCommonArea) StopWatching() []v1alpha1.ResourceImport {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "StopWatching")
+	ret := m.ctrl.Call(m, "StopWatching")
+	ret0, _ := ret[0].([]v1alpha1.ResourceImport)
+	return ret0
 }
 
 // StopWatching indicates an expected call of StopWatching.
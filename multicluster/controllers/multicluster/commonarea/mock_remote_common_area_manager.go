@@ -62,6 +62,20 @@ func (mr *MockRemoteCommonAreaManagerMockRecorder) AddRemoteCommonArea(remoteCom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRemoteCommonArea", reflect.TypeOf((*MockRemoteCommonAreaManager)(nil).AddRemoteCommonArea), remoteCommonArea)
 }
 
+// GetElectedLeader mocks base method.
+func (m *MockRemoteCommonAreaManager) GetElectedLeader() RemoteCommonArea {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetElectedLeader")
+	ret0, _ := ret[0].(RemoteCommonArea)
+	return ret0
+}
+
+// GetElectedLeader indicates an expected call of GetElectedLeader.
+func (mr *MockRemoteCommonAreaManagerMockRecorder) GetElectedLeader() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetElectedLeader", reflect.TypeOf((*MockRemoteCommonAreaManager)(nil).GetElectedLeader))
+}
+
 // GetElectedLeaderClusterID mocks base method.
 func (m *MockRemoteCommonAreaManager) GetElectedLeaderClusterID() common.ClusterID {
 	m.ctrl.T.Helper()
@@ -144,6 +158,18 @@ func (mr *MockRemoteCommonAreaManagerMockRecorder) RemoveRemoteCommonArea(remote
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRemoteCommonArea", reflect.TypeOf((*MockRemoteCommonAreaManager)(nil).RemoveRemoteCommonArea), remoteCluster)
 }
 
+// SetLeaderOrder mocks base method.
+func (m *MockRemoteCommonAreaManager) SetLeaderOrder(clusterIDs []common.ClusterID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetLeaderOrder", clusterIDs)
+}
+
+// SetLeaderOrder indicates an expected call of SetLeaderOrder.
+func (mr *MockRemoteCommonAreaManagerMockRecorder) SetLeaderOrder(clusterIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLeaderOrder", reflect.TypeOf((*MockRemoteCommonAreaManager)(nil).SetLeaderOrder), clusterIDs)
+}
+
 // Start mocks base method.
 func (m *MockRemoteCommonAreaManager) Start() error {
 	m.ctrl.T.Helper()
@@ -58,10 +58,13 @@ type RemoteCommonArea interface {
 	IsConnected() bool
 
 	// StartWatching sets up a Manager to reconcile resource crud operations from CommonArea of RemoteCommonArea.
-	StartWatching() error
+	// installedResImports are the ResourceImports installed from the previously elected leader, which are
+	// reconciled against the ResourceImports in this RemoteCommonArea.
+	StartWatching(installedResImports []multiclusterv1alpha1.ResourceImport) error
 
 	// StopWatching stops the Manager so the crud operations in RemoteCommonArea no longer invoke the reconcilers.
-	StopWatching()
+	// It returns the ResourceImports which have been installed from this RemoteCommonArea.
+	StopWatching() []multiclusterv1alpha1.ResourceImport
 
 	GetStatus() []multiclusterv1alpha1.ClusterCondition
 }
@@ -111,6 +114,12 @@ type remoteCommonArea struct {
 
 	// managerStopFunc to stop the manager when the RemoteCommonArea is stopped.
 	managerStopFunc context.CancelFunc
+	// managerStoppedCh is closed when the manager has stopped after managerStopFunc is called.
+	managerStoppedCh chan struct{}
+
+	// resImportReconciler is the ResourceImportReconciler running in the manager while
+	// this RemoteCommonArea is the elected leader.
+	resImportReconciler *ResourceImportReconciler
 }
 
 // NewRemoteCommonArea returns a RemoteCommonArea instance which will use access credentials from the Secret to
@@ -364,7 +373,7 @@ func (r *remoteCommonArea) IsConnected() bool {
 	return r.connected
 }
 
-func (r *remoteCommonArea) StartWatching() error {
+func (r *remoteCommonArea) StartWatching(installedResImports []multiclusterv1alpha1.ResourceImport) error {
 	if r.managerStopFunc != nil {
 		klog.InfoS("Manager already watching resources from RemoteCommonArea", "Cluster", r.ClusterID)
 		return nil
@@ -380,15 +389,23 @@ func (r *remoteCommonArea) StartWatching() error {
 		r.remoteCommonAreaManager.GetNamespace(),
 		r,
 	)
+	if len(installedResImports) > 0 {
+		klog.InfoS("Reconciling ResourceImports installed from the previous leader", "Cluster", r.ClusterID, "count", len(installedResImports))
+		resImportReconciler.AdoptResourceImports(installedResImports)
+	}
 
 	if err := resImportReconciler.SetupWithManager(r.ClusterManager); err != nil {
 		klog.V(2).ErrorS(err, "Error creating ResourceImport controller for RemoteCommonArea", "Cluster", r.ClusterID)
 		return fmt.Errorf("error creating ResourceImport controller for RemoteCommonArea: %v", err)
 	}
+	r.resImportReconciler = resImportReconciler
 
+	stopCtx, stopFunc := context.WithCancel(context.Background())
+	r.managerStopFunc = stopFunc
+	managerStoppedCh := make(chan struct{})
+	r.managerStoppedCh = managerStoppedCh
 	go func() {
-		stopCtx, stopFunc := context.WithCancel(context.Background())
-		r.managerStopFunc = stopFunc
+		defer close(managerStoppedCh)
 		// This starts the Manager and blocks; Manager performs reconciliation of resources from the RemoteCommonArea.
 		// When this RemoteCommonArea is not an elected leader anymore, stopCtx will be closed in StopWatching,
 		// so this blocking routine can return and finish. And the next time this RemoteCommonArea is elected as
@@ -403,12 +420,21 @@ func (r *remoteCommonArea) StartWatching() error {
 	return nil
 }
 
-func (r *remoteCommonArea) StopWatching() {
+func (r *remoteCommonArea) StopWatching() []multiclusterv1alpha1.ResourceImport {
 	if r.managerStopFunc == nil {
-		return
+		return nil
 	}
 	r.managerStopFunc()
 	r.managerStopFunc = nil
+	// Wait for the manager, and the in-flight reconciliations, to stop, so no
+	// ResourceImport is installed or removed after the snapshot below.
+	<-r.managerStoppedCh
+	r.managerStoppedCh = nil
+	var installedResImports []multiclusterv1alpha1.ResourceImport
+	if r.resImportReconciler != nil {
+		installedResImports = r.resImportReconciler.GetInstalledResourceImports()
+		r.resImportReconciler = nil
+	}
 
 	// Reset ClusterManager so this common area can be started again when it's reconnected.
 	mgr, err := ctrl.NewManager(r.config, ctrl.Options{
@@ -420,6 +446,7 @@ func (r *remoteCommonArea) StopWatching() {
 		klog.ErrorS(err, "Error to reset manager for RemoteCommonArea", "Cluster", r.ClusterID)
 	}
 	r.ClusterManager = mgr
+	return installedResImports
 }
 
 func (r *remoteCommonArea) GetStatus() []multiclusterv1alpha1.ClusterCondition {
@@ -45,6 +45,11 @@ type RemoteCommonAreaManager interface {
 	GetRemoteCommonAreas() map[common.ClusterID]RemoteCommonArea
 	// GetElectedLeaderClusterID returns the elected, leader RemoteCommonArea or InvalidClusterID if none elected
 	GetElectedLeaderClusterID() common.ClusterID
+	// GetElectedLeader returns the elected, leader RemoteCommonArea or nil if none elected.
+	GetElectedLeader() RemoteCommonArea
+	// SetLeaderOrder sets the priority of leader clusters used by leader election. The first
+	// connected leader in the list is elected.
+	SetLeaderOrder(clusterIDs []common.ClusterID)
 	// GetLocalClusterID returns local cluster ID
 	GetLocalClusterID() common.ClusterID
 	// GetNamespace returns local Namespace where the RemoteCommonAreaManager is running.
@@ -74,6 +79,16 @@ type remoteCommonAreaManager struct {
 	// 2. RemoteCommonArea background go routine
 	electedLeaderCluster RemoteCommonArea
 
+	// installedResImports are the ResourceImports installed from the previously elected
+	// leader, which have not been handed over to a new elected leader yet.
+	// It is protected by the mutex.
+	installedResImports []multiclusterv1alpha1.ResourceImport
+
+	// leaderOrder is the priority of leader clusters during leader election,
+	// which follows the order of leaders in the ClusterSet spec.
+	// It is protected by the mutex.
+	leaderOrder []common.ClusterID
+
 	// needElection tracks whether election is needed.
 	// It is only accessed in LeaderElector context.
 	needElection bool
@@ -206,6 +221,18 @@ func (r *remoteCommonAreaManager) GetElectedLeaderClusterID() common.ClusterID {
 	return common.InvalidClusterID
 }
 
+func (r *remoteCommonAreaManager) GetElectedLeader() RemoteCommonArea {
+	defer r.mutex.Unlock()
+	r.mutex.Lock()
+	return r.electedLeaderCluster
+}
+
+func (r *remoteCommonAreaManager) SetLeaderOrder(clusterIDs []common.ClusterID) {
+	defer r.mutex.Unlock()
+	r.mutex.Lock()
+	r.leaderOrder = append([]common.ClusterID(nil), clusterIDs...)
+}
+
 func (r *remoteCommonAreaManager) GetLocalClusterID() common.ClusterID {
 	return r.clusterID
 }
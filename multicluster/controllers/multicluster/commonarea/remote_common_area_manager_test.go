@@ -17,11 +17,13 @@ limitations under the License.
 package commonarea
 
 import (
+	"reflect"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	k8sscheme "k8s.io/client-go/kubernetes/scheme"
@@ -47,7 +49,7 @@ func TestAddMember(t *testing.T) {
 	mockRemoteCommonArea.EXPECT().GetClusterID().Return(common.ClusterID("leaderA")).AnyTimes()
 	mockRemoteCommonArea.EXPECT().IsConnected().Return(true).AnyTimes()
 	mockRemoteCommonArea.EXPECT().Start()
-	mockRemoteCommonArea.EXPECT().StartWatching()
+	mockRemoteCommonArea.EXPECT().StartWatching(gomock.Any())
 
 	remoteCommonAreaManagerUnderTest.AddRemoteCommonArea(mockRemoteCommonArea)
 
@@ -99,7 +101,7 @@ func TestLeaderElection(t *testing.T) {
 			return i < 2
 		}).AnyTimes()
 	mockRemoteCommonArea1.EXPECT().Start()
-	mockRemoteCommonArea1.EXPECT().StartWatching()
+	mockRemoteCommonArea1.EXPECT().StartWatching(gomock.Any())
 	mockRemoteCommonArea1.EXPECT().StopWatching()
 
 	mockRemoteCommonArea2 := NewMockRemoteCommonArea(mockCtrl)
@@ -111,7 +113,7 @@ func TestLeaderElection(t *testing.T) {
 			return j >= 2
 		}).AnyTimes()
 	mockRemoteCommonArea2.EXPECT().Start()
-	mockRemoteCommonArea2.EXPECT().StartWatching()
+	mockRemoteCommonArea2.EXPECT().StartWatching(gomock.Any())
 
 	remoteCommonAreaManagerUnderTest.AddRemoteCommonArea(mockRemoteCommonArea1)
 	remoteCommonAreaManagerUnderTest.AddRemoteCommonArea(mockRemoteCommonArea2)
@@ -161,6 +163,78 @@ func TestLeaderElection(t *testing.T) {
 	remoteCommonAreaManagerUnderTest.Stop()
 }
 
+func TestLeaderElectionWithLeaderOrder(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	connected := map[common.ClusterID]bool{"leaderA": false, "leaderB": true, "leaderC": true}
+	remoteCommonAreas := map[common.ClusterID]RemoteCommonArea{}
+	mocks := map[common.ClusterID]*MockRemoteCommonArea{}
+	for id := range connected {
+		clusterID := id
+		mockRemoteCommonArea := NewMockRemoteCommonArea(mockCtrl)
+		mockRemoteCommonArea.EXPECT().GetClusterID().Return(clusterID).AnyTimes()
+		mockRemoteCommonArea.EXPECT().IsConnected().DoAndReturn(func() bool {
+			return connected[clusterID]
+		}).AnyTimes()
+		remoteCommonAreas[clusterID] = mockRemoteCommonArea
+		mocks[clusterID] = mockRemoteCommonArea
+	}
+	r := &remoteCommonAreaManager{
+		clusterSetID:      "clusterSetA",
+		clusterID:         "memberA",
+		remoteCommonAreas: remoteCommonAreas,
+	}
+	r.SetLeaderOrder([]common.ClusterID{"leaderC", "leaderA", "leaderB"})
+
+	// leaderC is the connected leader with the highest priority.
+	mocks["leaderC"].EXPECT().StartWatching(nil)
+	r.RunLeaderElection()
+	if leader := r.GetElectedLeaderClusterID(); leader != "leaderC" {
+		t.Errorf("Expected leaderC to be elected, got %s", leader)
+	}
+
+	// leaderC is disconnected, the next connected leader is leaderB. The
+	// ResourceImports installed from leaderC are handed over to leaderB.
+	installedResImports := []mcsv1alpha1.ResourceImport{{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "default-nginx-service"},
+	}}
+	connected["leaderC"] = false
+	mocks["leaderC"].EXPECT().StopWatching().Return(installedResImports)
+	mocks["leaderB"].EXPECT().StartWatching(installedResImports)
+	r.RunLeaderElection()
+	r.RunLeaderElection()
+	if leader := r.GetElectedLeaderClusterID(); leader != "leaderB" {
+		t.Errorf("Expected leaderB to be elected, got %s", leader)
+	}
+
+	// leaderA has a higher priority than leaderB, fail over to it once connected.
+	connected["leaderA"] = true
+	mocks["leaderB"].EXPECT().StopWatching().Return(installedResImports)
+	mocks["leaderA"].EXPECT().StartWatching(installedResImports)
+	r.RunLeaderElection()
+	if leader := r.GetElectedLeaderClusterID(); leader != "leaderA" {
+		t.Errorf("Expected leaderA to be elected, got %s", leader)
+	}
+	if leader := r.GetElectedLeader(); leader != remoteCommonAreas["leaderA"] {
+		t.Errorf("Expected RemoteCommonArea of leaderA to be returned, got %v", leader)
+	}
+}
+
+func TestGetOrderedLeaderIDs(t *testing.T) {
+	r := &remoteCommonAreaManager{
+		remoteCommonAreas: map[common.ClusterID]RemoteCommonArea{
+			"leaderA": nil,
+			"leaderB": nil,
+			"leaderC": nil,
+			"leaderD": nil,
+		},
+	}
+	r.SetLeaderOrder([]common.ClusterID{"leaderC", "leaderB", "leaderE"})
+	expected := []common.ClusterID{"leaderC", "leaderB", "leaderA", "leaderD"}
+	if ids := r.getOrderedLeaderIDs(); !reflect.DeepEqual(ids, expected) {
+		t.Errorf("Expected ordered leaders %v, got %v", expected, ids)
+	}
+}
+
 func init() {
 	utilruntime.Must(mcsv1alpha1.AddToScheme(rcmtScheme))
 	utilruntime.Must(k8smcsapi.AddToScheme(rcmtScheme))
@@ -32,7 +32,10 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 	k8smcsv1alpha1 "sigs.k8s.io/mcs-api/pkg/apis/v1alpha1"
 
 	multiclusterv1alpha1 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha1"
@@ -63,6 +66,10 @@ type ResourceImportReconciler struct {
 	namespace           string
 	remoteCommonArea    RemoteCommonArea
 	installedResImports cache.Indexer
+	// adoptedResImportsCh is used to requeue the ResourceImports which were installed
+	// from the previously elected leader, so they are reconciled against the
+	// ResourceImports of this RemoteCommonArea.
+	adoptedResImportsCh chan event.GenericEvent
 }
 
 func NewResourceImportReconciler(client client.Client, scheme *runtime.Scheme, localClusterClient client.Client,
@@ -149,6 +156,52 @@ func (r *ResourceImportReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	return ctrl.Result{}, nil
 }
 
+// AdoptResourceImports takes over the ResourceImports installed from the previously
+// elected leader. Each of them is requeued once the controller is started: if the
+// ResourceImport also exists in this RemoteCommonArea, the imported resources are
+// updated, otherwise they are removed from the local cluster. It must be called
+// before SetupWithManager.
+//
+// The previous leader may use another Namespace for its ResourceImports, so the
+// adopted ResourceImports are keyed by their name in the Namespace of this
+// RemoteCommonArea. This way a ResourceImport which also exists in the new leader
+// is only reconciled once, against the ResourceImport of the new leader. A
+// ResourceImport already installed by this reconciler is never replaced by an
+// adopted one with a different UID.
+func (r *ResourceImportReconciler) AdoptResourceImports(resImps []multiclusterv1alpha1.ResourceImport) {
+	adopted := map[string]multiclusterv1alpha1.ResourceImport{}
+	for i := range resImps {
+		resImp := *resImps[i].DeepCopy()
+		resImp.Namespace = r.remoteCommonArea.GetNamespace()
+		key := common.NamespacedName(resImp.Namespace, resImp.Name)
+		if installed, exists, _ := r.installedResImports.GetByKey(key); exists &&
+			installed.(multiclusterv1alpha1.ResourceImport).UID != resImp.UID {
+			klog.InfoS("Skipping adopted ResourceImport which is already installed", "resourceimport", key)
+			continue
+		}
+		// The ResourceImports installed from earlier leaders come first, so the
+		// last one wins.
+		adopted[key] = resImp
+	}
+	r.adoptedResImportsCh = make(chan event.GenericEvent, len(adopted))
+	for key := range adopted {
+		resImp := adopted[key]
+		r.installedResImports.Add(resImp)
+		r.adoptedResImportsCh <- event.GenericEvent{Object: &resImp}
+	}
+}
+
+// GetInstalledResourceImports returns the ResourceImports which have been installed
+// in the local cluster by this reconciler.
+func (r *ResourceImportReconciler) GetInstalledResourceImports() []multiclusterv1alpha1.ResourceImport {
+	objs := r.installedResImports.List()
+	resImps := make([]multiclusterv1alpha1.ResourceImport, 0, len(objs))
+	for _, obj := range objs {
+		resImps = append(resImps, obj.(multiclusterv1alpha1.ResourceImport))
+	}
+	return resImps
+}
+
 func (r *ResourceImportReconciler) handleResImpUpdateForService(ctx context.Context, resImp *multiclusterv1alpha1.ResourceImport) (ctrl.Result, error) {
 	svcImpName := types.NamespacedName{Namespace: resImp.Spec.Namespace, Name: resImp.Spec.Name}
 	svcName := types.NamespacedName{Namespace: resImp.Spec.Namespace, Name: common.AntreaMCSPrefix + resImp.Spec.Name}
@@ -258,6 +311,10 @@ func (r *ResourceImportReconciler) handleResImpDeleteForService(ctx context.Cont
 		}
 		return ctrl.Result{}, err
 	}
+	if _, ok := svc.Annotations[common.AntreaMCServiceAnnotation]; !ok {
+		klog.InfoS("Service is not created by Importer, skip deletion", "service", svcName.String())
+		return cleanupServiceImport()
+	}
 	err = r.localClusterClient.Delete(ctx, svc, &client.DeleteOptions{})
 	if err != nil {
 		return ctrl.Result{}, err
@@ -332,6 +389,10 @@ func (r *ResourceImportReconciler) handleResImpDeleteForEndpoints(ctx context.Co
 		klog.InfoS("Unable to fetch imported Endpoints", "endpoints", epNamespaced.String(), "err", err)
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
+	if _, ok := ep.Annotations[common.AntreaMCServiceAnnotation]; !ok {
+		klog.InfoS("Endpoints is not created by Importer, skip deletion", "endpoints", epNamespaced.String())
+		return ctrl.Result{}, nil
+	}
 	err = r.localClusterClient.Delete(ctx, ep, &client.DeleteOptions{})
 	if err != nil {
 		klog.InfoS("Failed to delete imported Endpoints", "endpoints", epNamespaced.String(), "err", err)
@@ -382,8 +443,12 @@ func getMCServiceImport(resImp *multiclusterv1alpha1.ResourceImport) *k8smcsv1al
 func (r *ResourceImportReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	// Ignore status update event via GenerationChangedPredicate
 	instance := predicate.GenerationChangedPredicate{}
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&multiclusterv1alpha1.ResourceImport{}).
+	builder := ctrl.NewControllerManagedBy(mgr).
+		For(&multiclusterv1alpha1.ResourceImport{})
+	if r.adoptedResImportsCh != nil {
+		builder = builder.Watches(&source.Channel{Source: r.adoptedResImportsCh}, &handler.EnqueueRequestForObject{})
+	}
+	return builder.
 		WithEventFilter(instance).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: common.DefaultWorkerCount,
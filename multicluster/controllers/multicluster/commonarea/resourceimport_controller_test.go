@@ -180,8 +180,9 @@ func TestResourceImportReconciler_handleDeleteEvent(t *testing.T) {
 
 	existSvc := &corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
-			Namespace: "default",
-			Name:      "antrea-mc-nginx",
+			Namespace:   "default",
+			Name:        "antrea-mc-nginx",
+			Annotations: map[string]string{common.AntreaMCServiceAnnotation: "true"},
 		},
 	}
 	existSvcImp := &k8smcsapi.ServiceImport{
@@ -192,8 +193,9 @@ func TestResourceImportReconciler_handleDeleteEvent(t *testing.T) {
 	}
 	existEp := &corev1.Endpoints{
 		ObjectMeta: metav1.ObjectMeta{
-			Namespace: "default",
-			Name:      "antrea-mc-nginx",
+			Namespace:   "default",
+			Name:        "antrea-mc-nginx",
+			Annotations: map[string]string{common.AntreaMCServiceAnnotation: "true"},
 		},
 	}
 
@@ -248,6 +250,94 @@ func TestResourceImportReconciler_handleDeleteEvent(t *testing.T) {
 	}
 }
 
+func TestResourceImportReconciler_AdoptResourceImports(t *testing.T) {
+	remoteMgr := NewRemoteCommonAreaManager("test-clusterset", common.ClusterID(localClusterID), "kube-system")
+	remoteMgr.Start()
+	defer remoteMgr.Stop()
+
+	existSvc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "default",
+			Name:        "antrea-mc-nginx",
+			Annotations: map[string]string{common.AntreaMCServiceAnnotation: "true"},
+		},
+	}
+	existEp := &corev1.Endpoints{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "default",
+			Name:        "antrea-mc-nginx",
+			Annotations: map[string]string{common.AntreaMCServiceAnnotation: "true"},
+		},
+	}
+	// An Endpoints with the same name as an imported one, but which is not
+	// created by the Importer.
+	existUnownedEp := &corev1.Endpoints{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "kube-system",
+			Name:      "antrea-mc-nginx",
+		},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(existSvc, existEp, existUnownedEp).Build()
+	// The new leader only has the ServiceImport kind of ResourceImport.
+	fakeRemoteClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(svcResImport).Build()
+	remoteCluster := NewFakeRemoteCommonArea(scheme, remoteMgr, fakeRemoteClient, "leader-cluster", leaderNamespace)
+
+	// The previous leader uses another Namespace for its ResourceImports.
+	previousResImport := func(resImp *mcsv1alpha1.ResourceImport, uid types.UID) mcsv1alpha1.ResourceImport {
+		ri := resImp.DeepCopy()
+		ri.Namespace = "previous-leader-ns"
+		ri.UID = uid
+		return *ri
+	}
+	unownedEpResImport := epResImport.DeepCopy()
+	unownedEpResImport.Name = "kube-system-nginx-endpoints"
+	unownedEpResImport.Spec.Namespace = "kube-system"
+	adoptedResImps := []mcsv1alpha1.ResourceImport{
+		previousResImport(svcResImport, "svc-uid"),
+		previousResImport(epResImport, "ep-uid"),
+		previousResImport(unownedEpResImport, "unowned-ep-uid"),
+	}
+
+	r := NewResourceImportReconciler(fakeClient, scheme, fakeClient, localClusterID, "default", remoteCluster)
+	r.AdoptResourceImports(adoptedResImps)
+	var expectedResImps []mcsv1alpha1.ResourceImport
+	for _, ri := range adoptedResImps {
+		ri.Namespace = leaderNamespace
+		expectedResImps = append(expectedResImps, ri)
+	}
+	assert.ElementsMatch(t, expectedResImps, r.GetInstalledResourceImports())
+
+	for i := 0; i < len(adoptedResImps); i++ {
+		e := <-r.adoptedResImportsCh
+		req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: e.Object.GetNamespace(), Name: e.Object.GetName()}}
+		assert.Equal(t, leaderNamespace, req.Namespace)
+		if _, err := r.Reconcile(ctx, req); err != nil && !strings.Contains(err.Error(), "ClusterSetIP is empty") {
+			t.Errorf("ResourceImport Reconciler should handle adopted ResourceImport %s but got error = %v", req, err)
+		}
+	}
+	ep := &corev1.Endpoints{}
+	if err := fakeClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "antrea-mc-nginx"}, ep); !apierrors.IsNotFound(err) {
+		t.Errorf("Endpoints imported from the previous leader should be deleted but got error = %v", err)
+	}
+	if err := fakeClient.Get(ctx, types.NamespacedName{Namespace: "kube-system", Name: "antrea-mc-nginx"}, ep); err != nil {
+		t.Errorf("Endpoints not created by the Importer should be kept but got error = %v", err)
+	}
+	svcImp := &k8smcsapi.ServiceImport{}
+	if err := fakeClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "nginx"}, svcImp); err != nil {
+		t.Errorf("ServiceImport should be imported from the new leader but got error = %v", err)
+	}
+	svc := &corev1.Service{}
+	if err := fakeClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "antrea-mc-nginx"}, svc); err != nil {
+		t.Errorf("Service should be kept as the ResourceImport exists in the new leader but got error = %v", err)
+	}
+
+	// A ResourceImport already installed from the new leader is not replaced.
+	r = NewResourceImportReconciler(fakeClient, scheme, fakeClient, localClusterID, "default", remoteCluster)
+	r.installedResImports.Add(*svcResImport)
+	r.AdoptResourceImports(adoptedResImps[:1])
+	assert.Equal(t, []mcsv1alpha1.ResourceImport{*svcResImport}, r.GetInstalledResourceImports())
+}
+
 func TestResourceImportReconciler_handleUpdateEvent(t *testing.T) {
 	remoteMgr := NewRemoteCommonAreaManager("test-clusterset", common.ClusterID(localClusterID), "kube-system")
 	remoteMgr.Start()
@@ -29,6 +29,9 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	mcsv1alpha1 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha1"
 	"antrea.io/antrea/multicluster/controllers/multicluster/common"
@@ -45,6 +48,9 @@ type (
 		localClusterID   string
 		serviceCIDR      string
 		leaderNamespace  string
+		// resyncCh is used to requeue all Gateways when the elected leader changes,
+		// so the ClusterInfo kind of ResourceExport is created in the new leader.
+		resyncCh chan event.GenericEvent
 	}
 )
 
@@ -62,6 +68,7 @@ func NewGatewayReconciler(
 		namespace:        namespace,
 		serviceCIDR:      serviceCIDR,
 		commonAreaGetter: commonAreaGetter,
+		resyncCh:         make(chan event.GenericEvent),
 	}
 	return reconciler
 }
@@ -233,10 +240,29 @@ func (r *GatewayReconciler) createResourceExport(ctx context.Context, req ctrl.R
 	return nil
 }
 
+// OnLeaderChange requeues all Gateways. It is called when the elected leader
+// has changed, as the ClusterInfo kind of ResourceExport may not exist in the
+// new leader.
+func (r *GatewayReconciler) OnLeaderChange() {
+	gws := &mcsv1alpha1.GatewayList{}
+	if err := r.Client.List(ctx, gws, &client.ListOptions{}); err != nil {
+		klog.ErrorS(err, "Failed to list Gateways after leader change")
+		return
+	}
+	// Events are sent from a separate goroutine, so the leader check loop is not
+	// blocked until the controller has received all of them.
+	go func() {
+		for i := range gws.Items {
+			r.resyncCh <- event.GenericEvent{Object: &gws.Items[i]}
+		}
+	}()
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *GatewayReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&mcsv1alpha1.Gateway{}).
+		Watches(&source.Channel{Source: r.resyncCh}, &handler.EnqueueRequestForObject{}).
 		WithOptions(controller.Options{
 			// TODO: add a lock for serviceCIDR if there is any plan to
 			// increase this concurrent number.
@@ -239,3 +239,24 @@ func TestGatewayReconciler(t *testing.T) {
 		})
 	}
 }
+
+func TestGatewayReconciler_OnLeaderChange(t *testing.T) {
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&gwNode1, &gwNode2).Build()
+	commonAreaGatter := NewMemberClusterSetReconciler(fakeClient, scheme, "default")
+	r := NewGatewayReconciler(fakeClient, scheme, "default", serviceCIDR, commonAreaGatter)
+
+	// OnLeaderChange must not block when the controller is not receiving events.
+	r.OnLeaderChange()
+	var requeued []string
+	for i := 0; i < 2; i++ {
+		select {
+		case e := <-r.resyncCh:
+			requeued = append(requeued, e.Object.GetName())
+		case <-time.After(time.Second):
+			t.Fatalf("Timeout when waiting for Gateways to be requeued")
+		}
+	}
+	if expected := []string{"node-1", "node-2"}; !reflect.DeepEqual(expected, requeued) {
+		t.Errorf("Expected requeued Gateways %v, got %v", expected, requeued)
+	}
+}
@@ -29,10 +29,12 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	multiclusterv1alpha1 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha1"
@@ -40,10 +42,19 @@ import (
 	"antrea.io/antrea/multicluster/controllers/multicluster/commonarea"
 )
 
+const (
+	// leaderCheckInterval is the interval to check whether the elected leader has changed.
+	leaderCheckInterval = 5 * time.Second
+)
+
 type RemoteCommonAreaGetter interface {
 	GetRemoteCommonAreaAndLocalID() (commonarea.RemoteCommonArea, string, error)
 }
 
+// LeaderChangeHandler is notified when the elected leader of the member cluster
+// changes, so that exported resources can be synced to the new leader.
+type LeaderChangeHandler func()
+
 // MemberClusterSetReconciler reconciles a ClusterSet object in the member cluster deployment.
 type MemberClusterSetReconciler struct {
 	client.Client
@@ -56,6 +67,10 @@ type MemberClusterSetReconciler struct {
 	clusterID        common.ClusterID
 
 	remoteCommonAreaManager commonarea.RemoteCommonAreaManager
+
+	// electedLeaderID is the last observed elected leader.
+	electedLeaderID      common.ClusterID
+	leaderChangeHandlers []LeaderChangeHandler
 }
 
 func NewMemberClusterSetReconciler(client client.Client,
@@ -63,9 +78,10 @@ func NewMemberClusterSetReconciler(client client.Client,
 	namespace string,
 ) *MemberClusterSetReconciler {
 	return &MemberClusterSetReconciler{
-		Client:    client,
-		Scheme:    scheme,
-		Namespace: namespace,
+		Client:          client,
+		Scheme:          scheme,
+		Namespace:       namespace,
+		electedLeaderID: common.InvalidClusterID,
 	}
 }
 
@@ -127,13 +143,15 @@ func (r *MemberClusterSetReconciler) Reconcile(ctx context.Context, req ctrl.Req
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *MemberClusterSetReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	// Update status periodically
-	go func() {
-		for {
-			<-time.After(time.Second * 30)
-			r.updateStatus()
-		}
-	}()
+	// Update status and check for leader changes periodically, until the
+	// Manager is stopped.
+	if err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		go wait.Until(r.updateStatus, 30*time.Second, ctx.Done())
+		wait.Until(r.checkElectedLeader, leaderCheckInterval, ctx.Done())
+		return nil
+	})); err != nil {
+		return err
+	}
 
 	// Only register this controller to reconcile the ClusterSet in the same Namespace
 	namespaceFilter := func(object client.Object) bool {
@@ -170,6 +188,14 @@ func (r *MemberClusterSetReconciler) updateMultiClusterSetOnMemberCluster(cluste
 	currentLeaders := r.remoteCommonAreaManager.GetRemoteCommonAreas()
 	newLeaders := clusterSet.Spec.Leaders
 
+	// Leaders are elected in the order they are defined in the ClusterSet spec,
+	// so all member clusters converge on the same leader when it is reachable.
+	leaderOrder := make([]common.ClusterID, 0, len(newLeaders))
+	for _, leader := range newLeaders {
+		leaderOrder = append(leaderOrder, common.ClusterID(leader.ClusterID))
+	}
+	r.remoteCommonAreaManager.SetLeaderOrder(leaderOrder)
+
 	var addedLeaders []*multiclusterv1alpha1.MemberCluster
 	var removedLeaders map[common.ClusterID]commonarea.RemoteCommonArea
 
@@ -314,12 +340,49 @@ func (r *MemberClusterSetReconciler) updateStatus() {
 	}
 }
 
+// AddLeaderChangeHandler registers a handler which is called when the elected
+// leader changes. Handlers must be registered before the manager is started, and
+// must not block as they are called from the leader check loop.
+func (r *MemberClusterSetReconciler) AddLeaderChangeHandler(handler LeaderChangeHandler) {
+	r.leaderChangeHandlers = append(r.leaderChangeHandlers, handler)
+}
+
+// checkElectedLeader calls all LeaderChangeHandlers when a new leader has been
+// elected. Handlers are not called for the first elected leader, or when the
+// member is disconnected from all leaders.
+func (r *MemberClusterSetReconciler) checkElectedLeader() {
+	r.mutex.Lock()
+	if r.remoteCommonAreaManager == nil {
+		r.electedLeaderID = common.InvalidClusterID
+		r.mutex.Unlock()
+		return
+	}
+	leaderID := r.remoteCommonAreaManager.GetElectedLeaderClusterID()
+	if leaderID == common.InvalidClusterID || leaderID == r.electedLeaderID {
+		r.mutex.Unlock()
+		return
+	}
+	previousLeaderID := r.electedLeaderID
+	r.electedLeaderID = leaderID
+	r.mutex.Unlock()
+
+	if previousLeaderID == common.InvalidClusterID {
+		return
+	}
+	klog.InfoS("Elected leader changed", "previousLeader", previousLeaderID, "leader", leaderID)
+	for _, handler := range r.leaderChangeHandlers {
+		handler()
+	}
+}
+
 // SetRemoteCommonAreaManager is for testing only
 func (r *MemberClusterSetReconciler) SetRemoteCommonAreaManager(mgr commonarea.RemoteCommonAreaManager) commonarea.RemoteCommonAreaManager {
 	r.remoteCommonAreaManager = mgr
 	return r.remoteCommonAreaManager
 }
 
+// GetRemoteCommonAreaAndLocalID returns the RemoteCommonArea of the elected leader and the local
+// cluster ID. When no leader has been elected yet, any connected RemoteCommonArea is returned.
 func (r *MemberClusterSetReconciler) GetRemoteCommonAreaAndLocalID() (commonarea.RemoteCommonArea, string, error) {
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
@@ -333,10 +396,14 @@ func (r *MemberClusterSetReconciler) GetRemoteCommonAreaAndLocalID() (commonarea
 		return nil, "", errors.New("ClusterSet has not been set up properly, no available Common Area")
 	}
 
-	for _, c := range remoteCommonAreas {
-		if c.IsConnected() {
-			remoteCommonArea = c
-			break
+	if leader := r.remoteCommonAreaManager.GetElectedLeader(); leader != nil && leader.IsConnected() {
+		remoteCommonArea = leader
+	} else {
+		for _, c := range remoteCommonAreas {
+			if c.IsConnected() {
+				remoteCommonArea = c
+				break
+			}
 		}
 	}
 	if remoteCommonArea != nil {
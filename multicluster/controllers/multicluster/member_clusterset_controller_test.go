@@ -0,0 +1,100 @@
+/*
+Copyright 2022 Antrea Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multicluster
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"antrea.io/antrea/multicluster/controllers/multicluster/common"
+	"antrea.io/antrea/multicluster/controllers/multicluster/commonarea"
+)
+
+func TestCheckElectedLeader(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockManager := commonarea.NewMockRemoteCommonAreaManager(mockCtrl)
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	r := NewMemberClusterSetReconciler(fakeClient, scheme, "default")
+	handlerCalls := 0
+	r.AddLeaderChangeHandler(func() {
+		handlerCalls++
+	})
+
+	// No RemoteCommonAreaManager yet.
+	r.checkElectedLeader()
+	r.SetRemoteCommonAreaManager(mockManager)
+
+	tests := []struct {
+		name                 string
+		leaderID             common.ClusterID
+		expectedLeaderID     common.ClusterID
+		expectedHandlerCalls int
+	}{
+		{
+			name:                 "no leader elected",
+			leaderID:             common.InvalidClusterID,
+			expectedLeaderID:     common.InvalidClusterID,
+			expectedHandlerCalls: 0,
+		},
+		{
+			name:                 "first leader elected",
+			leaderID:             "leader-a",
+			expectedLeaderID:     "leader-a",
+			expectedHandlerCalls: 0,
+		},
+		{
+			name:                 "leader unchanged",
+			leaderID:             "leader-a",
+			expectedLeaderID:     "leader-a",
+			expectedHandlerCalls: 0,
+		},
+		{
+			name:                 "disconnected from all leaders",
+			leaderID:             common.InvalidClusterID,
+			expectedLeaderID:     "leader-a",
+			expectedHandlerCalls: 0,
+		},
+		{
+			name:                 "leader changed",
+			leaderID:             "leader-b",
+			expectedLeaderID:     "leader-b",
+			expectedHandlerCalls: 1,
+		},
+		{
+			name:                 "fail back to the previous leader",
+			leaderID:             "leader-a",
+			expectedLeaderID:     "leader-a",
+			expectedHandlerCalls: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockManager.EXPECT().GetElectedLeaderClusterID().Return(tt.leaderID)
+			r.checkElectedLeader()
+			if r.electedLeaderID != tt.expectedLeaderID {
+				t.Errorf("Expected elected leader %s, got %s", tt.expectedLeaderID, r.electedLeaderID)
+			}
+			if handlerCalls != tt.expectedHandlerCalls {
+				t.Errorf("Expected LeaderChangeHandler to be called %d times, got %d", tt.expectedHandlerCalls, handlerCalls)
+			}
+		})
+	}
+}
@@ -31,6 +31,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
@@ -59,6 +60,9 @@ type (
 		leaderNamespace  string
 		leaderClusterID  string
 		localClusterID   string
+		// resyncCh is used to requeue all ServiceExports when the elected leader changes,
+		// so the ResourceExports are created in the CommonArea of the new leader.
+		resyncCh chan event.GenericEvent
 	}
 )
 
@@ -85,6 +89,7 @@ func NewServiceExportReconciler(
 		installedSvcs: cache.NewIndexer(svcInfoKeyFunc, cache.Indexers{
 			svcIndexerByType: svcIndexerByTypeFunc,
 		}),
+		resyncCh: make(chan event.GenericEvent),
 	}
 	return reconciler
 }
@@ -259,6 +264,28 @@ func (r *ServiceExportReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	return ctrl.Result{}, nil
 }
 
+// OnLeaderChange resets the cache of installed Services and requeues all
+// ServiceExports. It is called when the elected leader has changed, as the
+// ResourceExports of installed Services may not exist in the new leader.
+func (r *ServiceExportReconciler) OnLeaderChange() {
+	svcExportList := &k8smcsv1alpha1.ServiceExportList{}
+	if err := r.Client.List(ctx, svcExportList, &client.ListOptions{}); err != nil {
+		klog.ErrorS(err, "Failed to list ServiceExports after leader change")
+		return
+	}
+	klog.InfoS("Elected leader changed, re-exporting all ServiceExports", "count", len(svcExportList.Items))
+	for _, obj := range r.installedSvcs.List() {
+		r.installedSvcs.Delete(obj)
+	}
+	// Events are sent from a separate goroutine, so the leader check loop is not
+	// blocked until the controller has received all of them.
+	go func() {
+		for i := range svcExportList.Items {
+			r.resyncCh <- event.GenericEvent{Object: &svcExportList.Items[i]}
+		}
+	}()
+}
+
 func (r *ServiceExportReconciler) handleServiceDeleteEvent(ctx context.Context, req ctrl.Request,
 	commonArea commonarea.RemoteCommonArea) error {
 	svcResExportName := getResourceExportName(r.localClusterID, req, "service")
@@ -361,6 +388,7 @@ func (r *ServiceExportReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&k8smcsv1alpha1.ServiceExport{}).
 		Watches(&source.Kind{Type: &corev1.Service{}}, handler.EnqueueRequestsFromMapFunc(serviceMapFunc)).
+		Watches(&source.Channel{Source: r.resyncCh}, &handler.EnqueueRequestForObject{}).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: common.DefaultWorkerCount,
 		}).
@@ -19,11 +19,13 @@ package multicluster
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -334,3 +336,35 @@ func Test_serviceMapFunc(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceExportReconciler_OnLeaderChange(t *testing.T) {
+	svcExports := []client.Object{
+		&k8smcsv1alpha1.ServiceExport{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "nginx"}},
+		&k8smcsv1alpha1.ServiceExport{ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "coredns"}},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(svcExports...).Build()
+	mcReconciler := NewMemberClusterSetReconciler(fakeClient, scheme, "default")
+	r := NewServiceExportReconciler(fakeClient, scheme, mcReconciler)
+	r.installedSvcs.Add(&svcInfo{
+		name:      svcNginx.Name,
+		namespace: svcNginx.Namespace,
+	})
+
+	// OnLeaderChange must not block when the controller is not receiving events.
+	r.OnLeaderChange()
+	if len(r.installedSvcs.List()) != 0 {
+		t.Errorf("Expected installed Services to be reset after leader change")
+	}
+	requeued := sets.NewString()
+	for i := 0; i < len(svcExports); i++ {
+		select {
+		case e := <-r.resyncCh:
+			requeued.Insert(e.Object.GetNamespace() + "/" + e.Object.GetName())
+		case <-time.After(time.Second):
+			t.Fatalf("Timeout when waiting for ServiceExports to be requeued")
+		}
+	}
+	if expected := sets.NewString("default/nginx", "kube-system/coredns"); !requeued.Equal(expected) {
+		t.Errorf("Expected requeued ServiceExports %v, got %v", expected.List(), requeued.List())
+	}
+}
@@ -276,8 +276,16 @@ func (c *StaleResCleanupController) Enqueue() {
 	c.queue.Add("key")
 }
 
+// OnLeaderChange triggers a cleanup of stale resources. It is called when
+// the elected leader has changed, as the new leader may keep ResourceExports
+// which were exported before it became unavailable.
+func (c *StaleResCleanupController) OnLeaderChange() {
+	c.Enqueue()
+}
+
 // Run starts the StaleResCleanupController and blocks until stopCh is closed.
-// it will run only once to clean up stale resources if no error happens.
+// It will clean up stale resources once at startup, and again every time the
+// elected leader changes.
 func (c *StaleResCleanupController) Run(stopCh <-chan struct{}) {
 	defer c.queue.ShutDown()
 
@@ -286,8 +294,8 @@ func (c *StaleResCleanupController) Run(stopCh <-chan struct{}) {
 
 	if err := c.RunOnce(); err != nil {
 		c.Enqueue()
-		go wait.Until(c.runWorker, time.Second, stopCh)
 	}
+	go wait.Until(c.runWorker, time.Second, stopCh)
 
 	<-stopCh
 }
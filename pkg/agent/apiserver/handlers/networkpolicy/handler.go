@@ -59,10 +59,12 @@ func HandleFunc(aq agentquerier.AgentQuerier) http.HandlerFunc {
 
 // From user shorthand input to cpv1beta1.NetworkPolicyType
 var mapToNetworkPolicyType = map[string]cpv1beta.NetworkPolicyType{
-	"NP":    cpv1beta.K8sNetworkPolicy,
-	"K8SNP": cpv1beta.K8sNetworkPolicy,
-	"ACNP":  cpv1beta.AntreaClusterNetworkPolicy,
-	"ANP":   cpv1beta.AntreaNetworkPolicy,
+	"NP":      cpv1beta.K8sNetworkPolicy,
+	"K8SNP":   cpv1beta.K8sNetworkPolicy,
+	"ACNP":    cpv1beta.AntreaClusterNetworkPolicy,
+	"ANP":     cpv1beta.AntreaNetworkPolicy,
+	"ADMINNP": cpv1beta.AdminNetworkPolicy,
+	"BANP":    cpv1beta.BaselineAdminNetworkPolicy,
 }
 
 // Create a Network Policy Filter from URL Query
@@ -0,0 +1,421 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ovsflows
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"antrea.io/antrea/pkg/agent/interfacestore"
+	"antrea.io/antrea/pkg/agent/openflow/cookie"
+	agentquerier "antrea.io/antrea/pkg/agent/querier"
+	"antrea.io/antrea/pkg/agent/types"
+	binding "antrea.io/antrea/pkg/ovs/openflow"
+)
+
+const (
+	driftMissing = "missing"
+	driftStale   = "stale"
+)
+
+var (
+	// flowStatsFields are the fields of a dumped flow which change over time
+	// or are not part of the flow itself.
+	flowStatsFields = sets.NewString("cookie", "duration", "n_packets", "n_bytes", "idle_age", "hard_age")
+	cookieRegex     = regexp.MustCompile(`cookie=(0x[0-9a-f]+)`)
+	conjIDRegex     = regexp.MustCompile(`(?:conj_id=|conjunction\()(\d+)`)
+	// flowMetadataFields are the fields of a dumped flow which are not match
+	// conditions.
+	flowMetadataFields = sets.NewString("table", "priority", "hard_timeout", "idle_timeout", "send_flow_rem", "reset_counts", "importance")
+	fieldRangeRegex    = regexp.MustCompile(`^(\w+)\[(\d+)\.\.(\d+)\]$`)
+	ctStateFlagRegex   = regexp.MustCompile(`[+-][a-z]+`)
+)
+
+// flowExplainer decodes the cookies, the tables and the owners of the flows
+// installed in OVS.
+type flowExplainer struct {
+	aq       agentquerier.AgentQuerier
+	roundNum uint64
+	// cookies maps the identities of the installed flows to their cookies.
+	cookies map[string]cookie.ID
+	// owners maps the identities of the installed flows to the objects they
+	// are installed for, which are learned from the flows cached in the
+	// OpenFlow client. Flows of NetworkPolicy rules are not included, as
+	// their owners are decoded from the conjunction IDs.
+	owners map[string]string
+	// missingFlows are the flows cached in the OpenFlow client, but not
+	// installed in OVS.
+	missingFlows []types.CachedFlow
+}
+
+// newFlowExplainer dumps the installed flows with their cookies, and matches
+// the flows cached in the OpenFlow client against them. The flows of
+// NetworkPolicy rules, which are usually the majority of the cached flows, are
+// matched only if includePolicyFlows is true. All the flows are dumped once and
+// matched in-process, as running ovs-ofctl for each cached flow does not scale.
+func newFlowExplainer(aq agentquerier.AgentQuerier, includePolicyFlows bool) (*flowExplainer, error) {
+	e := &flowExplainer{
+		aq:       aq,
+		roundNum: aq.GetOpenflowClient().GetRoundNum(),
+		cookies:  map[string]cookie.ID{},
+		owners:   map[string]string{},
+	}
+	flowDump, err := aq.GetOVSCtlClient().RunOfctlCmd("dump-flows", "--names")
+	if err != nil {
+		return nil, err
+	}
+	// installedFlows maps the match keys of the installed flows to their
+	// identities. If multiple flows, with different priorities, have the
+	// same match key, the first one is kept.
+	installedFlows := map[string]string{}
+	scanner := bufio.NewScanner(strings.NewReader(string(flowDump)))
+	for scanner.Scan() {
+		line := scanner.Text()
+		match := cookieRegex.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		identity := FlowIdentity(line)
+		if id, err := strconv.ParseUint(match[1], 0, 64); err == nil {
+			e.cookies[identity] = cookie.ID(id)
+		}
+		key := e.matchKey(line, true)
+		if _, ok := installedFlows[key]; !ok {
+			installedFlows[key] = identity
+		}
+	}
+
+	for _, f := range aq.GetOpenflowClient().GetCachedFlows() {
+		if f.OwnerType == types.FlowOwnerNetworkPolicyRule && !includePolicyFlows {
+			continue
+		}
+		identity, ok := installedFlows[e.matchKey(f.MatchString, false)]
+		if !ok {
+			e.missingFlows = append(e.missingFlows, f)
+			continue
+		}
+		if f.OwnerType != types.FlowOwnerNetworkPolicyRule {
+			e.owners[identity] = e.describeOwner(f.OwnerType, f.OwnerName)
+		}
+	}
+	return e, nil
+}
+
+// matchKey returns a normalized representation of the table and the match
+// conditions of a flow, which can be either a line of the dump-flows output
+// (dumped is true) or the match string of a cached flow. The priority is not
+// included, as it is not part of the match string of a cached flow. The match
+// conditions of a cached flow are written in a different format from the one
+// used by ovs-ofctl, e.g. "reg0[0..3]=0x1" is dumped as "reg0=0x1/0xf" and
+// "tp_dst=0x50" as "tp_dst=80", hence the normalization.
+func (e *flowExplainer) matchKey(flowStr string, dumped bool) string {
+	_, tableID := parseTable(flowStr)
+	matchStr := flowStr
+	if dumped {
+		// The match conditions are the last field before the actions.
+		if i := strings.Index(matchStr, " actions="); i != -1 {
+			matchStr = matchStr[:i]
+		}
+		fields := strings.Split(strings.TrimSpace(matchStr), ", ")
+		matchStr = fields[len(fields)-1]
+	}
+	type maskedValue struct{ value, mask uint64 }
+	values := map[string]string{}
+	maskedValues := map[string]*maskedValue{}
+	for _, cond := range strings.Split(matchStr, ",") {
+		key, value := cond, ""
+		if i := strings.Index(cond, "="); i != -1 {
+			key, value = cond[:i], cond[i+1:]
+		}
+		if key == "" || flowStatsFields.Has(key) || flowMetadataFields.Has(key) {
+			continue
+		}
+		// Convert "reg0[0..3]=0x1" to "reg0=0x1/0xf". The value of a ct_label
+		// range is already shifted to the range.
+		if m := fieldRangeRegex.FindStringSubmatch(key); m != nil {
+			start, err1 := strconv.ParseUint(m[2], 10, 6)
+			end, err2 := strconv.ParseUint(m[3], 10, 6)
+			v, err3 := strconv.ParseUint(value, 0, 64)
+			if err1 == nil && err2 == nil && err3 == nil && start <= end {
+				key = m[1]
+				mask := (uint64(1)<<(end-start+1) - 1) << start
+				if key != "ct_label" {
+					v <<= start
+				}
+				if mv, ok := maskedValues[key]; ok {
+					mv.value |= v
+					mv.mask |= mask
+				} else {
+					maskedValues[key] = &maskedValue{v, mask}
+				}
+				continue
+			}
+		}
+		values[key] = e.normalizeMatchValue(key, value)
+	}
+	for key, mv := range maskedValues {
+		if mv.mask == 0xffffffff && strings.HasPrefix(key, "reg") {
+			values[key] = fmt.Sprintf("0x%x", mv.value)
+		} else {
+			values[key] = fmt.Sprintf("0x%x/0x%x", mv.value, mv.mask)
+		}
+	}
+	conds := make([]string, 0, len(values))
+	for key, value := range values {
+		if value == "" {
+			conds = append(conds, key)
+		} else {
+			conds = append(conds, key+"="+value)
+		}
+	}
+	sort.Strings(conds)
+	return fmt.Sprintf("table=%d,%s", tableID, strings.Join(conds, ","))
+}
+
+// normalizeMatchValue returns the value of a match condition in a format which
+// does not depend on how the value is written.
+func (e *flowExplainer) normalizeMatchValue(key, value string) string {
+	if value == "" {
+		return value
+	}
+	switch key {
+	case "ct_state":
+		// The flags can be in any order, e.g. "+trk+new" or "+new+trk".
+		flags := ctStateFlagRegex.FindAllString(value, -1)
+		sort.Strings(flags)
+		return strings.Join(flags, "")
+	case "in_port":
+		// ovs-ofctl dumps the port names with "--names".
+		name := strings.Trim(value, `"`)
+		if _, err := strconv.ParseUint(name, 0, 32); err != nil {
+			if iface, ok := e.aq.GetInterfaceStore().GetInterfaceByName(name); ok && iface.OVSPortConfig != nil {
+				return fmt.Sprintf("0x%x", iface.OFPort)
+			}
+			return name
+		}
+	}
+	parts := strings.SplitN(value, "/", 2)
+	if v, err := strconv.ParseUint(parts[0], 0, 64); err == nil {
+		if len(parts) == 1 {
+			return fmt.Sprintf("0x%x", v)
+		}
+		if m, err := strconv.ParseUint(parts[1], 0, 64); err == nil {
+			return fmt.Sprintf("0x%x/0x%x", v, m)
+		}
+	}
+	if ip := net.ParseIP(value); ip != nil {
+		return ip.String()
+	}
+	if _, ipNet, err := net.ParseCIDR(value); err == nil {
+		if ones, bits := ipNet.Mask.Size(); ones == bits {
+			return ipNet.IP.String()
+		}
+		return ipNet.String()
+	}
+	return strings.ToLower(value)
+}
+
+// FlowIdentity returns the flow string without the cookie and the statistics,
+// so that the same flow can be identified in different dumps.
+func FlowIdentity(flowStr string) string {
+	var fields []string
+	for _, field := range strings.Split(strings.TrimSpace(flowStr), ", ") {
+		if flowStatsFields.Has(strings.SplitN(field, "=", 2)[0]) {
+			continue
+		}
+		fields = append(fields, field)
+	}
+	return strings.Join(fields, ", ")
+}
+
+// parseTable returns the name and the ID of the table of a flow string, which
+// can be either a dumped flow or the match string of a cached flow.
+func parseTable(flowStr string) (string, uint8) {
+	i := strings.Index(flowStr, "table=")
+	if i == -1 {
+		return "", binding.TableIDAll
+	}
+	table := flowStr[i+len("table="):]
+	if j := strings.IndexAny(table, ", "); j != -1 {
+		table = table[:j]
+	}
+	if n, err := strconv.ParseUint(table, 10, 8); err == nil {
+		if name := getFlowTableName(uint8(n)); name != "" {
+			return name, uint8(n)
+		}
+		return table, uint8(n)
+	}
+	return table, getFlowTableID(table)
+}
+
+func (e *flowExplainer) explain(resp *Response) {
+	tableName, tableID := parseTable(resp.Flow)
+	resp.Table = tableName
+	if tableID != binding.TableIDAll {
+		resp.Stage = getFlowTableStage(tableID)
+	}
+	identity := FlowIdentity(resp.Flow)
+	if id, ok := e.cookies[identity]; ok {
+		resp.Round = id.Round()
+		resp.Category = id.Category().String()
+		if resp.Round != e.roundNum {
+			resp.Drift = driftStale
+		}
+	}
+	if owner, ok := e.owners[identity]; ok {
+		resp.Owner = owner
+	} else if conjIDs := parseConjunctionIDs(resp.Flow); len(conjIDs) > 0 {
+		resp.Owner = e.describePolicyRules(conjIDs)
+	}
+}
+
+func parseConjunctionIDs(flowStr string) []uint32 {
+	idSet := map[uint32]bool{}
+	for _, match := range conjIDRegex.FindAllStringSubmatch(flowStr, -1) {
+		if id, err := strconv.ParseUint(match[1], 10, 32); err == nil {
+			idSet[uint32(id)] = true
+		}
+	}
+	ids := make([]uint32, 0, len(idSet))
+	for id := range idSet {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func (e *flowExplainer) describePolicyRules(conjIDs []uint32) string {
+	descs := make([]string, 0, len(conjIDs))
+	for _, id := range conjIDs {
+		rule := e.aq.GetNetworkPolicyInfoQuerier().GetRuleByFlowID(id)
+		if rule == nil || rule.PolicyRef == nil {
+			descs = append(descs, fmt.Sprintf("NetworkPolicy rule %d", id))
+			continue
+		}
+		desc := fmt.Sprintf("%s %s rule", rule.PolicyRef.ToString(), rule.Direction)
+		if rule.Name != "" {
+			desc += " " + rule.Name
+		}
+		descs = append(descs, desc)
+	}
+	return strings.Join(descs, "; ")
+}
+
+func describePod(iface *interfacestore.InterfaceConfig, found bool) string {
+	if !found || iface.ContainerInterfaceConfig == nil {
+		return ""
+	}
+	return fmt.Sprintf("Pod %s/%s", iface.PodNamespace, iface.PodName)
+}
+
+// describeOwner returns a human-readable description of the object a cached
+// flow is installed for.
+func (e *flowExplainer) describeOwner(ownerType types.FlowOwnerType, ownerName string) string {
+	switch ownerType {
+	case types.FlowOwnerNetworkPolicyRule:
+		var conjIDs []uint32
+		for _, s := range strings.Split(ownerName, ",") {
+			if id, err := strconv.ParseUint(s, 10, 32); err == nil {
+				conjIDs = append(conjIDs, uint32(id))
+			}
+		}
+		return e.describePolicyRules(conjIDs)
+	case types.FlowOwnerPodInterface:
+		if pod := describePod(e.aq.GetInterfaceStore().GetInterfaceByName(ownerName)); pod != "" {
+			return pod
+		}
+		return fmt.Sprintf("Interface %s", ownerName)
+	case types.FlowOwnerEgressPodPort:
+		if ofPort, err := strconv.ParseUint(ownerName, 10, 32); err == nil {
+			if pod := describePod(e.aq.GetInterfaceStore().GetInterfaceByOFPort(uint32(ofPort))); pod != "" {
+				return fmt.Sprintf("Egress of %s", pod)
+			}
+		}
+		return fmt.Sprintf("Egress of OF port %s", ownerName)
+	case types.FlowOwnerEgressSNATMark:
+		return fmt.Sprintf("Egress SNAT mark %s", ownerName)
+	case types.FlowOwnerServicePort:
+		if proxier := e.aq.GetProxier(); proxier != nil {
+			if svcPortName, ok := proxier.GetServiceByIP(ownerName); ok {
+				return fmt.Sprintf("Service %s (%s)", svcPortName.String(), ownerName)
+			}
+		}
+		return fmt.Sprintf("Service %s", ownerName)
+	case types.FlowOwnerEndpoint:
+		ip := ownerName
+		if i := strings.LastIndex(ip, ":"); i != -1 {
+			ip = ip[:i]
+		}
+		if pod := describePod(e.aq.GetInterfaceStore().GetInterfaceByIP(ip)); pod != "" {
+			return fmt.Sprintf("Endpoint %s of %s", ownerName, pod)
+		}
+		return fmt.Sprintf("Endpoint %s", ownerName)
+	default:
+		return strings.TrimSpace(fmt.Sprintf("%s %s", ownerType, ownerName))
+	}
+}
+
+func explainFlows(aq agentquerier.AgentQuerier, resps []Response) ([]Response, error) {
+	e, err := newFlowExplainer(aq, false)
+	if err != nil {
+		return nil, err
+	}
+	for i := range resps {
+		e.explain(&resps[i])
+	}
+	return resps, nil
+}
+
+// getDriftedFlows returns the flows which are cached in the OpenFlow client
+// but not installed in OVS, and the flows installed in OVS in a previous round.
+func getDriftedFlows(aq agentquerier.AgentQuerier) ([]Response, error) {
+	e, err := newFlowExplainer(aq, true)
+	if err != nil {
+		return nil, err
+	}
+	resps := []Response{}
+	for _, f := range e.missingFlows {
+		tableName, tableID := parseTable(f.MatchString)
+		resp := Response{
+			Flow:  f.MatchString,
+			Table: tableName,
+			Owner: e.describeOwner(f.OwnerType, f.OwnerName),
+			Drift: driftMissing,
+		}
+		if tableID != binding.TableIDAll {
+			resp.Stage = getFlowTableStage(tableID)
+		}
+		resps = append(resps, resp)
+	}
+
+	installed, err := dumpFlows(aq, binding.TableIDAll)
+	if err != nil {
+		return nil, err
+	}
+	for i := range installed {
+		e.explain(&installed[i])
+		if installed[i].Drift == driftStale {
+			resps = append(resps, installed[i])
+		}
+	}
+	return resps, nil
+}
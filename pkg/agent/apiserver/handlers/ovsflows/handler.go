@@ -16,6 +16,7 @@ package ovsflows
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
@@ -32,13 +33,24 @@ import (
 
 var (
 	// Use function variables for tests.
-	getFlowTableName = openflow.GetFlowTableName
-	getFlowTableID   = openflow.GetFlowTableID
+	getFlowTableName  = openflow.GetFlowTableName
+	getFlowTableID    = openflow.GetFlowTableID
+	getFlowTableStage = openflow.GetFlowTableStage
 )
 
 // Response is the response struct of ovsflows command.
 type Response struct {
 	Flow string `json:"flow,omitempty"`
+	// The following fields are only set in explain mode.
+	Table    string `json:"table,omitempty"`
+	Stage    string `json:"stage,omitempty"`
+	Round    uint64 `json:"round,omitempty"`
+	Category string `json:"category,omitempty"`
+	Owner    string `json:"owner,omitempty"`
+	// Drift is set to "missing" for a flow cached in the OpenFlow client but
+	// not installed in OVS, and "stale" for a flow installed in a previous
+	// round.
+	Drift string `json:"drift,omitempty"`
 }
 
 func dumpMatchedFlows(aq agentquerier.AgentQuerier, flowKeys []string) ([]Response, error) {
@@ -50,7 +62,7 @@ func dumpMatchedFlows(aq agentquerier.AgentQuerier, flowKeys []string) ([]Respon
 			return nil, err
 		}
 		if flowStr != "" {
-			resps = append(resps, Response{flowStr})
+			resps = append(resps, Response{Flow: flowStr})
 		}
 	}
 	return resps, nil
@@ -69,7 +81,7 @@ func dumpFlows(aq agentquerier.AgentQuerier, table uint8) ([]Response, error) {
 		return nil, err
 	}
 	for _, s := range flowStrs {
-		resps = append(resps, Response{s})
+		resps = append(resps, Response{Flow: s})
 	}
 	return resps, nil
 }
@@ -83,7 +95,7 @@ func dumpMatchedGroups(aq agentquerier.AgentQuerier, groupIDs []binding.GroupIDT
 			return nil, err
 		}
 		if groupStr != "" {
-			resps = append(resps, Response{groupStr})
+			resps = append(resps, Response{Flow: groupStr})
 		}
 	}
 	return resps, nil
@@ -127,7 +139,7 @@ func getGroups(aq agentquerier.AgentQuerier, groups string) ([]Response, error)
 		}
 		resps := make([]Response, 0, len(groupStrs))
 		for _, s := range groupStrs {
-			resps = append(resps, Response{s})
+			resps = append(resps, Response{Flow: s})
 		}
 		return resps, nil
 	}
@@ -184,10 +196,21 @@ func getNetworkPolicyFlows(aq agentquerier.AgentQuerier, npName, namespace strin
 	return dumpMatchedFlows(aq, flowKeys)
 }
 
+func parseBoolParam(r *http.Request, name string) (bool, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid value %q for %s", value, name)
+	}
+	return b, nil
+}
+
 // HandleFunc returns the function which can handle API requests to "/ovsflows".
 func HandleFunc(aq agentquerier.AgentQuerier) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		var err error
 		var resps []Response
 		pod := r.URL.Query().Get("pod")
 		service := r.URL.Query().Get("service")
@@ -195,13 +218,33 @@ func HandleFunc(aq agentquerier.AgentQuerier) http.HandlerFunc {
 		namespace := r.URL.Query().Get("namespace")
 		table := r.URL.Query().Get("table")
 		groups := r.URL.Query().Get("groups")
+		explain, err := parseBoolParam(r, "explain")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		drift, err := parseBoolParam(r, "drift")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
 
 		if (pod != "" || service != "" || networkPolicy != "") && namespace == "" {
 			http.Error(w, "namespace must be provided", http.StatusBadRequest)
 			return
 		}
+		if explain && groups != "" {
+			http.Error(w, "explain is not supported for groups", http.StatusBadRequest)
+			return
+		}
 
-		if pod == "" && service == "" && networkPolicy == "" && namespace == "" && table == "" && groups == "" {
+		if drift {
+			if pod != "" || service != "" || networkPolicy != "" || namespace != "" || table != "" || groups != "" {
+				http.Error(w, "drift cannot be combined with other parameters", http.StatusBadRequest)
+				return
+			}
+			resps, err = getDriftedFlows(aq)
+		} else if pod == "" && service == "" && networkPolicy == "" && namespace == "" && table == "" && groups == "" {
 			resps, err = dumpFlows(aq, binding.TableIDAll)
 		} else if pod != "" {
 			// Pod Namespace must be provided to dump flows of a Pod.
@@ -231,6 +274,9 @@ func HandleFunc(aq agentquerier.AgentQuerier) http.HandlerFunc {
 			return
 		}
 
+		if err == nil && explain && !drift && resps != nil {
+			resps, err = explainFlows(aq, resps)
+		}
 		if err != nil {
 			klog.Errorf("Failed to dump flows: %v", err)
 			http.Error(w, "OVS flow dumping failed", http.StatusInternalServerError)
@@ -250,11 +296,27 @@ func HandleFunc(aq agentquerier.AgentQuerier) http.HandlerFunc {
 
 var _ common.TableOutput = new(Response)
 
+// explained returns whether the Response is generated in explain mode, in
+// which the table is always set.
+func (r Response) explained() bool {
+	return r.Table != ""
+}
+
 func (r Response) GetTableHeader() []string {
+	if r.explained() {
+		return []string{"TABLE", "STAGE", "ROUND", "CATEGORY", "OWNER", "DRIFT", "FLOW"}
+	}
 	return []string{"FLOW"}
 }
 
 func (r Response) GetTableRow(maxColumnLength int) []string {
+	if r.explained() {
+		round := ""
+		if r.Round != 0 {
+			round = strconv.FormatUint(r.Round, 10)
+		}
+		return []string{r.Table, r.Stage, round, r.Category, r.Owner, r.Drift, r.Flow}
+	}
 	return []string{r.Flow}
 }
 
@@ -29,6 +29,7 @@ import (
 	proxytest "antrea.io/antrea/pkg/agent/proxy/testing"
 	agentquerier "antrea.io/antrea/pkg/agent/querier"
 	aqtest "antrea.io/antrea/pkg/agent/querier/testing"
+	"antrea.io/antrea/pkg/agent/types"
 	cpv1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
 	binding "antrea.io/antrea/pkg/ovs/openflow"
 	ovsctltest "antrea.io/antrea/pkg/ovs/ovsctl/testing"
@@ -41,8 +42,8 @@ var (
 	testDumpFlows      = []string{"flow1", "flow2"}
 	testGroupIDs       = []binding.GroupIDType{1, 2}
 	testDumpGroups     = []string{"group1", "group2"}
-	testResponses      = []Response{{"flow1"}, {"flow2"}}
-	testGroupResponses = []Response{{"group1"}, {"group2"}}
+	testResponses      = []Response{{Flow: "flow1"}, {Flow: "flow2"}}
+	testGroupResponses = []Response{{Flow: "group1"}, {Flow: "group2"}}
 )
 
 type testCase struct {
@@ -70,6 +71,9 @@ func TestBadRequests(t *testing.T) {
 		"Invalid group IDs":         "?groups=all,0",
 		"Too big group ID":          "?groups=123,4294967296",
 		"Negative group ID":         "?groups=-1",
+		"Invalid explain value":     "?explain=yes",
+		"Explain groups":            "?groups=all&&explain=true",
+		"Drift and table":           "?drift=true&&table=0",
 	}
 
 	handler := HandleFunc(nil)
@@ -285,7 +289,7 @@ func TestGroups(t *testing.T) {
 				test:           "Group 1234",
 				query:          "?groups=1234",
 				expectedStatus: http.StatusOK,
-				resps:          []Response{{"group1234"}},
+				resps:          []Response{{Flow: "group1234"}},
 			},
 			groupIDs:     []uint32{1234},
 			dumpedGroups: []string{"group1234"},
@@ -305,7 +309,7 @@ func TestGroups(t *testing.T) {
 				test:           "Group 10, 100, and 1000",
 				query:          "?groups=10,100,1000",
 				expectedStatus: http.StatusOK,
-				resps:          []Response{{"group10"}, {"group1000"}},
+				resps:          []Response{{Flow: "group10"}, {Flow: "group1000"}},
 			},
 			groupIDs:     []uint32{10, 100, 1000},
 			dumpedGroups: []string{"group10", "", "group1000"},
@@ -330,6 +334,195 @@ func TestGroups(t *testing.T) {
 	}
 }
 
+const (
+	testExplainedFlow1 = "table=IngressRule, n_packets=5, n_bytes=100, idle_age=2, priority=200,in_port=2 actions=goto_table:1"
+	testExplainedFlow2 = "table=IngressRule, n_packets=0, n_bytes=0, priority=190,conj_id=5 actions=goto_table:2"
+	// The cookie of the first flow is in the current round 3 with category
+	// PodConnectivity, and the cookie of the second flow is in round 2 with
+	// category NetworkPolicy.
+	testRawFlows = ` cookie=0x3010000000000, duration=10.0s, table=IngressRule, n_packets=3, n_bytes=60, idle_age=5, priority=200,in_port=2 actions=goto_table:1
+ cookie=0x2020000000000, duration=10.0s, table=IngressRule, n_packets=0, n_bytes=0, priority=190,conj_id=5 actions=goto_table:2
+`
+)
+
+var (
+	testPodFlow = types.CachedFlow{MatchString: "table=80,in_port=2", OwnerType: types.FlowOwnerPodInterface, OwnerName: "pod1-abc"}
+	testNPFlow  = types.CachedFlow{MatchString: "table=80,ip,nw_src=10.0.0.1", OwnerType: types.FlowOwnerNetworkPolicyRule, OwnerName: "5"}
+	testRule    = &types.PolicyRule{
+		Direction: cpv1beta.DirectionIn,
+		Name:      "rule1",
+		PolicyRef: &cpv1beta.NetworkPolicyReference{Type: cpv1beta.AntreaNetworkPolicy, Namespace: "ns1", Name: "anp1"},
+	}
+	testPodInterface = &interfacestore.InterfaceConfig{
+		InterfaceName:            "pod1-abc",
+		ContainerInterfaceConfig: &interfacestore.ContainerInterfaceConfig{PodName: "pod1", PodNamespace: "ns1"},
+	}
+)
+
+func mockGetFlowTableStage(id uint8) string {
+	if id == 80 {
+		return "IngressSecurity"
+	}
+	return ""
+}
+
+func newExplainQuerier(ctrl *gomock.Controller, ovsctl *ovsctltest.MockOVSCtlClient) *aqtest.MockAgentQuerier {
+	ofc := oftest.NewMockClient(ctrl)
+	i := interfacestoretest.NewMockInterfaceStore(ctrl)
+	npq := queriertest.NewMockAgentNetworkPolicyInfoQuerier(ctrl)
+	q := aqtest.NewMockAgentQuerier(ctrl)
+	q.EXPECT().GetOpenflowClient().Return(ofc).AnyTimes()
+	q.EXPECT().GetOVSCtlClient().Return(ovsctl).AnyTimes()
+	q.EXPECT().GetInterfaceStore().Return(i).AnyTimes()
+	q.EXPECT().GetNetworkPolicyInfoQuerier().Return(npq).AnyTimes()
+	ofc.EXPECT().GetRoundNum().Return(uint64(3)).Times(1)
+	ofc.EXPECT().GetCachedFlows().Return([]types.CachedFlow{testPodFlow, testNPFlow}).Times(1)
+	ovsctl.EXPECT().RunOfctlCmd("dump-flows", "--names").Return([]byte(testRawFlows), nil).Times(1)
+	i.EXPECT().GetInterfaceByName(testPodInterface.InterfaceName).Return(testPodInterface, true).Times(1)
+	npq.EXPECT().GetRuleByFlowID(uint32(5)).Return(testRule).Times(1)
+	return q
+}
+
+func TestExplainFlows(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	getFlowTableName = mockGetFlowTableName
+	getFlowTableID = mockGetFlowTableID
+	getFlowTableStage = mockGetFlowTableStage
+	ovsctl := ovsctltest.NewMockOVSCtlClient(ctrl)
+	q := newExplainQuerier(ctrl, ovsctl)
+	ovsctl.EXPECT().DumpTableFlows(uint8(80)).Return([]string{testExplainedFlow1, testExplainedFlow2}, nil).Times(1)
+
+	tc := testCase{
+		test:           "Explain table IngressRule",
+		query:          "?table=IngressRule&&explain=true",
+		expectedStatus: http.StatusOK,
+		resps: []Response{
+			{
+				Flow:     testExplainedFlow1,
+				Table:    "IngressRule",
+				Stage:    "IngressSecurity",
+				Round:    3,
+				Category: "PodConnectivity",
+				Owner:    "Pod ns1/pod1",
+			},
+			{
+				Flow:     testExplainedFlow2,
+				Table:    "IngressRule",
+				Stage:    "IngressSecurity",
+				Round:    2,
+				Category: "NetworkPolicy",
+				Owner:    "AntreaNetworkPolicy:ns1/anp1 In rule rule1",
+				Drift:    "stale",
+			},
+		},
+	}
+	runHTTPTest(t, &tc, q)
+}
+
+func TestDriftedFlows(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	getFlowTableName = mockGetFlowTableName
+	getFlowTableID = mockGetFlowTableID
+	getFlowTableStage = mockGetFlowTableStage
+	ovsctl := ovsctltest.NewMockOVSCtlClient(ctrl)
+	q := newExplainQuerier(ctrl, ovsctl)
+	// The flow of the NetworkPolicy rule, testNPFlow, is missing in OVS.
+	ovsctl.EXPECT().DumpFlows().Return([]string{testExplainedFlow1, testExplainedFlow2}, nil).Times(1)
+
+	tc := testCase{
+		test:           "Drifted flows",
+		query:          "?drift=true",
+		expectedStatus: http.StatusOK,
+		resps: []Response{
+			{
+				Flow:  testNPFlow.MatchString,
+				Table: "IngressRule",
+				Stage: "IngressSecurity",
+				Owner: "AntreaNetworkPolicy:ns1/anp1 In rule rule1",
+				Drift: "missing",
+			},
+			{
+				Flow:     testExplainedFlow2,
+				Table:    "IngressRule",
+				Stage:    "IngressSecurity",
+				Round:    2,
+				Category: "NetworkPolicy",
+				Owner:    "AntreaNetworkPolicy:ns1/anp1 In rule rule1",
+				Drift:    "stale",
+			},
+		},
+	}
+	runHTTPTest(t, &tc, q)
+}
+
+func TestFlowMatchKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	getFlowTableID = mockGetFlowTableID
+	i := interfacestoretest.NewMockInterfaceStore(ctrl)
+	q := aqtest.NewMockAgentQuerier(ctrl)
+	q.EXPECT().GetInterfaceStore().Return(i).AnyTimes()
+	i.EXPECT().GetInterfaceByName("pod1-abc").Return(&interfacestore.InterfaceConfig{
+		InterfaceName: "pod1-abc",
+		OVSPortConfig: &interfacestore.OVSPortConfig{OFPort: 2},
+	}, true).AnyTimes()
+	e := &flowExplainer{aq: q}
+
+	tests := []struct {
+		name        string
+		cachedFlow  string
+		dumpedFlow  string
+		expectMatch bool
+	}{
+		{
+			name:        "port name",
+			cachedFlow:  "table=80,in_port=2",
+			dumpedFlow:  ` cookie=0x3010000000000, duration=10.0s, table=IngressRule, n_packets=3, n_bytes=60, idle_age=5, priority=200,in_port="pod1-abc" actions=goto_table:1`,
+			expectMatch: true,
+		},
+		{
+			name:        "register ranges and transport port",
+			cachedFlow:  "table=80,tcp,reg0[0..3]=0x1,reg0[4..7]=0x2,tp_dst=0x50,ct_state=+trk+new",
+			dumpedFlow:  " cookie=0x3010000000000, table=IngressRule, n_packets=0, n_bytes=0, priority=200,ct_state=+new+trk,tcp,reg0=0x21/0xff,tp_dst=80 actions=drop",
+			expectMatch: true,
+		},
+		{
+			name:        "IP addresses",
+			cachedFlow:  "table=80,ip,nw_src=10.0.0.1/32,nw_dst=10.10.0.0/16",
+			dumpedFlow:  " cookie=0x3010000000000, table=IngressRule, n_packets=0, n_bytes=0, priority=200,ip,nw_src=10.0.0.1,nw_dst=10.10.0.0/16 actions=drop",
+			expectMatch: true,
+		},
+		{
+			name:        "different value",
+			cachedFlow:  "table=80,ip,nw_src=10.0.0.2",
+			dumpedFlow:  " cookie=0x3010000000000, table=IngressRule, n_packets=0, n_bytes=0, priority=200,ip,nw_src=10.0.0.1 actions=drop",
+			expectMatch: false,
+		},
+		{
+			name:        "extra match condition",
+			cachedFlow:  "table=80,ip",
+			dumpedFlow:  " cookie=0x3010000000000, table=IngressRule, n_packets=0, n_bytes=0, priority=200,ip,nw_src=10.0.0.1 actions=drop",
+			expectMatch: false,
+		},
+		{
+			name:        "different table",
+			cachedFlow:  "table=81,ip,nw_src=10.0.0.1",
+			dumpedFlow:  " cookie=0x3010000000000, table=IngressRule, n_packets=0, n_bytes=0, priority=200,ip,nw_src=10.0.0.1 actions=drop",
+			expectMatch: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectMatch, e.matchKey(tt.cachedFlow, false) == e.matchKey(tt.dumpedFlow, true))
+		})
+	}
+}
+
 func runHTTPTest(t *testing.T, tc *testCase, aq agentquerier.AgentQuerier) {
 	handler := HandleFunc(aq)
 	req, err := http.NewRequest(http.MethodGet, tc.query, nil)
@@ -0,0 +1,59 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packetcapture
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/klog/v2"
+)
+
+// HandleFunc returns the function which serves the pcapng file of the PacketCapture with the
+// given name. getFilePath returns the path of the file in which the Agent stores the packets
+// captured for a PacketCapture.
+func HandleFunc(getFilePath func(name string) string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := r.URL.Query().Get("name")
+		// The name must be a valid PacketCapture name, which also prevents it from
+		// referring to a file outside of the PacketCapture directory.
+		if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+			http.Error(w, "invalid PacketCapture name: "+strings.Join(errs, ", "), http.StatusBadRequest)
+			return
+		}
+		filePath := getFilePath(name)
+		f, err := os.Open(filePath)
+		if err != nil {
+			if os.IsNotExist(err) {
+				http.Error(w, "no captured packets found for PacketCapture "+name, http.StatusNotFound)
+				return
+			}
+			klog.ErrorS(err, "Failed to open PacketCapture file", "PacketCapture", name)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		defer f.Close()
+		stat, err := f.Stat()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/octet-stream")
+		http.ServeContent(w, r, filepath.Base(filePath), stat.ModTime(), f)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packetcapture
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPacketCaptureQuery(t *testing.T) {
+	dir := t.TempDir()
+	content := []byte("captured packets")
+	getFilePath := func(name string) string {
+		return filepath.Join(dir, name+".pcapng")
+	}
+	require.NoError(t, os.WriteFile(getFilePath("pc1"), content, 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "secret"), content, 0600))
+
+	testcases := map[string]struct {
+		name             string
+		expectedStatus   int
+		expectedResponse []byte
+	}{
+		"Existing PacketCapture": {
+			name:             "pc1",
+			expectedStatus:   http.StatusOK,
+			expectedResponse: content,
+		},
+		"Non-existing PacketCapture": {
+			name:           "pc2",
+			expectedStatus: http.StatusNotFound,
+		},
+		"Empty name": {
+			name:           "",
+			expectedStatus: http.StatusBadRequest,
+		},
+		"Invalid name": {
+			name:           "../pc1",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+	handler := HandleFunc(getFilePath)
+	for k, tc := range testcases {
+		t.Run(k, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, "", nil)
+			require.NoError(t, err)
+			q := req.URL.Query()
+			q.Add("name", tc.name)
+			req.URL.RawQuery = q.Encode()
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+			if tc.expectedStatus == http.StatusOK {
+				assert.Equal(t, tc.expectedResponse, recorder.Body.Bytes())
+			}
+		})
+	}
+}
@@ -0,0 +1,314 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cniserver
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/wait"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/interfacestore"
+	"antrea.io/antrea/pkg/agent/types"
+	"antrea.io/antrea/pkg/ovs/ovsconfig"
+	"antrea.io/antrea/pkg/util/channel"
+	"antrea.io/antrea/pkg/util/k8s"
+)
+
+const (
+	podBandwidthControllerName = "PodBandwidthController"
+	// How long to wait before retrying the processing of a Pod bandwidth change.
+	podBandwidthMinRetryDelay = 5 * time.Second
+	podBandwidthMaxRetryDelay = 300 * time.Second
+	// Default number of workers processing a Pod bandwidth change.
+	podBandwidthWorkers = 2
+
+	// The same annotations as the ones supported by the upstream bandwidth plugin.
+	ingressBandwidthAnnotation = "kubernetes.io/ingress-bandwidth"
+	egressBandwidthAnnotation  = "kubernetes.io/egress-bandwidth"
+
+	// minBurstBits is the minimum burst size when shaping the traffic of a Pod. It
+	// must be large enough for the biggest GSO packets (64KiB), which are dropped
+	// otherwise.
+	minBurstBits = 64 * 1024 * 8
+)
+
+var (
+	// The same limits as the ones enforced by kubelet.
+	minBandwidth = resource.MustParse("1k")
+	maxBandwidth = resource.MustParse("1P")
+)
+
+// podBandwidth holds the bandwidth limits of a Pod in bits per second. 0 means
+// that the bandwidth is not limited.
+type podBandwidth struct {
+	ingress int64
+	egress  int64
+}
+
+// appliedPodBandwidth is the bandwidth limits applied to the interfaces of a
+// Pod.
+type appliedPodBandwidth struct {
+	// containerID is the sorted container IDs of the interfaces, joined with ",".
+	containerID string
+	bandwidth   podBandwidth
+}
+
+func parseBandwidth(value string) (int64, error) {
+	quantity, err := resource.ParseQuantity(value)
+	if err != nil {
+		return 0, err
+	}
+	if quantity.Cmp(minBandwidth) < 0 {
+		return 0, fmt.Errorf("bandwidth %s is lower than the minimum %s", value, minBandwidth.String())
+	}
+	if quantity.Cmp(maxBandwidth) > 0 {
+		return 0, fmt.Errorf("bandwidth %s is higher than the maximum %s", value, maxBandwidth.String())
+	}
+	return quantity.Value(), nil
+}
+
+// getPodBandwidth returns the bandwidth limits set by the annotations of a Pod.
+func getPodBandwidth(pod *v1.Pod) (podBandwidth, error) {
+	var bandwidth podBandwidth
+	var err error
+	if value, ok := pod.Annotations[ingressBandwidthAnnotation]; ok {
+		if bandwidth.ingress, err = parseBandwidth(value); err != nil {
+			return bandwidth, fmt.Errorf("invalid annotation %s: %v", ingressBandwidthAnnotation, err)
+		}
+	}
+	if value, ok := pod.Annotations[egressBandwidthAnnotation]; ok {
+		if bandwidth.egress, err = parseBandwidth(value); err != nil {
+			return bandwidth, fmt.Errorf("invalid annotation %s: %v", egressBandwidthAnnotation, err)
+		}
+	}
+	return bandwidth, nil
+}
+
+// burstBits returns the burst size used to shape the traffic at the provided
+// rate, which is 100ms of traffic but no less than minBurstBits.
+func burstBits(rate int64) int64 {
+	if burst := rate / 10; burst > minBurstBits {
+		return burst
+	}
+	return minBurstBits
+}
+
+// PodBandwidthController shapes the bandwidth of the local Pods according to
+// the kubernetes.io/ingress-bandwidth and kubernetes.io/egress-bandwidth
+// annotations. The egress traffic of a Pod is limited with OVS ingress policing
+// on the Pod's OVS port, and the ingress traffic of a Pod is limited with a
+// traffic shaping qdisc on the host interface of the Pod. The limits are updated
+// when the annotations change.
+type PodBandwidthController struct {
+	interfaceStore  interfacestore.InterfaceStore
+	ovsBridgeClient ovsconfig.OVSBridgeClient
+	// setInterfaceEgressBandwidth limits the traffic sent by a host interface,
+	// i.e. the ingress traffic of the Pod.
+	setInterfaceEgressBandwidth func(ifaceName string, rate, burst int64) error
+
+	podLister       corelisters.PodLister
+	podListerSynced cache.InformerSynced
+	queue           workqueue.RateLimitingInterface
+
+	// appliedBandwidths is keyed by the namespaced name of Pods.
+	appliedBandwidths      map[string]*appliedPodBandwidth
+	appliedBandwidthsMutex sync.Mutex
+}
+
+func NewPodBandwidthController(
+	interfaceStore interfacestore.InterfaceStore,
+	ovsBridgeClient ovsconfig.OVSBridgeClient,
+	podInformer cache.SharedIndexInformer,
+	podUpdateSubscriber channel.Subscriber,
+) *PodBandwidthController {
+	c := &PodBandwidthController{
+		interfaceStore:              interfaceStore,
+		ovsBridgeClient:             ovsBridgeClient,
+		setInterfaceEgressBandwidth: setInterfaceEgressBandwidth,
+		podLister:                   corelisters.NewPodLister(podInformer.GetIndexer()),
+		podListerSynced:             podInformer.HasSynced,
+		queue:                       workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(podBandwidthMinRetryDelay, podBandwidthMaxRetryDelay), "podBandwidth"),
+		appliedBandwidths:           map[string]*appliedPodBandwidth{},
+	}
+	podInformer.AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.addPod,
+			UpdateFunc: c.updatePod,
+			DeleteFunc: c.deletePod,
+		},
+		0,
+	)
+	// The Pod may be received from the K8s apiserver before its interface is
+	// created by the CNI server, in which case the Pod is processed again when the
+	// interface is created.
+	podUpdateSubscriber.Subscribe(c.processPodUpdate)
+	return c
+}
+
+func (c *PodBandwidthController) processPodUpdate(e interface{}) {
+	podEvent := e.(types.PodUpdate)
+	c.queue.Add(k8s.NamespacedName(podEvent.PodNamespace, podEvent.PodName))
+}
+
+func (c *PodBandwidthController) enqueuePod(pod *v1.Pod) {
+	if pod.Spec.HostNetwork {
+		return
+	}
+	c.queue.Add(k8s.NamespacedName(pod.Namespace, pod.Name))
+}
+
+func (c *PodBandwidthController) addPod(obj interface{}) {
+	pod := obj.(*v1.Pod)
+	klog.V(4).InfoS("Processing Pod ADD event", "Pod", klog.KObj(pod))
+	c.enqueuePod(pod)
+}
+
+func (c *PodBandwidthController) updatePod(oldObj, obj interface{}) {
+	oldPod := oldObj.(*v1.Pod)
+	pod := obj.(*v1.Pod)
+	if oldPod.Annotations[ingressBandwidthAnnotation] == pod.Annotations[ingressBandwidthAnnotation] &&
+		oldPod.Annotations[egressBandwidthAnnotation] == pod.Annotations[egressBandwidthAnnotation] {
+		return
+	}
+	klog.V(2).InfoS("Processing Pod UPDATE event", "Pod", klog.KObj(pod))
+	c.enqueuePod(pod)
+}
+
+func (c *PodBandwidthController) deletePod(obj interface{}) {
+	pod, ok := obj.(*v1.Pod)
+	if !ok {
+		deletedState, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			klog.Errorf("Received unexpected object: %v", obj)
+			return
+		}
+		pod, ok = deletedState.Obj.(*v1.Pod)
+		if !ok {
+			klog.Errorf("DeletedFinalStateUnknown contains non-Pod object: %v", deletedState.Obj)
+			return
+		}
+	}
+	klog.V(4).InfoS("Processing Pod DELETE event", "Pod", klog.KObj(pod))
+	c.enqueuePod(pod)
+}
+
+func (c *PodBandwidthController) Run(stopCh <-chan struct{}) {
+	defer c.queue.ShutDown()
+
+	klog.InfoS("Starting", "controllerName", podBandwidthControllerName)
+	defer klog.InfoS("Shutting down", "controllerName", podBandwidthControllerName)
+
+	if !cache.WaitForNamedCacheSync(podBandwidthControllerName, stopCh, c.podListerSynced) {
+		return
+	}
+
+	for i := 0; i < podBandwidthWorkers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+}
+
+func (c *PodBandwidthController) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *PodBandwidthController) processNextWorkItem() bool {
+	obj, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(obj)
+
+	if key, ok := obj.(string); !ok {
+		c.queue.Forget(obj)
+		klog.Errorf("Expected string in work queue but got %#v", obj)
+		return true
+	} else if err := c.syncPodBandwidth(key); err == nil {
+		c.queue.Forget(key)
+	} else {
+		c.queue.AddRateLimited(key)
+		klog.ErrorS(err, "Syncing Pod bandwidth failed, requeue", "Pod", key)
+	}
+	return true
+}
+
+func (c *PodBandwidthController) syncPodBandwidth(key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	c.appliedBandwidthsMutex.Lock()
+	defer c.appliedBandwidthsMutex.Unlock()
+
+	pod, err := c.podLister.Pods(namespace).Get(name)
+	if err != nil || pod.Spec.HostNetwork {
+		// The limits are removed with the interface of the Pod.
+		delete(c.appliedBandwidths, key)
+		return nil
+	}
+	ifaces := c.interfaceStore.GetContainerInterfacesByPod(name, namespace)
+	if len(ifaces) == 0 {
+		// The interface has not been created yet, or has been deleted.
+		delete(c.appliedBandwidths, key)
+		return nil
+	}
+	bandwidth, err := getPodBandwidth(pod)
+	if err != nil {
+		// Retrying cannot fix an invalid annotation.
+		klog.ErrorS(err, "Failed to get the bandwidth of Pod", "Pod", klog.KObj(pod))
+		return nil
+	}
+	// There can be more than one interface temporarily if a Pod is recreated with
+	// the same name, the limits are applied to all of them.
+	containerIDs := make([]string, 0, len(ifaces))
+	for _, iface := range ifaces {
+		containerIDs = append(containerIDs, iface.ContainerID)
+	}
+	sort.Strings(containerIDs)
+	containerID := strings.Join(containerIDs, ",")
+	if applied, exists := c.appliedBandwidths[key]; exists && applied.containerID == containerID && applied.bandwidth == bandwidth {
+		return nil
+	}
+	// The egress traffic of the Pod is the ingress traffic of its OVS port. OVS
+	// uses kbps for the rate and kb for the burst size.
+	var policingRate, policingBurst int64
+	if bandwidth.egress > 0 {
+		policingRate = (bandwidth.egress + 999) / 1000
+		policingBurst = (burstBits(bandwidth.egress) + 999) / 1000
+	}
+	for _, iface := range ifaces {
+		if err := c.ovsBridgeClient.SetInterfaceIngressPolicing(iface.InterfaceName, policingRate, policingBurst); err != nil {
+			return fmt.Errorf("failed to set ingress policing of interface %s: %v", iface.InterfaceName, err)
+		}
+		if err := c.setInterfaceEgressBandwidth(iface.InterfaceName, bandwidth.ingress, burstBits(bandwidth.ingress)); err != nil {
+			return fmt.Errorf("failed to shape the traffic of interface %s: %v", iface.InterfaceName, err)
+		}
+	}
+	c.appliedBandwidths[key] = &appliedPodBandwidth{containerID: containerID, bandwidth: bandwidth}
+	klog.InfoS("Updated Pod bandwidth", "Pod", klog.KObj(pod), "ingress", bandwidth.ingress, "egress", bandwidth.egress)
+	return nil
+}
@@ -0,0 +1,62 @@
+//go:build !windows
+// +build !windows
+
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cniserver
+
+import (
+	"github.com/vishvananda/netlink"
+)
+
+// tbfLatencyInUsec is the maximum time a packet can be queued by the tbf qdisc,
+// the same as the one used by the upstream bandwidth plugin.
+const tbfLatencyInUsec = 25 * 1000
+
+// setInterfaceEgressBandwidth shapes the traffic sent by a host interface with
+// a tbf qdisc. rate and burst are in bits. The qdisc is removed if rate is 0.
+func setInterfaceEgressBandwidth(ifaceName string, rate, burst int64) error {
+	link, err := netlink.LinkByName(ifaceName)
+	if err != nil {
+		return err
+	}
+	if rate == 0 {
+		qdiscs, err := netlink.QdiscList(link)
+		if err != nil {
+			return err
+		}
+		for _, qdisc := range qdiscs {
+			if tbf, ok := qdisc.(*netlink.Tbf); ok && tbf.Parent == netlink.HANDLE_ROOT {
+				return netlink.QdiscDel(tbf)
+			}
+		}
+		return nil
+	}
+	rateInBytes := uint64(rate / 8)
+	burstInBytes := uint32(burst / 8)
+	bufferInTicks := netlink.Xmittime(rateInBytes, burstInBytes)
+	limitInBytes := uint32(float64(rateInBytes)*tbfLatencyInUsec/netlink.TIME_UNITS_PER_SEC) + burstInBytes
+	qdisc := &netlink.Tbf{
+		QdiscAttrs: netlink.QdiscAttrs{
+			LinkIndex: link.Attrs().Index,
+			Handle:    netlink.MakeHandle(1, 0),
+			Parent:    netlink.HANDLE_ROOT,
+		},
+		Rate:   rateInBytes,
+		Limit:  limitInBytes,
+		Buffer: bufferInTicks,
+	}
+	return netlink.QdiscReplace(qdisc)
+}
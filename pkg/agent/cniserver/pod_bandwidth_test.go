@@ -0,0 +1,132 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cniserver
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"antrea.io/antrea/pkg/agent/interfacestore"
+	ovsconfigtest "antrea.io/antrea/pkg/ovs/ovsconfig/testing"
+	"antrea.io/antrea/pkg/util/channel"
+)
+
+func TestGetPodBandwidth(t *testing.T) {
+	tests := []struct {
+		name              string
+		annotations       map[string]string
+		expectedBandwidth podBandwidth
+		expectedErr       bool
+	}{
+		{
+			name:              "no annotation",
+			expectedBandwidth: podBandwidth{},
+		},
+		{
+			name: "both annotations",
+			annotations: map[string]string{
+				ingressBandwidthAnnotation: "10M",
+				egressBandwidthAnnotation:  "1500k",
+			},
+			expectedBandwidth: podBandwidth{ingress: 10000000, egress: 1500000},
+		},
+		{
+			name:        "invalid quantity",
+			annotations: map[string]string{ingressBandwidthAnnotation: "10Mbps"},
+			expectedErr: true,
+		},
+		{
+			name:        "too small",
+			annotations: map[string]string{egressBandwidthAnnotation: "10"},
+			expectedErr: true,
+		},
+		{
+			name:        "too large",
+			annotations: map[string]string{egressBandwidthAnnotation: "2P"},
+			expectedErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "ns", Annotations: tt.annotations}}
+			bandwidth, err := getPodBandwidth(pod)
+			if tt.expectedErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedBandwidth, bandwidth)
+			}
+		})
+	}
+}
+
+func TestSyncPodBandwidth(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	mockOVSBridgeClient := ovsconfigtest.NewMockOVSBridgeClient(controller)
+
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "pod1",
+			Namespace:   "ns1",
+			Annotations: map[string]string{egressBandwidthAnnotation: "10M", ingressBandwidthAnnotation: "100M"},
+		},
+	}
+	client := fake.NewSimpleClientset(pod)
+	informerFactory := informers.NewSharedInformerFactory(client, 0)
+	podInformer := informerFactory.Core().V1().Pods().Informer()
+	ifaceStore := interfacestore.NewInterfaceStore()
+	c := NewPodBandwidthController(ifaceStore, mockOVSBridgeClient, podInformer, channel.NewSubscribableChannel("PodUpdate", 100))
+	type shapedRate struct {
+		ifaceName   string
+		rate, burst int64
+	}
+	var shapedRates []shapedRate
+	c.setInterfaceEgressBandwidth = func(ifaceName string, rate, burst int64) error {
+		shapedRates = append(shapedRates, shapedRate{ifaceName, rate, burst})
+		return nil
+	}
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	informerFactory.Start(stopCh)
+	informerFactory.WaitForCacheSync(stopCh)
+
+	// The interface of the Pod has not been created yet.
+	require.NoError(t, c.syncPodBandwidth("ns1/pod1"))
+	assert.Empty(t, shapedRates)
+
+	ifaceStore.AddInterface(interfacestore.NewContainerInterface("pod1-iface", "container1", "pod1", "ns1", nil, nil, 0))
+	mockOVSBridgeClient.EXPECT().SetInterfaceIngressPolicing("pod1-iface", int64(10000), int64(1000)).Times(1)
+	require.NoError(t, c.syncPodBandwidth("ns1/pod1"))
+	assert.Equal(t, []shapedRate{{"pod1-iface", 100000000, 10000000}}, shapedRates)
+
+	// Nothing is changed if the Pod is processed again.
+	require.NoError(t, c.syncPodBandwidth("ns1/pod1"))
+	assert.Len(t, shapedRates, 1)
+
+	// The limits are removed with the annotations.
+	pod.Annotations = nil
+	podInformer.GetIndexer().Update(pod)
+	mockOVSBridgeClient.EXPECT().SetInterfaceIngressPolicing("pod1-iface", int64(0), int64(0)).Times(1)
+	require.NoError(t, c.syncPodBandwidth("ns1/pod1"))
+	assert.Equal(t, shapedRate{"pod1-iface", 0, minBurstBits}, shapedRates[1])
+}
@@ -48,6 +48,7 @@ import (
 	crdinformers "antrea.io/antrea/pkg/client/informers/externalversions/crd/v1alpha2"
 	crdlisters "antrea.io/antrea/pkg/client/listers/crd/v1alpha2"
 	"antrea.io/antrea/pkg/controller/metrics"
+	"antrea.io/antrea/pkg/querier"
 	"antrea.io/antrea/pkg/util/channel"
 	"antrea.io/antrea/pkg/util/k8s"
 )
@@ -145,6 +146,8 @@ type EgressController struct {
 	ipAssigner ipassigner.IPAssigner
 }
 
+var _ querier.EgressQuerier = &EgressController{}
+
 func NewEgressController(
 	ofClient openflow.Client,
 	antreaClientGetter agent.AntreaClientProvider,
@@ -555,6 +558,34 @@ func (c *EgressController) unbindPodEgress(pod, egress string) (string, bool) {
 	return "", false
 }
 
+// GetEgress returns the effective Egress applied to the Pod, its Egress IP and the name of the Node holding the
+// Egress IP.
+func (c *EgressController) GetEgress(podNamespace, podName string) (string, string, string, error) {
+	pod := k8s.NamespacedName(podNamespace, podName)
+	egressName, exists := func() (string, bool) {
+		c.egressBindingsMutex.RLock()
+		defer c.egressBindingsMutex.RUnlock()
+		binding, exists := c.egressBindings[pod]
+		if !exists {
+			return "", false
+		}
+		return binding.effectiveEgress, true
+	}()
+	if !exists {
+		return "", "", "", fmt.Errorf("no Egress applied to Pod %s", pod)
+	}
+	egress, err := c.egressLister.Get(egressName)
+	if err != nil {
+		return "", "", "", err
+	}
+	egressIP := egress.Spec.EgressIP
+	// Prefer the Egress IP that has been realized.
+	if eState, exists := c.getEgressState(egressName); exists {
+		egressIP = eState.egressIP
+	}
+	return egressName, egressIP, egress.Status.EgressNode, nil
+}
+
 func (c *EgressController) updateEgressStatus(egress *crdv1a2.Egress, isLocal bool) error {
 	toUpdate := egress.DeepCopy()
 	var updateErr, getErr error
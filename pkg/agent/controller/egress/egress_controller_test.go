@@ -834,3 +834,27 @@ func TestUpdateEgressStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestGetEgress(t *testing.T) {
+	egress := &crdv1a2.Egress{
+		ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+		Spec:       crdv1a2.EgressSpec{EgressIP: fakeRemoteEgressIP1},
+		Status:     crdv1a2.EgressStatus{EgressNode: "node2"},
+	}
+	c := newFakeController(t, []runtime.Object{egress})
+	defer c.mockController.Finish()
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	c.crdInformerFactory.Start(stopCh)
+	c.crdInformerFactory.WaitForCacheSync(stopCh)
+	c.bindPodEgress(k8s.NamespacedName("ns1", "pod1"), egress.Name)
+
+	egressName, egressIP, egressNode, err := c.GetEgress("ns1", "pod1")
+	require.NoError(t, err)
+	assert.Equal(t, egress.Name, egressName)
+	assert.Equal(t, fakeRemoteEgressIP1, egressIP)
+	assert.Equal(t, "node2", egressNode)
+
+	_, _, _, err = c.GetEgress("ns2", "pod2")
+	assert.Error(t, err)
+}
@@ -94,6 +94,8 @@ type rule struct {
 	SourceRef *v1beta.NetworkPolicyReference
 	// EnableLogging is a boolean indicating whether logging is required for Antrea Policies. Always false for K8s NetworkPolicy.
 	EnableLogging bool
+	// RateLimit of this rule. Only set when Action is RateLimit.
+	RateLimit *v1beta.RateLimit
 }
 
 func (r *rule) Less(r2 *rule) bool {
@@ -661,6 +663,7 @@ func toRule(r *v1beta.NetworkPolicyRule, policy *v1beta.NetworkPolicy, maxPriori
 		PolicyUID:       policy.UID,
 		SourceRef:       policy.SourceRef,
 		EnableLogging:   r.EnableLogging,
+		RateLimit:       r.RateLimit,
 	}
 	rule.ID = hashRule(rule)
 	rule.PolicyName = policy.Name
@@ -32,9 +32,11 @@ import (
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
 
+	"antrea.io/antrea/pkg/agent/interfacestore"
 	"antrea.io/antrea/pkg/agent/openflow"
 	"antrea.io/antrea/pkg/agent/types"
 	binding "antrea.io/antrea/pkg/ovs/openflow"
+	"antrea.io/antrea/pkg/util/channel"
 	utilsets "antrea.io/antrea/pkg/util/sets"
 )
 
@@ -44,8 +46,17 @@ const (
 
 	ruleRealizationTimeout = 2 * time.Second
 	dnsRequestTimeout      = 10 * time.Second
+
+	// dnsVisibilityRuleID is the pseudo rule ID which selects all local Pods
+	// for DNS response interception when DNS visibility is enabled.
+	dnsVisibilityRuleID = "dns-visibility"
 )
 
+// dnsResponseRecorder records the DNS responses received by local Pods.
+type dnsResponseRecorder interface {
+	AddDNSResponse(msg *dns.Msg, clientIP net.IP, clientPort uint16, serverIP net.IP, serverPort uint16, responseTime time.Time)
+}
+
 // fqdnSelectorItem is a selector that selects FQDNs,
 // either by exact name match or by regex pattern.
 type fqdnSelectorItem struct {
@@ -124,6 +135,9 @@ type fqdnController struct {
 
 	// dirtyRuleHandler is a callback that is run upon finding a rule out-of-sync.
 	dirtyRuleHandler func(string)
+	// ruleFailureHandler is a callback that is run with the FQDN resolution error of a rule when any FQDN selected
+	// by the rule fails to be resolved or is resolved again. It is nil if failures are not reported.
+	ruleFailureHandler func(ruleID string, err error)
 	// A single instance of ruleSyncTracker.
 	ruleSyncTracker *ruleSyncTracker
 	// FQDN names this controller is tracking, with their corresponding dnsMeta.
@@ -150,6 +164,21 @@ type fqdnController struct {
 	ipv4Enabled           bool
 	ipv6Enabled           bool
 	gwPort                uint32
+
+	// failedFQDNs stores the FQDNs whose last DNS query failed. It is also
+	// protected by fqdnSelectorMutex.
+	failedFQDNs sets.String
+
+	// dnsResponseRecorder records all intercepted DNS responses when DNS
+	// visibility is enabled. It is nil otherwise.
+	dnsResponseRecorder dnsResponseRecorder
+	ifaceStore          interfacestore.InterfaceStore
+	// dnsVisibilityNamespaceFilter returns true if the DNS responses of the
+	// Pods in a Namespace must be intercepted for DNS visibility.
+	dnsVisibilityNamespaceFilter func(namespace string) bool
+	// dnsVisibilitySyncCh is used to trigger the sync of the local Pods
+	// selected by dnsVisibilityRuleID.
+	dnsVisibilitySyncCh chan struct{}
 }
 
 func newFQDNController(client openflow.Client, allocator *idAllocator, dnsServerOverride string, dirtyRuleHandler func(string), v4Enabled, v6Enabled bool, gwPort uint32) (*fqdnController, error) {
@@ -164,6 +193,7 @@ func newFQDNController(client openflow.Client, allocator *idAllocator, dnsServer
 		fqdnToSelectorItem:     map[string]map[fqdnSelectorItem]struct{}{},
 		selectorItemToFQDN:     map[fqdnSelectorItem]sets.String{},
 		selectorItemToRuleIDs:  map[fqdnSelectorItem]sets.String{},
+		failedFQDNs:            sets.NewString(),
 		ipv4Enabled:            v4Enabled,
 		ipv6Enabled:            v6Enabled,
 		gwPort:                 gwPort,
@@ -325,6 +355,60 @@ func (f *fqdnController) updateRuleSelectedPods(ruleID string, podOFAddrs sets.I
 	return nil
 }
 
+// enableDNSVisibility makes the controller intercept the DNS responses received
+// by the local Pods in the Namespaces matching namespaceFilter, and pass them to
+// recorder. Like the responses received by Pods selected by FQDN rules, they are
+// held in OVS until they are processed by the controller, and therefore share
+// the rate limit of the NetworkPolicy packet-ins. It must be called before any
+// Pod update event is published.
+func (f *fqdnController) enableDNSVisibility(recorder dnsResponseRecorder, ifaceStore interfacestore.InterfaceStore, podUpdateSubscriber channel.Subscriber, namespaceFilter func(namespace string) bool) {
+	f.dnsResponseRecorder = recorder
+	f.ifaceStore = ifaceStore
+	f.dnsVisibilityNamespaceFilter = namespaceFilter
+	f.dnsVisibilitySyncCh = make(chan struct{}, 1)
+	podUpdateSubscriber.Subscribe(func(interface{}) {
+		f.triggerDNSVisibilitySync()
+	})
+	f.triggerDNSVisibilitySync()
+}
+
+func (f *fqdnController) triggerDNSVisibilitySync() {
+	select {
+	case f.dnsVisibilitySyncCh <- struct{}{}:
+	default:
+		// A sync is already pending.
+	}
+}
+
+// runDNSVisibilitySync keeps the DNS response interception flows in sync with
+// the local Pods until stopCh is closed.
+func (f *fqdnController) runDNSVisibilitySync(stopCh <-chan struct{}) {
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-f.dnsVisibilitySyncCh:
+			if err := f.syncDNSVisibilityPods(); err != nil {
+				klog.ErrorS(err, "Failed to intercept DNS responses for local Pods")
+			}
+		}
+	}
+}
+
+func (f *fqdnController) syncDNSVisibilityPods() error {
+	podOFAddrs := sets.NewInt32()
+	for _, iface := range f.ifaceStore.GetInterfacesByType(interfacestore.ContainerInterface) {
+		if iface.OVSPortConfig == nil || iface.OFPort <= 0 {
+			continue
+		}
+		if f.dnsVisibilityNamespaceFilter != nil && !f.dnsVisibilityNamespaceFilter(iface.PodNamespace) {
+			continue
+		}
+		podOFAddrs.Insert(iface.OFPort)
+	}
+	return f.updateRuleSelectedPods(dnsVisibilityRuleID, podOFAddrs)
+}
+
 // deleteFQDNRule handles a FQDN policy rule delete event.
 func (f *fqdnController) deleteFQDNRule(ruleID string, fqdns []string) error {
 	f.deleteFQDNSelector(ruleID, fqdns)
@@ -359,6 +443,7 @@ func (f *fqdnController) cleanupFQDNSelectorItem(fs fqdnSelectorItem) {
 				// tracked by the fqdnController.
 				delete(f.fqdnToSelectorItem, fqdn)
 				delete(f.dnsEntryCache, fqdn)
+				f.failedFQDNs.Delete(fqdn)
 			}
 			delete(selectors, fs)
 		}
@@ -632,7 +717,54 @@ func (f *fqdnController) processNextWorkItem() bool {
 	return true
 }
 
+// setFQDNResolutionResult records whether the DNS query of the provided FQDN failed, and reports the FQDN resolution
+// errors of the rules selecting the FQDN if it changed.
+func (f *fqdnController) setFQDNResolutionResult(fqdn string, err error) {
+	if f.ruleFailureHandler == nil {
+		return
+	}
+	f.fqdnSelectorMutex.Lock()
+	defer f.fqdnSelectorMutex.Unlock()
+	if (err != nil) == f.failedFQDNs.Has(fqdn) {
+		return
+	}
+	if err != nil {
+		f.failedFQDNs.Insert(fqdn)
+	} else {
+		f.failedFQDNs.Delete(fqdn)
+	}
+	ruleIDs := sets.NewString()
+	for selectorItem := range f.fqdnToSelectorItem[fqdn] {
+		utilsets.MergeString(ruleIDs, f.selectorItemToRuleIDs[selectorItem])
+	}
+	for ruleID := range ruleIDs {
+		f.ruleFailureHandler(ruleID, f.getRuleFQDNResolutionError(ruleID))
+	}
+}
+
+// getRuleFQDNResolutionError returns an error listing the FQDNs selected by the provided rule which failed to be
+// resolved, or nil if there is none.
+// fqdnSelectorMutex must have been acquired by the caller.
+func (f *fqdnController) getRuleFQDNResolutionError(ruleID string) error {
+	failedFQDNs := sets.NewString()
+	for selectorItem, ruleIDs := range f.selectorItemToRuleIDs {
+		if !ruleIDs.Has(ruleID) {
+			continue
+		}
+		for fqdn := range f.selectorItemToFQDN[selectorItem] {
+			if f.failedFQDNs.Has(fqdn) {
+				failedFQDNs.Insert(fqdn)
+			}
+		}
+	}
+	if len(failedFQDNs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("DNS query failed for FQDN(s) %s", strings.Join(failedFQDNs.List(), ", "))
+}
+
 func (f *fqdnController) handleErr(err error, key interface{}) {
+	f.setFQDNResolutionResult(key.(string), err)
 	if err == nil {
 		f.dnsQueryQueue.Forget(key)
 		return
@@ -746,26 +878,31 @@ func (f *fqdnController) HandlePacketIn(pktIn *ofctrl.PacketIn) error {
 func (f *fqdnController) handlePacketIn(pktIn *ofctrl.PacketIn) error {
 	klog.V(4).InfoS("Received a packetIn for DNS response")
 	waitCh := make(chan error, 1)
-	handleUDPData := func(dnsPkt *protocol.UDP) {
+	handleUDPData := func(dnsPkt *protocol.UDP, srcIP, dstIP net.IP) {
 		dnsData := dnsPkt.Data
 		dnsMsg := dns.Msg{}
 		if err := dnsMsg.Unpack(dnsData); err != nil {
 			waitCh <- err
 			return
 		}
-		f.onDNSResponseMsg(&dnsMsg, time.Now(), waitCh)
+		responseTime := time.Now()
+		if f.dnsResponseRecorder != nil {
+			// The response is sent by the DNS server to the client Pod.
+			f.dnsResponseRecorder.AddDNSResponse(&dnsMsg, dstIP, dnsPkt.PortDst, srcIP, dnsPkt.PortSrc, responseTime)
+		}
+		f.onDNSResponseMsg(&dnsMsg, responseTime, waitCh)
 	}
 	go func() {
 		switch ipPkt := pktIn.Data.Data.(type) {
 		case *protocol.IPv4:
 			switch dnsPkt := ipPkt.Data.(type) {
 			case *protocol.UDP:
-				handleUDPData(dnsPkt)
+				handleUDPData(dnsPkt, ipPkt.NWSrc, ipPkt.NWDst)
 			}
 		case *protocol.IPv6:
 			switch dnsPkt := ipPkt.Data.(type) {
 			case *protocol.UDP:
-				handleUDPData(dnsPkt)
+				handleUDPData(dnsPkt, ipPkt.NWSrc, ipPkt.NWDst)
 			}
 		}
 	}()
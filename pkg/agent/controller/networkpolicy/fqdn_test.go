@@ -16,16 +16,23 @@ package networkpolicy
 
 import (
 	"context"
+	"fmt"
+	"net"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/miekg/dns"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"antrea.io/antrea/pkg/agent/config"
+	"antrea.io/antrea/pkg/agent/interfacestore"
+	"antrea.io/antrea/pkg/agent/openflow"
 	openflowtest "antrea.io/antrea/pkg/agent/openflow/testing"
+	"antrea.io/antrea/pkg/agent/types"
+	"antrea.io/antrea/pkg/util/channel"
 )
 
 func newMockFQDNController(t *testing.T, controller *gomock.Controller, dnsServer *string) (*fqdnController, *openflowtest.MockClient) {
@@ -305,3 +312,81 @@ func TestLookupIPFallback(t *testing.T) {
 	err := f.lookupIP(ctx, "www.google.com")
 	require.NoError(t, err, "Error when resolving name")
 }
+
+func TestSetFQDNResolutionResult(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	f, _ := newMockFQDNController(t, controller, nil)
+	ruleErrors := map[string]error{}
+	f.ruleFailureHandler = func(ruleID string, err error) {
+		ruleErrors[ruleID] = err
+	}
+	f.addFQDNSelector("rule1", []string{"test.antrea.io", "foo.antrea.io"})
+	f.addFQDNSelector("rule2", []string{"test.antrea.io"})
+	f.addFQDNSelector("rule3", []string{"bar.antrea.io"})
+
+	f.setFQDNResolutionResult("test.antrea.io", fmt.Errorf("DNS request failed"))
+	f.setFQDNResolutionResult("foo.antrea.io", fmt.Errorf("DNS request failed"))
+	assert.Equal(t, map[string]error{
+		"rule1": fmt.Errorf("DNS query failed for FQDN(s) foo.antrea.io, test.antrea.io"),
+		"rule2": fmt.Errorf("DNS query failed for FQDN(s) test.antrea.io"),
+	}, ruleErrors)
+
+	f.setFQDNResolutionResult("test.antrea.io", nil)
+	assert.Equal(t, map[string]error{
+		"rule1": fmt.Errorf("DNS query failed for FQDN(s) foo.antrea.io"),
+		"rule2": nil,
+	}, ruleErrors)
+
+	// The errors of the FQDNs which are no longer selected should be forgotten.
+	f.deleteFQDNSelector("rule1", []string{"test.antrea.io", "foo.antrea.io"})
+	assert.False(t, f.failedFQDNs.Has("foo.antrea.io"))
+}
+
+type fakeDNSResponseRecorder struct {
+	responses []*dns.Msg
+}
+
+func (r *fakeDNSResponseRecorder) AddDNSResponse(msg *dns.Msg, clientIP net.IP, clientPort uint16, serverIP net.IP, serverPort uint16, responseTime time.Time) {
+	r.responses = append(r.responses, msg)
+}
+
+func newTestPodInterface(name, namespace string, ofPort int32) *interfacestore.InterfaceConfig {
+	iface := interfacestore.NewContainerInterface(name+"-iface", name+"-container", name, namespace, nil, nil, 0)
+	iface.OVSPortConfig = &interfacestore.OVSPortConfig{OFPort: ofPort}
+	return iface
+}
+
+func TestSyncDNSVisibilityPods(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	f, c := newMockFQDNController(t, controller, nil)
+	ifaceStore := interfacestore.NewInterfaceStore()
+	pod1 := newTestPodInterface("pod1", "ns1", 1)
+	pod2 := newTestPodInterface("pod2", "ns1", 2)
+	pod3 := newTestPodInterface("pod3", "kube-system", 3)
+	for _, iface := range []*interfacestore.InterfaceConfig{pod1, pod2, pod3} {
+		ifaceStore.AddInterface(iface)
+	}
+	namespaceFilter := func(namespace string) bool {
+		return namespace != "kube-system"
+	}
+	f.enableDNSVisibility(&fakeDNSResponseRecorder{}, ifaceStore, channel.NewSubscribableChannel("PodUpdate", 100), namespaceFilter)
+	// An initial sync must be pending, and further triggers must be coalesced with it.
+	f.triggerDNSVisibilitySync()
+	assert.Len(t, f.dnsVisibilitySyncCh, 1)
+
+	// The Pods in the Namespaces which are filtered out must not be intercepted.
+	c.EXPECT().AddAddressToDNSConjunction(dnsInterceptRuleID, gomock.InAnyOrder([]types.Address{openflow.NewOFPortAddress(1), openflow.NewOFPortAddress(2)})).Times(1)
+	require.NoError(t, f.syncDNSVisibilityPods())
+	assert.Equal(t, sets.NewInt32(1, 2), f.fqdnRuleToSelectedPods[dnsVisibilityRuleID])
+
+	// pod1 is also selected by a FQDN rule, so its DNS responses must still be
+	// intercepted after it is no longer selected for DNS visibility.
+	require.NoError(t, f.updateRuleSelectedPods("rule1", sets.NewInt32(1)))
+	ifaceStore.DeleteInterface(pod1)
+	ifaceStore.DeleteInterface(pod2)
+	c.EXPECT().DeleteAddressFromDNSConjunction(dnsInterceptRuleID, []types.Address{openflow.NewOFPortAddress(2)}).Times(1)
+	require.NoError(t, f.syncDNSVisibilityPods())
+	assert.Empty(t, f.fqdnRuleToSelectedPods[dnsVisibilityRuleID])
+}
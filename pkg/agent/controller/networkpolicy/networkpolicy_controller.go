@@ -107,6 +107,7 @@ type Controller struct {
 	addressGroupWatcher   *watcher
 	fullSyncGroup         sync.WaitGroup
 	ifaceStore            interfacestore.InterfaceStore
+	podUpdateSubscriber   channel.Subscriber
 	// denyConnStore is for storing deny connections for flow exporter.
 	denyConnStore *connections.DenyConnectionStore
 	gwPort        uint32
@@ -160,6 +161,9 @@ func NewNetworkPolicyController(antreaClientGetter agent.AntreaClientProvider,
 	c.ruleCache = newRuleCache(c.enqueueRule, podUpdateSubscriber, groupIDUpdates)
 	if statusManagerEnabled {
 		c.statusManager = newStatusController(antreaClientGetter, nodeName, c.ruleCache)
+		if c.fqdnController != nil {
+			c.fqdnController.ruleFailureHandler = c.setFQDNResolutionFailure
+		}
 	}
 	// Create a WaitGroup that is used to block network policy workers from asynchronously processing
 	// NP rules until the events preceding bookmark are synced. It can also be used as part of the
@@ -368,6 +372,7 @@ func NewNetworkPolicyController(antreaClientGetter agent.AntreaClientProvider,
 		fullSynced:        false,
 	}
 	c.ifaceStore = ifaceStore
+	c.podUpdateSubscriber = podUpdateSubscriber
 	return c, nil
 }
 
@@ -432,6 +437,18 @@ func (c *Controller) SetDenyConnStore(denyConnStore *connections.DenyConnectionS
 	c.denyConnStore = denyConnStore
 }
 
+// SetDNSRecordStore makes the Controller intercept the DNS responses received by
+// the local Pods in the Namespaces matching namespaceFilter, and record them in
+// dnsRecordStore for flow export. It requires Antrea-native policies to be
+// enabled, and must be called before Run.
+func (c *Controller) SetDNSRecordStore(dnsRecordStore *connections.DNSRecordStore, namespaceFilter func(namespace string) bool) {
+	if c.fqdnController == nil {
+		klog.InfoS("AntreaPolicy is disabled, DNS responses will not be recorded")
+		return
+	}
+	c.fqdnController.enableDNSVisibility(dnsRecordStore, c.ifaceStore, c.podUpdateSubscriber, namespaceFilter)
+}
+
 // Run begins watching and processing Antrea AddressGroups, AppliedToGroups
 // and NetworkPolicies, and spawns workers that reconciles NetworkPolicy rules.
 // Run will not return until stopCh is closed.
@@ -464,6 +481,9 @@ func (c *Controller) Run(stopCh <-chan struct{}) {
 			go wait.Until(c.fqdnController.worker, time.Second, stopCh)
 		}
 		go c.fqdnController.runRuleSyncTracker(stopCh)
+		if c.fqdnController.dnsResponseRecorder != nil {
+			go c.fqdnController.runDNSVisibilitySync(stopCh)
+		}
 	}
 	klog.Infof("Waiting for all watchers to complete full sync")
 	c.fullSyncGroup.Wait()
@@ -611,15 +631,33 @@ func (c *Controller) syncRule(key string) error {
 		klog.V(2).InfoS("Rule realization was done", "ruleID", key)
 		c.fqdnController.notifyRuleUpdate(key, err)
 	}
+	if c.statusManagerEnabled && rule.SourceRef.Type != v1beta2.K8sNetworkPolicy {
+		// The failure is cleared once the rule is reconciled successfully.
+		c.statusManager.SetRuleFailure(key, rule.PolicyUID, v1beta2.NetworkPolicyFlowInstallationFailed, err)
+	}
 	if err != nil {
 		return err
 	}
 	if c.statusManagerEnabled && rule.SourceRef.Type != v1beta2.K8sNetworkPolicy {
 		c.statusManager.SetRuleRealization(key, rule.PolicyUID)
+		c.statusManager.SetRuleFailure(key, rule.PolicyUID, v1beta2.NetworkPolicyNamedPortUnresolved, unresolvedNamedPortsError(rule))
 	}
 	return nil
 }
 
+// setFQDNResolutionFailure reports the FQDN resolution error of a rule to the statusManager, or clears it if err is nil.
+func (c *Controller) setFQDNResolutionFailure(ruleID string, err error) {
+	obj, exists, _ := c.ruleCache.rules.GetByKey(ruleID)
+	if !exists {
+		return
+	}
+	rule := obj.(*rule)
+	if rule.SourceRef.Type == v1beta2.K8sNetworkPolicy {
+		return
+	}
+	c.statusManager.SetRuleFailure(ruleID, rule.PolicyUID, v1beta2.NetworkPolicyFQDNResolutionFailed, err)
+}
+
 // syncRules calls the reconciler to sync all the rules after watchers complete full sync.
 // After flows for those init events are installed, subsequent rules will be handled asynchronously
 // by the syncRule() function.
@@ -649,6 +687,7 @@ func (c *Controller) syncRules(keys []string) error {
 		for _, rule := range allRules {
 			if rule.SourceRef.Type != v1beta2.K8sNetworkPolicy {
 				c.statusManager.SetRuleRealization(rule.ID, rule.PolicyUID)
+				c.statusManager.SetRuleFailure(rule.ID, rule.PolicyUID, v1beta2.NetworkPolicyNamedPortUnresolved, unresolvedNamedPortsError(rule))
 			}
 		}
 	}
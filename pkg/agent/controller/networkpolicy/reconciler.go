@@ -164,7 +164,7 @@ type lastRealized struct {
 	fqdnIPAddresses sets.String
 	// groupIDAddresses tracks the last realized set of groupIDs resolved for
 	// the toServices of this policy rule. It must be empty for policy rule
-	// that is not egress and does not have toServices field.
+	// that does not have toServices field.
 	groupIDAddresses sets.Int64
 	// groupAddresses track the latest realized set of multicast groups for the multicast traffic
 	groupAddresses sets.String
@@ -534,6 +534,7 @@ func (r *reconciler) computeOFRulesForAdd(rule *CompletedRule, ofPriority *uint1
 			TableID:       table,
 			PolicyRef:     rule.SourceRef,
 			EnableLogging: rule.EnableLogging,
+			RateLimit:     rule.RateLimit,
 		}
 		return ofRuleByServicesMap, lastRealized
 	} else if isIGMP {
@@ -545,13 +546,23 @@ func (r *reconciler) computeOFRulesForAdd(rule *CompletedRule, ofPriority *uint1
 		from1 := groupMembersToOFAddresses(rule.FromAddresses)
 		// Get addresses that in From IPBlock but not in Except IPBlocks.
 		from2 := ipBlocksToOFAddresses(rule.From.IPBlocks, r.ipv4Enabled, r.ipv6Enabled)
+		from := append(from1, from2...)
+		if len(rule.To.ToServices) > 0 {
+			// The traffic arriving through the Services is matched by the IDs
+			// of their groups, which are loaded by AntreaProxy when it
+			// load-balances the traffic.
+			groupIDs := r.getServiceGroupIDs(rule.To.ToServices)
+			from = append(from, serviceGroupIDsToOFAddresses(groupIDs)...)
+			// If the rule installation fails, this will be reset.
+			lastRealized.groupIDAddresses = groupIDs
+		}
 		membersByServicesMap, servicesMap := groupMembersByServices(rule.Services, rule.TargetMembers)
 		for svcKey, members := range membersByServicesMap {
 			ofPorts := r.getOFPorts(members)
 			lastRealized.podOFPorts[svcKey] = ofPorts
 			ofRuleByServicesMap[svcKey] = &types.PolicyRule{
 				Direction:     v1beta2.DirectionIn,
-				From:          append(from1, from2...),
+				From:          from,
 				To:            ofPortsToOFAddresses(ofPorts),
 				Service:       filterUnresolvablePort(servicesMap[svcKey]),
 				Action:        rule.Action,
@@ -560,6 +571,7 @@ func (r *reconciler) computeOFRulesForAdd(rule *CompletedRule, ofPriority *uint1
 				TableID:       table,
 				PolicyRef:     rule.SourceRef,
 				EnableLogging: rule.EnableLogging,
+				RateLimit:     rule.RateLimit,
 			}
 		}
 	} else {
@@ -588,6 +600,7 @@ func (r *reconciler) computeOFRulesForAdd(rule *CompletedRule, ofPriority *uint1
 				TableID:       table,
 				PolicyRef:     rule.SourceRef,
 				EnableLogging: rule.EnableLogging,
+				RateLimit:     rule.RateLimit,
 			}
 		}
 
@@ -612,6 +625,7 @@ func (r *reconciler) computeOFRulesForAdd(rule *CompletedRule, ofPriority *uint1
 					TableID:       table,
 					PolicyRef:     rule.SourceRef,
 					EnableLogging: rule.EnableLogging,
+					RateLimit:     rule.RateLimit,
 				}
 				ofRuleByServicesMap[svcKey] = ofRule
 			}
@@ -633,19 +647,10 @@ func (r *reconciler) computeOFRulesForAdd(rule *CompletedRule, ofPriority *uint1
 				lastRealized.fqdnIPAddresses = addressSet
 			}
 			if len(rule.To.ToServices) > 0 {
-				var addresses []types.Address
-				addressSet := sets.NewInt64()
-				for _, svcRef := range rule.To.ToServices {
-					for _, groupCounter := range r.groupCounters {
-						for _, groupID := range groupCounter.GetAllGroupIDs(k8s.NamespacedName(svcRef.Namespace, svcRef.Name)) {
-							addresses = append(addresses, openflow.NewServiceGroupIDAddress(groupID))
-							addressSet.Insert(int64(groupID))
-						}
-					}
-				}
-				ofRule.To = append(ofRule.To, addresses...)
+				groupIDs := r.getServiceGroupIDs(rule.To.ToServices)
+				ofRule.To = append(ofRule.To, serviceGroupIDsToOFAddresses(groupIDs)...)
 				// If the rule installation fails, this will be reset.
-				lastRealized.groupIDAddresses = addressSet
+				lastRealized.groupIDAddresses = groupIDs
 			}
 		}
 	}
@@ -717,6 +722,7 @@ func (r *reconciler) update(lastRealized *lastRealized, newRule *CompletedRule,
 				TableID:       table,
 				PolicyRef:     newRule.SourceRef,
 				EnableLogging: newRule.EnableLogging,
+				RateLimit:     newRule.RateLimit,
 			}
 			err := r.idAllocator.allocateForRule(ofRule)
 			if err != nil {
@@ -746,6 +752,18 @@ func (r *reconciler) update(lastRealized *lastRealized, newRule *CompletedRule,
 		from2 := ipBlocksToOFAddresses(newRule.From.IPBlocks, r.ipv4Enabled, r.ipv6Enabled)
 		addedFrom := ipsToOFAddresses(newRule.FromAddresses.IPDifference(lastRealized.FromAddresses))
 		deletedFrom := ipsToOFAddresses(lastRealized.FromAddresses.IPDifference(newRule.FromAddresses))
+		from := append(from1, from2...)
+		newGroupIDAddressSet := sets.NewInt64()
+		if len(newRule.To.ToServices) > 0 {
+			originalGroupIDAddressSet := sets.NewInt64()
+			if lastRealized.groupIDAddresses != nil {
+				originalGroupIDAddressSet = lastRealized.groupIDAddresses
+			}
+			newGroupIDAddressSet = r.getServiceGroupIDs(newRule.To.ToServices)
+			from = append(from, serviceGroupIDsToOFAddresses(newGroupIDAddressSet)...)
+			addedFrom = append(addedFrom, serviceGroupIDsToOFAddresses(newGroupIDAddressSet.Difference(originalGroupIDAddressSet))...)
+			deletedFrom = append(deletedFrom, serviceGroupIDsToOFAddresses(originalGroupIDAddressSet.Difference(newGroupIDAddressSet))...)
+		}
 
 		membersByServicesMap, servicesMap := groupMembersByServices(newRule.Services, newRule.TargetMembers)
 		for svcKey, members := range membersByServicesMap {
@@ -755,7 +773,7 @@ func (r *reconciler) update(lastRealized *lastRealized, newRule *CompletedRule,
 			if !exists {
 				ofRule := &types.PolicyRule{
 					Direction:     v1beta2.DirectionIn,
-					From:          append(from1, from2...),
+					From:          from,
 					To:            ofPortsToOFAddresses(newOFPorts),
 					Service:       filterUnresolvablePort(servicesMap[svcKey]),
 					Action:        newRule.Action,
@@ -764,6 +782,7 @@ func (r *reconciler) update(lastRealized *lastRealized, newRule *CompletedRule,
 					TableID:       table,
 					PolicyRef:     newRule.SourceRef,
 					EnableLogging: newRule.EnableLogging,
+					RateLimit:     newRule.RateLimit,
 				}
 				err := r.idAllocator.allocateForRule(ofRule)
 				if err != nil {
@@ -784,6 +803,8 @@ func (r *reconciler) update(lastRealized *lastRealized, newRule *CompletedRule,
 			}
 			lastRealized.podOFPorts[svcKey] = newOFPorts
 		}
+		// Update the groupID address set if rule installation succeeds.
+		lastRealized.groupIDAddresses = newGroupIDAddressSet
 	} else {
 		if r.fqdnController != nil && len(newRule.To.FQDNs) > 0 {
 			if err := r.fqdnController.addFQDNRule(newRule.ID, newRule.To.FQDNs, r.getOFPorts(newRule.TargetMembers)); err != nil {
@@ -818,6 +839,7 @@ func (r *reconciler) update(lastRealized *lastRealized, newRule *CompletedRule,
 					TableID:       table,
 					PolicyRef:     newRule.SourceRef,
 					EnableLogging: newRule.EnableLogging,
+					RateLimit:     newRule.RateLimit,
 				}
 				// If the PolicyRule for the original services doesn't exist and IPBlocks is present, it means the
 				// reconciler hasn't installed flows for IPBlocks, then it must be added to the new PolicyRule.
@@ -861,21 +883,9 @@ func (r *reconciler) update(lastRealized *lastRealized, newRule *CompletedRule,
 					originalGroupIDAddressSet = lastRealized.groupIDAddresses
 				}
 				if len(newRule.To.ToServices) > 0 {
-					for _, svcRef := range newRule.To.ToServices {
-						for _, groupCounter := range r.groupCounters {
-							for _, groupID := range groupCounter.GetAllGroupIDs(k8s.NamespacedName(svcRef.Namespace, svcRef.Name)) {
-								newGroupIDAddressSet.Insert(int64(groupID))
-							}
-						}
-					}
-					addedGroupIDAddress := newGroupIDAddressSet.Difference(originalGroupIDAddressSet)
-					removedGroupIDAddress := originalGroupIDAddressSet.Difference(newGroupIDAddressSet)
-					for a := range addedGroupIDAddress {
-						addedTo = append(addedTo, openflow.NewServiceGroupIDAddress(binding.GroupIDType(a)))
-					}
-					for r := range removedGroupIDAddress {
-						deletedTo = append(deletedTo, openflow.NewServiceGroupIDAddress(binding.GroupIDType(r)))
-					}
+					newGroupIDAddressSet = r.getServiceGroupIDs(newRule.To.ToServices)
+					addedTo = append(addedTo, serviceGroupIDsToOFAddresses(newGroupIDAddressSet.Difference(originalGroupIDAddressSet))...)
+					deletedTo = append(deletedTo, serviceGroupIDsToOFAddresses(originalGroupIDAddressSet.Difference(newGroupIDAddressSet))...)
 				}
 				if err := r.updateOFRule(ofID, addedFrom, addedTo, deletedFrom, deletedTo, ofPriority); err != nil {
 					return err
@@ -1154,6 +1164,29 @@ func isIPNetSupportedByAF(ipnet *net.IPNet, ipv4Enabled, ipv6Enabled bool) bool
 	return false
 }
 
+// getServiceGroupIDs returns the IDs of the groups installed by AntreaProxy for
+// the provided Services, including the groups of both IP families and the
+// groups used for externalTrafficPolicy Local.
+func (r *reconciler) getServiceGroupIDs(svcRefs []v1beta2.ServiceReference) sets.Int64 {
+	groupIDs := sets.NewInt64()
+	for _, svcRef := range svcRefs {
+		for _, groupCounter := range r.groupCounters {
+			for _, groupID := range groupCounter.GetAllGroupIDs(k8s.NamespacedName(svcRef.Namespace, svcRef.Name)) {
+				groupIDs.Insert(int64(groupID))
+			}
+		}
+	}
+	return groupIDs
+}
+
+func serviceGroupIDsToOFAddresses(groupIDs sets.Int64) []types.Address {
+	addresses := make([]types.Address, 0, len(groupIDs))
+	for _, groupID := range groupIDs.List() {
+		addresses = append(addresses, openflow.NewServiceGroupIDAddress(binding.GroupIDType(groupID)))
+	}
+	return addresses
+}
+
 func ipsToOFAddresses(ips sets.String) []types.Address {
 	// Must not return nil as it means not restricted by addresses in Openflow implementation.
 	from := make([]types.Address, 0, len(ips))
@@ -1186,6 +1219,44 @@ func filterUnresolvablePort(in []v1beta2.Service) []v1beta2.Service {
 	return out
 }
 
+// unresolvedNamedPortsError returns an error listing the named ports of the provided rule which can't be resolved
+// for any of the members they apply to, i.e. the target members of ingress rules and the destination members of
+// egress rules. It returns nil if all named ports can be resolved or if there is no member to resolve them for.
+func unresolvedNamedPortsError(rule *CompletedRule) error {
+	members := rule.TargetMembers
+	if rule.Direction == v1beta2.DirectionOut {
+		members = rule.ToAddresses
+	}
+	if len(members) == 0 {
+		return nil
+	}
+	var unresolvedPorts []string
+	for _, service := range rule.Services {
+		if service.Port == nil || service.Port.Type == intstr.Int {
+			continue
+		}
+		resolved := false
+		for _, member := range members {
+			for _, port := range member.Ports {
+				if port.Name == service.Port.StrVal && port.Protocol == *service.Protocol {
+					resolved = true
+					break
+				}
+			}
+			if resolved {
+				break
+			}
+		}
+		if !resolved {
+			unresolvedPorts = append(unresolvedPorts, fmt.Sprintf("%s/%s", *service.Protocol, service.Port.StrVal))
+		}
+	}
+	if len(unresolvedPorts) == 0 {
+		return nil
+	}
+	return fmt.Errorf("named port(s) %s cannot be resolved for any selected endpoint", strings.Join(unresolvedPorts, ", "))
+}
+
 // resolveService resolves the port name of the provided service to a port number for the provided groupMember.
 // This function should eventually supersede resolveServiceForPod.
 func resolveService(service *v1beta2.Service, member *v1beta2.GroupMember) *v1beta2.Service {
@@ -23,6 +23,8 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
 
@@ -33,6 +35,8 @@ import (
 	"antrea.io/antrea/pkg/agent/types"
 	"antrea.io/antrea/pkg/agent/util"
 	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	binding "antrea.io/antrea/pkg/ovs/openflow"
+	k8sproxy "antrea.io/antrea/third_party/proxy"
 )
 
 var (
@@ -1032,6 +1036,87 @@ func TestReconcilerUpdate(t *testing.T) {
 	}
 }
 
+func TestReconcilerReconcileToServices(t *testing.T) {
+	ifaceStore := interfacestore.NewInterfaceStore()
+	ifaceStore.AddInterface(&interfacestore.InterfaceConfig{
+		InterfaceName:            util.GenerateContainerInterfaceName("pod1", "ns1", "container1"),
+		IPs:                      []net.IP{net.ParseIP("2.2.2.2")},
+		ContainerInterfaceConfig: &interfacestore.ContainerInterfaceConfig{PodName: "pod1", PodNamespace: "ns1", ContainerID: "container1"},
+		OVSPortConfig:            &interfacestore.OVSPortConfig{OFPort: 1},
+	})
+	svcPortName := k8sproxy.ServicePortName{
+		NamespacedName: k8stypes.NamespacedName{Namespace: "ns1", Name: "svc1"},
+		Port:           "http",
+		Protocol:       corev1.ProtocolTCP,
+	}
+	toServices := []v1beta2.ServiceReference{{Namespace: "ns1", Name: "svc1"}}
+	tests := []struct {
+		name             string
+		rule             *CompletedRule
+		expectedOFRule   func(groupID binding.GroupIDType) *types.PolicyRule
+		expectedAddrType types.AddressType
+	}{
+		{
+			name: "egress-rule",
+			rule: &CompletedRule{
+				rule:          &rule{ID: "egress-rule", Direction: v1beta2.DirectionOut, To: v1beta2.NetworkPolicyPeer{ToServices: toServices}, PolicyPriority: &policyPriority, TierPriority: &tierPriority, SourceRef: &cnp1},
+				TargetMembers: appliedToGroup1,
+			},
+			expectedOFRule: func(groupID binding.GroupIDType) *types.PolicyRule {
+				return &types.PolicyRule{
+					Direction: v1beta2.DirectionOut,
+					From:      ipsToOFAddresses(sets.NewString("2.2.2.2")),
+					To:        []types.Address{openflow.NewServiceGroupIDAddress(groupID)},
+					PolicyRef: &cnp1,
+				}
+			},
+			expectedAddrType: types.DstAddress,
+		},
+		{
+			name: "ingress-rule",
+			rule: &CompletedRule{
+				rule:          &rule{ID: "ingress-rule", Direction: v1beta2.DirectionIn, To: v1beta2.NetworkPolicyPeer{ToServices: toServices}, PolicyPriority: &policyPriority, TierPriority: &tierPriority, SourceRef: &cnp1},
+				TargetMembers: appliedToGroup1,
+			},
+			expectedOFRule: func(groupID binding.GroupIDType) *types.PolicyRule {
+				return &types.PolicyRule{
+					Direction: v1beta2.DirectionIn,
+					From:      []types.Address{openflow.NewServiceGroupIDAddress(groupID)},
+					To:        ofPortsToOFAddresses(sets.NewInt32(1)),
+					PolicyRef: &cnp1,
+				}
+			},
+			expectedAddrType: types.SrcAddress,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+			mockOFClient := openflowtest.NewMockClient(controller)
+			r := newTestReconciler(t, controller, ifaceStore, mockOFClient, true, false)
+			clusterGroupID := r.groupCounters[0].AllocateIfNotExist(svcPortName, false)
+			mockOFClient.EXPECT().InstallPolicyRuleFlows(newPolicyRulesMatcher(tt.expectedOFRule(clusterGroupID)))
+			if err := r.Reconcile(tt.rule); err != nil {
+				t.Fatalf("Reconcile() error = %v", err)
+			}
+			// The group for externalTrafficPolicy Local is allocated when the
+			// Service has local Endpoints, it must be matched by the rule too.
+			localGroupID := r.groupCounters[0].AllocateIfNotExist(svcPortName, true)
+			mockOFClient.EXPECT().AddPolicyRuleAddress(gomock.Any(), tt.expectedAddrType, []types.Address{openflow.NewServiceGroupIDAddress(localGroupID)}, gomock.Any())
+			if err := r.Reconcile(tt.rule); err != nil {
+				t.Fatalf("Reconcile() error = %v", err)
+			}
+			// The group is released when the Service has no local Endpoints.
+			r.groupCounters[0].Recycle(svcPortName, true)
+			mockOFClient.EXPECT().DeletePolicyRuleAddress(gomock.Any(), tt.expectedAddrType, []types.Address{openflow.NewServiceGroupIDAddress(localGroupID)}, gomock.Any())
+			if err := r.Reconcile(tt.rule); err != nil {
+				t.Fatalf("Reconcile() error = %v", err)
+			}
+		})
+	}
+}
+
 func TestGroupMembersByServices(t *testing.T) {
 	numberedServices := []v1beta2.Service{serviceTCP80, serviceTCP443}
 	numberedServicesKey := normalizeServices(numberedServices)
@@ -1147,6 +1232,70 @@ func TestGroupMembersByServices(t *testing.T) {
 	}
 }
 
+func TestUnresolvedNamedPortsError(t *testing.T) {
+	httpMember := &v1beta2.GroupMember{
+		IPs:   []v1beta2.IPAddress{v1beta2.IPAddress(net.ParseIP("1.1.1.1"))},
+		Ports: []v1beta2.NamedPort{{Port: 80, Name: "http", Protocol: protocolTCP}},
+	}
+	noPortMember := &v1beta2.GroupMember{
+		IPs: []v1beta2.IPAddress{v1beta2.IPAddress(net.ParseIP("1.1.1.2"))},
+	}
+	tests := []struct {
+		name        string
+		rule        *CompletedRule
+		expectedErr string
+	}{
+		{
+			name: "numbered ports",
+			rule: &CompletedRule{
+				rule:          &rule{Direction: v1beta2.DirectionIn, Services: []v1beta2.Service{serviceTCP80}},
+				TargetMembers: v1beta2.NewGroupMemberSet(noPortMember),
+			},
+		},
+		{
+			name: "named port resolved by one member",
+			rule: &CompletedRule{
+				rule:          &rule{Direction: v1beta2.DirectionIn, Services: []v1beta2.Service{serviceHTTP}},
+				TargetMembers: v1beta2.NewGroupMemberSet(httpMember, noPortMember),
+			},
+		},
+		{
+			name: "named port not resolved by target members",
+			rule: &CompletedRule{
+				rule:          &rule{Direction: v1beta2.DirectionIn, Services: []v1beta2.Service{serviceHTTP, serviceHTTPS}},
+				TargetMembers: v1beta2.NewGroupMemberSet(httpMember, noPortMember),
+			},
+			expectedErr: "named port(s) TCP/https cannot be resolved for any selected endpoint",
+		},
+		{
+			name: "named port not resolved by destination members",
+			rule: &CompletedRule{
+				rule:          &rule{Direction: v1beta2.DirectionOut, Services: []v1beta2.Service{serviceHTTP, serviceHTTPS}},
+				TargetMembers: v1beta2.NewGroupMemberSet(httpMember),
+				ToAddresses:   v1beta2.NewGroupMemberSet(noPortMember),
+			},
+			expectedErr: "named port(s) TCP/http, TCP/https cannot be resolved for any selected endpoint",
+		},
+		{
+			name: "no members",
+			rule: &CompletedRule{
+				rule:          &rule{Direction: v1beta2.DirectionIn, Services: []v1beta2.Service{serviceHTTPS}},
+				TargetMembers: v1beta2.NewGroupMemberSet(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := unresolvedNamedPortsError(tt.rule)
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
+
 func TestReconcilerReconcileIPv6Only(t *testing.T) {
 	ifaceStore := interfacestore.NewInterfaceStore()
 	ifaceStore.AddInterface(&interfacestore.InterfaceConfig{
@@ -17,6 +17,9 @@ package networkpolicy
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -40,10 +43,15 @@ const (
 // realized and all of its undesired rules have been removed.
 // For each new policy, SetRuleRealization is supposed to be called for each of its desired rules while
 // DeleteRuleRealization is supposed to be called for the removed rules.
+// If a rule fails to be realized, SetRuleFailure is supposed to be called with the reason of the failure, and the
+// failure is reported to the antrea-controller along with the status of the policy.
 type StatusManager interface {
 	// SetRuleRealization updates the actual status for the given NetworkPolicy rule.
 	SetRuleRealization(ruleID string, policyID types.UID)
-	// DeleteRuleRealization deletes the actual status for the given NetworkPolicy rule.
+	// SetRuleFailure sets the failure of the given reason for the given NetworkPolicy rule if err is not nil, and
+	// clears it otherwise.
+	SetRuleFailure(ruleID string, policyID types.UID, reason string, err error)
+	// DeleteRuleRealization deletes the actual status and the failures for the given NetworkPolicy rule.
 	DeleteRuleRealization(ruleID string)
 	// Resync triggers syncing status with the antrea-controller for the given NetworkPolicy.
 	Resync(policyID types.UID)
@@ -60,6 +68,9 @@ type StatusController struct {
 	ruleCache *ruleCache
 	// realizedRules keeps track of the realized NetworkPolicy rules.
 	realizedRules cache.Indexer
+	// failedRules keeps track of the failures of NetworkPolicy rules, keyed by rule ID.
+	failedRules      map[string]*ruleFailure
+	failedRulesMutex sync.RWMutex
 	// queue maintains the UIDs of the NetworkPolicy that need to be processed.
 	queue workqueue.RateLimitingInterface
 }
@@ -73,6 +84,13 @@ type realizedRule struct {
 	policyID types.UID
 }
 
+// ruleFailure is the struct kept by StatusController for storing the failures of a rule.
+type ruleFailure struct {
+	policyID types.UID
+	// messages maps the reasons of the failures to their messages.
+	messages map[string]string
+}
+
 func realizedRuleKeyFunc(obj interface{}) (string, error) {
 	return obj.(*realizedRule).ruleID, nil
 }
@@ -90,7 +108,8 @@ func newStatusController(antreaClientProvider agent.AntreaClientProvider, nodeNa
 		realizedRules: cache.NewIndexer(realizedRuleKeyFunc, cache.Indexers{
 			realizedRulePolicyIndex: realizedRulePolicyIndexFunc,
 		}),
-		queue: workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(minRetryDelay, maxRetryDelay), "networkpolicystatus"),
+		failedRules: map[string]*ruleFailure{},
+		queue:       workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(minRetryDelay, maxRetryDelay), "networkpolicystatus"),
 	}
 }
 
@@ -105,7 +124,45 @@ func (c *StatusController) SetRuleRealization(ruleID string, policyID types.UID)
 	c.queue.Add(policyID)
 }
 
+func (c *StatusController) SetRuleFailure(ruleID string, policyID types.UID, reason string, err error) {
+	c.failedRulesMutex.Lock()
+	defer c.failedRulesMutex.Unlock()
+	failure, exists := c.failedRules[ruleID]
+	if err == nil {
+		if !exists {
+			return
+		}
+		if _, exists := failure.messages[reason]; !exists {
+			return
+		}
+		delete(failure.messages, reason)
+		if len(failure.messages) == 0 {
+			delete(c.failedRules, ruleID)
+		}
+		c.queue.Add(failure.policyID)
+		return
+	}
+	if !exists {
+		failure = &ruleFailure{policyID: policyID, messages: map[string]string{}}
+		c.failedRules[ruleID] = failure
+	}
+	// The failure has been reported before, no need to sync the status again.
+	if message, exists := failure.messages[reason]; exists && message == err.Error() {
+		return
+	}
+	failure.messages[reason] = err.Error()
+	c.queue.Add(policyID)
+}
+
 func (c *StatusController) DeleteRuleRealization(ruleID string) {
+	c.failedRulesMutex.Lock()
+	failure, failed := c.failedRules[ruleID]
+	delete(c.failedRules, ruleID)
+	c.failedRulesMutex.Unlock()
+	if failed {
+		c.queue.Add(failure.policyID)
+	}
+
 	obj, exists, _ := c.realizedRules.GetByKey(ruleID)
 	// This rule hasn't been realized before, so it doesn't affect the policy's realization status.
 	if !exists {
@@ -115,6 +172,24 @@ func (c *StatusController) DeleteRuleRealization(ruleID string) {
 	c.queue.Add(obj.(*realizedRule).policyID)
 }
 
+// getRuleFailures returns the failures of the given rule sorted by reason.
+func (c *StatusController) getRuleFailures(ruleID string) (reasons []string, messages []string) {
+	c.failedRulesMutex.RLock()
+	defer c.failedRulesMutex.RUnlock()
+	failure, exists := c.failedRules[ruleID]
+	if !exists {
+		return nil, nil
+	}
+	for reason := range failure.messages {
+		reasons = append(reasons, reason)
+	}
+	sort.Strings(reasons)
+	for _, reason := range reasons {
+		messages = append(messages, failure.messages[reason])
+	}
+	return reasons, messages
+}
+
 func (c *StatusController) Resync(policyID types.UID) {
 	klog.V(2).Infof("Resyncing NetworkPolicyStatus for %s", policyID)
 	c.queue.Add(policyID)
@@ -170,26 +245,46 @@ func (c *StatusController) syncHandler(uid types.UID) error {
 		return nil
 	}
 	actualRules, _ := c.realizedRules.ByIndex(realizedRulePolicyIndex, string(uid))
-	// desiredRules should match actualRules exactly.
-	if len(desiredRules) != len(actualRules) {
-		return nil
-	}
+	// actualRules should be a subset of desiredRules, i.e. all undesired rules have been removed.
 	desiredRuleSet := sets.NewString()
 	for _, r := range desiredRules {
 		desiredRuleSet.Insert(r.ID)
 	}
+	actualRuleSet := sets.NewString()
 	for _, r := range actualRules {
 		ruleID := r.(*realizedRule).ruleID
 		if !desiredRuleSet.Has(ruleID) {
 			return nil
 		}
-		desiredRuleSet.Delete(ruleID)
+		actualRuleSet.Insert(ruleID)
 	}
-	if len(desiredRuleSet) > 0 {
-		return nil
+	// Each desired rule should have been either realized or failed.
+	sort.Slice(desiredRules, func(i, j int) bool {
+		return desiredRules[i].ID < desiredRules[j].ID
+	})
+	var failureReason string
+	var failureMessages []string
+	for _, r := range desiredRules {
+		reasons, messages := c.getRuleFailures(r.ID)
+		if len(reasons) == 0 {
+			if !actualRuleSet.Has(r.ID) {
+				return nil
+			}
+			continue
+		}
+		if failureReason == "" {
+			failureReason = reasons[0]
+		}
+		ruleName := r.Name
+		if ruleName == "" {
+			ruleName = r.ID
+		}
+		for _, message := range messages {
+			failureMessages = append(failureMessages, fmt.Sprintf("%s: %s", ruleName, message))
+		}
 	}
 
-	// At this point, all desired rules have been realized and all undesired rules have been removed, report it to the antrea-controller.
+	// At this point, all desired rules have been processed and all undesired rules have been removed, report it to the antrea-controller.
 	klog.V(2).Infof("Syncing NetworkPolicyStatus for %s, generation: %v", uid, policy.Generation)
 	status := &v1beta2.NetworkPolicyStatus{
 		ObjectMeta: metav1.ObjectMeta{
@@ -199,6 +294,8 @@ func (c *StatusController) syncHandler(uid types.UID) error {
 			{
 				NodeName:   c.nodeName,
 				Generation: policy.Generation,
+				Reason:     failureReason,
+				Message:    strings.Join(failureMessages, "; "),
 			},
 		},
 	}
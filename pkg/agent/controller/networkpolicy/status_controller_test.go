@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 
@@ -176,6 +177,54 @@ func TestSyncStatusUpForUpdatedPolicy(t *testing.T) {
 	assert.NoError(t, matchGeneration(policy.Generation), "The generation should be updated to %v but was not updated", policy.Generation)
 }
 
+func TestSyncStatusWithRuleFailures(t *testing.T) {
+	statusController, ruleCache, statusControl := newTestStatusController()
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go statusController.Run(stopCh)
+
+	ruleCache.AddAppliedToGroup(newAppliedToGroup("appliedToGroup1", []v1beta2.GroupMember{*newAppliedToGroupMember("pod1", "ns1")}))
+	policy := newNetworkPolicyWithMultipleRules("policy1", "uid1", []string{"addressGroup1"}, []string{}, []string{"appliedToGroup1"}, nil)
+	policy.Generation = 1
+	ruleCache.AddNetworkPolicy(policy)
+	rules := ruleCache.getEffectiveRulesByNetworkPolicy(string(policy.UID))
+	require.Len(t, rules, 2)
+	rule1, rule2 := rules[0], rules[1]
+	if rule1.ID > rule2.ID {
+		rule1, rule2 = rule2, rule1
+	}
+
+	matchNodeStatus := func(expected v1beta2.NetworkPolicyNodeStatus) error {
+		return wait.PollImmediate(100*time.Millisecond, 1*time.Second, func() (done bool, err error) {
+			status := statusControl.getNetworkPolicyStatus()
+			if status == nil {
+				return false, nil
+			}
+			return status.Nodes[0] == expected, nil
+		})
+	}
+
+	// A rule that failed to be installed and a rule with unresolved named ports should both be reported.
+	statusController.SetRuleFailure(rule1.ID, policy.UID, v1beta2.NetworkPolicyFlowInstallationFailed, fmt.Errorf("error adding flows"))
+	statusController.SetRuleRealization(rule2.ID, policy.UID)
+	statusController.SetRuleFailure(rule2.ID, policy.UID, v1beta2.NetworkPolicyNamedPortUnresolved, fmt.Errorf("named port(s) TCP/http cannot be resolved"))
+	assert.NoError(t, matchNodeStatus(v1beta2.NetworkPolicyNodeStatus{
+		NodeName:   testNode1,
+		Generation: 1,
+		Reason:     v1beta2.NetworkPolicyFlowInstallationFailed,
+		Message:    fmt.Sprintf("%s: error adding flows; %s: named port(s) TCP/http cannot be resolved", rule1.ID, rule2.ID),
+	}), "The failures of the rules should be reported")
+
+	// The failures should be cleared once the rules are realized.
+	statusController.SetRuleFailure(rule1.ID, policy.UID, v1beta2.NetworkPolicyFlowInstallationFailed, nil)
+	statusController.SetRuleRealization(rule1.ID, policy.UID)
+	statusController.SetRuleFailure(rule2.ID, policy.UID, v1beta2.NetworkPolicyNamedPortUnresolved, nil)
+	assert.NoError(t, matchNodeStatus(v1beta2.NetworkPolicyNodeStatus{
+		NodeName:   testNode1,
+		Generation: 1,
+	}), "The failures of the rules should be cleared")
+}
+
 // BenchmarkSyncHandler benchmarks syncHandler when the policy has 100 rules. Its current result is:
 // 47754 ns/op           15320 B/op         23 allocs/op
 func BenchmarkSyncHandler(b *testing.B) {
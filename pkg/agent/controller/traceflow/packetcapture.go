@@ -0,0 +1,617 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traceflow
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"antrea.io/libOpenflow/protocol"
+	"antrea.io/ofnet/ofctrl"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/interfacestore"
+	"antrea.io/antrea/pkg/agent/openflow"
+	"antrea.io/antrea/pkg/agent/util"
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	crdinformers "antrea.io/antrea/pkg/client/informers/externalversions/crd/v1alpha1"
+	crdlisters "antrea.io/antrea/pkg/client/listers/crd/v1alpha1"
+	binding "antrea.io/antrea/pkg/ovs/openflow"
+	"antrea.io/antrea/pkg/util/pcapng"
+)
+
+const (
+	// PacketCaptureDir is the directory in which the pcapng files of the PacketCaptures are
+	// stored on the Node.
+	PacketCaptureDir = "/tmp/antrea/packetcapture"
+	// PacketCaptureFileExtension is the extension of the pcapng files of the PacketCaptures.
+	PacketCaptureFileExtension = ".pcapng"
+)
+
+type packetCaptureState struct {
+	name   string
+	tag    uint8
+	number int32
+	// numCapturedPackets is the number of packets written to the file.
+	numCapturedPackets int32
+	filePath           string
+	file               *os.File
+	writer             *pcapng.Writer
+	timer              *time.Timer
+	// completed is set when the capture stops, until the PacketCapture leaves the Running
+	// phase, so that the capture is not started again from a stale informer event.
+	completed bool
+}
+
+type packetCaptureController struct {
+	informer     crdinformers.PacketCaptureInformer
+	lister       crdlisters.PacketCaptureLister
+	listerSynced cache.InformerSynced
+	queue        workqueue.RateLimitingInterface
+	mutex        sync.Mutex
+	// runningPacketCaptures is a map for storing the running PacketCapture state with
+	// dataplane tag to be the key.
+	runningPacketCaptures map[uint8]*packetCaptureState
+}
+
+// PacketCaptureFilePath returns the path of the pcapng file of the PacketCapture on the Node.
+func PacketCaptureFilePath(name string) string {
+	return filepath.Join(PacketCaptureDir, name+PacketCaptureFileExtension)
+}
+
+func (c *Controller) initPacketCapture(packetCaptureInformer crdinformers.PacketCaptureInformer) {
+	c.packetCapture = &packetCaptureController{
+		informer:              packetCaptureInformer,
+		lister:                packetCaptureInformer.Lister(),
+		listerSynced:          packetCaptureInformer.Informer().HasSynced,
+		queue:                 workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(minRetryDelay, maxRetryDelay), "packetcapture"),
+		runningPacketCaptures: make(map[uint8]*packetCaptureState),
+	}
+	packetCaptureInformer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.addPacketCapture,
+			UpdateFunc: c.updatePacketCapture,
+			DeleteFunc: c.deletePacketCapture,
+		},
+		resyncPeriod,
+	)
+}
+
+func (c *Controller) runPacketCapture(stopCh <-chan struct{}) {
+	defer c.packetCapture.queue.ShutDown()
+
+	if err := c.removeStalePacketCaptureFiles(); err != nil {
+		klog.ErrorS(err, "Failed to remove stale PacketCapture files")
+	}
+	for i := 0; i < defaultWorkers; i++ {
+		go wait.Until(c.packetCaptureWorker, time.Second, stopCh)
+	}
+	<-stopCh
+}
+
+// removeStalePacketCaptureFiles removes the files of the PacketCaptures which have been deleted
+// while the Agent was not running.
+func (c *Controller) removeStalePacketCaptureFiles() error {
+	entries, err := os.ReadDir(PacketCaptureDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	for _, entry := range entries {
+		name := strings.TrimSuffix(entry.Name(), PacketCaptureFileExtension)
+		if entry.IsDir() || name == entry.Name() {
+			continue
+		}
+		if _, err := c.packetCapture.lister.Get(name); err != nil && apierrors.IsNotFound(err) {
+			klog.InfoS("Removing stale PacketCapture file", "file", entry.Name())
+			if err := os.Remove(filepath.Join(PacketCaptureDir, entry.Name())); err != nil {
+				klog.ErrorS(err, "Failed to remove stale PacketCapture file", "file", entry.Name())
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Controller) addPacketCapture(obj interface{}) {
+	pc := obj.(*crdv1alpha1.PacketCapture)
+	klog.InfoS("Processing PacketCapture ADD event", "PacketCapture", klog.KObj(pc))
+	c.packetCapture.queue.Add(pc.Name)
+}
+
+func (c *Controller) updatePacketCapture(_, curObj interface{}) {
+	pc := curObj.(*crdv1alpha1.PacketCapture)
+	klog.InfoS("Processing PacketCapture UPDATE event", "PacketCapture", klog.KObj(pc))
+	c.packetCapture.queue.Add(pc.Name)
+}
+
+func (c *Controller) deletePacketCapture(old interface{}) {
+	pc, ok := old.(*crdv1alpha1.PacketCapture)
+	if !ok {
+		tombstone, ok := old.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			klog.Errorf("Error decoding object when deleting PacketCapture, invalid type: %v", old)
+			return
+		}
+		pc, ok = tombstone.Obj.(*crdv1alpha1.PacketCapture)
+		if !ok {
+			klog.Errorf("Error decoding object tombstone when deleting PacketCapture, invalid type: %v", tombstone.Obj)
+			return
+		}
+	}
+	klog.InfoS("Processing PacketCapture DELETE event", "PacketCapture", klog.KObj(pc))
+	c.packetCapture.queue.Add(pc.Name)
+}
+
+func (c *Controller) packetCaptureWorker() {
+	for c.processPacketCaptureItem() {
+	}
+}
+
+func (c *Controller) processPacketCaptureItem() bool {
+	obj, quit := c.packetCapture.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.packetCapture.queue.Done(obj)
+
+	if key, ok := obj.(string); !ok {
+		c.packetCapture.queue.Forget(obj)
+		klog.Errorf("Expected string in work queue but got %#v", obj)
+	} else if err := c.syncPacketCapture(key); err == nil {
+		c.packetCapture.queue.Forget(key)
+	} else {
+		klog.ErrorS(err, "Error syncing PacketCapture", "PacketCapture", key)
+	}
+	return true
+}
+
+func (c *Controller) syncPacketCapture(name string) error {
+	startTime := time.Now()
+	defer func() {
+		klog.V(4).InfoS("Finished syncing PacketCapture", "PacketCapture", name, "duration", time.Since(startTime))
+	}()
+
+	pc, err := c.packetCapture.lister.Get(name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			c.cleanupPacketCapture(name)
+			if err := os.Remove(PacketCaptureFilePath(name)); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			return nil
+		}
+		return err
+	}
+
+	switch pc.Status.Phase {
+	case crdv1alpha1.PacketCaptureRunning:
+		if pc.Status.DataplaneTag == 0 {
+			klog.InfoS("Invalid data plane tag for PacketCapture", "PacketCapture", klog.KObj(pc), "tag", pc.Status.DataplaneTag)
+			return nil
+		}
+		c.packetCapture.mutex.Lock()
+		pcState, exists := c.packetCapture.runningPacketCaptures[pc.Status.DataplaneTag]
+		started := exists && pcState.name == pc.Name
+		c.packetCapture.mutex.Unlock()
+		if !started {
+			err = c.startPacketCapture(pc)
+		}
+	default:
+		c.cleanupPacketCapture(name)
+	}
+	return err
+}
+
+// startPacketCapture creates the pcapng file and installs the OVS flows for the PacketCapture,
+// if the Pod whose packets are to be captured runs on the local Node.
+func (c *Controller) startPacketCapture(pc *crdv1alpha1.PacketCapture) error {
+	receiverOnly := pc.Spec.Source.Pod == ""
+	podName, podNamespace := pc.Spec.Source.Pod, pc.Spec.Source.Namespace
+	if receiverOnly {
+		podName, podNamespace = pc.Spec.Destination.Pod, pc.Spec.Destination.Namespace
+	}
+	podInterfaces := c.interfaceStore.GetContainerInterfacesByPod(podName, podNamespace)
+	if len(podInterfaces) == 0 {
+		// The packets are captured on another Node.
+		return nil
+	}
+
+	var err error
+	var pcState *packetCaptureState
+	defer func() {
+		if err != nil {
+			c.cleanupPacketCapture(pc.Name)
+			c.errorPacketCaptureCRD(pc, fmt.Sprintf("Node: %s, error: %+v", c.nodeConfig.Name, err))
+		}
+	}()
+
+	packet, err := c.preparePacketCapturePacket(pc, podInterfaces[0], receiverOnly)
+	if err != nil {
+		return err
+	}
+	klog.V(2).InfoS("PacketCapture packet", "PacketCapture", klog.KObj(pc), "packet", *packet)
+
+	number := pc.Spec.Number
+	if number == 0 {
+		number = crdv1alpha1.DefaultPacketCaptureNumber
+	}
+	timeout := pc.Spec.Timeout
+	if timeout == 0 {
+		timeout = crdv1alpha1.DefaultPacketCaptureTimeout
+	}
+	// The capture must stop when the PacketCapture times out, even if the Agent has been
+	// restarted since the PacketCapture was started.
+	remaining := time.Duration(timeout) * time.Second
+	if pc.Status.StartTime != nil {
+		remaining = time.Until(pc.Status.StartTime.Add(remaining))
+		if remaining <= 0 {
+			err = errors.New("PacketCapture timed out before the capture could be started")
+			return err
+		}
+	}
+
+	if err = os.MkdirAll(PacketCaptureDir, 0700); err != nil {
+		return err
+	}
+	filePath := PacketCaptureFilePath(pc.Name)
+	// The captured packets may include sensitive data, so the file is only readable by the
+	// Agent.
+	file, err := os.OpenFile(filePath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	writer, err := pcapng.NewWriter(file, 0)
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	pcState = &packetCaptureState{
+		name:     pc.Name,
+		tag:      pc.Status.DataplaneTag,
+		number:   number,
+		filePath: filePath,
+		file:     file,
+		writer:   writer,
+	}
+	c.packetCapture.mutex.Lock()
+	// The tag of a completed PacketCapture can be reused, but the packets of two running
+	// PacketCaptures with the same tag cannot be told apart.
+	if running, ok := c.packetCapture.runningPacketCaptures[pcState.tag]; ok && !running.completed {
+		c.packetCapture.mutex.Unlock()
+		file.Close()
+		err = fmt.Errorf("data plane tag %d is used by running PacketCapture %s", pcState.tag, running.name)
+		return err
+	}
+	c.packetCapture.runningPacketCaptures[pcState.tag] = pcState
+	c.packetCapture.mutex.Unlock()
+
+	klog.V(2).InfoS("Installing flow entries for PacketCapture", "PacketCapture", klog.KObj(pc))
+	// The flows are given an extra second so that the capture timer always fires first.
+	flowTimeout := uint16(remaining.Seconds()) + 1
+	if err = c.ofClient.InstallPacketCaptureFlows(pcState.tag, packet, uint32(podInterfaces[0].OFPort), flowTimeout); err != nil {
+		return err
+	}
+
+	c.packetCapture.mutex.Lock()
+	pcState.timer = time.AfterFunc(remaining, func() {
+		c.completePacketCapture(pcState)
+	})
+	c.packetCapture.mutex.Unlock()
+	return nil
+}
+
+// preparePacketCapturePacket builds the packet matched by the PacketCapture flows, from the
+// perspective of the Pod whose packets are captured: DestinationIP is the IP of the peer and
+// SourcePort is the transport port on the Pod side.
+func (c *Controller) preparePacketCapturePacket(pc *crdv1alpha1.PacketCapture, intf *interfacestore.InterfaceConfig, receiverOnly bool) (*binding.Packet, error) {
+	packet := new(binding.Packet)
+	packet.IsIPv6 = pc.Spec.Packet.IPv6Header != nil
+
+	var peerIP net.IP
+	if receiverOnly {
+		if pc.Spec.Source.IP != "" {
+			peerIP = net.ParseIP(pc.Spec.Source.IP)
+			if peerIP == nil {
+				return nil, fmt.Errorf("source IP is not valid: %s", pc.Spec.Source.IP)
+			}
+		}
+	} else if pc.Spec.Destination.IP != "" {
+		peerIP = net.ParseIP(pc.Spec.Destination.IP)
+		if peerIP == nil {
+			return nil, fmt.Errorf("destination IP is not valid: %s", pc.Spec.Destination.IP)
+		}
+	} else if pc.Spec.Destination.Pod != "" {
+		var podIPs []net.IP
+		if dstPodInterfaces := c.interfaceStore.GetContainerInterfacesByPod(pc.Spec.Destination.Pod, pc.Spec.Destination.Namespace); len(dstPodInterfaces) > 0 {
+			podIPs = dstPodInterfaces[0].IPs
+		} else {
+			dstPod, err := c.kubeClient.CoreV1().Pods(pc.Spec.Destination.Namespace).Get(context.TODO(), pc.Spec.Destination.Pod, metav1.GetOptions{})
+			if err != nil {
+				return nil, fmt.Errorf("failed to get the destination Pod: %v", err)
+			}
+			for _, ip := range dstPod.Status.PodIPs {
+				podIPs = append(podIPs, net.ParseIP(ip.IP))
+			}
+		}
+		if packet.IsIPv6 {
+			peerIP, _ = util.GetIPWithFamily(podIPs, util.FamilyIPv6)
+		} else {
+			peerIP = util.GetIPv4Addr(podIPs)
+		}
+		if peerIP == nil {
+			if packet.IsIPv6 {
+				return nil, errors.New("destination Pod does not have an IPv6 address")
+			}
+			return nil, errors.New("destination Pod does not have an IPv4 address")
+		}
+	}
+	if peerIP != nil {
+		if isIPv6 := peerIP.To4() == nil; pc.Spec.Packet.IPv6Header != nil && !isIPv6 {
+			return nil, errors.New("peer IP does not match the IP header family")
+		} else {
+			packet.IsIPv6 = isIPv6
+		}
+		if packet.IsIPv6 && intf.GetIPv6Addr() == nil || !packet.IsIPv6 && intf.GetIPv4Addr() == nil {
+			return nil, errors.New("Pod does not have an IP address of the same family as the peer")
+		}
+		packet.DestinationIP = peerIP
+	}
+
+	if pc.Spec.Packet.IPv6Header != nil {
+		if pc.Spec.Packet.IPv6Header.NextHeader != nil {
+			packet.IPProto = uint8(*pc.Spec.Packet.IPv6Header.NextHeader)
+		}
+	} else {
+		packet.IPProto = uint8(pc.Spec.Packet.IPHeader.Protocol)
+	}
+	// The ports in the spec are from the perspective of the source.
+	var srcPort, dstPort uint16
+	if pc.Spec.Packet.TransportHeader.TCP != nil {
+		packet.IPProto = protocol.Type_TCP
+		srcPort = uint16(pc.Spec.Packet.TransportHeader.TCP.SrcPort)
+		dstPort = uint16(pc.Spec.Packet.TransportHeader.TCP.DstPort)
+	} else if pc.Spec.Packet.TransportHeader.UDP != nil {
+		packet.IPProto = protocol.Type_UDP
+		srcPort = uint16(pc.Spec.Packet.TransportHeader.UDP.SrcPort)
+		dstPort = uint16(pc.Spec.Packet.TransportHeader.UDP.DstPort)
+	}
+	if packet.IPProto == protocol.Type_ICMP && packet.IsIPv6 {
+		packet.IPProto = protocol.Type_IPv6ICMP
+	}
+	if receiverOnly {
+		packet.SourcePort, packet.DestinationPort = dstPort, srcPort
+	} else {
+		packet.SourcePort, packet.DestinationPort = srcPort, dstPort
+	}
+	return packet, nil
+}
+
+// getPacketCaptureState returns the state of the running PacketCapture which the packet-in
+// belongs to, or nil if the packet-in is not for a PacketCapture.
+func (c *Controller) getPacketCaptureState(pktIn *ofctrl.PacketIn) *packetCaptureState {
+	if c.packetCapture == nil {
+		return nil
+	}
+	var tag uint8
+	switch pktIn.Data.Ethertype {
+	case protocol.IPv4_MSG:
+		ipPacket, ok := pktIn.Data.Data.(*protocol.IPv4)
+		if !ok {
+			return nil
+		}
+		tag = ipPacket.DSCP
+	case protocol.IPv6_MSG:
+		ipv6Packet, ok := pktIn.Data.Data.(*protocol.IPv6)
+		if !ok {
+			return nil
+		}
+		tag = ipv6Packet.TrafficClass >> 2
+	default:
+		return nil
+	}
+	c.packetCapture.mutex.Lock()
+	defer c.packetCapture.mutex.Unlock()
+	// The tag of a completed PacketCapture may have been allocated to a Traceflow already.
+	if pcState, ok := c.packetCapture.runningPacketCaptures[tag]; ok && !pcState.completed {
+		return pcState
+	}
+	return nil
+}
+
+// handlePacketCapturePacketIn writes the packet to the pcapng file of the PacketCapture, and
+// completes the PacketCapture once the requested number of packets has been captured.
+func (c *Controller) handlePacketCapturePacketIn(pcState *packetCaptureState, pktIn *ofctrl.PacketIn) error {
+	data, err := pktIn.Data.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("error when serializing packet for PacketCapture %s: %w", pcState.name, err)
+	}
+	// The original DSCP of the packet is saved in a register before the data plane tag is loaded.
+	var dscp uint8
+	if match := getMatchRegField(pktIn.GetMatches(), openflow.PacketCaptureDSCPField); match != nil {
+		value, err := getRegValue(match, openflow.PacketCaptureDSCPField.GetRange().ToNXRange())
+		if err != nil {
+			return fmt.Errorf("error when getting the original DSCP for PacketCapture %s: %w", pcState.name, err)
+		}
+		dscp = uint8(value)
+	}
+	restoreDSCP(data, dscp)
+
+	c.packetCapture.mutex.Lock()
+	if pcState.completed {
+		c.packetCapture.mutex.Unlock()
+		return nil
+	}
+	if err := pcState.writer.WritePacket(time.Now(), data); err != nil {
+		c.packetCapture.mutex.Unlock()
+		return fmt.Errorf("error when writing packet for PacketCapture %s: %w", pcState.name, err)
+	}
+	pcState.numCapturedPackets++
+	done := pcState.numCapturedPackets >= pcState.number
+	c.packetCapture.mutex.Unlock()
+
+	if done {
+		c.completePacketCapture(pcState)
+	}
+	return nil
+}
+
+// restoreDSCP overwrites the DSCP bits, which carry the data plane tag, of the IPv4 or IPv6
+// header of the Ethernet frame with the original DSCP of the packet. The IPv4 header checksum
+// is updated accordingly.
+func restoreDSCP(frame []byte, dscp uint8) {
+	if len(frame) < 14 {
+		return
+	}
+	offset := 12
+	etherType := binary.BigEndian.Uint16(frame[offset:])
+	// Skip the 802.1Q tag if any.
+	if etherType == 0x8100 && len(frame) >= 18 {
+		offset += 4
+		etherType = binary.BigEndian.Uint16(frame[offset:])
+	}
+	ipHeader := frame[offset+2:]
+	switch etherType {
+	case 0x0800:
+		if len(ipHeader) < 20 {
+			return
+		}
+		headerLen := int(ipHeader[0]&0x0f) * 4
+		if headerLen < 20 || len(ipHeader) < headerLen {
+			return
+		}
+		// Keep the ECN bits.
+		ipHeader[1] = dscp<<2 | ipHeader[1]&0x03
+		ipHeader[10], ipHeader[11] = 0, 0
+		var sum uint32
+		for i := 0; i < headerLen; i += 2 {
+			sum += uint32(binary.BigEndian.Uint16(ipHeader[i:]))
+		}
+		for sum > 0xffff {
+			sum = (sum >> 16) + (sum & 0xffff)
+		}
+		binary.BigEndian.PutUint16(ipHeader[10:], ^uint16(sum))
+	case 0x86dd:
+		if len(ipHeader) < 40 {
+			return
+		}
+		// The Traffic Class spans the 4 lower bits of the first byte and the 4 upper bits of
+		// the second byte. The DSCP bits are the 6 upper bits of the Traffic Class.
+		ipHeader[0] = ipHeader[0]&0xf0 | dscp>>2&0x0f
+		ipHeader[1] = dscp<<6 | ipHeader[1]&0x3f
+	}
+}
+
+// completePacketCapture stops the capture, and reports the result in the PacketCapture status.
+func (c *Controller) completePacketCapture(pcState *packetCaptureState) {
+	c.packetCapture.mutex.Lock()
+	if pcState.completed {
+		c.packetCapture.mutex.Unlock()
+		return
+	}
+	pcState.completed = true
+	if pcState.timer != nil {
+		pcState.timer.Stop()
+	}
+	numCapturedPackets := pcState.numCapturedPackets
+	closeErr := pcState.file.Close()
+	c.packetCapture.mutex.Unlock()
+
+	if err := c.ofClient.UninstallPacketCaptureFlows(pcState.tag); err != nil {
+		klog.ErrorS(err, "Failed to uninstall PacketCapture flows", "PacketCapture", pcState.name)
+	}
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		pc, err := c.packetCapture.lister.Get(pcState.name)
+		if err != nil {
+			return err
+		}
+		update := pc.DeepCopy()
+		update.Status.NodeName = c.nodeConfig.Name
+		update.Status.NumCapturedPackets = numCapturedPackets
+		if closeErr != nil {
+			update.Status.Phase = crdv1alpha1.PacketCaptureFailed
+			update.Status.Reason = fmt.Sprintf("Node: %s, error when writing the capture file: %v", c.nodeConfig.Name, closeErr)
+		} else {
+			update.Status.Phase = crdv1alpha1.PacketCaptureSucceeded
+			update.Status.FilePath = pcState.filePath
+			if numCapturedPackets < pcState.number {
+				update.Status.Reason = fmt.Sprintf("Captured %d packets before timeout", numCapturedPackets)
+			}
+		}
+		_, err = c.traceflowClient.CrdV1alpha1().PacketCaptures().UpdateStatus(context.TODO(), update, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		klog.ErrorS(err, "Failed to update PacketCapture status", "PacketCapture", pcState.name)
+		return
+	}
+	klog.InfoS("Completed PacketCapture", "PacketCapture", pcState.name, "packets", numCapturedPackets)
+}
+
+func (c *Controller) errorPacketCaptureCRD(pc *crdv1alpha1.PacketCapture, reason string) (*crdv1alpha1.PacketCapture, error) {
+	type PacketCapture struct {
+		Status crdv1alpha1.PacketCaptureStatus `json:"status,omitempty"`
+	}
+	patchData := PacketCapture{Status: crdv1alpha1.PacketCaptureStatus{Phase: crdv1alpha1.PacketCaptureFailed, Reason: reason}}
+	payloads, _ := json.Marshal(patchData)
+	return c.traceflowClient.CrdV1alpha1().PacketCaptures().Patch(context.TODO(), pc.Name, types.MergePatchType, payloads, metav1.PatchOptions{}, "status")
+}
+
+// cleanupPacketCapture deletes the PacketCapture state, and stops the capture if it is still
+// running. The pcapng file is kept until the PacketCapture is deleted.
+func (c *Controller) cleanupPacketCapture(name string) {
+	c.packetCapture.mutex.Lock()
+	var pcState *packetCaptureState
+	for tag, state := range c.packetCapture.runningPacketCaptures {
+		if state.name == name {
+			pcState = state
+			delete(c.packetCapture.runningPacketCaptures, tag)
+			break
+		}
+	}
+	if pcState == nil || pcState.completed {
+		c.packetCapture.mutex.Unlock()
+		return
+	}
+	pcState.completed = true
+	if pcState.timer != nil {
+		pcState.timer.Stop()
+	}
+	pcState.file.Close()
+	c.packetCapture.mutex.Unlock()
+
+	if err := c.ofClient.UninstallPacketCaptureFlows(pcState.tag); err != nil {
+		klog.ErrorS(err, "Failed to uninstall PacketCapture flows", "PacketCapture", name)
+	}
+}
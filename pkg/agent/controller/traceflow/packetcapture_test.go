@@ -0,0 +1,118 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traceflow
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func ipv4HeaderChecksum(header []byte) uint16 {
+	var sum uint32
+	for i := 0; i < len(header); i += 2 {
+		sum += uint32(binary.BigEndian.Uint16(header[i:]))
+	}
+	for sum > 0xffff {
+		sum = (sum >> 16) + (sum & 0xffff)
+	}
+	return ^uint16(sum)
+}
+
+func TestRestoreDSCP(t *testing.T) {
+	ethHeader := []byte{
+		0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0x01, // dst MAC
+		0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0x02, // src MAC
+	}
+	ipv4Header := func(tos byte) []byte {
+		header := []byte{
+			0x45, tos, 0x00, 0x1c, 0x12, 0x34, 0x40, 0x00, 0x40, 0x01, 0x00, 0x00,
+			10, 10, 0, 1, // src IP
+			10, 10, 0, 2, // dst IP
+		}
+		binary.BigEndian.PutUint16(header[10:], ipv4HeaderChecksum(header))
+		return header
+	}
+	ipv6Header := func(trafficClass byte) []byte {
+		header := make([]byte, 40)
+		header[0] = 0x60 | trafficClass>>4
+		header[1] = trafficClass << 4
+		header[6] = 58
+		header[7] = 64
+		return header
+	}
+	frame := func(vlan bool, etherType uint16, ipHeader []byte) []byte {
+		data := append([]byte{}, ethHeader...)
+		if vlan {
+			data = append(data, 0x81, 0x00, 0x00, 0x0a)
+		}
+		data = append(data, byte(etherType>>8), byte(etherType))
+		data = append(data, ipHeader...)
+		// Payload.
+		return append(data, 0x08, 0x00, 0xf7, 0xff, 0x00, 0x00, 0x00, 0x00)
+	}
+
+	tests := []struct {
+		name     string
+		frame    []byte
+		dscp     uint8
+		expected []byte
+	}{
+		{
+			name:     "IPv4",
+			frame:    frame(false, 0x0800, ipv4Header(0x0f<<2|0x01)),
+			expected: frame(false, 0x0800, ipv4Header(0x01)),
+		},
+		{
+			name:     "IPv4 with original DSCP",
+			frame:    frame(false, 0x0800, ipv4Header(0x0f<<2|0x01)),
+			dscp:     0x2e,
+			expected: frame(false, 0x0800, ipv4Header(0x2e<<2|0x01)),
+		},
+		{
+			name:     "IPv4 with VLAN",
+			frame:    frame(true, 0x0800, ipv4Header(0x0f<<2)),
+			expected: frame(true, 0x0800, ipv4Header(0)),
+		},
+		{
+			name:     "IPv6",
+			frame:    frame(false, 0x86dd, ipv6Header(0x3f<<2|0x02)),
+			expected: frame(false, 0x86dd, ipv6Header(0x02)),
+		},
+		{
+			name:     "IPv6 with original DSCP",
+			frame:    frame(false, 0x86dd, ipv6Header(0x3f<<2|0x02)),
+			dscp:     0x2e,
+			expected: frame(false, 0x86dd, ipv6Header(0x2e<<2|0x02)),
+		},
+		{
+			name:     "ARP",
+			frame:    frame(false, 0x0806, []byte{0x00, 0x01, 0x08, 0x00}),
+			expected: frame(false, 0x0806, []byte{0x00, 0x01, 0x08, 0x00}),
+		},
+		{
+			name:     "truncated frame",
+			frame:    ethHeader,
+			expected: ethHeader,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			restoreDSCP(tt.frame, tt.dscp)
+			assert.Equal(t, tt.expected, tt.frame)
+		})
+	}
+}
@@ -37,6 +37,11 @@ func (c *Controller) HandlePacketIn(pktIn *ofctrl.PacketIn) error {
 	if !c.traceflowListerSynced() {
 		return errors.New("traceflow controller is not started")
 	}
+	// Packets sent to the controller by the PacketCapture flows share the Traceflow
+	// packet-in reason and are told apart by their data plane tag.
+	if pcState := c.getPacketCaptureState(pktIn); pcState != nil {
+		return c.handlePacketCapturePacketIn(pcState, pktIn)
+	}
 	oldTf, nodeResult, packet, err := c.parsePacketIn(pktIn)
 	if err != nil {
 		klog.Errorf("parsePacketIn error: %+v", err)
@@ -105,6 +105,8 @@ type Controller struct {
 	// runningTraceflows is a map for storing the running Traceflow state
 	// with dataplane tag to be the key.
 	runningTraceflows map[uint8]*traceflowState
+	// packetCapture is nil if the PacketCapture feature is disabled.
+	packetCapture *packetCaptureController
 }
 
 // NewTraceflowController instantiates a new Controller object which will process Traceflow
@@ -114,6 +116,7 @@ func NewTraceflowController(
 	informerFactory informers.SharedInformerFactory,
 	traceflowClient clientsetversioned.Interface,
 	traceflowInformer crdinformers.TraceflowInformer,
+	packetCaptureInformer crdinformers.PacketCaptureInformer,
 	client openflow.Client,
 	npQuerier querier.AgentNetworkPolicyInfoQuerier,
 	ovsBridgeClient ovsconfig.OVSBridgeClient,
@@ -147,6 +150,9 @@ func NewTraceflowController(
 		},
 		resyncPeriod,
 	)
+	if packetCaptureInformer != nil {
+		c.initPacketCapture(packetCaptureInformer)
+	}
 	// Register packetInHandler
 	c.ofClient.RegisterPacketInHandler(uint8(openflow.PacketInReasonTF), "traceflow", c)
 	// Add serviceLister if AntreaProxy enabled
@@ -171,6 +177,9 @@ func (c *Controller) Run(stopCh <-chan struct{}) {
 	defer klog.Infof("Shutting down %s", controllerName)
 
 	cacheSyncs := []cache.InformerSynced{c.traceflowListerSynced}
+	if c.packetCapture != nil {
+		cacheSyncs = append(cacheSyncs, c.packetCapture.listerSynced)
+	}
 	if features.DefaultFeatureGate.Enabled(features.AntreaProxy) {
 		cacheSyncs = append(cacheSyncs, c.serviceListerSynced)
 	}
@@ -181,6 +190,9 @@ func (c *Controller) Run(stopCh <-chan struct{}) {
 	for i := 0; i < defaultWorkers; i++ {
 		go wait.Until(c.worker, time.Second, stopCh)
 	}
+	if c.packetCapture != nil {
+		go c.runPacketCapture(stopCh)
+	}
 	<-stopCh
 }
 
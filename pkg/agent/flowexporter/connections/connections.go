@@ -39,6 +39,7 @@ type connectionStore struct {
 	antreaProxier          proxy.Proxier
 	expirePriorityQueue    *priorityqueue.ExpirePriorityQueue
 	staleConnectionTimeout time.Duration
+	connectionFilter       flowexporter.ConnectionFilter
 	mutex                  sync.Mutex
 }
 
@@ -52,6 +53,7 @@ func NewConnectionStore(
 		antreaProxier:          proxier,
 		expirePriorityQueue:    priorityqueue.NewExpirePriorityQueue(o.ActiveFlowTimeout, o.IdleFlowTimeout),
 		staleConnectionTimeout: o.StaleConnectionTimeout,
+		connectionFilter:       o.ConnectionFilter,
 	}
 }
 
@@ -122,6 +124,12 @@ func (cs *connectionStore) fillPodInfo(conn *flowexporter.Connection) {
 	}
 }
 
+// isExcluded returns true if the connection is excluded by the flow export
+// filter, in which case it is not added to the connection store.
+func (cs *connectionStore) isExcluded(conn *flowexporter.Connection) bool {
+	return cs.connectionFilter != nil && !cs.connectionFilter.Match(conn)
+}
+
 func (cs *connectionStore) fillServiceInfo(conn *flowexporter.Connection, serviceStr string) {
 	// resolve destination Service information
 	if cs.antreaProxier != nil {
@@ -259,10 +259,15 @@ func (cs *ConntrackConnectionStore) AddOrUpdateConn(conn *flowexporter.Connectio
 				cs.fillServiceInfo(conn, serviceStr)
 			}
 		}
+		if cs.isExcluded(conn) {
+			klog.V(4).InfoS("Antrea flow excluded by flow export filter", "connection", conn)
+			return
+		}
 		cs.addNetworkPolicyMetadata(conn)
 		if conn.StartTime.IsZero() {
 			conn.StartTime = time.Now()
 			conn.StopTime = time.Now()
+			conn.IsStartTimeEstimated = true
 		}
 		metrics.TotalAntreaConnectionsInConnTrackTable.Inc()
 		conn.IsActive = true
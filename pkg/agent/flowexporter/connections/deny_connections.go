@@ -93,6 +93,10 @@ func (ds *DenyConnectionStore) AddOrUpdateConn(conn *flowexporter.Connection, ti
 		protocolStr := ip.IPProtocolNumberToString(conn.FlowKey.Protocol, "UnknownProtocol")
 		serviceStr := fmt.Sprintf("%s:%d/%s", conn.DestinationServiceAddress, conn.DestinationServicePort, protocolStr)
 		ds.fillServiceInfo(conn, serviceStr)
+		if ds.isExcluded(conn) {
+			klog.V(4).InfoS("Deny connection excluded by flow export filter", "connection", conn)
+			return
+		}
 		metrics.TotalDenyConnections.Inc()
 		conn.IsActive = true
 		ds.connections[connKey] = conn
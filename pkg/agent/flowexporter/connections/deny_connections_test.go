@@ -92,6 +92,38 @@ func TestDenyConnectionStore_AddOrUpdateConn(t *testing.T) {
 	checkDenyConnectionMetrics(t, len(denyConnStore.connections))
 }
 
+type fakeConnectionFilter struct {
+	excludedPort uint16
+}
+
+func (f *fakeConnectionFilter) Match(conn *flowexporter.Connection) bool {
+	return conn.FlowKey.DestinationPort != f.excludedPort
+}
+
+func TestDenyConnectionStore_AddOrUpdateConnFiltered(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	refTime := time.Now()
+	tuple := flowexporter.Tuple{SourceAddress: net.IP{1, 2, 3, 4}, DestinationAddress: net.IP{4, 3, 2, 1}, Protocol: 6, SourcePort: 65280, DestinationPort: 255}
+	testFlow := flowexporter.Connection{
+		FlowKey:                   tuple,
+		DestinationServiceAddress: tuple.DestinationAddress,
+		DestinationServicePort:    tuple.DestinationPort,
+	}
+	mockIfaceStore := interfacestoretest.NewMockInterfaceStore(ctrl)
+	mockIfaceStore.EXPECT().GetInterfaceByIP(tuple.SourceAddress.String()).Return(nil, false)
+	mockIfaceStore.EXPECT().GetInterfaceByIP(tuple.DestinationAddress.String()).Return(nil, false)
+
+	options := *testFlowExporterOptions
+	options.ConnectionFilter = &fakeConnectionFilter{excludedPort: 255}
+	denyConnStore := NewDenyConnectionStore(mockIfaceStore, nil, &options)
+
+	denyConnStore.AddOrUpdateConn(&testFlow, refTime, uint64(60))
+	_, ok := denyConnStore.GetConnByKey(flowexporter.NewConnectionKey(&testFlow))
+	assert.False(t, ok, "excluded deny connection should not be in deny connection store")
+	assert.Equal(t, 0, denyConnStore.connectionStore.expirePriorityQueue.Len())
+}
+
 func checkDenyConnectionMetrics(t *testing.T, numConns int) {
 	expectedDenyConnectionCount := `
 	# HELP antrea_agent_denied_connection_count [ALPHA] Number of denied connections detected by Flow Exporter deny connections tracking. This metric gets updated when a flow is rejected/dropped by network policy.
@@ -0,0 +1,152 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connections
+
+import (
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/flowexporter"
+	"antrea.io/antrea/pkg/agent/interfacestore"
+)
+
+const (
+	// maxDNSRecords is the maximum number of DNS records buffered between two
+	// exports. The oldest records are dropped when it is reached.
+	maxDNSRecords = 10000
+	// maxFQDNEntries is the maximum number of IPs associated with the FQDN
+	// they were resolved from.
+	maxFQDNEntries = 100000
+	// minFQDNRetention is the minimum time an IP stays associated with the
+	// FQDN it was resolved from, as connections often outlive the TTL of the
+	// DNS answers.
+	minFQDNRetention = 5 * time.Minute
+)
+
+type fqdnEntry struct {
+	fqdn           string
+	expirationTime time.Time
+}
+
+// DNSRecordStore buffers the DNS responses received by local Pods until they
+// are exported, and keeps track of the FQDNs resolved by local Pods to enrich
+// the flow records of Pod-to-External flows.
+type DNSRecordStore struct {
+	ifaceStore interfacestore.InterfaceStore
+	mutex      sync.Mutex
+	records    []flowexporter.DNSRecord
+	ipToFQDN   map[string]fqdnEntry
+}
+
+func NewDNSRecordStore(ifaceStore interfacestore.InterfaceStore) *DNSRecordStore {
+	return &DNSRecordStore{
+		ifaceStore: ifaceStore,
+		ipToFQDN:   make(map[string]fqdnEntry),
+	}
+}
+
+// AddDNSResponse records a DNS response sent to a client. Responses to clients
+// which are not local Pods are ignored.
+func (ds *DNSRecordStore) AddDNSResponse(msg *dns.Msg, clientIP net.IP, clientPort uint16, serverIP net.IP, serverPort uint16, responseTime time.Time) {
+	if len(msg.Question) == 0 {
+		return
+	}
+	iface, ok := ds.ifaceStore.GetInterfaceByIP(clientIP.String())
+	if !ok || iface.Type != interfacestore.ContainerInterface {
+		return
+	}
+	record := flowexporter.DNSRecord{
+		PodNamespace: iface.ContainerInterfaceConfig.PodNamespace,
+		PodName:      iface.ContainerInterfaceConfig.PodName,
+		ClientIP:     clientIP,
+		ClientPort:   clientPort,
+		ServerIP:     serverIP,
+		ServerPort:   serverPort,
+		QueryName:    strings.TrimSuffix(strings.ToLower(msg.Question[0].Name), "."),
+		QueryType:    msg.Question[0].Qtype,
+		ResponseCode: uint8(msg.Rcode),
+		ResponseTime: responseTime,
+	}
+	resolvedIPs := make(map[string]uint32)
+	for _, ans := range msg.Answer {
+		switch r := ans.(type) {
+		case *dns.A:
+			record.Answers = append(record.Answers, r.A.String())
+			resolvedIPs[r.A.String()] = r.Hdr.Ttl
+		case *dns.AAAA:
+			record.Answers = append(record.Answers, r.AAAA.String())
+			resolvedIPs[r.AAAA.String()] = r.Hdr.Ttl
+		case *dns.CNAME:
+			record.Answers = append(record.Answers, strings.TrimSuffix(strings.ToLower(r.Target), "."))
+		}
+	}
+
+	ds.mutex.Lock()
+	defer ds.mutex.Unlock()
+	if len(ds.records) >= maxDNSRecords {
+		klog.V(4).InfoS("Too many DNS records buffered, dropping the oldest one")
+		ds.records = ds.records[1:]
+	}
+	ds.records = append(ds.records, record)
+	for ip, ttl := range resolvedIPs {
+		retention := time.Duration(ttl) * time.Second
+		if retention < minFQDNRetention {
+			retention = minFQDNRetention
+		}
+		if _, exists := ds.ipToFQDN[ip]; !exists && len(ds.ipToFQDN) >= maxFQDNEntries {
+			ds.deleteExpiredFQDNsWithoutLock(responseTime)
+			if len(ds.ipToFQDN) >= maxFQDNEntries {
+				continue
+			}
+		}
+		ds.ipToFQDN[ip] = fqdnEntry{fqdn: record.QueryName, expirationTime: responseTime.Add(retention)}
+	}
+}
+
+// PopRecords returns the buffered DNS records and clears the buffer. Expired
+// FQDN entries are deleted at the same time.
+func (ds *DNSRecordStore) PopRecords() []flowexporter.DNSRecord {
+	ds.mutex.Lock()
+	defer ds.mutex.Unlock()
+	records := ds.records
+	ds.records = nil
+	ds.deleteExpiredFQDNsWithoutLock(time.Now())
+	return records
+}
+
+// LookupFQDN returns the FQDN which a local Pod resolved to the provided IP, or
+// an empty string if there is none.
+func (ds *DNSRecordStore) LookupFQDN(ip net.IP) string {
+	ds.mutex.Lock()
+	defer ds.mutex.Unlock()
+	entry, ok := ds.ipToFQDN[ip.String()]
+	if !ok || entry.expirationTime.Before(time.Now()) {
+		return ""
+	}
+	return entry.fqdn
+}
+
+func (ds *DNSRecordStore) deleteExpiredFQDNsWithoutLock(now time.Time) {
+	for ip, entry := range ds.ipToFQDN {
+		if entry.expirationTime.Before(now) {
+			delete(ds.ipToFQDN, ip)
+		}
+	}
+}
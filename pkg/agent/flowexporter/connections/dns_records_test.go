@@ -0,0 +1,128 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connections
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"antrea.io/antrea/pkg/agent/flowexporter"
+	"antrea.io/antrea/pkg/agent/interfacestore"
+	interfacestoretest "antrea.io/antrea/pkg/agent/interfacestore/testing"
+)
+
+func newDNSResponse(name string, rcode int, answers ...dns.RR) *dns.Msg {
+	msg := &dns.Msg{}
+	msg.SetQuestion(name, dns.TypeA)
+	msg.Response = true
+	msg.Rcode = rcode
+	msg.Answer = answers
+	return msg
+}
+
+func TestDNSRecordStore_AddDNSResponse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockIfaceStore := interfacestoretest.NewMockInterfaceStore(ctrl)
+
+	clientIP := net.ParseIP("10.10.0.2")
+	remoteIP := net.ParseIP("10.10.1.2")
+	serverIP := net.ParseIP("10.96.0.10")
+	podIface := interfacestore.NewContainerInterface("pod1-abc", "container1", "pod1", "ns1", nil, []net.IP{clientIP}, 0)
+	mockIfaceStore.EXPECT().GetInterfaceByIP(clientIP.String()).Return(podIface, true).AnyTimes()
+	mockIfaceStore.EXPECT().GetInterfaceByIP(remoteIP.String()).Return(nil, false).AnyTimes()
+
+	store := NewDNSRecordStore(mockIfaceStore)
+	responseTime := time.Now()
+	msg := newDNSResponse("WWW.Example.com.", dns.RcodeSuccess,
+		&dns.CNAME{Hdr: dns.RR_Header{Name: "www.example.com.", Rrtype: dns.TypeCNAME, Ttl: 60}, Target: "example.com."},
+		&dns.A{Hdr: dns.RR_Header{Name: "example.com.", Rrtype: dns.TypeA, Ttl: 3600}, A: net.ParseIP("93.184.216.34")},
+	)
+	store.AddDNSResponse(msg, clientIP, 40000, serverIP, 53, responseTime)
+	// Responses to clients which are not local Pods are ignored.
+	store.AddDNSResponse(msg, remoteIP, 40000, serverIP, 53, responseTime)
+	// Responses without question are ignored.
+	store.AddDNSResponse(&dns.Msg{}, clientIP, 40001, serverIP, 53, responseTime)
+
+	assert.Equal(t, "www.example.com", store.LookupFQDN(net.ParseIP("93.184.216.34")))
+	assert.Equal(t, "", store.LookupFQDN(net.ParseIP("1.1.1.1")))
+
+	records := store.PopRecords()
+	require.Len(t, records, 1)
+	assert.Equal(t, flowexporter.DNSRecord{
+		PodNamespace: "ns1",
+		PodName:      "pod1",
+		ClientIP:     clientIP,
+		ClientPort:   40000,
+		ServerIP:     serverIP,
+		ServerPort:   53,
+		QueryName:    "www.example.com",
+		QueryType:    dns.TypeA,
+		ResponseCode: dns.RcodeSuccess,
+		Answers:      []string{"example.com", "93.184.216.34"},
+		ResponseTime: responseTime,
+	}, records[0])
+	assert.Empty(t, store.PopRecords())
+}
+
+func TestDNSRecordStore_FQDNExpiration(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockIfaceStore := interfacestoretest.NewMockInterfaceStore(ctrl)
+
+	clientIP := net.ParseIP("10.10.0.2")
+	podIface := interfacestore.NewContainerInterface("pod1-abc", "container1", "pod1", "ns1", nil, []net.IP{clientIP}, 0)
+	mockIfaceStore.EXPECT().GetInterfaceByIP(clientIP.String()).Return(podIface, true).AnyTimes()
+
+	store := NewDNSRecordStore(mockIfaceStore)
+	msg := newDNSResponse("old.example.com.", dns.RcodeSuccess,
+		&dns.A{Hdr: dns.RR_Header{Name: "old.example.com.", Rrtype: dns.TypeA, Ttl: 30}, A: net.ParseIP("1.2.3.4")},
+	)
+	// The answer is retained for minFQDNRetention even though its TTL is shorter.
+	store.AddDNSResponse(msg, clientIP, 40000, net.ParseIP("10.96.0.10"), 53, time.Now().Add(-minFQDNRetention+time.Minute))
+	assert.Equal(t, "old.example.com", store.LookupFQDN(net.ParseIP("1.2.3.4")))
+
+	store.AddDNSResponse(msg, clientIP, 40000, net.ParseIP("10.96.0.10"), 53, time.Now().Add(-minFQDNRetention-time.Minute))
+	assert.Equal(t, "", store.LookupFQDN(net.ParseIP("1.2.3.4")))
+	store.PopRecords()
+	assert.Empty(t, store.ipToFQDN)
+}
+
+func TestDNSRecordStore_MaxRecords(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockIfaceStore := interfacestoretest.NewMockInterfaceStore(ctrl)
+
+	clientIP := net.ParseIP("10.10.0.2")
+	podIface := interfacestore.NewContainerInterface("pod1-abc", "container1", "pod1", "ns1", nil, []net.IP{clientIP}, 0)
+	mockIfaceStore.EXPECT().GetInterfaceByIP(clientIP.String()).Return(podIface, true).AnyTimes()
+
+	store := NewDNSRecordStore(mockIfaceStore)
+	msg := newDNSResponse("example.com.", dns.RcodeNameError)
+	for i := 0; i < maxDNSRecords+1; i++ {
+		store.AddDNSResponse(msg, clientIP, uint16(i), net.ParseIP("10.96.0.10"), 53, time.Now())
+	}
+	records := store.PopRecords()
+	require.Len(t, records, maxDNSRecords)
+	// The oldest record has been dropped.
+	assert.Equal(t, uint16(1), records[0].ClientPort)
+	assert.Equal(t, uint8(dns.RcodeNameError), records[0].ResponseCode)
+}
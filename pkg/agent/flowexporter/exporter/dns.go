@@ -0,0 +1,215 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"fmt"
+	"strings"
+
+	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
+	ipfixregistry "github.com/vmware/go-ipfix/pkg/registry"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/flowexporter"
+)
+
+const dnsPort = 53
+
+var (
+	IANAInfoElementsDNSCommon = []string{
+		"flowEndSeconds",
+		"sourceTransportPort",
+		"destinationTransportPort",
+		"protocolIdentifier",
+	}
+	IANAInfoElementsDNSIPv4 = append(IANAInfoElementsDNSCommon, []string{"sourceIPv4Address", "destinationIPv4Address"}...)
+	IANAInfoElementsDNSIPv6 = append(IANAInfoElementsDNSCommon, []string{"sourceIPv6Address", "destinationIPv6Address"}...)
+	// AntreaInfoElementsDNS are the Antrea information elements of DNS
+	// records. The Flow Collector can tell DNS records from flow records by
+	// the presence of dnsQueryName.
+	AntreaInfoElementsDNS = []string{
+		"sourcePodName",
+		"sourcePodNamespace",
+		"sourceNodeName",
+		"dnsQueryName",
+		"dnsQueryType",
+		"dnsResponseCode",
+		"dnsAnswers",
+		"dnsLatencyMicroseconds",
+	}
+)
+
+// dnsQueryKey identifies the UDP connection of a DNS query by its client.
+type dnsQueryKey struct {
+	clientIP   string
+	clientPort uint16
+}
+
+// fillDNSQueryTimes sets the QueryTime of the DNS records to the start time of
+// the conntrack connection of the query, when it can be found. The start time is
+// ignored when it is not provided by conntrack (nf_conntrack_timestamp is
+// disabled), as the poll time it is replaced with is unrelated to the query.
+func (exp *FlowExporter) fillDNSQueryTimes(records []flowexporter.DNSRecord) {
+	if len(records) == 0 {
+		return
+	}
+	queries := make(map[dnsQueryKey]*flowexporter.DNSRecord, len(records))
+	for i := range records {
+		queries[dnsQueryKey{records[i].ClientIP.String(), records[i].ClientPort}] = &records[i]
+	}
+	exp.conntrackConnStore.ForAllConnectionsDo(func(key flowexporter.ConnectionKey, conn *flowexporter.Connection) error {
+		if conn.FlowKey.Protocol != uint8(17) || conn.IsStartTimeEstimated {
+			return nil
+		}
+		if conn.FlowKey.DestinationPort != dnsPort && conn.DestinationServicePort != dnsPort {
+			return nil
+		}
+		if record, ok := queries[dnsQueryKey{conn.FlowKey.SourceAddress.String(), conn.FlowKey.SourcePort}]; ok {
+			record.QueryTime = conn.StartTime
+		}
+		return nil
+	})
+}
+
+// sendDNSRecords exports the DNS records buffered since the last export. The
+// records are dropped if an error occurs.
+func (exp *FlowExporter) sendDNSRecords() error {
+	records := exp.dnsRecordStore.PopRecords()
+	exp.fillDNSQueryTimes(records)
+	for i := range records {
+		if err := exp.exportDNSRecord(&records[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (exp *FlowExporter) sendDNSTemplateSet(isIPv6 bool) (int, error) {
+	elements := make([]ipfixentities.InfoElementWithValue, 0)
+
+	IANAInfoElements := IANAInfoElementsDNSIPv4
+	templateID := exp.templateIDDNSv4
+	if isIPv6 {
+		IANAInfoElements = IANAInfoElementsDNSIPv6
+		templateID = exp.templateIDDNSv6
+	}
+	for _, ie := range IANAInfoElements {
+		element, err := exp.registry.GetInfoElement(ie, ipfixregistry.IANAEnterpriseID)
+		if err != nil {
+			return 0, fmt.Errorf("%s not present. returned error: %v", ie, err)
+		}
+		ieWithValue, err := ipfixentities.DecodeAndCreateInfoElementWithValue(element, nil)
+		if err != nil {
+			return 0, fmt.Errorf("error when creating information element: %v", err)
+		}
+		elements = append(elements, ieWithValue)
+	}
+	for _, ie := range AntreaInfoElementsDNS {
+		element, err := exp.registry.GetInfoElement(ie, ipfixregistry.AntreaEnterpriseID)
+		if err != nil {
+			return 0, fmt.Errorf("information element %s is not present in Antrea registry", ie)
+		}
+		ieWithValue, err := ipfixentities.DecodeAndCreateInfoElementWithValue(element, nil)
+		if err != nil {
+			return 0, fmt.Errorf("error when creating information element: %v", err)
+		}
+		elements = append(elements, ieWithValue)
+	}
+	exp.ipfixSet.ResetSet()
+	if err := exp.ipfixSet.PrepareSet(ipfixentities.Template, templateID); err != nil {
+		return 0, err
+	}
+	if err := exp.ipfixSet.AddRecord(elements, templateID); err != nil {
+		return 0, fmt.Errorf("error in adding record to template set: %v", err)
+	}
+	sentBytes, err := exp.process.SendSet(exp.ipfixSet)
+	if err != nil {
+		return 0, fmt.Errorf("error in IPFIX exporting process when sending template record: %v", err)
+	}
+
+	if !isIPv6 {
+		exp.elementsListDNSv4 = elements
+	} else {
+		exp.elementsListDNSv6 = elements
+	}
+	return sentBytes, nil
+}
+
+func (exp *FlowExporter) addDNSRecordToSet(record *flowexporter.DNSRecord) error {
+	exp.ipfixSet.ResetSet()
+
+	eL := exp.elementsListDNSv4
+	templateID := exp.templateIDDNSv4
+	if record.ClientIP.To4() == nil {
+		templateID = exp.templateIDDNSv6
+		eL = exp.elementsListDNSv6
+	}
+	if err := exp.ipfixSet.PrepareSet(ipfixentities.Data, templateID); err != nil {
+		return err
+	}
+	for i := range eL {
+		ie := eL[i]
+		switch ieName := ie.GetInfoElement().Name; ieName {
+		case "flowEndSeconds":
+			ie.SetUnsigned32Value(uint32(record.ResponseTime.Unix()))
+		case "sourceIPv4Address", "sourceIPv6Address":
+			ie.SetIPAddressValue(record.ClientIP)
+		case "destinationIPv4Address", "destinationIPv6Address":
+			ie.SetIPAddressValue(record.ServerIP)
+		case "sourceTransportPort":
+			ie.SetUnsigned16Value(record.ClientPort)
+		case "destinationTransportPort":
+			ie.SetUnsigned16Value(record.ServerPort)
+		case "protocolIdentifier":
+			ie.SetUnsigned8Value(uint8(17))
+		case "sourcePodName":
+			ie.SetStringValue(record.PodName)
+		case "sourcePodNamespace":
+			ie.SetStringValue(record.PodNamespace)
+		case "sourceNodeName":
+			ie.SetStringValue(exp.nodeName)
+		case "dnsQueryName":
+			ie.SetStringValue(record.QueryName)
+		case "dnsQueryType":
+			ie.SetUnsigned16Value(record.QueryType)
+		case "dnsResponseCode":
+			ie.SetUnsigned8Value(record.ResponseCode)
+		case "dnsAnswers":
+			ie.SetStringValue(strings.Join(record.Answers, ","))
+		case "dnsLatencyMicroseconds":
+			var latency uint32
+			if !record.QueryTime.IsZero() && record.ResponseTime.After(record.QueryTime) {
+				latency = uint32(record.ResponseTime.Sub(record.QueryTime).Microseconds())
+			}
+			ie.SetUnsigned32Value(latency)
+		}
+	}
+	if err := exp.ipfixSet.AddRecord(eL, templateID); err != nil {
+		return fmt.Errorf("error in adding record to data set: %v", err)
+	}
+	return nil
+}
+
+func (exp *FlowExporter) exportDNSRecord(record *flowexporter.DNSRecord) error {
+	if err := exp.addDNSRecordToSet(record); err != nil {
+		return err
+	}
+	if _, err := exp.sendDataSet(); err != nil {
+		return err
+	}
+	exp.numDataSetsSent = exp.numDataSetsSent + 1
+	klog.V(4).InfoS("DNS record sent successfully", "pod", klog.KRef(record.PodNamespace, record.PodName), "query", record.QueryName)
+	return nil
+}
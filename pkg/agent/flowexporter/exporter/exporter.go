@@ -96,6 +96,10 @@ var (
 		"egressNetworkPolicyRuleAction",
 		"tcpState",
 		"flowType",
+		"egressName",
+		"egressIP",
+		"egressNodeName",
+		"destinationFQDN",
 	}
 	AntreaInfoElementsIPv4 = append(antreaInfoElementsCommon, []string{"destinationClusterIPv4"}...)
 	AntreaInfoElementsIPv6 = append(antreaInfoElementsCommon, []string{"destinationClusterIPv6"}...)
@@ -117,11 +121,19 @@ type FlowExporter struct {
 	exporterInput          exporter.ExporterInput
 	k8sClient              kubernetes.Interface
 	nodeRouteController    *noderoute.Controller
+	egressQuerier          querier.EgressQuerier
 	isNetworkPolicyOnly    bool
 	nodeName               string
 	conntrackPriorityQueue *priorityqueue.ExpirePriorityQueue
 	denyPriorityQueue      *priorityqueue.ExpirePriorityQueue
 	expiredConns           []flowexporter.Connection
+	membership             *collectorMembership
+	shards                 map[string]*FlowExporter
+	dnsRecordStore         *connections.DNSRecordStore
+	elementsListDNSv4      []ipfixentities.InfoElementWithValue
+	elementsListDNSv6      []ipfixentities.InfoElementWithValue
+	templateIDDNSv4        uint16
+	templateIDDNSv6        uint16
 }
 
 func genObservationID(nodeName string) uint32 {
@@ -149,7 +161,7 @@ func prepareExporterInputArgs(collectorAddr, collectorProto, nodeName string) ex
 
 func NewFlowExporter(ifaceStore interfacestore.InterfaceStore, proxier proxy.Proxier, k8sClient kubernetes.Interface, nodeRouteController *noderoute.Controller,
 	trafficEncapMode config.TrafficEncapModeType, nodeConfig *config.NodeConfig, v4Enabled, v6Enabled bool, serviceCIDRNet, serviceCIDRNetv6 *net.IPNet,
-	ovsDatapathType ovsconfig.OVSDatapathType, proxyEnabled bool, npQuerier querier.AgentNetworkPolicyInfoQuerier, o *flowexporter.FlowExporterOptions) (*FlowExporter, error) {
+	ovsDatapathType ovsconfig.OVSDatapathType, proxyEnabled bool, npQuerier querier.AgentNetworkPolicyInfoQuerier, egressQuerier querier.EgressQuerier, o *flowexporter.FlowExporterOptions) (*FlowExporter, error) {
 	// Initialize IPFIX registry
 	registry := ipfix.NewIPFIXRegistry()
 	registry.LoadRegistry()
@@ -165,6 +177,16 @@ func NewFlowExporter(ifaceStore interfacestore.InterfaceStore, proxier proxy.Pro
 	denyConnStore := connections.NewDenyConnectionStore(ifaceStore, proxier, o)
 	conntrackConnStore := connections.NewConntrackConnectionStore(connTrackDumper, v4Enabled, v6Enabled, npQuerier, ifaceStore, proxier, o)
 
+	var dnsRecordStore *connections.DNSRecordStore
+	if o.EnableDNSVisibility {
+		dnsRecordStore = connections.NewDNSRecordStore(ifaceStore)
+	}
+
+	var membership *collectorMembership
+	if o.FlowCollectorService != nil {
+		membership = newCollectorMembership(k8sClient, *o.FlowCollectorService, o.FlowCollectorProto)
+	}
+
 	return &FlowExporter{
 		conntrackConnStore:     conntrackConnStore,
 		denyConnStore:          denyConnStore,
@@ -175,11 +197,15 @@ func NewFlowExporter(ifaceStore interfacestore.InterfaceStore, proxier proxy.Pro
 		ipfixSet:               ipfixentities.NewSet(false),
 		k8sClient:              k8sClient,
 		nodeRouteController:    nodeRouteController,
+		egressQuerier:          egressQuerier,
 		isNetworkPolicyOnly:    trafficEncapMode.IsNetworkPolicyOnly(),
 		nodeName:               nodeName,
 		conntrackPriorityQueue: conntrackConnStore.GetPriorityQueue(),
 		denyPriorityQueue:      denyConnStore.GetPriorityQueue(),
 		expiredConns:           make([]flowexporter.Connection, 0, maxConnsToExport*2),
+		membership:             membership,
+		shards:                 make(map[string]*FlowExporter),
+		dnsRecordStore:         dnsRecordStore,
 	}, nil
 }
 
@@ -187,6 +213,12 @@ func (exp *FlowExporter) GetDenyConnStore() *connections.DenyConnectionStore {
 	return exp.denyConnStore
 }
 
+// GetDNSRecordStore returns the store of the DNS responses received by local
+// Pods, or nil if DNS visibility is disabled.
+func (exp *FlowExporter) GetDNSRecordStore() *connections.DNSRecordStore {
+	return exp.dnsRecordStore
+}
+
 func (exp *FlowExporter) Run(stopCh <-chan struct{}) {
 	// Start the goroutine to periodically delete stale deny connections.
 	go exp.denyConnStore.RunPeriodicDeletion(stopCh)
@@ -194,6 +226,10 @@ func (exp *FlowExporter) Run(stopCh <-chan struct{}) {
 	// Start the goroutine to poll conntrack flows.
 	go exp.conntrackConnStore.Run(stopCh)
 
+	if exp.membership != nil {
+		exp.membership.run(stopCh)
+	}
+
 	defaultTimeout := exp.conntrackPriorityQueue.ActiveFlowTimeout
 	expireTimer := time.NewTimer(defaultTimeout)
 	for {
@@ -202,9 +238,15 @@ func (exp *FlowExporter) Run(stopCh <-chan struct{}) {
 			if exp.process != nil {
 				exp.process.CloseConnToCollector()
 			}
+			exp.closeShards()
 			expireTimer.Stop()
 			return
 		case <-expireTimer.C:
+			if exp.membership != nil {
+				// Flow records are sharded across the Flow Aggregator replicas.
+				expireTimer.Reset(exp.sendShardedFlowRecords(defaultTimeout))
+				continue
+			}
 			if exp.process == nil {
 				err := exp.initFlowExporter()
 				if err != nil {
@@ -234,18 +276,34 @@ func (exp *FlowExporter) Run(stopCh <-chan struct{}) {
 				expireTimer.Reset(defaultTimeout)
 				continue
 			}
+			if exp.dnsRecordStore != nil {
+				if err := exp.sendDNSRecords(); err != nil {
+					klog.ErrorS(err, "Error when sending DNS records")
+					exp.process.CloseConnToCollector()
+					exp.process = nil
+					expireTimer.Reset(defaultTimeout)
+					continue
+				}
+			}
 			expireTimer.Reset(nextExpireTime)
 		}
 	}
 }
 
-func (exp *FlowExporter) sendFlowRecords() (time.Duration, error) {
+// getExpiredConns pops out the expired connections from the connection stores
+// into expiredConns, and returns the time after which the next export should
+// happen.
+func (exp *FlowExporter) getExpiredConns() time.Duration {
 	currTime := time.Now()
 	var expireTime1, expireTime2 time.Duration
 	exp.expiredConns, expireTime1 = exp.conntrackConnStore.GetExpiredConns(exp.expiredConns, currTime, maxConnsToExport)
 	exp.expiredConns, expireTime2 = exp.denyConnStore.GetExpiredConns(exp.expiredConns, currTime, maxConnsToExport)
 	// Select the shorter time out among two connection stores to do the next round of export.
-	nextExpireTime := getMinTime(expireTime1, expireTime2)
+	return getMinTime(expireTime1, expireTime2)
+}
+
+func (exp *FlowExporter) sendFlowRecords() (time.Duration, error) {
+	nextExpireTime := exp.getExpiredConns()
 	for i := range exp.expiredConns {
 		if err := exp.exportConn(&exp.expiredConns[i]); err != nil {
 			klog.ErrorS(err, "Error when sending expired flow record")
@@ -257,6 +315,13 @@ func (exp *FlowExporter) sendFlowRecords() (time.Duration, error) {
 	return nextExpireTime, nil
 }
 
+func (exp *FlowExporter) closeProcess() {
+	if exp.process != nil {
+		exp.process.CloseConnToCollector()
+		exp.process = nil
+	}
+}
+
 func (exp *FlowExporter) initFlowExporter() error {
 	var err error
 	if exp.exporterInput.IsEncrypted {
@@ -304,6 +369,24 @@ func (exp *FlowExporter) initFlowExporter() error {
 		}
 		klog.V(2).Infof("Initialized flow exporter for IPv6 flow records and sent %d bytes size of template record", sentBytes)
 	}
+	if exp.dnsRecordStore != nil {
+		if exp.v4Enabled {
+			exp.templateIDDNSv4 = exp.process.NewTemplateID()
+			sentBytes, err := exp.sendDNSTemplateSet(false)
+			if err != nil {
+				return err
+			}
+			klog.V(2).Infof("Initialized flow exporter for IPv4 DNS records and sent %d bytes size of template record", sentBytes)
+		}
+		if exp.v6Enabled {
+			exp.templateIDDNSv6 = exp.process.NewTemplateID()
+			sentBytes, err := exp.sendDNSTemplateSet(true)
+			if err != nil {
+				return err
+			}
+			klog.V(2).Infof("Initialized flow exporter for IPv6 DNS records and sent %d bytes size of template record", sentBytes)
+		}
+	}
 	metrics.ReconnectionsToFlowCollector.Inc()
 	return nil
 }
@@ -387,6 +470,15 @@ func (exp *FlowExporter) addConnToSet(conn *flowexporter.Connection) error {
 	if err := exp.ipfixSet.PrepareSet(ipfixentities.Data, templateID); err != nil {
 		return err
 	}
+	flowType := exp.findFlowType(*conn)
+	if flowType == ipfixregistry.FlowTypeToExternal {
+		if exp.egressQuerier != nil {
+			exp.fillEgressInfo(conn)
+		}
+		if exp.dnsRecordStore != nil {
+			conn.DestinationFQDN = exp.dnsRecordStore.LookupFQDN(conn.FlowKey.DestinationAddress)
+		}
+	}
 	// Iterate over all infoElements in the list
 	for i := range eL {
 		ie := eL[i]
@@ -518,7 +610,15 @@ func (exp *FlowExporter) addConnToSet(conn *flowexporter.Connection) error {
 		case "tcpState":
 			ie.SetStringValue(conn.TCPState)
 		case "flowType":
-			ie.SetUnsigned8Value(exp.findFlowType(*conn))
+			ie.SetUnsigned8Value(flowType)
+		case "egressName":
+			ie.SetStringValue(conn.EgressName)
+		case "egressIP":
+			ie.SetStringValue(conn.EgressIP)
+		case "egressNodeName":
+			ie.SetStringValue(conn.EgressNodeName)
+		case "destinationFQDN":
+			ie.SetStringValue(conn.DestinationFQDN)
 		}
 	}
 	err := exp.ipfixSet.AddRecord(eL, templateID)
@@ -564,6 +664,24 @@ func (exp *FlowExporter) findFlowType(conn flowexporter.Connection) uint8 {
 	return 0
 }
 
+// fillEgressInfo fills the Egress applied to the source Pod of a Pod-to-External
+// flow, the SNAT IP and the Node holding the SNAT IP.
+func (exp *FlowExporter) fillEgressInfo(conn *flowexporter.Connection) {
+	if conn.SourcePodName == "" {
+		return
+	}
+	egressName, egressIP, egressNodeName, err := exp.egressQuerier.GetEgress(conn.SourcePodNamespace, conn.SourcePodName)
+	if err != nil {
+		// No Egress is applied to the Pod.
+		return
+	}
+	conn.EgressName = egressName
+	conn.EgressIP = egressIP
+	conn.EgressNodeName = egressNodeName
+	klog.V(4).InfoS("Filled Egress info for flow", "egress", egressName, "egressIP", egressIP, "egressNode", egressNodeName,
+		"pod", klog.KRef(conn.SourcePodNamespace, conn.SourcePodName))
+}
+
 func (exp *FlowExporter) exportConn(conn *flowexporter.Connection) error {
 	// TODO: more records per data set will be supported when go-ipfix supports size check when adding records
 	if err := exp.addConnToSet(conn); err != nil {
@@ -15,12 +15,14 @@
 package exporter
 
 import (
+	"fmt"
 	"net"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/miekg/dns"
 	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
@@ -33,8 +35,12 @@ import (
 	"antrea.io/antrea/pkg/agent/flowexporter"
 	"antrea.io/antrea/pkg/agent/flowexporter/connections"
 	connectionstest "antrea.io/antrea/pkg/agent/flowexporter/connections/testing"
+	"antrea.io/antrea/pkg/agent/interfacestore"
+	interfacestoretest "antrea.io/antrea/pkg/agent/interfacestore/testing"
 	"antrea.io/antrea/pkg/agent/metrics"
+	"antrea.io/antrea/pkg/ipfix"
 	ipfixtest "antrea.io/antrea/pkg/ipfix/testing"
+	queriertest "antrea.io/antrea/pkg/querier/testing"
 )
 
 const (
@@ -45,7 +51,7 @@ const (
 )
 
 func init() {
-	registry.LoadRegistry()
+	ipfix.LoadRegistry()
 }
 
 func TestFlowExporter_sendTemplateSet(t *testing.T) {
@@ -335,6 +341,8 @@ func getElemList(ianaIE []string, antreaIE []string) []ipfixentities.InfoElement
 			ie.SetStringValue("")
 		case "ingressNetworkPolicyRuleName", "egressNetworkPolicyRuleName":
 			ie.SetStringValue("")
+		case "egressName", "egressIP", "egressNodeName", "destinationFQDN":
+			ie.SetStringValue("")
 		case "ingressNetworkPolicyType", "egressNetworkPolicyType", "ingressNetworkPolicyRuleAction", "egressNetworkPolicyRuleAction":
 			ie.SetUnsigned8Value(uint8(0))
 		}
@@ -626,6 +634,144 @@ func runSendFlowRecordTests(t *testing.T, flowExp *FlowExporter, isIPv6 bool) {
 	}
 }
 
+func TestFlowExporter_fillEgressInfo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEgressQuerier := queriertest.NewMockEgressQuerier(ctrl)
+	exp := &FlowExporter{egressQuerier: mockEgressQuerier}
+
+	conn := getConnection(false, true, 0x4, 6, "ESTABLISHED")
+	mockEgressQuerier.EXPECT().GetEgress("ns", "pod").Return("test-egress", "172.18.0.1", "node-1", nil)
+	exp.fillEgressInfo(conn)
+	assert.Equal(t, "test-egress", conn.EgressName)
+	assert.Equal(t, "172.18.0.1", conn.EgressIP)
+	assert.Equal(t, "node-1", conn.EgressNodeName)
+
+	conn = getConnection(false, true, 0x4, 6, "ESTABLISHED")
+	mockEgressQuerier.EXPECT().GetEgress("ns", "pod").Return("", "", "", fmt.Errorf("no Egress applied to Pod ns/pod"))
+	exp.fillEgressInfo(conn)
+	assert.Empty(t, conn.EgressName)
+	assert.Empty(t, conn.EgressIP)
+	assert.Empty(t, conn.EgressNodeName)
+}
+
+func TestFlowExporter_sendDNSRecords(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockIPFIXExpProc := ipfixtest.NewMockIPFIXExportingProcess(ctrl)
+	mockDataSet := ipfixentitiestesting.NewMockSet(ctrl)
+	mockIfaceStore := interfacestoretest.NewMockInterfaceStore(ctrl)
+	mockConnDumper := connectionstest.NewMockConnTrackDumper(ctrl)
+
+	clientIP, serverIP := net.ParseIP("10.10.0.2"), net.ParseIP("10.10.0.3")
+	podIface := interfacestore.NewContainerInterface("pod1-abc", "container1", "pod1", "ns1", nil, []net.IP{clientIP}, 0)
+	mockIfaceStore.EXPECT().GetInterfaceByIP(clientIP.String()).Return(podIface, true)
+
+	elemList := make([]ipfixentities.InfoElementWithValue, 0)
+	for _, ie := range IANAInfoElementsDNSIPv4 {
+		elemList = append(elemList, createElement(ie, ipfixregistry.IANAEnterpriseID))
+	}
+	for _, ie := range AntreaInfoElementsDNS {
+		elemList = append(elemList, createElement(ie, ipfixregistry.AntreaEnterpriseID))
+	}
+	o := &flowexporter.FlowExporterOptions{ActiveFlowTimeout: testActiveFlowTimeout, IdleFlowTimeout: testIdleFlowTimeout}
+	flowExp := &FlowExporter{
+		process:            mockIPFIXExpProc,
+		ipfixSet:           mockDataSet,
+		elementsListDNSv4:  elemList,
+		templateIDDNSv4:    testTemplateIDv4,
+		conntrackConnStore: connections.NewConntrackConnectionStore(mockConnDumper, true, false, nil, nil, nil, o),
+		dnsRecordStore:     connections.NewDNSRecordStore(mockIfaceStore),
+		nodeName:           "node1",
+	}
+
+	responseTime := time.Now()
+	queryConn := &flowexporter.Connection{
+		StartTime: responseTime.Add(-2 * time.Millisecond),
+		FlowKey:   flowexporter.Tuple{SourceAddress: clientIP, DestinationAddress: serverIP, Protocol: 17, SourcePort: 40000, DestinationPort: 53},
+	}
+	connKey := flowexporter.NewConnectionKey(queryConn)
+	flowExp.conntrackConnStore.AddConnToMap(&connKey, queryConn)
+
+	msg := &dns.Msg{}
+	msg.SetQuestion("example.com.", dns.TypeA)
+	msg.Answer = []dns.RR{&dns.A{Hdr: dns.RR_Header{Name: "example.com.", Rrtype: dns.TypeA, Ttl: 60}, A: net.ParseIP("93.184.216.34")}}
+	flowExp.dnsRecordStore.AddDNSResponse(msg, clientIP, 40000, serverIP, 53, responseTime)
+
+	mockDataSet.EXPECT().ResetSet()
+	mockDataSet.EXPECT().PrepareSet(ipfixentities.Data, testTemplateIDv4).Return(nil)
+	mockDataSet.EXPECT().AddRecord(elemList, testTemplateIDv4).Return(nil)
+	mockIPFIXExpProc.EXPECT().SendSet(mockDataSet).Return(0, nil)
+	assert.NoError(t, flowExp.sendDNSRecords())
+	assert.Equal(t, uint64(1), flowExp.numDataSetsSent)
+
+	for _, ie := range elemList {
+		switch ie.GetInfoElement().Name {
+		case "sourceIPv4Address":
+			assert.Equal(t, clientIP.To4(), ie.GetIPAddressValue().To4())
+		case "destinationTransportPort":
+			assert.Equal(t, uint16(53), ie.GetUnsigned16Value())
+		case "sourcePodName":
+			assert.Equal(t, "pod1", ie.GetStringValue())
+		case "sourceNodeName":
+			assert.Equal(t, "node1", ie.GetStringValue())
+		case "dnsQueryName":
+			assert.Equal(t, "example.com", ie.GetStringValue())
+		case "dnsQueryType":
+			assert.Equal(t, dns.TypeA, ie.GetUnsigned16Value())
+		case "dnsAnswers":
+			assert.Equal(t, "93.184.216.34", ie.GetStringValue())
+		case "dnsLatencyMicroseconds":
+			assert.Equal(t, uint32(2000), ie.GetUnsigned32Value())
+		}
+	}
+}
+
+func TestFlowExporter_fillDNSQueryTimes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockConnDumper := connectionstest.NewMockConnTrackDumper(ctrl)
+	o := &flowexporter.FlowExporterOptions{ActiveFlowTimeout: testActiveFlowTimeout, IdleFlowTimeout: testIdleFlowTimeout}
+	flowExp := &FlowExporter{
+		conntrackConnStore: connections.NewConntrackConnectionStore(mockConnDumper, true, false, nil, nil, nil, o),
+	}
+
+	clientIP, serverIP := net.ParseIP("10.10.0.2"), net.ParseIP("10.10.0.3")
+	responseTime := time.Now()
+	queryTime := responseTime.Add(-2 * time.Millisecond)
+	for _, conn := range []*flowexporter.Connection{
+		{
+			StartTime: queryTime,
+			FlowKey:   flowexporter.Tuple{SourceAddress: clientIP, DestinationAddress: serverIP, Protocol: 17, SourcePort: 40000, DestinationPort: 53},
+		},
+		{
+			// The start time was not provided by conntrack.
+			StartTime:            responseTime.Add(time.Second),
+			IsStartTimeEstimated: true,
+			FlowKey:              flowexporter.Tuple{SourceAddress: clientIP, DestinationAddress: serverIP, Protocol: 17, SourcePort: 40001, DestinationPort: 53},
+		},
+		{
+			StartTime: queryTime,
+			FlowKey:   flowexporter.Tuple{SourceAddress: clientIP, DestinationAddress: serverIP, Protocol: 6, SourcePort: 40002, DestinationPort: 53},
+		},
+	} {
+		connKey := flowexporter.NewConnectionKey(conn)
+		flowExp.conntrackConnStore.AddConnToMap(&connKey, conn)
+	}
+
+	records := []flowexporter.DNSRecord{
+		{ClientIP: clientIP, ClientPort: 40000, ResponseTime: responseTime},
+		{ClientIP: clientIP, ClientPort: 40001, ResponseTime: responseTime},
+		{ClientIP: clientIP, ClientPort: 40002, ResponseTime: responseTime},
+		{ClientIP: clientIP, ClientPort: 40003, ResponseTime: responseTime},
+	}
+	flowExp.fillDNSQueryTimes(records)
+	assert.Equal(t, queryTime, records[0].QueryTime)
+	assert.True(t, records[1].QueryTime.IsZero())
+	assert.True(t, records[2].QueryTime.IsZero())
+	assert.True(t, records[3].QueryTime.IsZero())
+}
+
 func getNumOfConntrackConns(connStore *connections.ConntrackConnectionStore) int {
 	count := 0
 	countNumOfConns := func(key flowexporter.ConnectionKey, conn *flowexporter.Connection) error {
@@ -0,0 +1,246 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"hash/fnv"
+	"net"
+	"sort"
+	"strconv"
+	"time"
+
+	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/flowexporter"
+)
+
+// collectorMembership tracks the replicas of the Flow Aggregator using the
+// Endpoints of the Flow Aggregator Service.
+type collectorMembership struct {
+	service         types.NamespacedName
+	protocol        corev1.Protocol
+	informerFactory informers.SharedInformerFactory
+	endpointsLister corelisters.EndpointsLister
+	endpointsSynced cache.InformerSynced
+}
+
+func newCollectorMembership(k8sClient kubernetes.Interface, service types.NamespacedName, collectorProto string) *collectorMembership {
+	// Only watch the Endpoints of the Flow Aggregator Service.
+	factory := informers.NewSharedInformerFactoryWithOptions(k8sClient, 0,
+		informers.WithNamespace(service.Namespace),
+		informers.WithTweakListOptions(func(options *metav1.ListOptions) {
+			options.FieldSelector = fields.OneTermEqualSelector("metadata.name", service.Name).String()
+		}))
+	endpointsInformer := factory.Core().V1().Endpoints()
+	protocol := corev1.ProtocolTCP
+	if collectorProto == "udp" {
+		protocol = corev1.ProtocolUDP
+	}
+	return &collectorMembership{
+		service:         service,
+		protocol:        protocol,
+		informerFactory: factory,
+		endpointsLister: endpointsInformer.Lister(),
+		endpointsSynced: endpointsInformer.Informer().HasSynced,
+	}
+}
+
+func (m *collectorMembership) run(stopCh <-chan struct{}) {
+	m.informerFactory.Start(stopCh)
+}
+
+// members returns the sorted addresses of the ready Flow Aggregator replicas.
+func (m *collectorMembership) members() []string {
+	if !m.endpointsSynced() {
+		return nil
+	}
+	endpoints, err := m.endpointsLister.Endpoints(m.service.Namespace).Get(m.service.Name)
+	if err != nil {
+		klog.V(2).InfoS("Failed to get Endpoints of flow collector Service", "service", m.service, "err", err)
+		return nil
+	}
+	var members []string
+	for _, subset := range endpoints.Subsets {
+		for _, port := range subset.Ports {
+			if port.Protocol != m.protocol {
+				continue
+			}
+			for _, address := range subset.Addresses {
+				members = append(members, net.JoinHostPort(address.IP, strconv.Itoa(int(port.Port))))
+			}
+		}
+	}
+	sort.Strings(members)
+	return members
+}
+
+// selectMember selects the member to which the record with the provided flow
+// key hash is sent, using rendezvous hashing: when a member is added or
+// removed, only the flows assigned to this member are moved.
+func selectMember(members []string, keyHash uint64) string {
+	var selected string
+	var maxScore uint64
+	for _, member := range members {
+		h := fnv.New64a()
+		h.Write([]byte(member))
+		if score := mix64(h.Sum64() ^ keyHash); selected == "" || score > maxScore {
+			selected, maxScore = member, score
+		}
+	}
+	return selected
+}
+
+// mix64 is the finalizer of SplitMix64, used to get uniformly distributed
+// scores from the combination of the member and flow key hashes.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+// newShardExporter returns a FlowExporter which sends flow records to a single
+// Flow Aggregator replica. It shares the configuration of exp, but has its own
+// IPFIX exporting process and templates.
+func (exp *FlowExporter) newShardExporter(collectorAddr string) *FlowExporter {
+	expInput := exp.exporterInput
+	expInput.CollectorAddress = collectorAddr
+	return &FlowExporter{
+		registry:            exp.registry,
+		v4Enabled:           exp.v4Enabled,
+		v6Enabled:           exp.v6Enabled,
+		exporterInput:       expInput,
+		ipfixSet:            ipfixentities.NewSet(false),
+		k8sClient:           exp.k8sClient,
+		nodeRouteController: exp.nodeRouteController,
+		egressQuerier:       exp.egressQuerier,
+		isNetworkPolicyOnly: exp.isNetworkPolicyOnly,
+		nodeName:            exp.nodeName,
+		dnsRecordStore:      exp.dnsRecordStore,
+	}
+}
+
+// syncShards closes the exporting processes to the replicas which are not
+// members anymore.
+func (exp *FlowExporter) syncShards(members []string) {
+	memberSet := make(map[string]bool, len(members))
+	for _, member := range members {
+		memberSet[member] = true
+	}
+	for addr, shard := range exp.shards {
+		if memberSet[addr] {
+			continue
+		}
+		klog.InfoS("Flow collector replica removed", "address", addr)
+		shard.closeProcess()
+		delete(exp.shards, addr)
+	}
+}
+
+// sendShardedFlowRecords sends the expired connections to the Flow Aggregator
+// replicas, and returns the time after which the next export should happen. A
+// replica which cannot be reached is skipped until the next export cycle, and
+// the records assigned to it are dropped.
+func (exp *FlowExporter) sendShardedFlowRecords(defaultTimeout time.Duration) time.Duration {
+	members := exp.membership.members()
+	exp.syncShards(members)
+	if len(members) == 0 {
+		klog.InfoS("No flow collector replica available, will retry in next cycle", "service", exp.membership.service)
+		return defaultTimeout
+	}
+	nextExpireTime := exp.getExpiredConns()
+	failed := make(map[string]error)
+	// getShard returns the exporter of the replica to which the record with
+	// the provided key hash is sent, or nil if the replica cannot be reached.
+	getShard := func(keyHash uint64) *FlowExporter {
+		addr := selectMember(members, keyHash)
+		if _, ok := failed[addr]; ok {
+			return nil
+		}
+		shard, ok := exp.shards[addr]
+		if !ok {
+			klog.InfoS("Flow collector replica added", "address", addr)
+			shard = exp.newShardExporter(addr)
+			exp.shards[addr] = shard
+		}
+		if shard.process == nil {
+			if err := shard.initFlowExporter(); err != nil {
+				shard.closeProcess()
+				failed[addr] = err
+				return nil
+			}
+		}
+		return shard
+	}
+	for i := range exp.expiredConns {
+		conn := &exp.expiredConns[i]
+		shard := getShard(flowexporter.FlowKeyHash(conn.FlowKey))
+		if shard == nil {
+			continue
+		}
+		if err := shard.exportConn(conn); err != nil {
+			shard.closeProcess()
+			failed[shard.exporterInput.CollectorAddress] = err
+		}
+	}
+	// Clear expiredConns slice after exporting. Allocated memory is kept.
+	exp.expiredConns = exp.expiredConns[:0]
+	if exp.dnsRecordStore != nil {
+		records := exp.dnsRecordStore.PopRecords()
+		exp.fillDNSQueryTimes(records)
+		for i := range records {
+			record := &records[i]
+			shard := getShard(flowexporter.FlowKeyHash(flowexporter.Tuple{
+				SourceAddress:      record.ClientIP,
+				DestinationAddress: record.ServerIP,
+				Protocol:           17,
+				SourcePort:         record.ClientPort,
+				DestinationPort:    record.ServerPort,
+			}))
+			if shard == nil {
+				continue
+			}
+			if err := shard.exportDNSRecord(record); err != nil {
+				shard.closeProcess()
+				failed[shard.exporterInput.CollectorAddress] = err
+			}
+		}
+	}
+	if len(failed) > 0 {
+		for addr, err := range failed {
+			klog.ErrorS(err, "Error when sending expired flow records to flow collector replica", "address", addr)
+		}
+		return defaultTimeout
+	}
+	return nextExpireTime
+}
+
+func (exp *FlowExporter) closeShards() {
+	for addr, shard := range exp.shards {
+		shard.closeProcess()
+		delete(exp.shards, addr)
+	}
+}
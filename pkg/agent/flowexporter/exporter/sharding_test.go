@@ -0,0 +1,98 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestSelectMember(t *testing.T) {
+	assert.Equal(t, "", selectMember(nil, 1))
+
+	members := []string{"10.10.0.10:4739", "10.10.1.10:4739", "10.10.2.10:4739"}
+	counts := make(map[string]int)
+	selected := make(map[uint64]string)
+	for key := uint64(0); key < 3000; key++ {
+		member := selectMember(members, key)
+		selected[key] = member
+		counts[member]++
+	}
+	// Flow records are spread across all the members.
+	for _, member := range members {
+		assert.Greater(t, counts[member], 500, "member %s got too few flows", member)
+	}
+
+	// When a member is removed, only the flows assigned to it are moved.
+	remaining := members[1:]
+	for key := uint64(0); key < 3000; key++ {
+		member := selectMember(remaining, key)
+		if selected[key] != members[0] {
+			assert.Equal(t, selected[key], member)
+		} else {
+			assert.Contains(t, remaining, member)
+		}
+	}
+}
+
+func TestCollectorMembership(t *testing.T) {
+	service := types.NamespacedName{Namespace: "flow-aggregator", Name: "flow-aggregator"}
+	endpoints := &corev1.Endpoints{
+		ObjectMeta: metav1.ObjectMeta{Namespace: service.Namespace, Name: service.Name},
+		Subsets: []corev1.EndpointSubset{
+			{
+				Addresses:         []corev1.EndpointAddress{{IP: "10.10.1.10"}, {IP: "10.10.0.10"}},
+				NotReadyAddresses: []corev1.EndpointAddress{{IP: "10.10.2.10"}},
+				Ports: []corev1.EndpointPort{
+					{Name: "ipfix-udp", Port: 4739, Protocol: corev1.ProtocolUDP},
+					{Name: "ipfix-tcp", Port: 4740, Protocol: corev1.ProtocolTCP},
+				},
+			},
+		},
+	}
+	otherEndpoints := endpoints.DeepCopy()
+	otherEndpoints.Name = "other"
+	k8sClient := fake.NewSimpleClientset(endpoints, otherEndpoints)
+
+	for _, tc := range []struct {
+		proto           string
+		expectedMembers []string
+	}{
+		{"tls", []string{"10.10.0.10:4740", "10.10.1.10:4740"}},
+		{"udp", []string{"10.10.0.10:4739", "10.10.1.10:4739"}},
+	} {
+		t.Run(tc.proto, func(t *testing.T) {
+			stopCh := make(chan struct{})
+			defer close(stopCh)
+			membership := newCollectorMembership(k8sClient, service, tc.proto)
+			membership.run(stopCh)
+			var members []string
+			err := wait.PollImmediate(10*time.Millisecond, time.Second, func() (bool, error) {
+				members = membership.members()
+				return len(members) > 0, nil
+			})
+			assert.NoError(t, err, fmt.Sprintf("members not found, got %v", members))
+			assert.Equal(t, tc.expectedMembers, members)
+		})
+	}
+}
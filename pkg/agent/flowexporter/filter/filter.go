@@ -0,0 +1,223 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/flowexporter"
+	agentconfig "antrea.io/antrea/pkg/config/agent"
+)
+
+var protocolNumbers = map[string]uint8{
+	"icmp":   1,
+	"tcp":    6,
+	"udp":    17,
+	"icmpv6": 58,
+	"sctp":   132,
+}
+
+// ParseProtocols converts the protocol names of the flow export filter to
+// protocol numbers.
+func ParseProtocols(protocols []string) (map[uint8]bool, error) {
+	numbers := make(map[uint8]bool, len(protocols))
+	for _, protocol := range protocols {
+		number, ok := protocolNumbers[strings.ToLower(protocol)]
+		if !ok {
+			return nil, fmt.Errorf("unsupported protocol %s in flow export filter", protocol)
+		}
+		numbers[number] = true
+	}
+	return numbers, nil
+}
+
+// ValidateConfig validates the flow export filter configuration.
+func ValidateConfig(config agentconfig.FlowExportFilterConfig) error {
+	if config.PodLabelSelector != "" {
+		if _, err := labels.Parse(config.PodLabelSelector); err != nil {
+			return fmt.Errorf("invalid Pod label selector in flow export filter: %v", err)
+		}
+	}
+	if _, err := ParseProtocols(config.Protocols); err != nil {
+		return err
+	}
+	for _, port := range config.DestinationPorts {
+		if port <= 0 || port > 65535 {
+			return fmt.Errorf("invalid destination port %d in flow export filter", port)
+		}
+	}
+	return nil
+}
+
+// ConnectionFilter implements flowexporter.ConnectionFilter. A connection is
+// exported only if it is sampled and matches all the configured filters. The
+// Namespace and Pod label filters are evaluated against the Pods known by the
+// Node, i.e. the local Pods: a connection matches if any of its local Pods
+// matches.
+type ConnectionFilter struct {
+	samplingRate        uint32
+	includeNamespaces   sets.String
+	excludeNamespaces   sets.String
+	podSelector         labels.Selector
+	podLister           corelisters.PodLister
+	protocols           map[uint8]bool
+	destinationPorts    map[uint16]bool
+	excludeHealthChecks bool
+	// nodeIPs are the source IPs of the health-check connections, i.e. the
+	// IPs of the Antrea gateway and of the Node.
+	nodeIPs []net.IP
+}
+
+var _ flowexporter.ConnectionFilter = &ConnectionFilter{}
+
+// NewConnectionFilter creates a ConnectionFilter from the flow export filter
+// configuration. It returns nil if the configuration does not filter any
+// connection. podLister is required only when a Pod label selector is
+// configured.
+func NewConnectionFilter(config agentconfig.FlowExportFilterConfig, podLister corelisters.PodLister, nodeIPs []net.IP) (*ConnectionFilter, error) {
+	if err := ValidateConfig(config); err != nil {
+		return nil, err
+	}
+	f := &ConnectionFilter{
+		samplingRate:        config.SamplingRate,
+		excludeHealthChecks: config.ExcludeHealthChecks,
+		nodeIPs:             nodeIPs,
+	}
+	if len(config.IncludeNamespaces) > 0 {
+		f.includeNamespaces = sets.NewString(config.IncludeNamespaces...)
+	}
+	if len(config.ExcludeNamespaces) > 0 {
+		f.excludeNamespaces = sets.NewString(config.ExcludeNamespaces...)
+	}
+	if config.PodLabelSelector != "" {
+		selector, _ := labels.Parse(config.PodLabelSelector)
+		if podLister == nil {
+			return nil, fmt.Errorf("a Pod lister is required to filter connections by Pod labels")
+		}
+		f.podSelector = selector
+		f.podLister = podLister
+	}
+	if len(config.Protocols) > 0 {
+		f.protocols, _ = ParseProtocols(config.Protocols)
+	}
+	if len(config.DestinationPorts) > 0 {
+		f.destinationPorts = make(map[uint16]bool, len(config.DestinationPorts))
+		for _, port := range config.DestinationPorts {
+			f.destinationPorts[uint16(port)] = true
+		}
+	}
+	if f.samplingRate <= 1 && f.includeNamespaces == nil && f.excludeNamespaces == nil && f.podSelector == nil &&
+		f.protocols == nil && f.destinationPorts == nil && !f.excludeHealthChecks {
+		return nil, nil
+	}
+	return f, nil
+}
+
+func (f *ConnectionFilter) Match(conn *flowexporter.Connection) bool {
+	if f.samplingRate > 1 && flowexporter.FlowKeyHash(conn.FlowKey)%uint64(f.samplingRate) != 0 {
+		return false
+	}
+	if f.protocols != nil && !f.protocols[conn.FlowKey.Protocol] {
+		return false
+	}
+	if f.destinationPorts != nil && !f.destinationPorts[conn.FlowKey.DestinationPort] && !f.destinationPorts[conn.DestinationServicePort] {
+		return false
+	}
+	if f.excludeHealthChecks && f.isHealthCheck(conn) {
+		return false
+	}
+	type podRef struct{ namespace, name string }
+	var pods []podRef
+	if conn.SourcePodName != "" {
+		pods = append(pods, podRef{conn.SourcePodNamespace, conn.SourcePodName})
+	}
+	if conn.DestinationPodName != "" {
+		pods = append(pods, podRef{conn.DestinationPodNamespace, conn.DestinationPodName})
+	}
+	if f.excludeNamespaces != nil {
+		for _, pod := range pods {
+			if f.excludeNamespaces.Has(pod.namespace) {
+				return false
+			}
+		}
+	}
+	if f.includeNamespaces != nil {
+		included := false
+		for _, pod := range pods {
+			if f.includeNamespaces.Has(pod.namespace) {
+				included = true
+				break
+			}
+		}
+		if !included {
+			return false
+		}
+	}
+	if f.podSelector != nil {
+		selected := false
+		for _, pod := range pods {
+			p, err := f.podLister.Pods(pod.namespace).Get(pod.name)
+			if err != nil {
+				klog.V(4).InfoS("Failed to get Pod to match flow export filter", "pod", klog.KRef(pod.namespace, pod.name), "err", err)
+				continue
+			}
+			if f.podSelector.Matches(labels.Set(p.Labels)) {
+				selected = true
+				break
+			}
+		}
+		if !selected {
+			return false
+		}
+	}
+	return true
+}
+
+// MatchNamespace returns true if the connections of the local Pods in the
+// Namespace can be exported, according to the Namespace filters. It can be
+// called on a nil ConnectionFilter, which matches all Namespaces.
+func (f *ConnectionFilter) MatchNamespace(namespace string) bool {
+	if f == nil {
+		return true
+	}
+	if f.excludeNamespaces != nil && f.excludeNamespaces.Has(namespace) {
+		return false
+	}
+	if f.includeNamespaces != nil && !f.includeNamespaces.Has(namespace) {
+		return false
+	}
+	return true
+}
+
+// isHealthCheck returns true if the connection is initiated by the local Node
+// to a local Pod, which is the case for kubelet probes.
+func (f *ConnectionFilter) isHealthCheck(conn *flowexporter.Connection) bool {
+	if conn.SourcePodName != "" || conn.DestinationPodName == "" {
+		return false
+	}
+	for _, ip := range f.nodeIPs {
+		if ip.Equal(conn.FlowKey.SourceAddress) {
+			return true
+		}
+	}
+	return false
+}
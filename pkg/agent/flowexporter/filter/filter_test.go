@@ -0,0 +1,166 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"antrea.io/antrea/pkg/agent/flowexporter"
+	agentconfig "antrea.io/antrea/pkg/config/agent"
+)
+
+var (
+	gatewayIP = net.ParseIP("10.10.0.1")
+	localPod  = &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "pod1", Labels: map[string]string{"app": "web"}}}
+)
+
+func newTestConn(srcIP string, srcPort uint16, dstIP string, dstPort uint16, protocol uint8) *flowexporter.Connection {
+	return &flowexporter.Connection{
+		FlowKey: flowexporter.Tuple{
+			SourceAddress:      net.ParseIP(srcIP),
+			DestinationAddress: net.ParseIP(dstIP),
+			Protocol:           protocol,
+			SourcePort:         srcPort,
+			DestinationPort:    dstPort,
+		},
+	}
+}
+
+func newTestPodLister(pods ...*corev1.Pod) corelisters.PodLister {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, pod := range pods {
+		indexer.Add(pod)
+	}
+	return corelisters.NewPodLister(indexer)
+}
+
+func TestNewConnectionFilter(t *testing.T) {
+	f, err := NewConnectionFilter(agentconfig.FlowExportFilterConfig{SamplingRate: 1}, nil, nil)
+	require.NoError(t, err)
+	assert.Nil(t, f, "No filter should be created when no connection is filtered")
+
+	for _, tc := range []struct {
+		config        agentconfig.FlowExportFilterConfig
+		expectedError string
+	}{
+		{agentconfig.FlowExportFilterConfig{Protocols: []string{"GRE"}}, "unsupported protocol GRE in flow export filter"},
+		{agentconfig.FlowExportFilterConfig{DestinationPorts: []int32{65536}}, "invalid destination port 65536 in flow export filter"},
+		{agentconfig.FlowExportFilterConfig{PodLabelSelector: "app in web"}, "invalid Pod label selector in flow export filter"},
+		{agentconfig.FlowExportFilterConfig{PodLabelSelector: "app=web"}, "a Pod lister is required to filter connections by Pod labels"},
+	} {
+		_, err := NewConnectionFilter(tc.config, nil, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), tc.expectedError)
+	}
+}
+
+func TestSampling(t *testing.T) {
+	f, err := NewConnectionFilter(agentconfig.FlowExportFilterConfig{SamplingRate: 4}, nil, nil)
+	require.NoError(t, err)
+	sampled := 0
+	for port := uint16(1024); port < 5024; port++ {
+		conn := newTestConn("10.10.0.2", port, "10.10.1.2", 80, 6)
+		reverseConn := newTestConn("10.10.1.2", 80, "10.10.0.2", port, 6)
+		match := f.Match(conn)
+		// Both Nodes must make the same decision.
+		assert.Equal(t, match, f.Match(reverseConn))
+		if match {
+			sampled++
+		}
+	}
+	assert.InDelta(t, 1000, sampled, 150)
+}
+
+func TestMatch(t *testing.T) {
+	healthCheck := newTestConn("10.10.0.1", 41000, "10.10.0.2", 8080, 6)
+	healthCheck.DestinationPodNamespace, healthCheck.DestinationPodName = "ns1", "pod1"
+	podToPod := newTestConn("10.10.0.2", 41000, "10.10.1.2", 80, 6)
+	podToPod.SourcePodNamespace, podToPod.SourcePodName = "ns1", "pod1"
+	dns := newTestConn("10.10.0.2", 41000, "10.10.1.3", 53, 17)
+	dns.SourcePodNamespace, dns.SourcePodName = "ns1", "pod1"
+	dns.DestinationServicePort = 53
+	remotePodToPod := newTestConn("10.10.1.2", 41000, "10.10.0.3", 80, 6)
+	remotePodToPod.DestinationPodNamespace, remotePodToPod.DestinationPodName = "ns2", "pod2"
+
+	for _, tc := range []struct {
+		name     string
+		config   agentconfig.FlowExportFilterConfig
+		expected []bool
+	}{
+		{
+			name:     "exclude health checks",
+			config:   agentconfig.FlowExportFilterConfig{ExcludeHealthChecks: true},
+			expected: []bool{false, true, true, true},
+		},
+		{
+			name:     "protocols",
+			config:   agentconfig.FlowExportFilterConfig{Protocols: []string{"udp"}},
+			expected: []bool{false, false, true, false},
+		},
+		{
+			name:     "destination ports",
+			config:   agentconfig.FlowExportFilterConfig{DestinationPorts: []int32{80, 8080}},
+			expected: []bool{true, true, false, true},
+		},
+		{
+			name:     "include Namespaces",
+			config:   agentconfig.FlowExportFilterConfig{IncludeNamespaces: []string{"ns2"}},
+			expected: []bool{false, false, false, true},
+		},
+		{
+			name:     "exclude Namespaces",
+			config:   agentconfig.FlowExportFilterConfig{ExcludeNamespaces: []string{"ns1"}},
+			expected: []bool{false, false, false, true},
+		},
+		{
+			name:     "Pod label selector",
+			config:   agentconfig.FlowExportFilterConfig{PodLabelSelector: "app=web"},
+			expected: []bool{true, true, true, false},
+		},
+		{
+			name:     "multiple filters",
+			config:   agentconfig.FlowExportFilterConfig{PodLabelSelector: "app=web", ExcludeHealthChecks: true, Protocols: []string{"TCP"}},
+			expected: []bool{false, true, false, false},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			f, err := NewConnectionFilter(tc.config, newTestPodLister(localPod), []net.IP{gatewayIP})
+			require.NoError(t, err)
+			for i, conn := range []*flowexporter.Connection{healthCheck, podToPod, dns, remotePodToPod} {
+				assert.Equal(t, tc.expected[i], f.Match(conn), fmt.Sprintf("unexpected result for connection %d", i))
+			}
+		})
+	}
+}
+
+func TestMatchNamespace(t *testing.T) {
+	var nilFilter *ConnectionFilter
+	assert.True(t, nilFilter.MatchNamespace("ns1"))
+
+	f, err := NewConnectionFilter(agentconfig.FlowExportFilterConfig{IncludeNamespaces: []string{"ns1", "ns2"}, ExcludeNamespaces: []string{"ns2"}}, nil, nil)
+	require.NoError(t, err)
+	assert.True(t, f.MatchNamespace("ns1"))
+	assert.False(t, f.MatchNamespace("ns2"))
+	assert.False(t, f.MatchNamespace("ns3"))
+}
@@ -17,6 +17,8 @@ package flowexporter
 import (
 	"net"
 	"time"
+
+	"k8s.io/apimachinery/pkg/types"
 )
 
 type ConnectionKey [5]string
@@ -36,6 +38,10 @@ type Connection struct {
 	ID        uint32
 	Timeout   uint32
 	StartTime time.Time
+	// IsStartTimeEstimated is true when the start time is not provided by
+	// conntrack, e.g. because nf_conntrack_timestamp is disabled. StartTime
+	// is then the time when the connection was first polled.
+	IsStartTimeEstimated bool
 	// For invalid and closed connections or deny connections: StopTime is the time when connection
 	// was updated last.
 	// For established connections: StopTime is latest time when it was polled.
@@ -72,6 +78,10 @@ type Connection struct {
 	EgressNetworkPolicyType        uint8
 	EgressNetworkPolicyRuleName    string
 	EgressNetworkPolicyRuleAction  uint8
+	EgressName                     string
+	EgressIP                       string
+	EgressNodeName                 string
+	DestinationFQDN                string
 	PrevPackets, PrevBytes         uint64
 	// Fields specific to conntrack connections
 	ReversePackets, ReverseBytes         uint64
@@ -88,12 +98,40 @@ type ItemToExpire struct {
 	Index int
 }
 
+// ConnectionFilter decides whether a connection is exported.
+type ConnectionFilter interface {
+	// Match returns true if the connection must be exported.
+	Match(conn *Connection) bool
+}
+
 type FlowExporterOptions struct {
 	FlowCollectorAddr      string
 	FlowCollectorProto     string
+	FlowCollectorService   *types.NamespacedName
 	ActiveFlowTimeout      time.Duration
 	IdleFlowTimeout        time.Duration
 	StaleConnectionTimeout time.Duration
 	PollInterval           time.Duration
 	ConnectUplinkToBridge  bool
+	ConnectionFilter       ConnectionFilter
+	EnableDNSVisibility    bool
+}
+
+// DNSRecord is a DNS response received by a local Pod.
+type DNSRecord struct {
+	PodNamespace string
+	PodName      string
+	ClientIP     net.IP
+	ClientPort   uint16
+	ServerIP     net.IP
+	ServerPort   uint16
+	QueryName    string
+	QueryType    uint16
+	ResponseCode uint8
+	// Answers are the A, AAAA and CNAME records of the response.
+	Answers      []string
+	ResponseTime time.Time
+	// QueryTime is the start time of the conntrack connection of the query. It
+	// is zero when the connection is not found.
+	QueryTime time.Time
 }
@@ -15,6 +15,10 @@
 package flowexporter
 
 import (
+	"bytes"
+	"encoding/binary"
+	"hash/fnv"
+	"net"
 	"strconv"
 
 	"github.com/vmware/go-ipfix/pkg/registry"
@@ -36,6 +40,28 @@ func NewConnectionKey(conn *Connection) ConnectionKey {
 	}
 }
 
+// FlowKeyHash hashes the flow key of a connection independently of its
+// direction, so that the records of both directions of a connection, and the
+// records exported by the source and destination Nodes, have the same hash.
+func FlowKeyHash(tuple Tuple) uint64 {
+	src, dst := endpointBytes(tuple.SourceAddress, tuple.SourcePort), endpointBytes(tuple.DestinationAddress, tuple.DestinationPort)
+	if bytes.Compare(src, dst) > 0 {
+		src, dst = dst, src
+	}
+	h := fnv.New64a()
+	h.Write(src)
+	h.Write(dst)
+	h.Write([]byte{tuple.Protocol})
+	return h.Sum64()
+}
+
+func endpointBytes(ip net.IP, port uint16) []byte {
+	b := make([]byte, net.IPv6len+2)
+	copy(b, ip.To16())
+	binary.BigEndian.PutUint16(b[net.IPv6len:], port)
+	return b
+}
+
 func IsConnectionDying(conn *Connection) bool {
 	// "TIME_WAIT" state indicates local endpoint has closed the connection.
 	// "CLOSE" state indicates closing RST flag is set and connection is closed.
@@ -66,6 +66,82 @@ var (
 		},
 	)
 
+	NetworkPolicyRulePacketCount = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "networkpolicy_rule_packet_count",
+			Help:           "Number of packets hit by NetworkPolicy rules on local Node, partitioned by policy type, Namespace, name and rule name. This metric gets updated every 60 seconds.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"policy_type", "namespace", "name", "rule"},
+	)
+
+	NetworkPolicyRuleByteCount = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "networkpolicy_rule_byte_count",
+			Help:           "Number of bytes hit by NetworkPolicy rules on local Node, partitioned by policy type, Namespace, name and rule name. This metric gets updated every 60 seconds.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"policy_type", "namespace", "name", "rule"},
+	)
+
+	NetworkPolicyRuleSessionCount = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "networkpolicy_rule_session_count",
+			Help:           "Number of sessions hit by NetworkPolicy rules on local Node, partitioned by policy type, Namespace, name and rule name. This metric gets updated every 60 seconds.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"policy_type", "namespace", "name", "rule"},
+	)
+
+	NetworkPolicyRuleMetricsOverflowCount = metrics.NewGauge(
+		&metrics.GaugeOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "networkpolicy_rule_metrics_overflow_count",
+			Help:           "Number of NetworkPolicy rules on local Node whose hit counts are not exported because the maximum number of exported rules has been reached.",
+			StabilityLevel: metrics.ALPHA,
+		},
+	)
+
+	PeerNodeLatencyRTT = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "peer_node_latency_rtt_milliseconds",
+			Help:           "Round-trip time of the last probe sent to the gateway of a peer Node, partitioned by peer Node name and probed IP.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"peer_node", "target_ip"},
+	)
+
+	PeerNodeLatencySentProbeCount = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "peer_node_latency_sent_probe_count",
+			Help:           "Number of probes sent to the gateway of a peer Node, partitioned by peer Node name and probed IP.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"peer_node", "target_ip"},
+	)
+
+	PeerNodeLatencyLostProbeCount = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "peer_node_latency_lost_probe_count",
+			Help:           "Number of probes sent to the gateway of a peer Node that got no reply before the next probe, partitioned by peer Node name and probed IP.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"peer_node", "target_ip"},
+	)
+
 	OVSTotalFlowCount = metrics.NewGauge(&metrics.GaugeOpts{
 		Namespace:      metricNamespaceAntrea,
 		Subsystem:      metricSubsystemAgent,
@@ -174,6 +250,7 @@ func InitializePrometheusMetrics() {
 	InitializeNetworkPolicyMetrics()
 	InitializeOVSMetrics()
 	InitializeConnectionMetrics()
+	InitializeNodeLatencyMetrics()
 }
 
 func InitializePodMetrics() {
@@ -194,6 +271,19 @@ func InitializeNetworkPolicyMetrics() {
 	if err := legacyregistry.Register(NetworkPolicyCount); err != nil {
 		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_networkpolicy_count")
 	}
+
+	if err := legacyregistry.Register(NetworkPolicyRulePacketCount); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_networkpolicy_rule_packet_count")
+	}
+	if err := legacyregistry.Register(NetworkPolicyRuleByteCount); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_networkpolicy_rule_byte_count")
+	}
+	if err := legacyregistry.Register(NetworkPolicyRuleSessionCount); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_networkpolicy_rule_session_count")
+	}
+	if err := legacyregistry.Register(NetworkPolicyRuleMetricsOverflowCount); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_networkpolicy_rule_metrics_overflow_count")
+	}
 }
 
 func InitializeOVSMetrics() {
@@ -240,3 +330,15 @@ func InitializeConnectionMetrics() {
 		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_conntrack_max_connection_count")
 	}
 }
+
+func InitializeNodeLatencyMetrics() {
+	if err := legacyregistry.Register(PeerNodeLatencyRTT); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_peer_node_latency_rtt_milliseconds")
+	}
+	if err := legacyregistry.Register(PeerNodeLatencySentProbeCount); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_peer_node_latency_sent_probe_count")
+	}
+	if err := legacyregistry.Register(PeerNodeLatencyLostProbeCount); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_peer_node_latency_lost_probe_count")
+	}
+}
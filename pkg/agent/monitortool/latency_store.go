@@ -0,0 +1,171 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitortool
+
+import (
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"antrea.io/antrea/pkg/agent/metrics"
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
+)
+
+// targetLatency is the latency stats of a probed IP of a peer Node.
+type targetLatency struct {
+	lastSendTime time.Time
+	lastRecvTime time.Time
+	lastRTT      time.Duration
+	sentProbes   int64
+	lostProbes   int64
+	// pending indicates whether the last probe is still waiting for its reply, pendingSeq is its sequence number.
+	pending    bool
+	pendingSeq uint16
+}
+
+// latencyStore stores the latency stats of the probed IPs of the peer Nodes and keeps the Prometheus metrics in
+// sync with them.
+type latencyStore struct {
+	mutex sync.Mutex
+	// nodeTargets is a mapping from peer Node names to the latency stats of their probed IPs, keyed by the IPs.
+	nodeTargets map[string]map[string]*targetLatency
+	// ipToNode is a mapping from probed IPs to peer Node names, used to look up the target of a reply.
+	ipToNode map[string]string
+}
+
+func newLatencyStore() *latencyStore {
+	return &latencyStore{
+		nodeTargets: map[string]map[string]*targetLatency{},
+		ipToNode:    map[string]string{},
+	}
+}
+
+// syncTargets updates the probed IPs to the provided ones, which are keyed by peer Node names. The stats and the
+// metrics of the IPs that are no longer probed are removed.
+func (s *latencyStore) syncTargets(targets map[string][]net.IP) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	desiredIPs := map[string]string{}
+	for nodeName, ips := range targets {
+		for _, ip := range ips {
+			desiredIPs[ip.String()] = nodeName
+		}
+	}
+	for ip, nodeName := range s.ipToNode {
+		if desiredIPs[ip] == nodeName {
+			continue
+		}
+		delete(s.ipToNode, ip)
+		delete(s.nodeTargets[nodeName], ip)
+		if len(s.nodeTargets[nodeName]) == 0 {
+			delete(s.nodeTargets, nodeName)
+		}
+		labels := metricLabels(nodeName, ip)
+		metrics.PeerNodeLatencyRTT.Delete(labels)
+		metrics.PeerNodeLatencySentProbeCount.Delete(labels)
+		metrics.PeerNodeLatencyLostProbeCount.Delete(labels)
+	}
+	for ip, nodeName := range desiredIPs {
+		if _, exists := s.ipToNode[ip]; exists {
+			continue
+		}
+		s.ipToNode[ip] = nodeName
+		if s.nodeTargets[nodeName] == nil {
+			s.nodeTargets[nodeName] = map[string]*targetLatency{}
+		}
+		s.nodeTargets[nodeName][ip] = &targetLatency{}
+	}
+}
+
+// recordProbe records a probe sent to a probed IP of a peer Node. If the previous probe sent to the IP hasn't got
+// its reply, it's counted as lost.
+func (s *latencyStore) recordProbe(nodeName string, ip net.IP, seq uint16, sendTime time.Time) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	target, exists := s.nodeTargets[nodeName][ip.String()]
+	if !exists {
+		return
+	}
+	labels := metricLabels(nodeName, ip.String())
+	if target.pending {
+		target.lostProbes++
+		metrics.PeerNodeLatencyLostProbeCount.With(labels).Inc()
+	}
+	target.pending = true
+	target.pendingSeq = seq
+	target.lastSendTime = sendTime
+	target.sentProbes++
+	metrics.PeerNodeLatencySentProbeCount.With(labels).Inc()
+}
+
+// recordReply records a reply received from a probed IP. It's ignored if it doesn't match the last probe sent to
+// the IP, e.g. a late reply of a probe that has been counted as lost.
+func (s *latencyStore) recordReply(ip net.IP, seq uint16, recvTime time.Time) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	nodeName, exists := s.ipToNode[ip.String()]
+	if !exists {
+		return
+	}
+	target := s.nodeTargets[nodeName][ip.String()]
+	if !target.pending || target.pendingSeq != seq {
+		return
+	}
+	target.pending = false
+	target.lastRecvTime = recvTime
+	target.lastRTT = recvTime.Sub(target.lastSendTime)
+	metrics.PeerNodeLatencyRTT.With(metricLabels(nodeName, ip.String())).Set(float64(target.lastRTT) / float64(time.Millisecond))
+}
+
+// getPeerNodeLatencyStats returns the latency stats of the peer Nodes, sorted by Node names and IPs.
+func (s *latencyStore) getPeerNodeLatencyStats() []statsv1alpha1.PeerNodeLatencyStats {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	peerStats := make([]statsv1alpha1.PeerNodeLatencyStats, 0, len(s.nodeTargets))
+	for nodeName, targets := range s.nodeTargets {
+		targetStats := make([]statsv1alpha1.TargetIPLatencyStats, 0, len(targets))
+		for ip, target := range targets {
+			targetStats = append(targetStats, statsv1alpha1.TargetIPLatencyStats{
+				TargetIP:                   ip,
+				LastSendTime:               metav1.NewTime(target.lastSendTime),
+				LastRecvTime:               metav1.NewTime(target.lastRecvTime),
+				LastMeasuredRTTNanoseconds: target.lastRTT.Nanoseconds(),
+				SentProbes:                 target.sentProbes,
+				LostProbes:                 target.lostProbes,
+			})
+		}
+		sort.Slice(targetStats, func(i, j int) bool {
+			return targetStats[i].TargetIP < targetStats[j].TargetIP
+		})
+		peerStats = append(peerStats, statsv1alpha1.PeerNodeLatencyStats{
+			NodeName:             nodeName,
+			TargetIPLatencyStats: targetStats,
+		})
+	}
+	sort.Slice(peerStats, func(i, j int) bool {
+		return peerStats[i].NodeName < peerStats[j].NodeName
+	})
+	return peerStats
+}
+
+func metricLabels(nodeName, ip string) map[string]string {
+	return map[string]string{
+		"peer_node": nodeName,
+		"target_ip": ip,
+	}
+}
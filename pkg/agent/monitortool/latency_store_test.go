@@ -0,0 +1,106 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitortool
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
+)
+
+var (
+	node2IP  = net.ParseIP("10.10.1.1")
+	node3IP  = net.ParseIP("10.10.2.1")
+	node3IP6 = net.ParseIP("fd00:10:10:2::1")
+)
+
+func TestLatencyStore(t *testing.T) {
+	s := newLatencyStore()
+	t0 := time.Unix(1650000000, 0)
+	s.syncTargets(map[string][]net.IP{
+		"node2": {node2IP},
+		"node3": {node3IP, node3IP6},
+	})
+
+	// First round: node2 and the IPv4 gateway of node3 reply, the IPv6 gateway of node3 doesn't.
+	s.recordProbe("node2", node2IP, 1, t0)
+	s.recordProbe("node3", node3IP, 1, t0)
+	s.recordProbe("node3", node3IP6, 1, t0)
+	s.recordReply(node2IP, 1, t0.Add(time.Millisecond))
+	s.recordReply(node3IP, 1, t0.Add(2*time.Millisecond))
+	// Replies with unknown IPs or mismatched sequence numbers are ignored.
+	s.recordReply(net.ParseIP("10.10.3.1"), 1, t0.Add(time.Millisecond))
+	s.recordReply(node3IP6, 2, t0.Add(time.Millisecond))
+
+	// Second round: the IPv6 gateway of node3 replies late, the first probe is counted as lost.
+	t1 := t0.Add(probePeriod)
+	s.recordProbe("node2", node2IP, 2, t1)
+	s.recordProbe("node3", node3IP, 2, t1)
+	s.recordProbe("node3", node3IP6, 2, t1)
+	s.recordReply(node3IP6, 1, t1.Add(time.Millisecond))
+	s.recordReply(node3IP6, 2, t1.Add(3*time.Millisecond))
+
+	expected := []statsv1alpha1.PeerNodeLatencyStats{
+		{
+			NodeName: "node2",
+			TargetIPLatencyStats: []statsv1alpha1.TargetIPLatencyStats{
+				{
+					TargetIP:                   "10.10.1.1",
+					LastSendTime:               metav1.NewTime(t1),
+					LastRecvTime:               metav1.NewTime(t0.Add(time.Millisecond)),
+					LastMeasuredRTTNanoseconds: int64(time.Millisecond),
+					SentProbes:                 2,
+				},
+			},
+		},
+		{
+			NodeName: "node3",
+			TargetIPLatencyStats: []statsv1alpha1.TargetIPLatencyStats{
+				{
+					TargetIP:                   "10.10.2.1",
+					LastSendTime:               metav1.NewTime(t1),
+					LastRecvTime:               metav1.NewTime(t0.Add(2 * time.Millisecond)),
+					LastMeasuredRTTNanoseconds: int64(2 * time.Millisecond),
+					SentProbes:                 2,
+				},
+				{
+					TargetIP:                   "fd00:10:10:2::1",
+					LastSendTime:               metav1.NewTime(t1),
+					LastRecvTime:               metav1.NewTime(t1.Add(3 * time.Millisecond)),
+					LastMeasuredRTTNanoseconds: int64(3 * time.Millisecond),
+					SentProbes:                 2,
+					LostProbes:                 1,
+				},
+			},
+		},
+	}
+	assert.Equal(t, expected, s.getPeerNodeLatencyStats())
+
+	// The stats of the removed Node and IP are deleted, the remaining ones are kept.
+	s.syncTargets(map[string][]net.IP{
+		"node3": {node3IP},
+	})
+	assert.Equal(t, []statsv1alpha1.PeerNodeLatencyStats{
+		{
+			NodeName:             "node3",
+			TargetIPLatencyStats: expected[1].TargetIPLatencyStats[:1],
+		},
+	}, s.getPeerNodeLatencyStats())
+}
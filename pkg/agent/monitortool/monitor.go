@@ -0,0 +1,260 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitortool
+
+import (
+	"context"
+	"net"
+	"os"
+	"time"
+
+	"github.com/containernetworking/plugins/pkg/ip"
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent"
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
+)
+
+const (
+	controllerName = "NodeLatencyMonitor"
+	// Period for sending probes to the peer Nodes. A probe that gets no reply before the next one is sent is
+	// considered lost.
+	probePeriod = 10 * time.Second
+	// Period for reporting the latency stats to the antrea-controller.
+	reportPeriod = 60 * time.Second
+
+	protocolICMP     = 1
+	protocolIPv6ICMP = 58
+)
+
+// probeData is the payload of the ICMP echo requests sent by the NodeLatencyMonitor.
+var probeData = []byte("antrea-node-latency")
+
+// NodeLatencyMonitor probes the gateway of each peer Node with ICMP echo requests periodically. As the traffic to
+// a peer gateway goes through the tunnel or the routes installed for the peer Node, the measured round-trip time
+// and packet loss reflect the health of the underlay network between the Nodes. The results are exported as
+// Prometheus metrics and reported to the antrea-controller as a NodeLatencyStats.
+type NodeLatencyMonitor struct {
+	nodeName         string
+	nodeLister       corelisters.NodeLister
+	nodeListerSynced cache.InformerSynced
+	// antreaClientProvider provides interfaces to get antreaClient, which will be used to report the stats to the
+	// antrea-controller.
+	antreaClientProvider agent.AntreaClientProvider
+	isIPv4Enabled        bool
+	isIPv6Enabled        bool
+	latencyStore         *latencyStore
+	// icmpID is the identifier of the ICMP echo requests sent by the monitor, it's used to filter out the replies
+	// to other ICMP echo requests.
+	icmpID int
+	// icmpSeq is the sequence number of the last probe round.
+	icmpSeq  uint16
+	ipv4Conn *icmp.PacketConn
+	ipv6Conn *icmp.PacketConn
+}
+
+func NewNodeLatencyMonitor(nodeName string, nodeInformer coreinformers.NodeInformer, antreaClientProvider agent.AntreaClientProvider, isIPv4Enabled, isIPv6Enabled bool) *NodeLatencyMonitor {
+	return &NodeLatencyMonitor{
+		nodeName:             nodeName,
+		nodeLister:           nodeInformer.Lister(),
+		nodeListerSynced:     nodeInformer.Informer().HasSynced,
+		antreaClientProvider: antreaClientProvider,
+		isIPv4Enabled:        isIPv4Enabled,
+		isIPv6Enabled:        isIPv6Enabled,
+		latencyStore:         newLatencyStore(),
+		icmpID:               os.Getpid() & 0xffff,
+	}
+}
+
+// Run runs a loop that probes the peer Nodes and reports the latency stats until the provided channel is closed.
+func (m *NodeLatencyMonitor) Run(stopCh <-chan struct{}) {
+	klog.InfoS("Starting", "controllerName", controllerName)
+	defer klog.InfoS("Shutting down", "controllerName", controllerName)
+
+	if !cache.WaitForNamedCacheSync(controllerName, stopCh, m.nodeListerSynced) {
+		return
+	}
+
+	if m.isIPv4Enabled {
+		conn, err := icmp.ListenPacket("ip4:icmp", "0.0.0.0")
+		if err != nil {
+			klog.ErrorS(err, "Failed to listen for ICMP packets")
+			return
+		}
+		defer conn.Close()
+		m.ipv4Conn = conn
+		go m.receive(conn, protocolICMP)
+	}
+	if m.isIPv6Enabled {
+		conn, err := icmp.ListenPacket("ip6:ipv6-icmp", "::")
+		if err != nil {
+			klog.ErrorS(err, "Failed to listen for ICMPv6 packets")
+			return
+		}
+		defer conn.Close()
+		m.ipv6Conn = conn
+		go m.receive(conn, protocolIPv6ICMP)
+	}
+
+	probeTicker := time.NewTicker(probePeriod)
+	defer probeTicker.Stop()
+	reportTicker := time.NewTicker(reportPeriod)
+	defer reportTicker.Stop()
+
+	m.probe()
+	for {
+		select {
+		case <-probeTicker.C:
+			m.probe()
+		case <-reportTicker.C:
+			if err := m.report(); err != nil {
+				klog.ErrorS(err, "Failed to report NodeLatencyStats")
+			}
+		case <-stopCh:
+			return
+		}
+	}
+}
+
+// probe sends an ICMP echo request to the gateway IPs of each peer Node.
+func (m *NodeLatencyMonitor) probe() {
+	nodes, err := m.nodeLister.List(labels.Everything())
+	if err != nil {
+		klog.ErrorS(err, "Failed to list Nodes")
+		return
+	}
+	targets := make(map[string][]net.IP, len(nodes))
+	for _, node := range nodes {
+		if node.Name == m.nodeName {
+			continue
+		}
+		if gatewayIPs := m.getGatewayIPs(node); len(gatewayIPs) > 0 {
+			targets[node.Name] = gatewayIPs
+		}
+	}
+	m.latencyStore.syncTargets(targets)
+
+	m.icmpSeq++
+	now := time.Now()
+	for nodeName, gatewayIPs := range targets {
+		for _, gatewayIP := range gatewayIPs {
+			// The probe is recorded even if it fails to be sent, in which case it will be counted as lost.
+			m.latencyStore.recordProbe(nodeName, gatewayIP, m.icmpSeq, now)
+			if err := m.sendEcho(gatewayIP, m.icmpSeq); err != nil {
+				klog.ErrorS(err, "Failed to send ICMP echo request", "node", nodeName, "ip", gatewayIP)
+			}
+		}
+	}
+}
+
+// getGatewayIPs returns the gateway IPs of a peer Node, which are the first IPs of its PodCIDRs, in the enabled
+// address families.
+func (m *NodeLatencyMonitor) getGatewayIPs(node *corev1.Node) []net.IP {
+	podCIDRs := node.Spec.PodCIDRs
+	if len(podCIDRs) == 0 && node.Spec.PodCIDR != "" {
+		podCIDRs = []string{node.Spec.PodCIDR}
+	}
+	var gatewayIPs []net.IP
+	for _, podCIDR := range podCIDRs {
+		podCIDRAddr, _, err := net.ParseCIDR(podCIDR)
+		if err != nil {
+			klog.ErrorS(err, "Failed to parse PodCIDR", "node", node.Name, "podCIDR", podCIDR)
+			continue
+		}
+		isIPv4 := podCIDRAddr.To4() != nil
+		if (isIPv4 && m.isIPv4Enabled) || (!isIPv4 && m.isIPv6Enabled) {
+			gatewayIPs = append(gatewayIPs, ip.NextIP(podCIDRAddr))
+		}
+	}
+	return gatewayIPs
+}
+
+func (m *NodeLatencyMonitor) sendEcho(dstIP net.IP, seq uint16) error {
+	msg := icmp.Message{
+		Code: 0,
+		Body: &icmp.Echo{
+			ID:   m.icmpID,
+			Seq:  int(seq),
+			Data: probeData,
+		},
+	}
+	conn := m.ipv4Conn
+	if dstIP.To4() != nil {
+		msg.Type = ipv4.ICMPTypeEcho
+	} else {
+		msg.Type = ipv6.ICMPTypeEchoRequest
+		conn = m.ipv6Conn
+	}
+	// The checksum of ICMPv6 messages is calculated by the kernel.
+	b, err := msg.Marshal(nil)
+	if err != nil {
+		return err
+	}
+	_, err = conn.WriteTo(b, &net.IPAddr{IP: dstIP})
+	return err
+}
+
+// receive reads the ICMP echo replies from the provided connection until it's closed.
+func (m *NodeLatencyMonitor) receive(conn *icmp.PacketConn, protocol int) {
+	buf := make([]byte, 1500)
+	for {
+		n, peer, err := conn.ReadFrom(buf)
+		if err != nil {
+			klog.V(2).InfoS("Stopped receiving ICMP packets", "err", err)
+			return
+		}
+		recvTime := time.Now()
+		msg, err := icmp.ParseMessage(protocol, buf[:n])
+		if err != nil {
+			klog.V(4).InfoS("Failed to parse ICMP packet", "err", err)
+			continue
+		}
+		if msg.Type != ipv4.ICMPTypeEchoReply && msg.Type != ipv6.ICMPTypeEchoReply {
+			continue
+		}
+		echo, ok := msg.Body.(*icmp.Echo)
+		if !ok || echo.ID != m.icmpID {
+			continue
+		}
+		peerAddr, ok := peer.(*net.IPAddr)
+		if !ok {
+			continue
+		}
+		m.latencyStore.recordReply(peerAddr.IP, uint16(echo.Seq), recvTime)
+	}
+}
+
+// report reports the latency stats of the peer Nodes to the antrea-controller.
+func (m *NodeLatencyMonitor) report() error {
+	antreaClient, err := m.antreaClientProvider.GetAntreaClient()
+	if err != nil {
+		return err
+	}
+	stats := &statsv1alpha1.NodeLatencyStats{
+		ObjectMeta:           metav1.ObjectMeta{Name: m.nodeName},
+		PeerNodeLatencyStats: m.latencyStore.getPeerNodeLatencyStats(),
+	}
+	_, err = antreaClient.StatsV1alpha1().NodeLatencyStats().Create(context.TODO(), stats, metav1.CreateOptions{})
+	return err
+}
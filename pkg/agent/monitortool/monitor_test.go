@@ -0,0 +1,75 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitortool
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetGatewayIPs(t *testing.T) {
+	tests := []struct {
+		name          string
+		podCIDR       string
+		podCIDRs      []string
+		isIPv4Enabled bool
+		isIPv6Enabled bool
+		expectedIPs   []net.IP
+	}{
+		{
+			name:          "IPv4",
+			podCIDR:       "10.10.1.0/24",
+			isIPv4Enabled: true,
+			expectedIPs:   []net.IP{net.ParseIP("10.10.1.1")},
+		},
+		{
+			name:          "dual-stack",
+			podCIDRs:      []string{"10.10.1.0/24", "fd00:10:10:1::/64"},
+			isIPv4Enabled: true,
+			isIPv6Enabled: true,
+			expectedIPs:   []net.IP{net.ParseIP("10.10.1.1"), net.ParseIP("fd00:10:10:1::1")},
+		},
+		{
+			name:          "IPv6 disabled",
+			podCIDRs:      []string{"10.10.1.0/24", "fd00:10:10:1::/64"},
+			isIPv4Enabled: true,
+			expectedIPs:   []net.IP{net.ParseIP("10.10.1.1")},
+		},
+		{
+			name:          "invalid PodCIDR",
+			podCIDR:       "10.10.1.0",
+			isIPv4Enabled: true,
+			expectedIPs:   nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &NodeLatencyMonitor{isIPv4Enabled: tt.isIPv4Enabled, isIPv6Enabled: tt.isIPv6Enabled}
+			node := &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "node2"},
+				Spec:       corev1.NodeSpec{PodCIDR: tt.podCIDR, PodCIDRs: tt.podCIDRs},
+			}
+			gatewayIPs := m.getGatewayIPs(node)
+			assert.Equal(t, len(tt.expectedIPs), len(gatewayIPs))
+			for i := range tt.expectedIPs {
+				assert.True(t, tt.expectedIPs[i].Equal(gatewayIPs[i]))
+			}
+		})
+	}
+}
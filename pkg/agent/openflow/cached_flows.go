@@ -0,0 +1,174 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openflow
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"antrea.io/antrea/pkg/agent/types"
+	binding "antrea.io/antrea/pkg/ovs/openflow"
+)
+
+// GetCachedFlows returns all the flows cached in the client. Flows installed at
+// initialization, which are not associated with any object, are not included.
+func (c *client) GetCachedFlows() []types.CachedFlow {
+	// Hold replayMutex write lock to protect the flows from being modified by
+	// NetworkPolicy updates and replayFlows, like GetNetworkPolicyFlowKeys.
+	c.replayMutex.Lock()
+	defer c.replayMutex.Unlock()
+
+	var flows []types.CachedFlow
+	addCachedFlows := func(cache *flowCategoryCache, ownerFn func(cacheKey string) (types.FlowOwnerType, string)) {
+		cache.Range(func(key, value interface{}) bool {
+			ownerType, ownerName := ownerFn(key.(string))
+			for _, flow := range value.(flowCache) {
+				flows = append(flows, types.CachedFlow{MatchString: flow.MatchString(), OwnerType: ownerType, OwnerName: ownerName})
+			}
+			return true
+		})
+	}
+
+	addCachedFlows(c.featurePodConnectivity.nodeCachedFlows, func(cacheKey string) (types.FlowOwnerType, string) {
+		return types.FlowOwnerNode, cacheKey
+	})
+	addCachedFlows(c.featurePodConnectivity.podCachedFlows, func(cacheKey string) (types.FlowOwnerType, string) {
+		return types.FlowOwnerPodInterface, cacheKey
+	})
+	addCachedFlows(c.featurePodConnectivity.tcCachedFlows, func(cacheKey string) (types.FlowOwnerType, string) {
+		return types.FlowOwnerTrafficControl, strings.TrimPrefix(cacheKey, "tc_")
+	})
+	addCachedFlows(c.featureService.cachedFlows, serviceFlowOwner)
+	if c.featureEgress != nil {
+		addCachedFlows(c.featureEgress.cachedFlows, egressFlowOwner)
+	}
+	if c.featureMulticast != nil {
+		addCachedFlows(c.featureMulticast.cachedFlows, multicastFlowOwner)
+	}
+	if c.featureMulticluster != nil {
+		addCachedFlows(c.featureMulticluster.cachedFlows, func(cacheKey string) (types.FlowOwnerType, string) {
+			return types.FlowOwnerMulticluster, strings.TrimPrefix(cacheKey, "cluster_")
+		})
+	}
+	addCachedFlows(c.featureTraceflow.cachedFlows, traceflowFlowOwner)
+	flows = append(flows, c.featureNetworkPolicy.getCachedFlows()...)
+	return flows
+}
+
+// getCachedFlows returns the flows of all the policy rules. Conjunctive match
+// flows can be shared by multiple rules, and are returned only once.
+func (f *featureNetworkPolicy) getCachedFlows() []types.CachedFlow {
+	var flows []types.CachedFlow
+	for _, obj := range f.policyCache.List() {
+		conj := obj.(*policyRuleConjunction)
+		ruleID := strconv.FormatUint(uint64(conj.id), 10)
+		for _, flow := range conj.actionFlows {
+			flows = append(flows, types.CachedFlow{MatchString: flow.MatchString(), OwnerType: types.FlowOwnerNetworkPolicyRule, OwnerName: ruleID})
+		}
+		for _, flow := range conj.metricFlows {
+			flows = append(flows, types.CachedFlow{MatchString: flow.MatchString(), OwnerType: types.FlowOwnerNetworkPolicyRule, OwnerName: ruleID})
+		}
+	}
+
+	f.conjMatchFlowLock.Lock()
+	defer f.conjMatchFlowLock.Unlock()
+	for _, ctx := range f.globalConjMatchFlowCache {
+		ruleIDs := make([]uint32, 0, len(ctx.actions)+len(ctx.denyAllRules))
+		for id := range ctx.actions {
+			ruleIDs = append(ruleIDs, id)
+		}
+		if ctx.flow != nil {
+			flows = append(flows, types.CachedFlow{MatchString: ctx.flow.MatchString(), OwnerType: types.FlowOwnerNetworkPolicyRule, OwnerName: joinRuleIDs(ruleIDs)})
+		}
+		if ctx.dropFlow != nil {
+			for id := range ctx.denyAllRules {
+				ruleIDs = append(ruleIDs, id)
+			}
+			flows = append(flows, types.CachedFlow{MatchString: ctx.dropFlow.MatchString(), OwnerType: types.FlowOwnerNetworkPolicyRule, OwnerName: joinRuleIDs(ruleIDs)})
+		}
+	}
+	return flows
+}
+
+func joinRuleIDs(ids []uint32) string {
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	strs := make([]string, 0, len(ids))
+	for i, id := range ids {
+		if i > 0 && id == ids[i-1] {
+			continue
+		}
+		strs = append(strs, strconv.FormatUint(uint64(id), 10))
+	}
+	return strings.Join(strs, ",")
+}
+
+// serviceFlowOwner decodes the cache keys generated by generateServicePortFlowCacheKey
+// and generateEndpointFlowCacheKey.
+func serviceFlowOwner(cacheKey string) (types.FlowOwnerType, string) {
+	ownerType := types.FlowOwnerServicePort
+	if strings.HasPrefix(cacheKey, "E") {
+		ownerType = types.FlowOwnerEndpoint
+	}
+	key := cacheKey[1:]
+	// Protocols with the "v6" suffix must be checked first as the other
+	// protocols are their prefixes.
+	for _, protocol := range []binding.Protocol{binding.ProtocolTCPv6, binding.ProtocolUDPv6, binding.ProtocolSCTPv6,
+		binding.ProtocolTCP, binding.ProtocolUDP, binding.ProtocolSCTP} {
+		i := strings.LastIndex(key, string(protocol))
+		if i <= 0 {
+			continue
+		}
+		ip := net.ParseIP(key[:i])
+		port, err := strconv.ParseUint(key[i+len(protocol):], 16, 16)
+		if ip == nil || err != nil {
+			continue
+		}
+		protocolName := strings.ToUpper(strings.TrimSuffix(string(protocol), "v6"))
+		// Use the same format as the Service strings of AntreaProxy.
+		return ownerType, fmt.Sprintf("%s:%d/%s", ip, port, protocolName)
+	}
+	return ownerType, key
+}
+
+// egressFlowOwner decodes the cache keys used by InstallSNATMarkFlows and
+// InstallPodSNATFlows.
+func egressFlowOwner(cacheKey string) (types.FlowOwnerType, string) {
+	if strings.HasPrefix(cacheKey, "p") {
+		if ofPort, err := strconv.ParseUint(cacheKey[1:], 16, 32); err == nil {
+			return types.FlowOwnerEgressPodPort, strconv.FormatUint(ofPort, 10)
+		}
+	}
+	return types.FlowOwnerEgressSNATMark, "0x" + strings.TrimPrefix(cacheKey, "s")
+}
+
+func multicastFlowOwner(cacheKey string) (types.FlowOwnerType, string) {
+	if ifName := strings.TrimPrefix(cacheKey, "multicast_pod_metric_"); ifName != cacheKey {
+		return types.FlowOwnerPodInterface, ifName
+	}
+	if group := strings.TrimPrefix(cacheKey, "multicast_"); group != cacheKey {
+		return types.FlowOwnerMulticastGroup, group
+	}
+	return types.FlowOwnerMulticast, ""
+}
+
+func traceflowFlowOwner(cacheKey string) (types.FlowOwnerType, string) {
+	if tag := strings.TrimPrefix(cacheKey, "pc-"); tag != cacheKey {
+		return types.FlowOwnerPacketCapture, "0x" + tag
+	}
+	return types.FlowOwnerTraceflow, "0x" + cacheKey
+}
@@ -0,0 +1,112 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openflow
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"antrea.io/antrea/pkg/agent/types"
+	binding "antrea.io/antrea/pkg/ovs/openflow"
+)
+
+func TestFlowOwners(t *testing.T) {
+	tests := []struct {
+		name              string
+		ownerFn           func(string) (types.FlowOwnerType, string)
+		cacheKey          string
+		expectedOwnerType types.FlowOwnerType
+		expectedOwnerName string
+	}{
+		{
+			name:              "IPv4 Service port",
+			ownerFn:           serviceFlowOwner,
+			cacheKey:          generateServicePortFlowCacheKey(net.ParseIP("10.96.0.10"), 53, binding.ProtocolUDP),
+			expectedOwnerType: types.FlowOwnerServicePort,
+			expectedOwnerName: "10.96.0.10:53/UDP",
+		},
+		{
+			name:              "IPv6 Service port",
+			ownerFn:           serviceFlowOwner,
+			cacheKey:          generateServicePortFlowCacheKey(net.ParseIP("fd00:abcd::1"), 443, binding.ProtocolTCPv6),
+			expectedOwnerType: types.FlowOwnerServicePort,
+			expectedOwnerName: "fd00:abcd::1:443/TCP",
+		},
+		{
+			name:              "IPv6 Endpoint",
+			ownerFn:           serviceFlowOwner,
+			cacheKey:          generateEndpointFlowCacheKey("fd00::5", 8080, binding.ProtocolSCTPv6),
+			expectedOwnerType: types.FlowOwnerEndpoint,
+			expectedOwnerName: "fd00::5:8080/SCTP",
+		},
+		{
+			name:              "Egress SNAT mark",
+			ownerFn:           egressFlowOwner,
+			cacheKey:          fmt.Sprintf("s%x", 26),
+			expectedOwnerType: types.FlowOwnerEgressSNATMark,
+			expectedOwnerName: "0x1a",
+		},
+		{
+			name:              "Egress Pod",
+			ownerFn:           egressFlowOwner,
+			cacheKey:          fmt.Sprintf("p%x", 26),
+			expectedOwnerType: types.FlowOwnerEgressPodPort,
+			expectedOwnerName: "26",
+		},
+		{
+			name:              "Multicast Pod metric",
+			ownerFn:           multicastFlowOwner,
+			cacheKey:          "multicast_pod_metric_pod1-abc",
+			expectedOwnerType: types.FlowOwnerPodInterface,
+			expectedOwnerName: "pod1-abc",
+		},
+		{
+			name:              "Multicast group",
+			ownerFn:           multicastFlowOwner,
+			cacheKey:          "multicast_224.1.2.3",
+			expectedOwnerType: types.FlowOwnerMulticastGroup,
+			expectedOwnerName: "224.1.2.3",
+		},
+		{
+			name:              "Traceflow",
+			ownerFn:           traceflowFlowOwner,
+			cacheKey:          fmt.Sprintf("%x", 10),
+			expectedOwnerType: types.FlowOwnerTraceflow,
+			expectedOwnerName: "0xa",
+		},
+		{
+			name:              "PacketCapture",
+			ownerFn:           traceflowFlowOwner,
+			cacheKey:          fmt.Sprintf("pc-%x", 10),
+			expectedOwnerType: types.FlowOwnerPacketCapture,
+			expectedOwnerName: "0xa",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ownerType, ownerName := tt.ownerFn(tt.cacheKey)
+			assert.Equal(t, tt.expectedOwnerType, ownerType)
+			assert.Equal(t, tt.expectedOwnerName, ownerName)
+		})
+	}
+}
+
+func TestJoinRuleIDs(t *testing.T) {
+	assert.Equal(t, "1,3,7", joinRuleIDs([]uint32{7, 3, 1, 3}))
+	assert.Equal(t, "", joinRuleIDs(nil))
+}
@@ -187,6 +187,14 @@ type Client interface {
 	// rules.
 	GetNetworkPolicyFlowKeys(npName, npNamespace string) []string
 
+	// GetCachedFlows returns the flows cached in the client and the object each
+	// flow is installed for. These flows are expected to be installed in OVS.
+	GetCachedFlows() []types.CachedFlow
+
+	// GetRoundNum returns the round number encoded in the cookies of the flows
+	// installed by the current Agent instance.
+	GetRoundNum() uint64
+
 	// ReassignFlowPriorities takes a list of priority updates, and update the actionFlows to replace
 	// the old priority with the desired one, for each priority update on that table.
 	ReassignFlowPriorities(updates map[uint16]uint16, table uint8) error
@@ -206,6 +214,13 @@ type Client interface {
 	// UninstallTraceflowFlows uninstalls flows for a Traceflow request.
 	UninstallTraceflowFlows(dataplaneTag uint8) error
 
+	// InstallPacketCaptureFlows installs flows to send a copy of the packets exchanged by the Pod on
+	// ofPort, which match the provided packet, to the Antrea Agent for a PacketCapture request.
+	InstallPacketCaptureFlows(dataplaneTag uint8, packet *binding.Packet, ofPort uint32, timeoutSeconds uint16) error
+
+	// UninstallPacketCaptureFlows uninstalls flows for a PacketCapture request.
+	UninstallPacketCaptureFlows(dataplaneTag uint8) error
+
 	// Initial tun_metadata0 in TLV map for Traceflow.
 	InitialTLVMap() error
 
@@ -229,6 +244,10 @@ type Client interface {
 	// Get multicast Pod ingress statistics from MulticastEgressPodMetricTable with specified src IP.
 	MulticastEgressPodMetricsByIP(ip net.IP) *types.RuleMetric
 
+	// PodTrafficMetrics returns the traffic metrics of each local Pod, keyed by the OFPort of the Pod, collected from
+	// PodTrafficEgressMetricTable and PodTrafficIngressMetricTable.
+	PodTrafficMetrics() map[uint32]*types.PodTrafficMetric
+
 	// SendTCPPacketOut sends TCP packet as a packet-out to OVS.
 	SendTCPPacketOut(
 		srcMAC string,
@@ -534,6 +553,10 @@ func (c *client) InstallPodFlows(interfaceName string, podInterfaceIPs []net.IP,
 			flows = append(flows, c.featurePodConnectivity.podVLANFlow(ofPort, vlanID))
 		}
 	}
+	if c.enablePodTrafficStats {
+		// Add flows to collect the traffic statistics of the Pod.
+		flows = append(flows, c.featurePodConnectivity.podTrafficMetricFlows(ofPort)...)
+	}
 	err := c.addFlows(c.featurePodConnectivity.podCachedFlows, interfaceName, flows)
 	if err != nil {
 		return err
@@ -766,7 +789,8 @@ func (c *client) generatePipelines() {
 		c.connectUplinkToBridge,
 		c.enableMulticast,
 		c.proxyAll,
-		c.enableTrafficControl)
+		c.enableTrafficControl,
+		c.enablePodTrafficStats)
 	c.activatedFeatures = append(c.activatedFeatures, c.featurePodConnectivity)
 	c.traceableFeatures = append(c.traceableFeatures, c.featurePodConnectivity)
 
@@ -896,6 +920,7 @@ func (c *client) ReplayFlows() {
 	}
 
 	c.featureService.replayGroups()
+	c.featureNetworkPolicy.replayMeters()
 	if c.enableMulticast {
 		c.featureMulticast.replayGroups()
 	}
@@ -920,6 +945,10 @@ func (c *client) DeleteStaleFlows() error {
 	return c.deleteFlowsByRoundNum(*c.roundInfo.PrevRoundNum)
 }
 
+func (c *client) GetRoundNum() uint64 {
+	return c.roundInfo.RoundNum
+}
+
 func (c *client) SubscribePacketIn(reason uint8, pktInQueue *binding.PacketInQueue) error {
 	return c.bridge.SubscribePacketIn(reason, pktInQueue)
 }
@@ -1008,6 +1037,22 @@ func (c *client) UninstallTraceflowFlows(dataplaneTag uint8) error {
 	return c.deleteFlows(c.featureTraceflow.cachedFlows, cacheKey)
 }
 
+func (c *client) InstallPacketCaptureFlows(dataplaneTag uint8, packet *binding.Packet, ofPort uint32, timeoutSeconds uint16) error {
+	// PacketCaptures and Traceflows never use the same data plane tag at the same time, but a distinct cache key
+	// prevents the flows of a terminated Traceflow from being mixed up with those of a PacketCapture.
+	cacheKey := fmt.Sprintf("pc-%x", dataplaneTag)
+	flows := c.featurePodConnectivity.flowsToCapture(dataplaneTag, c.ovsMetersAreSupported, packet, ofPort, timeoutSeconds)
+	if len(flows) == 0 {
+		return fmt.Errorf("the IP family of the packet to capture is not enabled on the Node")
+	}
+	return c.addFlows(c.featureTraceflow.cachedFlows, cacheKey, flows)
+}
+
+func (c *client) UninstallPacketCaptureFlows(dataplaneTag uint8) error {
+	cacheKey := fmt.Sprintf("pc-%x", dataplaneTag)
+	return c.deleteFlows(c.featureTraceflow.cachedFlows, cacheKey)
+}
+
 // Add TLV map optClass 0x0104, optType 0x80 optLength 4 tunMetadataIndex 0 to store data plane tag
 // in tunnel. Data plane tag will be stored to NXM_NX_TUN_METADATA0[28..31] when packet get encapsulated
 // into geneve, and will be stored back to NXM_NX_REG9[28..31] when packet get decapsulated.
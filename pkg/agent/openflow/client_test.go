@@ -23,6 +23,7 @@ import (
 	"testing"
 	"time"
 
+	"antrea.io/libOpenflow/protocol"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -107,7 +108,7 @@ func TestIdempotentFlowInstallation(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 			m := oftest.NewMockOFEntryOperations(ctrl)
-			ofClient := NewClient(bridgeName, bridgeMgmtAddr, true, false, false, false, false, false, false, false, false)
+			ofClient := NewClient(bridgeName, bridgeMgmtAddr, true, false, false, false, false, false, false, false, false, false)
 			client := ofClient.(*client)
 			client.cookieAllocator = cookie.NewAllocator(0)
 			client.ofEntryOperations = m
@@ -140,7 +141,7 @@ func TestIdempotentFlowInstallation(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 			m := oftest.NewMockOFEntryOperations(ctrl)
-			ofClient := NewClient(bridgeName, bridgeMgmtAddr, true, false, false, false, false, false, false, false, false)
+			ofClient := NewClient(bridgeName, bridgeMgmtAddr, true, false, false, false, false, false, false, false, false, false)
 			client := ofClient.(*client)
 			client.cookieAllocator = cookie.NewAllocator(0)
 			client.ofEntryOperations = m
@@ -186,7 +187,7 @@ func TestFlowInstallationFailed(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 			m := oftest.NewMockOFEntryOperations(ctrl)
-			ofClient := NewClient(bridgeName, bridgeMgmtAddr, true, false, false, false, false, false, false, false, false)
+			ofClient := NewClient(bridgeName, bridgeMgmtAddr, true, false, false, false, false, false, false, false, false, false)
 			client := ofClient.(*client)
 			client.cookieAllocator = cookie.NewAllocator(0)
 			client.ofEntryOperations = m
@@ -225,7 +226,7 @@ func TestConcurrentFlowInstallation(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 			m := oftest.NewMockOFEntryOperations(ctrl)
-			ofClient := NewClient(bridgeName, bridgeMgmtAddr, true, false, false, false, false, false, false, false, false)
+			ofClient := NewClient(bridgeName, bridgeMgmtAddr, true, false, false, false, false, false, false, false, false, false)
 			client := ofClient.(*client)
 			client.cookieAllocator = cookie.NewAllocator(0)
 			client.ofEntryOperations = m
@@ -310,6 +311,68 @@ func Test_client_InstallTraceflowFlows(t *testing.T) {
 	}
 }
 
+func Test_client_InstallPacketCaptureFlows(t *testing.T) {
+	tests := []struct {
+		name          string
+		ipProtocols   []binding.Protocol
+		packet        *binding.Packet
+		expectedFlows int
+	}{
+		{
+			name:          "IPv4 peer with TCP ports",
+			ipProtocols:   []binding.Protocol{binding.ProtocolIP, binding.ProtocolIPv6},
+			packet:        &binding.Packet{DestinationIP: net.ParseIP("10.10.0.2"), IPProto: protocol.Type_TCP, SourcePort: 80},
+			expectedFlows: 2,
+		},
+		{
+			name:          "any peer in dual-stack cluster",
+			ipProtocols:   []binding.Protocol{binding.ProtocolIP, binding.ProtocolIPv6},
+			packet:        &binding.Packet{},
+			expectedFlows: 4,
+		},
+		{
+			name:          "ICMP in dual-stack cluster",
+			ipProtocols:   []binding.Protocol{binding.ProtocolIP, binding.ProtocolIPv6},
+			packet:        &binding.Packet{IPProto: protocol.Type_ICMP},
+			expectedFlows: 2,
+		},
+		{
+			name:          "IPv6 peer in IPv4 cluster",
+			ipProtocols:   []binding.Protocol{binding.ProtocolIP},
+			packet:        &binding.Packet{DestinationIP: net.ParseIP("fec0::2"), IsIPv6: true},
+			expectedFlows: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ofClient := NewClient(bridgeName, bridgeMgmtAddr, true, true, false, false, false, false, false, false, false, false)
+			c := ofClient.(*client)
+			c.cookieAllocator = cookie.NewAllocator(0)
+			c.nodeConfig = nodeConfig
+			c.networkConfig = networkConfig
+			c.egressConfig = egressConfig
+			c.serviceConfig = serviceConfig
+			c.ipProtocols = tt.ipProtocols
+			m := oftest.NewMockOFEntryOperations(ctrl)
+			c.ofEntryOperations = m
+			c.generatePipelines()
+
+			if tt.expectedFlows == 0 {
+				assert.Error(t, c.InstallPacketCaptureFlows(7, tt.packet, 3, 60))
+				return
+			}
+			m.EXPECT().AddAll(gomock.Any()).Do(func(flows []binding.Flow) {
+				assert.Len(t, flows, tt.expectedFlows)
+			}).Return(nil).Times(1)
+			require.NoError(t, c.InstallPacketCaptureFlows(7, tt.packet, 3, 60))
+			m.EXPECT().DeleteAll(gomock.Any()).Return(nil).Times(1)
+			require.NoError(t, c.UninstallPacketCaptureFlows(7))
+		})
+	}
+}
+
 func Test_client_SendTraceflowPacket(t *testing.T) {
 	type args struct {
 		dataplaneTag uint8
@@ -420,7 +483,7 @@ func Test_client_SendTraceflowPacket(t *testing.T) {
 }
 
 func prepareTraceflowFlow(ctrl *gomock.Controller) *client {
-	ofClient := NewClient(bridgeName, bridgeMgmtAddr, true, true, false, false, false, false, false, false, false)
+	ofClient := NewClient(bridgeName, bridgeMgmtAddr, true, true, false, false, false, false, false, false, false, false)
 	c := ofClient.(*client)
 	c.cookieAllocator = cookie.NewAllocator(0)
 	c.nodeConfig = nodeConfig
@@ -446,7 +509,7 @@ func prepareTraceflowFlow(ctrl *gomock.Controller) *client {
 }
 
 func prepareSendTraceflowPacket(ctrl *gomock.Controller, success bool) *client {
-	ofClient := NewClient(bridgeName, bridgeMgmtAddr, true, true, false, false, false, false, false, false, false)
+	ofClient := NewClient(bridgeName, bridgeMgmtAddr, true, true, false, false, false, false, false, false, false, false)
 	c := ofClient.(*client)
 	c.nodeConfig = nodeConfig
 	m := ovsoftest.NewMockBridge(ctrl)
@@ -534,7 +597,7 @@ func Test_client_setBasePacketOutBuilder(t *testing.T) {
 }
 
 func prepareSetBasePacketOutBuilder(ctrl *gomock.Controller, success bool) *client {
-	ofClient := NewClient(bridgeName, bridgeMgmtAddr, true, true, false, false, false, false, false, false, false)
+	ofClient := NewClient(bridgeName, bridgeMgmtAddr, true, true, false, false, false, false, false, false, false, false)
 	c := ofClient.(*client)
 	m := ovsoftest.NewMockBridge(ctrl)
 	c.bridge = m
@@ -552,7 +615,7 @@ func TestMulticlusterFlowsInstallation(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	m := oftest.NewMockOFEntryOperations(ctrl)
-	ofClient := NewClient(bridgeName, bridgeMgmtAddr, true, false, false, false, false, false, false, false, true)
+	ofClient := NewClient(bridgeName, bridgeMgmtAddr, true, false, false, false, false, false, false, false, true, false)
 	client := ofClient.(*client)
 	client.cookieAllocator = cookie.NewAllocator(0)
 	client.ofEntryOperations = m
@@ -89,6 +89,10 @@ var (
 	// Field to help swap values in two different flow fields in the OpenFlow actions. This field is only used in func
 	// `arpResponderStaticFlow`.
 	SwapField = binding.NewRegField(2, 0, 31, "SwapValue")
+	// Field to store the original DSCP of a packet copy sent to Antrea Agent for PacketCapture, so that the Agent can
+	// restore it after the data plane tag is read. It is only loaded after the packet is output, so it never conflicts
+	// with SwapField.
+	PacketCaptureDSCPField = binding.NewRegField(2, 0, 5, "PacketCaptureDSCP")
 
 	// reg3(NXM_NX_REG3)
 	// Field to store the selected Service Endpoint IP
@@ -106,6 +106,22 @@ const (
 	stageOutput
 )
 
+// stageNames maps the stages to the names used when explaining flows to users.
+var stageNames = map[binding.StageID]string{
+	stageStart:           "Start",
+	stageClassifier:      "Classifier",
+	stageValidation:      "Validation",
+	stageConntrackState:  "ConntrackState",
+	stagePreRouting:      "PreRouting",
+	stageEgressSecurity:  "EgressSecurity",
+	stageRouting:         "Routing",
+	stagePostRouting:     "PostRouting",
+	stageSwitching:       "Switching",
+	stageIngressSecurity: "IngressSecurity",
+	stageConntrack:       "Conntrack",
+	stageOutput:          "Output",
+}
+
 // Table in FlexiblePipeline is the basic unit to build OVS pipelines. A Table can be referenced by one or more features,
 // but its member struct ofTable will be initialized and realized on OVS only when it is referenced by any activated features.
 type Table struct {
@@ -190,6 +206,9 @@ func (f *featurePodConnectivity) getRequiredTables() []*Table {
 	if f.enableTrafficControl {
 		tables = append(tables, TrafficControlTable)
 	}
+	if f.enablePodTrafficStats {
+		tables = append(tables, PodTrafficEgressMetricTable, PodTrafficIngressMetricTable)
+	}
 
 	return tables
 }
@@ -66,6 +66,9 @@ var (
 	// There could be other flows like default flow and Traceflow flows in the table. Only metric flows are supposed to
 	// have normal priority.
 	metricFlowIdentifier = fmt.Sprintf("priority=%d,", priorityNormal)
+	// rateLimitMetricFlowIdentifier is used to identify the metric flows applying the meters of the sources of
+	// RateLimit rules, which take precedence over the other metric flows.
+	rateLimitMetricFlowIdentifier = fmt.Sprintf("priority=%d,", priorityHigh)
 
 	protocolUDP = v1beta2.ProtocolUDP
 	dnsPort     = intstr.FromInt(53)
@@ -173,6 +176,13 @@ func NewOFPortAddress(addr int32) *OFPortAddress {
 	return &a
 }
 
+// ServiceGroupIDAddress is the ID of the group installed by AntreaProxy for a
+// Service. The ID is loaded into ServiceGroupIDField by the flows which
+// load-balance ClusterIP, NodePort and LoadBalancer traffic to the Endpoints of
+// the Service, so that the traffic can be matched with the Service regardless of
+// its destination IP and port. It's used as a destination address in egress
+// rules, and as a source address in ingress rules to match the traffic arriving
+// through the Service, hence the same match key is returned for both types.
 type ServiceGroupIDAddress binding.GroupIDType
 
 func (a *ServiceGroupIDAddress) GetMatchKey(addrType types.AddressType) *types.MatchKey {
@@ -557,6 +567,18 @@ type policyRuleConjunction struct {
 	// for conjunctions that are not built for a specific NetworkPolicy, e.g. DNS packetin Conjunction.
 	npRef       *v1beta2.NetworkPolicyReference
 	ruleTableID uint8
+	// rateLimit is the rate enforced by the meters realizing the RateLimit action
+	// of the rule, its value is nil for rules with other actions.
+	rateLimit *v1beta2.RateLimit
+	// meter is the OpenFlow meter shared by the sources of the rule which do not
+	// have a dedicated meter in sourceMeters, e.g. OF port sources or the sources
+	// above maxRateLimitSourceMeters.
+	meter   binding.Meter
+	meterID binding.MeterIDType
+	// sourceMeters maps the sources of the rule to their dedicated meters, so that
+	// the rate is enforced for each source separately. It is protected by
+	// conjMatchFlowLock once the policyRuleConjunction is in the policyCache.
+	sourceMeters map[string]*sourceMeter
 }
 
 // clause groups conjunctive match flows. Matches in a clause represent source addresses(for fromClause), or destination
@@ -992,13 +1014,23 @@ func (c *client) InstallPolicyRuleFlows(rule *types.PolicyRule) error {
 	defer c.featureNetworkPolicy.conjMatchFlowLock.Unlock()
 	ctxChanges := c.featureNetworkPolicy.calculateMatchFlowChangesForRule(conj, rule)
 
-	if err := c.ofEntryOperations.AddAll(conj.metricFlows); err != nil {
+	// The meters allocated for the rule must be released if it cannot be installed,
+	// as they will be allocated again when retrying.
+	if err := c.featureNetworkPolicy.installRateLimitMeters(conj); err != nil {
+		c.featureNetworkPolicy.releaseRateLimitMeters(conj)
+		return err
+	}
+	metricFlows := append(conj.rateLimitSourceFlows(), conj.metricFlows...)
+	if err := c.ofEntryOperations.AddAll(metricFlows); err != nil {
+		c.featureNetworkPolicy.releaseRateLimitMeters(conj)
 		return err
 	}
 	if err := c.ofEntryOperations.AddAll(conj.actionFlows); err != nil {
+		c.featureNetworkPolicy.releaseRateLimitMeters(conj)
 		return err
 	}
 	if err := c.featureNetworkPolicy.applyConjunctiveMatchFlows(ctxChanges); err != nil {
+		c.featureNetworkPolicy.releaseRateLimitMeters(conj)
 		return err
 	}
 	// Add the policyRuleConjunction into policyCache
@@ -1039,8 +1071,24 @@ func (f *featureNetworkPolicy) calculateActionFlowChangesForRule(rule *types.Pol
 			actionFlows = append(actionFlows, f.conjunctionActionDenyFlow(ruleOfID, ruleTable, rule.Priority, DispositionRej, rule.EnableLogging))
 		} else if rule.IsAntreaNetworkPolicyRule() && *rule.Action == crdv1alpha1.RuleActionPass {
 			actionFlows = append(actionFlows, f.conjunctionActionPassFlow(ruleOfID, ruleTable, rule.Priority, rule.EnableLogging))
+		} else if rule.IsAntreaNetworkPolicyRule() && *rule.Action == crdv1alpha1.RuleActionRateLimit && (!f.ovsMetersAreSupported || rule.RateLimit == nil) {
+			// The rate cannot be enforced without meters, the rule fails closed and drops the traffic it matches.
+			klog.ErrorS(nil, "Cannot enforce the rate of RateLimit rule, dropping the traffic matching it", "rule", rule.Name, "policy", rule.PolicyRef.ToString(), "ovsMetersSupported", f.ovsMetersAreSupported)
+			metricFlows = append(metricFlows, f.denyRuleMetricFlow(ruleOfID, isIngress, rule.TableID))
+			actionFlows = append(actionFlows, f.conjunctionActionDenyFlow(ruleOfID, ruleTable, rule.Priority, DispositionDrop, rule.EnableLogging))
+		} else if rule.IsAntreaNetworkPolicyRule() && *rule.Action == crdv1alpha1.RuleActionRateLimit {
+			// The meters are applied in the metric flows, which match all the packets of the connections allowed by
+			// the rule. Each source of the rule has a dedicated meter, applied by metric flows matching the source of
+			// the connections, the meter of the rule is applied to the traffic of the other sources.
+			conj.rateLimit = rule.RateLimit
+			conj.meterID = f.meterIDAllocator.allocate()
+			conj.meter = f.rateLimitMeter(conj.meterID, rule.RateLimit)
+			conj.sourceMeters = map[string]*sourceMeter{}
+			f.addRateLimitSources(conj, rule.From)
+			metricFlows = append(metricFlows, f.allowRulesMetricFlows(ruleOfID, isIngress, rule.TableID, uint32(conj.meterID))...)
+			actionFlows = append(actionFlows, f.conjunctionActionFlow(ruleOfID, ruleTable, dropTable.GetNext(), rule.Priority, rule.EnableLogging)...)
 		} else {
-			metricFlows = append(metricFlows, f.allowRulesMetricFlows(ruleOfID, isIngress, rule.TableID)...)
+			metricFlows = append(metricFlows, f.allowRulesMetricFlows(ruleOfID, isIngress, rule.TableID, 0)...)
 			actionFlows = append(actionFlows, f.conjunctionActionFlow(ruleOfID, ruleTable, dropTable.GetNext(), rule.Priority, rule.EnableLogging)...)
 		}
 		conj.actionFlows = actionFlows
@@ -1135,8 +1183,25 @@ func (c *client) BatchInstallPolicyRuleFlows(ofPolicyRules []*types.PolicyRule)
 		c.featureNetworkPolicy.addRuleToConjunctiveMatch(conj, rule)
 		allFlows = append(allFlows, conj.actionFlows...)
 		allFlows = append(allFlows, conj.metricFlows...)
+		allFlows = append(allFlows, conj.rateLimitSourceFlows()...)
 		conjunctions = append(conjunctions, conj)
 	}
+	// The meters allocated for the rules must be released if they cannot be installed, as they will be allocated
+	// again when retrying.
+	releaseMeters := func() {
+		for _, conj := range conjunctions {
+			c.featureNetworkPolicy.releaseRateLimitMeters(conj)
+		}
+	}
+
+	// Meters must be installed before the flows using them.
+	for _, conj := range conjunctions {
+		if err := c.featureNetworkPolicy.installRateLimitMeters(conj); err != nil {
+			c.featureNetworkPolicy.globalConjMatchFlowCache = map[string]*conjMatchFlowContext{}
+			releaseMeters()
+			return err
+		}
+	}
 
 	for _, ctx := range c.featureNetworkPolicy.globalConjMatchFlowCache {
 		// In theory there must be at least one action but InstallPolicyRuleFlows currently handles the 1 clause case
@@ -1160,6 +1225,7 @@ func (c *client) BatchInstallPolicyRuleFlows(ofPolicyRules []*types.PolicyRule)
 		// Reset the global conjunctive match flow cache since the OpenFlow bundle, which contains
 		// all the match flows to be installed, was not applied successfully.
 		c.featureNetworkPolicy.globalConjMatchFlowCache = map[string]*conjMatchFlowContext{}
+		releaseMeters()
 		return err
 	}
 	// Update conjMatchFlowContexts as the expected status.
@@ -1385,12 +1451,17 @@ func (c *client) UninstallPolicyRuleFlows(ruleID uint32) ([]string, error) {
 	if err := c.ofEntryOperations.DeleteAll(conj.actionFlows); err != nil {
 		return nil, err
 	}
-	if err := c.ofEntryOperations.DeleteAll(conj.metricFlows); err != nil {
-		return nil, err
-	}
 
 	c.featureNetworkPolicy.conjMatchFlowLock.Lock()
 	defer c.featureNetworkPolicy.conjMatchFlowLock.Unlock()
+	// The meters of the sources of a RateLimit rule are protected by conjMatchFlowLock as well.
+	if err := c.ofEntryOperations.DeleteAll(append(conj.rateLimitSourceFlows(), conj.metricFlows...)); err != nil {
+		return nil, err
+	}
+	// The meters can be deleted only after the metric flows using them.
+	if err := c.featureNetworkPolicy.uninstallRateLimitMeters(conj); err != nil {
+		return nil, err
+	}
 	// Get the conjMatchFlowContext changes.
 	ctxChanges := conj.calculateChangesForRuleDeletion()
 	// Send the changed OpenFlow entries to the OVS bridge and update the conjMatchFlowContext.
@@ -1440,7 +1511,7 @@ func (f *featureNetworkPolicy) replayFlows() []binding.Flow {
 		}
 	}
 	addMetricFlows := func(conj *policyRuleConjunction) {
-		for _, flow := range conj.metricFlows {
+		for _, flow := range append(conj.rateLimitSourceFlows(), conj.metricFlows...) {
 			flow.Reset()
 			flows = append(flows, flow)
 		}
@@ -1489,6 +1560,12 @@ func (c *client) AddPolicyRuleAddress(ruleID uint32, addrType types.AddressType,
 
 	c.featureNetworkPolicy.conjMatchFlowLock.Lock()
 	defer c.featureNetworkPolicy.conjMatchFlowLock.Unlock()
+	// The sources of a RateLimit rule must be rate limited before their traffic is allowed.
+	if addrType == types.SrcAddress {
+		if err := c.addRateLimitRuleSources(conj, addresses); err != nil {
+			return err
+		}
+	}
 	flowChanges := clause.addAddrFlows(c.featureNetworkPolicy, addrType, addresses, priority)
 	return c.featureNetworkPolicy.applyConjunctiveMatchFlows(flowChanges)
 }
@@ -1518,7 +1595,13 @@ func (c *client) DeletePolicyRuleAddress(ruleID uint32, addrType types.AddressTy
 	// Remove policyRuleConjunction to actions of conjunctive match using specific address.
 	changes := clause.deleteAddrFlows(addrType, addresses, priority)
 	// Update the Openflow entries on the OVS bridge, and update local cache.
-	return c.featureNetworkPolicy.applyConjunctiveMatchFlows(changes)
+	if err := c.featureNetworkPolicy.applyConjunctiveMatchFlows(changes); err != nil {
+		return err
+	}
+	if addrType == types.SrcAddress {
+		return c.deleteRateLimitRuleSources(conj, addresses)
+	}
+	return nil
 }
 
 func (c *client) GetNetworkPolicyFlowKeys(npName, npNamespace string) []string {
@@ -1742,7 +1825,7 @@ func parseDropFlow(flowMap map[string]string) (uint32, types.RuleMetric) {
 
 func parseAllowFlow(flowMap map[string]string) (uint32, types.RuleMetric) {
 	m := parseFlowMetric(flowMap)
-	if strings.Contains(flowMap["ct_state"], "+") { // ct_state=+new
+	if strings.Contains(flowMap["ct_state"], "+new") {
 		m.Sessions = m.Packets
 	}
 	ct_label := flowMap["ct_label"]
@@ -1840,12 +1923,83 @@ func (c *client) MulticastEgressPodMetricsByIP(ip net.IP) *types.RuleMetric {
 	return &metric
 }
 
+func (c *client) PodTrafficMetrics() map[uint32]*types.PodTrafficMetric {
+	result := map[uint32]*types.PodTrafficMetric{}
+	getMetric := func(ofPort uint32) *types.PodTrafficMetric {
+		metric, ok := result[ofPort]
+		if !ok {
+			metric = &types.PodTrafficMetric{}
+			result[ofPort] = metric
+		}
+		return metric
+	}
+	// Port numbers instead of port names are needed to identify the Pods.
+	egressFlows, _ := c.ovsctlClient.DumpFlowsWithoutTableNames(fmt.Sprintf("table=%d", PodTrafficEgressMetricTable.ofTable.GetID()))
+	for _, flow := range egressFlows {
+		flowMap := parseFlowToMap(flow)
+		if _, ok := flowMap["in_port"]; !ok {
+			continue
+		}
+		ofPort, metric := parsePodTrafficEgressFlow(flowMap)
+		getMetric(ofPort).Egress.Merge(&metric)
+	}
+	ingressFlows, _ := c.ovsctlClient.DumpFlowsWithoutTableNames(fmt.Sprintf("table=%d", PodTrafficIngressMetricTable.ofTable.GetID()))
+	for _, flow := range ingressFlows {
+		flowMap := parseFlowToMap(flow)
+		if _, ok := flowMap["reg1"]; !ok {
+			continue
+		}
+		ofPort, metric := parsePodTrafficIngressFlow(flowMap)
+		getMetric(ofPort).Ingress.Merge(&metric)
+	}
+	return result
+}
+
+// regMarkMatchString returns the match string of a RegMark in the format dumped by ovs-ofctl, e.g. "0x10/0xf0".
+func regMarkMatchString(m *binding.RegMark) string {
+	r := m.GetField().GetRange()
+	mask := uint32(1)<<r.Length() - 1
+	return fmt.Sprintf("0x%x/0x%x", m.GetValue()<<r.Offset(), mask<<r.Offset())
+}
+
+// parsePodTrafficEgressFlow parses a flow dumped from PodTrafficEgressMetricTable and returns the OFPort of the Pod,
+// and the TrafficBreakdownMetric with the statistics of the flow filled in the matched category.
+func parsePodTrafficEgressFlow(flowMap map[string]string) (uint32, types.TrafficBreakdownMetric) {
+	ofPort, _ := strconv.ParseUint(flowMap["in_port"], 10, 32)
+	metric := types.TrafficBreakdownMetric{}
+	switch flowMap["reg0"] {
+	case regMarkMatchString(ToTunnelRegMark):
+		metric.InterNode = parseFlowMetric(flowMap)
+	case regMarkMatchString(ToGatewayRegMark), regMarkMatchString(ToUplinkRegMark):
+		metric.External = parseFlowMetric(flowMap)
+	default:
+		metric.IntraNode = parseFlowMetric(flowMap)
+	}
+	return uint32(ofPort), metric
+}
+
+// parsePodTrafficIngressFlow parses a flow dumped from PodTrafficIngressMetricTable and returns the OFPort of the Pod,
+// and the TrafficBreakdownMetric with the statistics of the flow filled in the matched category.
+func parsePodTrafficIngressFlow(flowMap map[string]string) (uint32, types.TrafficBreakdownMetric) {
+	ofPort, _ := strconv.ParseUint(flowMap["reg1"], 0, 32)
+	metric := types.TrafficBreakdownMetric{}
+	switch flowMap["reg0"] {
+	case regMarkMatchString(FromLocalRegMark):
+		metric.IntraNode = parseFlowMetric(flowMap)
+	case regMarkMatchString(FromTunnelRegMark):
+		metric.InterNode = parseFlowMetric(flowMap)
+	default:
+		metric.External = parseFlowMetric(flowMap)
+	}
+	return uint32(ofPort), metric
+}
+
 func (c *client) NetworkPolicyMetrics() map[uint32]*types.RuleMetric {
 	result := map[uint32]*types.RuleMetric{}
 	collectMetricsFromFlows := func(table *Table, getMetricAndID func(flowMap map[string]string) (uint32, types.RuleMetric)) {
 		dumpedFlows, _ := c.ovsctlClient.DumpTableFlows(table.ofTable.GetID())
 		for _, flow := range dumpedFlows {
-			if !strings.Contains(flow, metricFlowIdentifier) {
+			if !strings.Contains(flow, metricFlowIdentifier) && !(strings.Contains(flow, rateLimitMetricFlowIdentifier) && strings.Contains(flow, "ct_label")) {
 				continue
 			}
 			flowMap := parseFlowToMap(flow)
@@ -1887,6 +2041,8 @@ type featureNetworkPolicy struct {
 	policyCache cache.Indexer
 	// egressTables map records all IDs of tables related to egress rules.
 	egressTables map[uint8]struct{}
+	// meterIDAllocator allocates the IDs of the meters realizing the RateLimit action of rules.
+	meterIDAllocator *meterIDAllocator
 
 	ovsMetersAreSupported bool
 	enableDenyTracking    bool
@@ -1919,6 +2075,7 @@ func newFeatureNetworkPolicy(
 		ipProtocols:              ipProtocols,
 		bridge:                   bridge,
 		globalConjMatchFlowCache: make(map[string]*conjMatchFlowContext),
+		meterIDAllocator:         newMeterIDAllocator(),
 		policyCache:              cache.NewIndexer(policyConjKeyFunc, cache.Indexers{priorityIndex: priorityIndexFunc}),
 		enableMulticast:          enableMulticast,
 		ovsMetersAreSupported:    ovsMetersAreSupported,
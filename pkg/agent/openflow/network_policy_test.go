@@ -524,7 +524,7 @@ func TestBatchInstallPolicyRuleFlows(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 			mockOperations := oftest.NewMockOFEntryOperations(ctrl)
-			ofClient := NewClient(bridgeName, bridgeMgmtAddr, false, true, false, false, false, false, false, false, false)
+			ofClient := NewClient(bridgeName, bridgeMgmtAddr, false, true, false, false, false, false, false, false, false, false)
 			c = ofClient.(*client)
 			c.cookieAllocator = cookie.NewAllocator(0)
 			c.ofEntryOperations = mockOperations
@@ -1081,6 +1081,61 @@ func TestParseMetricFlow(t *testing.T) {
 	}
 }
 
+func TestParsePodTrafficFlow(t *testing.T) {
+	for name, tc := range map[string]struct {
+		flow     string
+		ingress  bool
+		ofPort   uint32
+		expected types.TrafficBreakdownMetric
+	}{
+		"Egress inter-Node flow": {
+			flow:     "table=85, n_packets=9, n_bytes=666, priority=210,reg0=0x10/0xf0,in_port=5 actions=goto_table:86",
+			ofPort:   5,
+			expected: types.TrafficBreakdownMetric{InterNode: types.RuleMetric{Bytes: 666, Packets: 9}},
+		},
+		"Egress external flow": {
+			flow:     "table=85, n_packets=3, n_bytes=300, priority=210,reg0=0x20/0xf0,in_port=5 actions=goto_table:86",
+			ofPort:   5,
+			expected: types.TrafficBreakdownMetric{External: types.RuleMetric{Bytes: 300, Packets: 3}},
+		},
+		"Egress intra-Node flow": {
+			flow:     "table=85, n_packets=2, n_bytes=128, priority=200,in_port=5 actions=goto_table:86",
+			ofPort:   5,
+			expected: types.TrafficBreakdownMetric{IntraNode: types.RuleMetric{Bytes: 128, Packets: 2}},
+		},
+		"Ingress intra-Node flow": {
+			flow:     "table=86, n_packets=4, n_bytes=400, priority=210,reg0=0x3/0xf,reg1=0xc actions=goto_table:87",
+			ingress:  true,
+			ofPort:   12,
+			expected: types.TrafficBreakdownMetric{IntraNode: types.RuleMetric{Bytes: 400, Packets: 4}},
+		},
+		"Ingress inter-Node flow": {
+			flow:     "table=86, n_packets=5, n_bytes=500, priority=210,reg0=0x1/0xf,reg1=0xc actions=goto_table:87",
+			ingress:  true,
+			ofPort:   12,
+			expected: types.TrafficBreakdownMetric{InterNode: types.RuleMetric{Bytes: 500, Packets: 5}},
+		},
+		"Ingress external flow": {
+			flow:     "table=86, n_packets=6, n_bytes=600, priority=200,reg1=0xc actions=goto_table:87",
+			ingress:  true,
+			ofPort:   12,
+			expected: types.TrafficBreakdownMetric{External: types.RuleMetric{Bytes: 600, Packets: 6}},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var ofPort uint32
+			var metric types.TrafficBreakdownMetric
+			if tc.ingress {
+				ofPort, metric = parsePodTrafficIngressFlow(parseFlowToMap(tc.flow))
+			} else {
+				ofPort, metric = parsePodTrafficEgressFlow(parseFlowToMap(tc.flow))
+			}
+			assert.Equal(t, tc.ofPort, ofPort)
+			assert.Equal(t, tc.expected, metric)
+		})
+	}
+}
+
 func TestNetworkPolicyMetrics(t *testing.T) {
 	tests := []struct {
 		name         string
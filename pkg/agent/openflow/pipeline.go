@@ -173,8 +173,10 @@ var (
 	ConntrackCommitTable = newTable("ConntrackCommit", stageConntrack, pipelineIP)
 
 	// Tables in stageOutput:
-	VLANTable            = newTable("VLAN", stageOutput, pipelineIP)
-	L2ForwardingOutTable = newTable("Output", stageOutput, pipelineIP)
+	PodTrafficEgressMetricTable  = newTable("PodTrafficEgressMetric", stageOutput, pipelineIP)
+	PodTrafficIngressMetricTable = newTable("PodTrafficIngressMetric", stageOutput, pipelineIP)
+	VLANTable                    = newTable("VLAN", stageOutput, pipelineIP)
+	L2ForwardingOutTable         = newTable("Output", stageOutput, pipelineIP)
 
 	// Tables of pipelineMulticast are declared below. Do don't declare any tables of other pipelines here!
 	// Tables in stageEgressSecurity:
@@ -270,6 +272,16 @@ func GetFlowTableName(tableID uint8) string {
 	return table.GetName()
 }
 
+// GetFlowTableStage returns the name of the pipeline stage which the flow table
+// belongs to. An empty string is returned if the table cannot be found.
+func GetFlowTableStage(tableID uint8) string {
+	obj, exists, _ := tableCache.GetByKey(fmt.Sprintf("%d", tableID))
+	if !exists {
+		return ""
+	}
+	return stageNames[obj.(*Table).stage]
+}
+
 // GetFlowTableID does a case insensitive lookup of the table name, and
 // returns the flow table number if the table is found. Otherwise TableIDAll is
 // returned if the table cannot be found.
@@ -408,6 +420,7 @@ type client struct {
 	enableMulticast       bool
 	enableTrafficControl  bool
 	enableMulticluster    bool
+	enablePodTrafficStats bool
 	connectUplinkToBridge bool
 	roundInfo             types.RoundInfo
 	cookieAllocator       cookie.Allocator
@@ -1091,6 +1104,121 @@ func (f *featurePodConnectivity) flowsToTrace(dataplaneTag uint8,
 	return flows
 }
 
+// flowsToCapture generates the flows for a PacketCapture request in L2ForwardingOutTable. The packets sent by the Pod on
+// ofPort and the packets destined for it, which match the provided packet, are output to their target port as usual,
+// and a copy of each of them, with the data plane tag loaded into the DSCP bits, is sent to the Antrea Agent. The
+// provided packet describes the traffic from the perspective of the Pod: DestinationIP is the peer IP and SourcePort is
+// the transport port on the Pod side. Both DestinationIP and the ports are optional. The original DSCP of each copy is
+// saved in PacketCaptureDSCPField so that the Agent can restore it. Hairpin Service packets, which are both sent and
+// received by the Pod, are output with IN_PORT action and captured only once. As both their source and destination
+// are translated to the Pod itself, they are only captured when DestinationIP is not provided.
+func (f *featurePodConnectivity) flowsToCapture(dataplaneTag uint8,
+	ovsMetersAreSupported bool,
+	packet *binding.Packet,
+	ofPort uint32,
+	timeout uint16) []binding.Flow {
+	cookieID := f.cookieAllocator.Request(cookie.Traceflow).Raw()
+	// The packet is output before the copy is tagged and sent to the Agent, so that the original packet is never
+	// modified or dropped by the packet-in meter.
+	captureActions := func(fb binding.FlowBuilder, hairpin bool) binding.FlowBuilder {
+		if hairpin {
+			fb = fb.Action().OutputInPort()
+		} else {
+			fb = fb.Action().OutputToRegField(TargetOFPortField)
+		}
+		fb = fb.Action().MoveRange(binding.NxmFieldIPToS, PacketCaptureDSCPField.GetNXFieldName(), *binding.IPDSCPToSRange, *PacketCaptureDSCPField.GetRange()).
+			Action().LoadIPDSCP(dataplaneTag)
+		if ovsMetersAreSupported {
+			fb = fb.Action().Meter(PacketInMeterIDTF)
+		}
+		return fb.Action().SendToController(uint8(PacketInReasonTF))
+	}
+	matchTransport := func(fb binding.FlowBuilder, isIPv6 bool, srcPort, dstPort uint16) binding.FlowBuilder {
+		switch packet.IPProto {
+		case protocol.Type_TCP:
+			if isIPv6 {
+				fb = fb.MatchProtocol(binding.ProtocolTCPv6)
+			} else {
+				fb = fb.MatchProtocol(binding.ProtocolTCP)
+			}
+		case protocol.Type_UDP:
+			if isIPv6 {
+				fb = fb.MatchProtocol(binding.ProtocolUDPv6)
+			} else {
+				fb = fb.MatchProtocol(binding.ProtocolUDP)
+			}
+		case protocol.Type_ICMP:
+			fb = fb.MatchProtocol(binding.ProtocolICMP)
+		case protocol.Type_IPv6ICMP:
+			fb = fb.MatchProtocol(binding.ProtocolICMPv6)
+		case 0:
+			if isIPv6 {
+				fb = fb.MatchProtocol(binding.ProtocolIPv6)
+			} else {
+				fb = fb.MatchProtocol(binding.ProtocolIP)
+			}
+		default:
+			fb = fb.MatchIPProtocolValue(isIPv6, packet.IPProto)
+		}
+		if packet.IPProto == protocol.Type_TCP || packet.IPProto == protocol.Type_UDP {
+			if srcPort != 0 {
+				fb = fb.MatchSrcPort(srcPort, nil)
+			}
+			if dstPort != 0 {
+				fb = fb.MatchDstPort(dstPort, nil)
+			}
+		}
+		return fb
+	}
+
+	var flows []binding.Flow
+	for _, ipProtocol := range f.ipProtocols {
+		isIPv6 := ipProtocol == binding.ProtocolIPv6
+		if packet.DestinationIP != nil && (packet.DestinationIP.To4() == nil) != isIPv6 {
+			continue
+		}
+		if packet.IsIPv6 && !isIPv6 ||
+			packet.IPProto == protocol.Type_ICMP && isIPv6 ||
+			packet.IPProto == protocol.Type_IPv6ICMP && !isIPv6 {
+			continue
+		}
+		// Packets sent by the Pod.
+		egressFlowBuilder := L2ForwardingOutTable.ofTable.BuildFlow(priorityNormal+1).
+			Cookie(cookieID).
+			MatchInPort(ofPort).
+			MatchRegMark(OFPortFoundRegMark).
+			SetHardTimeout(timeout)
+		egressFlowBuilder = matchTransport(egressFlowBuilder, isIPv6, packet.SourcePort, packet.DestinationPort)
+		// Packets received by the Pod.
+		ingressFlowBuilder := L2ForwardingOutTable.ofTable.BuildFlow(priorityNormal+1).
+			Cookie(cookieID).
+			MatchRegFieldWithValue(TargetOFPortField, ofPort).
+			MatchRegMark(OFPortFoundRegMark).
+			SetHardTimeout(timeout)
+		ingressFlowBuilder = matchTransport(ingressFlowBuilder, isIPv6, packet.DestinationPort, packet.SourcePort)
+		if packet.DestinationIP != nil {
+			egressFlowBuilder = egressFlowBuilder.MatchDstIP(packet.DestinationIP)
+			ingressFlowBuilder = ingressFlowBuilder.MatchSrcIP(packet.DestinationIP)
+		}
+		flows = append(flows,
+			captureActions(egressFlowBuilder, false).Done(),
+			captureActions(ingressFlowBuilder, false).Done())
+		if packet.DestinationIP == nil {
+			// Hairpin Service packets sent by the Pod and destined for itself. HairpinCTMark is only loaded when
+			// AntreaProxy is enabled. This flow must have higher priority than the one installed by
+			// l2ForwardOutputHairpinServiceFlow.
+			hairpinFlowBuilder := L2ForwardingOutTable.ofTable.BuildFlow(priorityHigh + 1).
+				Cookie(cookieID).
+				MatchInPort(ofPort).
+				MatchCTMark(HairpinCTMark).
+				SetHardTimeout(timeout)
+			hairpinFlowBuilder = matchTransport(hairpinFlowBuilder, isIPv6, 0, 0)
+			flows = append(flows, captureActions(hairpinFlowBuilder, true).Done())
+		}
+	}
+	return flows
+}
+
 // flowsToTrace is used to generate flows for Traceflow in featureService.
 func (f *featureService) flowsToTrace(dataplaneTag uint8,
 	ovsMetersAreSupported,
@@ -1608,7 +1736,9 @@ func (f *featurePodConnectivity) arpNormalFlow() binding.Flow {
 		Done()
 }
 
-func (f *featureNetworkPolicy) allowRulesMetricFlows(conjunctionID uint32, ingress bool, tableID uint8) []binding.Flow {
+// allowRulesMetricFlows generates the metric flows of a rule allowing traffic. If meterID is not 0, the packets are
+// also processed by the meter, which drops the packets exceeding its rate.
+func (f *featureNetworkPolicy) allowRulesMetricFlows(conjunctionID uint32, ingress bool, tableID uint8, meterID uint32) []binding.Flow {
 	cookieID := f.cookieAllocator.Request(f.category).Raw()
 	metricTable := IngressMetricTable
 	offset := 0
@@ -1627,12 +1757,15 @@ func (f *featureNetworkPolicy) allowRulesMetricFlows(conjunctionID uint32, ingre
 		metricTable = MulticastIngressMetricTable
 	}
 	metricFlow := func(isCTNew bool, protocol binding.Protocol) binding.Flow {
-		return metricTable.ofTable.BuildFlow(priorityNormal).
+		fb := metricTable.ofTable.BuildFlow(priorityNormal).
 			Cookie(cookieID).
 			MatchProtocol(protocol).
 			MatchCTStateNew(isCTNew).
-			MatchCTLabelField(0, uint64(conjunctionID)<<offset, field).
-			Action().NextTable().
+			MatchCTLabelField(0, uint64(conjunctionID)<<offset, field)
+		if meterID != 0 {
+			fb = fb.Action().Meter(meterID)
+		}
+		return fb.Action().NextTable().
 			Done()
 	}
 	var flows []binding.Flow
@@ -2727,7 +2860,8 @@ func NewClient(bridgeName string,
 	connectUplinkToBridge bool,
 	enableMulticast bool,
 	enableTrafficControl bool,
-	enableMulticluster bool) Client {
+	enableMulticluster bool,
+	enablePodTrafficStats bool) Client {
 	bridge := binding.NewOFBridge(bridgeName, mgmtAddr)
 	c := &client{
 		bridge:                bridge,
@@ -2739,6 +2873,7 @@ func NewClient(bridgeName string,
 		enableMulticast:       enableMulticast,
 		enableTrafficControl:  enableTrafficControl,
 		enableMulticluster:    enableMulticluster,
+		enablePodTrafficStats: enablePodTrafficStats,
 		connectUplinkToBridge: connectUplinkToBridge,
 		pipelines:             make(map[binding.PipelineID]binding.Pipeline),
 		packetInHandlers:      map[uint8]map[string]PacketInHandler{},
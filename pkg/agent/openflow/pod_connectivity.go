@@ -49,6 +49,7 @@ type featurePodConnectivity struct {
 	enableMulticast       bool
 	proxyAll              bool
 	enableTrafficControl  bool
+	enablePodTrafficStats bool
 
 	category cookie.Category
 }
@@ -65,7 +66,8 @@ func newFeaturePodConnectivity(
 	connectUplinkToBridge bool,
 	enableMulticast bool,
 	proxyAll bool,
-	enableTrafficControl bool) *featurePodConnectivity {
+	enableTrafficControl bool,
+	enablePodTrafficStats bool) *featurePodConnectivity {
 	ctZones := make(map[binding.Protocol]int)
 	gatewayIPs := make(map[binding.Protocol]net.IP)
 	localCIDRs := make(map[binding.Protocol]net.IPNet)
@@ -118,6 +120,7 @@ func newFeaturePodConnectivity(
 		networkConfig:         networkConfig,
 		connectUplinkToBridge: connectUplinkToBridge,
 		enableTrafficControl:  enableTrafficControl,
+		enablePodTrafficStats: enablePodTrafficStats,
 		ipCtZoneTypeRegMarks:  ipCtZoneTypeRegMarks,
 		ctZoneSrcField:        getZoneSrcField(connectUplinkToBridge),
 		enableMulticast:       enableMulticast,
@@ -267,3 +270,40 @@ func (f *featurePodConnectivity) trafficControlCommonFlows() []binding.Flow {
 			Done(),
 	}
 }
+
+// podTrafficMetricFlows generates the flows to collect the traffic statistics of a local Pod. Egress packets are matched
+// with the OFPort of the Pod as in_port, and ingress packets are matched with the OFPort of the Pod as TargetOFPortField.
+// The flows with higher priority classify the traffic by the peer location, and the flows with lower priority count the
+// remaining traffic: egress traffic not sent to the tunnel, the gateway or the uplink is destined for a local Pod, while
+// ingress traffic received neither from a local Pod nor from the tunnel is from an external peer.
+func (f *featurePodConnectivity) podTrafficMetricFlows(ofPort uint32) []binding.Flow {
+	cookieID := f.cookieAllocator.Request(f.category).Raw()
+	var flows []binding.Flow
+	for _, m := range []*binding.RegMark{ToTunnelRegMark, ToGatewayRegMark, ToUplinkRegMark} {
+		flows = append(flows, PodTrafficEgressMetricTable.ofTable.BuildFlow(priorityHigh).
+			Cookie(cookieID).
+			MatchInPort(ofPort).
+			MatchRegMark(m).
+			Action().NextTable().
+			Done())
+	}
+	flows = append(flows, PodTrafficEgressMetricTable.ofTable.BuildFlow(priorityNormal).
+		Cookie(cookieID).
+		MatchInPort(ofPort).
+		Action().NextTable().
+		Done())
+	for _, m := range []*binding.RegMark{FromLocalRegMark, FromTunnelRegMark} {
+		flows = append(flows, PodTrafficIngressMetricTable.ofTable.BuildFlow(priorityHigh).
+			Cookie(cookieID).
+			MatchRegFieldWithValue(TargetOFPortField, ofPort).
+			MatchRegMark(m).
+			Action().NextTable().
+			Done())
+	}
+	flows = append(flows, PodTrafficIngressMetricTable.ofTable.BuildFlow(priorityNormal).
+		Cookie(cookieID).
+		MatchRegFieldWithValue(TargetOFPortField, ofPort).
+		Action().NextTable().
+		Done())
+	return flows
+}
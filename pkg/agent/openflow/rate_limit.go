@@ -0,0 +1,386 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openflow
+
+import (
+	"net"
+	"sync"
+
+	"antrea.io/ofnet/ofctrl"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/types"
+	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	binding "antrea.io/antrea/pkg/ovs/openflow"
+)
+
+// rateLimitMeterIDStart is the first meter ID used for the meters realizing the
+// RateLimit action of Antrea-native policy rules. IDs below it are reserved for
+// the packet-in meters.
+const rateLimitMeterIDStart binding.MeterIDType = 256
+
+// meterIDAllocator allocates the IDs of the meters realizing the RateLimit
+// action of Antrea-native policy rules. Released IDs are reused.
+type meterIDAllocator struct {
+	mutex    sync.Mutex
+	nextID   binding.MeterIDType
+	released []binding.MeterIDType
+}
+
+func newMeterIDAllocator() *meterIDAllocator {
+	return &meterIDAllocator{nextID: rateLimitMeterIDStart}
+}
+
+func (a *meterIDAllocator) allocate() binding.MeterIDType {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if n := len(a.released); n > 0 {
+		id := a.released[n-1]
+		a.released = a.released[:n-1]
+		return id
+	}
+	id := a.nextID
+	a.nextID++
+	return id
+}
+
+func (a *meterIDAllocator) release(id binding.MeterIDType) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.released = append(a.released, id)
+}
+
+// rateLimitMeter generates a meter entry dropping the packets which exceed the
+// rate of a RateLimit. BitsPerSecond is rounded up to a multiple of 1000 as OVS
+// meters use kbps as the unit.
+func (f *featureNetworkPolicy) rateLimitMeter(meterID binding.MeterIDType, rateLimit *v1beta2.RateLimit) binding.Meter {
+	flags := ofctrl.MeterBurst | ofctrl.MeterPktps
+	rate := uint32(rateLimit.PacketsPerSecond)
+	if rateLimit.BitsPerSecond > 0 {
+		flags = ofctrl.MeterBurst | ofctrl.MeterKbps
+		rate = uint32((rateLimit.BitsPerSecond + 999) / 1000)
+	}
+	return f.bridge.CreateMeter(meterID, flags).ResetMeterBands().
+		MeterBand().
+		MeterType(ofctrl.MeterDrop).
+		Rate(rate).
+		Burst(2 * rate).
+		Done()
+}
+
+// sourceMeter is the meter dedicated to one source of a RateLimit rule, with
+// the metric flows sending the traffic of the connections initiated by this
+// source to it.
+type sourceMeter struct {
+	meterID binding.MeterIDType
+	meter   binding.Meter
+	flows   []binding.Flow
+}
+
+// maxRateLimitSourceMeters is the maximum number of dedicated meters of the
+// sources of a RateLimit rule. Each meter takes an entry of the OVS meter
+// table, which has a limited capacity shared by all rules. The traffic of the
+// sources above this limit is rate limited by the meter of the rule.
+const maxRateLimitSourceMeters = 1024
+
+// rateLimitSourceKey returns the key of the dedicated meter of a source of a
+// RateLimit rule, and false if the source cannot have a dedicated meter. Only
+// IP address and CIDR sources, IPv4 or IPv6, have one, the traffic of the other
+// sources is rate limited by the meter of the rule.
+func rateLimitSourceKey(addr types.Address) (string, bool) {
+	switch addr.GetValue().(type) {
+	case net.IP, net.IPNet:
+		return addr.GetMatchValue(), true
+	}
+	return "", false
+}
+
+// rateLimitSourceMetricFlows generates the metric flows sending the packets of
+// the connections initiated by source and allowed by a RateLimit rule, in both
+// directions, to the meter of the source. The source is matched with the source
+// address of the packets in the original direction of the connections, and with
+// the destination address in the reply direction, which works the same way for
+// IPv4 and IPv6. They take precedence over the metric flows of the rule, and are
+// counted in the rule metrics as well.
+func (f *featureNetworkPolicy) rateLimitSourceMetricFlows(conjunctionID uint32, ingress bool, source types.Address, meterID binding.MeterIDType) []binding.Flow {
+	cookieID := f.cookieAllocator.Request(f.category).Raw()
+	metricTable := IngressMetricTable
+	offset := 0
+	field := IngressRuleCTLabel
+	if !ingress {
+		metricTable = EgressMetricTable
+		offset = 32
+		field = EgressRuleCTLabel
+	}
+	var ip net.IP
+	var ipNet *net.IPNet
+	switch addr := source.GetValue().(type) {
+	case net.IP:
+		ip = addr
+	case net.IPNet:
+		ip, ipNet = addr.IP, &addr
+	}
+	protocol := binding.ProtocolIP
+	if ip.To4() == nil {
+		protocol = binding.ProtocolIPv6
+	}
+	metricFlow := func(isCTNew, isReply bool) binding.Flow {
+		fb := metricTable.ofTable.BuildFlow(priorityHigh).
+			Cookie(cookieID).
+			MatchProtocol(protocol).
+			MatchCTStateNew(isCTNew)
+		// The first packet of a connection is always in the original direction.
+		if !isCTNew {
+			fb = fb.MatchCTStateRpl(isReply)
+		}
+		fb = fb.MatchCTLabelField(0, uint64(conjunctionID)<<offset, field)
+		switch {
+		case isReply && ipNet != nil:
+			fb = fb.MatchDstIPNet(*ipNet)
+		case isReply:
+			fb = fb.MatchDstIP(ip)
+		case ipNet != nil:
+			fb = fb.MatchSrcIPNet(*ipNet)
+		default:
+			fb = fb.MatchSrcIP(ip)
+		}
+		return fb.Action().Meter(uint32(meterID)).
+			Action().NextTable().
+			Done()
+	}
+	return []binding.Flow{metricFlow(true, false), metricFlow(false, false), metricFlow(false, true)}
+}
+
+// addRateLimitSources allocates a dedicated meter for each new source of a
+// RateLimit rule, and generates the metric flows using it. It returns the
+// added sourceMeters, which must be installed by the caller. Once the rule has
+// maxRateLimitSourceMeters dedicated meters, the traffic of the new sources is
+// rate limited by the meter of the rule. It must be called with
+// conjMatchFlowLock held, unless conj is not in the policyCache yet.
+func (f *featureNetworkPolicy) addRateLimitSources(conj *policyRuleConjunction, sources []types.Address) map[string]*sourceMeter {
+	if conj.rateLimit == nil {
+		return nil
+	}
+	_, isEgress := f.egressTables[conj.ruleTableID]
+	added := map[string]*sourceMeter{}
+	fallbacks := 0
+	for _, source := range sources {
+		key, ok := rateLimitSourceKey(source)
+		if !ok {
+			continue
+		}
+		if _, exists := conj.sourceMeters[key]; exists {
+			continue
+		}
+		if len(conj.sourceMeters) >= maxRateLimitSourceMeters {
+			fallbacks++
+			continue
+		}
+		meterID := f.meterIDAllocator.allocate()
+		sm := &sourceMeter{
+			meterID: meterID,
+			meter:   f.rateLimitMeter(meterID, conj.rateLimit),
+			flows:   f.rateLimitSourceMetricFlows(conj.id, !isEgress, source, meterID),
+		}
+		conj.sourceMeters[key] = sm
+		added[key] = sm
+	}
+	if fallbacks > 0 {
+		klog.InfoS("Too many sources for RateLimit rule, their traffic is rate limited by the meter of the rule",
+			"ruleID", conj.id, "sources", fallbacks, "maxSourceMeters", maxRateLimitSourceMeters)
+	}
+	return added
+}
+
+// getRateLimitSources returns the sourceMeters of the provided sources of a
+// RateLimit rule which have a dedicated meter. It must be called with
+// conjMatchFlowLock held.
+func (c *policyRuleConjunction) getRateLimitSources(sources []types.Address) map[string]*sourceMeter {
+	sourceMeters := map[string]*sourceMeter{}
+	for _, source := range sources {
+		key, ok := rateLimitSourceKey(source)
+		if !ok {
+			continue
+		}
+		if sm, exists := c.sourceMeters[key]; exists {
+			sourceMeters[key] = sm
+		}
+	}
+	return sourceMeters
+}
+
+// rateLimitSourceFlows returns the metric flows of all the sources of a
+// RateLimit rule.
+func (c *policyRuleConjunction) rateLimitSourceFlows() []binding.Flow {
+	var flows []binding.Flow
+	for _, sm := range c.sourceMeters {
+		flows = append(flows, sm.flows...)
+	}
+	return flows
+}
+
+// installSourceMeters installs the meters of the provided sources of a
+// RateLimit rule, then their metric flows.
+func (c *client) installSourceMeters(sourceMeters map[string]*sourceMeter) error {
+	var flows []binding.Flow
+	for _, sm := range sourceMeters {
+		if err := sm.meter.Add(); err != nil {
+			return err
+		}
+		flows = append(flows, sm.flows...)
+	}
+	return c.ofEntryOperations.AddAll(flows)
+}
+
+// uninstallSourceMeters deletes the metric flows of the provided sources of a
+// RateLimit rule, then their meters, and releases the meter IDs.
+func (c *client) uninstallSourceMeters(sourceMeters map[string]*sourceMeter) error {
+	var flows []binding.Flow
+	for _, sm := range sourceMeters {
+		flows = append(flows, sm.flows...)
+	}
+	if err := c.ofEntryOperations.DeleteAll(flows); err != nil {
+		return err
+	}
+	for _, sm := range sourceMeters {
+		if err := sm.meter.Delete(); err != nil {
+			return err
+		}
+		c.featureNetworkPolicy.meterIDAllocator.release(sm.meterID)
+	}
+	return nil
+}
+
+// addRateLimitRuleSources installs dedicated meters for the new sources of a
+// RateLimit rule. If they cannot be installed, they are removed from the rule
+// and their meter IDs are released. It must be called with conjMatchFlowLock
+// held.
+func (c *client) addRateLimitRuleSources(conj *policyRuleConjunction, sources []types.Address) error {
+	added := c.featureNetworkPolicy.addRateLimitSources(conj, sources)
+	if len(added) == 0 {
+		return nil
+	}
+	if err := c.installSourceMeters(added); err != nil {
+		for key := range added {
+			delete(conj.sourceMeters, key)
+		}
+		c.featureNetworkPolicy.releaseMeters(added)
+		return err
+	}
+	return nil
+}
+
+// deleteRateLimitRuleSources uninstalls the dedicated meters of the removed
+// sources of a RateLimit rule. It must be called with conjMatchFlowLock held.
+func (c *client) deleteRateLimitRuleSources(conj *policyRuleConjunction, sources []types.Address) error {
+	if conj.rateLimit == nil {
+		return nil
+	}
+	sourceMeters := conj.getRateLimitSources(sources)
+	if err := c.uninstallSourceMeters(sourceMeters); err != nil {
+		return err
+	}
+	for key := range sourceMeters {
+		delete(conj.sourceMeters, key)
+	}
+	return nil
+}
+
+// installRateLimitMeters installs all the meters of a policyRuleConjunction if
+// it has some. They must be installed before the metric flows using them.
+func (f *featureNetworkPolicy) installRateLimitMeters(conj *policyRuleConjunction) error {
+	if conj.meter == nil {
+		return nil
+	}
+	if err := conj.meter.Add(); err != nil {
+		return err
+	}
+	for _, sm := range conj.sourceMeters {
+		if err := sm.meter.Add(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// uninstallRateLimitMeters deletes all the meters of a policyRuleConjunction if
+// it has some, and releases the meter IDs. It must be called after the metric
+// flows using the meters have been deleted.
+func (f *featureNetworkPolicy) uninstallRateLimitMeters(conj *policyRuleConjunction) error {
+	if conj.meter == nil {
+		return nil
+	}
+	for key, sm := range conj.sourceMeters {
+		if err := sm.meter.Delete(); err != nil {
+			return err
+		}
+		f.meterIDAllocator.release(sm.meterID)
+		delete(conj.sourceMeters, key)
+	}
+	if err := conj.meter.Delete(); err != nil {
+		return err
+	}
+	f.meterIDAllocator.release(conj.meterID)
+	conj.meter = nil
+	return nil
+}
+
+// releaseRateLimitMeters is called when the flows of a policyRuleConjunction
+// could not be installed. It deletes the meters of the policyRuleConjunction
+// which may have been installed, and releases all the meter IDs allocated for
+// it, as they would be allocated again when retrying.
+func (f *featureNetworkPolicy) releaseRateLimitMeters(conj *policyRuleConjunction) {
+	if conj.meter == nil {
+		return
+	}
+	f.releaseMeters(conj.sourceMeters)
+	if err := conj.meter.Delete(); err != nil {
+		klog.V(2).InfoS("Failed to delete meter", "meterID", conj.meterID, "err", err)
+	}
+	f.meterIDAllocator.release(conj.meterID)
+	conj.meter = nil
+	conj.sourceMeters = map[string]*sourceMeter{}
+}
+
+// releaseMeters deletes the provided meters, ignoring the errors as they may
+// not have been installed, and releases their IDs.
+func (f *featureNetworkPolicy) releaseMeters(sourceMeters map[string]*sourceMeter) {
+	for _, sm := range sourceMeters {
+		if err := sm.meter.Delete(); err != nil {
+			klog.V(2).InfoS("Failed to delete meter", "meterID", sm.meterID, "err", err)
+		}
+		f.meterIDAllocator.release(sm.meterID)
+	}
+}
+
+// replayMeters installs again the meters of all the cached policyRuleConjunctions.
+func (f *featureNetworkPolicy) replayMeters() {
+	for _, obj := range f.policyCache.List() {
+		conj := obj.(*policyRuleConjunction)
+		if conj.meter == nil {
+			continue
+		}
+		conj.meter.Reset()
+		if err := conj.meter.Add(); err != nil {
+			klog.ErrorS(err, "Error when replaying cached meter", "meterID", conj.meterID)
+		}
+		for _, sm := range conj.sourceMeters {
+			sm.meter.Reset()
+			if err := sm.meter.Add(); err != nil {
+				klog.ErrorS(err, "Error when replaying cached meter", "meterID", sm.meterID)
+			}
+		}
+	}
+}
@@ -0,0 +1,304 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openflow
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"antrea.io/ofnet/ofctrl"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/cache"
+
+	"antrea.io/antrea/pkg/agent/config"
+	"antrea.io/antrea/pkg/agent/openflow/cookie"
+	oftest "antrea.io/antrea/pkg/agent/openflow/testing"
+	"antrea.io/antrea/pkg/agent/types"
+	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	binding "antrea.io/antrea/pkg/ovs/openflow"
+	mocks "antrea.io/antrea/pkg/ovs/openflow/testing"
+)
+
+var actionRateLimit = crdv1alpha1.RuleActionRateLimit
+
+// fakeMeter records in installed whether the meter is installed.
+type fakeMeter struct {
+	binding.Meter
+	id        binding.MeterIDType
+	installed map[binding.MeterIDType]bool
+}
+
+func (m *fakeMeter) ResetMeterBands() binding.Meter {
+	return m
+}
+
+func (m *fakeMeter) MeterBand() binding.MeterBandBuilder {
+	return &fakeMeterBandBuilder{meter: m}
+}
+
+func (m *fakeMeter) Add() error {
+	m.installed[m.id] = true
+	return nil
+}
+
+func (m *fakeMeter) Delete() error {
+	delete(m.installed, m.id)
+	return nil
+}
+
+func (m *fakeMeter) Reset() {}
+
+type fakeMeterBandBuilder struct {
+	binding.MeterBandBuilder
+	meter *fakeMeter
+}
+
+func (b *fakeMeterBandBuilder) MeterType(meterType ofctrl.MeterType) binding.MeterBandBuilder {
+	return b
+}
+
+func (b *fakeMeterBandBuilder) Rate(rate uint32) binding.MeterBandBuilder {
+	return b
+}
+
+func (b *fakeMeterBandBuilder) Burst(burst uint32) binding.MeterBandBuilder {
+	return b
+}
+
+func (b *fakeMeterBandBuilder) Done() binding.Meter {
+	return b.meter
+}
+
+// prepareRateLimitClient returns a client supporting OVS meters, and the map
+// recording the installed meters.
+func prepareRateLimitClient(t *testing.T, ctrl *gomock.Controller, mockOperations *oftest.MockOFEntryOperations) map[binding.MeterIDType]bool {
+	ofClient := NewClient(bridgeName, bridgeMgmtAddr, false, true, false, false, false, false, false, false, false, false)
+	c = ofClient.(*client)
+	c.cookieAllocator = cookie.NewAllocator(0)
+	c.ofEntryOperations = mockOperations
+	c.nodeConfig = &config.NodeConfig{PodIPv4CIDR: podIPv4CIDR, PodIPv6CIDR: nil}
+	c.networkConfig = &config.NetworkConfig{IPv4Enabled: true}
+	c.ipProtocols = []binding.Protocol{binding.ProtocolIP}
+
+	installed := map[binding.MeterIDType]bool{}
+	bridge := mocks.NewMockBridge(ctrl)
+	bridge.EXPECT().CreateTable(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	bridge.EXPECT().CreateMeter(gomock.Any(), gomock.Any()).DoAndReturn(func(id binding.MeterIDType, flags ofctrl.MeterFlag) binding.Meter {
+		return &fakeMeter{id: id, installed: installed}
+	}).AnyTimes()
+
+	mockFeaturePodConnectivity.cookieAllocator = c.cookieAllocator
+	mockFeaturePodConnectivity.ipProtocols = c.ipProtocols
+	mockFeatureNetworkPolicy.cookieAllocator = c.cookieAllocator
+	mockFeatureNetworkPolicy.ipProtocols = c.ipProtocols
+	mockFeatureNetworkPolicy.bridge = bridge
+	mockFeatureNetworkPolicy.ovsMetersAreSupported = true
+	mockFeatureNetworkPolicy.meterIDAllocator = newMeterIDAllocator()
+	t.Cleanup(func() {
+		mockFeatureNetworkPolicy.ovsMetersAreSupported = false
+		mockFeatureNetworkPolicy.meterIDAllocator = nil
+	})
+	c.featurePodConnectivity = &mockFeaturePodConnectivity
+	c.featureNetworkPolicy = &mockFeatureNetworkPolicy
+	c.featureNetworkPolicy.deterministic = true
+	c.featureNetworkPolicy.policyCache = cache.NewIndexer(policyConjKeyFunc, cache.Indexers{priorityIndex: priorityIndexFunc})
+	c.featureNetworkPolicy.globalConjMatchFlowCache = map[string]*conjMatchFlowContext{}
+
+	c.bridge = bridge
+	c.pipelines = pipelineMap
+	c.realizePipelines()
+	return installed
+}
+
+func newRateLimitRule(from []string) *types.PolicyRule {
+	return &types.PolicyRule{
+		Direction: v1beta2.DirectionIn,
+		From:      parseAddresses(from),
+		Action:    &actionRateLimit,
+		RateLimit: &v1beta2.RateLimit{PacketsPerSecond: 100},
+		Priority:  &priority100,
+		To:        []types.Address{NewOFPortAddress(1)},
+		FlowID:    uint32(10),
+		TableID:   AntreaPolicyIngressRuleTable.GetID(),
+		PolicyRef: &v1beta2.NetworkPolicyReference{
+			Type:      v1beta2.AntreaNetworkPolicy,
+			Namespace: "ns1",
+			Name:      "np1",
+			UID:       "id1",
+		},
+	}
+}
+
+func TestMeterIDAllocator(t *testing.T) {
+	allocator := newMeterIDAllocator()
+	assert.Equal(t, rateLimitMeterIDStart, allocator.allocate())
+	assert.Equal(t, rateLimitMeterIDStart+1, allocator.allocate())
+	allocator.release(rateLimitMeterIDStart)
+	assert.Equal(t, rateLimitMeterIDStart, allocator.allocate())
+	assert.Equal(t, rateLimitMeterIDStart+2, allocator.allocate())
+}
+
+func TestRateLimitSourceKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		address     types.Address
+		expectedKey string
+		expectedOK  bool
+	}{
+		{
+			name:        "IPv4 address",
+			address:     NewIPAddress(net.ParseIP("192.168.1.40")),
+			expectedKey: "192.168.1.40",
+			expectedOK:  true,
+		},
+		{
+			name:        "IPv4 CIDR",
+			address:     parseAddresses([]string{"192.168.2.0/24"})[0],
+			expectedKey: "192.168.2.0/24",
+			expectedOK:  true,
+		},
+		{
+			name:        "IPv6 address",
+			address:     NewIPAddress(net.ParseIP("fd00::1")),
+			expectedKey: "fd00::1",
+			expectedOK:  true,
+		},
+		{
+			name:        "IPv6 CIDR",
+			address:     parseAddresses([]string{"fd00:10::/64"})[0],
+			expectedKey: "fd00:10::/64",
+			expectedOK:  true,
+		},
+		{
+			name:       "OF port",
+			address:    NewOFPortAddress(1),
+			expectedOK: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, ok := rateLimitSourceKey(tt.address)
+			assert.Equal(t, tt.expectedOK, ok)
+			if tt.expectedOK {
+				assert.Equal(t, tt.expectedKey, key)
+			}
+		})
+	}
+}
+
+func TestRateLimitRuleSourceMeters(t *testing.T) {
+	preparePipelines()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockOperations := oftest.NewMockOFEntryOperations(ctrl)
+	mockOperations.EXPECT().AddAll(gomock.Any()).Return(nil).AnyTimes()
+	mockOperations.EXPECT().DeleteAll(gomock.Any()).Return(nil).AnyTimes()
+	installed := prepareRateLimitClient(t, ctrl, mockOperations)
+
+	rule := newRateLimitRule([]string{"192.168.1.40", "192.168.2.0/24"})
+	require.NoError(t, c.InstallPolicyRuleFlows(rule))
+	conj := c.featureNetworkPolicy.getPolicyRuleConjunction(rule.FlowID)
+	require.NotNil(t, conj)
+	// One meter for the rule, one for each source.
+	assert.Len(t, conj.sourceMeters, 2)
+	assert.Len(t, conj.rateLimitSourceFlows(), 6)
+	assert.Len(t, installed, 3)
+
+	require.NoError(t, c.AddPolicyRuleAddress(rule.FlowID, types.SrcAddress, parseAddresses([]string{"192.168.1.41", "fd00::1"}), &priority100))
+	assert.Len(t, conj.sourceMeters, 4)
+	assert.Contains(t, conj.sourceMeters, "fd00::1")
+	assert.Len(t, installed, 5)
+
+	require.NoError(t, c.DeletePolicyRuleAddress(rule.FlowID, types.SrcAddress, parseAddresses([]string{"192.168.1.40"}), &priority100))
+	assert.Len(t, conj.sourceMeters, 3)
+	assert.NotContains(t, conj.sourceMeters, "192.168.1.40")
+	assert.Len(t, installed, 4)
+
+	_, err := c.UninstallPolicyRuleFlows(rule.FlowID)
+	require.NoError(t, err)
+	assert.Empty(t, installed)
+	assert.Len(t, c.featureNetworkPolicy.meterIDAllocator.released, 5)
+}
+
+func TestRateLimitRuleSourceMetersLimit(t *testing.T) {
+	preparePipelines()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockOperations := oftest.NewMockOFEntryOperations(ctrl)
+	mockOperations.EXPECT().AddAll(gomock.Any()).Return(nil).AnyTimes()
+	installed := prepareRateLimitClient(t, ctrl, mockOperations)
+
+	var sources []string
+	for i := 0; i <= maxRateLimitSourceMeters; i++ {
+		sources = append(sources, fmt.Sprintf("10.%d.%d.1", i/256, i%256))
+	}
+	rule := newRateLimitRule(sources)
+	require.NoError(t, c.InstallPolicyRuleFlows(rule))
+	conj := c.featureNetworkPolicy.getPolicyRuleConjunction(rule.FlowID)
+	require.NotNil(t, conj)
+	// The last source falls back to the meter of the rule.
+	assert.Len(t, conj.sourceMeters, maxRateLimitSourceMeters)
+	assert.NotContains(t, conj.sourceMeters, sources[maxRateLimitSourceMeters])
+	assert.Len(t, installed, maxRateLimitSourceMeters+1)
+
+	require.NoError(t, c.AddPolicyRuleAddress(rule.FlowID, types.SrcAddress, parseAddresses([]string{"192.168.1.41"}), &priority100))
+	assert.Len(t, conj.sourceMeters, maxRateLimitSourceMeters)
+	assert.Len(t, installed, maxRateLimitSourceMeters+1)
+}
+
+func TestBatchInstallRateLimitRuleFailure(t *testing.T) {
+	preparePipelines()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockOperations := oftest.NewMockOFEntryOperations(ctrl)
+	mockOperations.EXPECT().AddAll(gomock.Any()).Return(errors.New("fake error")).Times(1)
+	installed := prepareRateLimitClient(t, ctrl, mockOperations)
+
+	rule := newRateLimitRule([]string{"192.168.1.40", "192.168.2.0/24"})
+	require.Error(t, c.BatchInstallPolicyRuleFlows([]*types.PolicyRule{rule}))
+	// The meters of the rule and of its sources must be deleted and their IDs released.
+	assert.Empty(t, installed)
+	assert.Len(t, c.featureNetworkPolicy.meterIDAllocator.released, 3)
+	assert.Nil(t, c.featureNetworkPolicy.getPolicyRuleConjunction(rule.FlowID))
+}
+
+func TestRateLimitRuleWithoutMeters(t *testing.T) {
+	preparePipelines()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockOperations := oftest.NewMockOFEntryOperations(ctrl)
+	prepareRateLimitClient(t, ctrl, mockOperations)
+	c.featureNetworkPolicy.ovsMetersAreSupported = false
+
+	rule := newRateLimitRule([]string{"192.168.1.40"})
+	conj := c.featureNetworkPolicy.calculateActionFlowChangesForRule(rule)
+	// The traffic matching the rule must be dropped rather than allowed without limit.
+	assert.Nil(t, conj.meter)
+	assert.Empty(t, conj.sourceMeters)
+	assert.Equal(t, []binding.Flow{c.featureNetworkPolicy.denyRuleMetricFlow(rule.FlowID, true, rule.TableID)}, conj.metricFlows)
+	assert.Equal(t, rateLimitMeterIDStart, c.featureNetworkPolicy.meterIDAllocator.allocate())
+}
+
+func TestParseRateLimitSourceMetricFlow(t *testing.T) {
+	flow := "cookie=0x1000000000000, table=IngressMetric, n_packets=5, n_bytes=500, priority=210,ct_state=-new+rpl,ct_label=0xa/0xffffffff,ipv6,ipv6_dst=fd00::1 actions=meter:257,goto_table:IngressRule"
+	ruleID, metric := parseAllowFlow(parseFlowToMap(flow))
+	assert.Equal(t, uint32(10), ruleID)
+	assert.Equal(t, types.RuleMetric{Bytes: 500, Packets: 5}, metric)
+}
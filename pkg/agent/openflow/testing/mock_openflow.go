@@ -154,6 +154,20 @@ func (mr *MockClientMockRecorder) Disconnect() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disconnect", reflect.TypeOf((*MockClient)(nil).Disconnect))
 }
 
+// GetCachedFlows mocks base method
+func (m *MockClient) GetCachedFlows() []types.CachedFlow {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCachedFlows")
+	ret0, _ := ret[0].([]types.CachedFlow)
+	return ret0
+}
+
+// GetCachedFlows indicates an expected call of GetCachedFlows
+func (mr *MockClientMockRecorder) GetCachedFlows() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCachedFlows", reflect.TypeOf((*MockClient)(nil).GetCachedFlows))
+}
+
 // GetFlowTableStatus mocks base method
 func (m *MockClient) GetFlowTableStatus() []openflow.TableStatus {
 	m.ctrl.T.Helper()
@@ -211,6 +225,20 @@ func (mr *MockClientMockRecorder) GetPolicyInfoFromConjunction(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicyInfoFromConjunction", reflect.TypeOf((*MockClient)(nil).GetPolicyInfoFromConjunction), arg0)
 }
 
+// GetRoundNum mocks base method
+func (m *MockClient) GetRoundNum() uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoundNum")
+	ret0, _ := ret[0].(uint64)
+	return ret0
+}
+
+// GetRoundNum indicates an expected call of GetRoundNum
+func (mr *MockClientMockRecorder) GetRoundNum() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoundNum", reflect.TypeOf((*MockClient)(nil).GetRoundNum))
+}
+
 // GetServiceFlowKeys mocks base method
 func (m *MockClient) GetServiceFlowKeys(arg0 net.IP, arg1 uint16, arg2 openflow.Protocol, arg3 []proxy.Endpoint) []string {
 	m.ctrl.T.Helper()
@@ -380,6 +408,20 @@ func (mr *MockClientMockRecorder) InstallNodeFlows(arg0, arg1, arg2, arg3, arg4
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallNodeFlows", reflect.TypeOf((*MockClient)(nil).InstallNodeFlows), arg0, arg1, arg2, arg3, arg4)
 }
 
+// InstallPacketCaptureFlows mocks base method
+func (m *MockClient) InstallPacketCaptureFlows(arg0 byte, arg1 *openflow.Packet, arg2 uint32, arg3 uint16) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallPacketCaptureFlows", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InstallPacketCaptureFlows indicates an expected call of InstallPacketCaptureFlows
+func (mr *MockClientMockRecorder) InstallPacketCaptureFlows(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallPacketCaptureFlows", reflect.TypeOf((*MockClient)(nil).InstallPacketCaptureFlows), arg0, arg1, arg2, arg3)
+}
+
 // InstallPodFlows mocks base method
 func (m *MockClient) InstallPodFlows(arg0 string, arg1 []net.IP, arg2 net.HardwareAddr, arg3 uint32, arg4 uint16) error {
 	m.ctrl.T.Helper()
@@ -604,6 +646,20 @@ func (mr *MockClientMockRecorder) NewDNSpacketInConjunction(arg0 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewDNSpacketInConjunction", reflect.TypeOf((*MockClient)(nil).NewDNSpacketInConjunction), arg0)
 }
 
+// PodTrafficMetrics mocks base method
+func (m *MockClient) PodTrafficMetrics() map[uint32]*types.PodTrafficMetric {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PodTrafficMetrics")
+	ret0, _ := ret[0].(map[uint32]*types.PodTrafficMetric)
+	return ret0
+}
+
+// PodTrafficMetrics indicates an expected call of PodTrafficMetrics
+func (mr *MockClientMockRecorder) PodTrafficMetrics() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PodTrafficMetrics", reflect.TypeOf((*MockClient)(nil).PodTrafficMetrics))
+}
+
 // ReassignFlowPriorities mocks base method
 func (m *MockClient) ReassignFlowPriorities(arg0 map[uint16]uint16, arg1 byte) error {
 	m.ctrl.T.Helper()
@@ -808,6 +864,20 @@ func (mr *MockClientMockRecorder) UninstallNodeFlows(arg0 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UninstallNodeFlows", reflect.TypeOf((*MockClient)(nil).UninstallNodeFlows), arg0)
 }
 
+// UninstallPacketCaptureFlows mocks base method
+func (m *MockClient) UninstallPacketCaptureFlows(arg0 byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UninstallPacketCaptureFlows", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UninstallPacketCaptureFlows indicates an expected call of UninstallPacketCaptureFlows
+func (mr *MockClientMockRecorder) UninstallPacketCaptureFlows(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UninstallPacketCaptureFlows", reflect.TypeOf((*MockClient)(nil).UninstallPacketCaptureFlows), arg0)
+}
+
 // UninstallPodFlows mocks base method
 func (m *MockClient) UninstallPodFlows(arg0 string) error {
 	m.ctrl.T.Helper()
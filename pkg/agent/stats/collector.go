@@ -24,6 +24,8 @@ import (
 	"k8s.io/klog/v2"
 
 	"antrea.io/antrea/pkg/agent"
+	"antrea.io/antrea/pkg/agent/interfacestore"
+	"antrea.io/antrea/pkg/agent/metrics"
 	"antrea.io/antrea/pkg/agent/multicast"
 	"antrea.io/antrea/pkg/agent/openflow"
 	agenttypes "antrea.io/antrea/pkg/agent/types"
@@ -37,8 +39,29 @@ import (
 const (
 	// Period for performing stats collection and report.
 	collectPeriod = 60 * time.Second
+	// Maximum number of NetworkPolicy rules whose stats are exported as Prometheus metrics. It bounds the cardinality
+	// of the metrics, the stats of the rules exceeding it are only reported to the antrea-controller.
+	maxRuleMetrics = 1000
 )
 
+// ruleMetricKey identifies a NetworkPolicy rule in the Prometheus metrics. K8s NetworkPolicy rules have no name, so
+// the stats of all rules of a K8s NetworkPolicy share the same key.
+type ruleMetricKey struct {
+	policyType cpv1beta.NetworkPolicyType
+	namespace  string
+	name       string
+	rule       string
+}
+
+func (k ruleMetricKey) labels() map[string]string {
+	return map[string]string{
+		"policy_type": string(k.policyType),
+		"namespace":   k.namespace,
+		"name":        k.name,
+		"rule":        k.rule,
+	}
+}
+
 // statsCollection is a collection of stats.
 type statsCollection struct {
 	// networkPolicyStats is a mapping from K8s NetworkPolicy UIDs to their traffic stats.
@@ -49,6 +72,10 @@ type statsCollection struct {
 	antreaNetworkPolicyStats map[types.UID]map[string]*statsv1alpha1.TrafficStats
 	// multicastGroups is a map that encodes the list of Pods that has joined the multicast group.
 	multicastGroups map[string][]cpv1beta.PodReference
+	// podTrafficStats is a mapping from local Pods to their traffic stats.
+	podTrafficStats map[cpv1beta.PodReference]*cpv1beta.PodTrafficStats
+	// ruleStats is a mapping from NetworkPolicy rules to their traffic stats, which are exported as Prometheus metrics.
+	ruleStats map[ruleMetricKey]*statsv1alpha1.TrafficStats
 }
 
 // Collector is responsible for collecting stats from the Openflow client, calculating the delta compared with the last
@@ -62,21 +89,32 @@ type Collector struct {
 	ofClient             openflow.Client
 	networkPolicyQuerier querier.AgentNetworkPolicyInfoQuerier
 	multicastQuerier     querier.AgentMulticastInfoQuerier
+	// ifaceStore is used to map the OFPorts in the Pod traffic metrics to Pods.
+	ifaceStore interfacestore.InterfaceStore
 	// lastStatsCollection is the last statistics that has been reported to antrea-controller successfully.
 	// It is used to calculate the delta of the statistics that will be reported.
-	lastStatsCollection *statsCollection
-	multicastEnabled    bool
+	lastStatsCollection    *statsCollection
+	multicastEnabled       bool
+	podTrafficStatsEnabled bool
+	ruleMetricsEnabled     bool
+	// exportedRuleStats is the last statistics of the NetworkPolicy rules that have been exported as Prometheus
+	// metrics. It is used to calculate the increments of the metrics.
+	exportedRuleStats map[ruleMetricKey]statsv1alpha1.TrafficStats
 }
 
-func NewCollector(antreaClientProvider agent.AntreaClientProvider, ofClient openflow.Client, npQuerier querier.AgentNetworkPolicyInfoQuerier, mcQuerier *multicast.Controller) *Collector {
+func NewCollector(antreaClientProvider agent.AntreaClientProvider, ofClient openflow.Client, npQuerier querier.AgentNetworkPolicyInfoQuerier, mcQuerier *multicast.Controller, ifaceStore interfacestore.InterfaceStore, podTrafficStatsEnabled bool, ruleMetricsEnabled bool) *Collector {
 	nodeName, _ := env.GetNodeName()
 	manager := &Collector{
-		nodeName:             nodeName,
-		antreaClientProvider: antreaClientProvider,
-		ofClient:             ofClient,
-		networkPolicyQuerier: npQuerier,
-		multicastQuerier:     mcQuerier,
-		multicastEnabled:     mcQuerier != nil,
+		nodeName:               nodeName,
+		antreaClientProvider:   antreaClientProvider,
+		ofClient:               ofClient,
+		networkPolicyQuerier:   npQuerier,
+		multicastQuerier:       mcQuerier,
+		ifaceStore:             ifaceStore,
+		multicastEnabled:       mcQuerier != nil,
+		podTrafficStatsEnabled: podTrafficStatsEnabled,
+		ruleMetricsEnabled:     ruleMetricsEnabled,
+		exportedRuleStats:      map[ruleMetricKey]statsv1alpha1.TrafficStats{},
 	}
 	return manager
 }
@@ -91,11 +129,15 @@ func (m *Collector) Run(stopCh <-chan struct{}) {
 	// If the counters increase during antrea-agent's downtime, the delta will not be reported to the antrea-controller,
 	// it's however better than reporting the full statistics twice which could introduce greater deviations.
 	m.lastStatsCollection = m.collect()
+	m.updateRuleMetrics(m.lastStatsCollection.ruleStats)
 
 	for {
 		select {
 		case <-ticker.C:
 			curStatsCollection := m.collect()
+			// The Prometheus metrics are updated regardless of the result of the report, as they are not reported to
+			// the antrea-controller.
+			m.updateRuleMetrics(curStatsCollection.ruleStats)
 			// Do not update m.lastStatsMap if the report fails so that the next report attempt can add up the
 			// statistics produced in this duration.
 			if err := m.report(curStatsCollection); err != nil {
@@ -116,6 +158,10 @@ func (m *Collector) collect() *statsCollection {
 	npStatsMap := map[types.UID]*statsv1alpha1.TrafficStats{}
 	acnpStatsMap := map[types.UID]map[string]*statsv1alpha1.TrafficStats{}
 	anpStatsMap := map[types.UID]map[string]*statsv1alpha1.TrafficStats{}
+	var ruleMetricStatsMap map[ruleMetricKey]*statsv1alpha1.TrafficStats
+	if m.ruleMetricsEnabled {
+		ruleMetricStatsMap = map[ruleMetricKey]*statsv1alpha1.TrafficStats{}
+	}
 
 	for ofID, ruleStats := range ruleStatsMap {
 		rule := m.networkPolicyQuerier.GetRuleByFlowID(ofID)
@@ -135,17 +181,93 @@ func (m *Collector) collect() *statsCollection {
 		case cpv1beta.AntreaNetworkPolicy:
 			addRuleStatsUp(anpStatsMap, ruleStats, rule)
 		}
+		if ruleMetricStatsMap != nil {
+			key := ruleMetricKey{policyType: rule.PolicyRef.Type, namespace: rule.PolicyRef.Namespace, name: rule.PolicyRef.Name, rule: rule.Name}
+			trafficStats, exists := ruleMetricStatsMap[key]
+			if !exists {
+				trafficStats = new(statsv1alpha1.TrafficStats)
+				ruleMetricStatsMap[key] = trafficStats
+			}
+			addUp(trafficStats, ruleStats)
+		}
 	}
 	var multicastGroupMap map[string][]cpv1beta.PodReference
 	if m.multicastEnabled {
 		multicastGroupMap = m.multicastQuerier.GetGroupPods()
 	}
+	var podTrafficStatsMap map[cpv1beta.PodReference]*cpv1beta.PodTrafficStats
+	if m.podTrafficStatsEnabled {
+		podTrafficStatsMap = m.collectPodTrafficStats()
+	}
 	return &statsCollection{
 		networkPolicyStats:              npStatsMap,
 		antreaClusterNetworkPolicyStats: acnpStatsMap,
 		antreaNetworkPolicyStats:        anpStatsMap,
 		multicastGroups:                 multicastGroupMap,
+		podTrafficStats:                 podTrafficStatsMap,
+		ruleStats:                       ruleMetricStatsMap,
+	}
+}
+
+// updateRuleMetrics adds the increments of the NetworkPolicy rule stats since the last collection to the Prometheus
+// metrics. The metrics of the rules that no longer exist are removed to release their slots. New rules are not
+// exported once maxRuleMetrics is reached, the number of them is exported instead.
+func (m *Collector) updateRuleMetrics(curRuleStats map[ruleMetricKey]*statsv1alpha1.TrafficStats) {
+	if !m.ruleMetricsEnabled {
+		return
 	}
+	for key := range m.exportedRuleStats {
+		if _, exists := curRuleStats[key]; !exists {
+			labels := key.labels()
+			metrics.NetworkPolicyRulePacketCount.Delete(labels)
+			metrics.NetworkPolicyRuleByteCount.Delete(labels)
+			metrics.NetworkPolicyRuleSessionCount.Delete(labels)
+			delete(m.exportedRuleStats, key)
+		}
+	}
+	overflowCount := 0
+	for key, curStats := range curRuleStats {
+		lastStats, exists := m.exportedRuleStats[key]
+		if !exists && len(m.exportedRuleStats) >= maxRuleMetrics {
+			overflowCount++
+			continue
+		}
+		inc := calculateTrafficStatsDiff(*curStats, lastStats)
+		labels := key.labels()
+		// Counters can't decrease, ignore the negative increments which could happen if some flows of the rule are
+		// reinstalled.
+		if inc.Packets > 0 {
+			metrics.NetworkPolicyRulePacketCount.With(labels).Add(float64(inc.Packets))
+		}
+		if inc.Bytes > 0 {
+			metrics.NetworkPolicyRuleByteCount.With(labels).Add(float64(inc.Bytes))
+		}
+		if inc.Sessions > 0 {
+			metrics.NetworkPolicyRuleSessionCount.With(labels).Add(float64(inc.Sessions))
+		}
+		m.exportedRuleStats[key] = *curStats
+	}
+	metrics.NetworkPolicyRuleMetricsOverflowCount.Set(float64(overflowCount))
+}
+
+// collectPodTrafficStats collects the traffic stats of local Pods from the Openflow client, and maps the OFPorts to
+// Pods with the interface store.
+func (m *Collector) collectPodTrafficStats() map[cpv1beta.PodReference]*cpv1beta.PodTrafficStats {
+	statsMap := map[cpv1beta.PodReference]*cpv1beta.PodTrafficStats{}
+	for ofPort, metric := range m.ofClient.PodTrafficMetrics() {
+		iface, ok := m.ifaceStore.GetInterfaceByOFPort(ofPort)
+		if !ok || iface.Type != interfacestore.ContainerInterface {
+			// This could happen if the Pod has been deleted after the flows were dumped.
+			klog.V(4).InfoS("Cannot find Pod for the OFPort", "ofPort", ofPort)
+			continue
+		}
+		pod := cpv1beta.PodReference{Name: iface.PodName, Namespace: iface.PodNamespace}
+		podStats := &cpv1beta.PodTrafficStats{Pod: pod}
+		addBreakdownUp(&podStats.Ingress, &metric.Ingress)
+		addBreakdownUp(&podStats.Egress, &metric.Egress)
+		statsMap[pod] = podStats
+	}
+	return statsMap
 }
 
 func addPolicyStatsUp(statsMap map[types.UID]*statsv1alpha1.TrafficStats, ruleStats *agenttypes.RuleMetric, rule *agenttypes.PolicyRule) {
@@ -177,6 +299,12 @@ func addUp(stats *statsv1alpha1.TrafficStats, inc *agenttypes.RuleMetric) {
 	stats.Bytes += int64(inc.Bytes)
 }
 
+func addBreakdownUp(stats *statsv1alpha1.TrafficBreakdown, inc *agenttypes.TrafficBreakdownMetric) {
+	addUp(&stats.IntraNode, &inc.IntraNode)
+	addUp(&stats.InterNode, &inc.InterNode)
+	addUp(&stats.External, &inc.External)
+}
+
 func isIdenticalMulticastGroupMap(a, b map[string][]cpv1beta.PodReference) bool {
 	if len(a) != len(b) {
 		return false
@@ -250,7 +378,9 @@ func (m *Collector) report(curStatsCollection *statsCollection) error {
 		anpStats = mergeReportStats(multicastANPStatsMap, anpStats)
 	}
 
-	if len(npStats) == 0 && len(acnpStats) == 0 && len(anpStats) == 0 && !multicastGroupsUpdated {
+	podTrafficStats := calculatePodTrafficDiff(curStatsCollection.podTrafficStats, m.lastStatsCollection.podTrafficStats)
+
+	if len(npStats) == 0 && len(acnpStats) == 0 && len(anpStats) == 0 && !multicastGroupsUpdated && len(podTrafficStats) == 0 {
 		klog.V(4).Info("No stats to report, skip reporting")
 		return nil
 	}
@@ -263,6 +393,7 @@ func (m *Collector) report(curStatsCollection *statsCollection) error {
 		AntreaClusterNetworkPolicies: acnpStats,
 		AntreaNetworkPolicies:        anpStats,
 		Multicast:                    multicastGroups,
+		PodTraffic:                   podTrafficStats,
 	}
 	klog.V(6).Infof("Reporting NodeStatsSummary: %v", summary)
 
@@ -365,3 +496,48 @@ func calculateDiff(curStatsMap, lastStatsMap map[types.UID]*statsv1alpha1.Traffi
 	}
 	return statsList
 }
+
+func calculatePodTrafficDiff(curStatsMap, lastStatsMap map[cpv1beta.PodReference]*cpv1beta.PodTrafficStats) []cpv1beta.PodTrafficStats {
+	if len(curStatsMap) == 0 {
+		return nil
+	}
+	statsList := make([]cpv1beta.PodTrafficStats, 0, len(curStatsMap))
+	for pod, curStats := range curStatsMap {
+		stats := *curStats
+		if lastStats, exists := lastStatsMap[pod]; exists {
+			stats.Ingress = calculateBreakdownDiff(curStats.Ingress, lastStats.Ingress)
+			stats.Egress = calculateBreakdownDiff(curStats.Egress, lastStats.Egress)
+		}
+		// If the statistics of the Pod remain unchanged, no need to report it.
+		if isZeroBreakdown(stats.Ingress) && isZeroBreakdown(stats.Egress) {
+			continue
+		}
+		statsList = append(statsList, stats)
+	}
+	return statsList
+}
+
+func calculateBreakdownDiff(cur, last statsv1alpha1.TrafficBreakdown) statsv1alpha1.TrafficBreakdown {
+	return statsv1alpha1.TrafficBreakdown{
+		IntraNode: calculateTrafficStatsDiff(cur.IntraNode, last.IntraNode),
+		InterNode: calculateTrafficStatsDiff(cur.InterNode, last.InterNode),
+		External:  calculateTrafficStatsDiff(cur.External, last.External),
+	}
+}
+
+func calculateTrafficStatsDiff(cur, last statsv1alpha1.TrafficStats) statsv1alpha1.TrafficStats {
+	// cur.Bytes < last.Bytes could happen if OVS is restarted, or the Pod is recreated with the same name in-between
+	// two collections. In these cases, cur is the delta it should report.
+	if cur.Bytes < last.Bytes {
+		return cur
+	}
+	return statsv1alpha1.TrafficStats{
+		Packets:  cur.Packets - last.Packets,
+		Sessions: cur.Sessions - last.Sessions,
+		Bytes:    cur.Bytes - last.Bytes,
+	}
+}
+
+func isZeroBreakdown(stats statsv1alpha1.TrafficBreakdown) bool {
+	return stats.IntraNode.Bytes == 0 && stats.InterNode.Bytes == 0 && stats.External.Bytes == 0
+}
@@ -15,13 +15,20 @@
 package stats
 
 import (
+	"fmt"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/component-base/metrics/legacyregistry"
 
+	"antrea.io/antrea/pkg/agent/interfacestore"
+	"antrea.io/antrea/pkg/agent/metrics"
 	oftest "antrea.io/antrea/pkg/agent/openflow/testing"
 	agenttypes "antrea.io/antrea/pkg/agent/types"
 	cpv1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
@@ -207,6 +214,98 @@ func TestCollect(t *testing.T) {
 	}
 }
 
+func TestCollectPodTrafficStats(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ofClient := oftest.NewMockClient(ctrl)
+	ifaceStore := interfacestore.NewInterfaceStore()
+	pod1Iface := interfacestore.NewContainerInterface("pod1-abc", "container1", "pod1", "ns1", nil, nil, 0)
+	pod1Iface.OVSPortConfig = &interfacestore.OVSPortConfig{OFPort: 3}
+	ifaceStore.AddInterface(pod1Iface)
+
+	ofClient.EXPECT().PodTrafficMetrics().Return(map[uint32]*agenttypes.PodTrafficMetric{
+		3: {
+			Ingress: agenttypes.TrafficBreakdownMetric{
+				IntraNode: agenttypes.RuleMetric{Bytes: 10, Packets: 1},
+				External:  agenttypes.RuleMetric{Bytes: 20, Packets: 2},
+			},
+			Egress: agenttypes.TrafficBreakdownMetric{
+				InterNode: agenttypes.RuleMetric{Bytes: 30, Packets: 3},
+			},
+		},
+		// The Pod of OFPort 4 has been deleted.
+		4: {
+			Egress: agenttypes.TrafficBreakdownMetric{
+				External: agenttypes.RuleMetric{Bytes: 40, Packets: 4},
+			},
+		},
+	}).Times(1)
+
+	m := &Collector{ofClient: ofClient, ifaceStore: ifaceStore, podTrafficStatsEnabled: true}
+	actual := m.collectPodTrafficStats()
+	pod1 := cpv1beta.PodReference{Name: "pod1", Namespace: "ns1"}
+	expected := map[cpv1beta.PodReference]*cpv1beta.PodTrafficStats{
+		pod1: {
+			Pod: pod1,
+			Ingress: statsv1alpha1.TrafficBreakdown{
+				IntraNode: statsv1alpha1.TrafficStats{Bytes: 10, Packets: 1},
+				External:  statsv1alpha1.TrafficStats{Bytes: 20, Packets: 2},
+			},
+			Egress: statsv1alpha1.TrafficBreakdown{
+				InterNode: statsv1alpha1.TrafficStats{Bytes: 30, Packets: 3},
+			},
+		},
+	}
+	assert.Equal(t, expected, actual)
+}
+
+func TestCalculatePodTrafficDiff(t *testing.T) {
+	pod1 := cpv1beta.PodReference{Name: "pod1", Namespace: "ns1"}
+	pod2 := cpv1beta.PodReference{Name: "pod2", Namespace: "ns1"}
+	pod3 := cpv1beta.PodReference{Name: "pod3", Namespace: "ns2"}
+	lastStats := map[cpv1beta.PodReference]*cpv1beta.PodTrafficStats{
+		pod1: {
+			Pod:     pod1,
+			Ingress: statsv1alpha1.TrafficBreakdown{IntraNode: statsv1alpha1.TrafficStats{Bytes: 10, Packets: 1}},
+			Egress:  statsv1alpha1.TrafficBreakdown{External: statsv1alpha1.TrafficStats{Bytes: 50, Packets: 5}},
+		},
+		pod2: {
+			Pod:    pod2,
+			Egress: statsv1alpha1.TrafficBreakdown{InterNode: statsv1alpha1.TrafficStats{Bytes: 10, Packets: 1}},
+		},
+	}
+	curStats := map[cpv1beta.PodReference]*cpv1beta.PodTrafficStats{
+		// Counters of pod1 increased, except that the egress external counters were reset.
+		pod1: {
+			Pod:     pod1,
+			Ingress: statsv1alpha1.TrafficBreakdown{IntraNode: statsv1alpha1.TrafficStats{Bytes: 25, Packets: 3}},
+			Egress:  statsv1alpha1.TrafficBreakdown{External: statsv1alpha1.TrafficStats{Bytes: 20, Packets: 2}},
+		},
+		// Counters of pod2 remain unchanged.
+		pod2: {
+			Pod:    pod2,
+			Egress: statsv1alpha1.TrafficBreakdown{InterNode: statsv1alpha1.TrafficStats{Bytes: 10, Packets: 1}},
+		},
+		// pod3 is a new Pod.
+		pod3: {
+			Pod:     pod3,
+			Ingress: statsv1alpha1.TrafficBreakdown{External: statsv1alpha1.TrafficStats{Bytes: 30, Packets: 3}},
+		},
+	}
+	expectedStatsList := []cpv1beta.PodTrafficStats{
+		{
+			Pod:     pod1,
+			Ingress: statsv1alpha1.TrafficBreakdown{IntraNode: statsv1alpha1.TrafficStats{Bytes: 15, Packets: 2}},
+			Egress:  statsv1alpha1.TrafficBreakdown{External: statsv1alpha1.TrafficStats{Bytes: 20, Packets: 2}},
+		},
+		{
+			Pod:     pod3,
+			Ingress: statsv1alpha1.TrafficBreakdown{External: statsv1alpha1.TrafficStats{Bytes: 30, Packets: 3}},
+		},
+	}
+	actualStatsList := calculatePodTrafficDiff(curStats, lastStats)
+	assert.ElementsMatch(t, expectedStatsList, actualStatsList)
+}
+
 func TestCalculateDiff(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -560,3 +659,59 @@ func TestCalculateRuleDiff(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateRuleMetrics(t *testing.T) {
+	metrics.InitializeNetworkPolicyMetrics()
+	m := &Collector{ruleMetricsEnabled: true, exportedRuleStats: map[ruleMetricKey]statsv1alpha1.TrafficStats{}}
+	knpKey := ruleMetricKey{policyType: cpv1beta.K8sNetworkPolicy, namespace: "foo", name: "bar"}
+	acnpKey := ruleMetricKey{policyType: cpv1beta.AntreaClusterNetworkPolicy, name: "acnp", rule: "deny-all"}
+
+	m.updateRuleMetrics(map[ruleMetricKey]*statsv1alpha1.TrafficStats{
+		knpKey:  {Packets: 5, Bytes: 50, Sessions: 1},
+		acnpKey: {Packets: 10, Bytes: 100, Sessions: 1},
+	})
+	checkRuleMetrics(t, `
+antrea_agent_networkpolicy_rule_packet_count{name="acnp",namespace="",policy_type="AntreaClusterNetworkPolicy",rule="deny-all"} 10
+antrea_agent_networkpolicy_rule_packet_count{name="bar",namespace="foo",policy_type="K8sNetworkPolicy",rule=""} 5
+`)
+
+	// The K8s NetworkPolicy is removed, and the flows of the ACNP rule are reinstalled.
+	m.updateRuleMetrics(map[ruleMetricKey]*statsv1alpha1.TrafficStats{
+		acnpKey: {Packets: 4, Bytes: 40, Sessions: 1},
+	})
+	checkRuleMetrics(t, `
+antrea_agent_networkpolicy_rule_packet_count{name="acnp",namespace="",policy_type="AntreaClusterNetworkPolicy",rule="deny-all"} 14
+`)
+
+	// The rules exceeding maxRuleMetrics are not exported.
+	curRuleStats := map[ruleMetricKey]*statsv1alpha1.TrafficStats{
+		acnpKey: {Packets: 5, Bytes: 50, Sessions: 1},
+	}
+	for i := 0; i < maxRuleMetrics; i++ {
+		curRuleStats[ruleMetricKey{policyType: cpv1beta.AntreaNetworkPolicy, namespace: "foo", name: fmt.Sprintf("anp-%d", i)}] = &statsv1alpha1.TrafficStats{Packets: 1, Bytes: 10}
+	}
+	m.updateRuleMetrics(curRuleStats)
+	assert.Len(t, m.exportedRuleStats, maxRuleMetrics)
+	assert.Contains(t, m.exportedRuleStats, acnpKey)
+	err := testutil.GatherAndCompare(legacyregistry.DefaultGatherer, strings.NewReader(`
+# HELP antrea_agent_networkpolicy_rule_metrics_overflow_count [ALPHA] Number of NetworkPolicy rules on local Node whose hit counts are not exported because the maximum number of exported rules has been reached.
+# TYPE antrea_agent_networkpolicy_rule_metrics_overflow_count gauge
+antrea_agent_networkpolicy_rule_metrics_overflow_count 1
+`), "antrea_agent_networkpolicy_rule_metrics_overflow_count")
+	assert.NoError(t, err)
+
+	m.updateRuleMetrics(nil)
+	assert.Empty(t, m.exportedRuleStats)
+	checkRuleMetrics(t, "")
+}
+
+func checkRuleMetrics(t *testing.T, expectedPacketCounts string) {
+	expected := ""
+	if expectedPacketCounts != "" {
+		expected = `
+# HELP antrea_agent_networkpolicy_rule_packet_count [ALPHA] Number of packets hit by NetworkPolicy rules on local Node, partitioned by policy type, Namespace, name and rule name. This metric gets updated every 60 seconds.
+# TYPE antrea_agent_networkpolicy_rule_packet_count counter` + expectedPacketCounts
+	}
+	err := testutil.GatherAndCompare(legacyregistry.DefaultGatherer, strings.NewReader(expected), "antrea_agent_networkpolicy_rule_packet_count")
+	require.NoError(t, err)
+}
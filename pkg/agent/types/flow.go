@@ -0,0 +1,48 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+// FlowOwnerType is the type of the object which an OpenFlow flow is installed for.
+type FlowOwnerType string
+
+const (
+	FlowOwnerNode              FlowOwnerType = "Node"
+	FlowOwnerPodInterface      FlowOwnerType = "PodInterface"
+	FlowOwnerTrafficControl    FlowOwnerType = "TrafficControl"
+	FlowOwnerServicePort       FlowOwnerType = "ServicePort"
+	FlowOwnerEndpoint          FlowOwnerType = "Endpoint"
+	FlowOwnerEgressSNATMark    FlowOwnerType = "EgressSNATMark"
+	FlowOwnerEgressPodPort     FlowOwnerType = "EgressPodPort"
+	FlowOwnerTraceflow         FlowOwnerType = "Traceflow"
+	FlowOwnerPacketCapture     FlowOwnerType = "PacketCapture"
+	FlowOwnerMulticast         FlowOwnerType = "Multicast"
+	FlowOwnerMulticastGroup    FlowOwnerType = "MulticastGroup"
+	FlowOwnerMulticluster      FlowOwnerType = "Multicluster"
+	FlowOwnerNetworkPolicyRule FlowOwnerType = "NetworkPolicyRule"
+)
+
+// CachedFlow describes a flow cached in the OpenFlow client, which is expected
+// to be installed in OVS, and the object the flow is installed for.
+type CachedFlow struct {
+	// MatchString is the key of the flow, which can be used to dump the flow
+	// from OVS.
+	MatchString string
+	OwnerType   FlowOwnerType
+	// OwnerName identifies the owner of the flow within OwnerType, e.g. the
+	// interface name of a Pod, or "<IP>:<port>/<protocol>" of a Service port or an Endpoint.
+	// For NetworkPolicyRule, it is the comma-separated conjunction IDs of the
+	// rules sharing the flow.
+	OwnerName string
+}
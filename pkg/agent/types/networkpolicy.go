@@ -85,6 +85,8 @@ type PolicyRule struct {
 	TableID       uint8
 	PolicyRef     *v1beta2.NetworkPolicyReference
 	EnableLogging bool
+	// RateLimit is only set when Action is RateLimit.
+	RateLimit *v1beta2.RateLimit
 }
 
 // IsAntreaNetworkPolicyRule returns if a PolicyRule is created for Antrea NetworkPolicy types.
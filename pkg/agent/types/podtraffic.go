@@ -0,0 +1,37 @@
+// Copyright 2022 Antrea Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+// TrafficBreakdownMetric is the traffic metric of a Pod in one direction, broken down by the location of the peer.
+type TrafficBreakdownMetric struct {
+	// IntraNode is the metric of the traffic with Pods running on the same Node.
+	IntraNode RuleMetric
+	// InterNode is the metric of the traffic with Pods running on other Nodes.
+	InterNode RuleMetric
+	// External is the metric of the traffic with peers outside the Pod network.
+	External RuleMetric
+}
+
+func (m *TrafficBreakdownMetric) Merge(m1 *TrafficBreakdownMetric) {
+	m.IntraNode.Merge(&m1.IntraNode)
+	m.InterNode.Merge(&m1.InterNode)
+	m.External.Merge(&m1.External)
+}
+
+// PodTrafficMetric is the traffic metric of a local Pod.
+type PodTrafficMetric struct {
+	Ingress TrafficBreakdownMetric
+	Egress  TrafficBreakdownMetric
+}
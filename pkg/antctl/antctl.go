@@ -25,19 +25,27 @@ import (
 	"antrea.io/antrea/pkg/agent/apiserver/handlers/serviceexternalip"
 	"antrea.io/antrea/pkg/agent/openflow"
 	fallbackversion "antrea.io/antrea/pkg/antctl/fallback/version"
+	"antrea.io/antrea/pkg/antctl/raw/check"
+	"antrea.io/antrea/pkg/antctl/raw/config"
 	"antrea.io/antrea/pkg/antctl/raw/featuregates"
 	"antrea.io/antrea/pkg/antctl/raw/multicluster"
+	"antrea.io/antrea/pkg/antctl/raw/packetcapture"
+	"antrea.io/antrea/pkg/antctl/raw/policy"
 	"antrea.io/antrea/pkg/antctl/raw/proxy"
 	"antrea.io/antrea/pkg/antctl/raw/set"
 	"antrea.io/antrea/pkg/antctl/raw/supportbundle"
+	"antrea.io/antrea/pkg/antctl/raw/topology"
 	"antrea.io/antrea/pkg/antctl/raw/traceflow"
 	"antrea.io/antrea/pkg/antctl/transform/addressgroup"
 	"antrea.io/antrea/pkg/antctl/transform/appliedtogroup"
 	"antrea.io/antrea/pkg/antctl/transform/controllerinfo"
 	"antrea.io/antrea/pkg/antctl/transform/networkpolicy"
+	"antrea.io/antrea/pkg/antctl/transform/nodelatencystats"
 	"antrea.io/antrea/pkg/antctl/transform/ovstracing"
+	"antrea.io/antrea/pkg/antctl/transform/policyverdict"
 	"antrea.io/antrea/pkg/antctl/transform/version"
 	cpv1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
 	systemv1beta1 "antrea.io/antrea/pkg/apis/system/v1beta1"
 	controllerinforest "antrea.io/antrea/pkg/apiserver/registry/system/controllerinfo"
 	"antrea.io/antrea/pkg/client/clientset/versioned/scheme"
@@ -188,6 +196,7 @@ $ antctl get podmulticaststats pod -n namespace`,
 					groupVersionResource: &cpv1beta.NetworkPolicyVersionResource,
 				},
 				addonTransform: networkpolicy.Transform,
+				watch:          &watchOption{},
 			},
 			agentEndpoint: &endpoint{
 				nonResourceEndpoint: &nonResourceEndpoint{
@@ -215,13 +224,14 @@ $ antctl get podmulticaststats pod -n namespace`,
 						},
 						{
 							name:      "type",
-							usage:     "Get NetworkPolicies with specific type. Type means the type of its source network policy: K8sNP, ACNP, ANP",
+							usage:     "Get NetworkPolicies with specific type. Type means the type of its source network policy: K8sNP, ACNP, ANP, AdminNP, BANP",
 							shorthand: "T",
 						},
 					}, getSortByFlag()),
 					outputType: multiple,
 				},
 				addonTransform: networkpolicy.Transform,
+				watch:          &watchOption{keyFunc: fieldsKey("metadata.name")},
 			},
 			transformedResponse: reflect.TypeOf(networkpolicy.Response{}),
 		},
@@ -236,6 +246,7 @@ $ antctl get podmulticaststats pod -n namespace`,
 					groupVersionResource: &cpv1beta.AppliedToGroupVersionResource,
 				},
 				addonTransform: appliedtogroup.Transform,
+				watch:          &watchOption{},
 			},
 			agentEndpoint: &endpoint{
 				nonResourceEndpoint: &nonResourceEndpoint{
@@ -249,6 +260,7 @@ $ antctl get podmulticaststats pod -n namespace`,
 					},
 				},
 				addonTransform: appliedtogroup.Transform,
+				watch:          &watchOption{keyFunc: fieldsKey("metadata.name")},
 			},
 			transformedResponse: reflect.TypeOf(appliedtogroup.Response{}),
 		},
@@ -263,6 +275,7 @@ $ antctl get podmulticaststats pod -n namespace`,
 					groupVersionResource: &cpv1beta.AddressGroupVersionResource,
 				},
 				addonTransform: addressgroup.Transform,
+				watch:          &watchOption{},
 			},
 			agentEndpoint: &endpoint{
 				nonResourceEndpoint: &nonResourceEndpoint{
@@ -276,9 +289,24 @@ $ antctl get podmulticaststats pod -n namespace`,
 					},
 				},
 				addonTransform: addressgroup.Transform,
+				watch:          &watchOption{keyFunc: fieldsKey("metadata.name")},
 			},
 			transformedResponse: reflect.TypeOf(addressgroup.Response{}),
 		},
+		{
+			use:          "nodelatencystats",
+			aliases:      []string{"nls"},
+			short:        "Print Node latency stats",
+			long:         "Print the latency and packet loss between Nodes measured by antrea-agents, which requires the NodeLatencyMonitor feature gate to be enabled",
+			commandGroup: get,
+			controllerEndpoint: &endpoint{
+				resourceEndpoint: &resourceEndpoint{
+					groupVersionResource: &statsv1alpha1.NodeLatencyStatsVersionResource,
+				},
+				addonTransform: nodelatencystats.Transform,
+			},
+			transformedResponse: reflect.TypeOf(nodelatencystats.Response{}),
+		},
 		{
 			use:     "controllerinfo",
 			aliases: []string{"controllerinfos", "ci"},
@@ -338,6 +366,7 @@ $ antctl get podmulticaststats pod -n namespace`,
 					},
 					outputType: multiple,
 				},
+				watch: &watchOption{keyFunc: fieldsKey("podNamespace", "name", "interfaceName")},
 			},
 			commandGroup:        get,
 			transformedResponse: reflect.TypeOf(podinterface.Response{}),
@@ -361,6 +390,10 @@ $ antctl get podmulticaststats pod -n namespace`,
   $ antctl get ovsflows -G 10,20
   Dump all OVS groups
   $ antctl get ovsflows -G all
+  Dump OVS flows of a local Pod, with their tables, pipeline stages and owners explained
+  $ antctl get ovsflows -p pod1 -n ns1 --explain
+  Dump the flows missing from OVS, and the stale flows installed in a previous round
+  $ antctl get ovsflows --drift
 
   Antrea OVS Flow Tables:` + generateFlowTableHelpMsg(),
 			agentEndpoint: &endpoint{
@@ -397,9 +430,28 @@ $ antctl get podmulticaststats pod -n namespace`,
 							usage:     "Comma separated OVS group IDs. Use 'all' to dump all groups",
 							shorthand: "G",
 						},
+						{
+							name:   "explain",
+							usage:  "Explain the flows with their tables, pipeline stages, cookies and owners. Not supported for groups.",
+							isBool: true,
+						},
+						{
+							name:   "drift",
+							usage:  "Dump the flows expected by the Agent but missing from OVS, and the stale flows installed in a previous round. Cannot be combined with other flags.",
+							isBool: true,
+						},
 					},
 					outputType: multiple,
 				},
+				// The statistics of the flows are ignored, so that only
+				// the installed and uninstalled flows are output.
+				watch: &watchOption{
+					keyFunc: func(obj map[string]interface{}) string {
+						flow, _ := obj["flow"].(string)
+						return ovsflows.FlowIdentity(flow)
+					},
+					keyOnly: true,
+				},
 			},
 			commandGroup:        get,
 			transformedResponse: reflect.TypeOf(ovsflows.Response{}),
@@ -488,6 +540,66 @@ $ antctl get podmulticaststats pod -n namespace`,
 			},
 			transformedResponse: reflect.TypeOf(controllernetworkpolicy.EndpointQueryResponse{}),
 		},
+		{
+			use:     "policy-verdict",
+			aliases: []string{"policyverdict"},
+			short:   "Evaluate NetworkPolicies for a connection without sending traffic.",
+			long:    "Evaluate the NetworkPolicies (K8s NetworkPolicies, Antrea NetworkPolicies and Antrea ClusterNetworkPolicies) which apply to a connection, and print the effective verdict along with the rule which decides it for the egress of the source and the ingress of the destination. The source can be an existing Pod, a new Pod in a Namespace, or an IP address. The destination can be a Pod, a Service (one verdict is printed for each backend Pod) or an IP address. FQDN rules are not evaluated.",
+			example: `  Check whether Pod ns1/client can connect to Pod ns2/server on TCP port 80
+  $ antctl query policy-verdict --source-pod ns1/client --destination-pod ns2/server --port 80
+  Check whether a new Pod with label app=web in Namespace ns1 can reach Service ns2/db on port 5432
+  $ antctl query policy-verdict --source-namespace ns1 --source-labels app=web --destination-service ns2/db --port 5432
+  Check whether an external IP can ping Pod ns2/server
+  $ antctl query policy-verdict --source-ip 192.168.1.10 --destination-pod ns2/server --protocol ICMP
+`,
+			commandGroup: query,
+			controllerEndpoint: &endpoint{
+				nonResourceEndpoint: &nonResourceEndpoint{
+					path: "/policyverdict",
+					params: []flagInfo{
+						{
+							name:  "source-pod",
+							usage: "Source Pod of the connection, specified by <Namespace>/<name> (the Namespace defaults to 'default')",
+						},
+						{
+							name:  "source-namespace",
+							usage: "Namespace of a new Pod to use as the source of the connection",
+						},
+						{
+							name:  "source-labels",
+							usage: "Labels of the new Pod created in the Namespace provided with --source-namespace, e.g. 'app=web,tier=frontend'",
+						},
+						{
+							name:  "source-ip",
+							usage: "Source IP address of the connection",
+						},
+						{
+							name:  "destination-pod",
+							usage: "Destination Pod of the connection, specified by <Namespace>/<name> (the Namespace defaults to 'default')",
+						},
+						{
+							name:  "destination-service",
+							usage: "Destination Service of the connection, specified by <Namespace>/<name> (the Namespace defaults to 'default')",
+						},
+						{
+							name:  "destination-ip",
+							usage: "Destination IP address of the connection",
+						},
+						{
+							name:  "protocol",
+							usage: "Protocol of the connection: TCP, UDP, SCTP or ICMP (defaults to TCP)",
+						},
+						{
+							name:  "port",
+							usage: "Destination port of the connection, or Service port when the destination is a Service. Required unless the protocol is ICMP",
+						},
+					},
+					outputType: single,
+				},
+				addonTransform: policyverdict.Transform,
+			},
+			transformedResponse: reflect.TypeOf(policyverdict.Response{}),
+		},
 		{
 			use:   "flowrecords",
 			short: "Print the matching flow records in the flow aggregator",
@@ -565,6 +677,7 @@ $ antctl get podmulticaststats pod -n namespace`,
 					},
 					outputType: multiple,
 				},
+				watch: &watchOption{keyFunc: fieldsKey("namespace", "serviceName")},
 			},
 			transformedResponse: reflect.TypeOf(serviceexternalip.Response{}),
 		},
@@ -580,17 +693,38 @@ $ antctl get podmulticaststats pod -n namespace`,
 			supportAgent:      true,
 			supportController: true,
 		},
+		{
+			cobraCommand:      packetcapture.Command,
+			supportAgent:      false,
+			supportController: true,
+		},
+		{
+			cobraCommand:      check.Command,
+			supportAgent:      false,
+			supportController: true,
+		},
 		{
 			cobraCommand:      proxy.Command,
 			supportAgent:      false,
 			supportController: true,
 		},
+		{
+			cobraCommand:      config.Command,
+			supportAgent:      true,
+			supportController: true,
+		},
 		{
 			cobraCommand:      featuregates.Command,
 			supportAgent:      true,
 			supportController: true,
 			commandGroup:      get,
 		},
+		{
+			cobraCommand:      topology.Command,
+			supportAgent:      false,
+			supportController: true,
+			commandGroup:      get,
+		},
 		{
 			cobraCommand:      multicluster.GetCmd,
 			supportAgent:      false,
@@ -621,6 +755,12 @@ $ antctl get podmulticaststats pod -n namespace`,
 			supportController: false,
 			commandGroup:      mc,
 		},
+		{
+			cobraCommand:          policy.PolicyCmd,
+			supportAgent:          false,
+			supportController:     true,
+			supportFlowAggregator: true,
+		},
 		{
 			cobraCommand:          set.SetCmd,
 			supportAgent:          false,
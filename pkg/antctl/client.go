@@ -24,7 +24,10 @@ import (
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/watch"
 	genericapiserver "k8s.io/apiserver/pkg/server"
 	"k8s.io/client-go/rest"
 
@@ -55,6 +58,7 @@ type requestOption struct {
 
 type AntctlClient interface {
 	request(opt *requestOption) (io.Reader, error)
+	watch(opt *requestOption) (watch.Interface, error)
 }
 
 // client issues requests to endpoints.
@@ -98,20 +102,32 @@ func (c *client) resolveKubeconfig(opt *requestOption) (*rest.Config, error) {
 }
 
 func (c *client) request(opt *requestOption) (io.Reader, error) {
-	var e *endpoint
-	if runtime.Mode == runtime.ModeAgent {
-		e = opt.commandDefinition.agentEndpoint
-	} else if runtime.Mode == runtime.ModeFlowAggregator {
-		e = opt.commandDefinition.flowAggregatorEndpoint
-	} else {
-		e = opt.commandDefinition.controllerEndpoint
-	}
+	e := requestEndpoint(opt)
 	if e.resourceEndpoint != nil {
 		return c.resourceRequest(e.resourceEndpoint, opt)
 	}
 	return c.nonResourceRequest(e.nonResourceEndpoint, opt)
 }
 
+// watch starts watching the resource of the command. Only resourceEndpoints
+// can be watched, the other endpoints must be polled.
+func (c *client) watch(opt *requestOption) (watch.Interface, error) {
+	e := requestEndpoint(opt)
+	if e.resourceEndpoint == nil {
+		return nil, fmt.Errorf("command %s does not support the watch API", opt.commandDefinition.use)
+	}
+	return c.resourceWatch(e.resourceEndpoint, opt)
+}
+
+func requestEndpoint(opt *requestOption) *endpoint {
+	if runtime.Mode == runtime.ModeAgent {
+		return opt.commandDefinition.agentEndpoint
+	} else if runtime.Mode == runtime.ModeFlowAggregator {
+		return opt.commandDefinition.flowAggregatorEndpoint
+	}
+	return opt.commandDefinition.controllerEndpoint
+}
+
 func (c *client) nonResourceRequest(e *nonResourceEndpoint, opt *requestOption) (io.Reader, error) {
 	kubeconfig, err := c.resolveKubeconfig(opt)
 	if err != nil {
@@ -186,3 +202,37 @@ func (c *client) resourceRequest(e *resourceEndpoint, opt *requestOption) (io.Re
 	}
 	return bytes.NewReader(raw), nil
 }
+
+func (c *client) resourceWatch(e *resourceEndpoint, opt *requestOption) (watch.Interface, error) {
+	kubeconfig, err := c.resolveKubeconfig(opt)
+	if err != nil {
+		return nil, err
+	}
+	if opt.server != "" {
+		kubeconfig.Host = opt.server
+	}
+	gv := e.groupVersionResource.GroupVersion()
+	kubeconfig.GroupVersion = &gv
+	kubeconfig.APIPath = genericapiserver.APIGroupPrefix
+	// Unlike the responses of the other requests, the watch events are
+	// decoded by the client, to the versioned objects.
+	kubeconfig.NegotiatedSerializer = c.codec.WithoutConversion()
+
+	// The timeout is not applied to the client, as the watch lasts until it
+	// is stopped.
+	restClient, err := rest.RESTClientFor(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create rest client: %w", err)
+	}
+	listOptions := metav1.ListOptions{Watch: true}
+	if len(e.resourceName) != 0 {
+		listOptions.FieldSelector = fields.OneTermEqualSelector("metadata.name", e.resourceName).String()
+	} else if name, ok := opt.args["name"]; ok {
+		listOptions.FieldSelector = fields.OneTermEqualSelector("metadata.name", name).String()
+	}
+	return restClient.Get().
+		NamespaceIfScoped(opt.args["namespace"], e.namespaced).
+		Resource(e.groupVersionResource.Resource).
+		VersionedParams(&listOptions, metav1.ParameterCodec).
+		Watch(context.TODO())
+}
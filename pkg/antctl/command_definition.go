@@ -41,6 +41,7 @@ const (
 	jsonFormatter  formatterType = "json"
 	yamlFormatter  formatterType = "yaml"
 	tableFormatter formatterType = "table"
+	wideFormatter  formatterType = "wide"
 )
 
 const (
@@ -172,6 +173,24 @@ type endpoint struct {
 	// function. This is useful if a command still needs to output useful
 	// information in case of error.
 	requestErrorFallback func() (io.Reader, error)
+	// watch enables the --watch flag of the command, which outputs the
+	// changes of the objects until the command is interrupted.
+	watch *watchOption
+}
+
+// watchOption defines how the changes of the objects of an endpoint are
+// observed. The objects of a resourceEndpoint are watched with the watch API of
+// the resource, while a nonResourceEndpoint is polled and the objects of
+// consecutive responses are compared.
+type watchOption struct {
+	// keyFunc returns the key identifying an object of the responses of a
+	// nonResourceEndpoint. It is not needed by a resourceEndpoint, whose
+	// objects are identified by their names.
+	keyFunc func(obj map[string]interface{}) string
+	// keyOnly indicates that the objects with the same key are considered
+	// unchanged, even if they are not equal, e.g. for OVS flows whose
+	// statistics change continuously.
+	keyOnly bool
 }
 
 // flagInfo represents a command-line flag that can be provided when invoking an antctl command.
@@ -181,7 +200,10 @@ type flagInfo struct {
 	defaultValue    string
 	supportedValues []string
 	arg             bool
-	usage           string
+	// isBool indicates the flag is a boolean flag, which is passed to the
+	// server as "true" when set.
+	isBool bool
+	usage  string
 }
 
 // rawCommand defines a full function cobra.Command which lets developers
@@ -263,6 +285,17 @@ func (cd *commandDefinition) getEndpoint() endpointResponder {
 	return nil
 }
 
+func (cd *commandDefinition) getWatchOption() *watchOption {
+	if runtime.Mode == runtime.ModeAgent && cd.agentEndpoint != nil {
+		return cd.agentEndpoint.watch
+	} else if runtime.Mode == runtime.ModeController && cd.controllerEndpoint != nil {
+		return cd.controllerEndpoint.watch
+	} else if runtime.Mode == runtime.ModeFlowAggregator && cd.flowAggregatorEndpoint != nil {
+		return cd.flowAggregatorEndpoint.watch
+	}
+	return nil
+}
+
 func (cd *commandDefinition) getRequestErrorFallback() func() (io.Reader, error) {
 	if runtime.Mode == runtime.ModeAgent {
 		if cd.agentEndpoint != nil {
@@ -347,8 +380,16 @@ func (cd *commandDefinition) validate() []error {
 	if cd.flowAggregatorEndpoint != nil && cd.flowAggregatorEndpoint.nonResourceEndpoint == nil && cd.flowAggregatorEndpoint.resourceEndpoint == nil {
 		errs = append(errs, fmt.Errorf("%s: command for flow aggregator must define one endpoint", cd.use))
 	}
+	for _, e := range []*endpoint{cd.agentEndpoint, cd.controllerEndpoint, cd.flowAggregatorEndpoint} {
+		if e != nil && e.watch != nil && e.nonResourceEndpoint != nil && e.watch.keyFunc == nil {
+			errs = append(errs, fmt.Errorf("%s: watch of a non-resource endpoint must define a key function", cd.use))
+		}
+	}
 	empty := struct{}{}
 	existingFlags := map[string]struct{}{"output": empty, "help": empty, "kubeconfig": empty, "timeout": empty, "verbose": empty}
+	if cd.getWatchOption() != nil {
+		existingFlags["watch"] = empty
+	}
 	if endpoint := cd.getEndpoint(); endpoint != nil {
 		for _, f := range endpoint.flags() {
 			if len(f.name) == 0 {
@@ -493,29 +534,41 @@ func (cd *commandDefinition) tableOutputForQueryEndpoint(obj interface{}, writer
 	return nil
 }
 
-// output reads bytes from the resp and outputs the data to the writer in desired
-// format. If the AddonTransform is set, it will use the function to transform
-// the data first. It will try to output the resp in the format ft specified after
-// doing transform.
-func (cd *commandDefinition) output(resp io.Reader, writer io.Writer, ft formatterType, single bool, args map[string]string) (err error) {
-	var obj interface{}
+// transform reads bytes from the resp and converts them to the
+// TransformedResponse object(s), with the AddonTransform if it is set. It
+// returns io.EOF if the resp is empty and there is no AddonTransform.
+func (cd *commandDefinition) transform(resp io.Reader, single bool, args map[string]string) (interface{}, error) {
 	addonTransform := cd.getAddonTransform()
-
 	if addonTransform == nil { // Decode the data if there is no AddonTransform.
-		obj, err = cd.decode(resp, single)
+		obj, err := cd.decode(resp, single)
 		if err == io.EOF {
-			// No response returned.
-			return nil
+			return nil, err
 		}
 		if err != nil {
-			return fmt.Errorf("error when decoding response %v: %w", resp, err)
+			return nil, fmt.Errorf("error when decoding response %v: %w", resp, err)
 		}
-	} else {
-		obj, err = addonTransform(resp, single, args)
-		if err != nil {
-			return fmt.Errorf("error when doing local transform: %w", err)
-		}
-		klog.Infof("After transforming %v", obj)
+		return obj, nil
+	}
+	obj, err := addonTransform(resp, single, args)
+	if err != nil {
+		return nil, fmt.Errorf("error when doing local transform: %w", err)
+	}
+	klog.Infof("After transforming %v", obj)
+	return obj, nil
+}
+
+// output reads bytes from the resp and outputs the data to the writer in desired
+// format. If the AddonTransform is set, it will use the function to transform
+// the data first. It will try to output the resp in the format ft specified after
+// doing transform.
+func (cd *commandDefinition) output(resp io.Reader, writer io.Writer, ft formatterType, single bool, args map[string]string) (err error) {
+	obj, err := cd.transform(resp, single, args)
+	if err == io.EOF {
+		// No response returned.
+		return nil
+	}
+	if err != nil {
+		return err
 	}
 
 	if str, ok := obj.([]byte); ok {
@@ -537,10 +590,17 @@ func (cd *commandDefinition) output(resp io.Reader, writer io.Writer, ft formatt
 		} else if cd.commandGroup == query {
 			if cd.controllerEndpoint.nonResourceEndpoint.path == "/endpoint" {
 				return cd.tableOutputForQueryEndpoint(obj, writer)
+			} else if cd.controllerEndpoint.nonResourceEndpoint.path == "/policyverdict" {
+				return output.TableOutputForGetCommands(obj, writer)
 			}
 		} else {
 			return output.TableOutput(obj, writer)
 		}
+	case wideFormatter:
+		if cd.commandGroup == get {
+			return output.WideTableOutputForGetCommands(obj, writer)
+		}
+		return fmt.Errorf("unsupported format type: %v", ft)
 	default:
 		return fmt.Errorf("unsupported format type: %v", ft)
 	}
@@ -555,6 +615,10 @@ func (cd *commandDefinition) collectFlags(cmd *cobra.Command, args []string) (ma
 				if len(args) > 0 {
 					argMap[f.name] = args[0]
 				}
+			} else if f.isBool {
+				if b, err := cmd.Flags().GetBool(f.name); err == nil && b {
+					argMap[f.name] = "true"
+				}
 			} else {
 				vs, err := cmd.Flags().GetString(f.name)
 				if err == nil && len(vs) != 0 {
@@ -606,13 +670,17 @@ func (cd *commandDefinition) newCommandRunE(c AntctlClient, out io.Writer) func(
 			return err
 		}
 
-		resp, requestErr := c.request(&requestOption{
+		opt := &requestOption{
 			commandDefinition: cd,
 			kubeconfig:        kubeconfigPath,
 			args:              argMap,
 			timeout:           timeout,
 			server:            server,
-		})
+		}
+		if watch, _ := cmd.Flags().GetBool("watch"); watch {
+			return cd.watch(c, opt, out, formatterType(outputFormat), nil)
+		}
+		resp, requestErr := c.request(opt)
 		if requestErr != nil {
 			fallback := cd.getRequestErrorFallback()
 			if fallback == nil {
@@ -640,6 +708,8 @@ func (cd *commandDefinition) applyFlagsToCommand(cmd *cobra.Command) {
 			cmd.Use += fmt.Sprintf(" [%s]", flag.name)
 			cmd.Long += fmt.Sprintf("\n\nArgs:\n  %s\t%s", flag.name, flag.usage)
 			hasFlag = true
+		} else if flag.isBool {
+			cmd.Flags().BoolP(flag.name, flag.shorthand, false, flag.usage)
 		} else {
 			cmd.Flags().StringP(flag.name, flag.shorthand, flag.defaultValue, flag.usage)
 		}
@@ -647,8 +717,11 @@ func (cd *commandDefinition) applyFlagsToCommand(cmd *cobra.Command) {
 	if !hasFlag {
 		cmd.Args = cobra.NoArgs
 	}
+	if cd.getWatchOption() != nil {
+		cmd.Flags().BoolP("watch", "w", false, "After getting the objects, watch for changes")
+	}
 	if cd.commandGroup == get {
-		cmd.Flags().StringP("output", "o", "table", "output format: json|table|yaml")
+		cmd.Flags().StringP("output", "o", "table", "output format: json|table|wide|yaml")
 	} else if cd.commandGroup == query {
 		cmd.Flags().StringP("output", "o", "table", "output format: json|table|yaml")
 	} else {
@@ -326,6 +326,42 @@ default   nginx-6db489d4b7-vgv7v Interface      127.0.0.1 07-16-76-00-02-86 port
 	}
 }
 
+func TestWideTableOutputForGetCommands(t *testing.T) {
+	rawResponseData := []networkpolicy.Response{
+		{
+			NetworkPolicy: &cpv1beta.NetworkPolicy{
+				ObjectMeta:      metav1.ObjectMeta{Name: "6001549b-ba63-4752-8267-30f52b4332db"},
+				AppliedToGroups: []string{"32ef631b-6817-5a18-86eb-93f4abf0467c"},
+				Rules:           []cpv1beta.NetworkPolicyRule{{Direction: "In"}},
+				SourceRef:       &cpv1beta.NetworkPolicyReference{Type: cpv1beta.K8sNetworkPolicy, Namespace: "default", Name: "allow-all"},
+			},
+		},
+		{
+			NetworkPolicy: &cpv1beta.NetworkPolicy{
+				ObjectMeta:      metav1.ObjectMeta{Name: "880db7e8-fc2a-4030-aefe-09afc5f341ad"},
+				AppliedToGroups: []string{"32ef631b-6817-5a18-86eb-93f4abf0467c"},
+				Rules:           []cpv1beta.NetworkPolicyRule{{Direction: "In"}},
+				SourceRef:       &cpv1beta.NetworkPolicyReference{Type: cpv1beta.AntreaNetworkPolicy, Namespace: "default", Name: "allow-web"},
+				RealizationStatus: &cpv1beta.NetworkPolicyRealizationStatus{
+					CurrentNodesRealized: 1,
+					DesiredNodesRealized: 3,
+					FailedNodes: []cpv1beta.NetworkPolicyNodeStatus{
+						{NodeName: "node2", Reason: cpv1beta.NetworkPolicyNamedPortUnresolved},
+						{NodeName: "node3", Reason: cpv1beta.NetworkPolicyFlowInstallationFailed},
+					},
+				},
+			},
+		},
+	}
+	var outputBuf bytes.Buffer
+	err := output.WideTableOutputForGetCommands(rawResponseData, &outputBuf)
+	assert.NoError(t, err)
+	assert.Equal(t, `NAME                                 APPLIED-TO                           RULES SOURCE                                TIER-PRIORITY PRIORITY REALIZED FAILED-NODES                          
+6001549b-ba63-4752-8267-30f52b4332db 32ef631b-6817-5a18-86eb-93f4abf0467c 1     K8sNetworkPolicy:default/allow-all    <NONE>        <NONE>   <NONE>   <NONE>                                
+880db7e8-fc2a-4030-aefe-09afc5f341ad 32ef631b-6817-5a18-86eb-93f4abf0467c 1     AntreaNetworkPolicy:default/allow-web <NONE>        <NONE>   1/3      node2(NamedPortUnresolved) + 1 more...
+`, outputBuf.String())
+}
+
 // TestFormat ensures the formatter and AddonTransform works as expected.
 func TestFormat(t *testing.T) {
 	for _, tc := range []struct {
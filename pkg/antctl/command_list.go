@@ -141,6 +141,11 @@ func (cl *commandList) GetDebugCommands(mode string) [][]string {
 			// cannot be used as is in e2e tests.
 			continue
 		}
+		if cmd.cobraCommand.Use == "packetcapture" {
+			// packetcapture requires a source or destination Pod, and
+			// the PacketCapture feature gate which is disabled by default.
+			continue
+		}
 		if mode == runtime.ModeController && cmd.supportController ||
 			mode == runtime.ModeAgent && cmd.supportAgent {
 			var currentCommand []string
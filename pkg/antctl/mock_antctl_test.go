@@ -22,6 +22,7 @@ package antctl
 import (
 	gomock "github.com/golang/mock/gomock"
 	io "io"
+	watch "k8s.io/apimachinery/pkg/watch"
 	reflect "reflect"
 )
 
@@ -62,3 +63,18 @@ func (mr *MockAntctlClientMockRecorder) request(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "request", reflect.TypeOf((*MockAntctlClient)(nil).request), arg0)
 }
+
+// watch mocks base method
+func (m *MockAntctlClient) watch(arg0 *requestOption) (watch.Interface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "watch", arg0)
+	ret0, _ := ret[0].(watch.Interface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// watch indicates an expected call of watch
+func (mr *MockAntctlClientMockRecorder) watch(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "watch", reflect.TypeOf((*MockAntctlClient)(nil).watch), arg0)
+}
@@ -148,8 +148,19 @@ func YamlOutput(obj interface{}, writer io.Writer) error {
 	return nil
 }
 
-// tableOutputForGetCommands formats the table output for "get" commands.
+// TableOutputForGetCommands formats the table output for "get" commands.
 func TableOutputForGetCommands(obj interface{}, writer io.Writer) error {
+	return tableOutputForGetCommands(obj, writer, false)
+}
+
+// WideTableOutputForGetCommands formats the wide table output for "get"
+// commands. It includes the additional columns of the responses implementing
+// common.WideTableOutput.
+func WideTableOutputForGetCommands(obj interface{}, writer io.Writer) error {
+	return tableOutputForGetCommands(obj, writer, true)
+}
+
+func tableOutputForGetCommands(obj interface{}, writer io.Writer, wide bool) error {
 	var list []common.TableOutput
 	if reflect.TypeOf(obj).Kind() == reflect.Slice {
 		s := reflect.ValueOf(obj)
@@ -183,6 +194,13 @@ func TableOutputForGetCommands(obj interface{}, writer io.Writer) error {
 	for i, element := range list {
 		rows[i+1] = element.GetTableRow(maxTableOutputColumnLength)
 	}
+	if wideElement, ok := list[0].(common.WideTableOutput); ok && wide {
+		args = append(args, wideElement.GetWideTableHeader()...)
+		rows[0] = args
+		for i, element := range list {
+			rows[i+1] = append(rows[i+1], element.(common.WideTableOutput).GetWideTableRow(maxTableOutputColumnLength)...)
+		}
+	}
 
 	if list[0].SortRows() {
 		// Sort the table rows according to columns in order.
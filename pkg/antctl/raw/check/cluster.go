@@ -0,0 +1,212 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"antrea.io/antrea/pkg/antctl/raw"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	antrea "antrea.io/antrea/pkg/client/clientset/versioned"
+)
+
+const (
+	// The Antrea components update their info every 60 seconds. A component is considered
+	// unhealthy if it has missed several heartbeats.
+	maxHeartbeatAge = 3 * time.Minute
+)
+
+var clusterOptions = &struct {
+	junitFile string
+}{}
+
+func newClusterCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cluster",
+		Short: "Check the health of the Antrea components",
+		Long: `Check the health of the Antrea components from the AntreaControllerInfo and
+AntreaAgentInfo resources, and the readiness of the Antrea Pods. No workload is
+deployed in the cluster.`,
+		Example: `  Check the health of the Antrea components
+  $ antctl check cluster
+  Check the health of the Antrea components and save the result in JUnit format
+  $ antctl check cluster --junit report.xml`,
+		Args: cobra.NoArgs,
+		RunE: runCheckCluster,
+	}
+	cmd.Flags().StringVar(&clusterOptions.junitFile, "junit", "", "path of the file to save the result to, in JUnit XML format")
+	return cmd
+}
+
+func runCheckCluster(cmd *cobra.Command, _ []string) error {
+	kubeconfig, err := raw.ResolveKubeconfig(cmd)
+	if err != nil {
+		return err
+	}
+	k8sClient, antreaClient, err := raw.SetupClients(kubeconfig)
+	if err != nil {
+		return fmt.Errorf("failed to create clientset: %w", err)
+	}
+
+	r := newReport("antctl check cluster", cmd.OutOrStdout())
+	checkClusterHealth(r, k8sClient, antreaClient, time.Now())
+	if clusterOptions.junitFile != "" {
+		if err := r.writeJUnitFile(clusterOptions.junitFile); err != nil {
+			return err
+		}
+	}
+	return r.summarize()
+}
+
+// checkClusterHealth runs the checks which validate the health of the Antrea components.
+func checkClusterHealth(r *report, k8sClient kubernetes.Interface, antreaClient antrea.Interface, now time.Time) {
+	var controllerInfo *crdv1beta1.AntreaControllerInfo
+	r.run("Antrea Controller is healthy", func() error {
+		var err error
+		controllerInfo, err = antreaClient.CrdV1beta1().AntreaControllerInfos().Get(context.TODO(), "antrea-controller", metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("error when getting AntreaControllerInfo: %w", err)
+		}
+		for _, condition := range controllerInfo.ControllerConditions {
+			if condition.Type == crdv1beta1.ControllerHealthy {
+				return checkCondition(string(condition.Type), condition.Status, condition.LastHeartbeatTime, now)
+			}
+		}
+		return fmt.Errorf("condition %s not found", crdv1beta1.ControllerHealthy)
+	})
+
+	r.run("All Nodes run a healthy Antrea Agent", func() error {
+		nodes, err := k8sClient.CoreV1().Nodes().List(context.TODO(), metav1.ListOptions{ResourceVersion: "0"})
+		if err != nil {
+			return fmt.Errorf("error when listing Nodes: %w", err)
+		}
+		agentInfos, err := antreaClient.CrdV1beta1().AntreaAgentInfos().List(context.TODO(), metav1.ListOptions{ResourceVersion: "0"})
+		if err != nil {
+			return fmt.Errorf("error when listing AntreaAgentInfos: %w", err)
+		}
+		agentInfoByNode := make(map[string]*crdv1beta1.AntreaAgentInfo, len(agentInfos.Items))
+		for i := range agentInfos.Items {
+			agentInfoByNode[agentInfos.Items[i].NodeRef.Name] = &agentInfos.Items[i]
+		}
+		var problems []string
+		for _, node := range nodes.Items {
+			agentInfo, ok := agentInfoByNode[node.Name]
+			if !ok {
+				problems = append(problems, fmt.Sprintf("Node %s: no Antrea Agent found", node.Name))
+				continue
+			}
+			if err := checkAgentInfo(agentInfo, now); err != nil {
+				problems = append(problems, fmt.Sprintf("Node %s: %v", node.Name, err))
+			}
+		}
+		if len(problems) > 0 {
+			return fmt.Errorf("%s", strings.Join(problems, "; "))
+		}
+		return nil
+	})
+
+	r.run("Antrea Pods are ready", func() error {
+		if controllerInfo == nil {
+			return newSkipError("Antrea Controller not found")
+		}
+		namespace := controllerInfo.PodRef.Namespace
+		pods, err := k8sClient.CoreV1().Pods(namespace).List(context.TODO(), metav1.ListOptions{LabelSelector: "app=antrea"})
+		if err != nil {
+			return fmt.Errorf("error when listing Antrea Pods: %w", err)
+		}
+		var notReady []string
+		for i := range pods.Items {
+			if !isPodReady(&pods.Items[i]) {
+				notReady = append(notReady, pods.Items[i].Name)
+			}
+		}
+		if len(notReady) > 0 {
+			sort.Strings(notReady)
+			return fmt.Errorf("Pods not ready in Namespace %s: %s", namespace, strings.Join(notReady, ", "))
+		}
+		return nil
+	})
+
+	r.run("Antrea components run the same version", func() error {
+		if controllerInfo == nil {
+			return newSkipError("Antrea Controller not found")
+		}
+		agentInfos, err := antreaClient.CrdV1beta1().AntreaAgentInfos().List(context.TODO(), metav1.ListOptions{ResourceVersion: "0"})
+		if err != nil {
+			return fmt.Errorf("error when listing AntreaAgentInfos: %w", err)
+		}
+		var mismatched []string
+		for _, agentInfo := range agentInfos.Items {
+			if agentInfo.Version != controllerInfo.Version {
+				mismatched = append(mismatched, fmt.Sprintf("%s (%s)", agentInfo.NodeRef.Name, agentInfo.Version))
+			}
+		}
+		if len(mismatched) > 0 {
+			sort.Strings(mismatched)
+			return fmt.Errorf("Antrea Controller runs version %s, but the Antrea Agents on these Nodes do not: %s", controllerInfo.Version, strings.Join(mismatched, ", "))
+		}
+		return nil
+	})
+}
+
+func checkAgentInfo(agentInfo *crdv1beta1.AntreaAgentInfo, now time.Time) error {
+	conditions := make(map[crdv1beta1.AgentConditionType]crdv1beta1.AgentCondition, len(agentInfo.AgentConditions))
+	for _, condition := range agentInfo.AgentConditions {
+		conditions[condition.Type] = condition
+	}
+	for _, conditionType := range []crdv1beta1.AgentConditionType{
+		crdv1beta1.AgentHealthy,
+		crdv1beta1.ControllerConnectionUp,
+		crdv1beta1.OVSDBConnectionUp,
+		crdv1beta1.OpenflowConnectionUp,
+	} {
+		condition, ok := conditions[conditionType]
+		if !ok {
+			return fmt.Errorf("condition %s not found", conditionType)
+		}
+		if err := checkCondition(string(conditionType), condition.Status, condition.LastHeartbeatTime, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkCondition(conditionType string, status corev1.ConditionStatus, lastHeartbeatTime metav1.Time, now time.Time) error {
+	if status != corev1.ConditionTrue {
+		return fmt.Errorf("condition %s is %s", conditionType, status)
+	}
+	if age := now.Sub(lastHeartbeatTime.Time); age > maxHeartbeatAge {
+		return fmt.Errorf("condition %s was last updated %s ago", conditionType, age.Round(time.Second))
+	}
+	return nil
+}
+
+func isPodReady(pod *corev1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
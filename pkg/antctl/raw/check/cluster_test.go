@@ -0,0 +1,130 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	antreafake "antrea.io/antrea/pkg/client/clientset/versioned/fake"
+)
+
+func newAgentInfo(nodeName, version string, heartbeat time.Time, ovsDBStatus corev1.ConditionStatus) *crdv1beta1.AntreaAgentInfo {
+	lastHeartbeatTime := metav1.NewTime(heartbeat)
+	return &crdv1beta1.AntreaAgentInfo{
+		ObjectMeta: metav1.ObjectMeta{Name: nodeName},
+		Version:    version,
+		NodeRef:    corev1.ObjectReference{Kind: "Node", Name: nodeName},
+		AgentConditions: []crdv1beta1.AgentCondition{
+			{Type: crdv1beta1.AgentHealthy, Status: corev1.ConditionTrue, LastHeartbeatTime: lastHeartbeatTime},
+			{Type: crdv1beta1.ControllerConnectionUp, Status: corev1.ConditionTrue, LastHeartbeatTime: lastHeartbeatTime},
+			{Type: crdv1beta1.OVSDBConnectionUp, Status: ovsDBStatus, LastHeartbeatTime: lastHeartbeatTime},
+			{Type: crdv1beta1.OpenflowConnectionUp, Status: corev1.ConditionTrue, LastHeartbeatTime: lastHeartbeatTime},
+		},
+	}
+}
+
+func newAntreaPod(name string, ready corev1.ConditionStatus) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "kube-system", Labels: map[string]string{"app": "antrea"}},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: ready}},
+		},
+	}
+}
+
+func TestCheckClusterHealth(t *testing.T) {
+	now := time.Now()
+	controllerInfo := &crdv1beta1.AntreaControllerInfo{
+		ObjectMeta: metav1.ObjectMeta{Name: "antrea-controller"},
+		Version:    "v1.7.0",
+		PodRef:     corev1.ObjectReference{Kind: "Pod", Namespace: "kube-system", Name: "antrea-controller-0"},
+		ControllerConditions: []crdv1beta1.ControllerCondition{
+			{Type: crdv1beta1.ControllerHealthy, Status: corev1.ConditionTrue, LastHeartbeatTime: metav1.NewTime(now.Add(-time.Minute))},
+		},
+	}
+	node1 := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node1"}}
+	node2 := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node2"}}
+
+	tests := []struct {
+		name           string
+		k8sObjects     []runtime.Object
+		antreaObjects  []runtime.Object
+		expectedOutput []string
+	}{
+		{
+			name:       "healthy",
+			k8sObjects: []runtime.Object{node1, node2, newAntreaPod("antrea-controller-0", corev1.ConditionTrue), newAntreaPod("antrea-agent-0", corev1.ConditionTrue)},
+			antreaObjects: []runtime.Object{
+				controllerInfo,
+				newAgentInfo("node1", "v1.7.0", now, corev1.ConditionTrue),
+				newAgentInfo("node2", "v1.7.0", now, corev1.ConditionTrue),
+			},
+			expectedOutput: []string{
+				`\[PASS\] Antrea Controller is healthy`,
+				`\[PASS\] All Nodes run a healthy Antrea Agent`,
+				`\[PASS\] Antrea Pods are ready`,
+				`\[PASS\] Antrea components run the same version`,
+			},
+		},
+		{
+			name:       "unhealthy",
+			k8sObjects: []runtime.Object{node1, node2, newAntreaPod("antrea-controller-0", corev1.ConditionTrue), newAntreaPod("antrea-agent-0", corev1.ConditionFalse)},
+			antreaObjects: []runtime.Object{
+				controllerInfo,
+				newAgentInfo("node1", "v1.6.0", now.Add(-10*time.Minute), corev1.ConditionTrue),
+			},
+			expectedOutput: []string{
+				`\[PASS\] Antrea Controller is healthy`,
+				`\[FAIL\] All Nodes run a healthy Antrea Agent .*: Node node1: condition AgentHealthy was last updated 10m0s ago; Node node2: no Antrea Agent found`,
+				`\[FAIL\] Antrea Pods are ready .*: Pods not ready in Namespace kube-system: antrea-agent-0`,
+				`\[FAIL\] Antrea components run the same version .*: Antrea Controller runs version v1.7.0, but the Antrea Agents on these Nodes do not: node1 \(v1.6.0\)`,
+			},
+		},
+		{
+			name:       "OVSDB connection down",
+			k8sObjects: []runtime.Object{node1},
+			antreaObjects: []runtime.Object{
+				newAgentInfo("node1", "v1.7.0", now, corev1.ConditionFalse),
+			},
+			expectedOutput: []string{
+				`\[FAIL\] Antrea Controller is healthy .*: error when getting AntreaControllerInfo: .* not found`,
+				`\[FAIL\] All Nodes run a healthy Antrea Agent .*: Node node1: condition OVSDBConnectionUp is False`,
+				`\[SKIP\] Antrea Pods are ready .*: Antrea Controller not found`,
+				`\[SKIP\] Antrea components run the same version .*: Antrea Controller not found`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			r := newReport("test", &out)
+			checkClusterHealth(r, k8sfake.NewSimpleClientset(tt.k8sObjects...), antreafake.NewSimpleClientset(tt.antreaObjects...), now)
+			lines := bytes.Split(bytes.TrimSpace(out.Bytes()), []byte("\n"))
+			assert.Len(t, lines, len(tt.expectedOutput))
+			for i := range tt.expectedOutput {
+				assert.Regexp(t, tt.expectedOutput[i], string(lines[i]))
+			}
+		})
+	}
+}
@@ -0,0 +1,518 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/antctl/raw"
+	crdv1alpha2 "antrea.io/antrea/pkg/apis/crd/v1alpha2"
+	antrea "antrea.io/antrea/pkg/client/clientset/versioned"
+)
+
+const (
+	defaultTestImage = "k8s.gcr.io/e2e-test-images/agnhost:2.29"
+	testPort         = 80
+	// The label set on all the resources created by the checks.
+	testLabelKey   = "app"
+	testLabelValue = "antrea-check"
+	testPodLabel   = "antrea-check/pod"
+	serviceName    = "antrea-check"
+
+	connectTimeout = 5 * time.Second
+	// Connectivity is retried for a while, as the Pod network or a NetworkPolicy may not be
+	// fully realized right after the Pods are running.
+	connectivityTimeout = 30 * time.Second
+	egressTimeout       = 30 * time.Second
+)
+
+var installationOptions = &struct {
+	image           string
+	podReadyTimeout time.Duration
+	egressIPPool    string
+	externalAddress string
+	junitFile       string
+}{}
+
+func newInstallationCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "installation",
+		Short: "Check that Antrea is installed and works correctly",
+		Long: `Check the health of the Antrea components, then deploy test Pods in a
+temporary Namespace to validate Pod-to-Pod connectivity on the same Node and
+across Nodes, Pod-to-Service connectivity for ClusterIP and NodePort Services,
+DNS resolution, NetworkPolicy enforcement and, if an ExternalIPPool is provided,
+Egress. The Namespace is deleted when the checks complete.`,
+		Example: `  Check the Antrea installation
+  $ antctl check installation
+  Check the Antrea installation, including Egress with an IP from ExternalIPPool pool1
+  $ antctl check installation --egress-ip-pool pool1 --external-address 192.168.77.100:80
+  Check the Antrea installation and save the result in JUnit format
+  $ antctl check installation --junit report.xml`,
+		Args: cobra.NoArgs,
+		RunE: runCheckInstallation,
+	}
+	cmd.Flags().StringVar(&installationOptions.image, "image", defaultTestImage, "image of the test Pods, which must provide the agnhost netexec and connect commands, and curl")
+	cmd.Flags().DurationVar(&installationOptions.podReadyTimeout, "pod-ready-timeout", 2*time.Minute, "how long to wait for the test Pods to be running")
+	cmd.Flags().StringVar(&installationOptions.egressIPPool, "egress-ip-pool", "", "name of the ExternalIPPool to allocate the Egress IP from; the Egress check is skipped if not set")
+	cmd.Flags().StringVar(&installationOptions.externalAddress, "external-address", "", "<IP>:<port> of an agnhost netexec server out of the cluster, whose /clientip endpoint must report the Egress IP as the source IP of the test Pod")
+	cmd.Flags().StringVar(&installationOptions.junitFile, "junit", "", "path of the file to save the result to, in JUnit XML format")
+	return cmd
+}
+
+func runCheckInstallation(cmd *cobra.Command, _ []string) error {
+	if installationOptions.externalAddress != "" {
+		if _, _, err := net.SplitHostPort(installationOptions.externalAddress); err != nil {
+			return fmt.Errorf("invalid external address: %w", err)
+		}
+	}
+	kubeconfig, err := raw.ResolveKubeconfig(cmd)
+	if err != nil {
+		return err
+	}
+	k8sClient, antreaClient, err := raw.SetupClients(kubeconfig)
+	if err != nil {
+		return fmt.Errorf("failed to create clientset: %w", err)
+	}
+
+	r := newReport("antctl check installation", cmd.OutOrStdout())
+	checkClusterHealth(r, k8sClient, antreaClient, time.Now())
+
+	c := &installationChecker{
+		k8sClient:    k8sClient,
+		antreaClient: antreaClient,
+		kubeconfig:   kubeconfig,
+	}
+	defer c.cleanup()
+	r.run("Test Pods are running", c.deploy)
+	r.run("Pod-to-Pod connectivity on the same Node", c.checkPodToPodIntraNode)
+	r.run("Pod-to-Pod connectivity across Nodes", c.checkPodToPodInterNode)
+	r.run("Pod-to-Service connectivity (ClusterIP)", c.checkPodToClusterIP)
+	r.run("Pod-to-Service connectivity (NodePort)", c.checkPodToNodePort)
+	r.run("DNS resolution of a Service name", c.checkDNS)
+	r.run("NetworkPolicy enforcement", c.checkNetworkPolicy)
+	r.run("Egress", c.checkEgress)
+
+	if installationOptions.junitFile != "" {
+		if err := r.writeJUnitFile(installationOptions.junitFile); err != nil {
+			return err
+		}
+	}
+	return r.summarize()
+}
+
+type installationChecker struct {
+	k8sClient    kubernetes.Interface
+	antreaClient antrea.Interface
+	kubeconfig   *rest.Config
+	namespace    string
+	// pods[0] and pods[1] run on the same Node. pods[2] runs on another Node if the cluster
+	// has more than one, otherwise on the same Node.
+	pods    []*corev1.Pod
+	service *corev1.Service
+	// nodeIP is the IP of the Node running pods[2].
+	nodeIP string
+	egress *crdv1alpha2.Egress
+}
+
+func (c *installationChecker) deploy() error {
+	nodes, err := c.k8sClient.CoreV1().Nodes().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error when listing Nodes: %w", err)
+	}
+	nodeNames := schedulableNodes(nodes.Items)
+	if len(nodeNames) == 0 {
+		return fmt.Errorf("no schedulable Linux Node found")
+	}
+	podNodes := []string{nodeNames[0], nodeNames[0], nodeNames[0]}
+	if len(nodeNames) > 1 {
+		podNodes[2] = nodeNames[1]
+	}
+	for _, node := range nodes.Items {
+		if node.Name == podNodes[2] {
+			c.nodeIP = nodeInternalIP(&node)
+		}
+	}
+
+	ns, err := c.k8sClient.CoreV1().Namespaces().Create(context.TODO(), &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "antrea-check-",
+			Labels:       map[string]string{testLabelKey: testLabelValue},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("error when creating Namespace: %w", err)
+	}
+	c.namespace = ns.Name
+
+	for i, nodeName := range podNodes {
+		pod := newTestPod(fmt.Sprintf("pod-%d", i), nodeName, installationOptions.image)
+		if _, err := c.k8sClient.CoreV1().Pods(c.namespace).Create(context.TODO(), pod, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("error when creating Pod %s: %w", pod.Name, err)
+		}
+	}
+	c.service, err = c.k8sClient.CoreV1().Services(c.namespace).Create(context.TODO(), &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   serviceName,
+			Labels: map[string]string{testLabelKey: testLabelValue},
+		},
+		Spec: corev1.ServiceSpec{
+			Type:     corev1.ServiceTypeNodePort,
+			Selector: map[string]string{testPodLabel: "pod-2"},
+			Ports: []corev1.ServicePort{{
+				Port:       testPort,
+				TargetPort: intstr.FromInt(testPort),
+				Protocol:   corev1.ProtocolTCP,
+			}},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("error when creating Service: %w", err)
+	}
+
+	var pods []*corev1.Pod
+	err = wait.PollImmediate(time.Second, installationOptions.podReadyTimeout, func() (bool, error) {
+		pods = nil
+		for i := range podNodes {
+			pod, err := c.k8sClient.CoreV1().Pods(c.namespace).Get(context.TODO(), fmt.Sprintf("pod-%d", i), metav1.GetOptions{})
+			if err != nil {
+				return false, err
+			}
+			if pod.Status.Phase != corev1.PodRunning || pod.Status.PodIP == "" {
+				return false, nil
+			}
+			pods = append(pods, pod)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return fmt.Errorf("error when waiting for the test Pods to be running: %w", err)
+	}
+	c.pods = pods
+	return nil
+}
+
+func (c *installationChecker) cleanup() {
+	if c.egress != nil {
+		if err := c.antreaClient.CrdV1alpha2().Egresses().Delete(context.TODO(), c.egress.Name, metav1.DeleteOptions{}); err != nil {
+			klog.ErrorS(err, "Failed to delete Egress", "Egress", c.egress.Name)
+		}
+	}
+	if c.namespace != "" {
+		if err := c.k8sClient.CoreV1().Namespaces().Delete(context.TODO(), c.namespace, metav1.DeleteOptions{}); err != nil {
+			klog.ErrorS(err, "Failed to delete Namespace", "Namespace", c.namespace)
+		}
+	}
+}
+
+func (c *installationChecker) requirePods() error {
+	if len(c.pods) == 0 {
+		return newSkipError("test Pods are not running")
+	}
+	return nil
+}
+
+func (c *installationChecker) checkPodToPodIntraNode() error {
+	if err := c.requirePods(); err != nil {
+		return err
+	}
+	return c.checkPodToPod(c.pods[0], c.pods[1])
+}
+
+func (c *installationChecker) checkPodToPodInterNode() error {
+	if err := c.requirePods(); err != nil {
+		return err
+	}
+	if c.pods[0].Spec.NodeName == c.pods[2].Spec.NodeName {
+		return newSkipError("the cluster has a single schedulable Node")
+	}
+	return c.checkPodToPod(c.pods[0], c.pods[2])
+}
+
+// checkPodToPod checks the connectivity between two Pods, for each IP family of the Pods.
+func (c *installationChecker) checkPodToPod(client, server *corev1.Pod) error {
+	for _, podIP := range server.Status.PodIPs {
+		if err := c.checkConnectivity(client, net.JoinHostPort(podIP.IP, strconv.Itoa(testPort)), true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *installationChecker) checkPodToClusterIP() error {
+	if err := c.requirePods(); err != nil {
+		return err
+	}
+	clusterIPs := c.service.Spec.ClusterIPs
+	if len(clusterIPs) == 0 {
+		clusterIPs = []string{c.service.Spec.ClusterIP}
+	}
+	for _, clusterIP := range clusterIPs {
+		if err := c.checkConnectivity(c.pods[0], net.JoinHostPort(clusterIP, strconv.Itoa(testPort)), true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *installationChecker) checkPodToNodePort() error {
+	if err := c.requirePods(); err != nil {
+		return err
+	}
+	if c.nodeIP == "" {
+		return newSkipError("no InternalIP found for Node %s", c.pods[2].Spec.NodeName)
+	}
+	nodePort := c.service.Spec.Ports[0].NodePort
+	return c.checkConnectivity(c.pods[0], net.JoinHostPort(c.nodeIP, strconv.Itoa(int(nodePort))), true)
+}
+
+func (c *installationChecker) checkDNS() error {
+	if err := c.requirePods(); err != nil {
+		return err
+	}
+	// The name is resolved with the search domains of the Pod, which do not depend on the
+	// cluster domain.
+	return c.checkConnectivity(c.pods[0], net.JoinHostPort(fmt.Sprintf("%s.%s", serviceName, c.namespace), strconv.Itoa(testPort)), true)
+}
+
+// checkNetworkPolicy creates a NetworkPolicy which only allows pods[2] to connect to pods[1],
+// and checks that pods[0] is denied while pods[2] is still allowed.
+func (c *installationChecker) checkNetworkPolicy() error {
+	if err := c.requirePods(); err != nil {
+		return err
+	}
+	np := &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "antrea-check",
+			Labels: map[string]string{testLabelKey: testLabelValue},
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{testPodLabel: "pod-1"}},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+			Ingress: []networkingv1.NetworkPolicyIngressRule{{
+				From: []networkingv1.NetworkPolicyPeer{{
+					PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{testPodLabel: "pod-2"}},
+				}},
+			}},
+		},
+	}
+	if _, err := c.k8sClient.NetworkingV1().NetworkPolicies(c.namespace).Create(context.TODO(), np, metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("error when creating NetworkPolicy: %w", err)
+	}
+	defer func() {
+		if err := c.k8sClient.NetworkingV1().NetworkPolicies(c.namespace).Delete(context.TODO(), np.Name, metav1.DeleteOptions{}); err != nil {
+			klog.ErrorS(err, "Failed to delete NetworkPolicy", "NetworkPolicy", klog.KObj(np))
+		}
+	}()
+	target := net.JoinHostPort(c.pods[1].Status.PodIP, strconv.Itoa(testPort))
+	if err := c.checkConnectivity(c.pods[0], target, false); err != nil {
+		return err
+	}
+	return c.checkConnectivity(c.pods[2], target, true)
+}
+
+// checkEgress creates an Egress applied to pods[0], with an IP allocated from the provided
+// ExternalIPPool, and checks that the Egress IP is assigned to a Node. If an external address
+// is provided, it also checks that the client IP seen by the agnhost netexec server listening
+// on this address, for a request sent by pods[0], is the Egress IP.
+func (c *installationChecker) checkEgress() error {
+	if installationOptions.egressIPPool == "" {
+		return newSkipError("--egress-ip-pool is not set")
+	}
+	if err := c.requirePods(); err != nil {
+		return err
+	}
+	egress, err := c.antreaClient.CrdV1alpha2().Egresses().Create(context.TODO(), &crdv1alpha2.Egress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   c.namespace,
+			Labels: map[string]string{testLabelKey: testLabelValue},
+		},
+		Spec: crdv1alpha2.EgressSpec{
+			AppliedTo: crdv1alpha2.AppliedTo{
+				PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{testPodLabel: "pod-0"}},
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"kubernetes.io/metadata.name": c.namespace},
+				},
+			},
+			ExternalIPPool: installationOptions.egressIPPool,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("error when creating Egress, is Egress feature gate enabled? %w", err)
+	}
+	c.egress = egress
+	err = wait.PollImmediate(time.Second, egressTimeout, func() (bool, error) {
+		egress, err = c.antreaClient.CrdV1alpha2().Egresses().Get(context.TODO(), c.egress.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		return egress.Spec.EgressIP != "" && egress.Status.EgressNode != "", nil
+	})
+	if err != nil {
+		return fmt.Errorf("Egress IP was not allocated and assigned to a Node (egressIP=%q, egressNode=%q): %w", egress.Spec.EgressIP, egress.Status.EgressNode, err)
+	}
+	if installationOptions.externalAddress == "" {
+		return nil
+	}
+	url := fmt.Sprintf("http://%s/clientip", installationOptions.externalAddress)
+	cmd := []string{"curl", "-s", "--connect-timeout", strconv.Itoa(int(connectTimeout.Seconds())), url}
+	var clientIP string
+	var lastErr error
+	err = wait.PollImmediate(time.Second, connectivityTimeout, func() (bool, error) {
+		clientIP, lastErr = c.getClientIP(c.pods[0], cmd)
+		return lastErr == nil && clientIP == egress.Spec.EgressIP, nil
+	})
+	if err != nil {
+		if lastErr != nil {
+			return fmt.Errorf("Pod %s cannot get its client IP from %s: %v", c.pods[0].Name, url, lastErr)
+		}
+		return fmt.Errorf("the client IP of Pod %s seen by %s is %s, expected Egress IP %s", c.pods[0].Name, url, clientIP, egress.Spec.EgressIP)
+	}
+	return nil
+}
+
+// getClientIP runs the command in the client Pod to query the /clientip endpoint of an agnhost
+// netexec server, and returns the IP in the response, which is formatted as <IP>:<port>.
+func (c *installationChecker) getClientIP(client *corev1.Pod, cmd []string) (string, error) {
+	stdout, stderr, err := c.runCommandFromPod(client, cmd)
+	if err != nil {
+		return "", fmt.Errorf("%v, stdout: %q, stderr: %q", err, stdout, stderr)
+	}
+	host, _, err := net.SplitHostPort(stdout)
+	if err != nil {
+		return "", fmt.Errorf("unexpected response %q: %w", stdout, err)
+	}
+	return host, nil
+}
+
+// checkConnectivity checks that the client Pod can, or cannot, establish a TCP connection to
+// the target address. It retries until the expected result is observed, or until timeout.
+func (c *installationChecker) checkConnectivity(client *corev1.Pod, target string, expectSuccess bool) error {
+	var lastErr error
+	err := wait.PollImmediate(time.Second, connectivityTimeout, func() (bool, error) {
+		lastErr = c.connect(client, target)
+		return (lastErr == nil) == expectSuccess, nil
+	})
+	if err == nil {
+		return nil
+	}
+	if expectSuccess {
+		return fmt.Errorf("Pod %s cannot connect to %s: %v", client.Name, target, lastErr)
+	}
+	return fmt.Errorf("Pod %s can connect to %s, which should be denied", client.Name, target)
+}
+
+func (c *installationChecker) connect(client *corev1.Pod, target string) error {
+	cmd := []string{"/agnhost", "connect", target, fmt.Sprintf("--timeout=%s", connectTimeout)}
+	stdout, stderr, err := c.runCommandFromPod(client, cmd)
+	if err != nil {
+		return fmt.Errorf("%v, stdout: %q, stderr: %q", err, stdout, stderr)
+	}
+	return nil
+}
+
+func (c *installationChecker) runCommandFromPod(pod *corev1.Pod, cmd []string) (string, string, error) {
+	request := c.k8sClient.CoreV1().RESTClient().Post().
+		Namespace(pod.Namespace).
+		Resource("pods").
+		Name(pod.Name).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Command: cmd,
+			Stdout:  true,
+			Stderr:  true,
+		}, scheme.ParameterCodec)
+	exec, err := remotecommand.NewSPDYExecutor(c.kubeconfig, "POST", request.URL())
+	if err != nil {
+		return "", "", err
+	}
+	var stdout, stderr bytes.Buffer
+	err = exec.Stream(remotecommand.StreamOptions{Stdout: &stdout, Stderr: &stderr})
+	return strings.TrimSpace(stdout.String()), strings.TrimSpace(stderr.String()), err
+}
+
+func newTestPod(name, nodeName, image string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				testLabelKey: testLabelValue,
+				testPodLabel: name,
+			},
+		},
+		Spec: corev1.PodSpec{
+			NodeName: nodeName,
+			Containers: []corev1.Container{{
+				Name:  "agnhost",
+				Image: image,
+				Args:  []string{"netexec", fmt.Sprintf("--http-port=%d", testPort)},
+			}},
+			RestartPolicy: corev1.RestartPolicyNever,
+		},
+	}
+}
+
+// schedulableNodes returns the names of the Linux Nodes which are ready and can run the test
+// Pods, sorted by name.
+func schedulableNodes(nodes []corev1.Node) []string {
+	var names []string
+nodeLoop:
+	for _, node := range nodes {
+		if node.Spec.Unschedulable || node.Labels[corev1.LabelOSStable] != "linux" {
+			continue
+		}
+		for _, taint := range node.Spec.Taints {
+			if taint.Effect == corev1.TaintEffectNoSchedule || taint.Effect == corev1.TaintEffectNoExecute {
+				continue nodeLoop
+			}
+		}
+		for _, condition := range node.Status.Conditions {
+			if condition.Type == corev1.NodeReady && condition.Status == corev1.ConditionTrue {
+				names = append(names, node.Name)
+				break
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func nodeInternalIP(node *corev1.Node) string {
+	for _, address := range node.Status.Addresses {
+		if address.Type == corev1.NodeInternalIP {
+			return address.Address
+		}
+	}
+	return ""
+}
@@ -0,0 +1,44 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSchedulableNodes(t *testing.T) {
+	newNode := func(name, os string, ready corev1.ConditionStatus, unschedulable bool, taints ...corev1.Taint) corev1.Node {
+		return corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{corev1.LabelOSStable: os}},
+			Spec:       corev1.NodeSpec{Unschedulable: unschedulable, Taints: taints},
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: ready}},
+			},
+		}
+	}
+	nodes := []corev1.Node{
+		newNode("worker2", "linux", corev1.ConditionTrue, false),
+		newNode("worker1", "linux", corev1.ConditionTrue, false, corev1.Taint{Key: "foo", Effect: corev1.TaintEffectPreferNoSchedule}),
+		newNode("control-plane", "linux", corev1.ConditionTrue, false, corev1.Taint{Key: "node-role.kubernetes.io/master", Effect: corev1.TaintEffectNoSchedule}),
+		newNode("windows", "windows", corev1.ConditionTrue, false),
+		newNode("not-ready", "linux", corev1.ConditionFalse, false),
+		newNode("cordoned", "linux", corev1.ConditionTrue, true),
+	}
+	assert.Equal(t, []string{"worker1", "worker2"}, schedulableNodes(nodes))
+}
@@ -0,0 +1,180 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+type resultStatus string
+
+const (
+	statusPassed  resultStatus = "PASS"
+	statusFailed  resultStatus = "FAIL"
+	statusSkipped resultStatus = "SKIP"
+)
+
+// skipError is returned by a check which cannot be run in the cluster, e.g. because it requires
+// more than one Node.
+type skipError struct {
+	reason string
+}
+
+func (e *skipError) Error() string {
+	return e.reason
+}
+
+func newSkipError(format string, a ...interface{}) error {
+	return &skipError{reason: fmt.Sprintf(format, a...)}
+}
+
+type testResult struct {
+	name     string
+	status   resultStatus
+	message  string
+	duration time.Duration
+}
+
+// report collects the results of the checks, and prints them as they complete.
+type report struct {
+	name    string
+	out     io.Writer
+	results []testResult
+}
+
+func newReport(name string, out io.Writer) *report {
+	return &report{name: name, out: out}
+}
+
+// run runs a check and records its result.
+func (r *report) run(name string, check func() error) {
+	start := time.Now()
+	err := check()
+	result := testResult{name: name, status: statusPassed, duration: time.Since(start)}
+	var skipErr *skipError
+	if errors.As(err, &skipErr) {
+		result.status = statusSkipped
+		result.message = skipErr.reason
+	} else if err != nil {
+		result.status = statusFailed
+		result.message = err.Error()
+	}
+	r.results = append(r.results, result)
+	if result.message != "" {
+		fmt.Fprintf(r.out, "[%s] %s (%s): %s\n", result.status, result.name, result.duration.Round(time.Millisecond), result.message)
+	} else {
+		fmt.Fprintf(r.out, "[%s] %s (%s)\n", result.status, result.name, result.duration.Round(time.Millisecond))
+	}
+}
+
+func (r *report) count(status resultStatus) int {
+	n := 0
+	for _, result := range r.results {
+		if result.status == status {
+			n++
+		}
+	}
+	return n
+}
+
+// summarize prints the number of checks per status, and returns an error if any check failed.
+func (r *report) summarize() error {
+	failed := r.count(statusFailed)
+	fmt.Fprintf(r.out, "\n%d passed, %d failed, %d skipped\n", r.count(statusPassed), failed, r.count(statusSkipped))
+	if failed > 0 {
+		return fmt.Errorf("%d of %d checks failed", failed, len(r.results))
+	}
+	return nil
+}
+
+type junitTestSuites struct {
+	XMLName    xml.Name         `xml:"testsuites"`
+	TestSuites []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	Time      string          `xml:"time,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+}
+
+func (r *report) writeJUnit(w io.Writer) error {
+	suite := junitTestSuite{
+		Name:     r.name,
+		Tests:    len(r.results),
+		Failures: r.count(statusFailed),
+		Skipped:  r.count(statusSkipped),
+	}
+	var total time.Duration
+	for _, result := range r.results {
+		tc := junitTestCase{
+			Name:      result.name,
+			ClassName: r.name,
+			Time:      fmt.Sprintf("%.3f", result.duration.Seconds()),
+		}
+		switch result.status {
+		case statusFailed:
+			tc.Failure = &junitMessage{Message: result.message}
+		case statusSkipped:
+			tc.Skipped = &junitMessage{Message: result.message}
+		}
+		total += result.duration
+		suite.TestCases = append(suite.TestCases, tc)
+	}
+	suite.Time = fmt.Sprintf("%.3f", total.Seconds())
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(junitTestSuites{TestSuites: []junitTestSuite{suite}}); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func (r *report) writeJUnitFile(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("error when creating JUnit report file: %w", err)
+	}
+	defer f.Close()
+	if err := r.writeJUnit(f); err != nil {
+		return fmt.Errorf("error when writing JUnit report: %w", err)
+	}
+	return nil
+}
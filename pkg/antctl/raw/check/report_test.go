@@ -0,0 +1,72 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runTestChecks(r *report) {
+	r.run("check1", func() error { return nil })
+	r.run("check2", func() error { return errors.New("connection refused") })
+	r.run("check3", func() error { return newSkipError("single Node") })
+}
+
+func TestReport(t *testing.T) {
+	var out bytes.Buffer
+	r := newReport("test", &out)
+	runTestChecks(r)
+	err := r.summarize()
+	assert.EqualError(t, err, "1 of 3 checks failed")
+
+	lines := bytes.Split(bytes.TrimSpace(out.Bytes()), []byte("\n"))
+	require.Len(t, lines, 5)
+	assert.Regexp(t, `^\[PASS\] check1 \(.*\)$`, string(lines[0]))
+	assert.Regexp(t, `^\[FAIL\] check2 \(.*\): connection refused$`, string(lines[1]))
+	assert.Regexp(t, `^\[SKIP\] check3 \(.*\): single Node$`, string(lines[2]))
+	assert.Equal(t, "1 passed, 1 failed, 1 skipped", string(lines[4]))
+
+	r = newReport("test", &out)
+	r.run("check1", func() error { return nil })
+	assert.NoError(t, r.summarize())
+}
+
+func TestWriteJUnit(t *testing.T) {
+	var out bytes.Buffer
+	r := newReport("antctl check", &bytes.Buffer{})
+	runTestChecks(r)
+	require.NoError(t, r.writeJUnit(&out))
+
+	var suites junitTestSuites
+	require.NoError(t, xml.Unmarshal(out.Bytes(), &suites))
+	require.Len(t, suites.TestSuites, 1)
+	suite := suites.TestSuites[0]
+	assert.Equal(t, "antctl check", suite.Name)
+	assert.Equal(t, 3, suite.Tests)
+	assert.Equal(t, 1, suite.Failures)
+	assert.Equal(t, 1, suite.Skipped)
+	require.Len(t, suite.TestCases, 3)
+	assert.Equal(t, "check1", suite.TestCases[0].Name)
+	assert.Nil(t, suite.TestCases[0].Failure)
+	assert.Nil(t, suite.TestCases[0].Skipped)
+	assert.Equal(t, &junitMessage{Message: "connection refused"}, suite.TestCases[1].Failure)
+	assert.Equal(t, &junitMessage{Message: "single Node"}, suite.TestCases[2].Skipped)
+}
@@ -0,0 +1,155 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const testManifest = `apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: antrea-agent
+  namespace: kube-system
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  labels:
+    app: antrea
+  name: antrea-config
+  namespace: kube-system
+data:
+  antrea-agent.conf: |
+    # Enable IPsec.
+    enableIPSecTunnel: true
+    tunnelType: geneve
+  antrea-controller.conf: |
+    apiPort: 10349
+`
+
+func TestParseConfigFile(t *testing.T) {
+	configs, err := parseConfigFile("antrea.yml", []byte(testManifest), "")
+	require.NoError(t, err)
+	require.Len(t, configs, 2)
+	assert.Equal(t, "ConfigMap antrea-config: antrea-agent.conf", configs[0].name)
+	assert.Equal(t, componentAgent, configs[0].component)
+	assert.Equal(t, agentConfigKey, configs[0].key)
+	assert.Equal(t, componentController, configs[1].component)
+	assert.Equal(t, "apiPort: 10349\n", string(configs[1].data))
+
+	configs, err = parseConfigFile("antrea.yml", []byte(testManifest), componentController)
+	require.NoError(t, err)
+	require.Len(t, configs, 1)
+	assert.Equal(t, componentController, configs[0].component)
+
+	configs, err = parseConfigFile("/etc/antrea/antrea-agent.conf", []byte("tunnelType: vxlan\n"), "")
+	require.NoError(t, err)
+	require.Len(t, configs, 1)
+	assert.Equal(t, componentAgent, configs[0].component)
+	assert.Nil(t, configs[0].configMap)
+
+	configs, err = parseConfigFile("config.yaml", []byte("apiPort: 10349\n"), componentController)
+	require.NoError(t, err)
+	assert.Equal(t, componentController, configs[0].component)
+
+	_, err = parseConfigFile("config.yaml", []byte("apiPort: 10349\n"), "")
+	assert.Error(t, err)
+	_, err = parseConfigFile("antrea.yml", []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: foo\n"), "")
+	assert.Error(t, err)
+}
+
+func TestGetConfigsFromCluster(t *testing.T) {
+	client := fake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "antrea-windows-config", Namespace: "kube-system"},
+		Data:       map[string]string{agentConfigKey: "tunnelType: geneve\n"},
+	})
+	configs, err := getConfigsFromCluster(client, "kube-system", "antrea-windows-config", "")
+	require.NoError(t, err)
+	require.Len(t, configs, 1)
+	assert.True(t, configs[0].windows)
+
+	_, err = getConfigsFromCluster(client, "kube-system", "antrea-windows-config", componentController)
+	assert.Error(t, err)
+	_, err = getConfigsFromCluster(client, "kube-system", "antrea-config", "")
+	assert.Error(t, err)
+}
+
+func TestValidateConfigs(t *testing.T) {
+	configs := []*configData{
+		{name: "antrea-agent.conf", component: componentAgent, data: []byte("enableIPSecTunnel: true\n")},
+		{name: "antrea-controller.conf", component: componentController, data: []byte("apiPort: 10349\n")},
+	}
+	var out bytes.Buffer
+	assert.True(t, validateConfigs(&out, configs, false))
+	assert.Equal(t, `antrea-agent.conf: valid
+  Warning: The enableIPSecTunnel option is deprecated, please use trafficEncryptionMode instead
+antrea-controller.conf: valid
+`, out.String())
+
+	out.Reset()
+	assert.False(t, validateConfigs(&out, configs, true))
+	assert.Equal(t, `antrea-agent.conf: invalid: unsupported features on Windows: {TrafficEncryptionMode: IPsec}
+  Warning: The enableIPSecTunnel option is deprecated, please use trafficEncryptionMode instead
+antrea-controller.conf: valid
+`, out.String())
+
+	out.Reset()
+	configs = []*configData{
+		{name: "antrea-agent.conf", component: componentAgent, data: []byte("unknownOption: true\n")},
+	}
+	assert.False(t, validateConfigs(&out, configs, false))
+	assert.Contains(t, out.String(), "antrea-agent.conf: invalid: yaml: unmarshal errors")
+}
+
+func TestMigrateConfigs(t *testing.T) {
+	configs, err := parseConfigFile("antrea.yml", []byte(testManifest), "")
+	require.NoError(t, err)
+	var out, log bytes.Buffer
+	require.NoError(t, migrateConfigs(&out, &log, configs))
+	assert.Equal(t, `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: antrea-config
+  namespace: kube-system
+  labels:
+    app: antrea
+data:
+  antrea-agent.conf: |
+    tunnelType: geneve
+    trafficEncryptionMode: ipsec
+  antrea-controller.conf: |
+    apiPort: 10349
+`, out.String())
+	assert.Equal(t, `ConfigMap antrea-config: antrea-agent.conf: Replaced enableIPSecTunnel with trafficEncryptionMode: ipsec
+ConfigMap antrea-config: antrea-controller.conf: no deprecated option
+`, log.String())
+
+	out.Reset()
+	log.Reset()
+	configs = []*configData{
+		{name: "antrea-agent.conf", component: componentAgent, data: []byte("nplPortRange: 40000-41000\n")},
+	}
+	require.NoError(t, migrateConfigs(&out, &log, configs))
+	assert.Equal(t, "nodePortLocal:\n  portRange: 40000-41000\n", out.String())
+	assert.Equal(t, "antrea-agent.conf: Moved nplPortRange to nodePortLocal.portRange\n", log.String())
+}
@@ -0,0 +1,207 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+
+	"antrea.io/antrea/pkg/antctl/raw"
+)
+
+const (
+	componentAgent      = "agent"
+	componentController = "controller"
+
+	osLinux   = "linux"
+	osWindows = "windows"
+
+	agentConfigKey      = "antrea-agent.conf"
+	controllerConfigKey = "antrea-controller.conf"
+	// The prefix of the name of the ConfigMap of the antrea-agent Windows DaemonSet.
+	windowsConfigMapPrefix = "antrea-windows-config"
+
+	defaultNamespace = "kube-system"
+	defaultConfigMap = "antrea-config"
+)
+
+var options = &struct {
+	file      string
+	component string
+	os        string
+	namespace string
+	configMap string
+}{}
+
+// configData is the content of an antrea-agent or antrea-controller
+// configuration, read from a file or from the data of a ConfigMap.
+type configData struct {
+	// name identifies the configuration in the output of the commands.
+	name      string
+	component string
+	windows   bool
+	data      []byte
+	// configMap and key are set when the configuration is read from a
+	// ConfigMap.
+	configMap *corev1.ConfigMap
+	key       string
+}
+
+func addInputFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&options.file, "filename", "f", "", "The configuration file, or a manifest including the Antrea ConfigMaps. The ConfigMap is read from the cluster if not set")
+	cmd.Flags().StringVar(&options.component, "component", "", "The component of the configuration: agent or controller. It is inferred from the file name or the ConfigMap if not set")
+	cmd.Flags().StringVar(&options.os, "os", osLinux, "The OS of the Nodes running antrea-agent: linux or windows")
+	cmd.Flags().StringVarP(&options.namespace, "namespace", "n", defaultNamespace, "The Namespace of the Antrea ConfigMap in the cluster")
+	cmd.Flags().StringVar(&options.configMap, "configmap", defaultConfigMap, "The name of the Antrea ConfigMap in the cluster")
+}
+
+func checkInputOptions() error {
+	if options.component != "" && options.component != componentAgent && options.component != componentController {
+		return fmt.Errorf("invalid component %q, must be %s or %s", options.component, componentAgent, componentController)
+	}
+	if options.os != osLinux && options.os != osWindows {
+		return fmt.Errorf("invalid OS %q, must be %s or %s", options.os, osLinux, osWindows)
+	}
+	return nil
+}
+
+// loadConfigs returns the configurations selected by the options.
+func loadConfigs(cmd *cobra.Command) ([]*configData, error) {
+	if err := checkInputOptions(); err != nil {
+		return nil, err
+	}
+	if options.file != "" {
+		data, err := os.ReadFile(options.file)
+		if err != nil {
+			return nil, err
+		}
+		return parseConfigFile(options.file, data, options.component)
+	}
+	kubeconfig, err := raw.ResolveKubeconfig(cmd)
+	if err != nil {
+		return nil, err
+	}
+	k8sClientset, _, err := raw.SetupClients(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	return getConfigsFromCluster(k8sClientset, options.namespace, options.configMap, options.component)
+}
+
+func getConfigsFromCluster(client kubernetes.Interface, namespace, name, component string) ([]*configData, error) {
+	cm, err := client.CoreV1().ConfigMaps(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error when getting ConfigMap %s/%s: %w", namespace, name, err)
+	}
+	configs := configsFromConfigMap(cm, component)
+	if len(configs) == 0 {
+		return nil, fmt.Errorf("no Antrea configuration found in ConfigMap %s/%s", namespace, name)
+	}
+	return configs, nil
+}
+
+// parseConfigFile parses the content of a file, which is either a manifest
+// including one or more ConfigMaps, or a raw configuration. In the latter case,
+// the component is inferred from the file name if it is not provided.
+func parseConfigFile(fileName string, data []byte, component string) ([]*configData, error) {
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(data)))
+	var configs []*configData
+	foundConfigMap := false
+	for {
+		doc, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error when reading %s: %w", fileName, err)
+		}
+		var typeMeta metav1.TypeMeta
+		if err := yaml.Unmarshal(doc, &typeMeta); err != nil {
+			// A raw configuration is not required to be a YAML map
+			// of the expected fields to be parsed here, the error
+			// will be reported when validating it.
+			continue
+		}
+		if typeMeta.Kind != "ConfigMap" {
+			continue
+		}
+		foundConfigMap = true
+		var cm corev1.ConfigMap
+		if err := yaml.Unmarshal(doc, &cm); err != nil {
+			return nil, fmt.Errorf("error when parsing ConfigMap in %s: %w", fileName, err)
+		}
+		configs = append(configs, configsFromConfigMap(&cm, component)...)
+	}
+	if foundConfigMap {
+		if len(configs) == 0 {
+			return nil, fmt.Errorf("no Antrea configuration found in %s", fileName)
+		}
+		return configs, nil
+	}
+
+	if component == "" {
+		base := filepath.Base(fileName)
+		switch {
+		case strings.Contains(base, componentController):
+			component = componentController
+		case strings.Contains(base, componentAgent):
+			component = componentAgent
+		default:
+			return nil, fmt.Errorf("cannot infer the component of %s from its name, please specify it with --component", fileName)
+		}
+	}
+	return []*configData{{name: fileName, component: component, data: data}}, nil
+}
+
+func configsFromConfigMap(cm *corev1.ConfigMap, component string) []*configData {
+	var configs []*configData
+	for _, c := range []struct {
+		key       string
+		component string
+	}{
+		{agentConfigKey, componentAgent},
+		{controllerConfigKey, componentController},
+	} {
+		if component != "" && component != c.component {
+			continue
+		}
+		data, ok := cm.Data[c.key]
+		if !ok {
+			continue
+		}
+		configs = append(configs, &configData{
+			name:      fmt.Sprintf("ConfigMap %s: %s", cm.Name, c.key),
+			component: c.component,
+			windows:   strings.HasPrefix(cm.Name, windowsConfigMapPrefix),
+			data:      []byte(data),
+			configMap: cm,
+			key:       c.key,
+		})
+	}
+	return configs
+}
@@ -0,0 +1,123 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
+
+	"antrea.io/antrea/pkg/config/validation"
+)
+
+func newMigrateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Rewrite the deprecated options of the configurations of antrea-agent and antrea-controller",
+		Long: `Rewrite the deprecated options of the configurations of antrea-agent and
+antrea-controller to the options replacing them, the same way as they are
+reconciled by the components at startup. The migrated configuration file, or the
+migrated ConfigMaps, are written to the standard output, and the changes to the
+standard error.`,
+		Example: `  Migrate the antrea-config ConfigMap of the cluster
+  $ antctl config migrate | kubectl apply -f -
+  Migrate an antrea-agent configuration file
+  $ antctl config migrate -f antrea-agent.conf > antrea-agent.conf.new`,
+		Args: cobra.NoArgs,
+		RunE: runMigrate,
+	}
+	addInputFlags(cmd)
+	return cmd
+}
+
+func runMigrate(cmd *cobra.Command, _ []string) error {
+	configs, err := loadConfigs(cmd)
+	if err != nil {
+		return err
+	}
+	return migrateConfigs(cmd.OutOrStdout(), cmd.ErrOrStderr(), configs)
+}
+
+// configMapManifest is used to write the migrated ConfigMaps with only the
+// fields required to apply them, in the usual order.
+type configMapManifest struct {
+	APIVersion string `yaml:"apiVersion"`
+	Kind       string `yaml:"kind"`
+	Metadata   struct {
+		Name      string            `yaml:"name"`
+		Namespace string            `yaml:"namespace,omitempty"`
+		Labels    map[string]string `yaml:"labels,omitempty"`
+	} `yaml:"metadata"`
+	Data map[string]string `yaml:"data"`
+}
+
+// migrateConfigs writes the migrated configurations to out, and the changes
+// to log. A configuration read from a file is written as is, and the
+// configurations read from ConfigMaps are written as ConfigMap manifests.
+func migrateConfigs(out, log io.Writer, configs []*configData) error {
+	var configMaps []*corev1.ConfigMap
+	migratedData := map[*corev1.ConfigMap]map[string]string{}
+	for _, c := range configs {
+		migrate := validation.MigrateAgentConfig
+		if c.component == componentController {
+			migrate = validation.MigrateControllerConfig
+		}
+		data, changes, err := migrate(c.data)
+		if err != nil {
+			return fmt.Errorf("error when migrating %s: %w", c.name, err)
+		}
+		if len(changes) == 0 {
+			fmt.Fprintf(log, "%s: no deprecated option\n", c.name)
+		}
+		for _, change := range changes {
+			fmt.Fprintf(log, "%s: %s\n", c.name, change)
+		}
+		if c.configMap == nil {
+			if _, err := out.Write(data); err != nil {
+				return err
+			}
+			continue
+		}
+		if _, ok := migratedData[c.configMap]; !ok {
+			configMaps = append(configMaps, c.configMap)
+			migratedData[c.configMap] = make(map[string]string, len(c.configMap.Data))
+			for k, v := range c.configMap.Data {
+				migratedData[c.configMap][k] = v
+			}
+		}
+		migratedData[c.configMap][c.key] = string(data)
+	}
+
+	for i, cm := range configMaps {
+		manifest := configMapManifest{APIVersion: "v1", Kind: "ConfigMap", Data: migratedData[cm]}
+		manifest.Metadata.Name = cm.Name
+		manifest.Metadata.Namespace = cm.Namespace
+		manifest.Metadata.Labels = cm.Labels
+		data, err := yaml.Marshal(&manifest)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			data = append([]byte("---\n"), data...)
+		}
+		if _, err := out.Write(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+
+	agentconfig "antrea.io/antrea/pkg/config/agent"
+	controllerconfig "antrea.io/antrea/pkg/config/controller"
+	"antrea.io/antrea/pkg/config/validation"
+)
+
+func newValidateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Validate the configurations of antrea-agent and antrea-controller",
+		Long: `Validate the configurations of antrea-agent and antrea-controller with the
+same checks as the components at startup, against the feature gates enabled in
+each configuration. The options which are deprecated, or which are ignored
+because of a disabled feature gate, are reported as warnings.`,
+		Example: `  Validate the configurations in the antrea-config ConfigMap of the cluster
+  $ antctl config validate
+  Validate the configurations in an Antrea manifest
+  $ antctl config validate -f antrea.yml
+  Validate an antrea-agent configuration file for Windows Nodes
+  $ antctl config validate -f antrea-agent.conf --os windows`,
+		Args: cobra.NoArgs,
+		RunE: runValidate,
+	}
+	addInputFlags(cmd)
+	return cmd
+}
+
+func runValidate(cmd *cobra.Command, _ []string) error {
+	configs, err := loadConfigs(cmd)
+	if err != nil {
+		return err
+	}
+	if !validateConfigs(cmd.OutOrStdout(), configs, options.os == osWindows) {
+		return fmt.Errorf("invalid configuration")
+	}
+	return nil
+}
+
+// validateConfigs writes the validation result of each configuration to out,
+// and returns false if any configuration is invalid.
+func validateConfigs(out io.Writer, configs []*configData, windows bool) bool {
+	allValid := true
+	for _, c := range configs {
+		var warnings []string
+		var err error
+		if c.component == componentController {
+			warnings, err = validateControllerConfig(c.data)
+		} else {
+			warnings, err = validateAgentConfig(c.data, windows || c.windows)
+		}
+		if err != nil {
+			allValid = false
+			fmt.Fprintf(out, "%s: invalid: %v\n", c.name, err)
+		} else {
+			fmt.Fprintf(out, "%s: valid\n", c.name)
+		}
+		for _, w := range warnings {
+			fmt.Fprintf(out, "  Warning: %s\n", w)
+		}
+	}
+	return allValid
+}
+
+func validateAgentConfig(data []byte, windows bool) ([]string, error) {
+	c := &agentconfig.AgentConfig{}
+	if err := yaml.UnmarshalStrict(data, c); err != nil {
+		return nil, err
+	}
+	fg, err := validation.NewFeatureGate(c.FeatureGates, windows)
+	if err != nil {
+		return nil, err
+	}
+	validation.SetAgentConfigDefaults(c, fg)
+	warnings, err := validation.ValidateAgentConfig(c, fg)
+	if err != nil {
+		return warnings, err
+	}
+	if windows {
+		if err := validation.CheckUnsupportedFeaturesOnWindows(c); err != nil {
+			return warnings, err
+		}
+	}
+	return warnings, nil
+}
+
+func validateControllerConfig(data []byte) ([]string, error) {
+	c := &controllerconfig.ControllerConfig{}
+	if err := yaml.UnmarshalStrict(data, c); err != nil {
+		return nil, err
+	}
+	fg, err := validation.NewFeatureGate(c.FeatureGates, false)
+	if err != nil {
+		return nil, err
+	}
+	validation.SetControllerConfigDefaults(c)
+	return validation.ValidateControllerConfig(c, fg)
+}
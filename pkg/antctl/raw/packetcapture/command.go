@@ -0,0 +1,341 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packetcapture
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/antctl/raw"
+	"antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	systemv1beta1 "antrea.io/antrea/pkg/apis/system/v1beta1"
+	antrea "antrea.io/antrea/pkg/client/clientset/versioned"
+)
+
+const (
+	maxTimeout = 300 * time.Second
+	// The Agent needs a few seconds to report the result after the capture times out.
+	waitGracePeriod = 10 * time.Second
+)
+
+var (
+	Command *cobra.Command
+	option  = &struct {
+		source      string
+		destination string
+		flow        string
+		number      int32
+		outputFile  string
+		timeout     time.Duration
+		nowait      bool
+	}{}
+)
+
+var protocols = map[string]int32{
+	"icmp": 1,
+	"tcp":  6,
+	"udp":  17,
+}
+
+func init() {
+	Command = &cobra.Command{
+		Use:     "packetcapture",
+		Short:   "Start a PacketCapture",
+		Long:    "Start a PacketCapture to capture the packets exchanged by a Pod and save them to a pcapng file.",
+		Aliases: []string{"pcap", "packetcaptures"},
+		Example: `  Capture 10 packets from pod1 to pod2, both Pods are in Namespace default
+  $antctl packetcapture -S pod1 -D pod2 -n 10
+  Capture the TCP packets from pod1 in Namespace ns1 to a destination IP on port 80, and save them to pod1.pcapng
+  $antctl packetcapture -S ns1/pod1 -D 10.10.1.2 -f tcp,tcp_dst=80 -o pod1.pcapng
+  Capture the UDP packets received by pod1 on port 53 for at most 2 minutes
+  $antctl packetcapture -D pod1 -f udp,udp_dst=53 -t 2m
+`,
+		RunE: runE,
+		Args: cobra.NoArgs,
+	}
+
+	Command.Flags().StringVarP(&option.source, "source", "S", "", "source of the packets: Namespace/Pod, Pod, or IP")
+	Command.Flags().StringVarP(&option.destination, "destination", "D", "", "destination of the packets: Namespace/Pod, Pod, or IP")
+	Command.Flags().StringVarP(&option.flow, "flow", "f", "", "specify the flow (packet headers) of the packets to capture, including tcp_src, tcp_dst, udp_src, udp_dst, ipv6")
+	Command.Flags().Int32VarP(&option.number, "number", "n", v1alpha1.DefaultPacketCaptureNumber, "number of packets to capture")
+	Command.Flags().StringVarP(&option.outputFile, "output", "o", "", "path of the pcapng file to save the captured packets to, defaults to <PacketCapture name>.pcapng")
+	Command.Flags().BoolVarP(&option.nowait, "nowait", "", false, "if set, command returns without retrieving the captured packets")
+}
+
+func runE(cmd *cobra.Command, _ []string) error {
+	option.timeout, _ = cmd.Flags().GetDuration("timeout")
+	if option.timeout > maxTimeout {
+		return fmt.Errorf("timeout cannot be longer than %v", maxTimeout)
+	}
+	if option.timeout == 0 {
+		option.timeout = time.Duration(v1alpha1.DefaultPacketCaptureTimeout) * time.Second
+	}
+	if option.number <= 0 {
+		return errors.New("number of packets must be positive")
+	}
+
+	kubeconfig, err := raw.ResolveKubeconfig(cmd)
+	if err != nil {
+		return err
+	}
+	// The config is used to create a client for the Agent API, which requires a GroupVersion
+	// to be set.
+	kubeconfig.APIPath = "/apis"
+	kubeconfig.GroupVersion = &systemv1beta1.SchemeGroupVersion
+	restconfigTmpl := rest.CopyConfig(kubeconfig)
+	raw.SetupKubeconfig(restconfigTmpl)
+
+	k8sClientset, antreaClientset, err := raw.SetupClients(kubeconfig)
+	if err != nil {
+		return fmt.Errorf("failed to create clientset: %w", err)
+	}
+
+	pc, err := newPacketCapture(k8sClientset)
+	if err != nil {
+		return fmt.Errorf("error when filling up PacketCapture config: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err = antreaClientset.CrdV1alpha1().PacketCaptures().Create(ctx, pc, metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("error when creating PacketCapture, is PacketCapture feature gate enabled? %w", err)
+	}
+	if option.nowait {
+		fmt.Printf("PacketCapture %s created\n", pc.Name)
+		return nil
+	}
+	defer func() {
+		if err := antreaClientset.CrdV1alpha1().PacketCaptures().Delete(context.TODO(), pc.Name, metav1.DeleteOptions{}); err != nil {
+			klog.Errorf("error when deleting PacketCapture: %+v", err)
+		}
+	}()
+
+	var res *v1alpha1.PacketCapture
+	err = wait.Poll(1*time.Second, option.timeout+waitGracePeriod, func() (bool, error) {
+		res, err = antreaClientset.CrdV1alpha1().PacketCaptures().Get(context.TODO(), pc.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		if res.Status.Phase != v1alpha1.PacketCaptureSucceeded && res.Status.Phase != v1alpha1.PacketCaptureFailed {
+			return false, nil
+		}
+		return true, nil
+	})
+	if err == wait.ErrWaitTimeout {
+		return errors.New("timeout waiting for PacketCapture done")
+	} else if err != nil {
+		return fmt.Errorf("error when retrieving PacketCapture: %w", err)
+	}
+	if res.Status.Phase == v1alpha1.PacketCaptureFailed {
+		return fmt.Errorf("PacketCapture failed: %s", res.Status.Reason)
+	}
+
+	outputFile := option.outputFile
+	if outputFile == "" {
+		outputFile = pc.Name + ".pcapng"
+	}
+	if err := download(k8sClientset, antreaClientset, restconfigTmpl, res, outputFile); err != nil {
+		return err
+	}
+	fmt.Printf("Captured %d packets on Node %s, saved to %s\n", res.Status.NumCapturedPackets, res.Status.NodeName, outputFile)
+	if res.Status.Reason != "" {
+		fmt.Println(res.Status.Reason)
+	}
+	return nil
+}
+
+// download retrieves the pcapng file of the PacketCapture from the Agent which captured the
+// packets.
+func download(k8sClientset kubernetes.Interface, antreaClientset antrea.Interface, cfgTmpl *rest.Config, pc *v1alpha1.PacketCapture, outputFile string) error {
+	cfg, err := raw.CreateAgentClientCfg(k8sClientset, antreaClientset, cfgTmpl, pc.Status.NodeName)
+	if err != nil {
+		return fmt.Errorf("error when creating Agent client config: %w", err)
+	}
+	client, err := rest.RESTClientFor(cfg)
+	if err != nil {
+		return fmt.Errorf("error when creating Agent client: %w", err)
+	}
+	stream, err := client.Get().AbsPath("/packetcaptures").Param("name", pc.Name).Stream(context.TODO())
+	if err != nil {
+		return fmt.Errorf("error when downloading the captured packets: %w", err)
+	}
+	defer stream.Close()
+	f, err := os.OpenFile(outputFile, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return fmt.Errorf("error when creating the output file: %w", err)
+	}
+	defer f.Close()
+	if _, err := io.Copy(f, stream); err != nil {
+		return fmt.Errorf("error when downloading the captured packets: %w", err)
+	}
+	return nil
+}
+
+func parseEndpoint(endpoint string) (namespace, pod string, ip net.IP, err error) {
+	if ip = net.ParseIP(endpoint); ip != nil {
+		return "", "", ip, nil
+	}
+	split := strings.Split(endpoint, "/")
+	if len(split) == 1 && len(split[0]) != 0 {
+		return "default", split[0], nil, nil
+	} else if len(split) == 2 && len(split[0]) != 0 && len(split[1]) != 0 {
+		return split[0], split[1], nil, nil
+	}
+	return "", "", nil, errors.New("should be in the format of Namespace/Pod or Pod, or an IP address")
+}
+
+func newPacketCapture(client kubernetes.Interface) (*v1alpha1.PacketCapture, error) {
+	if option.source == "" && option.destination == "" {
+		return nil, errors.New("one of source and destination must be a Pod")
+	}
+
+	srcName, dstName := "any", "any"
+	var src v1alpha1.Source
+	if option.source != "" {
+		namespace, pod, ip, err := parseEndpoint(option.source)
+		if err != nil {
+			return nil, fmt.Errorf("source %w", err)
+		}
+		if ip != nil {
+			src.IP = ip.String()
+			srcName = src.IP
+		} else {
+			src.Namespace, src.Pod = namespace, pod
+			srcName = fmt.Sprintf("%s-%s", src.Namespace, src.Pod)
+		}
+	}
+	var dst v1alpha1.Destination
+	if option.destination != "" {
+		namespace, pod, ip, err := parseEndpoint(option.destination)
+		if err != nil {
+			return nil, fmt.Errorf("destination %w", err)
+		}
+		if ip != nil {
+			dst.IP = ip.String()
+			dstName = dst.IP
+		} else {
+			dst.Namespace, dst.Pod = namespace, pod
+			dstName = fmt.Sprintf("%s-%s", dst.Namespace, dst.Pod)
+		}
+	}
+	if src.Pod == "" && dst.Pod == "" {
+		return nil, errors.New("one of source and destination must be a Pod")
+	}
+	// Check early that the Pod whose packets are captured exists.
+	namespace, pod := src.Namespace, src.Pod
+	if pod == "" {
+		namespace, pod = dst.Namespace, dst.Pod
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if _, err := client.CoreV1().Pods(namespace).Get(ctx, pod, metav1.GetOptions{}); err != nil {
+		return nil, fmt.Errorf("failed to get Pod %s/%s: %w", namespace, pod, err)
+	}
+
+	pkt, err := parseFlow(option.flow)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse flow: %w", err)
+	}
+
+	pc := &v1alpha1.PacketCapture{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: fmt.Sprintf("%s-to-%s-%s", srcName, dstName, rand.String(8)),
+		},
+		Spec: v1alpha1.PacketCaptureSpec{
+			Source:      src,
+			Destination: dst,
+			Packet:      *pkt,
+			Number:      option.number,
+			Timeout:     uint16(option.timeout.Seconds()),
+		},
+	}
+	return pc, nil
+}
+
+func parseFlow(flow string) (*v1alpha1.Packet, error) {
+	cleanFlow := strings.ReplaceAll(flow, " ", "")
+	fields, err := getPortFields(cleanFlow)
+	if err != nil {
+		return nil, fmt.Errorf("error when parsing the flow: %w", err)
+	}
+
+	var pkt v1alpha1.Packet
+	_, isIPv6 := fields["ipv6"]
+	if isIPv6 {
+		pkt.IPv6Header = new(v1alpha1.IPv6Header)
+	}
+	for k, v := range protocols {
+		if _, ok := fields[k]; ok {
+			if isIPv6 {
+				protocol := v
+				pkt.IPv6Header.NextHeader = &protocol
+			} else {
+				pkt.IPHeader.Protocol = v
+			}
+			break
+		}
+	}
+
+	_, hasTCPSrc := fields["tcp_src"]
+	_, hasTCPDst := fields["tcp_dst"]
+	if hasTCPSrc || hasTCPDst {
+		pkt.TransportHeader.TCP = &v1alpha1.TCPHeader{SrcPort: int32(fields["tcp_src"]), DstPort: int32(fields["tcp_dst"])}
+	}
+	_, hasUDPSrc := fields["udp_src"]
+	_, hasUDPDst := fields["udp_dst"]
+	if hasUDPSrc || hasUDPDst {
+		pkt.TransportHeader.UDP = &v1alpha1.UDPHeader{SrcPort: int32(fields["udp_src"]), DstPort: int32(fields["udp_dst"])}
+	}
+	if pkt.TransportHeader.TCP != nil && pkt.TransportHeader.UDP != nil {
+		return nil, errors.New("TCP and UDP fields cannot be used together")
+	}
+	return &pkt, nil
+}
+
+func getPortFields(cleanFlow string) (map[string]int, error) {
+	fields := map[string]int{}
+	for _, v := range strings.Split(cleanFlow, ",") {
+		kv := strings.Split(v, "=")
+		if len(kv) == 2 && len(kv[0]) != 0 && len(kv[1]) != 0 {
+			r, err := strconv.Atoi(kv[1])
+			if err != nil {
+				return nil, err
+			}
+			fields[kv[0]] = r
+		} else if len(kv) == 1 {
+			if len(kv[0]) != 0 {
+				fields[v] = 0
+			}
+		} else {
+			return nil, fmt.Errorf("%s is not valid in flow", v)
+		}
+	}
+	return fields, nil
+}
@@ -0,0 +1,152 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packetcapture
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"antrea.io/antrea/pkg/apis/crd/v1alpha1"
+)
+
+func TestParseFlow(t *testing.T) {
+	protocolTCP := int32(6)
+	tcs := []struct {
+		flow        string
+		expected    *v1alpha1.Packet
+		expectedErr bool
+	}{
+		{
+			flow: "tcp,tcp_src=1234,tcp_dst=80",
+			expected: &v1alpha1.Packet{
+				IPHeader: v1alpha1.IPHeader{Protocol: 6},
+				TransportHeader: v1alpha1.TransportHeader{
+					TCP: &v1alpha1.TCPHeader{SrcPort: 1234, DstPort: 80},
+				},
+			},
+		},
+		{
+			flow: "udp_dst=53",
+			expected: &v1alpha1.Packet{
+				TransportHeader: v1alpha1.TransportHeader{
+					UDP: &v1alpha1.UDPHeader{DstPort: 53},
+				},
+			},
+		},
+		{
+			flow: "ipv6, tcp",
+			expected: &v1alpha1.Packet{
+				IPv6Header: &v1alpha1.IPv6Header{NextHeader: &protocolTCP},
+			},
+		},
+		{
+			flow:     "",
+			expected: &v1alpha1.Packet{},
+		},
+		{
+			flow:        "tcp_dst=80,udp_dst=53",
+			expectedErr: true,
+		},
+		{
+			flow:        "tcp_dst=http",
+			expectedErr: true,
+		},
+	}
+	for _, tc := range tcs {
+		pkt, err := parseFlow(tc.flow)
+		if tc.expectedErr {
+			assert.Error(t, err, "flow: %s", tc.flow)
+		} else {
+			require.NoError(t, err, "flow: %s", tc.flow)
+			assert.Equal(t, tc.expected, pkt, "flow: %s", tc.flow)
+		}
+	}
+}
+
+func TestNewPacketCapture(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod1", Namespace: "default"}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod2", Namespace: "ns2"}},
+	)
+	option.number = 10
+	option.timeout = time.Minute
+	defer func() {
+		option.source = ""
+		option.destination = ""
+	}()
+
+	tcs := []struct {
+		name                string
+		source              string
+		destination         string
+		expectedSource      v1alpha1.Source
+		expectedDestination v1alpha1.Destination
+		expectedErr         string
+	}{
+		{
+			name:                "Pod to Pod",
+			source:              "pod1",
+			destination:         "ns2/pod2",
+			expectedSource:      v1alpha1.Source{Namespace: "default", Pod: "pod1"},
+			expectedDestination: v1alpha1.Destination{Namespace: "ns2", Pod: "pod2"},
+		},
+		{
+			name:                "IP to Pod",
+			source:              "10.10.1.2",
+			destination:         "ns2/pod2",
+			expectedSource:      v1alpha1.Source{IP: "10.10.1.2"},
+			expectedDestination: v1alpha1.Destination{Namespace: "ns2", Pod: "pod2"},
+		},
+		{
+			name:        "no Pod",
+			source:      "10.10.1.2",
+			destination: "10.10.1.3",
+			expectedErr: "one of source and destination must be a Pod",
+		},
+		{
+			name:        "non-existing Pod",
+			source:      "ns2/pod1",
+			expectedErr: "failed to get Pod ns2/pod1",
+		},
+		{
+			name:        "invalid source",
+			source:      "ns1/pod1/a",
+			expectedErr: "source should be in the format of Namespace/Pod or Pod, or an IP address",
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			option.source = tc.source
+			option.destination = tc.destination
+			pc, err := newPacketCapture(client)
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedSource, pc.Spec.Source)
+			assert.Equal(t, tc.expectedDestination, pc.Spec.Destination)
+			assert.Equal(t, int32(10), pc.Spec.Number)
+			assert.Equal(t, uint16(60), pc.Spec.Timeout)
+		})
+	}
+}
@@ -0,0 +1,224 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recommend
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"antrea.io/antrea/pkg/antctl/raw"
+	"antrea.io/antrea/pkg/flowaggregator/clickhouseclient"
+	"antrea.io/antrea/pkg/flowaggregator/policyrecommendation"
+)
+
+const (
+	defaultClickHouseURL = "tcp://clickhouse-clickhouse.flow-visibility.svc:9000"
+	// The Secret holding the ClickHouse credentials, as created by the
+	// flow-visibility manifest.
+	clickHouseSecretNamespace = "flow-visibility"
+	clickHouseSecretName      = "clickhouse-secret"
+)
+
+// Command is the policy recommendation command implementation.
+var Command *cobra.Command
+
+var option = &struct {
+	policyType         string
+	isolationMethod    string
+	namespaceAllowList []string
+	ignoredLabels      []string
+	startTime          string
+	endTime            string
+	limit              uint
+	store              bool
+	clickHouseURL      string
+	clickHouseDatabase string
+	clickHouseUsername string
+	clickHousePassword string
+}{}
+
+var example = strings.Trim(`
+  Recommend K8s NetworkPolicies allowing the traffic observed since 2022-07-01 10:00 UTC, selecting workloads by labels
+  $ antctl policy recommend --start-time 2022-07-01T10:00:00Z
+  Recommend Antrea ClusterNetworkPolicies isolating Namespaces from each other
+  $ antctl policy recommend --policy-type acnp --isolation namespace
+  Recommend Antrea NetworkPolicies and store them in the recommendations table of ClickHouse
+  $ antctl policy recommend --policy-type anp --store
+  Recommend policies using a port-forwarded ClickHouse Service
+  $ antctl policy recommend --clickhouse-url tcp://127.0.0.1:9000
+`, "\n")
+
+func NewRecommendCommand() *cobra.Command {
+	Command = &cobra.Command{
+		Use:   "recommend",
+		Short: "Recommend NetworkPolicies based on the flows stored in ClickHouse",
+		Long: `Recommend NetworkPolicies based on the flows stored in ClickHouse by the Flow Aggregator.
+The recommended policies allow exactly the traffic observed in the selected flows, and are printed as YAML.
+Flows dropped or rejected by a NetworkPolicy rule are ignored.`,
+		Example: example,
+		Args:    cobra.NoArgs,
+		RunE:    runE,
+	}
+	Command.Flags().StringVar(&option.policyType, "policy-type", string(policyrecommendation.PolicyTypeK8sNetworkPolicy), "type of the recommended policies: k8s-np, anp or acnp")
+	Command.Flags().StringVar(&option.isolationMethod, "isolation", string(policyrecommendation.IsolationMethodLabel), "how workloads are selected and allow-listed: label (Namespace and Pod labels) or namespace (Namespace only)")
+	Command.Flags().StringSliceVar(&option.namespaceAllowList, "ns-allow-list", policyrecommendation.DefaultNamespaceAllowList, "Namespaces for which no policy is recommended")
+	Command.Flags().StringSliceVar(&option.ignoredLabels, "ignored-labels", policyrecommendation.DefaultIgnoredLabels, "label keys which are not used in selectors")
+	Command.Flags().StringVar(&option.startTime, "start-time", "", "only use the flows which ended at or after this time, in RFC3339 format")
+	Command.Flags().StringVar(&option.endTime, "end-time", "", "only use the flows which ended before this time, in RFC3339 format")
+	Command.Flags().UintVar(&option.limit, "limit", 0, "maximum number of distinct flows used for the recommendation, 0 means no limit")
+	Command.Flags().BoolVar(&option.store, "store", false, "store the recommended policies in the recommendations table of ClickHouse")
+	Command.Flags().StringVar(&option.clickHouseURL, "clickhouse-url", defaultClickHouseURL, "URL of the ClickHouse database")
+	Command.Flags().StringVar(&option.clickHouseDatabase, "clickhouse-database", "default", "name of the ClickHouse database")
+	Command.Flags().StringVar(&option.clickHouseUsername, "clickhouse-username", "", "ClickHouse username, read from the environment or the clickhouse-secret Secret if not provided")
+	Command.Flags().StringVar(&option.clickHousePassword, "clickhouse-password", "", "ClickHouse password, read from the environment or the clickhouse-secret Secret if not provided")
+	return Command
+}
+
+func parseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+// resolveCredentials returns the ClickHouse credentials from the flags, or from
+// the environment when running in the Flow Aggregator Pod, or from the
+// clickhouse-secret Secret otherwise.
+func resolveCredentials(cmd *cobra.Command) (string, string, error) {
+	username, password := option.clickHouseUsername, option.clickHousePassword
+	if username == "" {
+		username = os.Getenv("CH_USERNAME")
+	}
+	if password == "" {
+		password = os.Getenv("CH_PASSWORD")
+	}
+	if username != "" && password != "" {
+		return username, password, nil
+	}
+	kubeconfig, err := raw.ResolveKubeconfig(cmd)
+	if err != nil {
+		return "", "", err
+	}
+	k8sClient, _, err := raw.SetupClients(kubeconfig)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to create clientset: %w", err)
+	}
+	secret, err := k8sClient.CoreV1().Secrets(clickHouseSecretNamespace).Get(context.TODO(), clickHouseSecretName, metav1.GetOptions{})
+	if err != nil {
+		return "", "", fmt.Errorf("error when getting ClickHouse credentials from Secret %s/%s: %w", clickHouseSecretNamespace, clickHouseSecretName, err)
+	}
+	if username == "" {
+		username = string(secret.Data["username"])
+	}
+	if password == "" {
+		password = string(secret.Data["password"])
+	}
+	return username, password, nil
+}
+
+// clickHouseDSN returns the data source name used to connect to ClickHouse.
+// The clickhouse-go driver reads the credentials from the query parameters
+// rather than from the userinfo of the URL, so they are set as query
+// parameters, escaped like the other ones.
+func clickHouseDSN(rawURL, username, password, database string) (string, error) {
+	dsn, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid ClickHouse URL %q: %w", rawURL, err)
+	}
+	if dsn.Scheme == "" || dsn.Host == "" {
+		return "", fmt.Errorf("invalid ClickHouse URL %q: scheme and host are required", rawURL)
+	}
+	query := dsn.Query()
+	query.Set("username", username)
+	query.Set("password", password)
+	query.Set("database", database)
+	dsn.RawQuery = query.Encode()
+	return dsn.String(), nil
+}
+
+func runE(cmd *cobra.Command, _ []string) error {
+	startTime, err := parseTime(option.startTime)
+	if err != nil {
+		return fmt.Errorf("invalid start time: %w", err)
+	}
+	endTime, err := parseTime(option.endTime)
+	if err != nil {
+		return fmt.Errorf("invalid end time: %w", err)
+	}
+	if !startTime.IsZero() && !endTime.IsZero() && !endTime.After(startTime) {
+		return fmt.Errorf("end time must be after start time")
+	}
+	options := policyrecommendation.Options{
+		PolicyType:         policyrecommendation.PolicyType(option.policyType),
+		IsolationMethod:    policyrecommendation.IsolationMethod(option.isolationMethod),
+		NamespaceAllowList: option.namespaceAllowList,
+		IgnoredLabels:      option.ignoredLabels,
+	}
+	// Validate the options before connecting to ClickHouse.
+	if err := options.Validate(); err != nil {
+		return err
+	}
+
+	username, password, err := resolveCredentials(cmd)
+	if err != nil {
+		return err
+	}
+	dsn, err := clickHouseDSN(option.clickHouseURL, username, password, option.clickHouseDatabase)
+	if err != nil {
+		return err
+	}
+	db, err := clickhouseclient.ConnectClickHouse(dsn)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	flows, err := policyrecommendation.ReadFlows(db, policyrecommendation.FlowQuery{
+		StartTime: startTime,
+		EndTime:   endTime,
+		Limit:     option.limit,
+	})
+	if err != nil {
+		return err
+	}
+	if len(flows) == 0 {
+		return fmt.Errorf("no flow found in ClickHouse for the provided time range")
+	}
+	policies, err := policyrecommendation.Recommend(flows, options)
+	if err != nil {
+		return err
+	}
+	yamls, err := policyrecommendation.ToYAML(policies)
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(cmd.OutOrStdout(), yamls)
+	if option.store {
+		id := uuid.New().String()
+		if err := policyrecommendation.StoreRecommendation(db, id, options.PolicyType, yamls); err != nil {
+			return err
+		}
+		fmt.Fprintf(cmd.ErrOrStderr(), "Recommendation stored in ClickHouse with ID %s\n", id)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recommend
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClickHouseDSN(t *testing.T) {
+	dsn, err := clickHouseDSN("tcp://127.0.0.1:9000?debug=true", "default", "p&ss=w?rd#1", "default")
+	require.NoError(t, err)
+	parsed, err := url.Parse(dsn)
+	require.NoError(t, err)
+	assert.Equal(t, "tcp", parsed.Scheme)
+	assert.Equal(t, "127.0.0.1:9000", parsed.Host)
+	query := parsed.Query()
+	assert.Equal(t, "default", query.Get("username"))
+	assert.Equal(t, "p&ss=w?rd#1", query.Get("password"))
+	assert.Equal(t, "default", query.Get("database"))
+	assert.Equal(t, "true", query.Get("debug"))
+
+	_, err = clickHouseDSN("127.0.0.1:9000", "default", "password", "default")
+	assert.Error(t, err)
+}
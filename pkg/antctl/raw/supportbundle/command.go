@@ -17,6 +17,8 @@ package supportbundle
 import (
 	"bufio"
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -37,6 +39,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/klog/v2"
 
@@ -46,6 +49,7 @@ import (
 	antrea "antrea.io/antrea/pkg/client/clientset/versioned"
 	"antrea.io/antrea/pkg/util/ip"
 	"antrea.io/antrea/pkg/util/k8s"
+	"antrea.io/antrea/pkg/util/redact"
 )
 
 const (
@@ -65,6 +69,11 @@ var option = &struct {
 	controllerOnly bool
 	nodeListFile   string
 	since          string
+	redact         bool
+	upload         string
+	// redactionKey is generated for each run when redact is set, so that
+	// the tokens of the same values are the same in all the bundles.
+	redactionKey string
 }{}
 
 var remoteControllerLongDescription = strings.TrimSpace(`
@@ -88,6 +97,12 @@ var remoteControllerExample = strings.Trim(`
   $ antctl supportbundle '*worker*' -l kubernetes.io/os=linux
   Generate support bundles of the controller and agents on all Nodes and save them to specific dir
   $ antctl supportbundle -d ~/Downloads
+  Generate support bundles of the controller and agents on all Nodes with IP addresses, Pod names and label values redacted
+  $ antctl supportbundle --redact
+  Generate support bundles of the controller and agents on all Nodes and upload them to an S3 bucket
+  $ AWS_ACCESS_KEY_ID=<key id> AWS_SECRET_ACCESS_KEY=<key> antctl supportbundle --upload 's3://my-bucket/antrea?region=us-west-2'
+  Generate support bundles of the controller and agents on all Nodes and upload them to an SFTP server
+  $ antctl supportbundle --upload sftp://user@sftp.example.com/uploads
 `, "\n")
 
 func init() {
@@ -111,6 +126,9 @@ func init() {
 		Command.Flags().BoolVar(&option.controllerOnly, "controller-only", false, "only collect the support bundle of Antrea controller")
 		Command.Flags().StringVarP(&option.nodeListFile, "node-list-file", "f", "", "only collect the support bundle of specific nodes filtered by names in a file (one node name per line)")
 		Command.Flags().StringVarP(&option.since, "since", "", "", "only return logs newer than a relative duration like 5s, 2m or 3h. Defaults to all logs")
+		Command.Flags().BoolVar(&option.redact, "redact", false, "replace IP addresses, Pod names and label values in the support bundles with deterministic tokens. Label values are only redacted in YAML files, not in logs")
+		Command.Flags().StringVar(&option.upload, "upload", "", "upload the support bundles to an S3-compatible (s3://bucket[/prefix][?endpoint=host[:port]&region=region]) or SFTP (sftp://user@host[:port]/path) endpoint instead of saving them to the output dir. "+
+			"S3 credentials are read from AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN. SFTP authenticates with SFTP_PASSWORD, the SSH agent or SFTP_PRIVATE_KEY_FILE and ~/.ssh keys, and verifies the server against ~/.ssh/known_hosts or SFTP_KNOWN_HOSTS_FILE")
 		Command.RunE = controllerRemoteRunE
 	}
 }
@@ -166,7 +184,7 @@ func request(component string, client *rest.RESTClient) error {
 	var err error
 	_, err = client.Post().
 		Resource("supportbundles").
-		Body(&systemv1beta1.SupportBundle{ObjectMeta: metav1.ObjectMeta{Name: component}, Since: option.since}).
+		Body(&systemv1beta1.SupportBundle{ObjectMeta: metav1.ObjectMeta{Name: component}, Since: option.since, RedactionKey: option.redactionKey}).
 		DoRaw(context.TODO())
 	if err == nil {
 		return nil
@@ -225,7 +243,9 @@ func requestAll(agentClients map[string]*rest.RESTClient, controllerClient *rest
 	)
 }
 
-func download(suffix, downloadPath string, client *rest.RESTClient, component string) error {
+// download saves the support bundle of the component to downloadPath, or
+// streams it to up if it is not nil.
+func download(suffix, downloadPath string, client *rest.RESTClient, component string, up uploader) error {
 	for {
 		var supportBundle systemv1beta1.SupportBundle
 		err := client.Get().Resource("supportbundles").Name(component).Do(context.TODO()).Into(&supportBundle)
@@ -238,15 +258,10 @@ func download(suffix, downloadPath string, client *rest.RESTClient, component st
 			}
 			var fileName string
 			if len(suffix) > 0 {
-				fileName = path.Join(downloadPath, fmt.Sprintf("%s_%s.tar.gz", component, suffix))
+				fileName = fmt.Sprintf("%s_%s.tar.gz", component, suffix)
 			} else {
-				fileName = path.Join(downloadPath, fmt.Sprintf("%s.tar.gz", component))
+				fileName = fmt.Sprintf("%s.tar.gz", component)
 			}
-			f, err := os.Create(fileName)
-			if err != nil {
-				return fmt.Errorf("error when creating the support bundle tar gz: %w", err)
-			}
-			defer f.Close()
 			stream, err := client.Get().
 				Resource("supportbundles").
 				Name(component).
@@ -256,6 +271,17 @@ func download(suffix, downloadPath string, client *rest.RESTClient, component st
 				return fmt.Errorf("error when downloading the support bundle: %w", err)
 			}
 			defer stream.Close()
+			if up != nil {
+				if err := up.upload(fileName, stream); err != nil {
+					return fmt.Errorf("error when uploading the support bundle: %w", err)
+				}
+				break
+			}
+			f, err := os.Create(path.Join(downloadPath, fileName))
+			if err != nil {
+				return fmt.Errorf("error when creating the support bundle tar gz: %w", err)
+			}
+			defer f.Close()
 			if _, err := io.Copy(f, stream); err != nil {
 				return fmt.Errorf("error when downloading the support bundle: %w", err)
 			}
@@ -286,7 +312,7 @@ func writeFailedNodes(downloadPath string, nodes []string) error {
 
 // downloadAll will download all supportBundles. preResults is the request results of node/controller supportBundle.
 // if err happens for some nodes or controller, the download step will be skipped for the failed nodes or the controller.
-func downloadAll(agentClients map[string]*rest.RESTClient, controllerClient *rest.RESTClient, downloadPath string, bar *pb.ProgressBar, preResults map[string]error) map[string]error {
+func downloadAll(agentClients map[string]*rest.RESTClient, controllerClient *rest.RESTClient, downloadPath string, up uploader, bar *pb.ProgressBar, preResults map[string]error) map[string]error {
 	results := mapClients(
 		"Downloading",
 		agentClients,
@@ -294,14 +320,14 @@ func downloadAll(agentClients map[string]*rest.RESTClient, controllerClient *res
 		bar,
 		func(nodeName string, c *rest.RESTClient) error {
 			if preResults[nodeName] == nil {
-				return download(nodeName, downloadPath, c, runtime.ModeAgent)
+				return download(nodeName, downloadPath, c, runtime.ModeAgent, up)
 			}
 			return preResults[nodeName]
 
 		},
 		func(nodeName string, c *rest.RESTClient) error {
 			if preResults[""] == nil {
-				return download("", downloadPath, c, runtime.ModeController)
+				return download("", downloadPath, c, runtime.ModeController, up)
 			}
 			return preResults[nodeName]
 		},
@@ -408,7 +434,9 @@ func createControllerClient(k8sClientset kubernetes.Interface, antreaClientset a
 	return controllerClient, nil
 }
 
-func getClusterInfo(w io.Writer, k8sClient kubernetes.Interface) error {
+// getClusterInfo writes the K8s resources of the cluster to w in YAML format.
+// The resources are redacted if redactor is not nil.
+func getClusterInfo(w io.Writer, k8sClient kubernetes.Interface, redactor *redact.Redactor) error {
 	g := new(errgroup.Group)
 	var writeLock sync.Mutex
 
@@ -419,6 +447,11 @@ func getClusterInfo(w io.Writer, k8sClient kubernetes.Interface) error {
 			return err
 		}
 		err := meta.EachListItem(list, func(obj k8sruntime.Object) error {
+			// The items of typed lists have an empty TypeMeta. The kind must be set
+			// so that the redactor recognizes the Pods and registers their names.
+			if gvks, _, err := scheme.Scheme.ObjectKinds(obj); err == nil && len(gvks) > 0 {
+				obj.GetObjectKind().SetGroupVersionKind(gvks[0])
+			}
 			var jsonObj interface{}
 			data, err := json.Marshal(obj)
 			if err != nil {
@@ -431,6 +464,11 @@ func getClusterInfo(w io.Writer, k8sClient kubernetes.Interface) error {
 			if err != nil {
 				return err
 			}
+			if redactor != nil {
+				if data, err = redactor.Redact(data); err != nil {
+					return err
+				}
+			}
 			_, err = w.Write(data)
 			if err != nil {
 				return err
@@ -587,21 +625,56 @@ func controllerRemoteRunE(cmd *cobra.Command, args []string) error {
 	bar := barTmpl.Start(amount)
 	defer bar.Finish()
 	defer bar.Set("prefix", "Finish ")
-	f, err := os.Create(filepath.Join(option.dir, "clusterinfo"))
-	if err != nil {
-		return err
+	var redactor *redact.Redactor
+	if option.redact {
+		key := make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return fmt.Errorf("error when generating redaction key: %w", err)
+		}
+		option.redactionKey = hex.EncodeToString(key)
+		redactor = redact.NewRedactor(option.redactionKey)
 	}
-	defer f.Close()
-	err = getClusterInfo(f, k8sClientset)
-	if err != nil {
+	var up uploader
+	if option.upload != "" {
+		up, err = newUploader(option.upload, filepath.Base(dir))
+		if err != nil {
+			return err
+		}
+		defer up.close()
+	}
+
+	if err := writeClusterInfo(filepath.Join(option.dir, "clusterinfo"), k8sClientset, redactor, up); err != nil {
 		return err
 	}
 
 	results := requestAll(agentClients, controllerClient, bar)
-	results = downloadAll(agentClients, controllerClient, dir, bar, results)
+	results = downloadAll(agentClients, controllerClient, dir, up, bar, results)
 	return processResults(results, dir)
 }
 
+// writeClusterInfo writes the cluster information to filePath, and uploads it
+// with up if it is not nil.
+func writeClusterInfo(filePath string, k8sClient kubernetes.Interface, redactor *redact.Redactor, up uploader) error {
+	f, err := os.Create(filePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := getClusterInfo(f, k8sClient, redactor); err != nil {
+		return err
+	}
+	if up == nil {
+		return nil
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	if err := up.upload(filepath.Base(filePath), f); err != nil {
+		return fmt.Errorf("error when uploading the cluster information: %w", err)
+	}
+	return nil
+}
+
 func genErrorMsg(resultMap map[string]error) string {
 	msg := ""
 	for _, v := range resultMap {
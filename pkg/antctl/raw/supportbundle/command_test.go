@@ -0,0 +1,66 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package supportbundle
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"antrea.io/antrea/pkg/util/redact"
+)
+
+func TestGetClusterInfoRedacted(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "frontend-7d9f8b6c5-x2k4p",
+			Labels:    map[string]string{"app": "frontend-secret"},
+		},
+		Spec: corev1.PodSpec{
+			NodeName: "node1",
+		},
+		Status: corev1.PodStatus{
+			PodIP: "10.10.1.5",
+		},
+	}
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "node1",
+		},
+		Status: corev1.NodeStatus{
+			Addresses: []corev1.NodeAddress{{Type: corev1.NodeInternalIP, Address: "172.18.0.2"}},
+		},
+	}
+	k8sClient := fake.NewSimpleClientset(pod, node)
+
+	var buf bytes.Buffer
+	require.NoError(t, getClusterInfo(&buf, k8sClient, redact.NewRedactor("key")))
+	out := buf.String()
+	assert.Contains(t, out, "kind: Pod")
+	assert.Contains(t, out, "node1")
+	for _, value := range []string{"frontend-7d9f8b6c5-x2k4p", "frontend-secret", "10.10.1.5", "172.18.0.2"} {
+		assert.NotContains(t, out, value)
+	}
+
+	buf.Reset()
+	require.NoError(t, getClusterInfo(&buf, k8sClient, nil))
+	assert.Contains(t, buf.String(), "frontend-7d9f8b6c5-x2k4p")
+}
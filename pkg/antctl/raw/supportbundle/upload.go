@@ -0,0 +1,265 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package supportbundle
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+const (
+	s3DefaultRegion = "us-east-1"
+
+	sftpDefaultPort = "22"
+)
+
+// uploader uploads the files of the support bundles to a remote endpoint. All
+// the files of a run are uploaded to the same directory, named after the output
+// dir.
+type uploader interface {
+	// upload uploads the data read from r as the file name.
+	upload(name string, r io.Reader) error
+	close() error
+}
+
+// newUploader creates an uploader for the given URL, which can be either
+// "s3://bucket[/prefix][?endpoint=host[:port]&region=region]" or
+// "sftp://user@host[:port]/path".
+func newUploader(rawURL, dirName string) (uploader, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("error when parsing upload URL: %w", err)
+	}
+	switch u.Scheme {
+	case "s3":
+		return newS3Uploader(u, dirName)
+	case "sftp":
+		return newSFTPUploader(u, dirName)
+	default:
+		return nil, fmt.Errorf("unsupported upload URL scheme %q, only s3 and sftp are supported", u.Scheme)
+	}
+}
+
+// s3ObjectUploader is the subset of manager.Uploader used by s3Uploader.
+type s3ObjectUploader interface {
+	Upload(ctx context.Context, input *s3.PutObjectInput, opts ...func(*manager.Uploader)) (*manager.UploadOutput, error)
+}
+
+// s3Uploader uploads files to an S3-compatible object storage. The files are
+// streamed in multiple parts if they are larger than the part size of the
+// upload manager, so that their size does not need to be known in advance.
+type s3Uploader struct {
+	uploader s3ObjectUploader
+	bucket   string
+	prefix   string
+	region   string
+	endpoint string
+}
+
+// newS3Uploader creates an s3Uploader. The credentials are resolved from the
+// default credential chain of the AWS SDK, e.g. from the AWS_ACCESS_KEY_ID,
+// AWS_SECRET_ACCESS_KEY and optional AWS_SESSION_TOKEN environment variables.
+func newS3Uploader(u *url.URL, dirName string) (*s3Uploader, error) {
+	if u.Host == "" {
+		return nil, fmt.Errorf("bucket must be specified in upload URL")
+	}
+	query := u.Query()
+	var optFns []func(*awsconfig.LoadOptions) error
+	if region := query.Get("region"); region != "" {
+		optFns = append(optFns, awsconfig.WithRegion(region))
+	}
+	awsConfig, err := awsconfig.LoadDefaultConfig(context.TODO(), optFns...)
+	if err != nil {
+		return nil, fmt.Errorf("error when loading AWS configuration: %w", err)
+	}
+	if awsConfig.Region == "" {
+		awsConfig.Region = s3DefaultRegion
+	}
+	endpoint := query.Get("endpoint")
+	if endpoint != "" && !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+	client := s3.NewFromConfig(awsConfig, func(o *s3.Options) {
+		if endpoint != "" {
+			// S3-compatible services generally do not support
+			// virtual-hosted-style URLs, which AWS S3 uses by default.
+			o.EndpointResolver = s3.EndpointResolverFromURL(endpoint, func(e *aws.Endpoint) {
+				e.HostnameImmutable = true
+			})
+			o.UsePathStyle = true
+		}
+	})
+	return &s3Uploader{
+		uploader: manager.NewUploader(client),
+		bucket:   u.Host,
+		prefix:   path.Join(strings.Trim(u.Path, "/"), dirName),
+		region:   awsConfig.Region,
+		endpoint: endpoint,
+	}, nil
+}
+
+func (u *s3Uploader) upload(name string, r io.Reader) error {
+	key := path.Join(u.prefix, name)
+	if _, err := u.uploader.Upload(context.TODO(), &s3.PutObjectInput{
+		Bucket: aws.String(u.bucket),
+		Key:    aws.String(key),
+		Body:   r,
+	}); err != nil {
+		return fmt.Errorf("error when uploading %s: %w", key, err)
+	}
+	return nil
+}
+
+func (u *s3Uploader) close() error {
+	return nil
+}
+
+// sftpUploader uploads files to an SFTP server.
+type sftpUploader struct {
+	mutex     sync.Mutex
+	sshClient *ssh.Client
+	client    *sftp.Client
+	dir       string
+}
+
+func sshAuthMethods() []ssh.AuthMethod {
+	var methods []ssh.AuthMethod
+	if password := os.Getenv("SFTP_PASSWORD"); password != "" {
+		methods = append(methods, ssh.Password(password))
+	}
+	if sock := os.Getenv("SSH_AUTH_SOCK"); sock != "" {
+		if conn, err := net.Dial("unix", sock); err == nil {
+			methods = append(methods, ssh.PublicKeysCallback(agent.NewClient(conn).Signers))
+		}
+	}
+	keyFiles := []string{os.Getenv("SFTP_PRIVATE_KEY_FILE")}
+	if home, err := os.UserHomeDir(); err == nil {
+		for _, name := range []string{"id_ed25519", "id_ecdsa", "id_rsa"} {
+			keyFiles = append(keyFiles, filepath.Join(home, ".ssh", name))
+		}
+	}
+	var signers []ssh.Signer
+	for _, keyFile := range keyFiles {
+		if keyFile == "" {
+			continue
+		}
+		data, err := os.ReadFile(keyFile)
+		if err != nil {
+			continue
+		}
+		if signer, err := ssh.ParsePrivateKey(data); err == nil {
+			signers = append(signers, signer)
+		}
+	}
+	if len(signers) > 0 {
+		methods = append(methods, ssh.PublicKeys(signers...))
+	}
+	return methods
+}
+
+func newSFTPUploader(u *url.URL, dirName string) (*sftpUploader, error) {
+	if u.User == nil || u.User.Username() == "" {
+		return nil, fmt.Errorf("user must be specified in upload URL")
+	}
+	knownHostsFile := os.Getenv("SFTP_KNOWN_HOSTS_FILE")
+	if knownHostsFile == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("error when locating known_hosts file: %w", err)
+		}
+		knownHostsFile = filepath.Join(home, ".ssh", "known_hosts")
+	}
+	hostKeyCallback, err := knownhosts.New(knownHostsFile)
+	if err != nil {
+		return nil, fmt.Errorf("error when loading known_hosts file: %w", err)
+	}
+	addr := u.Host
+	if u.Port() == "" {
+		addr = net.JoinHostPort(u.Hostname(), sftpDefaultPort)
+	}
+	sshClient, err := ssh.Dial("tcp", addr, &ssh.ClientConfig{
+		User:            u.User.Username(),
+		Auth:            sshAuthMethods(),
+		HostKeyCallback: hostKeyCallback,
+		Timeout:         30 * time.Second,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error when connecting to SFTP server %s: %w", addr, err)
+	}
+	client, err := sftp.NewClient(sshClient)
+	if err != nil {
+		sshClient.Close()
+		return nil, fmt.Errorf("error when creating SFTP client: %w", err)
+	}
+	uploader, err := newSFTPUploaderWithClient(client, path.Join(u.Path, dirName))
+	if err != nil {
+		client.Close()
+		sshClient.Close()
+		return nil, err
+	}
+	uploader.sshClient = sshClient
+	return uploader, nil
+}
+
+func newSFTPUploaderWithClient(client *sftp.Client, dir string) (*sftpUploader, error) {
+	if err := client.MkdirAll(dir); err != nil {
+		return nil, fmt.Errorf("error when creating directory %s: %w", dir, err)
+	}
+	return &sftpUploader{client: client, dir: dir}, nil
+}
+
+func (u *sftpUploader) upload(name string, r io.Reader) error {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+	filePath := path.Join(u.dir, name)
+	f, err := u.client.Create(filePath)
+	if err != nil {
+		return fmt.Errorf("error when creating %s: %w", filePath, err)
+	}
+	if _, err := f.ReadFrom(r); err != nil {
+		f.Close()
+		return fmt.Errorf("error when uploading %s: %w", filePath, err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("error when uploading %s: %w", filePath, err)
+	}
+	return nil
+}
+
+func (u *sftpUploader) close() error {
+	u.client.Close()
+	if u.sshClient == nil {
+		return nil
+	}
+	return u.sshClient.Close()
+}
@@ -0,0 +1,141 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package supportbundle
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pkg/sftp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setAWSEnv(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("AWS_ACCESS_KEY_ID", "id")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+	t.Setenv("AWS_REGION", "")
+	t.Setenv("AWS_CONFIG_FILE", filepath.Join(dir, "config"))
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", filepath.Join(dir, "credentials"))
+}
+
+func TestNewS3Uploader(t *testing.T) {
+	setAWSEnv(t)
+	for name, tc := range map[string]struct {
+		url              string
+		expectedEndpoint string
+		expectedBucket   string
+		expectedPrefix   string
+		expectedRegion   string
+	}{
+		"Default endpoint": {
+			url:            "s3://bucket",
+			expectedBucket: "bucket",
+			expectedPrefix: "bundles",
+			expectedRegion: "us-east-1",
+		},
+		"Region": {
+			url:            "s3://bucket/antrea/?region=eu-west-1",
+			expectedBucket: "bucket",
+			expectedPrefix: "antrea/bundles",
+			expectedRegion: "eu-west-1",
+		},
+		"Custom endpoint": {
+			url:              "s3://bucket/antrea?endpoint=minio.local:9000",
+			expectedEndpoint: "https://minio.local:9000",
+			expectedBucket:   "bucket",
+			expectedPrefix:   "antrea/bundles",
+			expectedRegion:   "us-east-1",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			u, err := url.Parse(tc.url)
+			require.NoError(t, err)
+			uploader, err := newS3Uploader(u, "bundles")
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedEndpoint, uploader.endpoint)
+			assert.Equal(t, tc.expectedBucket, uploader.bucket)
+			assert.Equal(t, tc.expectedPrefix, uploader.prefix)
+			assert.Equal(t, tc.expectedRegion, uploader.region)
+		})
+	}
+
+	_, err := newUploader("s3:///path", "bundles")
+	assert.Error(t, err)
+	_, err = newUploader("ftp://host/path", "bundles")
+	assert.Error(t, err)
+}
+
+func TestS3Upload(t *testing.T) {
+	setAWSEnv(t)
+	var gotPath, gotBody, gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.EscapedPath()
+		gotAuth = r.Header.Get("Authorization")
+		body, _ := io.ReadAll(r.Body)
+		gotBody = string(body)
+	}))
+	defer server.Close()
+
+	u, err := url.Parse("s3://bucket/antrea?endpoint=" + server.URL)
+	require.NoError(t, err)
+	uploader, err := newS3Uploader(u, "support-bundles_20220101T000000Z")
+	require.NoError(t, err)
+	content := "bundle content"
+	require.NoError(t, uploader.upload("agent_node 1.tar.gz", strings.NewReader(content)))
+	assert.Equal(t, "/bucket/antrea/support-bundles_20220101T000000Z/agent_node%201.tar.gz", gotPath)
+	assert.Equal(t, content, gotBody)
+	assert.True(t, strings.HasPrefix(gotAuth, "AWS4-HMAC-SHA256 Credential=id/"))
+}
+
+type pipeReadWriteCloser struct {
+	io.Reader
+	io.WriteCloser
+}
+
+func TestSFTPUploader(t *testing.T) {
+	clientReader, serverWriter := io.Pipe()
+	serverReader, clientWriter := io.Pipe()
+	server, err := sftp.NewServer(pipeReadWriteCloser{serverReader, serverWriter})
+	require.NoError(t, err)
+	go func() {
+		// Closing the client stops the server, which must then close its
+		// end of the pipe for the client to stop.
+		server.Serve()
+		serverWriter.Close()
+	}()
+	client, err := sftp.NewClientPipe(clientReader, clientWriter)
+	require.NoError(t, err)
+
+	dir := filepath.Join(t.TempDir(), "uploads", "bundles")
+	uploader, err := newSFTPUploaderWithClient(client, dir)
+	require.NoError(t, err)
+	defer uploader.close()
+	content := strings.Repeat("0123456789", 32*1024)
+	require.NoError(t, uploader.upload("agent_node1.tar.gz", strings.NewReader(content)))
+	require.NoError(t, uploader.upload("clusterinfo", strings.NewReader("")))
+
+	data, err := os.ReadFile(filepath.Join(dir, "agent_node1.tar.gz"))
+	require.NoError(t, err)
+	assert.Equal(t, content, string(data))
+	assert.FileExists(t, filepath.Join(dir, "clusterinfo"))
+}
@@ -0,0 +1,198 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topology
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+
+	"antrea.io/antrea/pkg/antctl/raw"
+)
+
+const (
+	outputDOT     = "dot"
+	outputJSON    = "json"
+	outputMermaid = "mermaid"
+
+	flowAggregatorNamespace = "flow-aggregator"
+	flowAggregatorSelector  = "app=flow-aggregator"
+	flowAggregatorContainer = "flow-aggregator"
+
+	// defaultTimeout is used when the timeout flag of antctl is not set.
+	defaultTimeout = 1 * time.Minute
+)
+
+var options = &struct {
+	output          string
+	namespace       string
+	traffic         bool
+	antreaNamespace string
+	configMap       string
+}{}
+
+var Command = &cobra.Command{
+	Use:   "topology",
+	Short: "Export the network topology of the cluster",
+	Long: `Export a graph of the network topology of the cluster: the Nodes and how
+they are connected (overlay tunnels or routes, depending on the traffic
+encapsulation mode), the Antrea gateway and the Pods of each Node, the Services
+and their backend Pods, and the Egresses and the Nodes their IPs are assigned to.
+With --traffic, the graph is overlaid with the flows exported to the Flow
+Aggregator: the traffic volume between the Pods, Services and external IPs, and
+the number of flows dropped by each NetworkPolicy.
+
+The graph can be written in the DOT language of Graphviz, in JSON, or as a
+Mermaid flowchart.`,
+	Example: `  Export the topology of the cluster, and render it with Graphviz
+  $ antctl get topology | dot -Tsvg > topology.svg
+  Export the topology of the Pods and Services of Namespace default as a Mermaid flowchart
+  $ antctl get topology -n default -o mermaid
+  Export the topology overlaid with the traffic reported by the Flow Aggregator in JSON
+  $ antctl get topology --traffic -o json`,
+	Args: cobra.NoArgs,
+	RunE: runE,
+}
+
+func init() {
+	Command.Flags().StringVarP(&options.output, "output", "o", outputDOT, "Output format: dot, json or mermaid")
+	Command.Flags().StringVarP(&options.namespace, "namespace", "n", "", "Only include the Pods and the Services of this Namespace")
+	Command.Flags().BoolVar(&options.traffic, "traffic", false, "Overlay the topology with the flows reported by the Flow Aggregator")
+	Command.Flags().StringVar(&options.antreaNamespace, "antrea-namespace", "kube-system", "The Namespace of the Antrea ConfigMap")
+	Command.Flags().StringVar(&options.configMap, "configmap", "antrea-config", "The name of the Antrea ConfigMap")
+}
+
+func runE(cmd *cobra.Command, _ []string) error {
+	if options.output != outputDOT && options.output != outputJSON && options.output != outputMermaid {
+		return fmt.Errorf("unsupported output format %q, must be %s, %s or %s", options.output, outputDOT, outputJSON, outputMermaid)
+	}
+	kubeconfig, err := raw.ResolveKubeconfig(cmd)
+	if err != nil {
+		return err
+	}
+	k8sClientset, antreaClientset, err := raw.SetupClients(kubeconfig)
+	if err != nil {
+		return err
+	}
+	timeout, _ := cmd.Flags().GetDuration("timeout")
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.TODO(), timeout)
+	defer cancel()
+
+	b := &builder{
+		k8sClient:       k8sClientset,
+		antreaClient:    antreaClientset,
+		namespace:       options.namespace,
+		antreaNamespace: options.antreaNamespace,
+		configMap:       options.configMap,
+	}
+	t, err := b.build(ctx)
+	if err != nil {
+		return err
+	}
+	if options.traffic {
+		records, metrics, err := getFlowAggregatorData(ctx, k8sClientset, kubeconfig)
+		if err != nil {
+			return err
+		}
+		t.addTraffic(records, metrics)
+	}
+	return output(cmd.OutOrStdout(), t, options.output)
+}
+
+func output(out io.Writer, t *Topology, format string) error {
+	switch format {
+	case outputJSON:
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(t)
+	case outputMermaid:
+		_, err := io.WriteString(out, renderMermaid(t))
+		return err
+	default:
+		dot, err := renderDOT(t)
+		if err != nil {
+			return err
+		}
+		_, err = io.WriteString(out, dot)
+		return err
+	}
+}
+
+// getFlowAggregatorData runs antctl in the Flow Aggregator Pod to get the flow
+// records and the record metrics, as the Flow Aggregator API is only exposed to
+// the Pod.
+func getFlowAggregatorData(ctx context.Context, k8sClient kubernetes.Interface, kubeconfig *rest.Config) ([]FlowRecord, *RecordMetrics, error) {
+	pods, err := k8sClient.CoreV1().Pods(flowAggregatorNamespace).List(ctx, metav1.ListOptions{LabelSelector: flowAggregatorSelector})
+	if err != nil {
+		return nil, nil, fmt.Errorf("error when listing the Flow Aggregator Pods: %w", err)
+	}
+	var pod *corev1.Pod
+	for i := range pods.Items {
+		if pods.Items[i].Status.Phase == corev1.PodRunning {
+			pod = &pods.Items[i]
+			break
+		}
+	}
+	if pod == nil {
+		return nil, nil, fmt.Errorf("no running Flow Aggregator Pod found in Namespace %s", flowAggregatorNamespace)
+	}
+
+	var records []FlowRecord
+	if err := runAntctlInPod(k8sClient, kubeconfig, pod, []string{"antctl", "get", "flowrecords", "-o", "json"}, &records); err != nil {
+		return nil, nil, fmt.Errorf("error when getting the flow records: %w", err)
+	}
+	metrics := &RecordMetrics{}
+	if err := runAntctlInPod(k8sClient, kubeconfig, pod, []string{"antctl", "get", "recordmetrics", "-o", "json"}, metrics); err != nil {
+		return nil, nil, fmt.Errorf("error when getting the record metrics: %w", err)
+	}
+	return records, metrics, nil
+}
+
+func runAntctlInPod(k8sClient kubernetes.Interface, kubeconfig *rest.Config, pod *corev1.Pod, cmd []string, result interface{}) error {
+	request := k8sClient.CoreV1().RESTClient().Post().
+		Namespace(pod.Namespace).
+		Resource("pods").
+		Name(pod.Name).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: flowAggregatorContainer,
+			Command:   cmd,
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+	exec, err := remotecommand.NewSPDYExecutor(kubeconfig, "POST", request.URL())
+	if err != nil {
+		return err
+	}
+	var stdout, stderr bytes.Buffer
+	if err := exec.Stream(remotecommand.StreamOptions{Stdout: &stdout, Stderr: &stderr}); err != nil {
+		return fmt.Errorf("%v, stderr: %q", err, stderr.String())
+	}
+	return json.Unmarshal(stdout.Bytes(), result)
+}
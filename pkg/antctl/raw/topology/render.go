@@ -0,0 +1,251 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topology
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/awalterschulze/gographviz"
+)
+
+const (
+	graphName = "topology"
+
+	dimGrey   = `"#696969"`
+	grey      = `"#808080"`
+	silver    = `"#C0C0C0"`
+	lightGrey = `"#C8C8C8"`
+	ghostBlue = `"#F0F4FF"`
+	steelBlue = `"#4682B4"`
+	seaGreen  = `"#2E8B57"`
+	darkRed   = `"#B20000"`
+)
+
+var dotVertexAttrs = map[VertexKind]map[string]string{
+	VertexNode:     {"shape": "box3d", "color": dimGrey},
+	VertexGateway:  {"shape": "ellipse", "color": dimGrey},
+	VertexPod:      {"shape": "box", "style": `"rounded,filled"`, "color": grey, "fillcolor": lightGrey},
+	VertexService:  {"shape": "hexagon", "color": steelBlue},
+	VertexEgress:   {"shape": "octagon", "color": seaGreen},
+	VertexExternal: {"shape": "note", "color": dimGrey},
+}
+
+// vertexTitle returns the name of the vertex, prefixed with its Namespace if
+// it is namespaced.
+func vertexTitle(v Vertex) string {
+	if v.Namespace != "" {
+		return v.Namespace + "/" + v.Name
+	}
+	return v.Name
+}
+
+func vertexLabel(v Vertex, separator string) string {
+	return strings.Join(append([]string{vertexTitle(v)}, v.IPs...), separator)
+}
+
+func edgeLabel(t *Topology, e Edge) string {
+	switch e.Kind {
+	case EdgeTunnel:
+		return t.TunnelType
+	case EdgeTraffic:
+		label := formatBytes(e.Bytes)
+		if e.Drops > 0 {
+			label += fmt.Sprintf(", %d dropped", e.Drops)
+		}
+		return label
+	}
+	return ""
+}
+
+// summary returns the lines describing the whole topology.
+func summary(t *Topology) []string {
+	lines := []string{fmt.Sprintf("Traffic encap mode: %s", t.TrafficEncapMode)}
+	if t.TunnelType != "" {
+		lines = append(lines, fmt.Sprintf("Tunnel type: %s", t.TunnelType))
+	}
+	if m := t.RecordMetrics; m != nil {
+		lines = append(lines, fmt.Sprintf("Flows: %d, records received: %d, records exported: %d", m.NumFlows, m.NumRecordsReceived, m.NumRecordsExported))
+	}
+	for _, d := range t.PolicyDrops {
+		lines = append(lines, fmt.Sprintf("Flows dropped by %s: %d", d.Policy, d.Drops))
+	}
+	return lines
+}
+
+// renderDOT renders the topology in the DOT language of Graphviz. Each Node is
+// a cluster including its Gateway and its Pods.
+func renderDOT(t *Topology) (string, error) {
+	graph := gographviz.NewEscape()
+	if err := graph.SetName(graphName); err != nil {
+		return "", err
+	}
+	if err := graph.SetDir(true); err != nil {
+		return "", err
+	}
+	for k, v := range map[string]string{
+		"rankdir":   "LR",
+		"compound":  "true",
+		"labelloc":  "t",
+		"label":     strings.Join(summary(t), `\n`),
+		"fontcolor": dimGrey,
+	} {
+		if err := graph.AddAttr(graphName, k, v); err != nil {
+			return "", err
+		}
+	}
+
+	clusters := map[string]string{}
+	for _, v := range t.Vertices {
+		if v.Kind != VertexNode {
+			continue
+		}
+		cluster := fmt.Sprintf("cluster_%d", len(clusters))
+		clusters[v.ID] = cluster
+		if err := graph.AddSubGraph(graphName, cluster, map[string]string{
+			"label":     "Node " + v.Name,
+			"style":     `"rounded,filled"`,
+			"color":     silver,
+			"fillcolor": ghostBlue,
+		}); err != nil {
+			return "", err
+		}
+	}
+	for _, v := range t.Vertices {
+		parent := graphName
+		if v.Kind == VertexNode {
+			parent = clusters[v.ID]
+		} else if cluster, ok := clusters[v.Parent]; ok {
+			parent = cluster
+		}
+		attrs := map[string]string{"label": vertexLabel(v, `\n`)}
+		for k, value := range dotVertexAttrs[v.Kind] {
+			attrs[k] = value
+		}
+		if err := graph.AddNode(parent, v.ID, attrs); err != nil {
+			return "", err
+		}
+	}
+	for _, e := range t.Edges {
+		attrs := map[string]string{}
+		switch e.Kind {
+		case EdgeTunnel:
+			attrs = map[string]string{"dir": "none", "style": "dashed", "color": dimGrey}
+		case EdgeRoute:
+			attrs = map[string]string{"dir": "none", "color": dimGrey}
+		case EdgeEndpoint:
+			attrs = map[string]string{"color": steelBlue}
+		case EdgeEgress:
+			attrs = map[string]string{"dir": "none", "style": "dotted", "color": seaGreen}
+		case EdgeTraffic:
+			attrs = map[string]string{"penwidth": "2.0", "color": silver}
+			if e.Drops > 0 {
+				attrs["color"] = darkRed
+				attrs["fontcolor"] = darkRed
+			}
+		}
+		if label := edgeLabel(t, e); label != "" {
+			attrs["label"] = label
+		}
+		if err := graph.AddEdge(e.From, e.To, true, attrs); err != nil {
+			return "", err
+		}
+	}
+	return graph.String(), nil
+}
+
+// mermaidEscape escapes the quotes of a Mermaid label, which is always quoted.
+func mermaidEscape(s string) string {
+	return strings.ReplaceAll(s, `"`, "#quot;")
+}
+
+// renderMermaid renders the topology as a Mermaid flowchart, e.g. to be
+// embedded in Markdown documents. Each Node is a subgraph including its Gateway
+// and its Pods.
+func renderMermaid(t *Topology) string {
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+	for _, line := range summary(t) {
+		fmt.Fprintf(&b, "  %%%% %s\n", line)
+	}
+
+	// Mermaid IDs cannot include most punctuation characters.
+	ids := make(map[string]string, len(t.Vertices))
+	for i, v := range t.Vertices {
+		ids[v.ID] = fmt.Sprintf("v%d", i)
+	}
+	writeVertex := func(indent string, v Vertex) {
+		label := `"` + mermaidEscape(vertexLabel(v, "<br/>")) + `"`
+		var shape string
+		switch v.Kind {
+		case VertexNode:
+			shape = "[" + label + "]"
+		case VertexGateway:
+			shape = "([" + label + "])"
+		case VertexPod:
+			shape = "(" + label + ")"
+		case VertexService:
+			shape = "{{" + label + "}}"
+		case VertexEgress:
+			shape = "[/" + label + "/]"
+		default:
+			shape = ">" + label + "]"
+		}
+		fmt.Fprintf(&b, "%s%s%s\n", indent, ids[v.ID], shape)
+	}
+
+	children := map[string][]Vertex{}
+	for _, v := range t.Vertices {
+		if v.Parent != "" {
+			children[v.Parent] = append(children[v.Parent], v)
+		}
+	}
+	clusterIndex := 0
+	for _, v := range t.Vertices {
+		switch {
+		case v.Kind == VertexNode:
+			fmt.Fprintf(&b, "  subgraph cluster%d [\"Node %s\"]\n", clusterIndex, mermaidEscape(v.Name))
+			clusterIndex++
+			writeVertex("    ", v)
+			for _, child := range children[v.ID] {
+				writeVertex("    ", child)
+			}
+			b.WriteString("  end\n")
+		case v.Parent == "":
+			writeVertex("  ", v)
+		}
+	}
+
+	for _, e := range t.Edges {
+		var arrow string
+		switch e.Kind {
+		case EdgeTunnel:
+			arrow = "-.-"
+		case EdgeRoute:
+			arrow = "---"
+		case EdgeEndpoint:
+			arrow = "-->"
+		case EdgeEgress:
+			arrow = "-.-"
+		case EdgeTraffic:
+			arrow = "==>"
+		}
+		if label := edgeLabel(t, e); label != "" {
+			arrow += `|"` + mermaidEscape(label) + `"|`
+		}
+		fmt.Fprintf(&b, "  %s %s %s\n", ids[e.From], arrow, ids[e.To])
+	}
+	return b.String()
+}
@@ -0,0 +1,457 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topology
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/config"
+	"antrea.io/antrea/pkg/agent/types"
+	antrea "antrea.io/antrea/pkg/client/clientset/versioned"
+	agentconfig "antrea.io/antrea/pkg/config/agent"
+	"antrea.io/antrea/pkg/config/validation"
+	"antrea.io/antrea/pkg/util/k8s"
+)
+
+type VertexKind string
+
+const (
+	VertexNode     VertexKind = "Node"
+	VertexGateway  VertexKind = "Gateway"
+	VertexPod      VertexKind = "Pod"
+	VertexService  VertexKind = "Service"
+	VertexEgress   VertexKind = "Egress"
+	VertexExternal VertexKind = "External"
+)
+
+type EdgeKind string
+
+const (
+	// EdgeTunnel connects two Nodes which forward the Pod traffic between
+	// them through an overlay tunnel.
+	EdgeTunnel EdgeKind = "Tunnel"
+	// EdgeRoute connects two Nodes which forward the Pod traffic between
+	// them without encapsulation.
+	EdgeRoute EdgeKind = "Route"
+	// EdgeEndpoint connects a Service to one of its backend Pods.
+	EdgeEndpoint EdgeKind = "Endpoint"
+	// EdgeEgress connects an Egress to the Node its IP is assigned to.
+	EdgeEgress EdgeKind = "Egress"
+	// EdgeTraffic connects the source and the destination of the flows
+	// reported by the Flow Aggregator.
+	EdgeTraffic EdgeKind = "Traffic"
+)
+
+// Vertex is a component of the cluster network. The Gateway and the Pods of a
+// Node have the ID of the Node as Parent.
+type Vertex struct {
+	ID        string     `json:"id"`
+	Kind      VertexKind `json:"kind"`
+	Name      string     `json:"name"`
+	Namespace string     `json:"namespace,omitempty"`
+	Parent    string     `json:"parent,omitempty"`
+	IPs       []string   `json:"ips,omitempty"`
+}
+
+// Edge is a link between two vertices. Only the Traffic edges are directed.
+type Edge struct {
+	From string   `json:"from"`
+	To   string   `json:"to"`
+	Kind EdgeKind `json:"kind"`
+	// Bytes is the total number of bytes of the flows, in both directions.
+	Bytes int64 `json:"bytes,omitempty"`
+	// Drops is the number of flows dropped or rejected by a NetworkPolicy.
+	Drops int64 `json:"drops,omitempty"`
+}
+
+// PolicyDrops is the number of flows dropped or rejected by a NetworkPolicy.
+type PolicyDrops struct {
+	Policy string `json:"policy"`
+	Drops  int64  `json:"drops"`
+}
+
+// Topology is the network topology of the cluster.
+type Topology struct {
+	TrafficEncapMode string   `json:"trafficEncapMode"`
+	TunnelType       string   `json:"tunnelType,omitempty"`
+	Vertices         []Vertex `json:"vertices"`
+	Edges            []Edge   `json:"edges"`
+	// The following fields are only set when the topology is overlaid
+	// with the flows of the Flow Aggregator.
+	PolicyDrops   []PolicyDrops  `json:"policyDrops,omitempty"`
+	RecordMetrics *RecordMetrics `json:"recordMetrics,omitempty"`
+
+	vertexIndex map[string]int
+}
+
+func nodeID(name string) string {
+	return "node/" + name
+}
+
+func gatewayID(nodeName string) string {
+	return "gateway/" + nodeName
+}
+
+func podID(namespace, name string) string {
+	return "pod/" + namespace + "/" + name
+}
+
+func serviceID(namespace, name string) string {
+	return "service/" + namespace + "/" + name
+}
+
+func egressID(name string) string {
+	return "egress/" + name
+}
+
+func externalID(ip string) string {
+	return "external/" + ip
+}
+
+func (t *Topology) addVertex(v Vertex) {
+	if t.vertexIndex == nil {
+		t.vertexIndex = map[string]int{}
+	}
+	if _, ok := t.vertexIndex[v.ID]; ok {
+		return
+	}
+	t.vertexIndex[v.ID] = len(t.Vertices)
+	t.Vertices = append(t.Vertices, v)
+}
+
+func (t *Topology) hasVertex(id string) bool {
+	_, ok := t.vertexIndex[id]
+	return ok
+}
+
+// builder lists the resources of the cluster to build a Topology.
+type builder struct {
+	k8sClient    kubernetes.Interface
+	antreaClient antrea.Interface
+	// namespace restricts the Pods and the Services of the Topology if it
+	// is not empty.
+	namespace string
+	// antreaNamespace and configMap locate the Antrea configuration.
+	antreaNamespace string
+	configMap       string
+}
+
+func (b *builder) build(ctx context.Context) (*Topology, error) {
+	t := &Topology{}
+	agentConfig, err := b.getAgentConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	_, encapMode := config.GetTrafficEncapModeFromStr(agentConfig.TrafficEncapMode)
+	t.TrafficEncapMode = encapMode.String()
+	if encapMode.SupportsEncap() {
+		t.TunnelType = agentConfig.TunnelType
+	}
+
+	nodes, err := b.k8sClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error when listing Nodes: %w", err)
+	}
+	if err := b.addNodes(ctx, t, nodes.Items, encapMode, agentConfig.HostGateway); err != nil {
+		return nil, err
+	}
+	if err := b.addPods(ctx, t); err != nil {
+		return nil, err
+	}
+	if err := b.addServices(ctx, t); err != nil {
+		return nil, err
+	}
+	if err := b.addEgresses(ctx, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// getAgentConfig returns the defaulted antrea-agent configuration, which
+// determines how the Nodes are connected.
+func (b *builder) getAgentConfig(ctx context.Context) (*agentconfig.AgentConfig, error) {
+	c := &agentconfig.AgentConfig{}
+	cm, err := b.k8sClient.CoreV1().ConfigMaps(b.antreaNamespace).Get(ctx, b.configMap, metav1.GetOptions{})
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return nil, fmt.Errorf("error when getting ConfigMap %s/%s: %w", b.antreaNamespace, b.configMap, err)
+		}
+		klog.InfoS("Antrea ConfigMap not found, assuming the default configuration", "configMap", klog.KRef(b.antreaNamespace, b.configMap))
+	} else if err := yaml.Unmarshal([]byte(cm.Data["antrea-agent.conf"]), c); err != nil {
+		return nil, fmt.Errorf("error when parsing the antrea-agent configuration: %w", err)
+	}
+	fg, err := validation.NewFeatureGate(c.FeatureGates, false)
+	if err != nil {
+		return nil, err
+	}
+	validation.SetAgentConfigDefaults(c, fg)
+	return c, nil
+}
+
+func getTransportIPs(node *corev1.Node) []net.IP {
+	addrs, err := k8s.GetNodeAddrsFromAnnotations(node, types.NodeTransportAddressAnnotationKey)
+	if err != nil || addrs == nil {
+		addrs, err = k8s.GetNodeAddrs(node)
+		if err != nil {
+			return nil
+		}
+	}
+	var ips []net.IP
+	if addrs.IPv4 != nil {
+		ips = append(ips, addrs.IPv4)
+	}
+	if addrs.IPv6 != nil {
+		ips = append(ips, addrs.IPv6)
+	}
+	return ips
+}
+
+func ipStrings(ips []net.IP) []string {
+	var s []string
+	for _, ip := range ips {
+		s = append(s, ip.String())
+	}
+	return s
+}
+
+// inSubnets returns true if any of ips is in any of the subnets.
+func inSubnets(ips []net.IP, subnets []string) bool {
+	for _, subnet := range subnets {
+		_, ipNet, err := net.ParseCIDR(subnet)
+		if err != nil {
+			continue
+		}
+		for _, ip := range ips {
+			if ipNet.Contains(ip) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (b *builder) addNodes(ctx context.Context, t *Topology, nodes []corev1.Node, encapMode config.TrafficEncapModeType, gatewayName string) error {
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Name < nodes[j].Name })
+	// The Node subnets are only reported by the antrea-agents, and are
+	// used to know which Nodes are connected through tunnels in hybrid mode.
+	nodeSubnets := map[string][]string{}
+	if encapMode == config.TrafficEncapModeHybrid {
+		agentInfos, err := b.antreaClient.CrdV1beta1().AntreaAgentInfos().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return fmt.Errorf("error when listing AntreaAgentInfos: %w", err)
+		}
+		for _, info := range agentInfos.Items {
+			nodeSubnets[info.NodeRef.Name] = info.NodeSubnets
+		}
+	}
+
+	transportIPs := make([][]net.IP, len(nodes))
+	for i := range nodes {
+		node := &nodes[i]
+		transportIPs[i] = getTransportIPs(node)
+		t.addVertex(Vertex{ID: nodeID(node.Name), Kind: VertexNode, Name: node.Name, IPs: ipStrings(transportIPs[i])})
+		if encapMode == config.TrafficEncapModeNetworkPolicyOnly {
+			// The Pod traffic is forwarded by the primary CNI.
+			continue
+		}
+		if gatewayIPs, err := k8s.GetNodeGatewayAddrs(node); err == nil {
+			var ips []net.IP
+			if gatewayIPs.IPv4 != nil {
+				ips = append(ips, gatewayIPs.IPv4)
+			}
+			if gatewayIPs.IPv6 != nil {
+				ips = append(ips, gatewayIPs.IPv6)
+			}
+			t.addVertex(Vertex{ID: gatewayID(node.Name), Kind: VertexGateway, Name: gatewayName, Parent: nodeID(node.Name), IPs: ipStrings(ips)})
+		}
+	}
+
+	for i := range nodes {
+		for j := i + 1; j < len(nodes); j++ {
+			var kind EdgeKind
+			switch encapMode {
+			case config.TrafficEncapModeEncap:
+				kind = EdgeTunnel
+			case config.TrafficEncapModeHybrid:
+				// Nodes in the same subnet are connected without
+				// encapsulation.
+				kind = EdgeTunnel
+				if inSubnets(transportIPs[j], nodeSubnets[nodes[i].Name]) {
+					kind = EdgeRoute
+				}
+			case config.TrafficEncapModeNoEncap:
+				kind = EdgeRoute
+			default:
+				continue
+			}
+			t.Edges = append(t.Edges, Edge{From: nodeID(nodes[i].Name), To: nodeID(nodes[j].Name), Kind: kind})
+		}
+	}
+	return nil
+}
+
+func (b *builder) addPods(ctx context.Context, t *Topology) error {
+	pods, err := b.k8sClient.CoreV1().Pods(b.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error when listing Pods: %w", err)
+	}
+	sort.Slice(pods.Items, func(i, j int) bool {
+		if pods.Items[i].Namespace != pods.Items[j].Namespace {
+			return pods.Items[i].Namespace < pods.Items[j].Namespace
+		}
+		return pods.Items[i].Name < pods.Items[j].Name
+	})
+	for _, pod := range pods.Items {
+		// The hostNetwork Pods are not connected to the Pod network.
+		if pod.Spec.HostNetwork || pod.Spec.NodeName == "" || !t.hasVertex(nodeID(pod.Spec.NodeName)) {
+			continue
+		}
+		var ips []string
+		for _, podIP := range pod.Status.PodIPs {
+			ips = append(ips, podIP.IP)
+		}
+		t.addVertex(Vertex{
+			ID:        podID(pod.Namespace, pod.Name),
+			Kind:      VertexPod,
+			Name:      pod.Name,
+			Namespace: pod.Namespace,
+			Parent:    nodeID(pod.Spec.NodeName),
+			IPs:       ips,
+		})
+	}
+	return nil
+}
+
+func (b *builder) addServices(ctx context.Context, t *Topology) error {
+	services, err := b.k8sClient.CoreV1().Services(b.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error when listing Services: %w", err)
+	}
+	endpointsList, err := b.k8sClient.CoreV1().Endpoints(b.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error when listing Endpoints: %w", err)
+	}
+	endpointsMap := map[string]*corev1.Endpoints{}
+	for i := range endpointsList.Items {
+		endpoints := &endpointsList.Items[i]
+		endpointsMap[endpoints.Namespace+"/"+endpoints.Name] = endpoints
+	}
+	sort.Slice(services.Items, func(i, j int) bool {
+		if services.Items[i].Namespace != services.Items[j].Namespace {
+			return services.Items[i].Namespace < services.Items[j].Namespace
+		}
+		return services.Items[i].Name < services.Items[j].Name
+	})
+	for _, svc := range services.Items {
+		if svc.Spec.Type == corev1.ServiceTypeExternalName {
+			continue
+		}
+		id := serviceID(svc.Namespace, svc.Name)
+		var ips []string
+		for _, clusterIP := range svc.Spec.ClusterIPs {
+			if clusterIP != corev1.ClusterIPNone {
+				ips = append(ips, clusterIP)
+			}
+		}
+		for _, ingress := range svc.Status.LoadBalancer.Ingress {
+			if ingress.IP != "" {
+				ips = append(ips, ingress.IP)
+			}
+		}
+		t.addVertex(Vertex{ID: id, Kind: VertexService, Name: svc.Name, Namespace: svc.Namespace, IPs: ips})
+		endpoints, ok := endpointsMap[svc.Namespace+"/"+svc.Name]
+		if !ok {
+			continue
+		}
+		backends := map[string]bool{}
+		for _, subset := range endpoints.Subsets {
+			for _, address := range subset.Addresses {
+				if address.TargetRef == nil || address.TargetRef.Kind != "Pod" {
+					continue
+				}
+				backend := podID(address.TargetRef.Namespace, address.TargetRef.Name)
+				if backends[backend] || !t.hasVertex(backend) {
+					continue
+				}
+				backends[backend] = true
+				t.Edges = append(t.Edges, Edge{From: id, To: backend, Kind: EdgeEndpoint})
+			}
+		}
+	}
+	return nil
+}
+
+func (b *builder) addEgresses(ctx context.Context, t *Topology) error {
+	egresses, err := b.antreaClient.CrdV1alpha2().Egresses().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		// The Egress CRD is not installed when the Egress feature is
+		// not used.
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("error when listing Egresses: %w", err)
+	}
+	sort.Slice(egresses.Items, func(i, j int) bool { return egresses.Items[i].Name < egresses.Items[j].Name })
+	for _, egress := range egresses.Items {
+		var ips []string
+		if egress.Spec.EgressIP != "" {
+			ips = append(ips, egress.Spec.EgressIP)
+		}
+		t.addVertex(Vertex{ID: egressID(egress.Name), Kind: VertexEgress, Name: egress.Name, IPs: ips})
+		if egress.Status.EgressNode != "" && t.hasVertex(nodeID(egress.Status.EgressNode)) {
+			t.Edges = append(t.Edges, Edge{From: egressID(egress.Name), To: nodeID(egress.Status.EgressNode), Kind: EdgeEgress})
+		}
+	}
+	return nil
+}
+
+// vertexByIP returns the ID of the Pod or Service vertex which has ip.
+func (t *Topology) vertexByIP(ip string) string {
+	for _, v := range t.Vertices {
+		if v.Kind != VertexPod && v.Kind != VertexService {
+			continue
+		}
+		for _, vip := range v.IPs {
+			if vip == ip {
+				return v.ID
+			}
+		}
+	}
+	return ""
+}
+
+// serviceIDFromPortName returns the ID of the Service of a Service port name
+// ("<namespace>/<name>:<port>"), as reported in the flow records.
+func serviceIDFromPortName(portName string) string {
+	if i := strings.LastIndex(portName, ":"); i != -1 {
+		portName = portName[:i]
+	}
+	parts := strings.SplitN(portName, "/", 2)
+	if len(parts) != 2 {
+		return ""
+	}
+	return serviceID(parts[0], parts[1])
+}
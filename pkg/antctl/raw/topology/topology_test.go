@@ -0,0 +1,268 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topology
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	crdv1alpha2 "antrea.io/antrea/pkg/apis/crd/v1alpha2"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	antreafake "antrea.io/antrea/pkg/client/clientset/versioned/fake"
+)
+
+func newNode(name, ip, podCIDR string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       corev1.NodeSpec{PodCIDR: podCIDR, PodCIDRs: []string{podCIDR}},
+		Status: corev1.NodeStatus{Addresses: []corev1.NodeAddress{
+			{Type: corev1.NodeInternalIP, Address: ip},
+		}},
+	}
+}
+
+func newPod(namespace, name, nodeName, ip string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec:       corev1.PodSpec{NodeName: nodeName},
+		Status:     corev1.PodStatus{PodIP: ip, PodIPs: []corev1.PodIP{{IP: ip}}},
+	}
+}
+
+func newAgentConfigMap(conf string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "antrea-config"},
+		Data:       map[string]string{"antrea-agent.conf": conf},
+	}
+}
+
+func testTopology(t *testing.T, k8sObjects []runtime.Object, antreaObjects []runtime.Object, namespace string) *Topology {
+	b := &builder{
+		k8sClient:       k8sfake.NewSimpleClientset(k8sObjects...),
+		antreaClient:    antreafake.NewSimpleClientset(antreaObjects...),
+		namespace:       namespace,
+		antreaNamespace: "kube-system",
+		configMap:       "antrea-config",
+	}
+	topology, err := b.build(context.TODO())
+	require.NoError(t, err)
+	return topology
+}
+
+func TestBuild(t *testing.T) {
+	hostNetworkPod := newPod("kube-system", "antrea-agent-1", "node1", "192.168.0.1")
+	hostNetworkPod.Spec.HostNetwork = true
+	k8sObjects := []runtime.Object{
+		newAgentConfigMap("tunnelType: vxlan\n"),
+		newNode("node2", "192.168.0.2", "10.10.1.0/24"),
+		newNode("node1", "192.168.0.1", "10.10.0.0/24"),
+		newPod("default", "web", "node1", "10.10.0.5"),
+		newPod("default", "client", "node2", "10.10.1.5"),
+		newPod("test", "other", "node2", "10.10.1.6"),
+		hostNetworkPod,
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web"},
+			Spec:       corev1.ServiceSpec{ClusterIP: "10.96.0.10", ClusterIPs: []string{"10.96.0.10"}},
+		},
+		&corev1.Endpoints{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web"},
+			Subsets: []corev1.EndpointSubset{{Addresses: []corev1.EndpointAddress{
+				{IP: "10.10.0.5", TargetRef: &corev1.ObjectReference{Kind: "Pod", Namespace: "default", Name: "web"}},
+			}}},
+		},
+	}
+	antreaObjects := []runtime.Object{
+		&crdv1alpha2.Egress{
+			ObjectMeta: metav1.ObjectMeta{Name: "egress1"},
+			Spec:       crdv1alpha2.EgressSpec{EgressIP: "192.168.0.100"},
+			Status:     crdv1alpha2.EgressStatus{EgressNode: "node2"},
+		},
+	}
+
+	topology := testTopology(t, k8sObjects, antreaObjects, "default")
+	assert.Equal(t, "encap", topology.TrafficEncapMode)
+	assert.Equal(t, "vxlan", topology.TunnelType)
+	assert.Equal(t, []Vertex{
+		{ID: "node/node1", Kind: VertexNode, Name: "node1", IPs: []string{"192.168.0.1"}},
+		{ID: "gateway/node1", Kind: VertexGateway, Name: "antrea-gw0", Parent: "node/node1", IPs: []string{"10.10.0.1"}},
+		{ID: "node/node2", Kind: VertexNode, Name: "node2", IPs: []string{"192.168.0.2"}},
+		{ID: "gateway/node2", Kind: VertexGateway, Name: "antrea-gw0", Parent: "node/node2", IPs: []string{"10.10.1.1"}},
+		{ID: "pod/default/client", Kind: VertexPod, Name: "client", Namespace: "default", Parent: "node/node2", IPs: []string{"10.10.1.5"}},
+		{ID: "pod/default/web", Kind: VertexPod, Name: "web", Namespace: "default", Parent: "node/node1", IPs: []string{"10.10.0.5"}},
+		{ID: "service/default/web", Kind: VertexService, Name: "web", Namespace: "default", IPs: []string{"10.96.0.10"}},
+		{ID: "egress/egress1", Kind: VertexEgress, Name: "egress1", IPs: []string{"192.168.0.100"}},
+	}, topology.Vertices)
+	assert.Equal(t, []Edge{
+		{From: "node/node1", To: "node/node2", Kind: EdgeTunnel},
+		{From: "service/default/web", To: "pod/default/web", Kind: EdgeEndpoint},
+		{From: "egress/egress1", To: "node/node2", Kind: EdgeEgress},
+	}, topology.Edges)
+}
+
+func TestBuildEncapModes(t *testing.T) {
+	nodes := []runtime.Object{
+		newNode("node1", "192.168.0.1", "10.10.0.0/24"),
+		newNode("node2", "192.168.0.2", "10.10.1.0/24"),
+		newNode("node3", "192.168.1.3", "10.10.2.0/24"),
+	}
+	agentInfos := []runtime.Object{
+		&crdv1beta1.AntreaAgentInfo{ObjectMeta: metav1.ObjectMeta{Name: "node1"}, NodeRef: corev1.ObjectReference{Name: "node1"}, NodeSubnets: []string{"192.168.0.0/24"}},
+		&crdv1beta1.AntreaAgentInfo{ObjectMeta: metav1.ObjectMeta{Name: "node2"}, NodeRef: corev1.ObjectReference{Name: "node2"}, NodeSubnets: []string{"192.168.0.0/24"}},
+		&crdv1beta1.AntreaAgentInfo{ObjectMeta: metav1.ObjectMeta{Name: "node3"}, NodeRef: corev1.ObjectReference{Name: "node3"}, NodeSubnets: []string{"192.168.1.0/24"}},
+	}
+	for _, tc := range []struct {
+		conf          string
+		expectedEdges []Edge
+		gateways      bool
+	}{
+		{
+			conf: "trafficEncapMode: hybrid\n",
+			expectedEdges: []Edge{
+				{From: "node/node1", To: "node/node2", Kind: EdgeRoute},
+				{From: "node/node1", To: "node/node3", Kind: EdgeTunnel},
+				{From: "node/node2", To: "node/node3", Kind: EdgeTunnel},
+			},
+			gateways: true,
+		},
+		{
+			conf: "trafficEncapMode: noEncap\n",
+			expectedEdges: []Edge{
+				{From: "node/node1", To: "node/node2", Kind: EdgeRoute},
+				{From: "node/node1", To: "node/node3", Kind: EdgeRoute},
+				{From: "node/node2", To: "node/node3", Kind: EdgeRoute},
+			},
+			gateways: true,
+		},
+		{
+			conf: "trafficEncapMode: networkPolicyOnly\n",
+		},
+	} {
+		t.Run(tc.conf, func(t *testing.T) {
+			topology := testTopology(t, append([]runtime.Object{newAgentConfigMap(tc.conf)}, nodes...), agentInfos, "")
+			assert.Equal(t, tc.expectedEdges, topology.Edges)
+			assert.Equal(t, tc.gateways, topology.hasVertex(gatewayID("node1")))
+		})
+	}
+}
+
+func TestBuildHostGateway(t *testing.T) {
+	k8sObjects := []runtime.Object{
+		newAgentConfigMap("hostGateway: antrea-gw1\n"),
+		newNode("node1", "192.168.0.1", "10.10.0.0/24"),
+	}
+	topology := testTopology(t, k8sObjects, nil, "")
+	assert.Equal(t, []Vertex{
+		{ID: "node/node1", Kind: VertexNode, Name: "node1", IPs: []string{"192.168.0.1"}},
+		{ID: "gateway/node1", Kind: VertexGateway, Name: "antrea-gw1", Parent: "node/node1", IPs: []string{"10.10.0.1"}},
+	}, topology.Vertices)
+}
+
+func TestAddTraffic(t *testing.T) {
+	topology := &Topology{TrafficEncapMode: "encap"}
+	topology.addVertex(Vertex{ID: podID("default", "client"), Kind: VertexPod, Name: "client", Namespace: "default", IPs: []string{"10.10.1.5"}})
+	topology.addVertex(Vertex{ID: podID("default", "web"), Kind: VertexPod, Name: "web", Namespace: "default", IPs: []string{"10.10.0.5"}})
+	topology.addVertex(Vertex{ID: serviceID("default", "web"), Kind: VertexService, Name: "web", Namespace: "default", IPs: []string{"10.96.0.10"}})
+
+	records := []FlowRecord{
+		{
+			"sourceIPv4Address": "10.10.1.5", "sourcePodNamespace": "default", "sourcePodName": "client",
+			"destinationIPv4Address": "10.10.0.5", "destinationServicePortName": "default/web:http",
+			"octetTotalCount": float64(1000), "reverseOctetTotalCount": float64(2000),
+		},
+		{
+			"sourceIPv4Address": "10.10.1.5", "sourcePodNamespace": "default", "sourcePodName": "client",
+			"destinationIPv4Address": "10.10.0.5", "destinationServicePortName": "default/web:http",
+			"octetTotalCount": float64(500), "ingressNetworkPolicyRuleAction": float64(2),
+			"ingressNetworkPolicyNamespace": "default", "ingressNetworkPolicyName": "deny-client",
+		},
+		{
+			"sourceIPv4Address": "10.10.0.5", "sourcePodNamespace": "default", "sourcePodName": "web",
+			"destinationIPv4Address": "8.8.8.8", "octetTotalCount": float64(100),
+			"egressNetworkPolicyRuleAction": float64(3), "egressNetworkPolicyName": "acnp-deny-dns",
+		},
+		{
+			"sourceIPv4Address": "10.10.0.5", "destinationIPv4Address": "10.10.1.5",
+			"octetTotalCount": float64(10), "ingressNetworkPolicyRuleAction": float64(1),
+		},
+	}
+	metrics := &RecordMetrics{NumFlows: 4}
+	topology.addTraffic(records, metrics)
+
+	assert.Equal(t, []Edge{
+		{From: "pod/default/client", To: "service/default/web", Kind: EdgeTraffic, Bytes: 3500, Drops: 1},
+		{From: "pod/default/web", To: "external/8.8.8.8", Kind: EdgeTraffic, Bytes: 100, Drops: 1},
+		{From: "pod/default/web", To: "pod/default/client", Kind: EdgeTraffic, Bytes: 10},
+	}, topology.Edges)
+	assert.True(t, topology.hasVertex(externalID("8.8.8.8")))
+	assert.Equal(t, []PolicyDrops{
+		{Policy: "acnp-deny-dns", Drops: 1},
+		{Policy: "default/deny-client", Drops: 1},
+	}, topology.PolicyDrops)
+	assert.Equal(t, metrics, topology.RecordMetrics)
+}
+
+func TestRender(t *testing.T) {
+	topology := &Topology{TrafficEncapMode: "encap", TunnelType: "geneve"}
+	topology.addVertex(Vertex{ID: nodeID("node1"), Kind: VertexNode, Name: "node1", IPs: []string{"192.168.0.1"}})
+	topology.addVertex(Vertex{ID: podID("default", "web"), Kind: VertexPod, Name: "web", Namespace: "default", Parent: nodeID("node1"), IPs: []string{"10.10.0.5"}})
+	topology.addVertex(Vertex{ID: nodeID("node2"), Kind: VertexNode, Name: "node2", IPs: []string{"192.168.0.2"}})
+	topology.addVertex(Vertex{ID: serviceID("default", "web"), Kind: VertexService, Name: "web", Namespace: "default", IPs: []string{"10.96.0.10"}})
+	topology.Edges = []Edge{
+		{From: nodeID("node1"), To: nodeID("node2"), Kind: EdgeTunnel},
+		{From: serviceID("default", "web"), To: podID("default", "web"), Kind: EdgeEndpoint},
+		{From: podID("default", "web"), To: serviceID("default", "web"), Kind: EdgeTraffic, Bytes: 2048, Drops: 2},
+	}
+	topology.PolicyDrops = []PolicyDrops{{Policy: "default/deny", Drops: 2}}
+
+	dot, err := renderDOT(topology)
+	require.NoError(t, err)
+	for _, expected := range []string{
+		"digraph topology {",
+		`subgraph cluster_0 {`,
+		`label="Node node1"`,
+		`"node/node1"->"node/node2"[ color="#696969", dir=none, label=geneve, style=dashed ];`,
+		`"pod/default/web"->"service/default/web"[ color="#B20000", fontcolor="#B20000", label="2.0KiB, 2 dropped", penwidth=2.0 ];`,
+		`"pod/default/web" [ color="#808080", fillcolor="#C8C8C8", label="default/web\n10.10.0.5", shape=box, style="rounded,filled" ];`,
+		`label="Traffic encap mode: encap\nTunnel type: geneve\nFlows dropped by default/deny: 2"`,
+	} {
+		assert.Contains(t, dot, expected)
+	}
+
+	assert.Equal(t, `flowchart LR
+  %% Traffic encap mode: encap
+  %% Tunnel type: geneve
+  %% Flows dropped by default/deny: 2
+  subgraph cluster0 ["Node node1"]
+    v0["node1<br/>192.168.0.1"]
+    v1("default/web<br/>10.10.0.5")
+  end
+  subgraph cluster1 ["Node node2"]
+    v2["node2<br/>192.168.0.2"]
+  end
+  v3{{"default/web<br/>10.96.0.10"}}
+  v0 -.-|"geneve"| v2
+  v3 --> v1
+  v1 ==>|"2.0KiB, 2 dropped"| v3
+`, renderMermaid(topology))
+	assert.True(t, strings.HasPrefix(formatBytes(3*1024*1024), "3.0MiB"))
+}
@@ -0,0 +1,181 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topology
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/vmware/go-ipfix/pkg/registry"
+
+	"antrea.io/antrea/pkg/flowaggregator/apiserver/handlers/recordmetrics"
+)
+
+// RecordMetrics are the metrics of the Flow Aggregator.
+type RecordMetrics = recordmetrics.Response
+
+// FlowRecord is a flow record of the Flow Aggregator, as returned by
+// "antctl get flowrecords -o json".
+type FlowRecord map[string]interface{}
+
+func (r FlowRecord) getString(key string) string {
+	if v, ok := r[key].(string); ok {
+		return v
+	}
+	return ""
+}
+
+func (r FlowRecord) getInt(key string) int64 {
+	switch v := r[key].(type) {
+	case float64:
+		return int64(v)
+	case int64:
+		return v
+	case int:
+		return int64(v)
+	}
+	return 0
+}
+
+func (r FlowRecord) sourceIP() string {
+	if ip := r.getString("sourceIPv4Address"); ip != "" {
+		return ip
+	}
+	return r.getString("sourceIPv6Address")
+}
+
+func (r FlowRecord) destinationIP() string {
+	if ip := r.getString("destinationIPv4Address"); ip != "" {
+		return ip
+	}
+	return r.getString("destinationIPv6Address")
+}
+
+func isDropAction(action int64) bool {
+	return action == int64(registry.NetworkPolicyRuleActionDrop) || action == int64(registry.NetworkPolicyRuleActionReject)
+}
+
+// droppingPolicy returns the name of the NetworkPolicy which dropped or
+// rejected the flow, and false if the flow is not dropped.
+func (r FlowRecord) droppingPolicy() (string, bool) {
+	for _, direction := range []string{"ingress", "egress"} {
+		if !isDropAction(r.getInt(direction + "NetworkPolicyRuleAction")) {
+			continue
+		}
+		name := r.getString(direction + "NetworkPolicyName")
+		if namespace := r.getString(direction + "NetworkPolicyNamespace"); namespace != "" {
+			name = namespace + "/" + name
+		}
+		return name, true
+	}
+	return "", false
+}
+
+// endpointVertex returns the ID of the vertex of a flow endpoint, identified by
+// its Pod or by its IP. A vertex is added for the IPs which are not in the
+// topology.
+func (t *Topology) endpointVertex(namespace, name, ip string) string {
+	if name != "" {
+		if id := podID(namespace, name); t.hasVertex(id) {
+			return id
+		}
+	}
+	if ip == "" {
+		return ""
+	}
+	if id := t.vertexByIP(ip); id != "" {
+		return id
+	}
+	id := externalID(ip)
+	t.addVertex(Vertex{ID: id, Kind: VertexExternal, Name: ip, IPs: []string{ip}})
+	return id
+}
+
+// addTraffic overlays the topology with the flows of the Flow Aggregator: a
+// directed Traffic edge is added from the source to the destination (the Service
+// if the flow was load-balanced) of the flows, with their volume and the number
+// of flows dropped by NetworkPolicies.
+func (t *Topology) addTraffic(records []FlowRecord, metrics *RecordMetrics) {
+	t.RecordMetrics = metrics
+	type edgeKey struct {
+		from string
+		to   string
+	}
+	edges := map[edgeKey]*Edge{}
+	policyDrops := map[string]int64{}
+	for _, r := range records {
+		from := t.endpointVertex(r.getString("sourcePodNamespace"), r.getString("sourcePodName"), r.sourceIP())
+		var to string
+		if portName := r.getString("destinationServicePortName"); portName != "" {
+			if id := serviceIDFromPortName(portName); t.hasVertex(id) {
+				to = id
+			}
+		}
+		if to == "" {
+			to = t.endpointVertex(r.getString("destinationPodNamespace"), r.getString("destinationPodName"), r.destinationIP())
+		}
+		if from == "" || to == "" {
+			continue
+		}
+		key := edgeKey{from, to}
+		edge, ok := edges[key]
+		if !ok {
+			edge = &Edge{From: from, To: to, Kind: EdgeTraffic}
+			edges[key] = edge
+		}
+		edge.Bytes += r.getInt("octetTotalCount") + r.getInt("reverseOctetTotalCount")
+		if policy, dropped := r.droppingPolicy(); dropped {
+			edge.Drops++
+			policyDrops[policy]++
+		}
+	}
+
+	keys := make([]edgeKey, 0, len(edges))
+	for key := range edges {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].from != keys[j].from {
+			return keys[i].from < keys[j].from
+		}
+		return keys[i].to < keys[j].to
+	})
+	for _, key := range keys {
+		t.Edges = append(t.Edges, *edges[key])
+	}
+	for policy, drops := range policyDrops {
+		t.PolicyDrops = append(t.PolicyDrops, PolicyDrops{Policy: policy, Drops: drops})
+	}
+	sort.Slice(t.PolicyDrops, func(i, j int) bool {
+		if t.PolicyDrops[i].Drops != t.PolicyDrops[j].Drops {
+			return t.PolicyDrops[i].Drops > t.PolicyDrops[j].Drops
+		}
+		return t.PolicyDrops[i].Policy < t.PolicyDrops[j].Policy
+	})
+}
+
+// formatBytes formats a number of bytes with a binary unit prefix.
+func formatBytes(bytes int64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%dB", bytes)
+	}
+	div, exp := int64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ciB", float64(bytes)/float64(div), "KMGTPE"[exp])
+}
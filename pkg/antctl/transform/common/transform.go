@@ -49,6 +49,15 @@ type TableOutput interface {
 	SortRows() bool
 }
 
+// WideTableOutput is implemented by the responses which have additional columns
+// in the wide table output. The additional columns are appended to the columns
+// of the table output.
+type WideTableOutput interface {
+	TableOutput
+	GetWideTableHeader() []string
+	GetWideTableRow(maxColumnLength int) []string
+}
+
 func Int32ToString(val int32) string {
 	return strconv.Itoa(int(val))
 }
@@ -15,6 +15,7 @@
 package networkpolicy
 
 import (
+	"fmt"
 	"io"
 	"reflect"
 	"sort"
@@ -118,7 +119,7 @@ func priorityToString(p interface{}) string {
 	}
 }
 
-var _ common.TableOutput = new(Response)
+var _ common.WideTableOutput = new(Response)
 
 func (r Response) GetTableHeader() []string {
 	return []string{"NAME", "APPLIED-TO", "RULES", "SOURCE", "TIER-PRIORITY", "PRIORITY"}
@@ -135,3 +136,22 @@ func (r Response) GetTableRow(maxColumnLength int) []string {
 func (r Response) SortRows() bool {
 	return false
 }
+
+func (r Response) GetWideTableHeader() []string {
+	return []string{"REALIZED", "FAILED-NODES"}
+}
+
+func (r Response) GetWideTableRow(maxColumnLength int) []string {
+	// The realization status is only reported by the antrea-controller for Antrea-native policies.
+	if r.RealizationStatus == nil {
+		return []string{"", ""}
+	}
+	failedNodes := make([]string, 0, len(r.RealizationStatus.FailedNodes))
+	for _, nodeStatus := range r.RealizationStatus.FailedNodes {
+		failedNodes = append(failedNodes, fmt.Sprintf("%s(%s)", nodeStatus.NodeName, nodeStatus.Reason))
+	}
+	return []string{
+		fmt.Sprintf("%d/%d", r.RealizationStatus.CurrentNodesRealized, r.RealizationStatus.DesiredNodesRealized),
+		common.GenerateTableElementWithSummary(failedNodes, maxColumnLength),
+	}
+}
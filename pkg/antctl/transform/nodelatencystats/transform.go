@@ -0,0 +1,88 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodelatencystats
+
+import (
+	"io"
+	"reflect"
+	"time"
+
+	"antrea.io/antrea/pkg/antctl/transform"
+	"antrea.io/antrea/pkg/antctl/transform/common"
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
+)
+
+// Response is the latency stats of a probed IP of a peer Node measured by a Node.
+type Response struct {
+	NodeName     string `json:"nodeName" yaml:"nodeName"`
+	PeerNodeName string `json:"peerNodeName" yaml:"peerNodeName"`
+	TargetIP     string `json:"targetIP" yaml:"targetIP"`
+	LastRTT      string `json:"lastRTT" yaml:"lastRTT"`
+	SentProbes   int64  `json:"sentProbes" yaml:"sentProbes"`
+	LostProbes   int64  `json:"lostProbes" yaml:"lostProbes"`
+}
+
+func listTransform(l interface{}, opts map[string]string) (interface{}, error) {
+	statsList := l.(*statsv1alpha1.NodeLatencyStatsList)
+	result := []interface{}{}
+	for i := range statsList.Items {
+		item := statsList.Items[i]
+		o, _ := objectTransform(&item, opts)
+		result = append(result, o.([]interface{})...)
+	}
+	return result, nil
+}
+
+func objectTransform(o interface{}, _ map[string]string) (interface{}, error) {
+	stats := o.(*statsv1alpha1.NodeLatencyStats)
+	result := []interface{}{}
+	for _, peer := range stats.PeerNodeLatencyStats {
+		for _, target := range peer.TargetIPLatencyStats {
+			result = append(result, Response{
+				NodeName:     stats.Name,
+				PeerNodeName: peer.NodeName,
+				TargetIP:     target.TargetIP,
+				LastRTT:      time.Duration(target.LastMeasuredRTTNanoseconds).String(),
+				SentProbes:   target.SentProbes,
+				LostProbes:   target.LostProbes,
+			})
+		}
+	}
+	return result, nil
+}
+
+func Transform(reader io.Reader, single bool, opts map[string]string) (interface{}, error) {
+	return transform.GenericFactory(
+		reflect.TypeOf(statsv1alpha1.NodeLatencyStats{}),
+		reflect.TypeOf(statsv1alpha1.NodeLatencyStatsList{}),
+		objectTransform,
+		listTransform,
+		opts,
+	)(reader, single)
+}
+
+var _ common.TableOutput = new(Response)
+
+func (r Response) GetTableHeader() []string {
+	return []string{"NODE", "PEER-NODE", "TARGET-IP", "LAST-RTT", "SENT-PROBES", "LOST-PROBES"}
+}
+
+func (r Response) GetTableRow(maxColumnLength int) []string {
+	return []string{r.NodeName, r.PeerNodeName, r.TargetIP, r.LastRTT, common.Int64ToString(r.SentProbes), common.Int64ToString(r.LostProbes)}
+}
+
+func (r Response) SortRows() bool {
+	return true
+}
@@ -0,0 +1,96 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policyverdict
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+
+	"antrea.io/antrea/pkg/antctl/transform"
+	"antrea.io/antrea/pkg/antctl/transform/common"
+	"antrea.io/antrea/pkg/apis/controlplane"
+	"antrea.io/antrea/pkg/controller/networkpolicy"
+)
+
+// Response is the verdict of a single connection, one per Service backend when
+// the destination is a Service.
+type Response struct {
+	networkpolicy.ConnectionVerdict
+}
+
+func objectTransform(o interface{}, _ map[string]string) (interface{}, error) {
+	resp := o.(*networkpolicy.PolicyVerdictResponse)
+	result := []interface{}{}
+	for _, verdict := range resp.Verdicts {
+		result = append(result, Response{verdict})
+	}
+	return result, nil
+}
+
+func Transform(reader io.Reader, single bool, opts map[string]string) (interface{}, error) {
+	return transform.GenericFactory(
+		reflect.TypeOf(networkpolicy.PolicyVerdictResponse{}),
+		reflect.TypeOf(networkpolicy.PolicyVerdictResponse{}),
+		objectTransform,
+		objectTransform,
+		opts,
+	)(reader, single)
+}
+
+var _ common.TableOutput = new(Response)
+
+func (r Response) GetTableHeader() []string {
+	return []string{"SOURCE", "DESTINATION", "PORT", "VERDICT", "EGRESS", "INGRESS"}
+}
+
+func (r Response) GetTableRow(maxColumnLength int) []string {
+	port := r.Protocol
+	if r.Port != 0 {
+		port = strconv.Itoa(int(r.Port)) + "/" + r.Protocol
+	}
+	return []string{r.Source, r.Destination, port, string(r.Verdict), directionVerdictToString(r.Egress), directionVerdictToString(r.Ingress)}
+}
+
+func (r Response) SortRows() bool {
+	return false
+}
+
+var policyTypeShortNames = map[controlplane.NetworkPolicyType]string{
+	controlplane.K8sNetworkPolicy:           "K8sNP",
+	controlplane.AntreaNetworkPolicy:        "ANP",
+	controlplane.AntreaClusterNetworkPolicy: "ACNP",
+	controlplane.AdminNetworkPolicy:         "AdminNP",
+	controlplane.BaselineAdminNetworkPolicy: "BANP",
+}
+
+func directionVerdictToString(v *networkpolicy.DirectionVerdict) string {
+	if v == nil {
+		return ""
+	}
+	if v.Rule == nil {
+		return fmt.Sprintf("%s (%s)", v.Verdict, v.Reason)
+	}
+	policy := v.Rule.PolicyRef.Name
+	if v.Rule.PolicyRef.Namespace != "" {
+		policy = v.Rule.PolicyRef.Namespace + "/" + policy
+	}
+	rule := v.Rule.Name
+	if rule == "" {
+		rule = "#" + strconv.Itoa(v.Rule.RuleIndex)
+	}
+	return fmt.Sprintf("%s (%s %s rule %s, action %s)", v.Verdict, policyTypeShortNames[v.Rule.PolicyType], policy, rule, v.Rule.Action)
+}
@@ -0,0 +1,432 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package antctl
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/antctl/output"
+	"antrea.io/antrea/pkg/antctl/transform/common"
+	cpv1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+)
+
+// watchPollInterval is the interval at which a nonResourceEndpoint is polled
+// in watch mode.
+var watchPollInterval = 2 * time.Second
+
+// rawWatchEvent is a change of an object, which is encoded in JSON like a
+// single object of the responses of the endpoint.
+type rawWatchEvent struct {
+	eventType watch.EventType
+	key       string
+	object    []byte
+}
+
+// watchEvent is a change of a TransformedResponse object, as it is output in
+// the json and yaml formats.
+type watchEvent struct {
+	Type   watch.EventType `json:"type"`
+	Object interface{}     `json:"object"`
+}
+
+// watchEventRow prepends the type of the event to the table row of an object.
+type watchEventRow struct {
+	eventType watch.EventType
+	common.TableOutput
+}
+
+func (r watchEventRow) GetTableHeader() []string {
+	return append([]string{"EVENT"}, r.TableOutput.GetTableHeader()...)
+}
+
+func (r watchEventRow) GetTableRow(maxColumnLength int) []string {
+	return append([]string{string(r.eventType)}, r.TableOutput.GetTableRow(maxColumnLength)...)
+}
+
+func (r watchEventRow) GetWideTableHeader() []string {
+	if wide, ok := r.TableOutput.(common.WideTableOutput); ok {
+		return wide.GetWideTableHeader()
+	}
+	return nil
+}
+
+func (r watchEventRow) GetWideTableRow(maxColumnLength int) []string {
+	if wide, ok := r.TableOutput.(common.WideTableOutput); ok {
+		return wide.GetWideTableRow(maxColumnLength)
+	}
+	return nil
+}
+
+// watchPrinter outputs the changes of the objects in batches: the initial
+// objects are output together, and then each batch of changes.
+type watchPrinter struct {
+	cd     *commandDefinition
+	out    io.Writer
+	ft     formatterType
+	args   map[string]string
+	events int
+}
+
+func (p *watchPrinter) print(events []rawWatchEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].key < events[j].key
+	})
+	transformed := make([]watchEvent, 0, len(events))
+	for _, e := range events {
+		obj, err := p.cd.transform(bytes.NewReader(e.object), true, p.args)
+		if err != nil {
+			return err
+		}
+		transformed = append(transformed, watchEvent{Type: e.eventType, Object: obj})
+	}
+	switch p.ft {
+	case jsonFormatter:
+		for _, e := range transformed {
+			if err := output.JsonOutput(e, p.out); err != nil {
+				return err
+			}
+		}
+	case yamlFormatter:
+		for _, e := range transformed {
+			if _, err := io.WriteString(p.out, "---\n"); err != nil {
+				return err
+			}
+			if err := output.YamlOutput(e, p.out); err != nil {
+				return err
+			}
+		}
+	default:
+		rows := make([]watchEventRow, 0, len(transformed))
+		for _, e := range transformed {
+			row, ok := e.Object.(common.TableOutput)
+			if !ok {
+				return output.TableOutput(transformed, p.out)
+			}
+			rows = append(rows, watchEventRow{eventType: e.Type, TableOutput: row})
+		}
+		// Separate the batches of changes, which are output as distinct
+		// tables.
+		if p.events > 0 {
+			if _, err := io.WriteString(p.out, "\n"); err != nil {
+				return err
+			}
+		}
+		tableOutput := output.TableOutputForGetCommands
+		if p.ft == wideFormatter {
+			tableOutput = output.WideTableOutputForGetCommands
+		}
+		if err := tableOutput(rows, p.out); err != nil {
+			return err
+		}
+	}
+	p.events += len(events)
+	return nil
+}
+
+// watch outputs the objects of the command, and then their changes until
+// stopCh is closed. The objects of a resourceEndpoint are watched with the
+// watch API, while a nonResourceEndpoint is polled.
+func (cd *commandDefinition) watch(c AntctlClient, opt *requestOption, out io.Writer, ft formatterType, stopCh <-chan struct{}) error {
+	if ft != jsonFormatter && ft != yamlFormatter && ft != tableFormatter && ft != wideFormatter {
+		return fmt.Errorf("unsupported format type: %v", ft)
+	}
+	p := &watchPrinter{cd: cd, out: out, ft: ft, args: opt.args}
+	if _, ok := cd.getEndpoint().(*resourceEndpoint); ok {
+		return cd.watchResource(c, opt, p, stopCh)
+	}
+	return cd.pollNonResource(c, opt, p, cd.getWatchOption(), stopCh)
+}
+
+// watchResource watches the objects of a resourceEndpoint. The AddressGroups and
+// the AppliedToGroups are updated with patches, which are applied to the
+// objects received previously so that the complete objects are output. The
+// watch is restarted when it is closed by the server; the initial objects of
+// the new watch, which end with a bookmark event, are then compared to the
+// objects received previously.
+func (cd *commandDefinition) watchResource(c AntctlClient, opt *requestOption, p *watchPrinter, stopCh <-chan struct{}) error {
+	objects := map[string]k8sruntime.Object{}
+	for {
+		w, err := c.watch(opt)
+		if err != nil {
+			return err
+		}
+		closed, err := watchObjects(w, objects, p, stopCh)
+		w.Stop()
+		if !closed || err != nil {
+			return err
+		}
+		klog.InfoS("The watch was closed by the server, restarting it")
+	}
+}
+
+// watchObjects processes the events of a watch, until the watch is closed or
+// stopCh is closed. It returns true if the watch was closed.
+func watchObjects(w watch.Interface, objects map[string]k8sruntime.Object, p *watchPrinter, stopCh <-chan struct{}) (bool, error) {
+	initializing := true
+	initObjects := map[string]bool{}
+	var initEvents []rawWatchEvent
+	for {
+		var event watch.Event
+		var ok bool
+		select {
+		case <-stopCh:
+			return false, nil
+		case event, ok = <-w.ResultChan():
+			if !ok {
+				return true, nil
+			}
+		}
+
+		switch event.Type {
+		case watch.Error:
+			return false, errors.FromObject(event.Object)
+		case watch.Bookmark:
+			if !initializing {
+				continue
+			}
+			// The objects which were received previously and are not
+			// among the initial objects were deleted while the watch
+			// was down.
+			for name, obj := range objects {
+				if !initObjects[name] {
+					e, err := newRawWatchEvent(watch.Deleted, name, obj)
+					if err != nil {
+						return false, err
+					}
+					initEvents = append(initEvents, e)
+					delete(objects, name)
+				}
+			}
+			initializing = false
+			if err := p.print(initEvents); err != nil {
+				return false, err
+			}
+			continue
+		}
+
+		name, obj, err := currentObject(event, objects)
+		if err != nil {
+			return false, err
+		}
+		eventType := event.Type
+		if eventType == watch.Deleted {
+			delete(objects, name)
+		} else {
+			prevObj, exists := objects[name]
+			objects[name] = obj
+			if initializing {
+				initObjects[name] = true
+				if exists && reflect.DeepEqual(prevObj, obj) {
+					continue
+				}
+			}
+			if exists {
+				eventType = watch.Modified
+			}
+		}
+		e, err := newRawWatchEvent(eventType, name, obj)
+		if err != nil {
+			return false, err
+		}
+		if initializing {
+			initEvents = append(initEvents, e)
+		} else if err := p.print([]rawWatchEvent{e}); err != nil {
+			return false, err
+		}
+	}
+}
+
+func newRawWatchEvent(eventType watch.EventType, name string, obj k8sruntime.Object) (rawWatchEvent, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return rawWatchEvent{}, fmt.Errorf("error when encoding object %s: %w", name, err)
+	}
+	return rawWatchEvent{eventType: eventType, key: name, object: data}, nil
+}
+
+// currentObject returns the name and the complete current version of the object
+// of a watch event. As the Deleted events only include the metadata of the
+// objects, the version received previously is returned for them.
+func currentObject(event watch.Event, objects map[string]k8sruntime.Object) (string, k8sruntime.Object, error) {
+	accessor, err := meta.Accessor(event.Object)
+	if err != nil {
+		return "", nil, err
+	}
+	name := accessor.GetName()
+	prevObj, exists := objects[name]
+	switch patch := event.Object.(type) {
+	case *cpv1beta.AddressGroupPatch:
+		group, ok := prevObj.(*cpv1beta.AddressGroup)
+		if !ok {
+			return "", nil, fmt.Errorf("received a patch for unknown AddressGroup %s", name)
+		}
+		group = group.DeepCopy()
+		group.GroupMembers = patchGroupMembers(group.GroupMembers, patch.AddedGroupMembers, patch.RemovedGroupMembers)
+		return name, group, nil
+	case *cpv1beta.AppliedToGroupPatch:
+		group, ok := prevObj.(*cpv1beta.AppliedToGroup)
+		if !ok {
+			return "", nil, fmt.Errorf("received a patch for unknown AppliedToGroup %s", name)
+		}
+		group = group.DeepCopy()
+		group.GroupMembers = patchGroupMembers(group.GroupMembers, patch.AddedGroupMembers, patch.RemovedGroupMembers)
+		return name, group, nil
+	}
+	if event.Type == watch.Deleted && exists {
+		return name, prevObj, nil
+	}
+	return name, event.Object, nil
+}
+
+func patchGroupMembers(members, added, removed []cpv1beta.GroupMember) []cpv1beta.GroupMember {
+	removedSet := cpv1beta.NewGroupMemberSet()
+	for i := range removed {
+		removedSet.Insert(&removed[i])
+	}
+	result := make([]cpv1beta.GroupMember, 0, len(members)+len(added))
+	for i := range members {
+		if !removedSet.Has(&members[i]) {
+			result = append(result, members[i])
+		}
+	}
+	return append(result, added...)
+}
+
+// pollNonResource polls a nonResourceEndpoint, and compares the objects of
+// consecutive responses, which are identified with the keyFunc of the
+// watchOption.
+func (cd *commandDefinition) pollNonResource(c AntctlClient, opt *requestOption, p *watchPrinter, option *watchOption, stopCh <-chan struct{}) error {
+	ticker := time.NewTicker(watchPollInterval)
+	defer ticker.Stop()
+	var objects map[string][]byte
+	for {
+		select {
+		case <-stopCh:
+			return nil
+		default:
+		}
+		resp, err := c.request(opt)
+		if err != nil {
+			return err
+		}
+		currObjects, err := splitResponse(resp, option.keyFunc)
+		if err != nil {
+			return err
+		}
+		if err := p.print(diffObjects(objects, currObjects, option.keyOnly)); err != nil {
+			return err
+		}
+		objects = currObjects
+		select {
+		case <-stopCh:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// splitResponse splits the response of a nonResourceEndpoint, which can be a
+// list, a list object with items, or a single object, into its objects indexed
+// by their keys.
+func splitResponse(resp io.Reader, keyFunc func(obj map[string]interface{}) string) (map[string][]byte, error) {
+	data, err := io.ReadAll(resp)
+	if err != nil {
+		return nil, err
+	}
+	data = bytes.TrimSpace(data)
+	var items []json.RawMessage
+	if len(data) == 0 {
+		// No response returned.
+	} else if data[0] == '[' {
+		if err := json.Unmarshal(data, &items); err != nil {
+			return nil, fmt.Errorf("error when decoding response: %w", err)
+		}
+	} else {
+		var list struct {
+			Items *[]json.RawMessage `json:"items"`
+		}
+		if err := json.Unmarshal(data, &list); err != nil {
+			return nil, fmt.Errorf("error when decoding response: %w", err)
+		}
+		if list.Items != nil {
+			items = *list.Items
+		} else {
+			items = []json.RawMessage{data}
+		}
+	}
+	objects := make(map[string][]byte, len(items))
+	for _, item := range items {
+		var obj map[string]interface{}
+		if err := json.Unmarshal(item, &obj); err != nil {
+			return nil, fmt.Errorf("error when decoding response: %w", err)
+		}
+		objects[keyFunc(obj)] = item
+	}
+	return objects, nil
+}
+
+// diffObjects returns the changes from the previous objects to the current
+// objects. All the current objects are added if there are no previous objects.
+func diffObjects(prevObjects, currObjects map[string][]byte, keyOnly bool) []rawWatchEvent {
+	var events []rawWatchEvent
+	for key, obj := range currObjects {
+		prevObj, exists := prevObjects[key]
+		if !exists {
+			events = append(events, rawWatchEvent{eventType: watch.Added, key: key, object: obj})
+		} else if !keyOnly && !bytes.Equal(prevObj, obj) {
+			events = append(events, rawWatchEvent{eventType: watch.Modified, key: key, object: obj})
+		}
+	}
+	for key, obj := range prevObjects {
+		if _, exists := currObjects[key]; !exists {
+			events = append(events, rawWatchEvent{eventType: watch.Deleted, key: key, object: obj})
+		}
+	}
+	return events
+}
+
+// fieldsKey returns a key function which joins the values of the provided
+// fields of an object, given as dot-separated paths.
+func fieldsKey(paths ...string) func(obj map[string]interface{}) string {
+	return func(obj map[string]interface{}) string {
+		values := make([]string, 0, len(paths))
+		for _, path := range paths {
+			var value interface{} = obj
+			for _, field := range strings.Split(path, ".") {
+				m, _ := value.(map[string]interface{})
+				value = m[field]
+			}
+			s, _ := value.(string)
+			values = append(values, s)
+		}
+		return strings.Join(values, "/")
+	}
+}
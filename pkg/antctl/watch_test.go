@@ -0,0 +1,256 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package antctl
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"antrea.io/antrea/pkg/antctl/runtime"
+	"antrea.io/antrea/pkg/antctl/transform/addressgroup"
+	cpv1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+)
+
+func podMember(name, ip string) cpv1beta.GroupMember {
+	return cpv1beta.GroupMember{
+		Pod: &cpv1beta.PodReference{Namespace: "default", Name: name},
+		IPs: []cpv1beta.IPAddress{cpv1beta.IPAddress(net.ParseIP(ip))},
+	}
+}
+
+func addressGroup(name string, members ...cpv1beta.GroupMember) *cpv1beta.AddressGroup {
+	return &cpv1beta.AddressGroup{ObjectMeta: metav1.ObjectMeta{Name: name}, GroupMembers: members}
+}
+
+func addressGroupCommand() *commandDefinition {
+	return &commandDefinition{
+		use:          "addressgroup",
+		commandGroup: get,
+		controllerEndpoint: &endpoint{
+			resourceEndpoint: &resourceEndpoint{
+				groupVersionResource: &cpv1beta.AddressGroupVersionResource,
+			},
+			addonTransform: addressgroup.Transform,
+			watch:          &watchOption{},
+		},
+		agentEndpoint: &endpoint{
+			nonResourceEndpoint: &nonResourceEndpoint{
+				path: "/addressgroups",
+			},
+			addonTransform: addressgroup.Transform,
+			watch:          &watchOption{keyFunc: fieldsKey("metadata.name")},
+		},
+		transformedResponse: reflect.TypeOf(addressgroup.Response{}),
+	}
+}
+
+func TestWatchResource(t *testing.T) {
+	defer func(mode string) {
+		runtime.Mode = mode
+	}(runtime.Mode)
+	runtime.Mode = runtime.ModeController
+
+	// The first watch is closed by the server after the group members are
+	// patched and a group is deleted.
+	w1 := watch.NewFakeWithChanSize(10, false)
+	w1.Add(addressGroup("group1", podMember("pod1", "10.0.0.1")))
+	w1.Add(addressGroup("group2", podMember("pod2", "10.0.0.2")))
+	w1.Action(watch.Bookmark, &cpv1beta.AddressGroup{})
+	w1.Modify(&cpv1beta.AddressGroupPatch{
+		ObjectMeta:          metav1.ObjectMeta{Name: "group1"},
+		AddedGroupMembers:   []cpv1beta.GroupMember{podMember("pod3", "10.0.0.3")},
+		RemovedGroupMembers: []cpv1beta.GroupMember{podMember("pod1", "10.0.0.1")},
+	})
+	w1.Delete(&cpv1beta.AddressGroup{ObjectMeta: metav1.ObjectMeta{Name: "group2"}})
+	w1.Stop()
+	// The initial objects of the second watch are compared to the objects
+	// received previously. The watch is stopped once they are processed.
+	w2 := watch.NewFake()
+	stopCh := make(chan struct{})
+	go func() {
+		w2.Add(addressGroup("group1", podMember("pod3", "10.0.0.3")))
+		w2.Add(addressGroup("group3", podMember("pod4", "10.0.0.4")))
+		w2.Action(watch.Bookmark, &cpv1beta.AddressGroup{})
+		close(stopCh)
+	}()
+
+	ctrl := gomock.NewController(t)
+	client := NewMockAntctlClient(ctrl)
+	gomock.InOrder(
+		client.EXPECT().watch(gomock.Any()).Return(w1, nil),
+		client.EXPECT().watch(gomock.Any()).Return(w2, nil),
+	)
+
+	cd := addressGroupCommand()
+	var out bytes.Buffer
+	err := cd.watch(client, &requestOption{commandDefinition: cd, args: map[string]string{}}, &out, tableFormatter, stopCh)
+	require.NoError(t, err)
+	assert.Equal(t, `EVENT NAME   POD-IPS  NODE-IPS
+ADDED group1 10.0.0.1 <NONE>  
+ADDED group2 10.0.0.2 <NONE>  
+
+EVENT    NAME   POD-IPS  NODE-IPS
+MODIFIED group1 10.0.0.3 <NONE>  
+
+EVENT   NAME   POD-IPS  NODE-IPS
+DELETED group2 10.0.0.2 <NONE>  
+
+EVENT NAME   POD-IPS  NODE-IPS
+ADDED group3 10.0.0.4 <NONE>  
+`, out.String())
+}
+
+func TestPollNonResource(t *testing.T) {
+	defer func(mode string, interval time.Duration) {
+		runtime.Mode = mode
+		watchPollInterval = interval
+	}(runtime.Mode, watchPollInterval)
+	runtime.Mode = runtime.ModeAgent
+	watchPollInterval = time.Millisecond
+
+	responses := []*cpv1beta.AddressGroupList{
+		{Items: []cpv1beta.AddressGroup{
+			*addressGroup("group1", podMember("pod1", "10.0.0.1")),
+			*addressGroup("group2", podMember("pod2", "10.0.0.2")),
+		}},
+		{Items: []cpv1beta.AddressGroup{
+			*addressGroup("group1", podMember("pod1", "10.0.0.1")),
+			*addressGroup("group2", podMember("pod2", "10.0.0.2")),
+		}},
+		{Items: []cpv1beta.AddressGroup{
+			*addressGroup("group1", podMember("pod1", "10.0.0.1"), podMember("pod3", "10.0.0.3")),
+			*addressGroup("group3"),
+		}},
+	}
+	stopCh := make(chan struct{})
+	ctrl := gomock.NewController(t)
+	client := NewMockAntctlClient(ctrl)
+	polls := 0
+	client.EXPECT().request(gomock.Any()).Times(len(responses)).DoAndReturn(func(*requestOption) (io.Reader, error) {
+		data, err := json.Marshal(responses[polls])
+		require.NoError(t, err)
+		polls++
+		if polls == len(responses) {
+			close(stopCh)
+		}
+		return bytes.NewReader(data), nil
+	})
+
+	cd := addressGroupCommand()
+	var out bytes.Buffer
+	err := cd.watch(client, &requestOption{commandDefinition: cd, args: map[string]string{}}, &out, jsonFormatter, stopCh)
+	require.NoError(t, err)
+
+	var events []watchEvent
+	decoder := json.NewDecoder(&out)
+	for decoder.More() {
+		var event struct {
+			Type   watch.EventType       `json:"type"`
+			Object addressgroup.Response `json:"object"`
+		}
+		require.NoError(t, decoder.Decode(&event))
+		events = append(events, watchEvent{Type: event.Type, Object: event.Object.Name + ":" + podIPs(event.Object)})
+	}
+	assert.Equal(t, []watchEvent{
+		{Type: watch.Added, Object: "group1:10.0.0.1"},
+		{Type: watch.Added, Object: "group2:10.0.0.2"},
+		{Type: watch.Modified, Object: "group1:10.0.0.1,10.0.0.3"},
+		{Type: watch.Deleted, Object: "group2:10.0.0.2"},
+		{Type: watch.Added, Object: "group3:"},
+	}, events)
+}
+
+func podIPs(r addressgroup.Response) string {
+	var ips []string
+	for _, pod := range r.Pods {
+		ips = append(ips, pod.IP)
+	}
+	return strings.Join(ips, ",")
+}
+
+func TestSplitResponse(t *testing.T) {
+	keyFunc := fieldsKey("namespace", "name")
+	for _, tc := range []struct {
+		name     string
+		resp     string
+		expected map[string][]byte
+	}{
+		{
+			name: "list",
+			resp: `[{"namespace":"ns1","name":"a"},{"namespace":"ns2","name":"a"}]`,
+			expected: map[string][]byte{
+				"ns1/a": []byte(`{"namespace":"ns1","name":"a"}`),
+				"ns2/a": []byte(`{"namespace":"ns2","name":"a"}`),
+			},
+		},
+		{
+			name: "list object",
+			resp: `{"metadata":{},"items":[{"namespace":"ns1","name":"a"}]}`,
+			expected: map[string][]byte{
+				"ns1/a": []byte(`{"namespace":"ns1","name":"a"}`),
+			},
+		},
+		{
+			name: "single object",
+			resp: `{"namespace":"ns1","name":"a"}` + "\n",
+			expected: map[string][]byte{
+				"ns1/a": []byte(`{"namespace":"ns1","name":"a"}`),
+			},
+		},
+		{
+			name:     "empty",
+			resp:     "",
+			expected: map[string][]byte{},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			objects, err := splitResponse(strings.NewReader(tc.resp), keyFunc)
+			require.NoError(t, err)
+			assert.Equal(t, len(tc.expected), len(objects))
+			for key, obj := range tc.expected {
+				assert.JSONEq(t, string(obj), string(objects[key]))
+			}
+		})
+	}
+}
+
+func TestDiffObjectsKeyOnly(t *testing.T) {
+	prevObjects := map[string][]byte{
+		"table=0, priority=200 actions=drop":       []byte(`{"flow":"table=0, n_packets=1, priority=200 actions=drop"}`),
+		"table=0, priority=100 actions=goto_table": []byte(`{"flow":"table=0, n_packets=1, priority=100 actions=goto_table"}`),
+	}
+	currObjects := map[string][]byte{
+		"table=0, priority=200 actions=drop": []byte(`{"flow":"table=0, n_packets=2, priority=200 actions=drop"}`),
+	}
+	assert.Equal(t, []rawWatchEvent{
+		{eventType: watch.Deleted, key: "table=0, priority=100 actions=goto_table", object: prevObjects["table=0, priority=100 actions=goto_table"]},
+	}, diffObjects(prevObjects, currObjects, true))
+	assert.ElementsMatch(t, []rawWatchEvent{
+		{eventType: watch.Modified, key: "table=0, priority=200 actions=drop", object: currObjects["table=0, priority=200 actions=drop"]},
+		{eventType: watch.Deleted, key: "table=0, priority=100 actions=goto_table", object: prevObjects["table=0, priority=100 actions=goto_table"]},
+	}, diffObjects(prevObjects, currObjects, false))
+}
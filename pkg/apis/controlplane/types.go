@@ -184,6 +184,8 @@ const (
 	K8sNetworkPolicy           NetworkPolicyType = "K8sNetworkPolicy"
 	AntreaClusterNetworkPolicy NetworkPolicyType = "AntreaClusterNetworkPolicy"
 	AntreaNetworkPolicy        NetworkPolicyType = "AntreaNetworkPolicy"
+	AdminNetworkPolicy         NetworkPolicyType = "AdminNetworkPolicy"
+	BaselineAdminNetworkPolicy NetworkPolicyType = "BaselineAdminNetworkPolicy"
 )
 
 type NetworkPolicyReference struct {
@@ -216,6 +218,19 @@ type NetworkPolicy struct {
 	TierPriority *int32
 	// Reference to the original NetworkPolicy that the internal NetworkPolicy is created for.
 	SourceRef *NetworkPolicyReference
+	// RealizationStatus summarizes the realization of the NetworkPolicy on the Nodes it spans.
+	// It is only set for Antrea-native policies in the responses to get and list requests.
+	RealizationStatus *NetworkPolicyRealizationStatus
+}
+
+// NetworkPolicyRealizationStatus summarizes the realization of a NetworkPolicy on the Nodes it spans.
+type NetworkPolicyRealizationStatus struct {
+	// The number of Nodes that have realized the current generation of the NetworkPolicy.
+	CurrentNodesRealized int32
+	// The total number of Nodes that should realize the NetworkPolicy.
+	DesiredNodesRealized int32
+	// The statuses of the Nodes that failed to realize the current generation of the NetworkPolicy.
+	FailedNodes []NetworkPolicyNodeStatus
 }
 
 // Direction defines traffic direction of NetworkPolicyRule.
@@ -255,6 +270,18 @@ type NetworkPolicyRule struct {
 	// Cannot be set in conjunction with NetworkPolicy.AppliedToGroups of the NetworkPolicy
 	// that this Rule is referred to.
 	AppliedToGroups []string
+	// RateLimit specifies the maximum rate of the traffic matching this rule.
+	// It is set only when Action is RateLimit.
+	RateLimit *RateLimit
+}
+
+// RateLimit describes the maximum rate of the traffic matching a rule. Only one
+// of PacketsPerSecond and BitsPerSecond is set to a non-zero value.
+type RateLimit struct {
+	// PacketsPerSecond is the maximum number of packets per second.
+	PacketsPerSecond int32
+	// BitsPerSecond is the maximum number of bits per second.
+	BitsPerSecond int64
 }
 
 // Protocol defines network protocols supported for things like container ports.
@@ -309,7 +336,8 @@ type NetworkPolicyPeer struct {
 	// This field can only be possibly set for NetworkPolicyPeer of egress rules.
 	FQDNs []string
 	// A list of ServiceReference.
-	// This field can only be possibly set for NetworkPolicyPeer of egress rules.
+	// This field can only be possibly set for the To NetworkPolicyPeer of rules.
+	// For ingress rules, it refers to the Services through which the traffic arrives.
 	ToServices []ServiceReference
 }
 
@@ -348,6 +376,8 @@ type NodeStatsSummary struct {
 	AntreaNetworkPolicies []NetworkPolicyStats
 	// Multicast group information from the Node.
 	Multicast []MulticastGroupInfo
+	// The traffic stats of Pods collected from the Node.
+	PodTraffic []PodTrafficStats
 }
 
 // MulticastGroupInfo contains the list of Pods that have joined a multicast group, for a given Node.
@@ -368,6 +398,16 @@ type NetworkPolicyStats struct {
 	RuleTrafficStats []statsv1alpha1.RuleTrafficStats
 }
 
+// PodTrafficStats contains the information and traffic stats of a Pod.
+type PodTrafficStats struct {
+	// The reference of the Pod.
+	Pod PodReference
+	// The stats of the packets received by the Pod.
+	Ingress statsv1alpha1.TrafficBreakdown
+	// The stats of the packets sent by the Pod.
+	Egress statsv1alpha1.TrafficBreakdown
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // NetworkPolicyStatus is the status of a NetworkPolicy.
@@ -384,8 +424,24 @@ type NetworkPolicyNodeStatus struct {
 	NodeName string
 	// The generation realized by the Node.
 	Generation int64
+	// Reason is a brief CamelCase string that describes why the Node failed to realize the
+	// NetworkPolicy. It is empty if the NetworkPolicy is realized successfully.
+	Reason string
+	// Message is a human readable message indicating details about the failure.
+	Message string
 }
 
+// These are the reasons for which a Node can fail to realize a NetworkPolicy.
+const (
+	// NetworkPolicyFlowInstallationFailed means the OpenFlow flows of a rule failed to be installed.
+	NetworkPolicyFlowInstallationFailed = "FlowInstallationFailed"
+	// NetworkPolicyNamedPortUnresolved means a named port of a rule cannot be resolved for any of
+	// the rule's workloads.
+	NetworkPolicyNamedPortUnresolved = "NamedPortUnresolved"
+	// NetworkPolicyFQDNResolutionFailed means the FQDNs of a rule failed to be resolved.
+	NetworkPolicyFQDNResolutionFailed = "FQDNResolutionFailed"
+)
+
 type GroupReference struct {
 	// Namespace of the Group. Empty for ClusterGroup.
 	Namespace string
@@ -663,10 +663,38 @@ func (m *NetworkPolicyPeer) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NetworkPolicyPeer proto.InternalMessageInfo
 
+func (m *NetworkPolicyRealizationStatus) Reset()      { *m = NetworkPolicyRealizationStatus{} }
+func (*NetworkPolicyRealizationStatus) ProtoMessage() {}
+func (*NetworkPolicyRealizationStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{22}
+}
+func (m *NetworkPolicyRealizationStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NetworkPolicyRealizationStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NetworkPolicyRealizationStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkPolicyRealizationStatus.Merge(m, src)
+}
+func (m *NetworkPolicyRealizationStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *NetworkPolicyRealizationStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkPolicyRealizationStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetworkPolicyRealizationStatus proto.InternalMessageInfo
+
 func (m *NetworkPolicyReference) Reset()      { *m = NetworkPolicyReference{} }
 func (*NetworkPolicyReference) ProtoMessage() {}
 func (*NetworkPolicyReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{22}
+	return fileDescriptor_fbaa7d016762fa1d, []int{23}
 }
 func (m *NetworkPolicyReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -694,7 +722,7 @@ var xxx_messageInfo_NetworkPolicyReference proto.InternalMessageInfo
 func (m *NetworkPolicyRule) Reset()      { *m = NetworkPolicyRule{} }
 func (*NetworkPolicyRule) ProtoMessage() {}
 func (*NetworkPolicyRule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{23}
+	return fileDescriptor_fbaa7d016762fa1d, []int{24}
 }
 func (m *NetworkPolicyRule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -722,7 +750,7 @@ var xxx_messageInfo_NetworkPolicyRule proto.InternalMessageInfo
 func (m *NetworkPolicyStats) Reset()      { *m = NetworkPolicyStats{} }
 func (*NetworkPolicyStats) ProtoMessage() {}
 func (*NetworkPolicyStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{24}
+	return fileDescriptor_fbaa7d016762fa1d, []int{25}
 }
 func (m *NetworkPolicyStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -750,7 +778,7 @@ var xxx_messageInfo_NetworkPolicyStats proto.InternalMessageInfo
 func (m *NetworkPolicyStatus) Reset()      { *m = NetworkPolicyStatus{} }
 func (*NetworkPolicyStatus) ProtoMessage() {}
 func (*NetworkPolicyStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{25}
+	return fileDescriptor_fbaa7d016762fa1d, []int{26}
 }
 func (m *NetworkPolicyStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -778,7 +806,7 @@ var xxx_messageInfo_NetworkPolicyStatus proto.InternalMessageInfo
 func (m *NodeReference) Reset()      { *m = NodeReference{} }
 func (*NodeReference) ProtoMessage() {}
 func (*NodeReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{26}
+	return fileDescriptor_fbaa7d016762fa1d, []int{27}
 }
 func (m *NodeReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -806,7 +834,7 @@ var xxx_messageInfo_NodeReference proto.InternalMessageInfo
 func (m *NodeStatsSummary) Reset()      { *m = NodeStatsSummary{} }
 func (*NodeStatsSummary) ProtoMessage() {}
 func (*NodeStatsSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{27}
+	return fileDescriptor_fbaa7d016762fa1d, []int{28}
 }
 func (m *NodeStatsSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -834,7 +862,7 @@ var xxx_messageInfo_NodeStatsSummary proto.InternalMessageInfo
 func (m *PaginationGetOptions) Reset()      { *m = PaginationGetOptions{} }
 func (*PaginationGetOptions) ProtoMessage() {}
 func (*PaginationGetOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{28}
+	return fileDescriptor_fbaa7d016762fa1d, []int{29}
 }
 func (m *PaginationGetOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -862,7 +890,7 @@ var xxx_messageInfo_PaginationGetOptions proto.InternalMessageInfo
 func (m *PodReference) Reset()      { *m = PodReference{} }
 func (*PodReference) ProtoMessage() {}
 func (*PodReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{29}
+	return fileDescriptor_fbaa7d016762fa1d, []int{30}
 }
 func (m *PodReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -887,10 +915,66 @@ func (m *PodReference) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PodReference proto.InternalMessageInfo
 
+func (m *PodTrafficStats) Reset()      { *m = PodTrafficStats{} }
+func (*PodTrafficStats) ProtoMessage() {}
+func (*PodTrafficStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{31}
+}
+func (m *PodTrafficStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PodTrafficStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PodTrafficStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PodTrafficStats.Merge(m, src)
+}
+func (m *PodTrafficStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *PodTrafficStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_PodTrafficStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PodTrafficStats proto.InternalMessageInfo
+
+func (m *RateLimit) Reset()      { *m = RateLimit{} }
+func (*RateLimit) ProtoMessage() {}
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{32}
+}
+func (m *RateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimit.Merge(m, src)
+}
+func (m *RateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimit proto.InternalMessageInfo
+
 func (m *Service) Reset()      { *m = Service{} }
 func (*Service) ProtoMessage() {}
 func (*Service) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{30}
+	return fileDescriptor_fbaa7d016762fa1d, []int{33}
 }
 func (m *Service) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -918,7 +1002,7 @@ var xxx_messageInfo_Service proto.InternalMessageInfo
 func (m *ServiceReference) Reset()      { *m = ServiceReference{} }
 func (*ServiceReference) ProtoMessage() {}
 func (*ServiceReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{31}
+	return fileDescriptor_fbaa7d016762fa1d, []int{34}
 }
 func (m *ServiceReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -966,6 +1050,7 @@ func init() {
 	proto.RegisterType((*NetworkPolicyList)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.NetworkPolicyList")
 	proto.RegisterType((*NetworkPolicyNodeStatus)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.NetworkPolicyNodeStatus")
 	proto.RegisterType((*NetworkPolicyPeer)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.NetworkPolicyPeer")
+	proto.RegisterType((*NetworkPolicyRealizationStatus)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.NetworkPolicyRealizationStatus")
 	proto.RegisterType((*NetworkPolicyReference)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.NetworkPolicyReference")
 	proto.RegisterType((*NetworkPolicyRule)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.NetworkPolicyRule")
 	proto.RegisterType((*NetworkPolicyStats)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.NetworkPolicyStats")
@@ -974,6 +1059,8 @@ func init() {
 	proto.RegisterType((*NodeStatsSummary)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.NodeStatsSummary")
 	proto.RegisterType((*PaginationGetOptions)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.PaginationGetOptions")
 	proto.RegisterType((*PodReference)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.PodReference")
+	proto.RegisterType((*PodTrafficStats)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.PodTrafficStats")
+	proto.RegisterType((*RateLimit)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.RateLimit")
 	proto.RegisterType((*Service)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.Service")
 	proto.RegisterType((*ServiceReference)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.ServiceReference")
 }
@@ -983,143 +1070,161 @@ func init() {
 }
 
 var fileDescriptor_fbaa7d016762fa1d = []byte{
-	// 2161 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x1a, 0xcd, 0x6f, 0x1b, 0x59,
-	0xbd, 0xe3, 0x8f, 0x24, 0xfe, 0xd9, 0x69, 0x9d, 0x97, 0x96, 0x9a, 0xa5, 0xd8, 0xdd, 0x59, 0x40,
-	0x3d, 0xc0, 0x78, 0x13, 0xda, 0x6d, 0x60, 0xb7, 0x0b, 0x71, 0x92, 0x46, 0x96, 0xda, 0xd4, 0xbc,
-	0x64, 0x55, 0x09, 0xe8, 0xb2, 0x93, 0x99, 0x67, 0x67, 0x88, 0x3d, 0x6f, 0x98, 0x79, 0x0e, 0x8d,
-	0x90, 0xd0, 0x22, 0xe0, 0xb0, 0x80, 0x04, 0x37, 0xc4, 0x0d, 0x4e, 0x5c, 0xf8, 0x0b, 0xb8, 0x71,
-	0xab, 0x38, 0xed, 0x0a, 0x21, 0xf6, 0x64, 0x51, 0x23, 0x40, 0x1c, 0xe0, 0x0f, 0x08, 0x17, 0xf4,
-	0xde, 0xbc, 0x99, 0x79, 0x63, 0x27, 0x4d, 0x9d, 0xa4, 0x41, 0x82, 0x3d, 0xc5, 0x7e, 0xbf, 0xef,
-	0xf7, 0xfb, 0x7e, 0x0e, 0xbc, 0x69, 0xba, 0xcc, 0x27, 0xa6, 0xe1, 0xd0, 0x7a, 0xf8, 0xa9, 0xee,
-	0xed, 0x76, 0xea, 0xa6, 0xe7, 0x04, 0x75, 0x8b, 0xba, 0xcc, 0xa7, 0x5d, 0xaf, 0x6b, 0xba, 0xa4,
-	0xbe, 0xb7, 0xb0, 0x4d, 0x98, 0xb9, 0x58, 0xef, 0x10, 0x97, 0xf8, 0x26, 0x23, 0xb6, 0xe1, 0xf9,
-	0x94, 0x51, 0x64, 0x84, 0x54, 0xdf, 0x70, 0xa8, 0xfc, 0x64, 0x78, 0xbb, 0x1d, 0x83, 0xd3, 0x1b,
-	0x2a, 0xbd, 0x21, 0xe9, 0x5f, 0x5a, 0x3a, 0x5a, 0x5e, 0xc0, 0x4c, 0x16, 0xd4, 0xf7, 0x16, 0xcc,
-	0xae, 0xb7, 0x63, 0x2e, 0x8c, 0x4a, 0x7a, 0xe9, 0x73, 0x1d, 0x87, 0xed, 0xf4, 0xb7, 0x0d, 0x8b,
-	0xf6, 0xea, 0x1d, 0xda, 0xa1, 0x75, 0x71, 0xbc, 0xdd, 0x6f, 0x8b, 0x6f, 0xe2, 0x8b, 0xf8, 0x24,
-	0xd1, 0x6f, 0xee, 0x2e, 0x05, 0x42, 0x8a, 0xe7, 0xf4, 0x4c, 0x6b, 0xc7, 0x71, 0x89, 0xbf, 0x9f,
-	0xc8, 0xea, 0x11, 0x66, 0xd6, 0xf7, 0xc6, 0x85, 0xd4, 0x8f, 0xa2, 0xf2, 0xfb, 0x2e, 0x73, 0x7a,
-	0x64, 0x8c, 0xe0, 0xb5, 0xe3, 0x08, 0x02, 0x6b, 0x87, 0xf4, 0xcc, 0x31, 0xba, 0xcf, 0x1f, 0x45,
-	0xd7, 0x67, 0x4e, 0xb7, 0xee, 0xb8, 0x2c, 0x60, 0xfe, 0x28, 0x91, 0xfe, 0x77, 0x0d, 0x4a, 0xcb,
-	0xb6, 0xed, 0x93, 0x20, 0x58, 0xf7, 0x69, 0xdf, 0x43, 0xef, 0xc0, 0x0c, 0xb7, 0xc4, 0x36, 0x99,
-	0x59, 0xd1, 0xae, 0x6b, 0x37, 0x8a, 0x8b, 0xaf, 0x1a, 0x21, 0x63, 0x43, 0x65, 0x9c, 0xf8, 0x84,
-	0x63, 0x1b, 0x7b, 0x0b, 0xc6, 0x83, 0xed, 0x6f, 0x12, 0x8b, 0xdd, 0x27, 0xcc, 0x6c, 0xa0, 0x27,
-	0x83, 0xda, 0x85, 0xe1, 0xa0, 0x06, 0xc9, 0x19, 0x8e, 0xb9, 0xa2, 0x3e, 0x94, 0x3a, 0x5c, 0xd4,
-	0x7d, 0xd2, 0xdb, 0x26, 0x7e, 0x50, 0xc9, 0x5c, 0xcf, 0xde, 0x28, 0x2e, 0xbe, 0x3e, 0xa1, 0xdb,
-	0x8d, 0xf5, 0x84, 0x47, 0xe3, 0xb2, 0x14, 0x58, 0x52, 0x0e, 0x03, 0x9c, 0x12, 0xa3, 0xff, 0x41,
-	0x83, 0xb2, 0x6a, 0xe9, 0x3d, 0x27, 0x60, 0xe8, 0xeb, 0x63, 0xd6, 0x1a, 0xcf, 0x67, 0x2d, 0xa7,
-	0x16, 0xb6, 0x96, 0xa5, 0xe8, 0x99, 0xe8, 0x44, 0xb1, 0xd4, 0x84, 0xbc, 0xc3, 0x48, 0x2f, 0x32,
-	0xf1, 0x8d, 0x49, 0x4d, 0x54, 0xd5, 0x6d, 0xcc, 0x4a, 0x41, 0xf9, 0x26, 0x67, 0x89, 0x43, 0xce,
-	0xfa, 0x7b, 0x59, 0x98, 0x53, 0xd1, 0x5a, 0x26, 0xb3, 0x76, 0xce, 0xc1, 0x89, 0x3f, 0xd0, 0x60,
-	0xce, 0xb4, 0x6d, 0x62, 0xaf, 0x9f, 0xb1, 0x2b, 0x3f, 0x2e, 0xc5, 0x72, 0xab, 0xd2, 0xdc, 0xf1,
-	0xb8, 0x40, 0xf4, 0x23, 0x0d, 0xe6, 0x7d, 0xd2, 0xa3, 0x7b, 0x23, 0x8a, 0x64, 0x4f, 0xaf, 0xc8,
-	0x27, 0xa4, 0x22, 0xf3, 0x78, 0x9c, 0x3f, 0x3e, 0x4c, 0xa8, 0xfe, 0x0f, 0x0d, 0x2e, 0x2e, 0x7b,
-	0x5e, 0xd7, 0x21, 0xf6, 0x16, 0xfd, 0x1f, 0xcf, 0xa6, 0x3f, 0x69, 0x80, 0xd2, 0xb6, 0x9e, 0x43,
-	0x3e, 0x59, 0xe9, 0x7c, 0x7a, 0x73, 0xe2, 0x7c, 0x4a, 0x29, 0x7c, 0x44, 0x46, 0xfd, 0x38, 0x0b,
-	0xf3, 0x69, 0xc4, 0x8f, 0x72, 0xea, 0xbf, 0x97, 0x53, 0xbf, 0xcc, 0xc1, 0xfc, 0x4a, 0xb7, 0x1f,
-	0x30, 0xe2, 0xa7, 0x94, 0x7c, 0xf1, 0xde, 0xf8, 0x9e, 0x06, 0x65, 0xd2, 0x6e, 0x13, 0x8b, 0x39,
-	0x7b, 0xe4, 0x0c, 0x9d, 0x51, 0x91, 0x52, 0xcb, 0x6b, 0x23, 0xcc, 0xf1, 0x98, 0x38, 0xf4, 0x5d,
-	0x98, 0x8b, 0xcf, 0x9a, 0xad, 0x46, 0x97, 0x5a, 0xbb, 0x91, 0x1f, 0x6e, 0x4d, 0xaa, 0x43, 0xb3,
-	0xb5, 0x41, 0x58, 0x12, 0x0a, 0x6b, 0xa3, 0x7c, 0xf1, 0xb8, 0x28, 0xb4, 0x04, 0x25, 0x46, 0x99,
-	0xd9, 0x8d, 0xcc, 0xcf, 0x5d, 0xd7, 0x6e, 0x64, 0x93, 0xfa, 0xb0, 0xa5, 0xc0, 0x70, 0x0a, 0x13,
-	0x2d, 0x02, 0x88, 0xef, 0x2d, 0xb3, 0x43, 0x82, 0x4a, 0x5e, 0xd0, 0xc5, 0xf7, 0xbd, 0x15, 0x43,
-	0xb0, 0x82, 0x85, 0x6e, 0x41, 0xd1, 0xea, 0xfb, 0x3e, 0x71, 0x19, 0xff, 0x5e, 0x99, 0x12, 0x44,
-	0xf3, 0x92, 0xa8, 0xb8, 0x92, 0x80, 0xb0, 0x8a, 0xa7, 0xff, 0x4d, 0x83, 0xe2, 0x5a, 0xe7, 0xff,
-	0x60, 0x82, 0xf9, 0x40, 0x83, 0x4b, 0x8a, 0xa1, 0xe7, 0x50, 0x70, 0xdf, 0x49, 0x17, 0xdc, 0x89,
-	0x2d, 0x54, 0xb4, 0x3d, 0xa2, 0xda, 0xfe, 0x24, 0x0b, 0x65, 0x05, 0x2b, 0x2c, 0xb5, 0x36, 0x00,
-	0x8d, 0xef, 0xfd, 0x4c, 0x7d, 0xa8, 0xf0, 0xfd, 0xa8, 0xdc, 0x1e, 0x52, 0x6e, 0xbb, 0x70, 0x75,
-	0xed, 0x31, 0x23, 0xbe, 0x6b, 0x76, 0xd7, 0x5c, 0xe6, 0xb0, 0x7d, 0x4c, 0xda, 0xc4, 0x27, 0xae,
-	0x45, 0xd0, 0x75, 0xc8, 0xb9, 0x66, 0x8f, 0x08, 0x77, 0x14, 0x1a, 0x25, 0xc9, 0x3a, 0xb7, 0x61,
-	0xf6, 0x08, 0x16, 0x10, 0x54, 0x87, 0x02, 0xff, 0x1b, 0x78, 0xa6, 0x45, 0x2a, 0x19, 0x81, 0x36,
-	0x27, 0xd1, 0x0a, 0x1b, 0x11, 0x00, 0x27, 0x38, 0xfa, 0xbf, 0x35, 0x28, 0x0b, 0xf1, 0xcb, 0x41,
-	0x40, 0x2d, 0xc7, 0x64, 0x0e, 0x75, 0xcf, 0xa7, 0xcf, 0x96, 0x4d, 0x29, 0x51, 0xda, 0x7f, 0xe2,
-	0x91, 0x42, 0x50, 0xc7, 0x97, 0x94, 0x14, 0xf7, 0xe5, 0x11, 0xfe, 0x78, 0x4c, 0xa2, 0xfe, 0x41,
-	0x16, 0x8a, 0xca, 0xe5, 0xa3, 0x87, 0x90, 0xf5, 0xa8, 0x2d, 0x6d, 0x9e, 0x78, 0x57, 0x68, 0x51,
-	0x3b, 0x51, 0x63, 0x7a, 0x38, 0xa8, 0x65, 0xf9, 0x09, 0xe7, 0x88, 0xbe, 0xaf, 0xc1, 0x45, 0x92,
-	0xf2, 0xaa, 0xf0, 0x4e, 0x71, 0x71, 0x7d, 0xe2, 0x7c, 0x3e, 0x3c, 0x36, 0x1a, 0x68, 0x38, 0xa8,
-	0x5d, 0x1c, 0x01, 0x8e, 0x88, 0x44, 0x9f, 0x81, 0xac, 0xe3, 0x85, 0x61, 0x5d, 0x6a, 0x5c, 0xe6,
-	0x0a, 0x36, 0x5b, 0xc1, 0xc1, 0xa0, 0x56, 0x68, 0xb6, 0xe4, 0x02, 0x83, 0x39, 0x02, 0x7a, 0x1b,
-	0xf2, 0x1e, 0xf5, 0x19, 0x6f, 0x36, 0xdc, 0x23, 0x5f, 0x98, 0x54, 0x47, 0x1e, 0x69, 0x76, 0x8b,
-	0xfa, 0x2c, 0xa9, 0x38, 0xfc, 0x5b, 0x80, 0x43, 0xb6, 0xe8, 0x6b, 0x90, 0x73, 0xa9, 0x4d, 0x44,
-	0x4f, 0x2a, 0x2e, 0xde, 0x99, 0x98, 0x3d, 0xb5, 0x49, 0x62, 0xf8, 0x8c, 0x48, 0x01, 0x7e, 0x24,
-	0x98, 0xea, 0xbf, 0xd6, 0xe0, 0x62, 0x3a, 0x24, 0xd2, 0x59, 0xa1, 0x1d, 0x9f, 0x15, 0x71, 0xa2,
-	0x65, 0x8e, 0x4c, 0xb4, 0x06, 0x64, 0xfb, 0x8e, 0x5d, 0xc9, 0x0a, 0x84, 0x57, 0x25, 0x42, 0xf6,
-	0xad, 0xe6, 0xea, 0xc1, 0xa0, 0xf6, 0xf2, 0x51, 0xaf, 0x00, 0x6c, 0xdf, 0x23, 0x81, 0xf1, 0x56,
-	0x73, 0x15, 0x73, 0x62, 0xfd, 0x77, 0x1a, 0x4c, 0xcb, 0x3e, 0x8f, 0x1e, 0x42, 0xce, 0x72, 0x6c,
-	0x5f, 0x86, 0xde, 0x09, 0x27, 0x8b, 0x58, 0xd1, 0x95, 0xe6, 0x2a, 0xc6, 0x82, 0x21, 0x7a, 0x04,
-	0x53, 0xe4, 0xb1, 0x45, 0x3c, 0x26, 0xd3, 0xeb, 0x84, 0xac, 0x2f, 0x4a, 0xd6, 0x53, 0x6b, 0x82,
-	0x19, 0x96, 0x4c, 0xf5, 0x36, 0xe4, 0x05, 0x02, 0x7a, 0x05, 0x32, 0x8e, 0x27, 0xd4, 0x2f, 0x35,
-	0xe6, 0x87, 0x83, 0x5a, 0xa6, 0xd9, 0x4a, 0x47, 0x56, 0xc6, 0xf1, 0xf8, 0x30, 0xe3, 0xf9, 0xa4,
-	0xed, 0x3c, 0xbe, 0x47, 0xdc, 0x0e, 0xdb, 0x11, 0xf7, 0x9b, 0x4f, 0x1a, 0x6f, 0x4b, 0x81, 0xe1,
-	0x14, 0xa6, 0xfe, 0x0b, 0x0d, 0xd0, 0xfd, 0x7e, 0x97, 0x39, 0x96, 0x19, 0x30, 0xe1, 0xde, 0xa6,
-	0xdb, 0xa6, 0xe8, 0x15, 0xc8, 0x8b, 0xfe, 0x2c, 0xbd, 0x1a, 0x87, 0x5b, 0x18, 0x00, 0x21, 0x0c,
-	0xbd, 0x0d, 0x39, 0x8f, 0xda, 0x27, 0x7e, 0x02, 0x48, 0xa5, 0x75, 0x7c, 0xc5, 0x2d, 0x6a, 0x07,
-	0x58, 0xf0, 0xd5, 0xdf, 0xd3, 0xa0, 0x10, 0x87, 0x3c, 0x8f, 0x1d, 0x1e, 0xe5, 0x42, 0xa3, 0xbc,
-	0x8a, 0xef, 0x33, 0x2c, 0x20, 0xcf, 0x11, 0x5d, 0x4b, 0x30, 0x23, 0xde, 0x86, 0x2c, 0xda, 0x95,
-	0x21, 0x76, 0x2d, 0x1a, 0x11, 0x5a, 0xf2, 0xfc, 0x40, 0xf9, 0x8c, 0x63, 0x6c, 0xfd, 0x9f, 0x59,
-	0x98, 0xdd, 0x20, 0xec, 0xdb, 0xd4, 0xdf, 0x6d, 0xd1, 0xae, 0x63, 0xed, 0x9f, 0x43, 0x31, 0x6f,
-	0x43, 0xde, 0xef, 0x77, 0x49, 0x74, 0xc1, 0xcb, 0x13, 0xe7, 0xb3, 0xaa, 0x2f, 0xee, 0x77, 0x49,
-	0xe2, 0x47, 0xfe, 0x2d, 0xc0, 0x21, 0x7b, 0x74, 0x07, 0x2e, 0x99, 0xa9, 0xad, 0x30, 0x2c, 0x65,
-	0x05, 0x11, 0x6f, 0x97, 0xd2, 0x0b, 0x63, 0x80, 0x47, 0x71, 0xd1, 0x0d, 0x7e, 0xa9, 0x0e, 0xf5,
-	0x79, 0xf1, 0xe5, 0x53, 0xb4, 0xd6, 0x28, 0x85, 0x17, 0x1a, 0x9e, 0xe1, 0x18, 0x8a, 0x6e, 0x42,
-	0x89, 0x39, 0xc4, 0x8f, 0x20, 0xa2, 0x4e, 0xe5, 0x1b, 0x65, 0x31, 0x6f, 0x2b, 0xe7, 0x38, 0x85,
-	0x85, 0x02, 0x28, 0x04, 0xb4, 0xef, 0x5b, 0xbc, 0x36, 0x89, 0xc9, 0xb9, 0xb8, 0x78, 0xf7, 0x74,
-	0x57, 0x11, 0x47, 0xdd, 0x2c, 0xaf, 0x54, 0x9b, 0x11, 0x73, 0x9c, 0xc8, 0xd1, 0xff, 0xa8, 0xc1,
-	0x5c, 0x8a, 0xe8, 0x1c, 0x46, 0xd2, 0xed, 0xf4, 0x48, 0x7a, 0xe7, 0x54, 0x46, 0x1e, 0x31, 0x94,
-	0x7e, 0x07, 0xae, 0xa6, 0xd0, 0x78, 0x81, 0xdf, 0x64, 0x26, 0xeb, 0x07, 0xe8, 0xb3, 0x30, 0xc3,
-	0x0b, 0xfd, 0x46, 0x32, 0x09, 0xc5, 0xca, 0x6e, 0xc8, 0x73, 0x1c, 0x63, 0xf0, 0x2d, 0x48, 0x3e,
-	0xb8, 0x3a, 0xd4, 0x15, 0x29, 0xa7, 0x6c, 0x41, 0xeb, 0x31, 0x04, 0x2b, 0x58, 0xfa, 0xef, 0x33,
-	0x23, 0x97, 0xda, 0x22, 0xc4, 0x47, 0xb7, 0x61, 0xd6, 0x54, 0x9e, 0xf9, 0x82, 0x8a, 0x26, 0x82,
-	0x6f, 0x6e, 0x38, 0xa8, 0xcd, 0xaa, 0xef, 0x7f, 0x01, 0x4e, 0xe3, 0x21, 0x02, 0x33, 0x8e, 0x27,
-	0x37, 0xc7, 0xf0, 0xca, 0x6e, 0x4f, 0x5e, 0x84, 0x05, 0x7d, 0x62, 0x69, 0xbc, 0x32, 0xc6, 0xac,
-	0x51, 0x0d, 0xf2, 0xed, 0x6f, 0xd9, 0x6e, 0x94, 0x14, 0x05, 0x7e, 0xa7, 0x77, 0xbf, 0xb2, 0xba,
-	0x11, 0xe0, 0xf0, 0x1c, 0x31, 0xbe, 0x10, 0x6e, 0x12, 0x7f, 0xcf, 0xb1, 0x48, 0xd4, 0xdb, 0xbf,
-	0x3c, 0xa9, 0x26, 0x92, 0x5e, 0x19, 0x3c, 0x92, 0x95, 0x32, 0xe2, 0x8d, 0x15, 0x39, 0x7c, 0x37,
-	0xfc, 0xd8, 0xe1, 0x61, 0x8d, 0x6e, 0x41, 0x8e, 0xb7, 0x44, 0xe9, 0xc5, 0x97, 0xa3, 0x42, 0xb8,
-	0xb5, 0xef, 0x91, 0x83, 0x41, 0x2d, 0xed, 0x02, 0x7e, 0x88, 0x05, 0xfa, 0xc4, 0x43, 0x6e, 0x5c,
-	0x70, 0xb3, 0xc7, 0xb5, 0xf3, 0xdc, 0x69, 0xda, 0xf9, 0xaf, 0xf2, 0x23, 0x51, 0xc3, 0x8b, 0x17,
-	0x7a, 0x03, 0x0a, 0xb6, 0xe3, 0xf3, 0xa5, 0x9e, 0xba, 0xd2, 0xd0, 0x6a, 0xa4, 0xec, 0x6a, 0x04,
-	0x38, 0x50, 0xbf, 0xe0, 0x84, 0x00, 0x59, 0x90, 0x6b, 0xfb, 0xb4, 0x27, 0x87, 0xc5, 0xd3, 0x55,
-	0x56, 0x1e, 0xc4, 0x89, 0xf1, 0x77, 0x7d, 0xda, 0xc3, 0x82, 0x39, 0x7a, 0x04, 0x19, 0x46, 0xc5,
-	0xe5, 0x9c, 0x89, 0x08, 0x90, 0x22, 0x32, 0x5b, 0x14, 0x67, 0x18, 0xe5, 0xe1, 0x1f, 0xa4, 0x83,
-	0xee, 0xf6, 0x09, 0x83, 0x2e, 0x09, 0xff, 0x38, 0xd2, 0x62, 0xd6, 0xbc, 0x2c, 0x78, 0x23, 0x05,
-	0x3b, 0xe9, 0x99, 0x63, 0x25, 0xfe, 0x21, 0x4c, 0x99, 0xa1, 0x4f, 0xa6, 0x84, 0x4f, 0xbe, 0xc4,
-	0x67, 0x9b, 0xe5, 0xc8, 0x19, 0x0b, 0xcf, 0xf8, 0xfd, 0xcc, 0xb7, 0xe3, 0x5f, 0xb3, 0x0c, 0xee,
-	0xe1, 0x90, 0x08, 0x4b, 0x76, 0xe8, 0x75, 0x98, 0x25, 0xae, 0xb9, 0xdd, 0x25, 0xf7, 0x68, 0xa7,
-	0xe3, 0xb8, 0x9d, 0xca, 0xf4, 0x75, 0xed, 0xc6, 0x4c, 0xe3, 0x8a, 0xd4, 0x65, 0x76, 0x4d, 0x05,
-	0xe2, 0x34, 0xee, 0x61, 0x1d, 0x6e, 0x66, 0x82, 0x0e, 0x17, 0xc5, 0x79, 0xe1, 0xa8, 0x38, 0xd7,
-	0x7f, 0x9a, 0x05, 0x94, 0xf2, 0x18, 0xaf, 0xa9, 0x01, 0x5f, 0x4f, 0x66, 0x5d, 0xf5, 0x58, 0x76,
-	0x8d, 0xb3, 0xea, 0x5f, 0xb1, 0xf5, 0x69, 0x78, 0x5a, 0x26, 0xf2, 0xa0, 0xc4, 0x7c, 0xb3, 0xdd,
-	0x76, 0x2c, 0xa1, 0x95, 0x0c, 0xfa, 0xd7, 0x9e, 0xa1, 0x83, 0xf8, 0x71, 0xd1, 0x88, 0xdd, 0xb1,
-	0xa5, 0x50, 0x2b, 0x4f, 0x64, 0xca, 0x29, 0x4e, 0x49, 0x40, 0xef, 0x6a, 0x50, 0xe6, 0xb3, 0x85,
-	0x8a, 0x22, 0xb7, 0xfe, 0x2f, 0x3e, 0xbf, 0x58, 0x3c, 0xc2, 0x21, 0x59, 0x41, 0x47, 0x21, 0x78,
-	0x4c, 0x9a, 0xfe, 0x57, 0x0d, 0xe6, 0xc7, 0x3c, 0xd2, 0x3f, 0x8f, 0xd7, 0xd5, 0x2e, 0xe4, 0x79,
-	0x97, 0x8c, 0x7a, 0xd2, 0xfa, 0xa9, 0x7c, 0x9d, 0xf4, 0xe7, 0xa4, 0xa1, 0xf3, 0xb3, 0x00, 0x87,
-	0x42, 0xf4, 0x05, 0x98, 0x4d, 0xed, 0x6d, 0xc7, 0x3f, 0x66, 0xe8, 0xbf, 0xcd, 0x43, 0x39, 0xe2,
-	0x1b, 0x6c, 0xf6, 0x7b, 0x3d, 0xd3, 0x3f, 0x8f, 0x71, 0xf6, 0x87, 0x1a, 0x5c, 0x52, 0x03, 0xd3,
-	0x89, 0xaf, 0xa8, 0x71, 0xaa, 0x2b, 0x0a, 0x63, 0xe3, 0xaa, 0x94, 0x7d, 0x69, 0x23, 0x2d, 0x02,
-	0x8f, 0xca, 0x44, 0xbf, 0xd1, 0xe0, 0x5a, 0x28, 0x45, 0xbe, 0xbe, 0x8f, 0x50, 0xc8, 0x40, 0x3d,
-	0x0b, 0xa5, 0x3e, 0x25, 0x95, 0xba, 0xb6, 0xfc, 0x0c, 0x79, 0xf8, 0x99, 0xda, 0xa0, 0x9f, 0x6b,
-	0x70, 0x25, 0x44, 0x18, 0xd5, 0x33, 0x77, 0x66, 0x7a, 0x7e, 0x52, 0xea, 0x79, 0x65, 0xf9, 0x30,
-	0x41, 0xf8, 0x70, 0xf9, 0x7c, 0x30, 0xef, 0x45, 0xab, 0x63, 0x25, 0x7f, 0x32, 0x65, 0xc6, 0x77,
-	0xcf, 0x64, 0xe6, 0x88, 0x61, 0x38, 0x91, 0xa3, 0x3f, 0x82, 0xcb, 0x2d, 0xb3, 0xe3, 0xb8, 0x62,
-	0xa2, 0x5c, 0x27, 0xec, 0x81, 0xc7, 0x3f, 0x88, 0x1a, 0xed, 0x99, 0x9d, 0x30, 0xec, 0xb3, 0xca,
-	0x7a, 0x68, 0x76, 0x08, 0x16, 0x10, 0xbe, 0xd3, 0x76, 0x9d, 0x9e, 0xc3, 0xe4, 0xb0, 0x1a, 0xa7,
-	0xd3, 0x3d, 0x7e, 0x88, 0x43, 0x98, 0x6e, 0x42, 0x49, 0xdd, 0x4b, 0x5f, 0xc4, 0xd3, 0xe0, 0xbf,
-	0x32, 0x30, 0x2d, 0xfb, 0x2c, 0xba, 0xa9, 0x2c, 0xa4, 0xa1, 0x88, 0xca, 0xf1, 0xcb, 0x28, 0xda,
-	0x90, 0xab, 0x70, 0xe6, 0x98, 0x3c, 0xed, 0x33, 0xa7, 0x6b, 0x84, 0xff, 0x1d, 0x61, 0x34, 0x5d,
-	0xf6, 0xc0, 0xdf, 0x64, 0xbe, 0xe3, 0x76, 0xc2, 0xa7, 0x1d, 0x65, 0x71, 0xfe, 0x34, 0x4c, 0x13,
-	0x57, 0x6c, 0xd9, 0x62, 0x5a, 0xc9, 0x37, 0x8a, 0xc3, 0x41, 0x6d, 0x7a, 0x2d, 0x3c, 0xc2, 0x11,
-	0x8c, 0x2f, 0x7a, 0x8e, 0xd5, 0xf3, 0xf8, 0xc4, 0x28, 0x26, 0xba, 0x7c, 0xb8, 0xe8, 0x35, 0x57,
-	0xee, 0xb7, 0xc4, 0x14, 0x19, 0x43, 0x23, 0xcc, 0x95, 0xe8, 0x31, 0x4a, 0xc1, 0xe4, 0x67, 0x38,
-	0x86, 0x0a, 0xcc, 0x8e, 0xe4, 0x39, 0xa5, 0x60, 0xae, 0xc7, 0x3c, 0x25, 0x14, 0x2d, 0xc9, 0x5f,
-	0x26, 0xe4, 0x4a, 0x20, 0xfa, 0x7f, 0x61, 0xe4, 0xc7, 0x85, 0xe8, 0x4d, 0x24, 0x85, 0xa9, 0x13,
-	0x28, 0x8f, 0x4e, 0xd7, 0x2f, 0xc0, 0xaf, 0x8d, 0xad, 0x27, 0x4f, 0xab, 0x17, 0xde, 0x7f, 0x5a,
-	0xbd, 0xf0, 0xe1, 0xd3, 0xea, 0x85, 0x77, 0x87, 0x55, 0xed, 0xc9, 0xb0, 0xaa, 0xbd, 0x3f, 0xac,
-	0x6a, 0x1f, 0x0e, 0xab, 0xda, 0x9f, 0x87, 0x55, 0xed, 0x67, 0x7f, 0xa9, 0x5e, 0xf8, 0xaa, 0x31,
-	0xd9, 0xbf, 0x10, 0xfd, 0x27, 0x00, 0x00, 0xff, 0xff, 0x31, 0xcc, 0xfd, 0xfc, 0x73, 0x24, 0x00,
-	0x00,
+	// 2453 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3a, 0xcf, 0x6f, 0x5c, 0x47,
+	0xfd, 0x79, 0xfb, 0xc3, 0xf6, 0x7e, 0x76, 0x1d, 0xaf, 0xc7, 0xc9, 0x37, 0xfb, 0x2d, 0x61, 0x9d,
+	0xbe, 0x42, 0x15, 0x24, 0xd8, 0xad, 0x4d, 0xd2, 0x84, 0xb6, 0x69, 0xf1, 0xda, 0x8e, 0xb5, 0x52,
+	0xe2, 0x2c, 0x63, 0x57, 0x91, 0x28, 0x29, 0x7d, 0x7e, 0x6f, 0x76, 0x3d, 0x78, 0xf7, 0xbd, 0xc7,
+	0xbc, 0x59, 0x37, 0xe1, 0x50, 0x15, 0x15, 0x0e, 0x05, 0x04, 0x48, 0x1c, 0x10, 0x37, 0x6e, 0x5c,
+	0x90, 0x38, 0x73, 0x44, 0xe2, 0x10, 0x71, 0x40, 0xad, 0x10, 0xa2, 0x27, 0x8b, 0x2c, 0x02, 0xc4,
+	0x85, 0x3f, 0xc0, 0x5c, 0xd0, 0xcc, 0x9b, 0xf7, 0x73, 0xd7, 0x76, 0xd7, 0x76, 0x8c, 0x04, 0x3d,
+	0x79, 0x77, 0x3e, 0x3f, 0x67, 0x3e, 0xbf, 0x3f, 0x6b, 0x78, 0xd5, 0xb0, 0x39, 0x23, 0x46, 0x8d,
+	0x3a, 0x75, 0xff, 0x53, 0xdd, 0xdd, 0xe9, 0xd4, 0x0d, 0x97, 0x7a, 0x75, 0xd3, 0xb1, 0x39, 0x73,
+	0xba, 0x6e, 0xd7, 0xb0, 0x49, 0x7d, 0x77, 0x61, 0x8b, 0x70, 0x63, 0xb1, 0xde, 0x21, 0x36, 0x61,
+	0x06, 0x27, 0x56, 0xcd, 0x65, 0x0e, 0x77, 0x50, 0xcd, 0xa7, 0xfa, 0x3a, 0x75, 0xd4, 0xa7, 0x9a,
+	0xbb, 0xd3, 0xa9, 0x09, 0xfa, 0x5a, 0x9c, 0xbe, 0xa6, 0xe8, 0x9f, 0xb9, 0x79, 0xb0, 0x3c, 0x8f,
+	0x1b, 0xdc, 0xab, 0xef, 0x2e, 0x18, 0x5d, 0x77, 0xdb, 0x58, 0x48, 0x4b, 0x7a, 0xe6, 0x0b, 0x1d,
+	0xca, 0xb7, 0xfb, 0x5b, 0x35, 0xd3, 0xe9, 0xd5, 0x3b, 0x4e, 0xc7, 0xa9, 0xcb, 0xe3, 0xad, 0x7e,
+	0x5b, 0x7e, 0x93, 0x5f, 0xe4, 0x27, 0x85, 0x7e, 0x6d, 0xe7, 0xa6, 0x27, 0xa5, 0xb8, 0xb4, 0x67,
+	0x98, 0xdb, 0xd4, 0x26, 0xec, 0x51, 0x24, 0xab, 0x47, 0xb8, 0x51, 0xdf, 0x1d, 0x16, 0x52, 0x3f,
+	0x88, 0x8a, 0xf5, 0x6d, 0x4e, 0x7b, 0x64, 0x88, 0xe0, 0xc5, 0xa3, 0x08, 0x3c, 0x73, 0x9b, 0xf4,
+	0x8c, 0x21, 0xba, 0x2f, 0x1e, 0x44, 0xd7, 0xe7, 0xb4, 0x5b, 0xa7, 0x36, 0xf7, 0x38, 0x4b, 0x13,
+	0xe9, 0x7f, 0xd7, 0xa0, 0xb4, 0x64, 0x59, 0x8c, 0x78, 0xde, 0x1a, 0x73, 0xfa, 0x2e, 0x7a, 0x0b,
+	0xa6, 0xc4, 0x4d, 0x2c, 0x83, 0x1b, 0x15, 0xed, 0x8a, 0x76, 0xb5, 0xb8, 0xf8, 0x42, 0xcd, 0x67,
+	0x5c, 0x8b, 0x33, 0x8e, 0x6c, 0x22, 0xb0, 0x6b, 0xbb, 0x0b, 0xb5, 0x7b, 0x5b, 0xdf, 0x20, 0x26,
+	0xbf, 0x4b, 0xb8, 0xd1, 0x40, 0x8f, 0xf7, 0xe6, 0xcf, 0x0d, 0xf6, 0xe6, 0x21, 0x3a, 0xc3, 0x21,
+	0x57, 0xd4, 0x87, 0x52, 0x47, 0x88, 0xba, 0x4b, 0x7a, 0x5b, 0x84, 0x79, 0x95, 0xcc, 0x95, 0xec,
+	0xd5, 0xe2, 0xe2, 0xcb, 0x63, 0x9a, 0xbd, 0xb6, 0x16, 0xf1, 0x68, 0x5c, 0x50, 0x02, 0x4b, 0xb1,
+	0x43, 0x0f, 0x27, 0xc4, 0xe8, 0x7f, 0xd0, 0xa0, 0x1c, 0xbf, 0xe9, 0x1d, 0xea, 0x71, 0xf4, 0xb5,
+	0xa1, 0xdb, 0xd6, 0x3e, 0xde, 0x6d, 0x05, 0xb5, 0xbc, 0x6b, 0x59, 0x89, 0x9e, 0x0a, 0x4e, 0x62,
+	0x37, 0x35, 0x20, 0x4f, 0x39, 0xe9, 0x05, 0x57, 0x7c, 0x65, 0xdc, 0x2b, 0xc6, 0xd5, 0x6d, 0x4c,
+	0x2b, 0x41, 0xf9, 0xa6, 0x60, 0x89, 0x7d, 0xce, 0xfa, 0xfb, 0x59, 0x98, 0x8d, 0xa3, 0xb5, 0x0c,
+	0x6e, 0x6e, 0x9f, 0x81, 0x11, 0xbf, 0xa3, 0xc1, 0xac, 0x61, 0x59, 0xc4, 0x5a, 0x3b, 0x65, 0x53,
+	0xfe, 0xbf, 0x12, 0x3b, 0xbb, 0x94, 0xe6, 0x8e, 0x87, 0x05, 0xa2, 0xef, 0x69, 0x30, 0xc7, 0x48,
+	0xcf, 0xd9, 0x4d, 0x29, 0x92, 0x3d, 0xb9, 0x22, 0x9f, 0x52, 0x8a, 0xcc, 0xe1, 0x61, 0xfe, 0x78,
+	0x94, 0x50, 0xfd, 0x1f, 0x1a, 0x9c, 0x5f, 0x72, 0xdd, 0x2e, 0x25, 0xd6, 0xa6, 0xf3, 0x5f, 0x1e,
+	0x4d, 0x7f, 0xd2, 0x00, 0x25, 0xef, 0x7a, 0x06, 0xf1, 0x64, 0x26, 0xe3, 0xe9, 0xd5, 0xb1, 0xe3,
+	0x29, 0xa1, 0xf0, 0x01, 0x11, 0xf5, 0xfd, 0x2c, 0xcc, 0x25, 0x11, 0x3f, 0x89, 0xa9, 0xff, 0x5c,
+	0x4c, 0xfd, 0x3c, 0x07, 0x73, 0xcb, 0xdd, 0xbe, 0xc7, 0x09, 0x4b, 0x28, 0xf9, 0xf4, 0xad, 0xf1,
+	0x6d, 0x0d, 0xca, 0xa4, 0xdd, 0x26, 0x26, 0xa7, 0xbb, 0xe4, 0x14, 0x8d, 0x51, 0x51, 0x52, 0xcb,
+	0xab, 0x29, 0xe6, 0x78, 0x48, 0x1c, 0x7a, 0x07, 0x66, 0xc3, 0xb3, 0x66, 0xab, 0xd1, 0x75, 0xcc,
+	0x9d, 0xc0, 0x0e, 0xd7, 0xc7, 0xd5, 0xa1, 0xd9, 0x5a, 0x27, 0x3c, 0x72, 0x85, 0xd5, 0x34, 0x5f,
+	0x3c, 0x2c, 0x0a, 0xdd, 0x84, 0x12, 0x77, 0xb8, 0xd1, 0x0d, 0xae, 0x9f, 0xbb, 0xa2, 0x5d, 0xcd,
+	0x46, 0xf9, 0x61, 0x33, 0x06, 0xc3, 0x09, 0x4c, 0xb4, 0x08, 0x20, 0xbf, 0xb7, 0x8c, 0x0e, 0xf1,
+	0x2a, 0x79, 0x49, 0x17, 0xbe, 0xf7, 0x66, 0x08, 0xc1, 0x31, 0x2c, 0x74, 0x1d, 0x8a, 0x66, 0x9f,
+	0x31, 0x62, 0x73, 0xf1, 0xbd, 0x32, 0x21, 0x89, 0xe6, 0x14, 0x51, 0x71, 0x39, 0x02, 0xe1, 0x38,
+	0x9e, 0xfe, 0x37, 0x0d, 0x8a, 0xab, 0x9d, 0xff, 0x81, 0x0e, 0xe6, 0x43, 0x0d, 0x66, 0x62, 0x17,
+	0x3d, 0x83, 0x84, 0xfb, 0x56, 0x32, 0xe1, 0x8e, 0x7d, 0xc3, 0x98, 0xb6, 0x07, 0x64, 0xdb, 0x1f,
+	0x64, 0xa1, 0x1c, 0xc3, 0xf2, 0x53, 0xad, 0x05, 0xe0, 0x84, 0xef, 0x7e, 0xaa, 0x36, 0x8c, 0xf1,
+	0xfd, 0x24, 0xdd, 0x8e, 0x48, 0xb7, 0x5d, 0xb8, 0xb4, 0xfa, 0x90, 0x13, 0x66, 0x1b, 0xdd, 0x55,
+	0x9b, 0x53, 0xfe, 0x08, 0x93, 0x36, 0x61, 0xc4, 0x36, 0x09, 0xba, 0x02, 0x39, 0xdb, 0xe8, 0x11,
+	0x69, 0x8e, 0x42, 0xa3, 0xa4, 0x58, 0xe7, 0xd6, 0x8d, 0x1e, 0xc1, 0x12, 0x82, 0xea, 0x50, 0x10,
+	0x7f, 0x3d, 0xd7, 0x30, 0x49, 0x25, 0x23, 0xd1, 0x66, 0x15, 0x5a, 0x61, 0x3d, 0x00, 0xe0, 0x08,
+	0x47, 0xff, 0x97, 0x06, 0x65, 0x29, 0x7e, 0xc9, 0xf3, 0x1c, 0x93, 0x1a, 0x9c, 0x3a, 0xf6, 0xd9,
+	0xd4, 0xd9, 0xb2, 0xa1, 0x24, 0xaa, 0xfb, 0x1f, 0xbb, 0xa5, 0x90, 0xd4, 0xe1, 0x23, 0x45, 0xc9,
+	0x7d, 0x29, 0xc5, 0x1f, 0x0f, 0x49, 0xd4, 0x3f, 0xcc, 0x42, 0x31, 0xf6, 0xf8, 0xe8, 0x3e, 0x64,
+	0x5d, 0xc7, 0x52, 0x77, 0x1e, 0x7b, 0x56, 0x68, 0x39, 0x56, 0xa4, 0xc6, 0xe4, 0x60, 0x6f, 0x3e,
+	0x2b, 0x4e, 0x04, 0x47, 0xf4, 0x9e, 0x06, 0xe7, 0x49, 0xc2, 0xaa, 0xd2, 0x3a, 0xc5, 0xc5, 0xb5,
+	0xb1, 0xe3, 0x79, 0xb4, 0x6f, 0x34, 0xd0, 0x60, 0x6f, 0xfe, 0x7c, 0x0a, 0x98, 0x12, 0x89, 0x9e,
+	0x87, 0x2c, 0x75, 0x7d, 0xb7, 0x2e, 0x35, 0x2e, 0x08, 0x05, 0x9b, 0x2d, 0x6f, 0x7f, 0x6f, 0xbe,
+	0xd0, 0x6c, 0xa9, 0x01, 0x06, 0x0b, 0x04, 0xf4, 0x26, 0xe4, 0x5d, 0x87, 0x71, 0x51, 0x6c, 0x84,
+	0x45, 0xbe, 0x34, 0xae, 0x8e, 0xc2, 0xd3, 0xac, 0x96, 0xc3, 0x78, 0x94, 0x71, 0xc4, 0x37, 0x0f,
+	0xfb, 0x6c, 0xd1, 0x1b, 0x90, 0xb3, 0x1d, 0x8b, 0xc8, 0x9a, 0x54, 0x5c, 0xbc, 0x35, 0x36, 0x7b,
+	0xc7, 0x22, 0xd1, 0xc5, 0xa7, 0x64, 0x08, 0x88, 0x23, 0xc9, 0x54, 0xff, 0x85, 0x06, 0xe7, 0x93,
+	0x2e, 0x91, 0x8c, 0x0a, 0xed, 0xe8, 0xa8, 0x08, 0x03, 0x2d, 0x73, 0x60, 0xa0, 0x35, 0x20, 0xdb,
+	0xa7, 0x56, 0x25, 0x2b, 0x11, 0x5e, 0x50, 0x08, 0xd9, 0xd7, 0x9b, 0x2b, 0xfb, 0x7b, 0xf3, 0xcf,
+	0x1e, 0xb4, 0x05, 0xe0, 0x8f, 0x5c, 0xe2, 0xd5, 0x5e, 0x6f, 0xae, 0x60, 0x41, 0xac, 0xff, 0x46,
+	0x83, 0x49, 0x55, 0xe7, 0xd1, 0x7d, 0xc8, 0x99, 0xd4, 0x62, 0xca, 0xf5, 0x8e, 0xd9, 0x59, 0x84,
+	0x8a, 0x2e, 0x37, 0x57, 0x30, 0x96, 0x0c, 0xd1, 0x03, 0x98, 0x20, 0x0f, 0x4d, 0xe2, 0x72, 0x15,
+	0x5e, 0xc7, 0x64, 0x7d, 0x5e, 0xb1, 0x9e, 0x58, 0x95, 0xcc, 0xb0, 0x62, 0xaa, 0xb7, 0x21, 0x2f,
+	0x11, 0xd0, 0x73, 0x90, 0xa1, 0xae, 0x54, 0xbf, 0xd4, 0x98, 0x1b, 0xec, 0xcd, 0x67, 0x9a, 0xad,
+	0xa4, 0x67, 0x65, 0xa8, 0x2b, 0x9a, 0x19, 0x97, 0x91, 0x36, 0x7d, 0x78, 0x87, 0xd8, 0x1d, 0xbe,
+	0x2d, 0xdf, 0x37, 0x1f, 0x15, 0xde, 0x56, 0x0c, 0x86, 0x13, 0x98, 0xfa, 0xcf, 0x34, 0x40, 0x77,
+	0xfb, 0x5d, 0x4e, 0x4d, 0xc3, 0xe3, 0xd2, 0xbc, 0x4d, 0xbb, 0xed, 0xa0, 0xe7, 0x20, 0x2f, 0xeb,
+	0xb3, 0xb2, 0x6a, 0xe8, 0x6e, 0xbe, 0x03, 0xf8, 0x30, 0xf4, 0x26, 0xe4, 0x5c, 0xc7, 0x3a, 0xf6,
+	0x0a, 0x20, 0x11, 0xd6, 0xe1, 0x13, 0xb7, 0x1c, 0xcb, 0xc3, 0x92, 0xaf, 0xfe, 0xbe, 0x06, 0x85,
+	0xd0, 0xe5, 0x85, 0xef, 0x08, 0x2f, 0x97, 0x1a, 0xe5, 0xe3, 0xf8, 0x8c, 0xe3, 0x9c, 0xab, 0x30,
+	0x8e, 0xf0, 0xae, 0x9b, 0x30, 0x25, 0x77, 0x43, 0xa6, 0xd3, 0x55, 0x2e, 0x76, 0x39, 0x68, 0x11,
+	0x5a, 0xea, 0x7c, 0x3f, 0xf6, 0x19, 0x87, 0xd8, 0xfa, 0x7b, 0x79, 0x98, 0x5e, 0x27, 0xfc, 0x6d,
+	0x87, 0xed, 0xb4, 0x9c, 0x2e, 0x35, 0x1f, 0x9d, 0x41, 0x32, 0x6f, 0x43, 0x9e, 0xf5, 0xbb, 0x24,
+	0x78, 0xe0, 0xa5, 0xb1, 0xe3, 0x39, 0xae, 0x2f, 0xee, 0x77, 0x49, 0x64, 0x47, 0xf1, 0xcd, 0xc3,
+	0x3e, 0x7b, 0x74, 0x0b, 0x66, 0x8c, 0xc4, 0x54, 0xe8, 0xa7, 0xb2, 0x82, 0xf4, 0xb7, 0x99, 0xe4,
+	0xc0, 0xe8, 0xe1, 0x34, 0x2e, 0xba, 0x2a, 0x1e, 0x95, 0x3a, 0x4c, 0x24, 0x5f, 0xd1, 0x45, 0x6b,
+	0x8d, 0x92, 0xff, 0xa0, 0xfe, 0x19, 0x0e, 0xa1, 0xe8, 0x1a, 0x94, 0x38, 0x25, 0x2c, 0x80, 0xc8,
+	0x3c, 0x95, 0x6f, 0x94, 0x65, 0xbf, 0x1d, 0x3b, 0xc7, 0x09, 0x2c, 0xe4, 0x41, 0xc1, 0x73, 0xfa,
+	0xcc, 0x14, 0xb9, 0x49, 0x76, 0xce, 0xc5, 0xc5, 0xdb, 0x27, 0x7b, 0x8a, 0xd0, 0xeb, 0xa6, 0x45,
+	0xa6, 0xda, 0x08, 0x98, 0xe3, 0x48, 0x0e, 0xfa, 0x89, 0x06, 0xb3, 0x8c, 0x18, 0x5d, 0xfa, 0x2d,
+	0x59, 0xba, 0x37, 0xb8, 0xc1, 0xfb, 0x5e, 0x65, 0x52, 0x4a, 0x5f, 0x3f, 0xa1, 0xf4, 0x14, 0xd7,
+	0xc6, 0x45, 0xd1, 0x50, 0x0d, 0x1d, 0xe3, 0x61, 0xf9, 0xfa, 0x1f, 0x35, 0x98, 0x4d, 0x30, 0x3b,
+	0x83, 0x46, 0x79, 0x2b, 0xd9, 0x28, 0xdf, 0x3a, 0xd1, 0xe5, 0x0f, 0x68, 0x95, 0x7f, 0xaf, 0xc1,
+	0xa5, 0x04, 0x9e, 0xa8, 0x3b, 0xfe, 0x9d, 0xd1, 0xe7, 0x61, 0x4a, 0xd4, 0x9f, 0xf5, 0xa8, 0x41,
+	0x0b, 0xb5, 0x5d, 0x57, 0xe7, 0x38, 0xc4, 0x10, 0xc3, 0x99, 0xda, 0x03, 0x53, 0xc7, 0xae, 0x64,
+	0x92, 0xc3, 0xd9, 0x5a, 0x08, 0xc1, 0x31, 0x2c, 0xf4, 0x3c, 0x4c, 0x30, 0x62, 0x78, 0x8e, 0xad,
+	0x72, 0x42, 0x98, 0x93, 0xb1, 0x3c, 0xc5, 0x0a, 0x8a, 0x3e, 0x07, 0x93, 0x3d, 0xe2, 0x79, 0x62,
+	0x80, 0xcb, 0x49, 0xc4, 0x19, 0x85, 0x38, 0x79, 0xd7, 0x3f, 0xc6, 0x01, 0x5c, 0xff, 0x5d, 0x26,
+	0x65, 0xa8, 0x16, 0x21, 0x0c, 0xdd, 0x80, 0x69, 0x23, 0xb6, 0xd0, 0xf4, 0x2a, 0x9a, 0x0c, 0xb3,
+	0xd9, 0xc1, 0xde, 0xfc, 0x74, 0x7c, 0xd3, 0xe9, 0xe1, 0x24, 0x1e, 0x22, 0x30, 0x45, 0x5d, 0x35,
+	0x23, 0xfb, 0x66, 0xb8, 0x31, 0x7e, 0xb9, 0x91, 0xf4, 0xd1, 0xe3, 0x85, 0xc3, 0x71, 0xc8, 0x1a,
+	0xcd, 0x43, 0xbe, 0xfd, 0x4d, 0xcb, 0x0e, 0xc2, 0xbf, 0x20, 0xec, 0x74, 0xfb, 0x2b, 0x2b, 0xeb,
+	0x1e, 0xf6, 0xcf, 0x11, 0x17, 0xa3, 0xef, 0x06, 0x61, 0xbb, 0xd4, 0x24, 0x41, 0x17, 0xf3, 0xe5,
+	0x71, 0x35, 0x51, 0xf4, 0xb1, 0x16, 0x2b, 0x1a, 0x9e, 0x03, 0xde, 0x38, 0x26, 0x47, 0xff, 0x6d,
+	0x06, 0xaa, 0x87, 0x87, 0x10, 0x6a, 0xc1, 0x05, 0x35, 0x37, 0x0b, 0x9f, 0xf0, 0x7c, 0x04, 0x62,
+	0xa9, 0x62, 0x11, 0x24, 0xf9, 0x0b, 0xcb, 0x23, 0x70, 0xf0, 0x48, 0x4a, 0xc1, 0xd1, 0x22, 0x1e,
+	0x65, 0xc4, 0x4a, 0x72, 0xcc, 0x24, 0x39, 0xae, 0x8c, 0xc0, 0xc1, 0x23, 0x29, 0xd1, 0x3b, 0x50,
+	0x6c, 0x1b, 0xb4, 0xab, 0x8e, 0xd5, 0x10, 0xb4, 0x76, 0xa2, 0x70, 0x8a, 0xc2, 0x24, 0x5a, 0x26,
+	0xdc, 0x8e, 0x64, 0xe0, 0xb8, 0x40, 0xb1, 0x4c, 0xf8, 0xbf, 0xd1, 0x79, 0x10, 0x5d, 0x87, 0x9c,
+	0xe8, 0xa1, 0x54, 0x7c, 0x3d, 0x1b, 0x54, 0xce, 0xcd, 0x47, 0x2e, 0xd9, 0xdf, 0x9b, 0x4f, 0x7a,
+	0xb2, 0x38, 0xc4, 0x12, 0x7d, 0xec, 0xa9, 0x28, 0xac, 0xd0, 0xd9, 0xa3, 0xfa, 0xbf, 0xdc, 0x49,
+	0xfa, 0xbf, 0xfd, 0x7c, 0x2a, 0xf8, 0x44, 0xb5, 0x43, 0xaf, 0x40, 0xc1, 0xa2, 0x8c, 0x98, 0x32,
+	0x31, 0xf8, 0x17, 0xad, 0x06, 0xca, 0xae, 0x04, 0x80, 0xfd, 0xf8, 0x17, 0x1c, 0x11, 0x20, 0x13,
+	0x72, 0x6d, 0xe6, 0xf4, 0xd4, 0x74, 0x71, 0xb2, 0x52, 0x2c, 0x72, 0x41, 0x74, 0xf9, 0xdb, 0xcc,
+	0xe9, 0x61, 0xc9, 0x1c, 0x3d, 0x80, 0x0c, 0x77, 0x2a, 0xd9, 0xd3, 0x12, 0x01, 0x4a, 0x44, 0x66,
+	0xd3, 0xc1, 0x19, 0xee, 0x88, 0x2c, 0xe2, 0x25, 0x63, 0xf7, 0xc6, 0x31, 0x63, 0x37, 0xca, 0x22,
+	0x61, 0xc0, 0x86, 0xac, 0x45, 0xc2, 0x76, 0x53, 0x15, 0x3e, 0x6a, 0xb2, 0x86, 0x7a, 0x82, 0xfb,
+	0x30, 0x61, 0xf8, 0x36, 0x99, 0x90, 0x36, 0x79, 0x4d, 0x24, 0xde, 0xa5, 0xc0, 0x18, 0x0b, 0x87,
+	0xfc, 0xe0, 0xca, 0xac, 0xf0, 0xe7, 0xcf, 0x9a, 0xb0, 0xb0, 0x4f, 0x84, 0x15, 0x3b, 0xf4, 0x32,
+	0x4c, 0x13, 0xdb, 0xd8, 0xea, 0x92, 0x3b, 0x4e, 0xa7, 0x43, 0xed, 0x8e, 0x2c, 0xde, 0x53, 0x8d,
+	0x8b, 0x4a, 0x97, 0xe9, 0xd5, 0x38, 0x10, 0x27, 0x71, 0x47, 0xb5, 0x44, 0x53, 0x63, 0xb4, 0x44,
+	0x81, 0x9f, 0x17, 0x0e, 0xf4, 0xf3, 0xfb, 0x50, 0x60, 0x06, 0x27, 0x77, 0x68, 0x8f, 0xf2, 0x0a,
+	0x5c, 0xd1, 0x8e, 0x33, 0x0e, 0xe2, 0x80, 0x01, 0x8e, 0x78, 0xe9, 0x3f, 0xca, 0x02, 0x4a, 0xb8,
+	0x82, 0xc8, 0x0f, 0x9e, 0x18, 0x94, 0xa7, 0xed, 0xf8, 0x71, 0x45, 0x3b, 0xd5, 0x4e, 0x2a, 0x7c,
+	0xd6, 0x24, 0x3c, 0x29, 0x13, 0xb9, 0x50, 0xe2, 0xcc, 0x68, 0xb7, 0xa9, 0x29, 0xb5, 0x52, 0xd1,
+	0xf4, 0xe2, 0x21, 0x3a, 0xc8, 0x9f, 0xb9, 0x6b, 0xa1, 0x9d, 0x37, 0x63, 0xd4, 0xb1, 0x65, 0x6d,
+	0xec, 0x14, 0x27, 0x24, 0xa0, 0x77, 0x35, 0x28, 0x8b, 0x2e, 0x37, 0x8e, 0xa2, 0x52, 0xef, 0x4b,
+	0x1f, 0x5f, 0x2c, 0x4e, 0x71, 0x88, 0x96, 0x21, 0x69, 0x08, 0x1e, 0x92, 0xa6, 0xff, 0x55, 0x83,
+	0xb9, 0x21, 0x8b, 0xf4, 0xcf, 0x62, 0xcf, 0xdf, 0x85, 0xbc, 0x2d, 0x6b, 0x4d, 0xe6, 0x74, 0x6b,
+	0x4d, 0xd8, 0xc4, 0xf9, 0x55, 0xc6, 0x17, 0xa2, 0x2f, 0xc0, 0x74, 0x62, 0x83, 0x70, 0xf4, 0x5a,
+	0x4d, 0xff, 0xd5, 0x04, 0x94, 0x03, 0xbe, 0xde, 0x46, 0xbf, 0xd7, 0x33, 0xd8, 0x59, 0x0c, 0x56,
+	0xdf, 0xd5, 0x60, 0x26, 0xee, 0x98, 0x34, 0x7c, 0xa2, 0xc6, 0x89, 0x9e, 0xc8, 0xf7, 0x8d, 0x4b,
+	0x4a, 0xf6, 0xcc, 0x7a, 0x52, 0x04, 0x4e, 0xcb, 0x44, 0xbf, 0xd4, 0xe0, 0xb2, 0x2f, 0x45, 0xfd,
+	0x0e, 0x94, 0xa2, 0xa8, 0x64, 0x4f, 0x4d, 0xa9, 0xcf, 0x28, 0xa5, 0x2e, 0x2f, 0x1d, 0x22, 0x0f,
+	0x1f, 0xaa, 0x0d, 0xfa, 0xa9, 0x06, 0x17, 0x7d, 0x84, 0xb4, 0x9e, 0xb9, 0x53, 0xd3, 0xf3, 0xd3,
+	0x4a, 0xcf, 0x8b, 0x4b, 0xa3, 0x04, 0xe1, 0xd1, 0xf2, 0xc5, 0x88, 0xd8, 0x0b, 0x96, 0x18, 0x95,
+	0xfc, 0xf1, 0x94, 0x19, 0xde, 0x82, 0x44, 0xcd, 0x4c, 0x08, 0xc3, 0x91, 0x1c, 0xe4, 0x01, 0xb8,
+	0x8e, 0xa5, 0x42, 0xbd, 0x32, 0x21, 0xa5, 0xbe, 0x76, 0x8c, 0x25, 0x48, 0x22, 0xb1, 0x84, 0x8e,
+	0x1b, 0x01, 0x70, 0x4c, 0x8c, 0xfe, 0x00, 0x2e, 0xb4, 0x8c, 0x0e, 0xb5, 0x65, 0xf3, 0xbb, 0x46,
+	0xf8, 0x3d, 0x57, 0x7c, 0x90, 0x15, 0xc7, 0x15, 0x83, 0x89, 0x26, 0x27, 0x9e, 0x68, 0x3b, 0x22,
+	0xa6, 0x12, 0x09, 0x11, 0x2b, 0x9d, 0xae, 0xac, 0x36, 0xfe, 0x50, 0x14, 0xc6, 0xb0, 0x5f, 0x41,
+	0x7c, 0x98, 0x6e, 0x40, 0x29, 0xbe, 0x96, 0x79, 0x1a, 0x9b, 0xf1, 0x5f, 0x67, 0x60, 0x26, 0x75,
+	0x6b, 0xf4, 0xc6, 0xe9, 0xed, 0x87, 0x8b, 0x41, 0xcf, 0x18, 0xee, 0x88, 0x09, 0x4c, 0x52, 0x5b,
+	0xfe, 0x0e, 0xa3, 0xea, 0xcd, 0x4b, 0x63, 0xd7, 0x9b, 0x06, 0x23, 0xc6, 0x8e, 0xe5, 0xbc, 0x6d,
+	0x47, 0x23, 0x5f, 0xd3, 0x67, 0x89, 0x03, 0xde, 0x68, 0x0b, 0x26, 0x88, 0x2f, 0x25, 0x7b, 0x62,
+	0x29, 0xd1, 0x56, 0xd0, 0x17, 0xa2, 0x38, 0xeb, 0x3f, 0xd4, 0xa0, 0x10, 0x56, 0x7d, 0xb4, 0x02,
+	0x65, 0xd7, 0x30, 0x77, 0x08, 0xf7, 0x5a, 0x84, 0x6d, 0x10, 0xd3, 0xb1, 0x83, 0x81, 0x27, 0x2c,
+	0x4f, 0xad, 0x14, 0x1c, 0x0f, 0x51, 0x88, 0x3e, 0x69, 0x8b, 0xc6, 0x59, 0xf8, 0xfe, 0x11, 0x16,
+	0xf4, 0x46, 0x1c, 0x88, 0x93, 0xb8, 0xfa, 0x3f, 0x33, 0x30, 0xa9, 0x5a, 0x40, 0x74, 0x2d, 0xb6,
+	0x5c, 0xf3, 0xfd, 0xa5, 0x72, 0xf4, 0x62, 0x0d, 0xad, 0xab, 0xb5, 0x5e, 0xe6, 0x88, 0x4c, 0x2f,
+	0xfe, 0xd3, 0xab, 0xe6, 0xff, 0xa7, 0x57, 0xad, 0x69, 0xf3, 0x7b, 0x6c, 0x83, 0x33, 0x6a, 0x77,
+	0x1a, 0x53, 0xa9, 0x25, 0xe0, 0x67, 0x61, 0x92, 0xd8, 0x72, 0x63, 0x28, 0xed, 0x90, 0x6f, 0x14,
+	0x85, 0xb5, 0x56, 0xfd, 0x23, 0x1c, 0xc0, 0xc4, 0xd2, 0x8a, 0x9a, 0x3d, 0x57, 0x0c, 0x33, 0x72,
+	0xd8, 0xc8, 0xfb, 0x4b, 0xab, 0xe6, 0xf2, 0xdd, 0x96, 0x38, 0xc3, 0x21, 0x34, 0xc0, 0x5c, 0x0e,
+	0x16, 0xeb, 0x31, 0x4c, 0x71, 0x86, 0x43, 0xa8, 0xc4, 0xec, 0x28, 0x9e, 0x13, 0x31, 0xcc, 0xb5,
+	0x90, 0xa7, 0x82, 0x8a, 0x7d, 0xad, 0x5c, 0xa1, 0xaa, 0xa1, 0x5f, 0xb6, 0xa6, 0x85, 0xd4, 0x0f,
+	0xa5, 0x0a, 0x86, 0x13, 0x98, 0x3a, 0x81, 0x72, 0x7a, 0x7e, 0x7e, 0x0a, 0x41, 0xda, 0xd8, 0x7c,
+	0xfc, 0xa4, 0x7a, 0xee, 0x83, 0x27, 0xd5, 0x73, 0x1f, 0x3d, 0xa9, 0x9e, 0x7b, 0x77, 0x50, 0xd5,
+	0x1e, 0x0f, 0xaa, 0xda, 0x07, 0x83, 0xaa, 0xf6, 0xd1, 0xa0, 0xaa, 0xfd, 0x79, 0x50, 0xd5, 0x7e,
+	0xfc, 0x97, 0xea, 0xb9, 0xaf, 0xd6, 0xc6, 0xfb, 0x77, 0xc8, 0x7f, 0x0f, 0x00, 0x96, 0xe0, 0xa4,
+	0x29, 0x3f, 0x29, 0x00, 0x00,
 }
 
 func (m *AddressGroup) Marshal() (dAtA []byte, err error) {
@@ -2035,6 +2140,18 @@ func (m *NetworkPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RealizationStatus != nil {
+		{
+			size, err := m.RealizationStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.SourceRef != nil {
 		{
 			size, err := m.SourceRef.MarshalToSizedBuffer(dAtA[:i])
@@ -2161,6 +2278,16 @@ func (m *NetworkPolicyNodeStatus) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Reason)
+	copy(dAtA[i:], m.Reason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
+	i--
+	dAtA[i] = 0x1a
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Generation))
 	i--
 	dAtA[i] = 0x10
@@ -2241,6 +2368,49 @@ func (m *NetworkPolicyPeer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NetworkPolicyRealizationStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NetworkPolicyRealizationStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NetworkPolicyRealizationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FailedNodes) > 0 {
+		for iNdEx := len(m.FailedNodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedNodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.DesiredNodesRealized))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintGenerated(dAtA, i, uint64(m.CurrentNodesRealized))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *NetworkPolicyReference) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2304,6 +2474,18 @@ func (m *NetworkPolicyRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RateLimit != nil {
+		{
+			size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	i -= len(m.Name)
 	copy(dAtA[i:], m.Name)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
@@ -2530,6 +2712,20 @@ func (m *NodeStatsSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PodTraffic) > 0 {
+		for iNdEx := len(m.PodTraffic) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PodTraffic[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.Multicast) > 0 {
 		for iNdEx := len(m.Multicast) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2661,6 +2857,88 @@ func (m *PodReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PodTrafficStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PodTrafficStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PodTrafficStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Egress.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Ingress.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Pod.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.BitsPerSecond))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintGenerated(dAtA, i, uint64(m.PacketsPerSecond))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *Service) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3139,6 +3417,10 @@ func (m *NetworkPolicy) Size() (n int) {
 		l = m.SourceRef.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.RealizationStatus != nil {
+		l = m.RealizationStatus.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -3168,6 +3450,10 @@ func (m *NetworkPolicyNodeStatus) Size() (n int) {
 	l = len(m.NodeName)
 	n += 1 + l + sovGenerated(uint64(l))
 	n += 1 + sovGenerated(uint64(m.Generation))
+	l = len(m.Reason)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -3204,6 +3490,23 @@ func (m *NetworkPolicyPeer) Size() (n int) {
 	return n
 }
 
+func (m *NetworkPolicyRealizationStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.CurrentNodesRealized))
+	n += 1 + sovGenerated(uint64(m.DesiredNodesRealized))
+	if len(m.FailedNodes) > 0 {
+		for _, e := range m.FailedNodes {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *NetworkPolicyReference) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3253,6 +3556,10 @@ func (m *NetworkPolicyRule) Size() (n int) {
 	}
 	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.RateLimit != nil {
+		l = m.RateLimit.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -3335,6 +3642,12 @@ func (m *NodeStatsSummary) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.PodTraffic) > 0 {
+		for _, e := range m.PodTraffic {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3362,6 +3675,32 @@ func (m *PodReference) Size() (n int) {
 	return n
 }
 
+func (m *PodTrafficStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pod.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Ingress.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Egress.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *RateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.PacketsPerSecond))
+	n += 1 + sovGenerated(uint64(m.BitsPerSecond))
+	return n
+}
+
 func (m *Service) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3728,6 +4067,7 @@ func (this *NetworkPolicy) String() string {
 		`Priority:` + valueToStringGenerated(this.Priority) + `,`,
 		`TierPriority:` + valueToStringGenerated(this.TierPriority) + `,`,
 		`SourceRef:` + strings.Replace(this.SourceRef.String(), "NetworkPolicyReference", "NetworkPolicyReference", 1) + `,`,
+		`RealizationStatus:` + strings.Replace(this.RealizationStatus.String(), "NetworkPolicyRealizationStatus", "NetworkPolicyRealizationStatus", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3755,6 +4095,8 @@ func (this *NetworkPolicyNodeStatus) String() string {
 	s := strings.Join([]string{`&NetworkPolicyNodeStatus{`,
 		`NodeName:` + fmt.Sprintf("%v", this.NodeName) + `,`,
 		`Generation:` + fmt.Sprintf("%v", this.Generation) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3782,6 +4124,23 @@ func (this *NetworkPolicyPeer) String() string {
 	}, "")
 	return s
 }
+func (this *NetworkPolicyRealizationStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForFailedNodes := "[]NetworkPolicyNodeStatus{"
+	for _, f := range this.FailedNodes {
+		repeatedStringForFailedNodes += strings.Replace(strings.Replace(f.String(), "NetworkPolicyNodeStatus", "NetworkPolicyNodeStatus", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForFailedNodes += "}"
+	s := strings.Join([]string{`&NetworkPolicyRealizationStatus{`,
+		`CurrentNodesRealized:` + fmt.Sprintf("%v", this.CurrentNodesRealized) + `,`,
+		`DesiredNodesRealized:` + fmt.Sprintf("%v", this.DesiredNodesRealized) + `,`,
+		`FailedNodes:` + repeatedStringForFailedNodes + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *NetworkPolicyReference) String() string {
 	if this == nil {
 		return "nil"
@@ -3814,6 +4173,7 @@ func (this *NetworkPolicyRule) String() string {
 		`EnableLogging:` + fmt.Sprintf("%v", this.EnableLogging) + `,`,
 		`AppliedToGroups:` + fmt.Sprintf("%v", this.AppliedToGroups) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`RateLimit:` + strings.Replace(this.RateLimit.String(), "RateLimit", "RateLimit", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3885,12 +4245,18 @@ func (this *NodeStatsSummary) String() string {
 		repeatedStringForMulticast += strings.Replace(strings.Replace(f.String(), "MulticastGroupInfo", "MulticastGroupInfo", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForMulticast += "}"
+	repeatedStringForPodTraffic := "[]PodTrafficStats{"
+	for _, f := range this.PodTraffic {
+		repeatedStringForPodTraffic += strings.Replace(strings.Replace(f.String(), "PodTrafficStats", "PodTrafficStats", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPodTraffic += "}"
 	s := strings.Join([]string{`&NodeStatsSummary{`,
 		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`NetworkPolicies:` + repeatedStringForNetworkPolicies + `,`,
 		`AntreaClusterNetworkPolicies:` + repeatedStringForAntreaClusterNetworkPolicies + `,`,
 		`AntreaNetworkPolicies:` + repeatedStringForAntreaNetworkPolicies + `,`,
 		`Multicast:` + repeatedStringForMulticast + `,`,
+		`PodTraffic:` + repeatedStringForPodTraffic + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3917,6 +4283,29 @@ func (this *PodReference) String() string {
 	}, "")
 	return s
 }
+func (this *PodTrafficStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PodTrafficStats{`,
+		`Pod:` + strings.Replace(strings.Replace(this.Pod.String(), "PodReference", "PodReference", 1), `&`, ``, 1) + `,`,
+		`Ingress:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Ingress), "TrafficBreakdown", "v1alpha1.TrafficBreakdown", 1), `&`, ``, 1) + `,`,
+		`Egress:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Egress), "TrafficBreakdown", "v1alpha1.TrafficBreakdown", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RateLimit) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RateLimit{`,
+		`PacketsPerSecond:` + fmt.Sprintf("%v", this.PacketsPerSecond) + `,`,
+		`BitsPerSecond:` + fmt.Sprintf("%v", this.BitsPerSecond) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Service) String() string {
 	if this == nil {
 		return "nil"
@@ -6581,6 +6970,42 @@ func (m *NetworkPolicy) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RealizationStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RealizationStatus == nil {
+				m.RealizationStatus = &NetworkPolicyRealizationStatus{}
+			}
+			if err := m.RealizationStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -6799,6 +7224,70 @@ func (m *NetworkPolicyNodeStatus) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -7002,6 +7491,128 @@ func (m *NetworkPolicyPeer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *NetworkPolicyRealizationStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkPolicyRealizationStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkPolicyRealizationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentNodesRealized", wireType)
+			}
+			m.CurrentNodesRealized = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentNodesRealized |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredNodesRealized", wireType)
+			}
+			m.DesiredNodesRealized = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DesiredNodesRealized |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedNodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedNodes = append(m.FailedNodes, NetworkPolicyNodeStatus{})
+			if err := m.FailedNodes[len(m.FailedNodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *NetworkPolicyReference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7477,6 +8088,42 @@ func (m *NetworkPolicyRule) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RateLimit == nil {
+				m.RateLimit = &RateLimit{}
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -8045,6 +8692,40 @@ func (m *NodeStatsSummary) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodTraffic", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodTraffic = append(m.PodTraffic, PodTrafficStats{})
+			if err := m.PodTraffic[len(m.PodTraffic)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -8268,6 +8949,243 @@ func (m *PodReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PodTrafficStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PodTrafficStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PodTrafficStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ingress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ingress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketsPerSecond", wireType)
+			}
+			m.PacketsPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketsPerSecond |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BitsPerSecond", wireType)
+			}
+			m.BitsPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BitsPerSecond |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Service) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -17,7 +17,7 @@ package v1beta2
 import "fmt"
 
 func (r *NetworkPolicyReference) ToString() string {
-	if r.Type == AntreaClusterNetworkPolicy {
+	if r.Type == AntreaClusterNetworkPolicy || r.Type == AdminNetworkPolicy || r.Type == BaselineAdminNetworkPolicy {
 		return fmt.Sprintf("%s:%s", r.Type, r.Name)
 	}
 	return fmt.Sprintf("%s:%s/%s", r.Type, r.Namespace, r.Name)
@@ -180,6 +180,8 @@ const (
 	K8sNetworkPolicy           NetworkPolicyType = "K8sNetworkPolicy"
 	AntreaClusterNetworkPolicy NetworkPolicyType = "AntreaClusterNetworkPolicy"
 	AntreaNetworkPolicy        NetworkPolicyType = "AntreaNetworkPolicy"
+	AdminNetworkPolicy         NetworkPolicyType = "AdminNetworkPolicy"
+	BaselineAdminNetworkPolicy NetworkPolicyType = "BaselineAdminNetworkPolicy"
 )
 
 type NetworkPolicyReference struct {
@@ -215,6 +217,19 @@ type NetworkPolicy struct {
 	TierPriority *int32 `json:"tierPriority,omitempty" protobuf:"varint,5,opt,name=tierPriority"`
 	// Reference to the original NetworkPolicy that the internal NetworkPolicy is created for.
 	SourceRef *NetworkPolicyReference `json:"sourceRef,omitempty" protobuf:"bytes,6,opt,name=sourceRef"`
+	// RealizationStatus summarizes the realization of the NetworkPolicy on the Nodes it spans.
+	// It is only set for Antrea-native policies in the responses to get and list requests.
+	RealizationStatus *NetworkPolicyRealizationStatus `json:"realizationStatus,omitempty" protobuf:"bytes,7,opt,name=realizationStatus"`
+}
+
+// NetworkPolicyRealizationStatus summarizes the realization of a NetworkPolicy on the Nodes it spans.
+type NetworkPolicyRealizationStatus struct {
+	// The number of Nodes that have realized the current generation of the NetworkPolicy.
+	CurrentNodesRealized int32 `json:"currentNodesRealized" protobuf:"varint,1,opt,name=currentNodesRealized"`
+	// The total number of Nodes that should realize the NetworkPolicy.
+	DesiredNodesRealized int32 `json:"desiredNodesRealized" protobuf:"varint,2,opt,name=desiredNodesRealized"`
+	// The statuses of the Nodes that failed to realize the current generation of the NetworkPolicy.
+	FailedNodes []NetworkPolicyNodeStatus `json:"failedNodes,omitempty" protobuf:"bytes,3,rep,name=failedNodes"`
 }
 
 // Direction defines traffic direction of NetworkPolicyRule.
@@ -253,6 +268,18 @@ type NetworkPolicyRule struct {
 	// Name describes the intention of this rule.
 	// Name should be unique within the policy.
 	Name string `json:"name,omitempty" protobuf:"bytes,9,opt,name=name"`
+	// RateLimit specifies the maximum rate of the traffic matching this rule.
+	// It is set only when Action is RateLimit.
+	RateLimit *RateLimit `json:"rateLimit,omitempty" protobuf:"bytes,10,opt,name=rateLimit"`
+}
+
+// RateLimit describes the maximum rate of the traffic matching a rule. Only one
+// of PacketsPerSecond and BitsPerSecond is set to a non-zero value.
+type RateLimit struct {
+	// PacketsPerSecond is the maximum number of packets per second.
+	PacketsPerSecond int32 `json:"packetsPerSecond,omitempty" protobuf:"varint,1,opt,name=packetsPerSecond"`
+	// BitsPerSecond is the maximum number of bits per second.
+	BitsPerSecond int64 `json:"bitsPerSecond,omitempty" protobuf:"varint,2,opt,name=bitsPerSecond"`
 }
 
 // Protocol defines network protocols supported for things like container ports.
@@ -308,7 +335,8 @@ type NetworkPolicyPeer struct {
 	// This field can only be possibly set for NetworkPolicyPeer of egress rules.
 	FQDNs []string `json:"fqdns,omitempty" protobuf:"bytes,3,rep,name=fqdns"`
 	// A list of ServiceReference.
-	// This field can only be possibly set for NetworkPolicyPeer of egress rules.
+	// This field can only be possibly set for the To NetworkPolicyPeer of rules.
+	// For ingress rules, it refers to the Services through which the traffic arrives.
 	ToServices []ServiceReference `json:"toServices,omitempty" protobuf:"bytes,4,rep,name=toServices"`
 }
 
@@ -350,6 +378,8 @@ type NodeStatsSummary struct {
 	AntreaNetworkPolicies []NetworkPolicyStats `json:"antreaNetworkPolicies,omitempty" protobuf:"bytes,4,rep,name=antreaNetworkPolicies"`
 	// Multicast group information collected from the Node.
 	Multicast []MulticastGroupInfo `json:"multicast,omitempty" protobuf:"bytes,5,rep,name=multicast"`
+	// The traffic stats of Pods collected from the Node.
+	PodTraffic []PodTrafficStats `json:"podTraffic,omitempty" protobuf:"bytes,6,rep,name=podTraffic"`
 }
 
 // MulticastGroupInfo contains the list of Pods that have joined a multicast group, for a given Node.
@@ -370,6 +400,16 @@ type NetworkPolicyStats struct {
 	RuleTrafficStats []statsv1alpha1.RuleTrafficStats `json:"ruleTrafficStats,omitempty" protobuf:"bytes,3,rep,name=ruleTrafficStats"`
 }
 
+// PodTrafficStats contains the information and traffic stats of a Pod.
+type PodTrafficStats struct {
+	// The reference of the Pod.
+	Pod PodReference `json:"pod,omitempty" protobuf:"bytes,1,opt,name=pod"`
+	// The stats of the packets received by the Pod.
+	Ingress statsv1alpha1.TrafficBreakdown `json:"ingress,omitempty" protobuf:"bytes,2,opt,name=ingress"`
+	// The stats of the packets sent by the Pod.
+	Egress statsv1alpha1.TrafficBreakdown `json:"egress,omitempty" protobuf:"bytes,3,opt,name=egress"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // NetworkPolicyStatus is the status of a NetworkPolicy.
@@ -386,8 +426,24 @@ type NetworkPolicyNodeStatus struct {
 	NodeName string `json:"nodeName,omitempty" protobuf:"bytes,1,opt,name=nodeName"`
 	// The generation realized by the Node.
 	Generation int64 `json:"generation,omitempty" protobuf:"varint,2,opt,name=generation"`
+	// Reason is a brief CamelCase string that describes why the Node failed to realize the
+	// NetworkPolicy. It is empty if the NetworkPolicy is realized successfully.
+	Reason string `json:"reason,omitempty" protobuf:"bytes,3,opt,name=reason"`
+	// Message is a human readable message indicating details about the failure.
+	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
 }
 
+// These are the reasons for which a Node can fail to realize a NetworkPolicy.
+const (
+	// NetworkPolicyFlowInstallationFailed means the OpenFlow flows of a rule failed to be installed.
+	NetworkPolicyFlowInstallationFailed = "FlowInstallationFailed"
+	// NetworkPolicyNamedPortUnresolved means a named port of a rule cannot be resolved for any of
+	// the rule's workloads.
+	NetworkPolicyNamedPortUnresolved = "NamedPortUnresolved"
+	// NetworkPolicyFQDNResolutionFailed means the FQDNs of a rule failed to be resolved.
+	NetworkPolicyFQDNResolutionFailed = "FQDNResolutionFailed"
+)
+
 type GroupReference struct {
 	// Namespace of the Group. Empty for ClusterGroup.
 	Namespace string `json:"namespace,omitempty" protobuf:"bytes,1,opt,name=namespace"`
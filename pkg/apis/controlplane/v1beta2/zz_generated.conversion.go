@@ -259,6 +259,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NetworkPolicyRealizationStatus)(nil), (*controlplane.NetworkPolicyRealizationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_NetworkPolicyRealizationStatus_To_controlplane_NetworkPolicyRealizationStatus(a.(*NetworkPolicyRealizationStatus), b.(*controlplane.NetworkPolicyRealizationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.NetworkPolicyRealizationStatus)(nil), (*NetworkPolicyRealizationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_NetworkPolicyRealizationStatus_To_v1beta2_NetworkPolicyRealizationStatus(a.(*controlplane.NetworkPolicyRealizationStatus), b.(*NetworkPolicyRealizationStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NetworkPolicyReference)(nil), (*controlplane.NetworkPolicyReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_NetworkPolicyReference_To_controlplane_NetworkPolicyReference(a.(*NetworkPolicyReference), b.(*controlplane.NetworkPolicyReference), scope)
 	}); err != nil {
@@ -339,6 +349,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PodTrafficStats)(nil), (*controlplane.PodTrafficStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_PodTrafficStats_To_controlplane_PodTrafficStats(a.(*PodTrafficStats), b.(*controlplane.PodTrafficStats), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.PodTrafficStats)(nil), (*PodTrafficStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_PodTrafficStats_To_v1beta2_PodTrafficStats(a.(*controlplane.PodTrafficStats), b.(*PodTrafficStats), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RateLimit)(nil), (*controlplane.RateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_RateLimit_To_controlplane_RateLimit(a.(*RateLimit), b.(*controlplane.RateLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.RateLimit)(nil), (*RateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_RateLimit_To_v1beta2_RateLimit(a.(*controlplane.RateLimit), b.(*RateLimit), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Service)(nil), (*controlplane.Service)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_Service_To_controlplane_Service(a.(*Service), b.(*controlplane.Service), scope)
 	}); err != nil {
@@ -1068,6 +1098,7 @@ func autoConvert_v1beta2_NetworkPolicy_To_controlplane_NetworkPolicy(in *Network
 	out.Priority = (*float64)(unsafe.Pointer(in.Priority))
 	out.TierPriority = (*int32)(unsafe.Pointer(in.TierPriority))
 	out.SourceRef = (*controlplane.NetworkPolicyReference)(unsafe.Pointer(in.SourceRef))
+	out.RealizationStatus = (*controlplane.NetworkPolicyRealizationStatus)(unsafe.Pointer(in.RealizationStatus))
 	return nil
 }
 
@@ -1093,6 +1124,7 @@ func autoConvert_controlplane_NetworkPolicy_To_v1beta2_NetworkPolicy(in *control
 	out.Priority = (*float64)(unsafe.Pointer(in.Priority))
 	out.TierPriority = (*int32)(unsafe.Pointer(in.TierPriority))
 	out.SourceRef = (*NetworkPolicyReference)(unsafe.Pointer(in.SourceRef))
+	out.RealizationStatus = (*NetworkPolicyRealizationStatus)(unsafe.Pointer(in.RealizationStatus))
 	return nil
 }
 
@@ -1146,6 +1178,8 @@ func Convert_controlplane_NetworkPolicyList_To_v1beta2_NetworkPolicyList(in *con
 func autoConvert_v1beta2_NetworkPolicyNodeStatus_To_controlplane_NetworkPolicyNodeStatus(in *NetworkPolicyNodeStatus, out *controlplane.NetworkPolicyNodeStatus, s conversion.Scope) error {
 	out.NodeName = in.NodeName
 	out.Generation = in.Generation
+	out.Reason = in.Reason
+	out.Message = in.Message
 	return nil
 }
 
@@ -1157,6 +1191,8 @@ func Convert_v1beta2_NetworkPolicyNodeStatus_To_controlplane_NetworkPolicyNodeSt
 func autoConvert_controlplane_NetworkPolicyNodeStatus_To_v1beta2_NetworkPolicyNodeStatus(in *controlplane.NetworkPolicyNodeStatus, out *NetworkPolicyNodeStatus, s conversion.Scope) error {
 	out.NodeName = in.NodeName
 	out.Generation = in.Generation
+	out.Reason = in.Reason
+	out.Message = in.Message
 	return nil
 }
 
@@ -1191,6 +1227,30 @@ func Convert_controlplane_NetworkPolicyPeer_To_v1beta2_NetworkPolicyPeer(in *con
 	return autoConvert_controlplane_NetworkPolicyPeer_To_v1beta2_NetworkPolicyPeer(in, out, s)
 }
 
+func autoConvert_v1beta2_NetworkPolicyRealizationStatus_To_controlplane_NetworkPolicyRealizationStatus(in *NetworkPolicyRealizationStatus, out *controlplane.NetworkPolicyRealizationStatus, s conversion.Scope) error {
+	out.CurrentNodesRealized = in.CurrentNodesRealized
+	out.DesiredNodesRealized = in.DesiredNodesRealized
+	out.FailedNodes = *(*[]controlplane.NetworkPolicyNodeStatus)(unsafe.Pointer(&in.FailedNodes))
+	return nil
+}
+
+// Convert_v1beta2_NetworkPolicyRealizationStatus_To_controlplane_NetworkPolicyRealizationStatus is an autogenerated conversion function.
+func Convert_v1beta2_NetworkPolicyRealizationStatus_To_controlplane_NetworkPolicyRealizationStatus(in *NetworkPolicyRealizationStatus, out *controlplane.NetworkPolicyRealizationStatus, s conversion.Scope) error {
+	return autoConvert_v1beta2_NetworkPolicyRealizationStatus_To_controlplane_NetworkPolicyRealizationStatus(in, out, s)
+}
+
+func autoConvert_controlplane_NetworkPolicyRealizationStatus_To_v1beta2_NetworkPolicyRealizationStatus(in *controlplane.NetworkPolicyRealizationStatus, out *NetworkPolicyRealizationStatus, s conversion.Scope) error {
+	out.CurrentNodesRealized = in.CurrentNodesRealized
+	out.DesiredNodesRealized = in.DesiredNodesRealized
+	out.FailedNodes = *(*[]NetworkPolicyNodeStatus)(unsafe.Pointer(&in.FailedNodes))
+	return nil
+}
+
+// Convert_controlplane_NetworkPolicyRealizationStatus_To_v1beta2_NetworkPolicyRealizationStatus is an autogenerated conversion function.
+func Convert_controlplane_NetworkPolicyRealizationStatus_To_v1beta2_NetworkPolicyRealizationStatus(in *controlplane.NetworkPolicyRealizationStatus, out *NetworkPolicyRealizationStatus, s conversion.Scope) error {
+	return autoConvert_controlplane_NetworkPolicyRealizationStatus_To_v1beta2_NetworkPolicyRealizationStatus(in, out, s)
+}
+
 func autoConvert_v1beta2_NetworkPolicyReference_To_controlplane_NetworkPolicyReference(in *NetworkPolicyReference, out *controlplane.NetworkPolicyReference, s conversion.Scope) error {
 	out.Type = controlplane.NetworkPolicyType(in.Type)
 	out.Namespace = in.Namespace
@@ -1231,6 +1291,7 @@ func autoConvert_v1beta2_NetworkPolicyRule_To_controlplane_NetworkPolicyRule(in
 	out.EnableLogging = in.EnableLogging
 	out.AppliedToGroups = *(*[]string)(unsafe.Pointer(&in.AppliedToGroups))
 	out.Name = in.Name
+	out.RateLimit = (*controlplane.RateLimit)(unsafe.Pointer(in.RateLimit))
 	return nil
 }
 
@@ -1253,6 +1314,7 @@ func autoConvert_controlplane_NetworkPolicyRule_To_v1beta2_NetworkPolicyRule(in
 	out.Action = (*v1alpha1.RuleAction)(unsafe.Pointer(in.Action))
 	out.EnableLogging = in.EnableLogging
 	out.AppliedToGroups = *(*[]string)(unsafe.Pointer(&in.AppliedToGroups))
+	out.RateLimit = (*RateLimit)(unsafe.Pointer(in.RateLimit))
 	return nil
 }
 
@@ -1337,6 +1399,7 @@ func autoConvert_v1beta2_NodeStatsSummary_To_controlplane_NodeStatsSummary(in *N
 	out.AntreaClusterNetworkPolicies = *(*[]controlplane.NetworkPolicyStats)(unsafe.Pointer(&in.AntreaClusterNetworkPolicies))
 	out.AntreaNetworkPolicies = *(*[]controlplane.NetworkPolicyStats)(unsafe.Pointer(&in.AntreaNetworkPolicies))
 	out.Multicast = *(*[]controlplane.MulticastGroupInfo)(unsafe.Pointer(&in.Multicast))
+	out.PodTraffic = *(*[]controlplane.PodTrafficStats)(unsafe.Pointer(&in.PodTraffic))
 	return nil
 }
 
@@ -1351,6 +1414,7 @@ func autoConvert_controlplane_NodeStatsSummary_To_v1beta2_NodeStatsSummary(in *c
 	out.AntreaClusterNetworkPolicies = *(*[]NetworkPolicyStats)(unsafe.Pointer(&in.AntreaClusterNetworkPolicies))
 	out.AntreaNetworkPolicies = *(*[]NetworkPolicyStats)(unsafe.Pointer(&in.AntreaNetworkPolicies))
 	out.Multicast = *(*[]MulticastGroupInfo)(unsafe.Pointer(&in.Multicast))
+	out.PodTraffic = *(*[]PodTrafficStats)(unsafe.Pointer(&in.PodTraffic))
 	return nil
 }
 
@@ -1428,6 +1492,56 @@ func Convert_controlplane_PodReference_To_v1beta2_PodReference(in *controlplane.
 	return autoConvert_controlplane_PodReference_To_v1beta2_PodReference(in, out, s)
 }
 
+func autoConvert_v1beta2_PodTrafficStats_To_controlplane_PodTrafficStats(in *PodTrafficStats, out *controlplane.PodTrafficStats, s conversion.Scope) error {
+	if err := Convert_v1beta2_PodReference_To_controlplane_PodReference(&in.Pod, &out.Pod, s); err != nil {
+		return err
+	}
+	out.Ingress = in.Ingress
+	out.Egress = in.Egress
+	return nil
+}
+
+// Convert_v1beta2_PodTrafficStats_To_controlplane_PodTrafficStats is an autogenerated conversion function.
+func Convert_v1beta2_PodTrafficStats_To_controlplane_PodTrafficStats(in *PodTrafficStats, out *controlplane.PodTrafficStats, s conversion.Scope) error {
+	return autoConvert_v1beta2_PodTrafficStats_To_controlplane_PodTrafficStats(in, out, s)
+}
+
+func autoConvert_controlplane_PodTrafficStats_To_v1beta2_PodTrafficStats(in *controlplane.PodTrafficStats, out *PodTrafficStats, s conversion.Scope) error {
+	if err := Convert_controlplane_PodReference_To_v1beta2_PodReference(&in.Pod, &out.Pod, s); err != nil {
+		return err
+	}
+	out.Ingress = in.Ingress
+	out.Egress = in.Egress
+	return nil
+}
+
+// Convert_controlplane_PodTrafficStats_To_v1beta2_PodTrafficStats is an autogenerated conversion function.
+func Convert_controlplane_PodTrafficStats_To_v1beta2_PodTrafficStats(in *controlplane.PodTrafficStats, out *PodTrafficStats, s conversion.Scope) error {
+	return autoConvert_controlplane_PodTrafficStats_To_v1beta2_PodTrafficStats(in, out, s)
+}
+
+func autoConvert_v1beta2_RateLimit_To_controlplane_RateLimit(in *RateLimit, out *controlplane.RateLimit, s conversion.Scope) error {
+	out.PacketsPerSecond = in.PacketsPerSecond
+	out.BitsPerSecond = in.BitsPerSecond
+	return nil
+}
+
+// Convert_v1beta2_RateLimit_To_controlplane_RateLimit is an autogenerated conversion function.
+func Convert_v1beta2_RateLimit_To_controlplane_RateLimit(in *RateLimit, out *controlplane.RateLimit, s conversion.Scope) error {
+	return autoConvert_v1beta2_RateLimit_To_controlplane_RateLimit(in, out, s)
+}
+
+func autoConvert_controlplane_RateLimit_To_v1beta2_RateLimit(in *controlplane.RateLimit, out *RateLimit, s conversion.Scope) error {
+	out.PacketsPerSecond = in.PacketsPerSecond
+	out.BitsPerSecond = in.BitsPerSecond
+	return nil
+}
+
+// Convert_controlplane_RateLimit_To_v1beta2_RateLimit is an autogenerated conversion function.
+func Convert_controlplane_RateLimit_To_v1beta2_RateLimit(in *controlplane.RateLimit, out *RateLimit, s conversion.Scope) error {
+	return autoConvert_controlplane_RateLimit_To_v1beta2_RateLimit(in, out, s)
+}
+
 func autoConvert_v1beta2_Service_To_controlplane_Service(in *Service, out *controlplane.Service, s conversion.Scope) error {
 	out.Protocol = (*controlplane.Protocol)(unsafe.Pointer(in.Protocol))
 	out.Port = (*intstr.IntOrString)(unsafe.Pointer(in.Port))
@@ -628,6 +628,11 @@ func (in *NetworkPolicy) DeepCopyInto(out *NetworkPolicy) {
 		*out = new(NetworkPolicyReference)
 		**out = **in
 	}
+	if in.RealizationStatus != nil {
+		in, out := &in.RealizationStatus, &out.RealizationStatus
+		*out = new(NetworkPolicyRealizationStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -736,6 +741,27 @@ func (in *NetworkPolicyPeer) DeepCopy() *NetworkPolicyPeer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyRealizationStatus) DeepCopyInto(out *NetworkPolicyRealizationStatus) {
+	*out = *in
+	if in.FailedNodes != nil {
+		in, out := &in.FailedNodes, &out.FailedNodes
+		*out = make([]NetworkPolicyNodeStatus, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyRealizationStatus.
+func (in *NetworkPolicyRealizationStatus) DeepCopy() *NetworkPolicyRealizationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyRealizationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkPolicyReference) DeepCopyInto(out *NetworkPolicyReference) {
 	*out = *in
@@ -774,6 +800,11 @@ func (in *NetworkPolicyRule) DeepCopyInto(out *NetworkPolicyRule) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(RateLimit)
+		**out = **in
+	}
 	return
 }
 
@@ -890,6 +921,11 @@ func (in *NodeStatsSummary) DeepCopyInto(out *NodeStatsSummary) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PodTraffic != nil {
+		in, out := &in.PodTraffic, &out.PodTraffic
+		*out = make([]PodTrafficStats, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -952,6 +988,41 @@ func (in *PodReference) DeepCopy() *PodReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodTrafficStats) DeepCopyInto(out *PodTrafficStats) {
+	*out = *in
+	out.Pod = in.Pod
+	out.Ingress = in.Ingress
+	out.Egress = in.Egress
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodTrafficStats.
+func (in *PodTrafficStats) DeepCopy() *PodTrafficStats {
+	if in == nil {
+		return nil
+	}
+	out := new(PodTrafficStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimit) DeepCopyInto(out *RateLimit) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimit.
+func (in *RateLimit) DeepCopy() *RateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Service) DeepCopyInto(out *Service) {
 	*out = *in
@@ -51,6 +51,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		SchemeGroupVersion,
 		&Traceflow{},
 		&TraceflowList{},
+		&PacketCapture{},
+		&PacketCaptureList{},
 		&NetworkPolicy{},
 		&NetworkPolicyList{},
 		&ClusterNetworkPolicy{},
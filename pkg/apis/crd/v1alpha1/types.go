@@ -283,6 +283,86 @@ type TraceflowList struct {
 	Items []Traceflow `json:"items"`
 }
 
+type PacketCapturePhase string
+
+const (
+	PacketCapturePending   PacketCapturePhase = "Pending"
+	PacketCaptureRunning   PacketCapturePhase = "Running"
+	PacketCaptureSucceeded PacketCapturePhase = "Succeeded"
+	PacketCaptureFailed    PacketCapturePhase = "Failed"
+)
+
+// Default timeout in seconds.
+const DefaultPacketCaptureTimeout uint16 = 60
+
+// Default number of packets to capture.
+const DefaultPacketCaptureNumber int32 = 100
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type PacketCapture struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PacketCaptureSpec   `json:"spec,omitempty"`
+	Status PacketCaptureStatus `json:"status,omitempty"`
+}
+
+// PacketCaptureSpec describes the spec of the packet capture.
+type PacketCaptureSpec struct {
+	// Source and Destination select the traffic to capture. At least one of
+	// them must be a Pod, and packets are captured on the Node of that Pod
+	// (the source Pod takes precedence when both are Pods).
+	Source      Source      `json:"source,omitempty"`
+	Destination Destination `json:"destination,omitempty"`
+	// Packet filters the captured traffic on IP protocol and transport
+	// ports. Both directions of matching connections are captured.
+	Packet Packet `json:"packet,omitempty"`
+	// Number is the number of packets to capture. Defaults to 100 if not
+	// set.
+	Number int32 `json:"number,omitempty"`
+	// Timeout specifies the maximum duration of the capture in seconds.
+	// The capture stops when either Number packets have been captured or
+	// Timeout has elapsed, whichever comes first. Defaults to 60 seconds
+	// if not set.
+	Timeout uint16 `json:"timeout,omitempty"`
+}
+
+// PacketCaptureStatus describes current status of the packet capture.
+type PacketCaptureStatus struct {
+	// Phase is the PacketCapture phase.
+	Phase PacketCapturePhase `json:"phase,omitempty"`
+	// Reason is a message indicating the reason of the packet capture's
+	// current phase.
+	Reason string `json:"reason,omitempty"`
+	// StartTime is the time at which the PacketCapture was started by the
+	// Antrea Controller.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// DataplaneTag is a tag to identify the packets of this capture in the
+	// dataplane. It is allocated from the same space as Traceflow tags.
+	DataplaneTag uint8 `json:"dataplaneTag,omitempty"`
+	// NodeName is the Node on which the packets were captured.
+	NodeName string `json:"nodeName,omitempty"`
+	// NumCapturedPackets is the number of packets written to the capture
+	// file.
+	NumCapturedPackets int32 `json:"numCapturedPackets,omitempty"`
+	// FilePath is the path of the pcapng file on the capturing Node. The
+	// file can be retrieved through the Antrea Agent API of that Node, and
+	// is removed when the PacketCapture is deleted.
+	FilePath string `json:"filePath,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type PacketCaptureList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []PacketCapture `json:"items"`
+}
+
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
@@ -335,6 +415,8 @@ const (
 	NetworkPolicyRealizing NetworkPolicyPhase = "Realizing"
 	// NetworkPolicyRealized means the NetworkPolicy has been enforced to all Pods on all Nodes it applies to.
 	NetworkPolicyRealized NetworkPolicyPhase = "Realized"
+	// NetworkPolicyFailed means the NetworkPolicy failed to be realized on some of the Nodes it applies to.
+	NetworkPolicyFailed NetworkPolicyPhase = "Failed"
 )
 
 // NetworkPolicyStatus represents information about the status of a NetworkPolicy.
@@ -347,6 +429,70 @@ type NetworkPolicyStatus struct {
 	CurrentNodesRealized int32 `json:"currentNodesRealized"`
 	// The total number of nodes that should realize the NetworkPolicy.
 	DesiredNodesRealized int32 `json:"desiredNodesRealized"`
+	// The names of the rules which are currently not enforced because they
+	// are outside of their schedule.
+	// +optional
+	InactiveRules []string `json:"inactiveRules,omitempty"`
+	// The time windows of the rules which have a schedule.
+	// +optional
+	RuleSchedules []RuleScheduleStatus `json:"ruleSchedules,omitempty"`
+	// Conditions represent the latest available observations of the
+	// NetworkPolicy's realization.
+	// +optional
+	Conditions []NetworkPolicyCondition `json:"conditions,omitempty"`
+}
+
+// RuleScheduleStatus describes the state of the schedule of a rule.
+type RuleScheduleStatus struct {
+	// Name of the rule.
+	Name string `json:"name"`
+	// Active is true if the rule is enforced at the moment.
+	Active bool `json:"active"`
+	// WindowStart is the start of the time window during which the rule is
+	// enforced at the moment if Active is true, or of the next one otherwise.
+	// +optional
+	WindowStart *metav1.Time `json:"windowStart,omitempty"`
+	// WindowEnd is the end of the time window WindowStart belongs to.
+	// +optional
+	WindowEnd *metav1.Time `json:"windowEnd,omitempty"`
+}
+
+// NetworkPolicyConditionType describes the type of a NetworkPolicyCondition.
+type NetworkPolicyConditionType string
+
+// These are the valid values for NetworkPolicyConditionType.
+const (
+	// NetworkPolicyRealizationFailure is set when some of the Nodes the
+	// NetworkPolicy applies to failed to realize it. Its message lists the
+	// failed Nodes and why they failed. It is removed once all the Nodes have
+	// realized the NetworkPolicy.
+	NetworkPolicyRealizationFailure NetworkPolicyConditionType = "RealizationFailure"
+	// NetworkPolicyIngressToServicesNotLocal is set when some of the Services
+	// referred to in ingress toServices don't exist, or their traffic can be
+	// load-balanced on another Node than the Node of the selected Endpoint, in
+	// which case it is not matched by the rules. Its message lists the
+	// Services and why. It is removed once all the Services meet the
+	// requirements.
+	NetworkPolicyIngressToServicesNotLocal NetworkPolicyConditionType = "IngressToServicesNotLocal"
+)
+
+// NetworkPolicyCondition describes the state of a NetworkPolicy at a certain
+// point.
+type NetworkPolicyCondition struct {
+	// Type of the condition.
+	Type NetworkPolicyConditionType `json:"type"`
+	// Status of the condition, one of True, False, Unknown.
+	Status v1.ConditionStatus `json:"status"`
+	// Last time the condition transitioned from one status to another.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	// A brief CamelCase string that describes the reason for the condition's
+	// last transition.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// A human readable message indicating details about the transition.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // Rule describes the traffic allowed to/from the workloads selected by
@@ -364,7 +510,8 @@ type Rule struct {
 	// +optional
 	Protocols []NetworkPolicyProtocol `json:"protocols,omitempty"`
 	// Rule is matched if traffic originates from workloads selected by
-	// this field. If this field is empty, this rule matches all sources.
+	// this field. This field can't be used with ToServices. If this field is
+	// empty, this rule matches all sources.
 	// +optional
 	From []NetworkPolicyPeer `json:"from,omitempty"`
 	// Rule is matched if traffic is intended for workloads selected by
@@ -373,9 +520,14 @@ type Rule struct {
 	// +optional
 	To []NetworkPolicyPeer `json:"to,omitempty"`
 	// Rule is matched if traffic is intended for a Service listed in this field.
-	// Currently only ClusterIP types Services are supported in this field. This field
-	// can only be used when AntreaProxy is enabled. This field can't be used with To
-	// or Ports. If this field and To are both empty or missing, this rule matches all
+	// In ingress rules, the rule is matched if traffic arrives at the selected
+	// workloads through a Service listed in this field. ClusterIP, NodePort and
+	// LoadBalancer Services are supported in this field, as long as their traffic
+	// is load-balanced by AntreaProxy. In ingress rules, the listed Services must
+	// set internalTrafficPolicy, and externalTrafficPolicy for NodePort and
+	// LoadBalancer Services, to Local. This field can only be used when AntreaProxy
+	// is enabled. This field can't be used with To, From in ingress rules, or Ports.
+	// If this field and To are both empty or missing, this rule matches all
 	// destinations.
 	// +optional
 	ToServices []NamespacedName `json:"toServices,omitempty"`
@@ -391,8 +543,88 @@ type Rule struct {
 	// conjunction with NetworkPolicySpec/ClusterNetworkPolicySpec.AppliedTo.
 	// +optional
 	AppliedTo []NetworkPolicyPeer `json:"appliedTo,omitempty"`
+	// Schedule restricts the enforcement of this rule to the specified time
+	// windows. If this field is not set, the rule is always enforced.
+	// +optional
+	Schedule *RuleSchedule `json:"schedule,omitempty"`
+	// RateLimit specifies the maximum rate of the traffic matching this rule.
+	// It must be set if and only if Action is RateLimit.
+	// +optional
+	RateLimit *RateLimit `json:"rateLimit,omitempty"`
+}
+
+// RateLimit describes the maximum rate of the traffic matching a rule. Exactly
+// one of PacketsPerSecond and BitsPerSecond must be set. The limit applies to
+// all the traffic matching the rule on each Node, and the traffic exceeding it
+// is dropped.
+type RateLimit struct {
+	// PacketsPerSecond is the maximum number of packets per second.
+	// +optional
+	PacketsPerSecond *int32 `json:"packetsPerSecond,omitempty"`
+	// BitsPerSecond is the maximum number of bits per second. It is rounded
+	// up to the nearest multiple of 1000.
+	// +optional
+	BitsPerSecond *int64 `json:"bitsPerSecond,omitempty"`
+}
+
+// RuleSchedule describes when a rule is enforced. At least one of Windows and
+// CronWindows must be set, and the rule is enforced if the current time falls
+// within any of their windows.
+type RuleSchedule struct {
+	// TimeZone is the IANA name of the time zone in which the time windows are
+	// evaluated, e.g. "America/Los_Angeles". Defaults to "UTC".
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+	// Windows is the list of daily time windows during which the rule is
+	// enforced.
+	// +optional
+	Windows []TimeWindow `json:"windows,omitempty"`
+	// CronWindows is the list of time windows starting at the times matched
+	// by cron expressions during which the rule is enforced.
+	// +optional
+	CronWindows []CronWindow `json:"cronWindows,omitempty"`
+}
+
+// CronWindow describes the time windows starting at the times matched by a
+// cron expression.
+type CronWindow struct {
+	// Schedule is a cron expression in the standard 5-field format
+	// ("minute hour day-of-month month day-of-week"), e.g. "0 1 * * 1-5",
+	// or a predefined schedule such as "@daily".
+	Schedule string `json:"schedule"`
+	// Duration is how long each window lasts, in the format accepted by
+	// time.ParseDuration, e.g. "2h" or "90m".
+	Duration string `json:"duration"`
+}
+
+// TimeWindow describes a daily time window.
+type TimeWindow struct {
+	// Start is the time of day at which the window starts, in the "HH:MM"
+	// 24-hour format.
+	Start string `json:"start"`
+	// End is the time of day at which the window ends, in the "HH:MM" 24-hour
+	// format. If End is earlier than Start, the window spans midnight and ends
+	// on the following day.
+	End string `json:"end"`
+	// Days is the list of the days of the week on which the window starts,
+	// e.g. "Mon", "Tue". If it is empty, the window starts every day.
+	// +optional
+	Days []Weekday `json:"days,omitempty"`
 }
 
+// Weekday is the abbreviated English name of a day of the week.
+type Weekday string
+
+const (
+	Monday    Weekday = "Mon"
+	Tuesday   Weekday = "Tue"
+	Wednesday Weekday = "Wed"
+	Thursday  Weekday = "Thu"
+	Friday    Weekday = "Fri"
+	Saturday  Weekday = "Sat"
+	Sunday    Weekday = "Sun"
+)
+
 // NetworkPolicyPeer describes the grouping selector of workloads.
 type NetworkPolicyPeer struct {
 	// IPBlock describes the IPAddresses/IPBlocks that is matched in to/from.
@@ -503,6 +735,10 @@ const (
 	// RuleActionReject indicates that the traffic matching the rule must be rejected and the
 	// client will receive a response.
 	RuleActionReject RuleAction = "Reject"
+	// RuleActionRateLimit indicates that the traffic matching the rule must be allowed
+	// up to the rate specified in the rule, and that the traffic exceeding it must be
+	// dropped.
+	RuleActionRateLimit RuleAction = "RateLimit"
 
 	IGMPQuery    int32 = 0x11
 	IGMPReportV1 int32 = 0x12
@@ -52,6 +52,12 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&NetworkPolicyStatsList{},
 		&MulticastGroup{},
 		&MulticastGroupList{},
+		&PodTrafficStats{},
+		&PodTrafficStatsList{},
+		&NamespaceTrafficStats{},
+		&NamespaceTrafficStatsList{},
+		&NodeLatencyStats{},
+		&NodeLatencyStatsList{},
 	)
 	return nil
 }
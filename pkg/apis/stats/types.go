@@ -119,6 +119,55 @@ type NetworkPolicyStatsList struct {
 	Items []NetworkPolicyStats
 }
 
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PodTrafficStats is the traffic statistics of a Pod.
+type PodTrafficStats struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	// The traffic stats of the packets received by the Pod.
+	Ingress TrafficBreakdown
+	// The traffic stats of the packets sent by the Pod.
+	Egress TrafficBreakdown
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PodTrafficStatsList is a list of PodTrafficStats.
+type PodTrafficStatsList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+
+	// List of PodTrafficStats.
+	Items []PodTrafficStats
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NamespaceTrafficStats is the traffic statistics of a Namespace, aggregated from the Pods in the Namespace. It has the
+// same name as the Namespace.
+type NamespaceTrafficStats struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	// The traffic stats of the packets received by the Pods in the Namespace.
+	Ingress TrafficBreakdown
+	// The traffic stats of the packets sent by the Pods in the Namespace.
+	Egress TrafficBreakdown
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NamespaceTrafficStatsList is a list of NamespaceTrafficStats.
+type NamespaceTrafficStatsList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+
+	// List of NamespaceTrafficStats.
+	Items []NamespaceTrafficStats
+}
+
 // TrafficStats contains the traffic stats of a NetworkPolicy.
 type TrafficStats struct {
 	// Packets is the packets count hit by the NetworkPolicy.
@@ -134,3 +183,60 @@ type RuleTrafficStats struct {
 	Name         string
 	TrafficStats TrafficStats
 }
+
+// TrafficBreakdown contains the traffic stats of a Pod or a Namespace in one direction, broken down by the location of
+// the peer.
+type TrafficBreakdown struct {
+	// IntraNode is the traffic stats with peer Pods running on the same Node.
+	IntraNode TrafficStats
+	// InterNode is the traffic stats with peer Pods running on other Nodes.
+	InterNode TrafficStats
+	// External is the traffic stats with peers outside the Pod network.
+	External TrafficStats
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NodeLatencyStats contains the latency stats of the peer Nodes measured by a Node. It has the same name as the Node.
+type NodeLatencyStats struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	// The list of latency stats of the peer Nodes.
+	PeerNodeLatencyStats []PeerNodeLatencyStats
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NodeLatencyStatsList is a list of NodeLatencyStats.
+type NodeLatencyStatsList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+
+	// List of NodeLatencyStats.
+	Items []NodeLatencyStats
+}
+
+// PeerNodeLatencyStats contains the latency stats of a peer Node.
+type PeerNodeLatencyStats struct {
+	// The name of the peer Node.
+	NodeName string
+	// The latency stats of the IPs of the peer Node that are probed.
+	TargetIPLatencyStats []TargetIPLatencyStats
+}
+
+// TargetIPLatencyStats contains the latency stats of a probed IP of a peer Node.
+type TargetIPLatencyStats struct {
+	// The probed IP, which is the gateway IP of the peer Node.
+	TargetIP string
+	// The time when the last probe was sent.
+	LastSendTime metav1.Time
+	// The time when the last reply was received.
+	LastRecvTime metav1.Time
+	// The round-trip time of the last reply, in nanoseconds.
+	LastMeasuredRTTNanoseconds int64
+	// The number of probes sent.
+	SentProbes int64
+	// The number of probes that got no reply within the probe interval.
+	LostProbes int64
+}
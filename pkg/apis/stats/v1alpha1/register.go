@@ -26,6 +26,13 @@ const GroupName = "stats.antrea.io"
 // SchemeGroupVersion is group version used to register these objects
 var SchemeGroupVersion = schema.GroupVersion{Group: GroupName, Version: "v1alpha1"}
 
+var (
+	NodeLatencyStatsVersionResource = schema.GroupVersionResource{
+		Group:    SchemeGroupVersion.Group,
+		Version:  SchemeGroupVersion.Version,
+		Resource: "nodelatencystats"}
+)
+
 // Resource takes an unqualified resource and returns a Group qualified GroupResource
 func Resource(resource string) schema.GroupResource {
 	return SchemeGroupVersion.WithResource(resource).GroupResource()
@@ -49,6 +56,12 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&NetworkPolicyStatsList{},
 		&MulticastGroup{},
 		&MulticastGroupList{},
+		&PodTrafficStats{},
+		&PodTrafficStatsList{},
+		&NamespaceTrafficStats{},
+		&NamespaceTrafficStatsList{},
+		&NodeLatencyStats{},
+		&NodeLatencyStatsList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -112,6 +112,62 @@ type MulticastGroupList struct {
 	Items []MulticastGroup `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
 
+// +genclient
+// +resourceName=podtrafficstats
+// +genclient:readonly
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PodTrafficStats is the traffic statistics of a Pod.
+type PodTrafficStats struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// The traffic stats of the packets received by the Pod.
+	Ingress TrafficBreakdown `json:"ingress,omitempty" protobuf:"bytes,2,opt,name=ingress"`
+	// The traffic stats of the packets sent by the Pod.
+	Egress TrafficBreakdown `json:"egress,omitempty" protobuf:"bytes,3,opt,name=egress"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PodTrafficStatsList is a list of PodTrafficStats.
+type PodTrafficStatsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// List of PodTrafficStats.
+	Items []PodTrafficStats `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// +genclient
+// +resourceName=namespacetrafficstats
+// +genclient:readonly
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NamespaceTrafficStats is the traffic statistics of a Namespace, aggregated from the Pods in the Namespace. It has the
+// same name as the Namespace.
+type NamespaceTrafficStats struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// The traffic stats of the packets received by the Pods in the Namespace.
+	Ingress TrafficBreakdown `json:"ingress,omitempty" protobuf:"bytes,2,opt,name=ingress"`
+	// The traffic stats of the packets sent by the Pods in the Namespace.
+	Egress TrafficBreakdown `json:"egress,omitempty" protobuf:"bytes,3,opt,name=egress"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NamespaceTrafficStatsList is a list of NamespaceTrafficStats.
+type NamespaceTrafficStatsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// List of NamespaceTrafficStats.
+	Items []NamespaceTrafficStats `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // NetworkPolicyStatsList is a list of NetworkPolicyStats.
@@ -146,3 +202,64 @@ type RuleTrafficStats struct {
 	Name         string       `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
 	TrafficStats TrafficStats `json:"trafficStats,omitempty" protobuf:"bytes,2,opt,name=trafficStats"`
 }
+
+// TrafficBreakdown contains the traffic stats of a Pod or a Namespace in one direction, broken down by the location of
+// the peer.
+type TrafficBreakdown struct {
+	// IntraNode is the traffic stats with peer Pods running on the same Node.
+	IntraNode TrafficStats `json:"intraNode,omitempty" protobuf:"bytes,1,opt,name=intraNode"`
+	// InterNode is the traffic stats with peer Pods running on other Nodes.
+	InterNode TrafficStats `json:"interNode,omitempty" protobuf:"bytes,2,opt,name=interNode"`
+	// External is the traffic stats with peers outside the Pod network.
+	External TrafficStats `json:"external,omitempty" protobuf:"bytes,3,opt,name=external"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:onlyVerbs=create,delete,get,list
+// +resourceName=nodelatencystats
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NodeLatencyStats contains the latency stats of the peer Nodes measured by a Node. It has the same name as the Node.
+type NodeLatencyStats struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// The list of latency stats of the peer Nodes.
+	PeerNodeLatencyStats []PeerNodeLatencyStats `json:"peerNodeLatencyStats,omitempty" protobuf:"bytes,2,rep,name=peerNodeLatencyStats"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NodeLatencyStatsList is a list of NodeLatencyStats.
+type NodeLatencyStatsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// List of NodeLatencyStats.
+	Items []NodeLatencyStats `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// PeerNodeLatencyStats contains the latency stats of a peer Node.
+type PeerNodeLatencyStats struct {
+	// The name of the peer Node.
+	NodeName string `json:"nodeName,omitempty" protobuf:"bytes,1,opt,name=nodeName"`
+	// The latency stats of the IPs of the peer Node that are probed.
+	TargetIPLatencyStats []TargetIPLatencyStats `json:"targetIPLatencyStats,omitempty" protobuf:"bytes,2,rep,name=targetIPLatencyStats"`
+}
+
+// TargetIPLatencyStats contains the latency stats of a probed IP of a peer Node.
+type TargetIPLatencyStats struct {
+	// The probed IP, which is the gateway IP of the peer Node.
+	TargetIP string `json:"targetIP,omitempty" protobuf:"bytes,1,opt,name=targetIP"`
+	// The time when the last probe was sent.
+	LastSendTime metav1.Time `json:"lastSendTime,omitempty" protobuf:"bytes,2,opt,name=lastSendTime"`
+	// The time when the last reply was received.
+	LastRecvTime metav1.Time `json:"lastRecvTime,omitempty" protobuf:"bytes,3,opt,name=lastRecvTime"`
+	// The round-trip time of the last reply, in nanoseconds.
+	LastMeasuredRTTNanoseconds int64 `json:"lastMeasuredRTTNanoseconds,omitempty" protobuf:"varint,4,opt,name=lastMeasuredRTTNanoseconds"`
+	// The number of probes sent.
+	SentProbes int64 `json:"sentProbes,omitempty" protobuf:"varint,5,opt,name=sentProbes"`
+	// The number of probes that got no reply within the probe interval.
+	LostProbes int64 `json:"lostProbes,omitempty" protobuf:"varint,6,opt,name=lostProbes"`
+}
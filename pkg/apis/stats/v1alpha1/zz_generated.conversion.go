@@ -94,6 +94,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceTrafficStats)(nil), (*stats.NamespaceTrafficStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NamespaceTrafficStats_To_stats_NamespaceTrafficStats(a.(*NamespaceTrafficStats), b.(*stats.NamespaceTrafficStats), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*stats.NamespaceTrafficStats)(nil), (*NamespaceTrafficStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_stats_NamespaceTrafficStats_To_v1alpha1_NamespaceTrafficStats(a.(*stats.NamespaceTrafficStats), b.(*NamespaceTrafficStats), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceTrafficStatsList)(nil), (*stats.NamespaceTrafficStatsList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NamespaceTrafficStatsList_To_stats_NamespaceTrafficStatsList(a.(*NamespaceTrafficStatsList), b.(*stats.NamespaceTrafficStatsList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*stats.NamespaceTrafficStatsList)(nil), (*NamespaceTrafficStatsList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_stats_NamespaceTrafficStatsList_To_v1alpha1_NamespaceTrafficStatsList(a.(*stats.NamespaceTrafficStatsList), b.(*NamespaceTrafficStatsList), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NetworkPolicyStats)(nil), (*stats.NetworkPolicyStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NetworkPolicyStats_To_stats_NetworkPolicyStats(a.(*NetworkPolicyStats), b.(*stats.NetworkPolicyStats), scope)
 	}); err != nil {
@@ -114,6 +134,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NodeLatencyStats)(nil), (*stats.NodeLatencyStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NodeLatencyStats_To_stats_NodeLatencyStats(a.(*NodeLatencyStats), b.(*stats.NodeLatencyStats), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*stats.NodeLatencyStats)(nil), (*NodeLatencyStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_stats_NodeLatencyStats_To_v1alpha1_NodeLatencyStats(a.(*stats.NodeLatencyStats), b.(*NodeLatencyStats), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NodeLatencyStatsList)(nil), (*stats.NodeLatencyStatsList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NodeLatencyStatsList_To_stats_NodeLatencyStatsList(a.(*NodeLatencyStatsList), b.(*stats.NodeLatencyStatsList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*stats.NodeLatencyStatsList)(nil), (*NodeLatencyStatsList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_stats_NodeLatencyStatsList_To_v1alpha1_NodeLatencyStatsList(a.(*stats.NodeLatencyStatsList), b.(*NodeLatencyStatsList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PeerNodeLatencyStats)(nil), (*stats.PeerNodeLatencyStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PeerNodeLatencyStats_To_stats_PeerNodeLatencyStats(a.(*PeerNodeLatencyStats), b.(*stats.PeerNodeLatencyStats), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*stats.PeerNodeLatencyStats)(nil), (*PeerNodeLatencyStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_stats_PeerNodeLatencyStats_To_v1alpha1_PeerNodeLatencyStats(a.(*stats.PeerNodeLatencyStats), b.(*PeerNodeLatencyStats), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*PodReference)(nil), (*stats.PodReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_PodReference_To_stats_PodReference(a.(*PodReference), b.(*stats.PodReference), scope)
 	}); err != nil {
@@ -124,6 +174,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PodTrafficStats)(nil), (*stats.PodTrafficStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PodTrafficStats_To_stats_PodTrafficStats(a.(*PodTrafficStats), b.(*stats.PodTrafficStats), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*stats.PodTrafficStats)(nil), (*PodTrafficStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_stats_PodTrafficStats_To_v1alpha1_PodTrafficStats(a.(*stats.PodTrafficStats), b.(*PodTrafficStats), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PodTrafficStatsList)(nil), (*stats.PodTrafficStatsList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PodTrafficStatsList_To_stats_PodTrafficStatsList(a.(*PodTrafficStatsList), b.(*stats.PodTrafficStatsList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*stats.PodTrafficStatsList)(nil), (*PodTrafficStatsList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_stats_PodTrafficStatsList_To_v1alpha1_PodTrafficStatsList(a.(*stats.PodTrafficStatsList), b.(*PodTrafficStatsList), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RuleTrafficStats)(nil), (*stats.RuleTrafficStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_RuleTrafficStats_To_stats_RuleTrafficStats(a.(*RuleTrafficStats), b.(*stats.RuleTrafficStats), scope)
 	}); err != nil {
@@ -134,6 +204,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*TargetIPLatencyStats)(nil), (*stats.TargetIPLatencyStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TargetIPLatencyStats_To_stats_TargetIPLatencyStats(a.(*TargetIPLatencyStats), b.(*stats.TargetIPLatencyStats), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*stats.TargetIPLatencyStats)(nil), (*TargetIPLatencyStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_stats_TargetIPLatencyStats_To_v1alpha1_TargetIPLatencyStats(a.(*stats.TargetIPLatencyStats), b.(*TargetIPLatencyStats), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TrafficBreakdown)(nil), (*stats.TrafficBreakdown)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TrafficBreakdown_To_stats_TrafficBreakdown(a.(*TrafficBreakdown), b.(*stats.TrafficBreakdown), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*stats.TrafficBreakdown)(nil), (*TrafficBreakdown)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_stats_TrafficBreakdown_To_v1alpha1_TrafficBreakdown(a.(*stats.TrafficBreakdown), b.(*TrafficBreakdown), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*TrafficStats)(nil), (*stats.TrafficStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_TrafficStats_To_stats_TrafficStats(a.(*TrafficStats), b.(*stats.TrafficStats), scope)
 	}); err != nil {
@@ -293,6 +383,60 @@ func Convert_stats_MulticastGroupList_To_v1alpha1_MulticastGroupList(in *stats.M
 	return autoConvert_stats_MulticastGroupList_To_v1alpha1_MulticastGroupList(in, out, s)
 }
 
+func autoConvert_v1alpha1_NamespaceTrafficStats_To_stats_NamespaceTrafficStats(in *NamespaceTrafficStats, out *stats.NamespaceTrafficStats, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_TrafficBreakdown_To_stats_TrafficBreakdown(&in.Ingress, &out.Ingress, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_TrafficBreakdown_To_stats_TrafficBreakdown(&in.Egress, &out.Egress, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_NamespaceTrafficStats_To_stats_NamespaceTrafficStats is an autogenerated conversion function.
+func Convert_v1alpha1_NamespaceTrafficStats_To_stats_NamespaceTrafficStats(in *NamespaceTrafficStats, out *stats.NamespaceTrafficStats, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NamespaceTrafficStats_To_stats_NamespaceTrafficStats(in, out, s)
+}
+
+func autoConvert_stats_NamespaceTrafficStats_To_v1alpha1_NamespaceTrafficStats(in *stats.NamespaceTrafficStats, out *NamespaceTrafficStats, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_stats_TrafficBreakdown_To_v1alpha1_TrafficBreakdown(&in.Ingress, &out.Ingress, s); err != nil {
+		return err
+	}
+	if err := Convert_stats_TrafficBreakdown_To_v1alpha1_TrafficBreakdown(&in.Egress, &out.Egress, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_stats_NamespaceTrafficStats_To_v1alpha1_NamespaceTrafficStats is an autogenerated conversion function.
+func Convert_stats_NamespaceTrafficStats_To_v1alpha1_NamespaceTrafficStats(in *stats.NamespaceTrafficStats, out *NamespaceTrafficStats, s conversion.Scope) error {
+	return autoConvert_stats_NamespaceTrafficStats_To_v1alpha1_NamespaceTrafficStats(in, out, s)
+}
+
+func autoConvert_v1alpha1_NamespaceTrafficStatsList_To_stats_NamespaceTrafficStatsList(in *NamespaceTrafficStatsList, out *stats.NamespaceTrafficStatsList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]stats.NamespaceTrafficStats)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_NamespaceTrafficStatsList_To_stats_NamespaceTrafficStatsList is an autogenerated conversion function.
+func Convert_v1alpha1_NamespaceTrafficStatsList_To_stats_NamespaceTrafficStatsList(in *NamespaceTrafficStatsList, out *stats.NamespaceTrafficStatsList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NamespaceTrafficStatsList_To_stats_NamespaceTrafficStatsList(in, out, s)
+}
+
+func autoConvert_stats_NamespaceTrafficStatsList_To_v1alpha1_NamespaceTrafficStatsList(in *stats.NamespaceTrafficStatsList, out *NamespaceTrafficStatsList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]NamespaceTrafficStats)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_stats_NamespaceTrafficStatsList_To_v1alpha1_NamespaceTrafficStatsList is an autogenerated conversion function.
+func Convert_stats_NamespaceTrafficStatsList_To_v1alpha1_NamespaceTrafficStatsList(in *stats.NamespaceTrafficStatsList, out *NamespaceTrafficStatsList, s conversion.Scope) error {
+	return autoConvert_stats_NamespaceTrafficStatsList_To_v1alpha1_NamespaceTrafficStatsList(in, out, s)
+}
+
 func autoConvert_v1alpha1_NetworkPolicyStats_To_stats_NetworkPolicyStats(in *NetworkPolicyStats, out *stats.NetworkPolicyStats, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha1_TrafficStats_To_stats_TrafficStats(&in.TrafficStats, &out.TrafficStats, s); err != nil {
@@ -341,6 +485,72 @@ func Convert_stats_NetworkPolicyStatsList_To_v1alpha1_NetworkPolicyStatsList(in
 	return autoConvert_stats_NetworkPolicyStatsList_To_v1alpha1_NetworkPolicyStatsList(in, out, s)
 }
 
+func autoConvert_v1alpha1_NodeLatencyStats_To_stats_NodeLatencyStats(in *NodeLatencyStats, out *stats.NodeLatencyStats, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.PeerNodeLatencyStats = *(*[]stats.PeerNodeLatencyStats)(unsafe.Pointer(&in.PeerNodeLatencyStats))
+	return nil
+}
+
+// Convert_v1alpha1_NodeLatencyStats_To_stats_NodeLatencyStats is an autogenerated conversion function.
+func Convert_v1alpha1_NodeLatencyStats_To_stats_NodeLatencyStats(in *NodeLatencyStats, out *stats.NodeLatencyStats, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NodeLatencyStats_To_stats_NodeLatencyStats(in, out, s)
+}
+
+func autoConvert_stats_NodeLatencyStats_To_v1alpha1_NodeLatencyStats(in *stats.NodeLatencyStats, out *NodeLatencyStats, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.PeerNodeLatencyStats = *(*[]PeerNodeLatencyStats)(unsafe.Pointer(&in.PeerNodeLatencyStats))
+	return nil
+}
+
+// Convert_stats_NodeLatencyStats_To_v1alpha1_NodeLatencyStats is an autogenerated conversion function.
+func Convert_stats_NodeLatencyStats_To_v1alpha1_NodeLatencyStats(in *stats.NodeLatencyStats, out *NodeLatencyStats, s conversion.Scope) error {
+	return autoConvert_stats_NodeLatencyStats_To_v1alpha1_NodeLatencyStats(in, out, s)
+}
+
+func autoConvert_v1alpha1_NodeLatencyStatsList_To_stats_NodeLatencyStatsList(in *NodeLatencyStatsList, out *stats.NodeLatencyStatsList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]stats.NodeLatencyStats)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_NodeLatencyStatsList_To_stats_NodeLatencyStatsList is an autogenerated conversion function.
+func Convert_v1alpha1_NodeLatencyStatsList_To_stats_NodeLatencyStatsList(in *NodeLatencyStatsList, out *stats.NodeLatencyStatsList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NodeLatencyStatsList_To_stats_NodeLatencyStatsList(in, out, s)
+}
+
+func autoConvert_stats_NodeLatencyStatsList_To_v1alpha1_NodeLatencyStatsList(in *stats.NodeLatencyStatsList, out *NodeLatencyStatsList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]NodeLatencyStats)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_stats_NodeLatencyStatsList_To_v1alpha1_NodeLatencyStatsList is an autogenerated conversion function.
+func Convert_stats_NodeLatencyStatsList_To_v1alpha1_NodeLatencyStatsList(in *stats.NodeLatencyStatsList, out *NodeLatencyStatsList, s conversion.Scope) error {
+	return autoConvert_stats_NodeLatencyStatsList_To_v1alpha1_NodeLatencyStatsList(in, out, s)
+}
+
+func autoConvert_v1alpha1_PeerNodeLatencyStats_To_stats_PeerNodeLatencyStats(in *PeerNodeLatencyStats, out *stats.PeerNodeLatencyStats, s conversion.Scope) error {
+	out.NodeName = in.NodeName
+	out.TargetIPLatencyStats = *(*[]stats.TargetIPLatencyStats)(unsafe.Pointer(&in.TargetIPLatencyStats))
+	return nil
+}
+
+// Convert_v1alpha1_PeerNodeLatencyStats_To_stats_PeerNodeLatencyStats is an autogenerated conversion function.
+func Convert_v1alpha1_PeerNodeLatencyStats_To_stats_PeerNodeLatencyStats(in *PeerNodeLatencyStats, out *stats.PeerNodeLatencyStats, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PeerNodeLatencyStats_To_stats_PeerNodeLatencyStats(in, out, s)
+}
+
+func autoConvert_stats_PeerNodeLatencyStats_To_v1alpha1_PeerNodeLatencyStats(in *stats.PeerNodeLatencyStats, out *PeerNodeLatencyStats, s conversion.Scope) error {
+	out.NodeName = in.NodeName
+	out.TargetIPLatencyStats = *(*[]TargetIPLatencyStats)(unsafe.Pointer(&in.TargetIPLatencyStats))
+	return nil
+}
+
+// Convert_stats_PeerNodeLatencyStats_To_v1alpha1_PeerNodeLatencyStats is an autogenerated conversion function.
+func Convert_stats_PeerNodeLatencyStats_To_v1alpha1_PeerNodeLatencyStats(in *stats.PeerNodeLatencyStats, out *PeerNodeLatencyStats, s conversion.Scope) error {
+	return autoConvert_stats_PeerNodeLatencyStats_To_v1alpha1_PeerNodeLatencyStats(in, out, s)
+}
+
 func autoConvert_v1alpha1_PodReference_To_stats_PodReference(in *PodReference, out *stats.PodReference, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Namespace = in.Namespace
@@ -363,6 +573,60 @@ func Convert_stats_PodReference_To_v1alpha1_PodReference(in *stats.PodReference,
 	return autoConvert_stats_PodReference_To_v1alpha1_PodReference(in, out, s)
 }
 
+func autoConvert_v1alpha1_PodTrafficStats_To_stats_PodTrafficStats(in *PodTrafficStats, out *stats.PodTrafficStats, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_TrafficBreakdown_To_stats_TrafficBreakdown(&in.Ingress, &out.Ingress, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_TrafficBreakdown_To_stats_TrafficBreakdown(&in.Egress, &out.Egress, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_PodTrafficStats_To_stats_PodTrafficStats is an autogenerated conversion function.
+func Convert_v1alpha1_PodTrafficStats_To_stats_PodTrafficStats(in *PodTrafficStats, out *stats.PodTrafficStats, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PodTrafficStats_To_stats_PodTrafficStats(in, out, s)
+}
+
+func autoConvert_stats_PodTrafficStats_To_v1alpha1_PodTrafficStats(in *stats.PodTrafficStats, out *PodTrafficStats, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_stats_TrafficBreakdown_To_v1alpha1_TrafficBreakdown(&in.Ingress, &out.Ingress, s); err != nil {
+		return err
+	}
+	if err := Convert_stats_TrafficBreakdown_To_v1alpha1_TrafficBreakdown(&in.Egress, &out.Egress, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_stats_PodTrafficStats_To_v1alpha1_PodTrafficStats is an autogenerated conversion function.
+func Convert_stats_PodTrafficStats_To_v1alpha1_PodTrafficStats(in *stats.PodTrafficStats, out *PodTrafficStats, s conversion.Scope) error {
+	return autoConvert_stats_PodTrafficStats_To_v1alpha1_PodTrafficStats(in, out, s)
+}
+
+func autoConvert_v1alpha1_PodTrafficStatsList_To_stats_PodTrafficStatsList(in *PodTrafficStatsList, out *stats.PodTrafficStatsList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]stats.PodTrafficStats)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_PodTrafficStatsList_To_stats_PodTrafficStatsList is an autogenerated conversion function.
+func Convert_v1alpha1_PodTrafficStatsList_To_stats_PodTrafficStatsList(in *PodTrafficStatsList, out *stats.PodTrafficStatsList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PodTrafficStatsList_To_stats_PodTrafficStatsList(in, out, s)
+}
+
+func autoConvert_stats_PodTrafficStatsList_To_v1alpha1_PodTrafficStatsList(in *stats.PodTrafficStatsList, out *PodTrafficStatsList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]PodTrafficStats)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_stats_PodTrafficStatsList_To_v1alpha1_PodTrafficStatsList is an autogenerated conversion function.
+func Convert_stats_PodTrafficStatsList_To_v1alpha1_PodTrafficStatsList(in *stats.PodTrafficStatsList, out *PodTrafficStatsList, s conversion.Scope) error {
+	return autoConvert_stats_PodTrafficStatsList_To_v1alpha1_PodTrafficStatsList(in, out, s)
+}
+
 func autoConvert_v1alpha1_RuleTrafficStats_To_stats_RuleTrafficStats(in *RuleTrafficStats, out *stats.RuleTrafficStats, s conversion.Scope) error {
 	out.Name = in.Name
 	if err := Convert_v1alpha1_TrafficStats_To_stats_TrafficStats(&in.TrafficStats, &out.TrafficStats, s); err != nil {
@@ -389,6 +653,72 @@ func Convert_stats_RuleTrafficStats_To_v1alpha1_RuleTrafficStats(in *stats.RuleT
 	return autoConvert_stats_RuleTrafficStats_To_v1alpha1_RuleTrafficStats(in, out, s)
 }
 
+func autoConvert_v1alpha1_TargetIPLatencyStats_To_stats_TargetIPLatencyStats(in *TargetIPLatencyStats, out *stats.TargetIPLatencyStats, s conversion.Scope) error {
+	out.TargetIP = in.TargetIP
+	out.LastSendTime = in.LastSendTime
+	out.LastRecvTime = in.LastRecvTime
+	out.LastMeasuredRTTNanoseconds = in.LastMeasuredRTTNanoseconds
+	out.SentProbes = in.SentProbes
+	out.LostProbes = in.LostProbes
+	return nil
+}
+
+// Convert_v1alpha1_TargetIPLatencyStats_To_stats_TargetIPLatencyStats is an autogenerated conversion function.
+func Convert_v1alpha1_TargetIPLatencyStats_To_stats_TargetIPLatencyStats(in *TargetIPLatencyStats, out *stats.TargetIPLatencyStats, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TargetIPLatencyStats_To_stats_TargetIPLatencyStats(in, out, s)
+}
+
+func autoConvert_stats_TargetIPLatencyStats_To_v1alpha1_TargetIPLatencyStats(in *stats.TargetIPLatencyStats, out *TargetIPLatencyStats, s conversion.Scope) error {
+	out.TargetIP = in.TargetIP
+	out.LastSendTime = in.LastSendTime
+	out.LastRecvTime = in.LastRecvTime
+	out.LastMeasuredRTTNanoseconds = in.LastMeasuredRTTNanoseconds
+	out.SentProbes = in.SentProbes
+	out.LostProbes = in.LostProbes
+	return nil
+}
+
+// Convert_stats_TargetIPLatencyStats_To_v1alpha1_TargetIPLatencyStats is an autogenerated conversion function.
+func Convert_stats_TargetIPLatencyStats_To_v1alpha1_TargetIPLatencyStats(in *stats.TargetIPLatencyStats, out *TargetIPLatencyStats, s conversion.Scope) error {
+	return autoConvert_stats_TargetIPLatencyStats_To_v1alpha1_TargetIPLatencyStats(in, out, s)
+}
+
+func autoConvert_v1alpha1_TrafficBreakdown_To_stats_TrafficBreakdown(in *TrafficBreakdown, out *stats.TrafficBreakdown, s conversion.Scope) error {
+	if err := Convert_v1alpha1_TrafficStats_To_stats_TrafficStats(&in.IntraNode, &out.IntraNode, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_TrafficStats_To_stats_TrafficStats(&in.InterNode, &out.InterNode, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_TrafficStats_To_stats_TrafficStats(&in.External, &out.External, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_TrafficBreakdown_To_stats_TrafficBreakdown is an autogenerated conversion function.
+func Convert_v1alpha1_TrafficBreakdown_To_stats_TrafficBreakdown(in *TrafficBreakdown, out *stats.TrafficBreakdown, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TrafficBreakdown_To_stats_TrafficBreakdown(in, out, s)
+}
+
+func autoConvert_stats_TrafficBreakdown_To_v1alpha1_TrafficBreakdown(in *stats.TrafficBreakdown, out *TrafficBreakdown, s conversion.Scope) error {
+	if err := Convert_stats_TrafficStats_To_v1alpha1_TrafficStats(&in.IntraNode, &out.IntraNode, s); err != nil {
+		return err
+	}
+	if err := Convert_stats_TrafficStats_To_v1alpha1_TrafficStats(&in.InterNode, &out.InterNode, s); err != nil {
+		return err
+	}
+	if err := Convert_stats_TrafficStats_To_v1alpha1_TrafficStats(&in.External, &out.External, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_stats_TrafficBreakdown_To_v1alpha1_TrafficBreakdown is an autogenerated conversion function.
+func Convert_stats_TrafficBreakdown_To_v1alpha1_TrafficBreakdown(in *stats.TrafficBreakdown, out *TrafficBreakdown, s conversion.Scope) error {
+	return autoConvert_stats_TrafficBreakdown_To_v1alpha1_TrafficBreakdown(in, out, s)
+}
+
 func autoConvert_v1alpha1_TrafficStats_To_stats_TrafficStats(in *TrafficStats, out *stats.TrafficStats, s conversion.Scope) error {
 	out.Packets = in.Packets
 	out.Bytes = in.Bytes
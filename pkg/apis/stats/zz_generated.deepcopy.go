@@ -217,6 +217,67 @@ func (in *MulticastGroupList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceTrafficStats) DeepCopyInto(out *NamespaceTrafficStats) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Ingress = in.Ingress
+	out.Egress = in.Egress
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceTrafficStats.
+func (in *NamespaceTrafficStats) DeepCopy() *NamespaceTrafficStats {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceTrafficStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NamespaceTrafficStats) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceTrafficStatsList) DeepCopyInto(out *NamespaceTrafficStatsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NamespaceTrafficStats, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceTrafficStatsList.
+func (in *NamespaceTrafficStatsList) DeepCopy() *NamespaceTrafficStatsList {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceTrafficStatsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NamespaceTrafficStatsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkPolicyStats) DeepCopyInto(out *NetworkPolicyStats) {
 	*out = *in
@@ -277,6 +338,95 @@ func (in *NetworkPolicyStatsList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeLatencyStats) DeepCopyInto(out *NodeLatencyStats) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.PeerNodeLatencyStats != nil {
+		in, out := &in.PeerNodeLatencyStats, &out.PeerNodeLatencyStats
+		*out = make([]PeerNodeLatencyStats, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeLatencyStats.
+func (in *NodeLatencyStats) DeepCopy() *NodeLatencyStats {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeLatencyStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NodeLatencyStats) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeLatencyStatsList) DeepCopyInto(out *NodeLatencyStatsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NodeLatencyStats, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeLatencyStatsList.
+func (in *NodeLatencyStatsList) DeepCopy() *NodeLatencyStatsList {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeLatencyStatsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NodeLatencyStatsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PeerNodeLatencyStats) DeepCopyInto(out *PeerNodeLatencyStats) {
+	*out = *in
+	if in.TargetIPLatencyStats != nil {
+		in, out := &in.TargetIPLatencyStats, &out.TargetIPLatencyStats
+		*out = make([]TargetIPLatencyStats, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PeerNodeLatencyStats.
+func (in *PeerNodeLatencyStats) DeepCopy() *PeerNodeLatencyStats {
+	if in == nil {
+		return nil
+	}
+	out := new(PeerNodeLatencyStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodReference) DeepCopyInto(out *PodReference) {
 	*out = *in
@@ -293,6 +443,67 @@ func (in *PodReference) DeepCopy() *PodReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodTrafficStats) DeepCopyInto(out *PodTrafficStats) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Ingress = in.Ingress
+	out.Egress = in.Egress
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodTrafficStats.
+func (in *PodTrafficStats) DeepCopy() *PodTrafficStats {
+	if in == nil {
+		return nil
+	}
+	out := new(PodTrafficStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PodTrafficStats) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodTrafficStatsList) DeepCopyInto(out *PodTrafficStatsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PodTrafficStats, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodTrafficStatsList.
+func (in *PodTrafficStatsList) DeepCopy() *PodTrafficStatsList {
+	if in == nil {
+		return nil
+	}
+	out := new(PodTrafficStatsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PodTrafficStatsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RuleTrafficStats) DeepCopyInto(out *RuleTrafficStats) {
 	*out = *in
@@ -310,6 +521,43 @@ func (in *RuleTrafficStats) DeepCopy() *RuleTrafficStats {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetIPLatencyStats) DeepCopyInto(out *TargetIPLatencyStats) {
+	*out = *in
+	in.LastSendTime.DeepCopyInto(&out.LastSendTime)
+	in.LastRecvTime.DeepCopyInto(&out.LastRecvTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetIPLatencyStats.
+func (in *TargetIPLatencyStats) DeepCopy() *TargetIPLatencyStats {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetIPLatencyStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficBreakdown) DeepCopyInto(out *TrafficBreakdown) {
+	*out = *in
+	out.IntraNode = in.IntraNode
+	out.InterNode = in.InterNode
+	out.External = in.External
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficBreakdown.
+func (in *TrafficBreakdown) DeepCopy() *TrafficBreakdown {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficBreakdown)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrafficStats) DeepCopyInto(out *TrafficStats) {
 	*out = *in
@@ -33,9 +33,13 @@ type SupportBundle struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	Status   BundleStatus `json:"status,omitempty"`
-	Sum      string       `json:"sum,omitempty"`
-	Since    string       `json:"since,omitempty"`
-	Size     uint32       `json:"size,omitempty"`
-	Filepath string       `json:"-"`
+	Status BundleStatus `json:"status,omitempty"`
+	Sum    string       `json:"sum,omitempty"`
+	Since  string       `json:"since,omitempty"`
+	Size   uint32       `json:"size,omitempty"`
+	// RedactionKey is the key used to replace IP addresses, Pod names and label
+	// values in the bundle with deterministic tokens. The bundle is not redacted
+	// if it is empty. It is never returned by the server.
+	RedactionKey string `json:"redactionKey,omitempty"`
+	Filepath     string `json:"-"`
 }
@@ -40,6 +40,7 @@ import (
 	"antrea.io/antrea/pkg/apiserver/handlers/endpoint"
 	"antrea.io/antrea/pkg/apiserver/handlers/featuregates"
 	"antrea.io/antrea/pkg/apiserver/handlers/loglevel"
+	"antrea.io/antrea/pkg/apiserver/handlers/policyverdict"
 	"antrea.io/antrea/pkg/apiserver/handlers/webhook"
 	"antrea.io/antrea/pkg/apiserver/registry/controlplane/egressgroup"
 	"antrea.io/antrea/pkg/apiserver/registry/controlplane/nodestatssummary"
@@ -51,7 +52,10 @@ import (
 	"antrea.io/antrea/pkg/apiserver/registry/stats/antreaclusternetworkpolicystats"
 	"antrea.io/antrea/pkg/apiserver/registry/stats/antreanetworkpolicystats"
 	"antrea.io/antrea/pkg/apiserver/registry/stats/multicastgroup"
+	"antrea.io/antrea/pkg/apiserver/registry/stats/namespacetrafficstats"
 	"antrea.io/antrea/pkg/apiserver/registry/stats/networkpolicystats"
+	"antrea.io/antrea/pkg/apiserver/registry/stats/nodelatencystats"
+	"antrea.io/antrea/pkg/apiserver/registry/stats/podtrafficstats"
 	"antrea.io/antrea/pkg/apiserver/registry/system/controllerinfo"
 	"antrea.io/antrea/pkg/apiserver/registry/system/supportbundle"
 	"antrea.io/antrea/pkg/apiserver/storage"
@@ -95,6 +99,7 @@ type ExtraConfig struct {
 	egressGroupStore              storage.Interface
 	controllerQuerier             querier.ControllerQuerier
 	endpointQuerier               controllernetworkpolicy.EndpointQuerier
+	policyVerdictQuerier          controllernetworkpolicy.PolicyVerdictQuerier
 	networkPolicyController       *controllernetworkpolicy.NetworkPolicyController
 	egressController              *egress.EgressController
 	externalIPPoolController      *externalippool.ExternalIPPoolController
@@ -139,6 +144,7 @@ func NewConfig(
 	controllerQuerier querier.ControllerQuerier,
 	networkPolicyStatusController *controllernetworkpolicy.StatusController,
 	endpointQuerier controllernetworkpolicy.EndpointQuerier,
+	policyVerdictQuerier controllernetworkpolicy.PolicyVerdictQuerier,
 	npController *controllernetworkpolicy.NetworkPolicyController,
 	egressController *egress.EgressController) *Config {
 	return &Config{
@@ -153,6 +159,7 @@ func NewConfig(
 			statsAggregator:               statsAggregator,
 			controllerQuerier:             controllerQuerier,
 			endpointQuerier:               endpointQuerier,
+			policyVerdictQuerier:          policyVerdictQuerier,
 			networkPolicyController:       npController,
 			networkPolicyStatusController: networkPolicyStatusController,
 			egressController:              egressController,
@@ -167,7 +174,11 @@ func (c *Config) Complete(informers informers.SharedInformerFactory) completedCo
 func installAPIGroup(s *APIServer, c completedConfig) error {
 	addressGroupStorage := addressgroup.NewREST(c.extraConfig.addressGroupStore)
 	appliedToGroupStorage := appliedtogroup.NewREST(c.extraConfig.appliedToGroupStore)
-	networkPolicyStorage := networkpolicy.NewREST(c.extraConfig.networkPolicyStore)
+	networkPolicyStorage := networkpolicy.NewREST(c.extraConfig.networkPolicyStore, nil)
+	// The status controller is only running when AntreaPolicy is enabled.
+	if c.extraConfig.networkPolicyStatusController != nil {
+		networkPolicyStorage = networkpolicy.NewREST(c.extraConfig.networkPolicyStore, c.extraConfig.networkPolicyStatusController)
+	}
 	networkPolicyStatusStorage := networkpolicy.NewStatusREST(c.extraConfig.networkPolicyStatusController)
 	clusterGroupMembershipStorage := clustergroupmember.NewREST(c.extraConfig.networkPolicyController)
 	groupAssociationStorage := groupassociation.NewREST(c.extraConfig.networkPolicyController)
@@ -199,6 +210,9 @@ func installAPIGroup(s *APIServer, c completedConfig) error {
 	statsStorage["antreaclusternetworkpolicystats"] = antreaclusternetworkpolicystats.NewREST(c.extraConfig.statsAggregator)
 	statsStorage["antreanetworkpolicystats"] = antreanetworkpolicystats.NewREST(c.extraConfig.statsAggregator)
 	statsStorage["multicastgroups"] = multicastgroup.NewREST(c.extraConfig.statsAggregator)
+	statsStorage["podtrafficstats"] = podtrafficstats.NewREST(c.extraConfig.statsAggregator)
+	statsStorage["namespacetrafficstats"] = namespacetrafficstats.NewREST(c.extraConfig.statsAggregator)
+	statsStorage["nodelatencystats"] = nodelatencystats.NewREST(c.genericConfig.SharedInformerFactory.Core().V1().Nodes(), c.genericConfig.SharedInformerFactory.Core().V1().Pods().Lister())
 	statsGroup.VersionedResourcesStorageMap["v1alpha1"] = statsStorage
 
 	groups := []*genericapiserver.APIGroupInfo{&cpGroup, &systemGroup, &statsGroup}
@@ -260,6 +274,7 @@ func installHandlers(c *ExtraConfig, s *genericapiserver.GenericAPIServer) {
 	s.Handler.NonGoRestfulMux.HandleFunc("/loglevel", loglevel.HandleFunc())
 	s.Handler.NonGoRestfulMux.HandleFunc("/featuregates", featuregates.HandleFunc(c.k8sClient))
 	s.Handler.NonGoRestfulMux.HandleFunc("/endpoint", endpoint.HandleFunc(c.endpointQuerier))
+	s.Handler.NonGoRestfulMux.HandleFunc("/policyverdict", policyverdict.HandleFunc(c.policyVerdictQuerier))
 	// Webhook to mutate Namespace labels and add its metadata.name as a label
 	s.Handler.NonGoRestfulMux.HandleFunc("/mutate/namespace", webhook.HandleMutationLabels())
 	if features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
@@ -0,0 +1,126 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policyverdict
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	"antrea.io/antrea/pkg/controller/networkpolicy"
+)
+
+// HandleFunc creates a http.HandlerFunc which uses a PolicyVerdictQuerier to
+// evaluate the NetworkPolicies for the connection described by the query
+// parameters.
+func HandleFunc(pq networkpolicy.PolicyVerdictQuerier) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query, err := parseQuery(r.URL.Query())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		response, err := pq.QueryPolicyVerdict(query)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				http.Error(w, err.Error(), http.StatusNotFound)
+			} else {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+			}
+			return
+		}
+		if err := json.NewEncoder(w).Encode(*response); err != nil {
+			http.Error(w, "failed to encode response: "+err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
+
+// parseNamespacedName parses "<Namespace>/<name>", or "<name>" in the
+// "default" Namespace.
+func parseNamespacedName(s string) (string, string) {
+	if i := strings.Index(s, "/"); i >= 0 {
+		return s[:i], s[i+1:]
+	}
+	return "default", s
+}
+
+func parseQuery(values url.Values) (*networkpolicy.PolicyVerdictQuery, error) {
+	query := &networkpolicy.PolicyVerdictQuery{}
+	sourcePod, sourceNamespace, sourceIP := values.Get("source-pod"), values.Get("source-namespace"), values.Get("source-ip")
+	if values.Get("source-labels") != "" && sourceNamespace == "" {
+		return nil, fmt.Errorf("source-labels can only be used with source-namespace")
+	}
+	switch {
+	case sourcePod != "" && sourceNamespace == "" && sourceIP == "":
+		query.SourceNamespace, query.SourcePod = parseNamespacedName(sourcePod)
+	case sourceNamespace != "" && sourcePod == "" && sourceIP == "":
+		query.SourceNamespace = sourceNamespace
+		if sourceLabels := values.Get("source-labels"); sourceLabels != "" {
+			podLabels, err := labels.ConvertSelectorToLabelsMap(sourceLabels)
+			if err != nil {
+				return nil, fmt.Errorf("invalid source labels %s: %v", sourceLabels, err)
+			}
+			query.SourceLabels = podLabels
+		}
+	case sourceIP != "" && sourcePod == "" && sourceNamespace == "":
+		if net.ParseIP(sourceIP) == nil {
+			return nil, fmt.Errorf("invalid source IP %s", sourceIP)
+		}
+		query.SourceIP = sourceIP
+	default:
+		return nil, fmt.Errorf("exactly one of source-pod, source-namespace and source-ip must be provided")
+	}
+
+	destinationPod, destinationService, destinationIP := values.Get("destination-pod"), values.Get("destination-service"), values.Get("destination-ip")
+	switch {
+	case destinationPod != "" && destinationService == "" && destinationIP == "":
+		query.DestinationNamespace, query.DestinationPod = parseNamespacedName(destinationPod)
+	case destinationService != "" && destinationPod == "" && destinationIP == "":
+		query.DestinationNamespace, query.DestinationService = parseNamespacedName(destinationService)
+	case destinationIP != "" && destinationPod == "" && destinationService == "":
+		if net.ParseIP(destinationIP) == nil {
+			return nil, fmt.Errorf("invalid destination IP %s", destinationIP)
+		}
+		query.DestinationIP = destinationIP
+	default:
+		return nil, fmt.Errorf("exactly one of destination-pod, destination-service and destination-ip must be provided")
+	}
+
+	query.Protocol = controlplane.ProtocolTCP
+	if protocol := values.Get("protocol"); protocol != "" {
+		query.Protocol = controlplane.Protocol(strings.ToUpper(protocol))
+		switch query.Protocol {
+		case controlplane.ProtocolTCP, controlplane.ProtocolUDP, controlplane.ProtocolSCTP, controlplane.ProtocolICMP:
+		default:
+			return nil, fmt.Errorf("unsupported protocol %s", protocol)
+		}
+	}
+	if query.Protocol != controlplane.ProtocolICMP {
+		port, err := strconv.ParseUint(values.Get("port"), 10, 16)
+		if err != nil || port == 0 {
+			return nil, fmt.Errorf("a valid port must be provided for protocol %s", query.Protocol)
+		}
+		query.Port = int32(port)
+	}
+	return query, nil
+}
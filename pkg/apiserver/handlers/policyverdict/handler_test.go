@@ -0,0 +1,149 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policyverdict
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	"antrea.io/antrea/pkg/controller/networkpolicy"
+	queriermock "antrea.io/antrea/pkg/controller/networkpolicy/testing"
+)
+
+func TestPolicyVerdictQuery(t *testing.T) {
+	response := &networkpolicy.PolicyVerdictResponse{
+		Verdicts: []networkpolicy.ConnectionVerdict{
+			{Source: "ns1/pod1", Destination: "ns2/pod2", Protocol: "TCP", Port: 80, Verdict: networkpolicy.VerdictDrop},
+		},
+	}
+	tests := []struct {
+		name           string
+		request        string
+		expectedQuery  *networkpolicy.PolicyVerdictQuery
+		queryErr       error
+		expectedStatus int
+	}{
+		{
+			name:    "Pod to Pod",
+			request: "?source-pod=ns1/pod1&destination-pod=ns2/pod2&port=80",
+			expectedQuery: &networkpolicy.PolicyVerdictQuery{
+				SourceNamespace:      "ns1",
+				SourcePod:            "pod1",
+				DestinationNamespace: "ns2",
+				DestinationPod:       "pod2",
+				Protocol:             controlplane.ProtocolTCP,
+				Port:                 80,
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:    "Namespace to Service",
+			request: "?source-namespace=ns1&source-labels=app%3Dclient&destination-service=svc&protocol=udp&port=53",
+			expectedQuery: &networkpolicy.PolicyVerdictQuery{
+				SourceNamespace:      "ns1",
+				SourceLabels:         map[string]string{"app": "client"},
+				DestinationNamespace: "default",
+				DestinationService:   "svc",
+				Protocol:             controlplane.ProtocolUDP,
+				Port:                 53,
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:    "IP to IP with ICMP",
+			request: "?source-ip=10.0.0.1&destination-ip=10.0.0.2&protocol=ICMP",
+			expectedQuery: &networkpolicy.PolicyVerdictQuery{
+				SourceIP:      "10.0.0.1",
+				DestinationIP: "10.0.0.2",
+				Protocol:      controlplane.ProtocolICMP,
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:    "Pod not found",
+			request: "?source-pod=ns1/pod1&destination-ip=10.0.0.2&port=443",
+			expectedQuery: &networkpolicy.PolicyVerdictQuery{
+				SourceNamespace: "ns1",
+				SourcePod:       "pod1",
+				DestinationIP:   "10.0.0.2",
+				Protocol:        controlplane.ProtocolTCP,
+				Port:            443,
+			},
+			queryErr:       fmt.Errorf("failed to get source Pod ns1/pod1: %w", apierrors.NewNotFound(schema.GroupResource{Resource: "pods"}, "pod1")),
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "missing source",
+			request:        "?destination-ip=10.0.0.2&port=80",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "multiple destinations",
+			request:        "?source-ip=10.0.0.1&destination-ip=10.0.0.2&destination-pod=pod2&port=80",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "labels without Namespace",
+			request:        "?source-pod=pod1&source-labels=app%3Dclient&destination-ip=10.0.0.2&port=80",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "missing port",
+			request:        "?source-ip=10.0.0.1&destination-ip=10.0.0.2",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid protocol",
+			request:        "?source-ip=10.0.0.1&destination-ip=10.0.0.2&protocol=IGMP",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockQuerier := queriermock.NewMockPolicyVerdictQuerier(mockCtrl)
+			if tt.expectedQuery != nil {
+				var resp *networkpolicy.PolicyVerdictResponse
+				if tt.queryErr == nil {
+					resp = response
+				}
+				mockQuerier.EXPECT().QueryPolicyVerdict(tt.expectedQuery).Return(resp, tt.queryErr)
+			}
+			handler := HandleFunc(mockQuerier)
+			req, err := http.NewRequest(http.MethodGet, tt.request, nil)
+			require.NoError(t, err)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+			assert.Equal(t, tt.expectedStatus, recorder.Code)
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+			var received networkpolicy.PolicyVerdictResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &received))
+			assert.Equal(t, *response, received)
+		})
+	}
+}
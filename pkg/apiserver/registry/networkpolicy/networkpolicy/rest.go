@@ -35,6 +35,12 @@ import (
 // REST implements rest.Storage for NetworkPolicies.
 type REST struct {
 	networkPolicyStore storage.Interface
+	statusProvider     realizationStatusProvider
+}
+
+// realizationStatusProvider is the interface required to populate the realization status of NetworkPolicies.
+type realizationStatusProvider interface {
+	GetRealizationStatus(internalNP *types.NetworkPolicy) *controlplane.NetworkPolicyRealizationStatus
 }
 
 var (
@@ -45,9 +51,10 @@ var (
 	_ rest.Getter  = &REST{}
 )
 
-// NewREST returns a REST object that will work against API services.
-func NewREST(networkPolicyStore storage.Interface) *REST {
-	return &REST{networkPolicyStore}
+// NewREST returns a REST object that will work against API services. statusProvider can be nil, in which case the
+// realization status of NetworkPolicies is not populated.
+func NewREST(networkPolicyStore storage.Interface, statusProvider realizationStatusProvider) *REST {
+	return &REST{networkPolicyStore, statusProvider}
 }
 
 func (r *REST) New() runtime.Object {
@@ -67,7 +74,7 @@ func (r *REST) Get(ctx context.Context, name string, options *metav1.GetOptions)
 		return nil, errors.NewNotFound(controlplane.Resource("networkpolicy"), name)
 	}
 	obj := new(controlplane.NetworkPolicy)
-	store.ToNetworkPolicyMsg(networkPolicy.(*types.NetworkPolicy), obj, true)
+	r.toNetworkPolicyMsg(networkPolicy.(*types.NetworkPolicy), obj)
 	return obj, nil
 }
 
@@ -80,7 +87,7 @@ func (r *REST) List(ctx context.Context, options *internalversion.ListOptions) (
 	items := make([]controlplane.NetworkPolicy, 0, len(networkPolicies))
 	for i := range networkPolicies {
 		var item controlplane.NetworkPolicy
-		store.ToNetworkPolicyMsg(networkPolicies[i].(*types.NetworkPolicy), &item, true)
+		r.toNetworkPolicyMsg(networkPolicies[i].(*types.NetworkPolicy), &item)
 		if labelSelector.Matches(labels.Set(item.Labels)) {
 			items = append(items, item)
 		}
@@ -89,6 +96,15 @@ func (r *REST) List(ctx context.Context, options *internalversion.ListOptions) (
 	return list, nil
 }
 
+// toNetworkPolicyMsg converts the internal NetworkPolicy to a controlplane NetworkPolicy, including its realization
+// status if available.
+func (r *REST) toNetworkPolicyMsg(internalNP *types.NetworkPolicy, obj *controlplane.NetworkPolicy) {
+	store.ToNetworkPolicyMsg(internalNP, obj, true)
+	if r.statusProvider != nil {
+		obj.RealizationStatus = r.statusProvider.GetRealizationStatus(internalNP)
+	}
+}
+
 func (r *REST) NamespaceScoped() bool {
 	return false
 }
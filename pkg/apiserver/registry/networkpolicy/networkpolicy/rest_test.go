@@ -71,10 +71,49 @@ func TestRESTList(t *testing.T) {
 			for _, obj := range tt.networkPolicies {
 				storage.Create(obj)
 			}
-			r := NewREST(storage)
+			r := NewREST(storage, nil)
 			actualObj, err := r.List(context.TODO(), &internalversion.ListOptions{LabelSelector: tt.labelSelector})
 			assert.NoError(t, err)
 			assert.ElementsMatch(t, tt.expectedObj.(*controlplane.NetworkPolicyList).Items, actualObj.(*controlplane.NetworkPolicyList).Items)
 		})
 	}
 }
+
+type fakeRealizationStatusProvider struct {
+	statuses map[string]*controlplane.NetworkPolicyRealizationStatus
+}
+
+func (p *fakeRealizationStatusProvider) GetRealizationStatus(internalNP *types.NetworkPolicy) *controlplane.NetworkPolicyRealizationStatus {
+	return p.statuses[internalNP.Name]
+}
+
+func TestRESTGetRealizationStatus(t *testing.T) {
+	realizationStatus := &controlplane.NetworkPolicyRealizationStatus{
+		CurrentNodesRealized: 1,
+		DesiredNodesRealized: 2,
+		FailedNodes: []controlplane.NetworkPolicyNodeStatus{
+			{NodeName: "node2", Generation: 1, Reason: controlplane.NetworkPolicyFlowInstallationFailed, Message: "rule1: error adding flows"},
+		},
+	}
+	storage := store.NewNetworkPolicyStore()
+	storage.Create(&types.NetworkPolicy{Name: "foo"})
+	storage.Create(&types.NetworkPolicy{Name: "bar"})
+	r := NewREST(storage, &fakeRealizationStatusProvider{statuses: map[string]*controlplane.NetworkPolicyRealizationStatus{"foo": realizationStatus}})
+
+	obj, err := r.Get(context.TODO(), "foo", &v1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, realizationStatus, obj.(*controlplane.NetworkPolicy).RealizationStatus)
+	obj, err = r.Get(context.TODO(), "bar", &v1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Nil(t, obj.(*controlplane.NetworkPolicy).RealizationStatus)
+
+	list, err := r.List(context.TODO(), &internalversion.ListOptions{})
+	assert.NoError(t, err)
+	for _, item := range list.(*controlplane.NetworkPolicyList).Items {
+		if item.Name == "foo" {
+			assert.Equal(t, realizationStatus, item.RealizationStatus)
+		} else {
+			assert.Nil(t, item.RealizationStatus)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespacetrafficstats
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metatable "k8s.io/apimachinery/pkg/api/meta/table"
+	"k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
+	"antrea.io/antrea/pkg/features"
+)
+
+type REST struct {
+	statsProvider statsProvider
+}
+
+// NewREST returns a REST object that will work against API services.
+func NewREST(p statsProvider) *REST {
+	return &REST{p}
+}
+
+var (
+	_ rest.Storage = &REST{}
+	_ rest.Scoper  = &REST{}
+	_ rest.Getter  = &REST{}
+	_ rest.Lister  = &REST{}
+)
+
+type statsProvider interface {
+	ListNamespaceTrafficStats() []statsv1alpha1.NamespaceTrafficStats
+
+	GetNamespaceTrafficStats(name string) (*statsv1alpha1.NamespaceTrafficStats, bool)
+}
+
+func (r *REST) New() runtime.Object {
+	return &statsv1alpha1.NamespaceTrafficStats{}
+}
+
+func (r *REST) NewList() runtime.Object {
+	return &statsv1alpha1.NamespaceTrafficStatsList{}
+}
+
+func (r *REST) List(ctx context.Context, options *internalversion.ListOptions) (runtime.Object, error) {
+	if !features.DefaultFeatureGate.Enabled(features.NetworkPolicyStats) || !features.DefaultFeatureGate.Enabled(features.PodTrafficStats) {
+		return &statsv1alpha1.NamespaceTrafficStatsList{}, nil
+	}
+	labelSelector := labels.Everything()
+	if options != nil && options.LabelSelector != nil {
+		labelSelector = options.LabelSelector
+	}
+	stats := r.statsProvider.ListNamespaceTrafficStats()
+	items := make([]statsv1alpha1.NamespaceTrafficStats, 0, len(stats))
+	for i := range stats {
+		if labelSelector.Matches(labels.Set(stats[i].Labels)) {
+			items = append(items, stats[i])
+		}
+	}
+	metricList := &statsv1alpha1.NamespaceTrafficStatsList{
+		Items: items,
+	}
+	return metricList, nil
+}
+
+func (r *REST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	if !features.DefaultFeatureGate.Enabled(features.NetworkPolicyStats) || !features.DefaultFeatureGate.Enabled(features.PodTrafficStats) {
+		return nil, errors.NewBadRequest("feature PodTrafficStats disabled")
+	}
+	metric, exists := r.statsProvider.GetNamespaceTrafficStats(name)
+	if !exists {
+		return nil, errors.NewNotFound(statsv1alpha1.Resource("namespacetrafficstats"), name)
+	}
+	return metric, nil
+}
+
+var swaggerMetadataDescriptions = metav1.ObjectMeta{}.SwaggerDoc()
+
+func (r *REST) ConvertToTable(ctx context.Context, obj runtime.Object, tableOptions runtime.Object) (*metav1.Table, error) {
+	table := &metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "Name", Type: "string", Format: "name", Description: swaggerMetadataDescriptions["name"]},
+			{Name: "Ingress-Packets", Type: "integer", Description: "The packets count received by the Pods in the Namespace."},
+			{Name: "Ingress-Bytes", Type: "integer", Description: "The bytes count received by the Pods in the Namespace."},
+			{Name: "Egress-Packets", Type: "integer", Description: "The packets count sent by the Pods in the Namespace."},
+			{Name: "Egress-Bytes", Type: "integer", Description: "The bytes count sent by the Pods in the Namespace."},
+			{Name: "Created At", Type: "date", Description: swaggerMetadataDescriptions["creationTimestamp"]},
+		},
+	}
+	if m, err := meta.ListAccessor(obj); err == nil {
+		table.ResourceVersion = m.GetResourceVersion()
+		table.Continue = m.GetContinue()
+		table.RemainingItemCount = m.GetRemainingItemCount()
+	} else {
+		if m, err := meta.CommonAccessor(obj); err == nil {
+			table.ResourceVersion = m.GetResourceVersion()
+		}
+	}
+
+	var err error
+	table.Rows, err = metatable.MetaToTableRow(obj, func(obj runtime.Object, m metav1.Object, name, age string) ([]interface{}, error) {
+		stats := obj.(*statsv1alpha1.NamespaceTrafficStats)
+		ingress, egress := sumTrafficBreakdown(&stats.Ingress), sumTrafficBreakdown(&stats.Egress)
+		return []interface{}{name, ingress.Packets, ingress.Bytes, egress.Packets, egress.Bytes, m.GetCreationTimestamp().Time.UTC().Format(time.RFC3339)}, nil
+	})
+	return table, err
+}
+
+// sumTrafficBreakdown returns the total traffic stats of all categories in a TrafficBreakdown.
+func sumTrafficBreakdown(breakdown *statsv1alpha1.TrafficBreakdown) statsv1alpha1.TrafficStats {
+	return statsv1alpha1.TrafficStats{
+		Packets:  breakdown.IntraNode.Packets + breakdown.InterNode.Packets + breakdown.External.Packets,
+		Bytes:    breakdown.IntraNode.Bytes + breakdown.InterNode.Bytes + breakdown.External.Bytes,
+		Sessions: breakdown.IntraNode.Sessions + breakdown.InterNode.Sessions + breakdown.External.Sessions,
+	}
+}
+
+func (r *REST) NamespaceScoped() bool {
+	return false
+}
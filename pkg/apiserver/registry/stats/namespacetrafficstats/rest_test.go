@@ -0,0 +1,151 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespacetrafficstats
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
+
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
+	"antrea.io/antrea/pkg/features"
+)
+
+type fakeStatsProvider struct {
+	stats map[string]statsv1alpha1.NamespaceTrafficStats
+}
+
+func (p *fakeStatsProvider) ListNamespaceTrafficStats() []statsv1alpha1.NamespaceTrafficStats {
+	var list []statsv1alpha1.NamespaceTrafficStats
+	for _, m := range p.stats {
+		list = append(list, m)
+	}
+	return list
+}
+
+func (p *fakeStatsProvider) GetNamespaceTrafficStats(name string) (*statsv1alpha1.NamespaceTrafficStats, bool) {
+	m, exists := p.stats[name]
+	if !exists {
+		return nil, false
+	}
+	return &m, true
+}
+
+var (
+	fooStats = statsv1alpha1.NamespaceTrafficStats{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+		Ingress: statsv1alpha1.TrafficBreakdown{
+			InterNode: statsv1alpha1.TrafficStats{Packets: 1, Bytes: 10},
+		},
+	}
+	barStats = statsv1alpha1.NamespaceTrafficStats{
+		ObjectMeta: metav1.ObjectMeta{Name: "bar"},
+		Egress: statsv1alpha1.TrafficBreakdown{
+			IntraNode: statsv1alpha1.TrafficStats{Packets: 2, Bytes: 20},
+		},
+	}
+	allStats = map[string]statsv1alpha1.NamespaceTrafficStats{
+		"foo": fooStats,
+		"bar": barStats,
+	}
+)
+
+func TestRESTGet(t *testing.T) {
+	tests := []struct {
+		name                   string
+		podTrafficStatsEnabled bool
+		namespace              string
+		expectedObj            runtime.Object
+		expectedErr            bool
+	}{
+		{
+			name:                   "PodTrafficStats feature disabled",
+			podTrafficStatsEnabled: false,
+			namespace:              "foo",
+			expectedErr:            true,
+		},
+		{
+			name:                   "Namespace not found",
+			podTrafficStatsEnabled: true,
+			namespace:              "baz",
+			expectedErr:            true,
+		},
+		{
+			name:                   "Namespace found",
+			podTrafficStatsEnabled: true,
+			namespace:              "foo",
+			expectedObj:            &fooStats,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.NetworkPolicyStats, true)()
+			defer featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.PodTrafficStats, tt.podTrafficStatsEnabled)()
+
+			r := NewREST(&fakeStatsProvider{stats: allStats})
+			actualObj, err := r.Get(context.TODO(), tt.namespace, &metav1.GetOptions{})
+			if tt.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedObj, actualObj)
+		})
+	}
+}
+
+func TestRESTList(t *testing.T) {
+	tests := []struct {
+		name                   string
+		podTrafficStatsEnabled bool
+		labelSelector          labels.Selector
+		expectedItems          []statsv1alpha1.NamespaceTrafficStats
+	}{
+		{
+			name:                   "PodTrafficStats feature disabled",
+			podTrafficStatsEnabled: false,
+			expectedItems:          nil,
+		},
+		{
+			name:                   "all Namespaces",
+			podTrafficStatsEnabled: true,
+			expectedItems:          []statsv1alpha1.NamespaceTrafficStats{fooStats, barStats},
+		},
+		{
+			name:                   "label selector selecting nothing",
+			podTrafficStatsEnabled: true,
+			labelSelector:          labels.Nothing(),
+			expectedItems:          []statsv1alpha1.NamespaceTrafficStats{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.NetworkPolicyStats, true)()
+			defer featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.PodTrafficStats, tt.podTrafficStatsEnabled)()
+
+			r := NewREST(&fakeStatsProvider{stats: allStats})
+			actualObj, err := r.List(context.TODO(), &internalversion.ListOptions{LabelSelector: tt.labelSelector})
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.expectedItems, actualObj.(*statsv1alpha1.NamespaceTrafficStatsList).Items)
+		})
+	}
+}
@@ -0,0 +1,274 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodelatencystats
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metatable "k8s.io/apimachinery/pkg/api/meta/table"
+	"k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apiserver/pkg/authentication/serviceaccount"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
+	"antrea.io/antrea/pkg/features"
+	"antrea.io/antrea/pkg/util/env"
+)
+
+// REST implements rest.Storage for NodeLatencyStats. The stats are reported by
+// antrea-agents and are only kept in memory, so they are lost when
+// antrea-controller restarts and are reported again by the next probe round.
+// The stats of a Node are removed when the Node is deleted.
+type REST struct {
+	mutex sync.RWMutex
+	// stats stores the latest NodeLatencyStats reported by each Node, keyed
+	// by the Node name.
+	stats map[string]*statsv1alpha1.NodeLatencyStats
+	// podLister is used to check that the stats of a Node are reported by
+	// the antrea-agent Pod running on it.
+	podLister corelisters.PodLister
+}
+
+// NewREST returns a REST object that will work against API services.
+func NewREST(nodeInformer coreinformers.NodeInformer, podLister corelisters.PodLister) *REST {
+	r := &REST{
+		stats:     map[string]*statsv1alpha1.NodeLatencyStats{},
+		podLister: podLister,
+	}
+	nodeInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		DeleteFunc: r.deleteNode,
+	})
+	return r
+}
+
+func (r *REST) deleteNode(obj interface{}) {
+	node, ok := obj.(*corev1.Node)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			klog.Errorf("Received unexpected object: %v", obj)
+			return
+		}
+		node, ok = tombstone.Obj.(*corev1.Node)
+		if !ok {
+			klog.Errorf("DeletedFinalStateUnknown contains non-Node object: %v", tombstone.Obj)
+			return
+		}
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	delete(r.stats, node.Name)
+}
+
+// verifyRequester checks that the request is sent by the antrea-agent running on
+// the Node. The antrea-agent Pod is identified by the extra fields of its
+// ServiceAccount token, which are only provided when bound ServiceAccount tokens
+// are used (BoundServiceAccountTokenVolume feature gate of K8s).
+func (r *REST) verifyRequester(ctx context.Context, nodeName string) error {
+	forbidden := func(err error) error {
+		return errors.NewForbidden(statsv1alpha1.Resource("nodelatencystats"), nodeName, err)
+	}
+	userInfo, ok := genericapirequest.UserFrom(ctx)
+	if !ok {
+		return forbidden(fmt.Errorf("unknown requester"))
+	}
+	if userInfo.GetName() != serviceaccount.MakeUsername(env.GetAntreaNamespace(), antreaAgentServiceAccountName) {
+		return forbidden(fmt.Errorf("only antrea-agent can report NodeLatencyStats"))
+	}
+	podNameValues, podUIDValues := userInfo.GetExtra()[serviceaccount.PodNameKey], userInfo.GetExtra()[serviceaccount.PodUIDKey]
+	if len(podNameValues) == 0 && len(podUIDValues) == 0 {
+		klog.InfoS("Could not determine the Pod identity of the requester, enable K8s BoundServiceAccountTokenVolume feature gate to provide maximum security", "node", nodeName)
+		return nil
+	}
+	if len(podNameValues) == 0 || len(podUIDValues) == 0 || podNameValues[0] == "" || podUIDValues[0] == "" {
+		return forbidden(fmt.Errorf("the Pod name and UID of the requester are required"))
+	}
+	pod, err := r.podLister.Pods(env.GetAntreaNamespace()).Get(podNameValues[0])
+	if errors.IsNotFound(err) {
+		return forbidden(fmt.Errorf("Pod %s not found", podNameValues[0]))
+	} else if err != nil {
+		return errors.NewInternalError(err)
+	}
+	if pod.UID != types.UID(podUIDValues[0]) {
+		return forbidden(fmt.Errorf("UID of Pod %s does not match", pod.Name))
+	}
+	if pod.Spec.NodeName != nodeName {
+		return forbidden(fmt.Errorf("Pod %s is not running on Node %s", pod.Name, nodeName))
+	}
+	return nil
+}
+
+const antreaAgentServiceAccountName = "antrea-agent"
+
+var (
+	_ rest.Storage         = &REST{}
+	_ rest.Scoper          = &REST{}
+	_ rest.Getter          = &REST{}
+	_ rest.Lister          = &REST{}
+	_ rest.Creater         = &REST{}
+	_ rest.GracefulDeleter = &REST{}
+)
+
+func (r *REST) New() runtime.Object {
+	return &statsv1alpha1.NodeLatencyStats{}
+}
+
+func (r *REST) NewList() runtime.Object {
+	return &statsv1alpha1.NodeLatencyStatsList{}
+}
+
+// Create stores the NodeLatencyStats reported by a Node, replacing the previous
+// one reported by the same Node if any. Only the antrea-agent running on the
+// Node can report its stats.
+func (r *REST) Create(ctx context.Context, obj runtime.Object, createValidation rest.ValidateObjectFunc, options *metav1.CreateOptions) (runtime.Object, error) {
+	if !features.DefaultFeatureGate.Enabled(features.NodeLatencyMonitor) {
+		return nil, errors.NewBadRequest("feature NodeLatencyMonitor disabled")
+	}
+	stats := obj.(*statsv1alpha1.NodeLatencyStats)
+	if stats.Name == "" {
+		return nil, errors.NewBadRequest(fmt.Sprintf("name is required for %s", statsv1alpha1.Resource("nodelatencystats")))
+	}
+	if err := r.verifyRequester(ctx, stats.Name); err != nil {
+		return nil, err
+	}
+	if createValidation != nil {
+		if err := createValidation(ctx, obj); err != nil {
+			return nil, err
+		}
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	stored := stats.DeepCopy()
+	if existing, exists := r.stats[stats.Name]; exists {
+		stored.CreationTimestamp = existing.CreationTimestamp
+	} else {
+		stored.CreationTimestamp = metav1.Time{Time: time.Now()}
+	}
+	r.stats[stats.Name] = stored
+	return stored.DeepCopy(), nil
+}
+
+func (r *REST) List(ctx context.Context, options *internalversion.ListOptions) (runtime.Object, error) {
+	if !features.DefaultFeatureGate.Enabled(features.NodeLatencyMonitor) {
+		return &statsv1alpha1.NodeLatencyStatsList{}, nil
+	}
+	labelSelector := labels.Everything()
+	if options != nil && options.LabelSelector != nil {
+		labelSelector = options.LabelSelector
+	}
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	items := make([]statsv1alpha1.NodeLatencyStats, 0, len(r.stats))
+	for _, stats := range r.stats {
+		if labelSelector.Matches(labels.Set(stats.Labels)) {
+			items = append(items, *stats.DeepCopy())
+		}
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Name < items[j].Name
+	})
+	return &statsv1alpha1.NodeLatencyStatsList{Items: items}, nil
+}
+
+func (r *REST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	if !features.DefaultFeatureGate.Enabled(features.NodeLatencyMonitor) {
+		return nil, errors.NewBadRequest("feature NodeLatencyMonitor disabled")
+	}
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	stats, exists := r.stats[name]
+	if !exists {
+		return nil, errors.NewNotFound(statsv1alpha1.Resource("nodelatencystats"), name)
+	}
+	return stats.DeepCopy(), nil
+}
+
+func (r *REST) Delete(ctx context.Context, name string, deleteValidation rest.ValidateObjectFunc, options *metav1.DeleteOptions) (runtime.Object, bool, error) {
+	if !features.DefaultFeatureGate.Enabled(features.NodeLatencyMonitor) {
+		return nil, false, errors.NewBadRequest("feature NodeLatencyMonitor disabled")
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	stats, exists := r.stats[name]
+	if !exists {
+		return nil, false, errors.NewNotFound(statsv1alpha1.Resource("nodelatencystats"), name)
+	}
+	if deleteValidation != nil {
+		if err := deleteValidation(ctx, stats); err != nil {
+			return nil, false, err
+		}
+	}
+	delete(r.stats, name)
+	return stats, true, nil
+}
+
+var swaggerMetadataDescriptions = metav1.ObjectMeta{}.SwaggerDoc()
+
+func (r *REST) ConvertToTable(ctx context.Context, obj runtime.Object, tableOptions runtime.Object) (*metav1.Table, error) {
+	table := &metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "Name", Type: "string", Format: "name", Description: swaggerMetadataDescriptions["name"]},
+			{Name: "Peers", Type: "integer", Description: "The number of peer Nodes probed by the Node."},
+			{Name: "Max-RTT", Type: "string", Description: "The maximum round-trip time to the peer Nodes measured by the last probes."},
+			{Name: "Lost-Probes", Type: "integer", Description: "The total number of probes sent to the peer Nodes that got no reply."},
+			{Name: "Created At", Type: "date", Description: swaggerMetadataDescriptions["creationTimestamp"]},
+		},
+	}
+	if m, err := meta.ListAccessor(obj); err == nil {
+		table.ResourceVersion = m.GetResourceVersion()
+		table.Continue = m.GetContinue()
+		table.RemainingItemCount = m.GetRemainingItemCount()
+	} else {
+		if m, err := meta.CommonAccessor(obj); err == nil {
+			table.ResourceVersion = m.GetResourceVersion()
+		}
+	}
+
+	var err error
+	table.Rows, err = metatable.MetaToTableRow(obj, func(obj runtime.Object, m metav1.Object, name, age string) ([]interface{}, error) {
+		stats := obj.(*statsv1alpha1.NodeLatencyStats)
+		var maxRTT, lostProbes int64
+		for _, peer := range stats.PeerNodeLatencyStats {
+			for _, target := range peer.TargetIPLatencyStats {
+				if target.LastMeasuredRTTNanoseconds > maxRTT {
+					maxRTT = target.LastMeasuredRTTNanoseconds
+				}
+				lostProbes += target.LostProbes
+			}
+		}
+		return []interface{}{name, int64(len(stats.PeerNodeLatencyStats)), time.Duration(maxRTT).String(), lostProbes, m.GetCreationTimestamp().Time.UTC().Format(time.RFC3339)}, nil
+	})
+	return table, err
+}
+
+func (r *REST) NamespaceScoped() bool {
+	return false
+}
@@ -0,0 +1,284 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodelatencystats
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apiserver/pkg/authentication/serviceaccount"
+	"k8s.io/apiserver/pkg/authentication/user"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
+
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
+	"antrea.io/antrea/pkg/features"
+)
+
+func newNodeLatencyStats(name string, peers ...string) *statsv1alpha1.NodeLatencyStats {
+	stats := &statsv1alpha1.NodeLatencyStats{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	for i, peer := range peers {
+		stats.PeerNodeLatencyStats = append(stats.PeerNodeLatencyStats, statsv1alpha1.PeerNodeLatencyStats{
+			NodeName: peer,
+			TargetIPLatencyStats: []statsv1alpha1.TargetIPLatencyStats{
+				{
+					TargetIP:                   "10.10.0.1",
+					LastMeasuredRTTNanoseconds: int64(i+1) * int64(time.Millisecond),
+					SentProbes:                 10,
+					LostProbes:                 int64(i),
+				},
+			},
+		})
+	}
+	return stats
+}
+
+const antreaAgentUsername = "system:serviceaccount:kube-system:antrea-agent"
+
+func newAgentPod(nodeName string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "antrea-agent-" + nodeName, UID: types.UID("uid-" + nodeName)},
+		Spec:       corev1.PodSpec{NodeName: nodeName},
+	}
+}
+
+func newNode(name string) *corev1.Node {
+	return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}}
+}
+
+// newTestREST returns a REST whose informers are synced with the provided
+// objects. The antrea-agent Pods of node1 and node2 are always created.
+func newTestREST(t *testing.T, objects ...runtime.Object) (*REST, kubernetes.Interface) {
+	objects = append(objects, newAgentPod("node1"), newAgentPod("node2"))
+	client := fake.NewSimpleClientset(objects...)
+	informerFactory := informers.NewSharedInformerFactory(client, 0)
+	r := NewREST(informerFactory.Core().V1().Nodes(), informerFactory.Core().V1().Pods().Lister())
+	stopCh := make(chan struct{})
+	t.Cleanup(func() { close(stopCh) })
+	informerFactory.Start(stopCh)
+	informerFactory.WaitForCacheSync(stopCh)
+	return r, client
+}
+
+// agentContext returns the context of a request sent by the antrea-agent running
+// on the Node.
+func agentContext(nodeName string) context.Context {
+	pod := newAgentPod(nodeName)
+	return genericapirequest.WithUser(context.TODO(), &user.DefaultInfo{
+		Name: antreaAgentUsername,
+		Extra: map[string][]string{
+			serviceaccount.PodNameKey: {pod.Name},
+			serviceaccount.PodUIDKey:  {string(pod.UID)},
+		},
+	})
+}
+
+func TestRESTCreateAndGet(t *testing.T) {
+	defer featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.NodeLatencyMonitor, true)()
+
+	r, _ := newTestREST(t)
+	_, err := r.Get(context.TODO(), "node1", &metav1.GetOptions{})
+	assert.True(t, errors.IsNotFound(err))
+
+	_, err = r.Create(agentContext("node1"), newNodeLatencyStats(""), nil, &metav1.CreateOptions{})
+	assert.True(t, errors.IsBadRequest(err))
+
+	created, err := r.Create(agentContext("node1"), newNodeLatencyStats("node1", "node2"), nil, &metav1.CreateOptions{})
+	require.NoError(t, err)
+	creationTimestamp := created.(*statsv1alpha1.NodeLatencyStats).CreationTimestamp
+	assert.False(t, creationTimestamp.IsZero())
+
+	// Creating it again should replace the stats but keep the creation timestamp.
+	_, err = r.Create(agentContext("node1"), newNodeLatencyStats("node1", "node2", "node3"), nil, &metav1.CreateOptions{})
+	require.NoError(t, err)
+	obj, err := r.Get(context.TODO(), "node1", &metav1.GetOptions{})
+	require.NoError(t, err)
+	expected := newNodeLatencyStats("node1", "node2", "node3")
+	expected.CreationTimestamp = creationTimestamp
+	assert.Equal(t, expected, obj)
+}
+
+func TestRESTList(t *testing.T) {
+	tests := []struct {
+		name                      string
+		nodeLatencyMonitorEnabled bool
+		labelSelector             labels.Selector
+		expectedItems             []statsv1alpha1.NodeLatencyStats
+	}{
+		{
+			name:                      "NodeLatencyMonitor feature disabled",
+			nodeLatencyMonitorEnabled: false,
+			expectedItems:             nil,
+		},
+		{
+			name:                      "all Nodes",
+			nodeLatencyMonitorEnabled: true,
+			expectedItems:             []statsv1alpha1.NodeLatencyStats{*newNodeLatencyStats("node1", "node2"), *newNodeLatencyStats("node2", "node1")},
+		},
+		{
+			name:                      "label selector selecting nothing",
+			nodeLatencyMonitorEnabled: true,
+			labelSelector:             labels.Nothing(),
+			expectedItems:             []statsv1alpha1.NodeLatencyStats{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.NodeLatencyMonitor, true)()
+
+			r, _ := newTestREST(t)
+			for _, stats := range []*statsv1alpha1.NodeLatencyStats{newNodeLatencyStats("node2", "node1"), newNodeLatencyStats("node1", "node2")} {
+				_, err := r.Create(agentContext(stats.Name), stats, nil, &metav1.CreateOptions{})
+				require.NoError(t, err)
+			}
+
+			defer featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.NodeLatencyMonitor, tt.nodeLatencyMonitorEnabled)()
+			actualObj, err := r.List(context.TODO(), &internalversion.ListOptions{LabelSelector: tt.labelSelector})
+			require.NoError(t, err)
+			items := actualObj.(*statsv1alpha1.NodeLatencyStatsList).Items
+			for i := range items {
+				items[i].CreationTimestamp = metav1.Time{}
+			}
+			assert.Equal(t, tt.expectedItems, items)
+		})
+	}
+}
+
+func TestRESTDelete(t *testing.T) {
+	defer featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.NodeLatencyMonitor, true)()
+
+	r, _ := newTestREST(t)
+	_, err := r.Create(agentContext("node1"), newNodeLatencyStats("node1", "node2"), nil, &metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	_, deleted, err := r.Delete(context.TODO(), "node1", nil, &metav1.DeleteOptions{})
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	_, err = r.Get(context.TODO(), "node1", &metav1.GetOptions{})
+	assert.True(t, errors.IsNotFound(err))
+
+	_, _, err = r.Delete(context.TODO(), "node1", nil, &metav1.DeleteOptions{})
+	assert.True(t, errors.IsNotFound(err))
+}
+
+func TestRESTCreateVerifyRequester(t *testing.T) {
+	defer featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.NodeLatencyMonitor, true)()
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		expectedErr bool
+	}{
+		{
+			name: "antrea-agent of the Node",
+			ctx:  agentContext("node1"),
+		},
+		{
+			name:        "antrea-agent of another Node",
+			ctx:         agentContext("node2"),
+			expectedErr: true,
+		},
+		{
+			name:        "no user",
+			ctx:         context.TODO(),
+			expectedErr: true,
+		},
+		{
+			name:        "other user",
+			ctx:         genericapirequest.WithUser(context.TODO(), &user.DefaultInfo{Name: "system:serviceaccount:kube-system:antctl"}),
+			expectedErr: true,
+		},
+		{
+			name: "antrea-agent without Pod identity",
+			ctx:  genericapirequest.WithUser(context.TODO(), &user.DefaultInfo{Name: antreaAgentUsername}),
+		},
+		{
+			name: "Pod UID mismatch",
+			ctx: genericapirequest.WithUser(context.TODO(), &user.DefaultInfo{
+				Name: antreaAgentUsername,
+				Extra: map[string][]string{
+					serviceaccount.PodNameKey: {"antrea-agent-node1"},
+					serviceaccount.PodUIDKey:  {"uid-node2"},
+				},
+			}),
+			expectedErr: true,
+		},
+		{
+			name: "Pod not found",
+			ctx: genericapirequest.WithUser(context.TODO(), &user.DefaultInfo{
+				Name: antreaAgentUsername,
+				Extra: map[string][]string{
+					serviceaccount.PodNameKey: {"antrea-agent-node3"},
+					serviceaccount.PodUIDKey:  {"uid-node3"},
+				},
+			}),
+			expectedErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, _ := newTestREST(t)
+			_, err := r.Create(tt.ctx, newNodeLatencyStats("node1", "node2"), nil, &metav1.CreateOptions{})
+			if tt.expectedErr {
+				assert.True(t, errors.IsForbidden(err), "expected Forbidden error, got %v", err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRESTDeleteNode(t *testing.T) {
+	defer featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.NodeLatencyMonitor, true)()
+
+	r, client := newTestREST(t, newNode("node1"), newNode("node2"))
+	for _, nodeName := range []string{"node1", "node2"} {
+		_, err := r.Create(agentContext(nodeName), newNodeLatencyStats(nodeName), nil, &metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, client.CoreV1().Nodes().Delete(context.TODO(), "node1", metav1.DeleteOptions{}))
+	// The stats of the deleted Node must be removed.
+	err := wait.PollImmediate(10*time.Millisecond, time.Second, func() (bool, error) {
+		_, err := r.Get(context.TODO(), "node1", &metav1.GetOptions{})
+		return errors.IsNotFound(err), nil
+	})
+	assert.NoError(t, err)
+	_, err = r.Get(context.TODO(), "node2", &metav1.GetOptions{})
+	assert.NoError(t, err)
+}
+
+func TestRESTConvertToTable(t *testing.T) {
+	r, _ := newTestREST(t)
+	stats := newNodeLatencyStats("node1", "node2", "node3")
+	table, err := r.ConvertToTable(context.TODO(), stats, nil)
+	require.NoError(t, err)
+	require.Len(t, table.Rows, 1)
+	assert.Equal(t, []interface{}{"node1", int64(2), "2ms", int64(1), stats.CreationTimestamp.Time.UTC().Format(time.RFC3339)}, table.Rows[0].Cells)
+}
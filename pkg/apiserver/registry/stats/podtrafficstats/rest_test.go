@@ -0,0 +1,189 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package podtrafficstats
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
+
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
+	"antrea.io/antrea/pkg/features"
+)
+
+type fakeStatsProvider struct {
+	stats map[string]map[string]statsv1alpha1.PodTrafficStats
+}
+
+func (p *fakeStatsProvider) ListPodTrafficStats(namespace string) []statsv1alpha1.PodTrafficStats {
+	var list []statsv1alpha1.PodTrafficStats
+	if namespace == "" {
+		for _, m1 := range p.stats {
+			for _, m2 := range m1 {
+				list = append(list, m2)
+			}
+		}
+	} else {
+		for _, m2 := range p.stats[namespace] {
+			list = append(list, m2)
+		}
+	}
+	return list
+}
+
+func (p *fakeStatsProvider) GetPodTrafficStats(namespace, name string) (*statsv1alpha1.PodTrafficStats, bool) {
+	m, exists := p.stats[namespace][name]
+	if !exists {
+		return nil, false
+	}
+	return &m, true
+}
+
+var (
+	pod1Stats = statsv1alpha1.PodTrafficStats{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "pod1"},
+		Ingress: statsv1alpha1.TrafficBreakdown{
+			IntraNode: statsv1alpha1.TrafficStats{Packets: 1, Bytes: 10},
+		},
+	}
+	pod2Stats = statsv1alpha1.PodTrafficStats{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "bar", Name: "pod2"},
+		Egress: statsv1alpha1.TrafficBreakdown{
+			External: statsv1alpha1.TrafficStats{Packets: 2, Bytes: 20},
+		},
+	}
+	allStats = map[string]map[string]statsv1alpha1.PodTrafficStats{
+		"foo": {"pod1": pod1Stats},
+		"bar": {"pod2": pod2Stats},
+	}
+)
+
+func TestRESTGet(t *testing.T) {
+	tests := []struct {
+		name                   string
+		podTrafficStatsEnabled bool
+		podNamespace           string
+		podName                string
+		expectedObj            runtime.Object
+		expectedErr            bool
+	}{
+		{
+			name:                   "PodTrafficStats feature disabled",
+			podTrafficStatsEnabled: false,
+			podNamespace:           "foo",
+			podName:                "pod1",
+			expectedErr:            true,
+		},
+		{
+			name:                   "Namespace not provided",
+			podTrafficStatsEnabled: true,
+			podName:                "pod1",
+			expectedErr:            true,
+		},
+		{
+			name:                   "Pod not found",
+			podTrafficStatsEnabled: true,
+			podNamespace:           "foo",
+			podName:                "pod2",
+			expectedErr:            true,
+		},
+		{
+			name:                   "Pod found",
+			podTrafficStatsEnabled: true,
+			podNamespace:           "foo",
+			podName:                "pod1",
+			expectedObj:            &pod1Stats,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.NetworkPolicyStats, true)()
+			defer featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.PodTrafficStats, tt.podTrafficStatsEnabled)()
+
+			r := NewREST(&fakeStatsProvider{stats: allStats})
+			ctx := request.WithNamespace(context.TODO(), tt.podNamespace)
+			actualObj, err := r.Get(ctx, tt.podName, &metav1.GetOptions{})
+			if tt.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedObj, actualObj)
+		})
+	}
+}
+
+func TestRESTList(t *testing.T) {
+	tests := []struct {
+		name                   string
+		podTrafficStatsEnabled bool
+		labelSelector          labels.Selector
+		podNamespace           string
+		expectedItems          []statsv1alpha1.PodTrafficStats
+	}{
+		{
+			name:                   "PodTrafficStats feature disabled",
+			podTrafficStatsEnabled: false,
+			expectedItems:          nil,
+		},
+		{
+			name:                   "all namespaces",
+			podTrafficStatsEnabled: true,
+			expectedItems:          []statsv1alpha1.PodTrafficStats{pod1Stats, pod2Stats},
+		},
+		{
+			name:                   "one namespace",
+			podTrafficStatsEnabled: true,
+			podNamespace:           "foo",
+			expectedItems:          []statsv1alpha1.PodTrafficStats{pod1Stats},
+		},
+		{
+			name:                   "label selector selecting nothing",
+			podTrafficStatsEnabled: true,
+			labelSelector:          labels.Nothing(),
+			expectedItems:          []statsv1alpha1.PodTrafficStats{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.NetworkPolicyStats, true)()
+			defer featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.PodTrafficStats, tt.podTrafficStatsEnabled)()
+
+			r := NewREST(&fakeStatsProvider{stats: allStats})
+			ctx := request.WithNamespace(context.TODO(), tt.podNamespace)
+			actualObj, err := r.List(ctx, &internalversion.ListOptions{LabelSelector: tt.labelSelector})
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.expectedItems, actualObj.(*statsv1alpha1.PodTrafficStatsList).Items)
+		})
+	}
+}
+
+func TestRESTConvertToTable(t *testing.T) {
+	stats := pod1Stats
+	stats.Ingress.External = statsv1alpha1.TrafficStats{Packets: 2, Bytes: 20}
+	r := NewREST(&fakeStatsProvider{})
+	table, err := r.ConvertToTable(context.TODO(), &stats, nil)
+	require.NoError(t, err)
+	require.Len(t, table.Rows, 1)
+	assert.Equal(t, []interface{}{"pod1", int64(3), int64(30), int64(0), int64(0)}, table.Rows[0].Cells[:5])
+}
@@ -36,11 +36,13 @@ import (
 	"k8s.io/klog/v2"
 	"k8s.io/utils/exec"
 
+	"antrea.io/antrea/pkg/agent/interfacestore"
 	agentquerier "antrea.io/antrea/pkg/agent/querier"
 	systemv1beta1 "antrea.io/antrea/pkg/apis/system/v1beta1"
 	"antrea.io/antrea/pkg/ovs/ovsctl"
 	"antrea.io/antrea/pkg/querier"
 	"antrea.io/antrea/pkg/support"
+	"antrea.io/antrea/pkg/util/redact"
 )
 
 const (
@@ -141,13 +143,15 @@ func (r *supportBundleREST) Create(ctx context.Context, obj runtime.Object, _ re
 		Status:     systemv1beta1.SupportBundleStatusCollecting,
 	}
 	r.cancelFunc = cancelFunc
-	go func(since string) {
+	// The redaction key is not kept in the cache, so that it is never returned
+	// to the clients.
+	go func(since, redactionKey string) {
 		var err error
 		var b *systemv1beta1.SupportBundle
 		if r.mode == modeAgent {
-			b, err = r.collectAgent(ctx, since)
+			b, err = r.collectAgent(ctx, since, redactionKey)
 		} else if r.mode == modeController {
-			b, err = r.collectController(ctx, since)
+			b, err = r.collectController(ctx, since, redactionKey)
 		}
 		func() {
 			r.statusLocker.Lock()
@@ -167,7 +171,7 @@ func (r *supportBundleREST) Create(ctx context.Context, obj runtime.Object, _ re
 		if err != nil {
 			r.clean(ctx, b.Filepath, bundleExpireDuration)
 		}
-	}(r.cache.Since)
+	}(r.cache.Since, requestBundle.RedactionKey)
 
 	return r.cache, nil
 }
@@ -209,7 +213,9 @@ func (r *supportBundleREST) NamespaceScoped() bool {
 	return false
 }
 
-func (r *supportBundleREST) collect(ctx context.Context, dumpers ...func(string) error) (*systemv1beta1.SupportBundle, error) {
+// collect runs the dumpers and packs the files they create. The files are
+// redacted before being packed if redactor is not nil.
+func (r *supportBundleREST) collect(ctx context.Context, redactor *redact.Redactor, dumpers ...func(string) error) (*systemv1beta1.SupportBundle, error) {
 	basedir, err := afero.TempDir(defaultFS, "", "bundle_tmp_")
 	if err != nil {
 		return nil, fmt.Errorf("error when creating tempdir: %w", err)
@@ -220,6 +226,11 @@ func (r *supportBundleREST) collect(ctx context.Context, dumpers ...func(string)
 			return nil, err
 		}
 	}
+	if redactor != nil {
+		if err := redactor.RedactDir(defaultFS, basedir); err != nil {
+			return nil, err
+		}
+	}
 	outputFile, err := afero.TempFile(defaultFS, "", "bundle_*.tar.gz")
 	if err != nil {
 		return nil, fmt.Errorf("error when creating output tarfile: %w", err)
@@ -256,10 +267,19 @@ func (r *supportBundleREST) collect(ctx context.Context, dumpers ...func(string)
 	}, nil
 }
 
-func (r *supportBundleREST) collectAgent(ctx context.Context, since string) (*systemv1beta1.SupportBundle, error) {
+func (r *supportBundleREST) collectAgent(ctx context.Context, since, redactionKey string) (*systemv1beta1.SupportBundle, error) {
 	dumper := support.NewAgentDumper(defaultFS, defaultExecutor, r.ovsCtlClient, r.aq, r.npq, since, r.v4Enabled, r.v6Enabled)
+	var redactor *redact.Redactor
+	if redactionKey != "" {
+		redactor = redact.NewRedactor(redactionKey)
+		// The names of the local Pods may only appear in logs and flows.
+		for _, iface := range r.aq.GetInterfaceStore().GetInterfacesByType(interfacestore.ContainerInterface) {
+			redactor.AddPodNames(iface.PodName)
+		}
+	}
 	return r.collect(
 		ctx,
+		redactor,
 		dumper.DumpLog,
 		dumper.DumpHostNetworkInfo,
 		dumper.DumpFlows,
@@ -267,13 +287,20 @@ func (r *supportBundleREST) collectAgent(ctx context.Context, since string) (*sy
 		dumper.DumpAgentInfo,
 		dumper.DumpHeapPprof,
 		dumper.DumpOVSPorts,
+		dumper.DumpOVSDatapath,
+		dumper.DumpOVSDB,
 	)
 }
 
-func (r *supportBundleREST) collectController(ctx context.Context, since string) (*systemv1beta1.SupportBundle, error) {
+func (r *supportBundleREST) collectController(ctx context.Context, since, redactionKey string) (*systemv1beta1.SupportBundle, error) {
 	dumper := support.NewControllerDumper(defaultFS, defaultExecutor, since)
+	var redactor *redact.Redactor
+	if redactionKey != "" {
+		redactor = redact.NewRedactor(redactionKey)
+	}
 	return r.collect(
 		ctx,
+		redactor,
 		dumper.DumpLog,
 		dumper.DumpNetworkPolicyResources,
 		dumper.DumpControllerInfo,
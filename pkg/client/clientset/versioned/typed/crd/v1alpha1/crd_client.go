@@ -28,6 +28,7 @@ type CrdV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	ClusterNetworkPoliciesGetter
 	NetworkPoliciesGetter
+	PacketCapturesGetter
 	TiersGetter
 	TraceflowsGetter
 }
@@ -45,6 +46,10 @@ func (c *CrdV1alpha1Client) NetworkPolicies(namespace string) NetworkPolicyInter
 	return newNetworkPolicies(c, namespace)
 }
 
+func (c *CrdV1alpha1Client) PacketCaptures() PacketCaptureInterface {
+	return newPacketCaptures(c)
+}
+
 func (c *CrdV1alpha1Client) Tiers() TierInterface {
 	return newTiers(c)
 }
@@ -0,0 +1,74 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeNamespaceTrafficStats implements NamespaceTrafficStatsInterface
+type FakeNamespaceTrafficStats struct {
+	Fake *FakeStatsV1alpha1
+}
+
+var namespacetrafficstatsResource = schema.GroupVersionResource{Group: "stats.antrea.io", Version: "v1alpha1", Resource: "namespacetrafficstats"}
+
+var namespacetrafficstatsKind = schema.GroupVersionKind{Group: "stats.antrea.io", Version: "v1alpha1", Kind: "NamespaceTrafficStats"}
+
+// Get takes name of the namespaceTrafficStats, and returns the corresponding namespaceTrafficStats object, and an error if there is any.
+func (c *FakeNamespaceTrafficStats) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.NamespaceTrafficStats, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(namespacetrafficstatsResource, name), &v1alpha1.NamespaceTrafficStats{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.NamespaceTrafficStats), err
+}
+
+// List takes label and field selectors, and returns the list of NamespaceTrafficStats that match those selectors.
+func (c *FakeNamespaceTrafficStats) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.NamespaceTrafficStatsList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(namespacetrafficstatsResource, namespacetrafficstatsKind, opts), &v1alpha1.NamespaceTrafficStatsList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.NamespaceTrafficStatsList{ListMeta: obj.(*v1alpha1.NamespaceTrafficStatsList).ListMeta}
+	for _, item := range obj.(*v1alpha1.NamespaceTrafficStatsList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested namespaceTrafficStats.
+func (c *FakeNamespaceTrafficStats) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(namespacetrafficstatsResource, opts))
+}
@@ -0,0 +1,84 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeNodeLatencyStats implements NodeLatencyStatsInterface
+type FakeNodeLatencyStats struct {
+	Fake *FakeStatsV1alpha1
+}
+
+var nodelatencystatsResource = schema.GroupVersionResource{Group: "stats.antrea.io", Version: "v1alpha1", Resource: "nodelatencystats"}
+
+var nodelatencystatsKind = schema.GroupVersionKind{Group: "stats.antrea.io", Version: "v1alpha1", Kind: "NodeLatencyStats"}
+
+// Get takes name of the nodeLatencyStats, and returns the corresponding nodeLatencyStats object, and an error if there is any.
+func (c *FakeNodeLatencyStats) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.NodeLatencyStats, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(nodelatencystatsResource, name), &v1alpha1.NodeLatencyStats{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.NodeLatencyStats), err
+}
+
+// List takes label and field selectors, and returns the list of NodeLatencyStats that match those selectors.
+func (c *FakeNodeLatencyStats) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.NodeLatencyStatsList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(nodelatencystatsResource, nodelatencystatsKind, opts), &v1alpha1.NodeLatencyStatsList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.NodeLatencyStatsList{ListMeta: obj.(*v1alpha1.NodeLatencyStatsList).ListMeta}
+	for _, item := range obj.(*v1alpha1.NodeLatencyStatsList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Create takes the representation of a nodeLatencyStats and creates it.  Returns the server's representation of the nodeLatencyStats, and an error, if there is any.
+func (c *FakeNodeLatencyStats) Create(ctx context.Context, nodeLatencyStats *v1alpha1.NodeLatencyStats, opts v1.CreateOptions) (result *v1alpha1.NodeLatencyStats, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(nodelatencystatsResource, nodeLatencyStats), &v1alpha1.NodeLatencyStats{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.NodeLatencyStats), err
+}
+
+// Delete takes name of the nodeLatencyStats and deletes it. Returns an error if one occurs.
+func (c *FakeNodeLatencyStats) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteActionWithOptions(nodelatencystatsResource, name, opts), &v1alpha1.NodeLatencyStats{})
+	return err
+}
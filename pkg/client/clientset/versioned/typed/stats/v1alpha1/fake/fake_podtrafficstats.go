@@ -0,0 +1,78 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakePodTrafficStats implements PodTrafficStatsInterface
+type FakePodTrafficStats struct {
+	Fake *FakeStatsV1alpha1
+	ns   string
+}
+
+var podtrafficstatsResource = schema.GroupVersionResource{Group: "stats.antrea.io", Version: "v1alpha1", Resource: "podtrafficstats"}
+
+var podtrafficstatsKind = schema.GroupVersionKind{Group: "stats.antrea.io", Version: "v1alpha1", Kind: "PodTrafficStats"}
+
+// Get takes name of the podTrafficStats, and returns the corresponding podTrafficStats object, and an error if there is any.
+func (c *FakePodTrafficStats) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.PodTrafficStats, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(podtrafficstatsResource, c.ns, name), &v1alpha1.PodTrafficStats{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.PodTrafficStats), err
+}
+
+// List takes label and field selectors, and returns the list of PodTrafficStats that match those selectors.
+func (c *FakePodTrafficStats) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.PodTrafficStatsList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(podtrafficstatsResource, podtrafficstatsKind, c.ns, opts), &v1alpha1.PodTrafficStatsList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.PodTrafficStatsList{ListMeta: obj.(*v1alpha1.PodTrafficStatsList).ListMeta}
+	for _, item := range obj.(*v1alpha1.PodTrafficStatsList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested podTrafficStats.
+func (c *FakePodTrafficStats) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(podtrafficstatsResource, c.ns, opts))
+
+}
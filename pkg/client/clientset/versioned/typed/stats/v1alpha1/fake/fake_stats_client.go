@@ -38,10 +38,22 @@ func (c *FakeStatsV1alpha1) MulticastGroups() v1alpha1.MulticastGroupInterface {
 	return &FakeMulticastGroups{c}
 }
 
+func (c *FakeStatsV1alpha1) NamespaceTrafficStats() v1alpha1.NamespaceTrafficStatsInterface {
+	return &FakeNamespaceTrafficStats{c}
+}
+
 func (c *FakeStatsV1alpha1) NetworkPolicyStats(namespace string) v1alpha1.NetworkPolicyStatsInterface {
 	return &FakeNetworkPolicyStats{c, namespace}
 }
 
+func (c *FakeStatsV1alpha1) NodeLatencyStats() v1alpha1.NodeLatencyStatsInterface {
+	return &FakeNodeLatencyStats{c}
+}
+
+func (c *FakeStatsV1alpha1) PodTrafficStats(namespace string) v1alpha1.PodTrafficStatsInterface {
+	return &FakePodTrafficStats{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeStatsV1alpha1) RESTClient() rest.Interface {
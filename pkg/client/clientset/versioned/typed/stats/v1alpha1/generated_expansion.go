@@ -22,4 +22,10 @@ type AntreaNetworkPolicyStatsExpansion interface{}
 
 type MulticastGroupExpansion interface{}
 
+type NamespaceTrafficStatsExpansion interface{}
+
 type NetworkPolicyStatsExpansion interface{}
+
+type NodeLatencyStatsExpansion interface{}
+
+type PodTrafficStatsExpansion interface{}
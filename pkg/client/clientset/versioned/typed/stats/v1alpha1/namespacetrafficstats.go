@@ -0,0 +1,96 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
+	scheme "antrea.io/antrea/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// NamespaceTrafficStatsGetter has a method to return a NamespaceTrafficStatsInterface.
+// A group's client should implement this interface.
+type NamespaceTrafficStatsGetter interface {
+	NamespaceTrafficStats() NamespaceTrafficStatsInterface
+}
+
+// NamespaceTrafficStatsInterface has methods to work with NamespaceTrafficStats resources.
+type NamespaceTrafficStatsInterface interface {
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.NamespaceTrafficStats, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.NamespaceTrafficStatsList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	NamespaceTrafficStatsExpansion
+}
+
+// namespaceTrafficStats implements NamespaceTrafficStatsInterface
+type namespaceTrafficStats struct {
+	client rest.Interface
+}
+
+// newNamespaceTrafficStats returns a NamespaceTrafficStats
+func newNamespaceTrafficStats(c *StatsV1alpha1Client) *namespaceTrafficStats {
+	return &namespaceTrafficStats{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the namespaceTrafficStats, and returns the corresponding namespaceTrafficStats object, and an error if there is any.
+func (c *namespaceTrafficStats) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.NamespaceTrafficStats, err error) {
+	result = &v1alpha1.NamespaceTrafficStats{}
+	err = c.client.Get().
+		Resource("namespacetrafficstats").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of NamespaceTrafficStats that match those selectors.
+func (c *namespaceTrafficStats) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.NamespaceTrafficStatsList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.NamespaceTrafficStatsList{}
+	err = c.client.Get().
+		Resource("namespacetrafficstats").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested namespaceTrafficStats.
+func (c *namespaceTrafficStats) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("namespacetrafficstats").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
@@ -0,0 +1,101 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
+	scheme "antrea.io/antrea/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// PodTrafficStatsGetter has a method to return a PodTrafficStatsInterface.
+// A group's client should implement this interface.
+type PodTrafficStatsGetter interface {
+	PodTrafficStats(namespace string) PodTrafficStatsInterface
+}
+
+// PodTrafficStatsInterface has methods to work with PodTrafficStats resources.
+type PodTrafficStatsInterface interface {
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.PodTrafficStats, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.PodTrafficStatsList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	PodTrafficStatsExpansion
+}
+
+// podTrafficStats implements PodTrafficStatsInterface
+type podTrafficStats struct {
+	client rest.Interface
+	ns     string
+}
+
+// newPodTrafficStats returns a PodTrafficStats
+func newPodTrafficStats(c *StatsV1alpha1Client, namespace string) *podTrafficStats {
+	return &podTrafficStats{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the podTrafficStats, and returns the corresponding podTrafficStats object, and an error if there is any.
+func (c *podTrafficStats) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.PodTrafficStats, err error) {
+	result = &v1alpha1.PodTrafficStats{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("podtrafficstats").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of PodTrafficStats that match those selectors.
+func (c *podTrafficStats) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.PodTrafficStatsList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.PodTrafficStatsList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("podtrafficstats").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested podTrafficStats.
+func (c *podTrafficStats) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("podtrafficstats").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
@@ -29,7 +29,10 @@ type StatsV1alpha1Interface interface {
 	AntreaClusterNetworkPolicyStatsGetter
 	AntreaNetworkPolicyStatsGetter
 	MulticastGroupsGetter
+	NamespaceTrafficStatsGetter
 	NetworkPolicyStatsGetter
+	NodeLatencyStatsGetter
+	PodTrafficStatsGetter
 }
 
 // StatsV1alpha1Client is used to interact with features provided by the stats.antrea.io group.
@@ -49,10 +52,22 @@ func (c *StatsV1alpha1Client) MulticastGroups() MulticastGroupInterface {
 	return newMulticastGroups(c)
 }
 
+func (c *StatsV1alpha1Client) NamespaceTrafficStats() NamespaceTrafficStatsInterface {
+	return newNamespaceTrafficStats(c)
+}
+
 func (c *StatsV1alpha1Client) NetworkPolicyStats(namespace string) NetworkPolicyStatsInterface {
 	return newNetworkPolicyStats(c, namespace)
 }
 
+func (c *StatsV1alpha1Client) NodeLatencyStats() NodeLatencyStatsInterface {
+	return newNodeLatencyStats(c)
+}
+
+func (c *StatsV1alpha1Client) PodTrafficStats(namespace string) PodTrafficStatsInterface {
+	return newPodTrafficStats(c, namespace)
+}
+
 // NewForConfig creates a new StatsV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -135,6 +135,14 @@ type AgentConfig struct {
 	// "udp" L4 transport protocols.
 	// Defaults to "flow-aggregator.flow-aggregator.svc:4739:tcp".
 	FlowCollectorAddr string `yaml:"flowCollectorAddr,omitempty"`
+	// Enable sharding of flow records across the replicas of the Flow Aggregator.
+	// When enabled, HOST in FlowCollectorAddr must be the DNS name of the Flow
+	// Aggregator Service, in the form "<NAME>.<NAMESPACE>.svc". The Endpoints of
+	// this Service are used to track the replicas, and each flow record is sent
+	// to the replica selected by hashing its flow key, so that both directions of
+	// a connection are sent to the same replica.
+	// Defaults to false.
+	EnableFlowCollectorSharding bool `yaml:"enableFlowCollectorSharding,omitempty"`
 	// Provide flow poll interval in format "0s". This determines how often flow
 	// exporter dumps connections in conntrack module. Flow poll interval should
 	// be greater than or equal to 1s(one second).
@@ -156,6 +164,14 @@ type AgentConfig struct {
 	// Defaults to "15s". Valid time units are "ns", "us" (or "µs"), "ms", "s",
 	// "m", "h".
 	IdleFlowExportTimeout string `yaml:"idleFlowExportTimeout,omitempty"`
+	// Sampling and filtering of the connections exported by the Flow Exporter.
+	FlowExportFilter FlowExportFilterConfig `yaml:"flowExportFilter,omitempty"`
+	// Enable recording and exporting the DNS responses received by local Pods,
+	// and enriching the flow records of Pod-to-External flows with the FQDN the
+	// destination IP was resolved from. Requires the AntreaPolicy feature gate.
+	// DNS responses are only intercepted for the Pods in the Namespaces selected
+	// by the Namespace filters of FlowExportFilter. Defaults to false.
+	EnableDNSVisibility bool `yaml:"enableDNSVisibility,omitempty"`
 	// Deprecated. Use the NodePortLocal config options instead.
 	NPLPortRange string `yaml:"nplPortRange,omitempty"`
 	// NodePortLocal (NPL) configuration options.
@@ -243,6 +259,31 @@ type NodePortLocalConfig struct {
 	PortRange string `yaml:"portRange,omitempty"`
 }
 
+type FlowExportFilterConfig struct {
+	// Export 1 out of SamplingRate connections. Connections are sampled by hashing their
+	// 5-tuple independently of the direction, so that the source and destination Nodes of a
+	// connection make the same decision. Defaults to 1, which means that all connections are
+	// exported.
+	SamplingRate uint32 `yaml:"samplingRate,omitempty"`
+	// Only export connections with a local Pod in one of these Namespaces. If empty, connections
+	// are not filtered by Namespace.
+	IncludeNamespaces []string `yaml:"includeNamespaces,omitempty"`
+	// Do not export connections with a local Pod in one of these Namespaces.
+	ExcludeNamespaces []string `yaml:"excludeNamespaces,omitempty"`
+	// Only export connections with a local Pod matching this label selector, e.g.
+	// "app=web,tier!=db". If empty, connections are not filtered by Pod labels.
+	PodLabelSelector string `yaml:"podLabelSelector,omitempty"`
+	// Only export connections using one of these protocols. Supported protocols are "TCP",
+	// "UDP", "SCTP", "ICMP" and "ICMPv6". If empty, connections are not filtered by protocol.
+	Protocols []string `yaml:"protocols,omitempty"`
+	// Only export connections to one of these destination ports. If empty, connections are
+	// not filtered by port.
+	DestinationPorts []int32 `yaml:"destinationPorts,omitempty"`
+	// Do not export health-check connections, i.e. connections from the local Node (e.g.
+	// kubelet probes) to a local Pod.
+	ExcludeHealthChecks bool `yaml:"excludeHealthChecks,omitempty"`
+}
+
 type MulticastConfig struct {
 	// The names of the interfaces on Nodes that are used to forward multicast traffic.
 	// Defaults to transport interface if not set.
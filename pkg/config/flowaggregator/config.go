@@ -53,6 +53,17 @@ type FlowAggregatorConfig struct {
 	FlowCollector FlowCollectorConfig `yaml:"flowCollector,omitempty"`
 	// clickHouse contains ClickHouse related configuration options.
 	ClickHouse ClickHouseConfig `yaml:"clickHouse,omitempty"`
+	// kafka contains Kafka related configuration options.
+	Kafka KafkaConfig `yaml:"kafka,omitempty"`
+	// flowLogger contains configuration options for writing flow records to
+	// local files.
+	FlowLogger FlowLoggerConfig `yaml:"flowLogger,omitempty"`
+	// s3Uploader contains configuration options for uploading flow records to
+	// S3-compatible object storage.
+	S3Uploader S3UploaderConfig `yaml:"s3Uploader,omitempty"`
+	// otlp contains configuration options for exporting flow records and
+	// service-to-service metrics to an OpenTelemetry collector.
+	OTLP OTLPConfig `yaml:"otlp,omitempty"`
 }
 
 type RecordContentsConfig struct {
@@ -104,3 +115,108 @@ type ClickHouseConfig struct {
 	// Min value allowed is "1s".
 	CommitInterval string `yaml:"commitInterval,omitempty"`
 }
+
+type KafkaConfig struct {
+	// Enable is the switch to enable producing flow records to Kafka.
+	Enable bool `yaml:"enable,omitempty"`
+	// Brokers is the list of bootstrap brokers, with format <host>:<port>.
+	Brokers []string `yaml:"brokers,omitempty"`
+	// Topic is the Kafka topic the flow records are produced to.
+	// Defaults to "antrea-flows".
+	Topic string `yaml:"topic,omitempty"`
+	// RecordFormat is the encoding of the Kafka messages. Supported formats
+	// are JSON and Protobuf. Defaults to "JSON".
+	RecordFormat string `yaml:"recordFormat,omitempty"`
+	// FlushInterval is the maximum interval between two batches of flow records
+	// produced to Kafka. Defaults to "1s". Valid time units are "ns", "us" (or
+	// "µs"), "ms", "s", "m", "h".
+	FlushInterval string `yaml:"flushInterval,omitempty"`
+	// TLS contains the TLS configuration used to connect to the brokers.
+	TLS KafkaTLSConfig `yaml:"tls,omitempty"`
+}
+
+type KafkaTLSConfig struct {
+	// Enable is the switch to enable TLS when connecting to the brokers.
+	Enable bool `yaml:"enable,omitempty"`
+	// CACertFile is the path of the CA certificate used to verify the brokers.
+	// If omitted, the system CA certificates are used.
+	CACertFile string `yaml:"caCertFile,omitempty"`
+}
+
+type FlowLoggerConfig struct {
+	// Enable is the switch to enable writing flow records to local files, one
+	// JSON object per line.
+	Enable bool `yaml:"enable,omitempty"`
+	// Path is the path of the file flow records are written to.
+	// Defaults to "/tmp/antrea-flows.log".
+	Path string `yaml:"path,omitempty"`
+	// MaxSize is the maximum size in MB of a file before it gets rotated.
+	// Defaults to 100.
+	MaxSize int `yaml:"maxSize,omitempty"`
+	// MaxBackups is the maximum number of rotated files to retain. Defaults
+	// to 3.
+	MaxBackups int `yaml:"maxBackups,omitempty"`
+	// MaxAge is the maximum number of days to retain rotated files. 0 means
+	// that files are not removed based on their age. Defaults to 0.
+	MaxAge int `yaml:"maxAge,omitempty"`
+	// Compress enables gzip compression of the rotated files. Defaults to true.
+	Compress *bool `yaml:"compress,omitempty"`
+}
+
+type S3UploaderConfig struct {
+	// Enable is the switch to enable uploading flow records to S3-compatible
+	// object storage.
+	Enable bool `yaml:"enable,omitempty"`
+	// BucketName is the name of the bucket flow records are uploaded to.
+	BucketName string `yaml:"bucketName,omitempty"`
+	// BucketPrefix is the prefix prepended to the keys of the uploaded objects.
+	BucketPrefix string `yaml:"bucketPrefix,omitempty"`
+	// Region is the region of the bucket. Defaults to "us-west-2".
+	Region string `yaml:"region,omitempty"`
+	// Endpoint is the URL of the S3-compatible service. If omitted, the AWS S3
+	// endpoint of the region is used.
+	Endpoint string `yaml:"endpoint,omitempty"`
+	// RecordFormat is the format of the uploaded objects. Supported formats
+	// are CSV and Parquet. Defaults to "CSV".
+	RecordFormat string `yaml:"recordFormat,omitempty"`
+	// Compress enables gzip compression of the uploaded CSV files, or Snappy
+	// compression of the columns of the uploaded Parquet files. Defaults to
+	// true.
+	Compress *bool `yaml:"compress,omitempty"`
+	// MaxRecordsPerFile is the maximum number of flow records in an uploaded
+	// object. Defaults to 1000000.
+	MaxRecordsPerFile int32 `yaml:"maxRecordsPerFile,omitempty"`
+	// UploadInterval is the interval between two uploads. Defaults to "60s".
+	// Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h".
+	// Min value allowed is "1s".
+	UploadInterval string `yaml:"uploadInterval,omitempty"`
+}
+
+type OTLPConfig struct {
+	// Enable is the switch to enable exporting to an OpenTelemetry collector,
+	// using the OTLP/gRPC protocol.
+	Enable bool `yaml:"enable,omitempty"`
+	// Endpoint is the address of the OTLP/gRPC receiver of the collector, with
+	// format <host>:<port>.
+	Endpoint string `yaml:"endpoint,omitempty"`
+	// Logs enables exporting every flow record as an OTLP log record.
+	// Defaults to true.
+	Logs *bool `yaml:"logs,omitempty"`
+	// Metrics enables exporting service-to-service metrics (bytes, packets
+	// and throughput) derived from the flow records. Defaults to true.
+	Metrics *bool `yaml:"metrics,omitempty"`
+	// ExportInterval is the interval between two exports of the buffered log
+	// records and of the metrics. Defaults to "10s". Valid time units are
+	// "ns", "us" (or "µs"), "ms", "s", "m", "h". Min value allowed is "1s".
+	ExportInterval string `yaml:"exportInterval,omitempty"`
+	// TLS contains the TLS configuration used to connect to the collector.
+	TLS OTLPTLSConfig `yaml:"tls,omitempty"`
+}
+
+type OTLPTLSConfig struct {
+	// Enable is the switch to enable TLS when connecting to the collector.
+	Enable bool `yaml:"enable,omitempty"`
+	// CACertFile is the path of the CA certificate used to verify the
+	// collector. If omitted, the system CA certificates are used.
+	CACertFile string `yaml:"caCertFile,omitempty"`
+}
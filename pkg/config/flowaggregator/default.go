@@ -32,6 +32,19 @@ const (
 	DefaultClickHouseCommitInterval       = "8s"
 	MinClickHouseCommitInterval           = 1 * time.Second
 	DefaultClickHouseDatabaseUrl          = "tcp://clickhouse-clickhouse.flow-visibility.svc:9000"
+	DefaultKafkaTopic                     = "antrea-flows"
+	DefaultKafkaRecordFormat              = "JSON"
+	DefaultKafkaFlushInterval             = "1s"
+	DefaultFlowLoggerPath                 = "/tmp/antrea-flows.log"
+	DefaultFlowLoggerMaxSize              = 100
+	DefaultFlowLoggerMaxBackups           = 3
+	DefaultS3Region                       = "us-west-2"
+	DefaultS3RecordFormat                 = "CSV"
+	DefaultS3MaxRecordsPerFile            = 1000000
+	DefaultS3UploadInterval               = "60s"
+	MinS3UploadInterval                   = 1 * time.Second
+	DefaultOTLPExportInterval             = "10s"
+	MinOTLPExportInterval                 = 1 * time.Second
 )
 
 func SetConfigDefaults(flowAggregatorConf *FlowAggregatorConfig) {
@@ -66,4 +79,53 @@ func SetConfigDefaults(flowAggregatorConf *FlowAggregatorConfig) {
 	if flowAggregatorConf.ClickHouse.CommitInterval == "" {
 		flowAggregatorConf.ClickHouse.CommitInterval = DefaultClickHouseCommitInterval
 	}
+	if flowAggregatorConf.Kafka.Topic == "" {
+		flowAggregatorConf.Kafka.Topic = DefaultKafkaTopic
+	}
+	if flowAggregatorConf.Kafka.RecordFormat == "" {
+		flowAggregatorConf.Kafka.RecordFormat = DefaultKafkaRecordFormat
+	}
+	if flowAggregatorConf.Kafka.FlushInterval == "" {
+		flowAggregatorConf.Kafka.FlushInterval = DefaultKafkaFlushInterval
+	}
+	if flowAggregatorConf.FlowLogger.Path == "" {
+		flowAggregatorConf.FlowLogger.Path = DefaultFlowLoggerPath
+	}
+	if flowAggregatorConf.FlowLogger.MaxSize == 0 {
+		flowAggregatorConf.FlowLogger.MaxSize = DefaultFlowLoggerMaxSize
+	}
+	if flowAggregatorConf.FlowLogger.MaxBackups == 0 {
+		flowAggregatorConf.FlowLogger.MaxBackups = DefaultFlowLoggerMaxBackups
+	}
+	if flowAggregatorConf.FlowLogger.Compress == nil {
+		flowAggregatorConf.FlowLogger.Compress = new(bool)
+		*flowAggregatorConf.FlowLogger.Compress = true
+	}
+	if flowAggregatorConf.S3Uploader.Region == "" {
+		flowAggregatorConf.S3Uploader.Region = DefaultS3Region
+	}
+	if flowAggregatorConf.S3Uploader.RecordFormat == "" {
+		flowAggregatorConf.S3Uploader.RecordFormat = DefaultS3RecordFormat
+	}
+	if flowAggregatorConf.S3Uploader.Compress == nil {
+		flowAggregatorConf.S3Uploader.Compress = new(bool)
+		*flowAggregatorConf.S3Uploader.Compress = true
+	}
+	if flowAggregatorConf.S3Uploader.MaxRecordsPerFile == 0 {
+		flowAggregatorConf.S3Uploader.MaxRecordsPerFile = DefaultS3MaxRecordsPerFile
+	}
+	if flowAggregatorConf.S3Uploader.UploadInterval == "" {
+		flowAggregatorConf.S3Uploader.UploadInterval = DefaultS3UploadInterval
+	}
+	if flowAggregatorConf.OTLP.Logs == nil {
+		flowAggregatorConf.OTLP.Logs = new(bool)
+		*flowAggregatorConf.OTLP.Logs = true
+	}
+	if flowAggregatorConf.OTLP.Metrics == nil {
+		flowAggregatorConf.OTLP.Metrics = new(bool)
+		*flowAggregatorConf.OTLP.Metrics = true
+	}
+	if flowAggregatorConf.OTLP.ExportInterval == "" {
+		flowAggregatorConf.OTLP.ExportInterval = DefaultOTLPExportInterval
+	}
 }
@@ -0,0 +1,456 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package validation implements the defaulting, the validation and the
+// migration of the configurations of antrea-agent and antrea-controller. It is
+// used by the components at startup, and by antctl to check configurations
+// offline.
+package validation
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/component-base/featuregate"
+
+	"antrea.io/antrea/pkg/agent/config"
+	"antrea.io/antrea/pkg/agent/flowexporter/filter"
+	"antrea.io/antrea/pkg/apis"
+	"antrea.io/antrea/pkg/cni"
+	agentconfig "antrea.io/antrea/pkg/config/agent"
+	"antrea.io/antrea/pkg/features"
+	"antrea.io/antrea/pkg/ovs/ovsconfig"
+	"antrea.io/antrea/pkg/util/env"
+	"antrea.io/antrea/pkg/util/flowexport"
+	"antrea.io/antrea/pkg/util/ip"
+)
+
+const (
+	defaultOVSBridge            = "br-int"
+	defaultHostGateway          = "antrea-gw0"
+	defaultHostProcPathPrefix   = "/host"
+	defaultServiceCIDR          = "10.96.0.0/12"
+	defaultTunnelType           = ovsconfig.GeneveTunnel
+	defaultFlowCollectorAddress = "flow-aggregator.flow-aggregator.svc:4739:tls"
+	defaultNPLPortRange         = "61000-62000"
+
+	DefaultFlowCollectorTransport = "tls"
+	DefaultFlowCollectorPort      = "4739"
+	DefaultFlowPollInterval       = 5 * time.Second
+)
+
+// NewFeatureGate returns a FeatureGate with all the Antrea features, set from
+// the featureGates of a configuration. The features which are not supported on
+// Windows are disabled by default if isWindows is true.
+func NewFeatureGate(featureGates map[string]bool, isWindows bool) (featuregate.FeatureGate, error) {
+	specs := make(map[featuregate.Feature]featuregate.FeatureSpec, len(features.DefaultAntreaFeatureGates))
+	for f, spec := range features.DefaultAntreaFeatureGates {
+		if isWindows && !features.SupportedOnWindows(f) {
+			spec.Default = false
+		}
+		specs[f] = spec
+	}
+	fg := featuregate.NewFeatureGate()
+	if err := fg.Add(specs); err != nil {
+		return nil, err
+	}
+	if err := fg.SetFromMap(featureGates); err != nil {
+		return nil, err
+	}
+	return fg, nil
+}
+
+// SetAgentConfigDefaults sets the default values of the options which are not
+// set in the antrea-agent configuration, and copies the deprecated options to
+// the options replacing them.
+func SetAgentConfigDefaults(c *agentconfig.AgentConfig, fg featuregate.FeatureGate) {
+	if c.CNISocket == "" {
+		c.CNISocket = cni.AntreaCNISocketAddr
+	}
+	if c.OVSBridge == "" {
+		c.OVSBridge = defaultOVSBridge
+	}
+	if c.OVSDatapathType == "" {
+		c.OVSDatapathType = string(ovsconfig.OVSDatapathSystem)
+	}
+	if c.OVSRunDir == "" {
+		c.OVSRunDir = ovsconfig.DefaultOVSRunDir
+	}
+	if c.HostGateway == "" {
+		c.HostGateway = defaultHostGateway
+	}
+	if c.TrafficEncapMode == "" {
+		c.TrafficEncapMode = config.TrafficEncapModeEncap.String()
+	}
+	if c.EnableIPSecTunnel {
+		c.TrafficEncryptionMode = config.TrafficEncryptionModeIPSec.String()
+	}
+	if c.TrafficEncryptionMode == "" {
+		c.TrafficEncryptionMode = config.TrafficEncryptionModeNone.String()
+	}
+	if _, encapMode := config.GetTrafficEncapModeFromStr(c.TrafficEncapMode); encapMode == config.TrafficEncapModeNetworkPolicyOnly {
+		// In the NetworkPolicyOnly mode, Antrea will not perform SNAT
+		// (but SNAT can be done by the primary CNI).
+		c.NoSNAT = true
+	}
+	if c.TunnelType == "" {
+		c.TunnelType = defaultTunnelType
+	}
+	if c.HostProcPathPrefix == "" {
+		c.HostProcPathPrefix = defaultHostProcPathPrefix
+	}
+	if fg.Enabled(features.AntreaProxy) {
+		if c.AntreaProxy.ProxyLoadBalancerIPs == nil {
+			c.AntreaProxy.ProxyLoadBalancerIPs = new(bool)
+			*c.AntreaProxy.ProxyLoadBalancerIPs = true
+		}
+	} else {
+		if c.ServiceCIDR == "" {
+			c.ServiceCIDR = defaultServiceCIDR
+		}
+	}
+	if c.APIPort == 0 {
+		c.APIPort = apis.AntreaAgentAPIPort
+	}
+	if c.ClusterMembershipPort == 0 {
+		c.ClusterMembershipPort = apis.AntreaAgentClusterMembershipPort
+	}
+	if c.EnablePrometheusMetrics == nil {
+		c.EnablePrometheusMetrics = new(bool)
+		*c.EnablePrometheusMetrics = true
+	}
+	if c.WireGuard.Port == 0 {
+		c.WireGuard.Port = apis.WireGuardListenPort
+	}
+	if c.IPsec.AuthenticationMode == "" {
+		c.IPsec.AuthenticationMode = config.IPsecAuthenticationModePSK.String()
+	}
+
+	if fg.Enabled(features.FlowExporter) {
+		if c.FlowCollectorAddr == "" {
+			c.FlowCollectorAddr = defaultFlowCollectorAddress
+		}
+	}
+
+	if fg.Enabled(features.NodePortLocal) {
+		switch {
+		case c.NodePortLocal.PortRange != "":
+		case c.NPLPortRange != "":
+			c.NodePortLocal.PortRange = c.NPLPortRange
+		default:
+			c.NodePortLocal.PortRange = defaultNPLPortRange
+		}
+	}
+
+	if fg.Enabled(features.Multicast) {
+		if len(c.Multicast.MulticastInterfaces) == 0 && len(c.MulticastInterfaces) > 0 {
+			c.Multicast.MulticastInterfaces = c.MulticastInterfaces
+		}
+	}
+}
+
+// ValidateAgentConfig validates the antrea-agent configuration, which must
+// have been defaulted with SetAgentConfigDefaults, against the enabled feature
+// gates. It returns the first invalid option as an error, and warnings about
+// the deprecated options, the options ignored because of the feature gates and
+// the options which are not fully supported.
+func ValidateAgentConfig(c *agentconfig.AgentConfig, fg featuregate.FeatureGate) ([]string, error) {
+	var warnings []string
+	warn := func(format string, a ...interface{}) {
+		warnings = append(warnings, fmt.Sprintf(format, a...))
+	}
+
+	if c.EnableIPSecTunnel {
+		warn("The enableIPSecTunnel option is deprecated, please use trafficEncryptionMode instead")
+	}
+	if c.NPLPortRange != "" {
+		warn("The nplPortRange option is deprecated, please use nodePortLocal.portRange instead")
+	}
+	if len(c.MulticastInterfaces) > 0 {
+		warn("The multicastInterfaces option is deprecated, please use multicast.multicastInterfaces instead")
+	}
+
+	if c.TunnelType != ovsconfig.VXLANTunnel && c.TunnelType != ovsconfig.GeneveTunnel &&
+		c.TunnelType != ovsconfig.GRETunnel && c.TunnelType != ovsconfig.STTTunnel {
+		return warnings, fmt.Errorf("tunnel type %s is invalid", c.TunnelType)
+	}
+	ok, encryptionMode := config.GetTrafficEncryptionModeFromStr(c.TrafficEncryptionMode)
+	if !ok {
+		return warnings, fmt.Errorf("TrafficEncryptionMode %s is unknown", c.TrafficEncryptionMode)
+	}
+	if c.OVSDatapathType != string(ovsconfig.OVSDatapathSystem) && c.OVSDatapathType != string(ovsconfig.OVSDatapathNetdev) {
+		return warnings, fmt.Errorf("OVS datapath type %s is not supported", c.OVSDatapathType)
+	}
+	if c.OVSDatapathType == string(ovsconfig.OVSDatapathNetdev) {
+		warn("OVS 'netdev' datapath is not fully supported at the moment")
+	}
+	ok, encapMode := config.GetTrafficEncapModeFromStr(c.TrafficEncapMode)
+	if !ok {
+		return warnings, fmt.Errorf("TrafficEncapMode %s is unknown", c.TrafficEncapMode)
+	}
+	ok, ipsecAuthMode := config.GetIPsecAuthenticationModeFromStr(c.IPsec.AuthenticationMode)
+	if !ok {
+		return warnings, fmt.Errorf("IPsec AuthenticationMode %s is unknown", c.IPsec.AuthenticationMode)
+	}
+	if ipsecAuthMode == config.IPsecAuthenticationModeCert && !fg.Enabled(features.IPsecCertAuth) {
+		return warnings, fmt.Errorf("IPsec AuthenticationMode %s requires feature gate %s to be enabled", c.IPsec.AuthenticationMode, features.IPsecCertAuth)
+	}
+	if fg.Enabled(features.PodTrafficStats) && !fg.Enabled(features.NetworkPolicyStats) {
+		return warnings, fmt.Errorf("feature gate %s requires feature gate %s to be enabled", features.PodTrafficStats, features.NetworkPolicyStats)
+	}
+
+	if encapMode.SupportsNoEncap() {
+		// When using NoEncap traffic mode without AntreaProxy, Pod-to-Service traffic is handled by kube-proxy
+		// (iptables/ipvs) in the root netns. If the Endpoint is not local the DNATed traffic will be output to
+		// the physical network directly without going back to OVS for Egress NetworkPolicy enforcement, which
+		// breaks basic security functionality. Therefore, we usually do not allow the NoEncap traffic mode without
+		// AntreaProxy. But one can bypass this check and force this feature combination to be allowed, by defining
+		// the ALLOW_NO_ENCAP_WITHOUT_ANTREA_PROXY environment variable and setting it to true. This may lead to
+		// better performance when using NoEncap if Egress NetworkPolicy enforcement is not required.
+		if !fg.Enabled(features.AntreaProxy) {
+			if env.GetAllowNoEncapWithoutAntreaProxy() {
+				warn("Disabling AntreaProxy in NoEncap mode will prevent Egress NetworkPolicy rules from being enforced correctly")
+			} else {
+				return warnings, fmt.Errorf("TrafficEncapMode %s requires AntreaProxy to be enabled", c.TrafficEncapMode)
+			}
+		}
+		if encryptionMode != config.TrafficEncryptionModeNone {
+			return warnings, fmt.Errorf("TrafficEncryptionMode %s may only be enabled in %s mode", encryptionMode, config.TrafficEncapModeEncap)
+		}
+	}
+	if c.NoSNAT && !(encapMode == config.TrafficEncapModeNoEncap || encapMode == config.TrafficEncapModeNetworkPolicyOnly) {
+		return warnings, fmt.Errorf("noSNAT is only applicable to the %s mode", config.TrafficEncapModeNoEncap)
+	}
+	if err := validateAntreaProxyConfig(c, fg, warn); err != nil {
+		return warnings, fmt.Errorf("proxy config is invalid: %w", err)
+	}
+	if err := validateFlowExporterConfig(c, fg, warn); err != nil {
+		return warnings, fmt.Errorf("failed to validate flow exporter config: %v", err)
+	}
+	if err := validateMulticastConfig(c, fg, warn); err != nil {
+		return warnings, fmt.Errorf("failed to validate multicast config: %v", err)
+	}
+	if fg.Enabled(features.Egress) {
+		for _, cidr := range c.Egress.ExceptCIDRs {
+			_, _, err := net.ParseCIDR(cidr)
+			if err != nil {
+				return warnings, fmt.Errorf("Egress Except CIDR %s is invalid", cidr)
+			}
+		}
+	} else if len(c.Egress.ExceptCIDRs) > 0 {
+		warn("The egress.exceptCIDRs option will be ignored because the %s feature gate is disabled", features.Egress)
+	}
+	if (fg.Enabled(features.Multicluster) || c.Multicluster.Enable) &&
+		encapMode != config.TrafficEncapModeEncap {
+		// Only Encap mode is supported for Multi-cluster feature.
+		return warnings, fmt.Errorf("Multicluster is only applicable to the %s mode", config.TrafficEncapModeEncap)
+	}
+	if fg.Enabled(features.NodePortLocal) {
+		if _, _, err := ParsePortRange(c.NodePortLocal.PortRange); err != nil {
+			return warnings, fmt.Errorf("NodePortLocal portRange is not valid: %v", err)
+		}
+	} else if c.NodePortLocal.Enable {
+		warn("The nodePortLocal.enable config option is set to true, but it will be ignored because the %s feature gate is disabled", features.NodePortLocal)
+	}
+	if err := validateAntreaIPAMConfig(c, fg); err != nil {
+		return warnings, fmt.Errorf("failed to validate AntreaIPAM config: %v", err)
+	}
+
+	if c.DNSServerOverride != "" {
+		hostPort := ip.AppendPortIfMissing(c.DNSServerOverride, "53")
+		_, _, err := net.SplitHostPort(hostPort)
+		if err != nil {
+			return warnings, fmt.Errorf("dnsServerOverride %s is invalid: %v", c.DNSServerOverride, err)
+		}
+	}
+	return warnings, nil
+}
+
+func validateAntreaProxyConfig(c *agentconfig.AgentConfig, fg featuregate.FeatureGate, warn func(string, ...interface{})) error {
+	if !fg.Enabled(features.AntreaProxy) {
+		// Validate service CIDR configuration if AntreaProxy is not enabled.
+		if _, _, err := net.ParseCIDR(c.ServiceCIDR); err != nil {
+			return fmt.Errorf("Service CIDR %s is invalid", c.ServiceCIDR)
+		}
+		if c.ServiceCIDRv6 != "" {
+			if _, _, err := net.ParseCIDR(c.ServiceCIDRv6); err != nil {
+				return fmt.Errorf("Service CIDR v6 %s is invalid", c.ServiceCIDRv6)
+			}
+		}
+		if len(c.AntreaProxy.SkipServices) > 0 {
+			warn("The antreaProxy.skipServices option will be ignored because the %s feature gate is disabled", features.AntreaProxy)
+		}
+		if c.AntreaProxy.ProxyAll {
+			warn("The antreaProxy.proxyAll option will be ignored because the %s feature gate is disabled", features.AntreaProxy)
+		}
+	}
+
+	if c.AntreaProxy.ProxyAll {
+		for _, nodePortAddress := range c.AntreaProxy.NodePortAddresses {
+			if _, _, err := net.ParseCIDR(nodePortAddress); err != nil {
+				return fmt.Errorf("invalid NodePort IP address `%s`: %w", nodePortAddress, err)
+			}
+		}
+	}
+	return nil
+}
+
+func validateFlowExporterConfig(c *agentconfig.AgentConfig, fg featuregate.FeatureGate, warn func(string, ...interface{})) error {
+	if !fg.Enabled(features.FlowExporter) {
+		return nil
+	}
+	host, _, _, err := flowexport.ParseFlowCollectorAddr(c.FlowCollectorAddr, DefaultFlowCollectorPort, DefaultFlowCollectorTransport)
+	if err != nil {
+		return err
+	}
+	if c.EnableFlowCollectorSharding {
+		if _, _, err := flowexport.ParseFlowCollectorService(host); err != nil {
+			return fmt.Errorf("flow collector sharding requires a Service DNS name: %v", err)
+		}
+	}
+	if err := filter.ValidateConfig(c.FlowExportFilter); err != nil {
+		return err
+	}
+	if c.EnableDNSVisibility && !fg.Enabled(features.AntreaPolicy) {
+		return fmt.Errorf("enableDNSVisibility requires the AntreaPolicy feature gate")
+	}
+
+	pollInterval := DefaultFlowPollInterval
+	if c.FlowPollInterval != "" {
+		pollInterval, err = flowexport.ParseFlowIntervalString(c.FlowPollInterval)
+		if err != nil {
+			return err
+		}
+	}
+	if c.ActiveFlowExportTimeout != "" {
+		activeFlowTimeout, err := time.ParseDuration(c.ActiveFlowExportTimeout)
+		if err != nil {
+			return fmt.Errorf("ActiveFlowExportTimeout is not provided in right format")
+		}
+		if activeFlowTimeout < pollInterval {
+			warn("ActiveFlowExportTimeout must be greater than or equal to FlowPollInterval, FlowPollInterval will be used instead")
+		}
+	}
+	if c.IdleFlowExportTimeout != "" {
+		idleFlowTimeout, err := time.ParseDuration(c.IdleFlowExportTimeout)
+		if err != nil {
+			return fmt.Errorf("IdleFlowExportTimeout is not provided in right format")
+		}
+		if idleFlowTimeout < pollInterval {
+			warn("IdleFlowExportTimeout must be greater than or equal to FlowPollInterval, FlowPollInterval will be used instead")
+		}
+	}
+	return nil
+}
+
+func validateMulticastConfig(c *agentconfig.AgentConfig, fg featuregate.FeatureGate, warn func(string, ...interface{})) error {
+	if !fg.Enabled(features.Multicast) {
+		// The other multicast options have non-empty default values in
+		// the configuration templates.
+		if len(c.Multicast.MulticastInterfaces) > 0 || len(c.MulticastInterfaces) > 0 {
+			warn("The multicast.multicastInterfaces option will be ignored because the %s feature gate is disabled", features.Multicast)
+		}
+		return nil
+	}
+	if c.Multicast.IGMPQueryInterval != "" {
+		if _, err := time.ParseDuration(c.Multicast.IGMPQueryInterval); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateAntreaIPAMConfig(c *agentconfig.AgentConfig, fg featuregate.FeatureGate) error {
+	if !c.EnableBridgingMode {
+		return nil
+	}
+	if !fg.Enabled(features.AntreaIPAM) {
+		return fmt.Errorf("AntreaIPAM feature gate must be enabled to configure bridging mode")
+	}
+	// Bridging mode will connect uplink to OVS bridge, which is not compatible with OVSDatapathSystem 'netdev'.
+	if c.OVSDatapathType != string(ovsconfig.OVSDatapathSystem) {
+		return fmt.Errorf("Bridging mode requires 'system' OVSDatapathType, current: %s",
+			c.OVSDatapathType)
+	}
+	if !strings.EqualFold(c.TrafficEncapMode, config.TrafficEncapModeNoEncap.String()) {
+		return fmt.Errorf("Bridging mode requires 'noEncap' TrafficEncapMode, current: %s",
+			c.TrafficEncapMode)
+	}
+	// TODO(gran): support SNAT for Per-Node IPAM Pods
+	// SNAT needs to be updated to bypass traffic from AntreaIPAM Pod to Per-Node IPAM Pod
+	if !c.NoSNAT {
+		return fmt.Errorf("Bridging mode requires noSNAT")
+	}
+	return nil
+}
+
+// CheckUnsupportedFeaturesOnWindows returns an error if any feature which is
+// not supported on Windows is enabled in the antrea-agent configuration.
+func CheckUnsupportedFeaturesOnWindows(c *agentconfig.AgentConfig) error {
+	var unsupported []string
+
+	// First check feature gates.
+	for f, enabled := range c.FeatureGates {
+		if enabled && !features.SupportedOnWindows(featuregate.Feature(f)) {
+			unsupported = append(unsupported, f)
+		}
+	}
+
+	if c.OVSDatapathType != string(ovsconfig.OVSDatapathSystem) {
+		unsupported = append(unsupported, "OVSDatapathType: "+c.OVSDatapathType)
+	}
+	_, encapMode := config.GetTrafficEncapModeFromStr(c.TrafficEncapMode)
+	if encapMode == config.TrafficEncapModeNetworkPolicyOnly {
+		unsupported = append(unsupported, "TrafficEncapMode: "+encapMode.String())
+	}
+	if c.TunnelType == ovsconfig.GRETunnel {
+		unsupported = append(unsupported, "TunnelType: "+c.TunnelType)
+	}
+	_, encryptionMode := config.GetTrafficEncryptionModeFromStr(c.TrafficEncryptionMode)
+	if encryptionMode != config.TrafficEncryptionModeNone {
+		unsupported = append(unsupported, "TrafficEncryptionMode: "+encryptionMode.String())
+	}
+	if c.EnableBridgingMode {
+		unsupported = append(unsupported, "EnableBridgingMode")
+	}
+	if unsupported != nil {
+		return fmt.Errorf("unsupported features on Windows: {%s}", strings.Join(unsupported, ", "))
+	}
+	return nil
+}
+
+// ParsePortRange parses a port range ("<start>-<end>") and checks that it is valid.
+func ParsePortRange(portRangeStr string) (start, end int, err error) {
+	portsRange := strings.Split(portRangeStr, "-")
+	if len(portsRange) != 2 {
+		return 0, 0, fmt.Errorf("wrong port range format: %s", portRangeStr)
+	}
+
+	if start, err = strconv.Atoi(portsRange[0]); err != nil {
+		return 0, 0, err
+	}
+
+	if end, err = strconv.Atoi(portsRange[1]); err != nil {
+		return 0, 0, err
+	}
+
+	if end <= start {
+		return 0, 0, fmt.Errorf("start port must be smaller than end port: %s", portRangeStr)
+	}
+
+	return start, end, nil
+}
@@ -0,0 +1,214 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"antrea.io/antrea/pkg/agent/config"
+	agentconfig "antrea.io/antrea/pkg/config/agent"
+	"antrea.io/antrea/pkg/features"
+	"antrea.io/antrea/pkg/ovs/ovsconfig"
+)
+
+func TestNewFeatureGate(t *testing.T) {
+	fg, err := NewFeatureGate(map[string]bool{string(features.Multicast): true}, false)
+	require.NoError(t, err)
+	assert.True(t, fg.Enabled(features.Multicast))
+	assert.Equal(t, features.DefaultAntreaFeatureGates[features.AntreaProxy].Default, fg.Enabled(features.AntreaProxy))
+
+	fg, err = NewFeatureGate(nil, true)
+	require.NoError(t, err)
+	assert.False(t, fg.Enabled(features.Egress))
+
+	_, err = NewFeatureGate(map[string]bool{"Unknown": true}, false)
+	assert.Error(t, err)
+}
+
+func TestSetAgentConfigDefaults(t *testing.T) {
+	fg, err := NewFeatureGate(map[string]bool{string(features.NodePortLocal): true, string(features.Multicast): true}, false)
+	require.NoError(t, err)
+
+	c := &agentconfig.AgentConfig{
+		EnableIPSecTunnel:   true,
+		NPLPortRange:        "40000-41000",
+		MulticastInterfaces: []string{"eth1"},
+	}
+	SetAgentConfigDefaults(c, fg)
+	assert.Equal(t, defaultOVSBridge, c.OVSBridge)
+	assert.Equal(t, defaultTunnelType, c.TunnelType)
+	assert.Equal(t, config.TrafficEncapModeEncap.String(), c.TrafficEncapMode)
+	assert.Equal(t, config.TrafficEncryptionModeIPSec.String(), c.TrafficEncryptionMode)
+	assert.Equal(t, "40000-41000", c.NodePortLocal.PortRange)
+	assert.Equal(t, []string{"eth1"}, c.Multicast.MulticastInterfaces)
+
+	c = &agentconfig.AgentConfig{
+		TrafficEncapMode: config.TrafficEncapModeNetworkPolicyOnly.String(),
+		NPLPortRange:     "40000-41000",
+		NodePortLocal:    agentconfig.NodePortLocalConfig{PortRange: "50000-51000"},
+	}
+	SetAgentConfigDefaults(c, fg)
+	assert.True(t, c.NoSNAT)
+	assert.Equal(t, "50000-51000", c.NodePortLocal.PortRange)
+}
+
+func TestValidateAgentConfig(t *testing.T) {
+	for _, tc := range []struct {
+		name             string
+		featureGates     map[string]bool
+		config           agentconfig.AgentConfig
+		expectedErr      string
+		expectedWarnings []string
+	}{
+		{
+			name: "default",
+		},
+		{
+			name:        "invalid tunnel type",
+			config:      agentconfig.AgentConfig{TunnelType: "foo"},
+			expectedErr: "tunnel type foo is invalid",
+		},
+		{
+			name:        "IPsec in noEncap mode",
+			config:      agentconfig.AgentConfig{TrafficEncapMode: "noEncap", EnableIPSecTunnel: true},
+			expectedErr: "TrafficEncryptionMode IPsec may only be enabled in encap mode",
+			expectedWarnings: []string{
+				"The enableIPSecTunnel option is deprecated, please use trafficEncryptionMode instead",
+			},
+		},
+		{
+			name:        "IPsec certificate authentication without feature gate",
+			config:      agentconfig.AgentConfig{IPsec: agentconfig.IPsecConfig{AuthenticationMode: "cert"}},
+			expectedErr: "IPsec AuthenticationMode cert requires feature gate IPsecCertAuth to be enabled",
+		},
+		{
+			name:        "noSNAT in encap mode",
+			config:      agentconfig.AgentConfig{NoSNAT: true},
+			expectedErr: "noSNAT is only applicable to the noEncap mode",
+		},
+		{
+			name:         "invalid NodePortLocal port range",
+			featureGates: map[string]bool{string(features.NodePortLocal): true},
+			config:       agentconfig.AgentConfig{NodePortLocal: agentconfig.NodePortLocalConfig{PortRange: "62000-61000"}},
+			expectedErr:  "NodePortLocal portRange is not valid: start port must be smaller than end port: 62000-61000",
+		},
+		{
+			name:         "options ignored because of feature gates",
+			featureGates: map[string]bool{string(features.NodePortLocal): false, string(features.Egress): false},
+			config: agentconfig.AgentConfig{
+				NodePortLocal: agentconfig.NodePortLocalConfig{Enable: true},
+				Egress:        agentconfig.EgressConfig{ExceptCIDRs: []string{"10.0.0.0/8"}},
+			},
+			expectedWarnings: []string{
+				"The egress.exceptCIDRs option will be ignored because the Egress feature gate is disabled",
+				"The nodePortLocal.enable config option is set to true, but it will be ignored because the NodePortLocal feature gate is disabled",
+			},
+		},
+		{
+			name:         "deprecated NodePortLocal port range",
+			featureGates: map[string]bool{string(features.NodePortLocal): true},
+			config:       agentconfig.AgentConfig{NPLPortRange: "40000-41000"},
+			expectedWarnings: []string{
+				"The nplPortRange option is deprecated, please use nodePortLocal.portRange instead",
+			},
+		},
+		{
+			name:         "flow exporter timeout",
+			featureGates: map[string]bool{string(features.FlowExporter): true},
+			config:       agentconfig.AgentConfig{FlowPollInterval: "10s", IdleFlowExportTimeout: "5s"},
+			expectedWarnings: []string{
+				"IdleFlowExportTimeout must be greater than or equal to FlowPollInterval, FlowPollInterval will be used instead",
+			},
+		},
+		{
+			name:        "invalid DNS server override",
+			config:      agentconfig.AgentConfig{DNSServerOverride: "[::1"},
+			expectedErr: "dnsServerOverride [::1 is invalid",
+		},
+		{
+			name:        "bridging mode without AntreaIPAM",
+			config:      agentconfig.AgentConfig{EnableBridgingMode: true},
+			expectedErr: "failed to validate AntreaIPAM config: AntreaIPAM feature gate must be enabled to configure bridging mode",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			fg, err := NewFeatureGate(tc.featureGates, false)
+			require.NoError(t, err)
+			c := tc.config
+			SetAgentConfigDefaults(&c, fg)
+			warnings, err := ValidateAgentConfig(&c, fg)
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectedWarnings, warnings)
+		})
+	}
+}
+
+func TestCheckUnsupportedFeaturesOnWindows(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		config agentconfig.AgentConfig
+		pass   bool
+	}{
+		{
+			name: "default",
+			pass: true,
+		},
+		{
+			name:   "unsupported feature gate",
+			config: agentconfig.AgentConfig{FeatureGates: map[string]bool{string(features.Egress): true}},
+		},
+		{
+			name:   "GRE tunnel",
+			config: agentconfig.AgentConfig{TunnelType: ovsconfig.GRETunnel},
+		},
+		{
+			name:   "IPsec encryption",
+			config: agentconfig.AgentConfig{EnableIPSecTunnel: true},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			fg, err := NewFeatureGate(nil, true)
+			require.NoError(t, err)
+			c := tc.config
+			SetAgentConfigDefaults(&c, fg)
+			err = CheckUnsupportedFeaturesOnWindows(&c)
+			if tc.pass {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestParsePortRange(t *testing.T) {
+	start, end, err := ParsePortRange("61000-62000")
+	require.NoError(t, err)
+	assert.Equal(t, 61000, start)
+	assert.Equal(t, 62000, end)
+
+	for _, portRange := range []string{"61000", "a-62000", "61000-b", "62000-61000"} {
+		_, _, err := ParsePortRange(portRange)
+		assert.Error(t, err, portRange)
+	}
+}
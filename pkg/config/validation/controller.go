@@ -0,0 +1,147 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+	"net"
+
+	"k8s.io/component-base/featuregate"
+	netutils "k8s.io/utils/net"
+
+	"antrea.io/antrea/pkg/apis"
+	controllerconfig "antrea.io/antrea/pkg/config/controller"
+	"antrea.io/antrea/pkg/features"
+)
+
+const (
+	ipamIPv4MaskLo      = 16
+	ipamIPv4MaskHi      = 30
+	ipamIPv4MaskDefault = 24
+	ipamIPv6MaskLo      = 64
+	ipamIPv6MaskHi      = 126
+	ipamIPv6MaskDefault = 64
+)
+
+func ptrBool(value bool) *bool {
+	return &value
+}
+
+// SetControllerConfigDefaults sets the default values of the options which are
+// not set in the antrea-controller configuration.
+func SetControllerConfigDefaults(c *controllerconfig.ControllerConfig) {
+	if c.APIPort == 0 {
+		c.APIPort = apis.AntreaControllerAPIPort
+	}
+	if c.EnablePrometheusMetrics == nil {
+		c.EnablePrometheusMetrics = ptrBool(true)
+	}
+	if c.SelfSignedCert == nil {
+		c.SelfSignedCert = ptrBool(true)
+	}
+	if c.NodeIPAM.NodeCIDRMaskSizeIPv4 == 0 {
+		c.NodeIPAM.NodeCIDRMaskSizeIPv4 = ipamIPv4MaskDefault
+	}
+
+	if c.NodeIPAM.NodeCIDRMaskSizeIPv6 == 0 {
+		c.NodeIPAM.NodeCIDRMaskSizeIPv6 = ipamIPv6MaskDefault
+	}
+	if c.IPsecCSRSignerConfig.SelfSignedCA == nil {
+		c.IPsecCSRSignerConfig.SelfSignedCA = ptrBool(true)
+	}
+	if c.IPsecCSRSignerConfig.AutoApprove == nil {
+		c.IPsecCSRSignerConfig.AutoApprove = ptrBool(true)
+	}
+}
+
+// ValidateControllerConfig validates the antrea-controller configuration, which
+// must have been defaulted with SetControllerConfigDefaults, against the
+// enabled feature gates. It returns the first invalid option as an error, and
+// warnings about the deprecated options and the options ignored because of the
+// feature gates.
+func ValidateControllerConfig(c *controllerconfig.ControllerConfig, fg featuregate.FeatureGate) ([]string, error) {
+	var warnings []string
+	if c.LegacyCRDMirroring != nil {
+		warnings = append(warnings, "The legacyCRDMirroring config option is deprecated and will be ignored (no CRD mirroring)")
+	}
+	if c.NodeIPAM.EnableNodeIPAM {
+		if !fg.Enabled(features.NodeIPAM) {
+			warnings = append(warnings, fmt.Sprintf("The nodeIPAM.enableNodeIPAM option will be ignored because the %s feature gate is disabled", features.NodeIPAM))
+		}
+		if err := validateNodeIPAMControllerOptions(&c.NodeIPAM); err != nil {
+			return warnings, err
+		}
+	}
+	return warnings, nil
+}
+
+func validateNodeIPAMControllerOptions(c *controllerconfig.NodeIPAMConfig) error {
+	// Validate ClusterCIDRs
+	cidrs, err := netutils.ParseCIDRs(c.ClusterCIDRs)
+	if err != nil {
+		return fmt.Errorf("cluster CIDRs %v is invalid", c.ClusterCIDRs)
+	}
+
+	if len(cidrs) == 0 {
+		return fmt.Errorf("at least one cluster CIDR must be specified")
+	}
+	if len(cidrs) > 2 {
+		return fmt.Errorf("at most two cluster CIDRs may be specified")
+	}
+
+	hasIP4, hasIP6 := false, false
+	for _, cidr := range cidrs {
+		if cidr.IP.To4() == nil {
+			hasIP6 = true
+		} else {
+			hasIP4 = true
+		}
+	}
+
+	dualStack := hasIP4 && hasIP6
+	if len(cidrs) > 1 && !dualStack {
+		return fmt.Errorf("at most one cluster CIDR may be specified for each IP family")
+	}
+
+	if hasIP4 {
+		if c.NodeCIDRMaskSizeIPv4 < ipamIPv4MaskLo || c.NodeCIDRMaskSizeIPv4 > ipamIPv4MaskHi {
+			return fmt.Errorf("node IPv4 CIDR mask size %d is invalid, should be between %d and %d",
+				c.NodeCIDRMaskSizeIPv4, ipamIPv4MaskLo, ipamIPv4MaskHi)
+		}
+	}
+
+	if hasIP6 {
+		if c.NodeCIDRMaskSizeIPv6 < ipamIPv6MaskLo || c.NodeCIDRMaskSizeIPv6 > ipamIPv6MaskHi {
+			return fmt.Errorf("node IPv6 CIDR mask size %d is invalid, should be between %d and %d",
+				c.NodeCIDRMaskSizeIPv6, ipamIPv6MaskLo, ipamIPv6MaskHi)
+		}
+	}
+
+	// Validate ServiceCIDR and ServiceCIDRv6. Service CIDRs can be empty when there is no overlap with ClusterCIDR
+	if c.ServiceCIDR != "" {
+		_, _, err = net.ParseCIDR(c.ServiceCIDR)
+		if err != nil {
+			return fmt.Errorf("service CIDR %s is invalid", c.ServiceCIDR)
+		}
+	}
+	if c.ServiceCIDRv6 != "" {
+		_, _, err = net.ParseCIDR(c.ServiceCIDRv6)
+		if err != nil {
+			return fmt.Errorf("secondary service CIDR %s is invalid", c.ServiceCIDRv6)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	controllerconfig "antrea.io/antrea/pkg/config/controller"
+	"antrea.io/antrea/pkg/features"
+)
+
+func TestValidateControllerConfig(t *testing.T) {
+	for _, tc := range []struct {
+		name             string
+		featureGates     map[string]bool
+		config           controllerconfig.ControllerConfig
+		expectedErr      string
+		expectedWarnings []string
+	}{
+		{
+			name: "default",
+		},
+		{
+			name:   "legacyCRDMirroring",
+			config: controllerconfig.ControllerConfig{LegacyCRDMirroring: ptrBool(true)},
+			expectedWarnings: []string{
+				"The legacyCRDMirroring config option is deprecated and will be ignored (no CRD mirroring)",
+			},
+		},
+		{
+			name:         "valid NodeIPAM",
+			featureGates: map[string]bool{string(features.NodeIPAM): true},
+			config: controllerconfig.ControllerConfig{NodeIPAM: controllerconfig.NodeIPAMConfig{
+				EnableNodeIPAM: true,
+				ClusterCIDRs:   []string{"10.10.0.0/16", "fd00::/48"},
+			}},
+		},
+		{
+			name:         "invalid NodeIPAM mask size",
+			featureGates: map[string]bool{string(features.NodeIPAM): true},
+			config: controllerconfig.ControllerConfig{NodeIPAM: controllerconfig.NodeIPAMConfig{
+				EnableNodeIPAM:       true,
+				ClusterCIDRs:         []string{"10.10.0.0/16"},
+				NodeCIDRMaskSizeIPv4: 31,
+			}},
+			expectedErr: "node IPv4 CIDR mask size 31 is invalid, should be between 16 and 30",
+		},
+		{
+			name:         "NodeIPAM without feature gate",
+			featureGates: map[string]bool{string(features.NodeIPAM): false},
+			config: controllerconfig.ControllerConfig{NodeIPAM: controllerconfig.NodeIPAMConfig{
+				EnableNodeIPAM: true,
+			}},
+			expectedErr: "at least one cluster CIDR must be specified",
+			expectedWarnings: []string{
+				"The nodeIPAM.enableNodeIPAM option will be ignored because the NodeIPAM feature gate is disabled",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			fg, err := NewFeatureGate(tc.featureGates, false)
+			require.NoError(t, err)
+			c := tc.config
+			SetControllerConfigDefaults(&c)
+			warnings, err := ValidateControllerConfig(&c, fg)
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectedWarnings, warnings)
+		})
+	}
+}
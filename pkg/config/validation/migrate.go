@@ -0,0 +1,176 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"antrea.io/antrea/pkg/agent/config"
+)
+
+// MigrateAgentConfig rewrites the deprecated options of the antrea-agent
+// configuration data to the options replacing them, the same way as they are
+// reconciled by antrea-agent. The comments and the order of the other options
+// are preserved. It returns the new configuration and the descriptions of the
+// changes, and data itself if no option is deprecated.
+func MigrateAgentConfig(data []byte) ([]byte, []string, error) {
+	return migrate(data, migrateIPSecTunnel, migrateNPLPortRange, migrateMulticastInterfaces)
+}
+
+// MigrateControllerConfig rewrites the deprecated options of the
+// antrea-controller configuration data, like MigrateAgentConfig.
+func MigrateControllerConfig(data []byte) ([]byte, []string, error) {
+	return migrate(data, migrateLegacyCRDMirroring)
+}
+
+func migrate(data []byte, migrations ...func(root *yaml.Node) []string) ([]byte, []string, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, nil, err
+	}
+	// The document is empty or only has comments.
+	if len(doc.Content) == 0 {
+		return data, nil, nil
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, nil, fmt.Errorf("configuration is not a YAML map")
+	}
+	var changes []string
+	for _, m := range migrations {
+		changes = append(changes, m(root)...)
+	}
+	if len(changes) == 0 {
+		return data, nil, nil
+	}
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&doc); err != nil {
+		return nil, nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, nil, err
+	}
+	return buf.Bytes(), changes, nil
+}
+
+// valueIndex returns the index of the value of key in the content of the
+// mapping node m, or -1 if key is not in m.
+func valueIndex(m *yaml.Node, key string) int {
+	for i := 0; i+1 < len(m.Content); i += 2 {
+		if m.Content[i].Value == key {
+			return i + 1
+		}
+	}
+	return -1
+}
+
+// removeKey removes key from the mapping node m, and returns its value, or nil
+// if key is not in m.
+func removeKey(m *yaml.Node, key string) *yaml.Node {
+	i := valueIndex(m, key)
+	if i == -1 {
+		return nil
+	}
+	value := m.Content[i]
+	m.Content = append(m.Content[:i-1], m.Content[i+1:]...)
+	return value
+}
+
+func isEmpty(n *yaml.Node) bool {
+	return n == nil || n.Tag == "!!null" || (n.Kind == yaml.ScalarNode && n.Value == "") || (n.Kind == yaml.SequenceNode && len(n.Content) == 0)
+}
+
+// setValue sets the value of key in the mapping node m, adding key to m if it
+// is not in m yet.
+func setValue(m *yaml.Node, key string, value *yaml.Node) {
+	if i := valueIndex(m, key); i != -1 {
+		value.LineComment = m.Content[i].LineComment
+		m.Content[i] = value
+		return
+	}
+	m.Content = append(m.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, value)
+}
+
+// getMap returns the mapping node which is the value of key in the mapping node
+// m, and adds it to m if it is not in m or if its value is empty.
+func getMap(m *yaml.Node, key string) *yaml.Node {
+	if i := valueIndex(m, key); i != -1 && m.Content[i].Kind == yaml.MappingNode {
+		return m.Content[i]
+	}
+	value := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	setValue(m, key, value)
+	return value
+}
+
+func migrateIPSecTunnel(root *yaml.Node) []string {
+	value := removeKey(root, "enableIPSecTunnel")
+	if value == nil {
+		return nil
+	}
+	var enabled bool
+	if err := value.Decode(&enabled); err != nil || !enabled {
+		return []string{"Removed enableIPSecTunnel, which is not enabled"}
+	}
+	// The lower case value is the one used in the documentation of the option.
+	ipsec := strings.ToLower(config.TrafficEncryptionModeIPSec.String())
+	changes := []string{fmt.Sprintf("Replaced enableIPSecTunnel with trafficEncryptionMode: %s", ipsec)}
+	if i := valueIndex(root, "trafficEncryptionMode"); i != -1 {
+		mode := root.Content[i].Value
+		if !isEmpty(root.Content[i]) && !strings.EqualFold(mode, ipsec) && !strings.EqualFold(mode, config.TrafficEncryptionModeNone.String()) {
+			changes = append(changes, fmt.Sprintf("Replaced trafficEncryptionMode %s, which is overridden by enableIPSecTunnel", mode))
+		}
+	}
+	setValue(root, "trafficEncryptionMode", &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: ipsec})
+	return changes
+}
+
+// moveValue moves the value of the deprecated option oldKey to the option key
+// of the map parentKey, unless the latter is already set.
+func moveValue(root *yaml.Node, oldKey, parentKey, key string) []string {
+	value := removeKey(root, oldKey)
+	if value == nil {
+		return nil
+	}
+	if isEmpty(value) {
+		return []string{fmt.Sprintf("Removed %s, which is empty", oldKey)}
+	}
+	parent := getMap(root, parentKey)
+	if i := valueIndex(parent, key); i != -1 && !isEmpty(parent.Content[i]) {
+		return []string{fmt.Sprintf("Removed %s, which is overridden by %s.%s", oldKey, parentKey, key)}
+	}
+	setValue(parent, key, value)
+	return []string{fmt.Sprintf("Moved %s to %s.%s", oldKey, parentKey, key)}
+}
+
+func migrateNPLPortRange(root *yaml.Node) []string {
+	return moveValue(root, "nplPortRange", "nodePortLocal", "portRange")
+}
+
+func migrateMulticastInterfaces(root *yaml.Node) []string {
+	return moveValue(root, "multicastInterfaces", "multicast", "multicastInterfaces")
+}
+
+func migrateLegacyCRDMirroring(root *yaml.Node) []string {
+	if removeKey(root, "legacyCRDMirroring") == nil {
+		return nil
+	}
+	return []string{"Removed legacyCRDMirroring, which is ignored"}
+}
@@ -0,0 +1,118 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateAgentConfig(t *testing.T) {
+	for _, tc := range []struct {
+		name            string
+		config          string
+		expectedConfig  string
+		expectedChanges []string
+	}{
+		{
+			name: "no deprecated option",
+			config: `# The tunnel type.
+tunnelType: vxlan
+`,
+			expectedConfig: `# The tunnel type.
+tunnelType: vxlan
+`,
+		},
+		{
+			name: "enableIPSecTunnel",
+			config: `featureGates:
+  Egress: true
+# Enable IPsec.
+enableIPSecTunnel: true
+trafficEncryptionMode: none # The encryption mode.
+`,
+			expectedConfig: `featureGates:
+  Egress: true
+trafficEncryptionMode: ipsec # The encryption mode.
+`,
+			expectedChanges: []string{"Replaced enableIPSecTunnel with trafficEncryptionMode: ipsec"},
+		},
+		{
+			name: "enableIPSecTunnel disabled",
+			config: `enableIPSecTunnel: false
+trafficEncryptionMode: wireGuard
+`,
+			expectedConfig: `trafficEncryptionMode: wireGuard
+`,
+			expectedChanges: []string{"Removed enableIPSecTunnel, which is not enabled"},
+		},
+		{
+			name: "nplPortRange and multicastInterfaces",
+			config: `nplPortRange: 40000-41000
+multicastInterfaces: [eth1]
+nodePortLocal:
+  enable: true
+multicast:
+  multicastInterfaces: [eth2]
+`,
+			expectedConfig: `nodePortLocal:
+  enable: true
+  portRange: 40000-41000
+multicast:
+  multicastInterfaces: [eth2]
+`,
+			expectedChanges: []string{
+				"Moved nplPortRange to nodePortLocal.portRange",
+				"Removed multicastInterfaces, which is overridden by multicast.multicastInterfaces",
+			},
+		},
+		{
+			name: "empty nplPortRange and new section",
+			config: `nplPortRange: ""
+multicastInterfaces:
+  - eth1
+`,
+			expectedConfig: `multicast:
+  multicastInterfaces:
+    - eth1
+`,
+			expectedChanges: []string{
+				"Removed nplPortRange, which is empty",
+				"Moved multicastInterfaces to multicast.multicastInterfaces",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			data, changes, err := MigrateAgentConfig([]byte(tc.config))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedConfig, string(data))
+			assert.Equal(t, tc.expectedChanges, changes)
+		})
+	}
+
+	_, _, err := MigrateAgentConfig([]byte("- tunnelType"))
+	assert.Error(t, err)
+}
+
+func TestMigrateControllerConfig(t *testing.T) {
+	data, changes, err := MigrateControllerConfig([]byte(`legacyCRDMirroring: true
+apiPort: 10349
+`))
+	require.NoError(t, err)
+	assert.Equal(t, "apiPort: 10349\n", string(data))
+	assert.Equal(t, []string{"Removed legacyCRDMirroring, which is ignored"}, changes)
+}
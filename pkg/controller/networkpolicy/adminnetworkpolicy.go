@@ -0,0 +1,346 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+	policyv1alpha1 "antrea.io/antrea/third_party/networkpolicyapi/apis/v1alpha1"
+)
+
+var (
+	// adminNetworkPolicyActions maps the actions of AdminNetworkPolicy rules to Antrea rule actions.
+	adminNetworkPolicyActions = map[policyv1alpha1.AdminNetworkPolicyRuleAction]crdv1alpha1.RuleAction{
+		policyv1alpha1.AdminNetworkPolicyRuleActionAllow: crdv1alpha1.RuleActionAllow,
+		policyv1alpha1.AdminNetworkPolicyRuleActionDeny:  crdv1alpha1.RuleActionDrop,
+		policyv1alpha1.AdminNetworkPolicyRuleActionPass:  crdv1alpha1.RuleActionPass,
+	}
+	// baselineAdminNetworkPolicyActions maps the actions of BaselineAdminNetworkPolicy rules to Antrea rule actions.
+	baselineAdminNetworkPolicyActions = map[policyv1alpha1.BaselineAdminNetworkPolicyRuleAction]crdv1alpha1.RuleAction{
+		policyv1alpha1.BaselineAdminNetworkPolicyRuleActionAllow: crdv1alpha1.RuleActionAllow,
+		policyv1alpha1.BaselineAdminNetworkPolicyRuleActionDeny:  crdv1alpha1.RuleActionDrop,
+	}
+)
+
+const (
+	// baselineAdminNetworkPolicyPriority is the policy priority of the BaselineAdminNetworkPolicy in the baseline
+	// Tier. It is lower than the priority of any Antrea ClusterNetworkPolicy, which is at most 10000, so that
+	// Antrea-native policies in the baseline Tier take precedence over it.
+	baselineAdminNetworkPolicyPriority = float64(10001)
+)
+
+// addAdminNP receives AdminNetworkPolicy ADD events and creates resources
+// which can be consumed by agents to configure corresponding rules on the Nodes.
+func (n *NetworkPolicyController) addAdminNP(obj interface{}) {
+	defer n.heartbeat("addAdminNP")
+	anp := obj.(*policyv1alpha1.AdminNetworkPolicy)
+	klog.InfoS("Processing AdminNetworkPolicy ADD event", "adminNetworkPolicy", anp.Name)
+	n.createInternalNetworkPolicyForAdminPolicy(n.processAdminNetworkPolicy(anp))
+}
+
+// updateAdminNP receives AdminNetworkPolicy UPDATE events and updates resources
+// which can be consumed by agents to configure corresponding rules on the Nodes.
+func (n *NetworkPolicyController) updateAdminNP(old, cur interface{}) {
+	defer n.heartbeat("updateAdminNP")
+	curANP := cur.(*policyv1alpha1.AdminNetworkPolicy)
+	klog.InfoS("Processing AdminNetworkPolicy UPDATE event", "adminNetworkPolicy", curANP.Name)
+	n.updateInternalNetworkPolicyForAdminPolicy(n.processAdminNetworkPolicy(curANP))
+}
+
+// deleteAdminNP receives AdminNetworkPolicy DELETED events and deletes resources
+// which can be consumed by agents to delete corresponding rules on the Nodes.
+func (n *NetworkPolicyController) deleteAdminNP(old interface{}) {
+	anp, ok := old.(*policyv1alpha1.AdminNetworkPolicy)
+	if !ok {
+		tombstone, ok := old.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			klog.Errorf("Error decoding object when deleting AdminNetworkPolicy, invalid type: %v", old)
+			return
+		}
+		anp, ok = tombstone.Obj.(*policyv1alpha1.AdminNetworkPolicy)
+		if !ok {
+			klog.Errorf("Error decoding object tombstone when deleting AdminNetworkPolicy, invalid type: %v", tombstone.Obj)
+			return
+		}
+	}
+	defer n.heartbeat("deleteAdminNP")
+	klog.InfoS("Processing AdminNetworkPolicy DELETE event", "adminNetworkPolicy", anp.Name)
+	n.deleteInternalNetworkPolicyForAdminPolicy(internalNetworkPolicyKeyFunc(anp))
+}
+
+// addBANP receives BaselineAdminNetworkPolicy ADD events and creates resources
+// which can be consumed by agents to configure corresponding rules on the Nodes.
+func (n *NetworkPolicyController) addBANP(obj interface{}) {
+	defer n.heartbeat("addBANP")
+	banp := obj.(*policyv1alpha1.BaselineAdminNetworkPolicy)
+	klog.InfoS("Processing BaselineAdminNetworkPolicy ADD event", "baselineAdminNetworkPolicy", banp.Name)
+	n.createInternalNetworkPolicyForAdminPolicy(n.processBaselineAdminNetworkPolicy(banp))
+}
+
+// updateBANP receives BaselineAdminNetworkPolicy UPDATE events and updates resources
+// which can be consumed by agents to configure corresponding rules on the Nodes.
+func (n *NetworkPolicyController) updateBANP(old, cur interface{}) {
+	defer n.heartbeat("updateBANP")
+	curBANP := cur.(*policyv1alpha1.BaselineAdminNetworkPolicy)
+	klog.InfoS("Processing BaselineAdminNetworkPolicy UPDATE event", "baselineAdminNetworkPolicy", curBANP.Name)
+	n.updateInternalNetworkPolicyForAdminPolicy(n.processBaselineAdminNetworkPolicy(curBANP))
+}
+
+// deleteBANP receives BaselineAdminNetworkPolicy DELETED events and deletes resources
+// which can be consumed by agents to delete corresponding rules on the Nodes.
+func (n *NetworkPolicyController) deleteBANP(old interface{}) {
+	banp, ok := old.(*policyv1alpha1.BaselineAdminNetworkPolicy)
+	if !ok {
+		tombstone, ok := old.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			klog.Errorf("Error decoding object when deleting BaselineAdminNetworkPolicy, invalid type: %v", old)
+			return
+		}
+		banp, ok = tombstone.Obj.(*policyv1alpha1.BaselineAdminNetworkPolicy)
+		if !ok {
+			klog.Errorf("Error decoding object tombstone when deleting BaselineAdminNetworkPolicy, invalid type: %v", tombstone.Obj)
+			return
+		}
+	}
+	defer n.heartbeat("deleteBANP")
+	klog.InfoS("Processing BaselineAdminNetworkPolicy DELETE event", "baselineAdminNetworkPolicy", banp.Name)
+	n.deleteInternalNetworkPolicyForAdminPolicy(internalNetworkPolicyKeyFunc(banp))
+}
+
+func (n *NetworkPolicyController) createInternalNetworkPolicyForAdminPolicy(internalNP *antreatypes.NetworkPolicy) {
+	klog.V(2).Infof("Creating new internal NetworkPolicy %s for %s", internalNP.Name, internalNP.SourceRef.ToString())
+	n.internalNetworkPolicyStore.Create(internalNP)
+	n.enqueueInternalNetworkPolicy(internalNP.Name)
+}
+
+func (n *NetworkPolicyController) updateInternalNetworkPolicyForAdminPolicy(curInternalNP *antreatypes.NetworkPolicy) {
+	klog.V(2).Infof("Updating existing internal NetworkPolicy %s for %s", curInternalNP.Name, curInternalNP.SourceRef.ToString())
+	key := curInternalNP.Name
+	n.internalNetworkPolicyMutex.Lock()
+	oldInternalNPObj, _, _ := n.internalNetworkPolicyStore.Get(key)
+	oldInternalNP := oldInternalNPObj.(*antreatypes.NetworkPolicy)
+	// Must preserve old internal NetworkPolicy Span.
+	curInternalNP.SpanMeta = oldInternalNP.SpanMeta
+	n.internalNetworkPolicyStore.Update(curInternalNP)
+	n.internalNetworkPolicyMutex.Unlock()
+	// Enqueue addressGroup keys to update their Node span.
+	for _, rule := range curInternalNP.Rules {
+		for _, addrGroupName := range rule.From.AddressGroups {
+			n.enqueueAddressGroup(addrGroupName)
+		}
+		for _, addrGroupName := range rule.To.AddressGroups {
+			n.enqueueAddressGroup(addrGroupName)
+		}
+	}
+	n.enqueueInternalNetworkPolicy(key)
+	for _, atg := range oldInternalNP.AppliedToGroups {
+		// Delete the old AppliedToGroup object if it is not referenced
+		// by any internal NetworkPolicy.
+		n.deleteDereferencedAppliedToGroup(atg)
+	}
+	n.deleteDereferencedAddressGroups(oldInternalNP)
+}
+
+func (n *NetworkPolicyController) deleteInternalNetworkPolicyForAdminPolicy(key string) {
+	n.internalNetworkPolicyMutex.Lock()
+	oldInternalNPObj, _, _ := n.internalNetworkPolicyStore.Get(key)
+	oldInternalNP := oldInternalNPObj.(*antreatypes.NetworkPolicy)
+	klog.V(2).Infof("Deleting internal NetworkPolicy %s for %s", oldInternalNP.Name, oldInternalNP.SourceRef.ToString())
+	err := n.internalNetworkPolicyStore.Delete(key)
+	n.internalNetworkPolicyMutex.Unlock()
+	if err != nil {
+		klog.Errorf("Error deleting internal NetworkPolicy %s: %v", key, err)
+		return
+	}
+	for _, atg := range oldInternalNP.AppliedToGroups {
+		n.deleteDereferencedAppliedToGroup(atg)
+	}
+	n.deleteDereferencedAddressGroups(oldInternalNP)
+}
+
+// processAdminNetworkPolicy creates an internal NetworkPolicy instance corresponding to the
+// policyv1alpha1.AdminNetworkPolicy object. The AdminNetworkPolicy is converted to an equivalent
+// Antrea ClusterNetworkPolicy, enforced in the Tier reserved for AdminNetworkPolicies, which is
+// after all the Antrea Tiers and before K8s NetworkPolicies. The priority of the AdminNetworkPolicy
+// is used as the policy priority in this Tier.
+func (n *NetworkPolicyController) processAdminNetworkPolicy(anp *policyv1alpha1.AdminNetworkPolicy) *antreatypes.NetworkPolicy {
+	cnp := &crdv1alpha1.ClusterNetworkPolicy{
+		ObjectMeta: anp.ObjectMeta,
+		Spec: crdv1alpha1.ClusterNetworkPolicySpec{
+			Priority:  float64(anp.Spec.Priority),
+			AppliedTo: adminPolicySubjectToAppliedTo(anp.Spec.Subject),
+		},
+	}
+	var unsupportedRules []string
+	for _, rule := range anp.Spec.Ingress {
+		cnpRule, ok, ignoredPeers := toAntreaRuleForAdminPolicy(anp.Name, rule.Name, adminNetworkPolicyActions[rule.Action], controlplane.DirectionIn, rule.From, rule.Ports)
+		if ok {
+			cnp.Spec.Ingress = append(cnp.Spec.Ingress, cnpRule)
+		}
+		if ignoredPeers > 0 {
+			unsupportedRules = append(unsupportedRules, rule.Name)
+		}
+	}
+	for _, rule := range anp.Spec.Egress {
+		cnpRule, ok, ignoredPeers := toAntreaRuleForAdminPolicy(anp.Name, rule.Name, adminNetworkPolicyActions[rule.Action], controlplane.DirectionOut, rule.To, rule.Ports)
+		if ok {
+			cnp.Spec.Egress = append(cnp.Spec.Egress, cnpRule)
+		}
+		if ignoredPeers > 0 {
+			unsupportedRules = append(unsupportedRules, rule.Name)
+		}
+	}
+	internalNP := n.processClusterNetworkPolicy(cnp)
+	internalNP.UnsupportedRules = unsupportedRules
+	internalNP.SourceRef.Type = controlplane.AdminNetworkPolicy
+	tierPriority := AdminNetworkPolicyTierPriority
+	internalNP.TierPriority = &tierPriority
+	return internalNP
+}
+
+// processBaselineAdminNetworkPolicy creates an internal NetworkPolicy instance corresponding to the
+// policyv1alpha1.BaselineAdminNetworkPolicy object. The BaselineAdminNetworkPolicy is converted to
+// an equivalent Antrea ClusterNetworkPolicy, enforced in the baseline Tier after K8s NetworkPolicies.
+func (n *NetworkPolicyController) processBaselineAdminNetworkPolicy(banp *policyv1alpha1.BaselineAdminNetworkPolicy) *antreatypes.NetworkPolicy {
+	cnp := &crdv1alpha1.ClusterNetworkPolicy{
+		ObjectMeta: banp.ObjectMeta,
+		Spec: crdv1alpha1.ClusterNetworkPolicySpec{
+			Priority:  baselineAdminNetworkPolicyPriority,
+			AppliedTo: adminPolicySubjectToAppliedTo(banp.Spec.Subject),
+		},
+	}
+	var unsupportedRules []string
+	for _, rule := range banp.Spec.Ingress {
+		cnpRule, ok, ignoredPeers := toAntreaRuleForAdminPolicy(banp.Name, rule.Name, baselineAdminNetworkPolicyActions[rule.Action], controlplane.DirectionIn, rule.From, rule.Ports)
+		if ok {
+			cnp.Spec.Ingress = append(cnp.Spec.Ingress, cnpRule)
+		}
+		if ignoredPeers > 0 {
+			unsupportedRules = append(unsupportedRules, rule.Name)
+		}
+	}
+	for _, rule := range banp.Spec.Egress {
+		cnpRule, ok, ignoredPeers := toAntreaRuleForAdminPolicy(banp.Name, rule.Name, baselineAdminNetworkPolicyActions[rule.Action], controlplane.DirectionOut, rule.To, rule.Ports)
+		if ok {
+			cnp.Spec.Egress = append(cnp.Spec.Egress, cnpRule)
+		}
+		if ignoredPeers > 0 {
+			unsupportedRules = append(unsupportedRules, rule.Name)
+		}
+	}
+	internalNP := n.processClusterNetworkPolicy(cnp)
+	internalNP.UnsupportedRules = unsupportedRules
+	internalNP.SourceRef.Type = controlplane.BaselineAdminNetworkPolicy
+	tierPriority := BaselineTierPriority
+	internalNP.TierPriority = &tierPriority
+	return internalNP
+}
+
+// adminPolicySubjectToAppliedTo converts the subject of an AdminNetworkPolicy or a
+// BaselineAdminNetworkPolicy to the appliedTo of an Antrea ClusterNetworkPolicy.
+func adminPolicySubjectToAppliedTo(subject policyv1alpha1.AdminNetworkPolicySubject) []crdv1alpha1.NetworkPolicyPeer {
+	if subject.Namespaces != nil {
+		return []crdv1alpha1.NetworkPolicyPeer{{NamespaceSelector: subject.Namespaces.DeepCopy()}}
+	}
+	if subject.Pods != nil {
+		return []crdv1alpha1.NetworkPolicyPeer{{
+			NamespaceSelector: subject.Pods.NamespaceSelector.DeepCopy(),
+			PodSelector:       subject.Pods.PodSelector.DeepCopy(),
+		}}
+	}
+	return nil
+}
+
+// toAntreaRuleForAdminPolicy converts a rule of an AdminNetworkPolicy or a BaselineAdminNetworkPolicy
+// to a rule of an Antrea ClusterNetworkPolicy. Peers selecting Namespaces by sameLabels or notSameLabels
+// are not supported and are ignored, their number is returned so that the policy can be reported as not
+// realized. It returns false if none of the peers of the rule is supported, as a rule without peer would
+// match all the traffic.
+func toAntreaRuleForAdminPolicy(policyName, ruleName string, action crdv1alpha1.RuleAction, direction controlplane.Direction, peers []policyv1alpha1.AdminNetworkPolicyPeer, ports *[]policyv1alpha1.AdminNetworkPolicyPort) (crdv1alpha1.Rule, bool, int) {
+	var antreaPeers []crdv1alpha1.NetworkPolicyPeer
+	ignoredPeers := 0
+	for _, peer := range peers {
+		var namespaces *policyv1alpha1.NamespacedPeer
+		var podSelector *metav1.LabelSelector
+		if peer.Namespaces != nil {
+			namespaces = peer.Namespaces
+		} else if peer.Pods != nil {
+			namespaces = &peer.Pods.Namespaces
+			podSelector = peer.Pods.PodSelector.DeepCopy()
+		} else {
+			continue
+		}
+		if namespaces.NamespaceSelector == nil {
+			klog.InfoS("Ignoring peer selecting Namespaces by sameLabels or notSameLabels, which is not supported", "policy", policyName, "rule", ruleName)
+			ignoredPeers++
+			continue
+		}
+		antreaPeers = append(antreaPeers, crdv1alpha1.NetworkPolicyPeer{
+			NamespaceSelector: namespaces.NamespaceSelector.DeepCopy(),
+			PodSelector:       podSelector,
+		})
+	}
+	if len(antreaPeers) == 0 {
+		klog.InfoS("Ignoring rule without supported peer", "policy", policyName, "rule", ruleName)
+		return crdv1alpha1.Rule{}, false, ignoredPeers
+	}
+	rule := crdv1alpha1.Rule{
+		Name:   ruleName,
+		Action: &action,
+	}
+	if direction == controlplane.DirectionIn {
+		rule.From = antreaPeers
+	} else {
+		rule.To = antreaPeers
+	}
+	if ports != nil {
+		for _, port := range *ports {
+			rule.Ports = append(rule.Ports, toAntreaPortForAdminPolicy(port))
+		}
+	}
+	return rule, true, ignoredPeers
+}
+
+// toAntreaPortForAdminPolicy converts an AdminNetworkPolicyPort to an Antrea NetworkPolicyPort.
+func toAntreaPortForAdminPolicy(port policyv1alpha1.AdminNetworkPolicyPort) crdv1alpha1.NetworkPolicyPort {
+	switch {
+	case port.PortNumber != nil:
+		portNumber := intstr.FromInt(int(port.PortNumber.Port))
+		return crdv1alpha1.NetworkPolicyPort{Protocol: toAntreaProtocolForAdminPolicy(port.PortNumber.Protocol), Port: &portNumber}
+	case port.PortRange != nil:
+		start := intstr.FromInt(int(port.PortRange.Start))
+		end := port.PortRange.End
+		return crdv1alpha1.NetworkPolicyPort{Protocol: toAntreaProtocolForAdminPolicy(port.PortRange.Protocol), Port: &start, EndPort: &end}
+	default:
+		namedPort := intstr.FromString(*port.NamedPort)
+		return crdv1alpha1.NetworkPolicyPort{Port: &namedPort}
+	}
+}
+
+// toAntreaProtocolForAdminPolicy returns the protocol of an AdminNetworkPolicyPort, or nil if it is not
+// set, in which case TCP is used.
+func toAntreaProtocolForAdminPolicy(protocol v1.Protocol) *v1.Protocol {
+	if protocol == "" {
+		return nil
+	}
+	return &protocol
+}
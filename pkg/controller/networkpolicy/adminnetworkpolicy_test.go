@@ -0,0 +1,298 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+	policyv1alpha1 "antrea.io/antrea/third_party/networkpolicyapi/apis/v1alpha1"
+)
+
+func TestProcessAdminNetworkPolicy(t *testing.T) {
+	p10 := float64(10)
+	allowAction := crdv1alpha1.RuleActionAllow
+	dropAction := crdv1alpha1.RuleActionDrop
+	passAction := crdv1alpha1.RuleActionPass
+	protocolUDP := controlplane.ProtocolUDP
+	int1024 := intstr.FromInt(1024)
+	int2048 := int32(2048)
+	selectorA := metav1.LabelSelector{MatchLabels: map[string]string{"foo1": "bar1"}}
+	selectorB := metav1.LabelSelector{MatchLabels: map[string]string{"foo2": "bar2"}}
+	selectorC := metav1.LabelSelector{MatchLabels: map[string]string{"foo3": "bar3"}}
+	tests := []struct {
+		name                    string
+		inputPolicy             *policyv1alpha1.AdminNetworkPolicy
+		expectedPolicy          *antreatypes.NetworkPolicy
+		expectedAppliedToGroups int
+		expectedAddressGroups   int
+	}{
+		{
+			name: "pods-subject",
+			inputPolicy: &policyv1alpha1.AdminNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "anpA", UID: "uidA", Generation: 2},
+				Spec: policyv1alpha1.AdminNetworkPolicySpec{
+					Priority: 10,
+					Subject: policyv1alpha1.AdminNetworkPolicySubject{
+						Pods: &policyv1alpha1.NamespacedPodSubject{
+							NamespaceSelector: selectorA,
+							PodSelector:       selectorB,
+						},
+					},
+					Ingress: []policyv1alpha1.AdminNetworkPolicyIngressRule{
+						{
+							Name:   "deny-from-c",
+							Action: policyv1alpha1.AdminNetworkPolicyRuleActionDeny,
+							From: []policyv1alpha1.AdminNetworkPolicyPeer{
+								{Namespaces: &policyv1alpha1.NamespacedPeer{NamespaceSelector: &selectorC}},
+							},
+							Ports: &[]policyv1alpha1.AdminNetworkPolicyPort{
+								{PortNumber: &policyv1alpha1.Port{Protocol: k8sProtocolTCP, Port: 80}},
+							},
+						},
+					},
+					Egress: []policyv1alpha1.AdminNetworkPolicyEgressRule{
+						{
+							Name:   "pass-to-b",
+							Action: policyv1alpha1.AdminNetworkPolicyRuleActionPass,
+							To: []policyv1alpha1.AdminNetworkPolicyPeer{
+								{Pods: &policyv1alpha1.NamespacedPodPeer{
+									Namespaces:  policyv1alpha1.NamespacedPeer{NamespaceSelector: &selectorC},
+									PodSelector: selectorB,
+								}},
+							},
+							Ports: &[]policyv1alpha1.AdminNetworkPolicyPort{
+								{PortRange: &policyv1alpha1.PortRange{Protocol: k8sProtocolUDP, Start: 1024, End: 2048}},
+								{NamedPort: &strHTTP.StrVal},
+							},
+						},
+					},
+				},
+			},
+			expectedPolicy: &antreatypes.NetworkPolicy{
+				UID:        "uidA",
+				Name:       "uidA",
+				Generation: 2,
+				SourceRef: &controlplane.NetworkPolicyReference{
+					Type: controlplane.AdminNetworkPolicy,
+					Name: "anpA",
+					UID:  "uidA",
+				},
+				Priority:     &p10,
+				TierPriority: &AdminNetworkPolicyTierPriority,
+				Rules: []controlplane.NetworkPolicyRule{
+					{
+						Direction: controlplane.DirectionIn,
+						From: controlplane.NetworkPolicyPeer{
+							AddressGroups: []string{getNormalizedUID(antreatypes.NewGroupSelector("", nil, &selectorC, nil, nil).NormalizedName)},
+						},
+						Services: []controlplane.Service{
+							{Protocol: &protocolTCP, Port: &int80},
+						},
+						Name:     "deny-from-c",
+						Priority: 0,
+						Action:   &dropAction,
+					},
+					{
+						Direction: controlplane.DirectionOut,
+						To: controlplane.NetworkPolicyPeer{
+							AddressGroups: []string{getNormalizedUID(antreatypes.NewGroupSelector("", &selectorB, &selectorC, nil, nil).NormalizedName)},
+						},
+						Services: []controlplane.Service{
+							{Protocol: &protocolUDP, Port: &int1024, EndPort: &int2048},
+							{Protocol: &protocolTCP, Port: &strHTTP},
+						},
+						Name:     "pass-to-b",
+						Priority: 0,
+						Action:   &passAction,
+					},
+				},
+				AppliedToGroups: []string{getNormalizedUID(antreatypes.NewGroupSelector("", &selectorB, &selectorA, nil, nil).NormalizedName)},
+			},
+			expectedAppliedToGroups: 1,
+			expectedAddressGroups:   2,
+		},
+		{
+			name: "namespaces-subject-with-unsupported-peers",
+			inputPolicy: &policyv1alpha1.AdminNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "anpB", UID: "uidB"},
+				Spec: policyv1alpha1.AdminNetworkPolicySpec{
+					Priority: 10,
+					Subject: policyv1alpha1.AdminNetworkPolicySubject{
+						Namespaces: &selectorA,
+					},
+					Ingress: []policyv1alpha1.AdminNetworkPolicyIngressRule{
+						{
+							Name:   "allow-from-same-labels",
+							Action: policyv1alpha1.AdminNetworkPolicyRuleActionAllow,
+							From: []policyv1alpha1.AdminNetworkPolicyPeer{
+								{Namespaces: &policyv1alpha1.NamespacedPeer{SameLabels: []string{"tenant"}}},
+							},
+						},
+						{
+							Name:   "allow-from-b",
+							Action: policyv1alpha1.AdminNetworkPolicyRuleActionAllow,
+							From: []policyv1alpha1.AdminNetworkPolicyPeer{
+								{Namespaces: &policyv1alpha1.NamespacedPeer{NotSameLabels: []string{"tenant"}}},
+								{Namespaces: &policyv1alpha1.NamespacedPeer{NamespaceSelector: &selectorB}},
+							},
+						},
+					},
+				},
+			},
+			expectedPolicy: &antreatypes.NetworkPolicy{
+				UID:  "uidB",
+				Name: "uidB",
+				SourceRef: &controlplane.NetworkPolicyReference{
+					Type: controlplane.AdminNetworkPolicy,
+					Name: "anpB",
+					UID:  "uidB",
+				},
+				Priority:     &p10,
+				TierPriority: &AdminNetworkPolicyTierPriority,
+				Rules: []controlplane.NetworkPolicyRule{
+					{
+						Direction: controlplane.DirectionIn,
+						From: controlplane.NetworkPolicyPeer{
+							AddressGroups: []string{getNormalizedUID(antreatypes.NewGroupSelector("", nil, &selectorB, nil, nil).NormalizedName)},
+						},
+						Name:     "allow-from-b",
+						Priority: 0,
+						Action:   &allowAction,
+					},
+				},
+				AppliedToGroups:  []string{getNormalizedUID(antreatypes.NewGroupSelector("", nil, &selectorA, nil, nil).NormalizedName)},
+				UnsupportedRules: []string{"allow-from-same-labels", "allow-from-b"},
+			},
+			expectedAppliedToGroups: 1,
+			expectedAddressGroups:   1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, c := newController()
+			actualPolicy := c.processAdminNetworkPolicy(tt.inputPolicy)
+			assert.Equal(t, tt.expectedPolicy, actualPolicy)
+			assert.Equal(t, tt.expectedAppliedToGroups, len(c.appliedToGroupStore.List()))
+			assert.Equal(t, tt.expectedAddressGroups, len(c.addressGroupStore.List()))
+		})
+	}
+}
+
+func TestProcessBaselineAdminNetworkPolicy(t *testing.T) {
+	banpPriority := baselineAdminNetworkPolicyPriority
+	dropAction := crdv1alpha1.RuleActionDrop
+	selectorA := metav1.LabelSelector{MatchLabels: map[string]string{"foo1": "bar1"}}
+	selectorB := metav1.LabelSelector{MatchLabels: map[string]string{"foo2": "bar2"}}
+	banp := &policyv1alpha1.BaselineAdminNetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", UID: "uidA"},
+		Spec: policyv1alpha1.BaselineAdminNetworkPolicySpec{
+			Subject: policyv1alpha1.AdminNetworkPolicySubject{
+				Namespaces: &selectorA,
+			},
+			Egress: []policyv1alpha1.BaselineAdminNetworkPolicyEgressRule{
+				{
+					Name:   "deny-to-b",
+					Action: policyv1alpha1.BaselineAdminNetworkPolicyRuleActionDeny,
+					To: []policyv1alpha1.AdminNetworkPolicyPeer{
+						{Namespaces: &policyv1alpha1.NamespacedPeer{NamespaceSelector: &selectorB}},
+					},
+				},
+			},
+		},
+	}
+	expectedPolicy := &antreatypes.NetworkPolicy{
+		UID:  "uidA",
+		Name: "uidA",
+		SourceRef: &controlplane.NetworkPolicyReference{
+			Type: controlplane.BaselineAdminNetworkPolicy,
+			Name: "default",
+			UID:  "uidA",
+		},
+		Priority:     &banpPriority,
+		TierPriority: &BaselineTierPriority,
+		Rules: []controlplane.NetworkPolicyRule{
+			{
+				Direction: controlplane.DirectionOut,
+				To: controlplane.NetworkPolicyPeer{
+					AddressGroups: []string{getNormalizedUID(antreatypes.NewGroupSelector("", nil, &selectorB, nil, nil).NormalizedName)},
+				},
+				Name:     "deny-to-b",
+				Priority: 0,
+				Action:   &dropAction,
+			},
+		},
+		AppliedToGroups: []string{getNormalizedUID(antreatypes.NewGroupSelector("", nil, &selectorA, nil, nil).NormalizedName)},
+	}
+	_, c := newController()
+	actualPolicy := c.processBaselineAdminNetworkPolicy(banp)
+	assert.Equal(t, expectedPolicy, actualPolicy)
+}
+
+func TestAddUpdateDeleteAdminNP(t *testing.T) {
+	selectorA := metav1.LabelSelector{MatchLabels: map[string]string{"foo1": "bar1"}}
+	selectorB := metav1.LabelSelector{MatchLabels: map[string]string{"foo2": "bar2"}}
+	selectorC := metav1.LabelSelector{MatchLabels: map[string]string{"foo3": "bar3"}}
+	anp := &policyv1alpha1.AdminNetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "anpA", UID: "uidA"},
+		Spec: policyv1alpha1.AdminNetworkPolicySpec{
+			Priority: 10,
+			Subject: policyv1alpha1.AdminNetworkPolicySubject{
+				Namespaces: &selectorA,
+			},
+			Ingress: []policyv1alpha1.AdminNetworkPolicyIngressRule{
+				{
+					Action: policyv1alpha1.AdminNetworkPolicyRuleActionDeny,
+					From: []policyv1alpha1.AdminNetworkPolicyPeer{
+						{Namespaces: &policyv1alpha1.NamespacedPeer{NamespaceSelector: &selectorB}},
+					},
+				},
+			},
+		},
+	}
+	key := internalNetworkPolicyKeyFunc(anp)
+	_, npc := newController()
+
+	npc.addAdminNP(anp)
+	obj, found, _ := npc.internalNetworkPolicyStore.Get(key)
+	require.True(t, found, "expected internal NetworkPolicy to be created")
+	assert.Equal(t, controlplane.AdminNetworkPolicy, obj.(*antreatypes.NetworkPolicy).SourceRef.Type)
+	assert.Equal(t, 1, npc.GetAddressGroupNum())
+	assert.Equal(t, 1, npc.GetAppliedToGroupNum())
+
+	updatedANP := anp.DeepCopy()
+	updatedANP.Spec.Priority = 20
+	updatedANP.Spec.Ingress[0].From[0].Namespaces.NamespaceSelector = &selectorC
+	npc.updateAdminNP(anp, updatedANP)
+	obj, found, _ = npc.internalNetworkPolicyStore.Get(key)
+	require.True(t, found, "expected internal NetworkPolicy to be updated")
+	internalNP := obj.(*antreatypes.NetworkPolicy)
+	assert.Equal(t, float64(20), *internalNP.Priority)
+	assert.Equal(t, []string{getNormalizedUID(antreatypes.NewGroupSelector("", nil, &selectorC, nil, nil).NormalizedName)}, internalNP.Rules[0].From.AddressGroups)
+	assert.Equal(t, 1, npc.GetAddressGroupNum(), "expected the stale AddressGroup to be deleted")
+
+	npc.deleteAdminNP(updatedANP)
+	_, found, _ = npc.internalNetworkPolicyStore.Get(key)
+	assert.False(t, found, "expected internal NetworkPolicy to be deleted")
+	assert.Equal(t, 0, npc.GetAddressGroupNum())
+	assert.Equal(t, 0, npc.GetAppliedToGroupNum())
+}
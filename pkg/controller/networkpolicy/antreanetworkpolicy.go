@@ -30,6 +30,7 @@ func (n *NetworkPolicyController) addANP(obj interface{}) {
 	defer n.heartbeat("addANP")
 	np := obj.(*crdv1alpha1.NetworkPolicy)
 	klog.Infof("Processing Antrea NetworkPolicy %s/%s ADD event", np.Namespace, np.Name)
+	n.enqueueRuleScheduleTransition(antreaNetworkPolicyReference(np), np.Spec.Ingress, np.Spec.Egress)
 	// Create an internal NetworkPolicy object corresponding to this
 	// NetworkPolicy and enqueue task to internal NetworkPolicy Workqueue.
 	internalNP := n.processAntreaNetworkPolicy(np)
@@ -45,6 +46,7 @@ func (n *NetworkPolicyController) updateANP(old, cur interface{}) {
 	defer n.heartbeat("updateANP")
 	curNP := cur.(*crdv1alpha1.NetworkPolicy)
 	klog.Infof("Processing Antrea NetworkPolicy %s/%s UPDATE event", curNP.Namespace, curNP.Name)
+	n.enqueueRuleScheduleTransition(antreaNetworkPolicyReference(curNP), curNP.Spec.Ingress, curNP.Spec.Egress)
 	// Update an internal NetworkPolicy, corresponding to this NetworkPolicy and
 	// enqueue task to internal NetworkPolicy Workqueue.
 	curInternalNP := n.processAntreaNetworkPolicy(curNP)
@@ -84,6 +86,46 @@ func (n *NetworkPolicyController) updateANP(old, cur interface{}) {
 	n.deleteDereferencedAddressGroups(oldInternalNP)
 }
 
+// reprocessANP is triggered when the rules of an Antrea NetworkPolicy may
+// have become active or inactive according to their schedules.
+func (n *NetworkPolicyController) reprocessANP(np *crdv1alpha1.NetworkPolicy) {
+	key := internalNetworkPolicyKeyFunc(np)
+	n.internalNetworkPolicyMutex.Lock()
+	oldInternalNPObj, exist, _ := n.internalNetworkPolicyStore.Get(key)
+	// The internal NetworkPolicy may haven't been created yet, or may have
+	// been deleted. It's fine to skip processing this ANP as addANP will
+	// create it eventually.
+	if !exist {
+		klog.V(2).Infof("Cannot find the original internal NetworkPolicy, skip reprocessANP")
+		n.internalNetworkPolicyMutex.Unlock()
+		return
+	}
+	defer n.heartbeat("reprocessANP")
+	klog.Infof("Processing Antrea NetworkPolicy %s/%s REPROCESS event", np.Namespace, np.Name)
+	oldInternalNP := oldInternalNPObj.(*antreatypes.NetworkPolicy)
+	curInternalNP := n.processAntreaNetworkPolicy(np)
+	// Must preserve old internal NetworkPolicy Span.
+	curInternalNP.SpanMeta = oldInternalNP.SpanMeta
+	n.internalNetworkPolicyStore.Update(curInternalNP)
+	n.internalNetworkPolicyMutex.Unlock()
+	// Enqueue addressGroup keys to update their Node span.
+	for _, rule := range curInternalNP.Rules {
+		for _, addrGroupName := range rule.From.AddressGroups {
+			n.enqueueAddressGroup(addrGroupName)
+		}
+		for _, addrGroupName := range rule.To.AddressGroups {
+			n.enqueueAddressGroup(addrGroupName)
+		}
+	}
+	n.enqueueInternalNetworkPolicy(key)
+	for _, atg := range oldInternalNP.AppliedToGroups {
+		// Delete the old AppliedToGroup object if it is not referenced
+		// by any internal NetworkPolicy.
+		n.deleteDereferencedAppliedToGroup(atg)
+	}
+	n.deleteDereferencedAddressGroups(oldInternalNP)
+}
+
 // deleteANP receives AntreaNetworkPolicy DELETED events and deletes resources
 // which can be consumed by agents to delete corresponding rules on the Nodes.
 func (n *NetworkPolicyController) deleteANP(old interface{}) {
@@ -134,9 +176,21 @@ func (n *NetworkPolicyController) processAntreaNetworkPolicy(np *crdv1alpha1.Net
 		appliedToGroupNamesSet.Insert(n.createAppliedToGroup(
 			np.Namespace, at.PodSelector, at.NamespaceSelector, at.ExternalEntitySelector))
 	}
+	policyRef := antreaNetworkPolicyReference(np)
 	rules := make([]controlplane.NetworkPolicyRule, 0, len(np.Spec.Ingress)+len(np.Spec.Egress))
+	var inactiveRules []string
+	var ruleSchedules []crdv1alpha1.RuleScheduleStatus
 	// Compute NetworkPolicyRule for Ingress Rule.
 	for idx, ingressRule := range np.Spec.Ingress {
+		// Skip rules outside of their scheduled time windows. The priority of
+		// the other rules is still their index in the original policy.
+		if scheduleStatus := n.getRuleScheduleStatus(&np.Spec.Ingress[idx]); scheduleStatus != nil {
+			ruleSchedules = append(ruleSchedules, *scheduleStatus)
+			if !scheduleStatus.Active {
+				inactiveRules = append(inactiveRules, ingressRule.Name)
+				continue
+			}
+		}
 		// Set default action to ALLOW to allow traffic.
 		services, namedPortExists := toAntreaServicesForCRD(ingressRule.Ports, ingressRule.Protocols)
 		var appliedToGroupNamesForRule []string
@@ -146,19 +200,34 @@ func (n *NetworkPolicyController) processAntreaNetworkPolicy(np *crdv1alpha1.Net
 			appliedToGroupNamesForRule = append(appliedToGroupNamesForRule, atGroup)
 			appliedToGroupNamesSet.Insert(atGroup)
 		}
-		rules = append(rules, controlplane.NetworkPolicyRule{
+		rule := controlplane.NetworkPolicyRule{
 			Direction:       controlplane.DirectionIn,
-			From:            *n.toAntreaPeerForCRD(ingressRule.From, np, controlplane.DirectionIn, namedPortExists),
 			Services:        services,
 			Name:            ingressRule.Name,
 			Action:          ingressRule.Action,
 			Priority:        int32(idx),
 			EnableLogging:   ingressRule.EnableLogging,
 			AppliedToGroups: appliedToGroupNamesForRule,
-		})
+			RateLimit:       toAntreaRateLimitForCRD(ingressRule.Action, ingressRule.RateLimit),
+		}
+		// The Services through which the traffic arrives are set in the To
+		// peer of ingress rules, the From peer is left empty.
+		if ingressRule.ToServices != nil {
+			rule.To = *n.svcRefToPeerForCRD(ingressRule.ToServices, np.Namespace)
+		} else {
+			rule.From = *n.toAntreaPeerForCRD(ingressRule.From, np, controlplane.DirectionIn, namedPortExists)
+		}
+		rules = append(rules, rule)
 	}
 	// Compute NetworkPolicyRule for Egress Rule.
 	for idx, egressRule := range np.Spec.Egress {
+		if scheduleStatus := n.getRuleScheduleStatus(&np.Spec.Egress[idx]); scheduleStatus != nil {
+			ruleSchedules = append(ruleSchedules, *scheduleStatus)
+			if !scheduleStatus.Active {
+				inactiveRules = append(inactiveRules, egressRule.Name)
+				continue
+			}
+		}
 		// Set default action to ALLOW to allow traffic.
 		services, namedPortExists := toAntreaServicesForCRD(egressRule.Ports, egressRule.Protocols)
 		var appliedToGroupNamesForRule []string
@@ -183,24 +252,33 @@ func (n *NetworkPolicyController) processAntreaNetworkPolicy(np *crdv1alpha1.Net
 			Priority:        int32(idx),
 			EnableLogging:   egressRule.EnableLogging,
 			AppliedToGroups: appliedToGroupNamesForRule,
+			RateLimit:       toAntreaRateLimitForCRD(egressRule.Action, egressRule.RateLimit),
 		})
 	}
 	tierPriority := n.getTierPriority(np.Spec.Tier)
 	internalNetworkPolicy := &antreatypes.NetworkPolicy{
-		SourceRef: &controlplane.NetworkPolicyReference{
-			Type:      controlplane.AntreaNetworkPolicy,
-			Namespace: np.Namespace,
-			Name:      np.Name,
-			UID:       np.UID,
-		},
-		Name:             internalNetworkPolicyKeyFunc(np),
-		UID:              np.UID,
-		Generation:       np.Generation,
-		AppliedToGroups:  appliedToGroupNamesSet.List(),
-		Rules:            rules,
-		Priority:         &np.Spec.Priority,
-		TierPriority:     &tierPriority,
-		AppliedToPerRule: appliedToPerRule,
+		SourceRef:               &policyRef,
+		Name:                    internalNetworkPolicyKeyFunc(np),
+		UID:                     np.UID,
+		Generation:              np.Generation,
+		AppliedToGroups:         appliedToGroupNamesSet.List(),
+		Rules:                   rules,
+		Priority:                &np.Spec.Priority,
+		TierPriority:            &tierPriority,
+		AppliedToPerRule:        appliedToPerRule,
+		InactiveRules:           inactiveRules,
+		RuleSchedules:           ruleSchedules,
+		NonLocalIngressServices: n.nonLocalIngressServices(rules),
 	}
 	return internalNetworkPolicy
 }
+
+// antreaNetworkPolicyReference returns the reference of an Antrea NetworkPolicy.
+func antreaNetworkPolicyReference(np *crdv1alpha1.NetworkPolicy) controlplane.NetworkPolicyReference {
+	return controlplane.NetworkPolicyReference{
+		Type:      controlplane.AntreaNetworkPolicy,
+		Namespace: np.Namespace,
+		Name:      np.Name,
+		UID:       np.UID,
+	}
+}
@@ -39,6 +39,7 @@ func (n *NetworkPolicyController) addCNP(obj interface{}) {
 	defer n.heartbeat("addCNP")
 	cnp := obj.(*crdv1alpha1.ClusterNetworkPolicy)
 	klog.Infof("Processing ClusterNetworkPolicy %s ADD event", cnp.Name)
+	n.enqueueRuleScheduleTransition(clusterNetworkPolicyReference(cnp), cnp.Spec.Ingress, cnp.Spec.Egress)
 	// Create an internal NetworkPolicy object corresponding to this
 	// ClusterNetworkPolicy and enqueue task to internal NetworkPolicy Workqueue.
 	internalNP := n.processClusterNetworkPolicy(cnp)
@@ -54,6 +55,7 @@ func (n *NetworkPolicyController) updateCNP(old, cur interface{}) {
 	defer n.heartbeat("updateCNP")
 	curCNP := cur.(*crdv1alpha1.ClusterNetworkPolicy)
 	klog.Infof("Processing ClusterNetworkPolicy %s UPDATE event", curCNP.Name)
+	n.enqueueRuleScheduleTransition(clusterNetworkPolicyReference(curCNP), curCNP.Spec.Ingress, curCNP.Spec.Egress)
 	// Update an internal NetworkPolicy, corresponding to this NetworkPolicy and
 	// enqueue task to internal NetworkPolicy Workqueue.
 	curInternalNP := n.processClusterNetworkPolicy(curCNP)
@@ -378,9 +380,21 @@ func (n *NetworkPolicyController) processClusterNetworkPolicy(cnp *crdv1alpha1.C
 			}
 		}
 	}
+	policyRef := clusterNetworkPolicyReference(cnp)
 	var rules []controlplane.NetworkPolicyRule
+	var inactiveRules []string
+	var ruleSchedules []crdv1alpha1.RuleScheduleStatus
 	processRules := func(cnpRules []crdv1alpha1.Rule, direction controlplane.Direction) {
 		for idx, cnpRule := range cnpRules {
+			// Skip rules outside of their scheduled time windows. The priority of
+			// the other rules is still their index in the original policy.
+			if scheduleStatus := n.getRuleScheduleStatus(&cnpRules[idx]); scheduleStatus != nil {
+				ruleSchedules = append(ruleSchedules, *scheduleStatus)
+				if !scheduleStatus.Active {
+					inactiveRules = append(inactiveRules, cnpRule.Name)
+					continue
+				}
+			}
 			services, namedPortExists := toAntreaServicesForCRD(cnpRule.Ports, cnpRule.Protocols)
 			clusterPeers, perNSPeers := splitPeersByScope(cnpRule, direction)
 			addRule := func(peer *controlplane.NetworkPolicyPeer, dir controlplane.Direction, ruleAppliedTos []string) {
@@ -392,10 +406,13 @@ func (n *NetworkPolicyController) processClusterNetworkPolicy(cnp *crdv1alpha1.C
 					Priority:        int32(idx),
 					EnableLogging:   cnpRule.EnableLogging,
 					AppliedToGroups: ruleAppliedTos,
+					RateLimit:       toAntreaRateLimitForCRD(cnpRule.Action, cnpRule.RateLimit),
 				}
-				if dir == controlplane.DirectionIn {
+				// The Services through which the traffic arrives are set in the
+				// To peer of ingress rules, as they are for egress rules.
+				if dir == controlplane.DirectionIn && len(peer.ToServices) == 0 {
 					rule.From = *peer
-				} else if dir == controlplane.DirectionOut {
+				} else {
 					rule.To = *peer
 				}
 				rules = append(rules, rule)
@@ -457,20 +474,19 @@ func (n *NetworkPolicyController) processClusterNetworkPolicy(cnp *crdv1alpha1.C
 	}
 	tierPriority := n.getTierPriority(cnp.Spec.Tier)
 	internalNetworkPolicy := &antreatypes.NetworkPolicy{
-		Name:       internalNetworkPolicyKeyFunc(cnp),
-		Generation: cnp.Generation,
-		SourceRef: &controlplane.NetworkPolicyReference{
-			Type: controlplane.AntreaClusterNetworkPolicy,
-			Name: cnp.Name,
-			UID:  cnp.UID,
-		},
-		UID:                   cnp.UID,
-		AppliedToGroups:       atgNamesSet.List(),
-		Rules:                 rules,
-		Priority:              &cnp.Spec.Priority,
-		TierPriority:          &tierPriority,
-		AppliedToPerRule:      appliedToPerRule,
-		PerNamespaceSelectors: getUniqueNSSelectors(affectedNamespaceSelectors),
+		Name:                    internalNetworkPolicyKeyFunc(cnp),
+		Generation:              cnp.Generation,
+		SourceRef:               &policyRef,
+		UID:                     cnp.UID,
+		AppliedToGroups:         atgNamesSet.List(),
+		Rules:                   rules,
+		Priority:                &cnp.Spec.Priority,
+		TierPriority:            &tierPriority,
+		AppliedToPerRule:        appliedToPerRule,
+		PerNamespaceSelectors:   getUniqueNSSelectors(affectedNamespaceSelectors),
+		InactiveRules:           inactiveRules,
+		RuleSchedules:           ruleSchedules,
+		NonLocalIngressServices: n.nonLocalIngressServices(rules),
 	}
 	return internalNetworkPolicy
 }
@@ -673,3 +689,12 @@ func (n *NetworkPolicyController) processAppliedToGroupForCG(g string) string {
 	}
 	return n.createAppliedToGroupForClusterGroupCRD(intGrp)
 }
+
+// clusterNetworkPolicyReference returns the reference of an Antrea ClusterNetworkPolicy.
+func clusterNetworkPolicyReference(cnp *crdv1alpha1.ClusterNetworkPolicy) controlplane.NetworkPolicyReference {
+	return controlplane.NetworkPolicyReference{
+		Type: controlplane.AntreaClusterNetworkPolicy,
+		Name: cnp.Name,
+		UID:  cnp.UID,
+	}
+}
@@ -965,6 +965,59 @@ func TestProcessClusterNetworkPolicy(t *testing.T) {
 			expectedAppliedToGroups: 1,
 			expectedAddressGroups:   0,
 		},
+		{
+			name: "ingress-rule-with-to-service",
+			inputPolicy: &crdv1alpha1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "cnpK", UID: "uidK"},
+				Spec: crdv1alpha1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1alpha1.NetworkPolicyPeer{
+						{PodSelector: &selectorA},
+					},
+					Priority: p10,
+					Ingress: []crdv1alpha1.Rule{
+						{
+							ToServices: []crdv1alpha1.NamespacedName{
+								{
+									Namespace: "nsA",
+									Name:      "svcA",
+								},
+							},
+							Action: &allowAction,
+						},
+					},
+				},
+			},
+			expectedPolicy: &antreatypes.NetworkPolicy{
+				UID:  "uidK",
+				Name: "uidK",
+				SourceRef: &controlplane.NetworkPolicyReference{
+					Type: controlplane.AntreaClusterNetworkPolicy,
+					Name: "cnpK",
+					UID:  "uidK",
+				},
+				Priority:     &p10,
+				TierPriority: &DefaultTierPriority,
+				Rules: []controlplane.NetworkPolicyRule{
+					{
+						Direction: controlplane.DirectionIn,
+						To: controlplane.NetworkPolicyPeer{
+							ToServices: []controlplane.ServiceReference{
+								{
+									Namespace: "nsA",
+									Name:      "svcA",
+								},
+							},
+						},
+						Priority: 0,
+						Action:   &allowAction,
+					},
+				},
+				AppliedToGroups:         []string{getNormalizedUID(antreatypes.NewGroupSelector("", &selectorA, nil, nil, nil).NormalizedName)},
+				NonLocalIngressServices: []string{"nsA/svcA: internalTrafficPolicy is not Local"},
+			},
+			expectedAppliedToGroups: 1,
+			expectedAddressGroups:   0,
+		},
 		{
 			name: "applied-to-with-service-account-namespaced-name",
 			inputPolicy: &crdv1alpha1.ClusterNetworkPolicy{
@@ -1398,6 +1451,7 @@ func TestProcessClusterNetworkPolicy(t *testing.T) {
 			assert.ElementsMatch(t, tt.expectedPolicy.Rules, actualPolicy.Rules)
 			assert.ElementsMatch(t, tt.expectedPolicy.PerNamespaceSelectors, actualPolicy.PerNamespaceSelectors)
 			assert.ElementsMatch(t, tt.expectedPolicy.AppliedToGroups, actualPolicy.AppliedToGroups)
+			assert.Equal(t, tt.expectedPolicy.NonLocalIngressServices, actualPolicy.NonLocalIngressServices)
 			assert.Equal(t, tt.expectedAppliedToGroups, len(c.appliedToGroupStore.List()))
 			assert.Equal(t, tt.expectedAddressGroups, len(c.addressGroupStore.List()))
 		})
@@ -15,16 +15,20 @@
 package networkpolicy
 
 import (
+	"fmt"
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 
 	"antrea.io/antrea/pkg/apis/controlplane"
 	"antrea.io/antrea/pkg/apis/crd/v1alpha1"
 	"antrea.io/antrea/pkg/controller/networkpolicy/store"
 	antreatypes "antrea.io/antrea/pkg/controller/types"
+	"antrea.io/antrea/pkg/util/k8s"
 )
 
 var (
@@ -88,6 +92,22 @@ func toAntreaIPBlockForCRD(ipBlock *v1alpha1.IPBlock) (*controlplane.IPBlock, er
 	return antreaIPBlock, nil
 }
 
+// toAntreaRateLimitForCRD converts a v1alpha1.RateLimit to an Antrea RateLimit.
+// It returns nil if the rule action is not RateLimit.
+func toAntreaRateLimitForCRD(action *v1alpha1.RuleAction, rateLimit *v1alpha1.RateLimit) *controlplane.RateLimit {
+	if action == nil || *action != v1alpha1.RuleActionRateLimit || rateLimit == nil {
+		return nil
+	}
+	antreaRateLimit := &controlplane.RateLimit{}
+	if rateLimit.PacketsPerSecond != nil {
+		antreaRateLimit.PacketsPerSecond = *rateLimit.PacketsPerSecond
+	}
+	if rateLimit.BitsPerSecond != nil {
+		antreaRateLimit.BitsPerSecond = *rateLimit.BitsPerSecond
+	}
+	return antreaRateLimit
+}
+
 // toAntreaPeerForCRD creates a Antrea controlplane NetworkPolicyPeer for crdv1alpha1 NetworkPolicyPeer.
 // It is used when peer's Namespaces are not matched by NamespaceMatchTypes, for which the controlplane
 // NetworkPolicyPeers will need to be created on a per Namespace basis.
@@ -177,6 +197,43 @@ func (n *NetworkPolicyController) svcRefToPeerForCRD(svcRefs []v1alpha1.Namespac
 	return &controlplane.NetworkPolicyPeer{ToServices: controlplaneSvcRefs}
 }
 
+// nonLocalIngressServices returns the Services referred to in the ingress
+// toServices of the provided rules whose traffic is not always load-balanced on
+// the Node of the selected Endpoint, each with the reason. The Service of the
+// traffic is only known on the Node where AntreaProxy load-balances it, so the
+// traffic load-balanced on another Node and forwarded to the Endpoint cannot be
+// matched by such rules. A Service which doesn't exist yet is reported as well.
+func (n *NetworkPolicyController) nonLocalIngressServices(rules []controlplane.NetworkPolicyRule) []string {
+	var nonLocalServices []string
+	checked := sets.NewString()
+	for _, rule := range rules {
+		if rule.Direction != controlplane.DirectionIn {
+			continue
+		}
+		for _, svcRef := range rule.To.ToServices {
+			key := k8s.NamespacedName(svcRef.Namespace, svcRef.Name)
+			if checked.Has(key) {
+				continue
+			}
+			checked.Insert(key)
+			var reason string
+			svc, err := n.serviceLister.Services(svcRef.Namespace).Get(svcRef.Name)
+			if err != nil {
+				reason = "Service not found"
+			} else if svc.Spec.InternalTrafficPolicy == nil || *svc.Spec.InternalTrafficPolicy != corev1.ServiceInternalTrafficPolicyLocal {
+				reason = "internalTrafficPolicy is not Local"
+			} else if (svc.Spec.Type == corev1.ServiceTypeNodePort || svc.Spec.Type == corev1.ServiceTypeLoadBalancer) &&
+				svc.Spec.ExternalTrafficPolicy != corev1.ServiceExternalTrafficPolicyTypeLocal {
+				reason = "externalTrafficPolicy is not Local"
+			} else {
+				continue
+			}
+			nonLocalServices = append(nonLocalServices, fmt.Sprintf("%s: %s", key, reason))
+		}
+	}
+	return nonLocalServices
+}
+
 // createAppliedToGroupForClusterGroupCRD creates an AppliedToGroup object corresponding to a
 // internal Group. If the AppliedToGroup already exists, it returns the key
 // otherwise it copies the internal Group contents to an AppliedToGroup resource and returns
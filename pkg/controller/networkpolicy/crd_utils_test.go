@@ -251,6 +251,43 @@ func TestToAntreaIPBlockForCRD(t *testing.T) {
 	}
 }
 
+func TestToAntreaRateLimitForCRD(t *testing.T) {
+	allowAction := crdv1alpha1.RuleActionAllow
+	rateLimitAction := crdv1alpha1.RuleActionRateLimit
+	pps := int32(100)
+	bps := int64(2000000)
+	tests := []struct {
+		name      string
+		action    *crdv1alpha1.RuleAction
+		rateLimit *crdv1alpha1.RateLimit
+		expected  *controlplane.RateLimit
+	}{
+		{
+			name:      "packets-per-second",
+			action:    &rateLimitAction,
+			rateLimit: &crdv1alpha1.RateLimit{PacketsPerSecond: &pps},
+			expected:  &controlplane.RateLimit{PacketsPerSecond: 100},
+		},
+		{
+			name:      "bits-per-second",
+			action:    &rateLimitAction,
+			rateLimit: &crdv1alpha1.RateLimit{BitsPerSecond: &bps},
+			expected:  &controlplane.RateLimit{BitsPerSecond: 2000000},
+		},
+		{
+			name:      "other-action",
+			action:    &allowAction,
+			rateLimit: &crdv1alpha1.RateLimit{PacketsPerSecond: &pps},
+			expected:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, toAntreaRateLimitForCRD(tt.action, tt.rateLimit))
+		})
+	}
+}
+
 func TestToAntreaPeerForCRD(t *testing.T) {
 	testCNPObj := &crdv1alpha1.ClusterNetworkPolicy{
 		ObjectMeta: metav1.ObjectMeta{
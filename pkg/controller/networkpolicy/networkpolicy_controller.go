@@ -43,6 +43,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
 
 	"antrea.io/antrea/pkg/apis/controlplane"
 	secv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
@@ -60,6 +61,8 @@ import (
 	"antrea.io/antrea/pkg/features"
 	"antrea.io/antrea/pkg/util/k8s"
 	utilsets "antrea.io/antrea/pkg/util/sets"
+	policyinformers "antrea.io/antrea/third_party/networkpolicyapi/pkg/client/informers/externalversions/apis/v1alpha1"
+	policylisters "antrea.io/antrea/third_party/networkpolicyapi/pkg/client/listers/apis/v1alpha1"
 )
 
 const (
@@ -159,6 +162,20 @@ type NetworkPolicyController struct {
 	// anpListerSynced is a function which returns true if the AntreaNetworkPolicies shared informer has been synced at least once.
 	anpListerSynced cache.InformerSynced
 
+	adminNPInformer policyinformers.AdminNetworkPolicyInformer
+	// adminNPLister is able to list/get AdminNetworkPolicies and is populated by the shared informer passed to
+	// NewNetworkPolicyController.
+	adminNPLister policylisters.AdminNetworkPolicyLister
+	// adminNPListerSynced is a function which returns true if the AdminNetworkPolicies shared informer has been synced at least once.
+	adminNPListerSynced cache.InformerSynced
+
+	banpInformer policyinformers.BaselineAdminNetworkPolicyInformer
+	// banpLister is able to list/get BaselineAdminNetworkPolicies and is populated by the shared informer passed to
+	// NewNetworkPolicyController.
+	banpLister policylisters.BaselineAdminNetworkPolicyLister
+	// banpListerSynced is a function which returns true if the BaselineAdminNetworkPolicies shared informer has been synced at least once.
+	banpListerSynced cache.InformerSynced
+
 	tierInformer secinformers.TierInformer
 	// tierLister is able to list/get Tiers and is populated by the shared informer passed to
 	// NewNetworkPolicyController.
@@ -203,6 +220,13 @@ type NetworkPolicyController struct {
 	// internalGroupQueue maintains the networkpolicy.Group objects that needs to be
 	// synced.
 	internalGroupQueue workqueue.RateLimitingInterface
+	// ruleScheduleQueue maintains the references of the Antrea-native policies
+	// that need to be reprocessed when the schedule of one of their rules
+	// starts or ends.
+	ruleScheduleQueue workqueue.DelayingInterface
+	// clock is used to evaluate rule schedules. Added as a member to the struct
+	// to allow injection for testing.
+	clock clock.Clock
 
 	// internalNetworkPolicyMutex protects the internalNetworkPolicyStore from
 	// concurrent access during updates to the internal NetworkPolicy object.
@@ -302,6 +326,8 @@ func NewNetworkPolicyController(kubeClient clientset.Interface,
 	anpInformer secinformers.NetworkPolicyInformer,
 	tierInformer secinformers.TierInformer,
 	cgInformer crdv1a3informers.ClusterGroupInformer,
+	adminNPInformer policyinformers.AdminNetworkPolicyInformer,
+	banpInformer policyinformers.BaselineAdminNetworkPolicyInformer,
 	addressGroupStore storage.Interface,
 	appliedToGroupStore storage.Interface,
 	internalNetworkPolicyStore storage.Interface,
@@ -320,6 +346,8 @@ func NewNetworkPolicyController(kubeClient clientset.Interface,
 		addressGroupQueue:          workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(minRetryDelay, maxRetryDelay), "addressGroup"),
 		internalNetworkPolicyQueue: workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(minRetryDelay, maxRetryDelay), "internalNetworkPolicy"),
 		internalGroupQueue:         workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(minRetryDelay, maxRetryDelay), "internalGroup"),
+		ruleScheduleQueue:          workqueue.NewNamedDelayingQueue("ruleSchedule"),
+		clock:                      clock.RealClock{},
 		groupingInterface:          groupingInterface,
 		groupingInterfaceSynced:    groupingInterface.HasSynced,
 	}
@@ -412,6 +440,32 @@ func NewNetworkPolicyController(kubeClient clientset.Interface,
 			},
 			resyncPeriod,
 		)
+		// Register Informers and add handlers for AdminNetworkPolicy and BaselineAdminNetworkPolicy events only
+		// if the feature is enabled.
+		if features.DefaultFeatureGate.Enabled(features.AdminNetworkPolicy) {
+			n.adminNPInformer = adminNPInformer
+			n.adminNPLister = adminNPInformer.Lister()
+			n.adminNPListerSynced = adminNPInformer.Informer().HasSynced
+			n.banpInformer = banpInformer
+			n.banpLister = banpInformer.Lister()
+			n.banpListerSynced = banpInformer.Informer().HasSynced
+			adminNPInformer.Informer().AddEventHandlerWithResyncPeriod(
+				cache.ResourceEventHandlerFuncs{
+					AddFunc:    n.addAdminNP,
+					UpdateFunc: n.updateAdminNP,
+					DeleteFunc: n.deleteAdminNP,
+				},
+				resyncPeriod,
+			)
+			banpInformer.Informer().AddEventHandlerWithResyncPeriod(
+				cache.ResourceEventHandlerFuncs{
+					AddFunc:    n.addBANP,
+					UpdateFunc: n.updateBANP,
+					DeleteFunc: n.deleteBANP,
+				},
+				resyncPeriod,
+			)
+		}
 	}
 	return n
 }
@@ -781,6 +835,7 @@ func (n *NetworkPolicyController) addService(obj interface{}) {
 	for group := range groupKeySet {
 		n.enqueueInternalGroup(group)
 	}
+	n.reprocessPoliciesForIngressService(service)
 }
 
 // updatePod retrieves all internal Groups which refers to this Service
@@ -790,6 +845,13 @@ func (n *NetworkPolicyController) updateService(oldObj, curObj interface{}) {
 	oldService := oldObj.(*v1.Service)
 	curService := curObj.(*v1.Service)
 	klog.V(2).Infof("Processing Service %s/%s UPDATE event, selectors: %v", curService.Namespace, curService.Name, curService.Spec.Selector)
+	// The policies referring to the Service in ingress toServices report whether its traffic is always load-balanced
+	// on the Node of the selected Endpoint, which depends on these fields.
+	if oldService.Spec.Type != curService.Spec.Type ||
+		!reflect.DeepEqual(oldService.Spec.InternalTrafficPolicy, curService.Spec.InternalTrafficPolicy) ||
+		oldService.Spec.ExternalTrafficPolicy != curService.Spec.ExternalTrafficPolicy {
+		n.reprocessPoliciesForIngressService(curService)
+	}
 	// No need to trigger processing of groups if there is no change in the Service selectors.
 	if reflect.DeepEqual(oldService.Spec.Selector, curService.Spec.Selector) {
 		klog.V(4).Infof("No change in Service %s/%s. Skipping group evaluation.", curService.Namespace, curService.Name)
@@ -828,6 +890,26 @@ func (n *NetworkPolicyController) deleteService(old interface{}) {
 	for group := range groupKeySet {
 		n.enqueueInternalGroup(group)
 	}
+	n.reprocessPoliciesForIngressService(service)
+}
+
+// reprocessPoliciesForIngressService reprocesses the Antrea-native policies referring to the Service in ingress
+// toServices, so that the Services whose traffic is not always load-balanced locally are reported in their status.
+func (n *NetworkPolicyController) reprocessPoliciesForIngressService(service *v1.Service) {
+	policies, _ := n.internalNetworkPolicyStore.GetByIndex(store.IngressServiceIndex, k8s.NamespacedName(service.Namespace, service.Name))
+	for _, obj := range policies {
+		internalNP := obj.(*antreatypes.NetworkPolicy)
+		switch internalNP.SourceRef.Type {
+		case controlplane.AntreaClusterNetworkPolicy:
+			if cnp, err := n.cnpLister.Get(internalNP.SourceRef.Name); err == nil {
+				n.reprocessCNP(cnp, false)
+			}
+		case controlplane.AntreaNetworkPolicy:
+			if anp, err := n.anpLister.NetworkPolicies(internalNP.SourceRef.Namespace).Get(internalNP.SourceRef.Name); err == nil {
+				n.reprocessANP(anp)
+			}
+		}
+	}
 }
 
 func (n *NetworkPolicyController) enqueueAppliedToGroup(key string) {
@@ -905,6 +987,7 @@ func (n *NetworkPolicyController) Run(stopCh <-chan struct{}) {
 	defer n.addressGroupQueue.ShutDown()
 	defer n.internalNetworkPolicyQueue.ShutDown()
 	defer n.internalGroupQueue.ShutDown()
+	defer n.ruleScheduleQueue.ShutDown()
 
 	klog.Infof("Starting %s", controllerName)
 	defer klog.Infof("Shutting down %s", controllerName)
@@ -913,6 +996,9 @@ func (n *NetworkPolicyController) Run(stopCh <-chan struct{}) {
 	// Only wait for cnpListerSynced and anpListerSynced when AntreaPolicy feature gate is enabled.
 	if features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
 		cacheSyncs = append(cacheSyncs, n.cnpListerSynced, n.anpListerSynced, n.cgListerSynced)
+		if features.DefaultFeatureGate.Enabled(features.AdminNetworkPolicy) {
+			cacheSyncs = append(cacheSyncs, n.adminNPListerSynced, n.banpListerSynced)
+		}
 	}
 	if !cache.WaitForNamedCacheSync(controllerName, stopCh, cacheSyncs...) {
 		return
@@ -924,6 +1010,9 @@ func (n *NetworkPolicyController) Run(stopCh <-chan struct{}) {
 		go wait.Until(n.internalNetworkPolicyWorker, time.Second, stopCh)
 		go wait.Until(n.internalGroupWorker, time.Second, stopCh)
 	}
+	for i := 0; i < ruleScheduleWorkers; i++ {
+		go wait.Until(n.ruleScheduleWorker, time.Second, stopCh)
+	}
 	<-stopCh
 }
 
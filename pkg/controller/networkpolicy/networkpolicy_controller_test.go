@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -37,6 +38,7 @@ import (
 	k8stesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
 
 	"antrea.io/antrea/pkg/apis/controlplane"
 	"antrea.io/antrea/pkg/apis/crd/v1alpha1"
@@ -48,6 +50,8 @@ import (
 	"antrea.io/antrea/pkg/controller/grouping"
 	"antrea.io/antrea/pkg/controller/networkpolicy/store"
 	antreatypes "antrea.io/antrea/pkg/controller/types"
+	fakepolicyversioned "antrea.io/antrea/third_party/networkpolicyapi/pkg/client/clientset/versioned/fake"
+	policyinformers "antrea.io/antrea/third_party/networkpolicyapi/pkg/client/informers/externalversions"
 )
 
 var alwaysReady = func() bool { return true }
@@ -104,6 +108,7 @@ func newController(objects ...runtime.Object) (*fake.Clientset, *networkPolicyCo
 		informerFactory.Core().V1().Pods(),
 		informerFactory.Core().V1().Namespaces(),
 		crdInformerFactory.Crd().V1alpha2().ExternalEntities())
+	policyInformerFactory := policyinformers.NewSharedInformerFactory(fakepolicyversioned.NewSimpleClientset(), informerDefaultResync)
 	npController := NewNetworkPolicyController(client,
 		crdClient,
 		groupEntityIndex,
@@ -115,6 +120,8 @@ func newController(objects ...runtime.Object) (*fake.Clientset, *networkPolicyCo
 		crdInformerFactory.Crd().V1alpha1().NetworkPolicies(),
 		crdInformerFactory.Crd().V1alpha1().Tiers(),
 		cgInformer,
+		policyInformerFactory.Policy().V1alpha1().AdminNetworkPolicies(),
+		policyInformerFactory.Policy().V1alpha1().BaselineAdminNetworkPolicies(),
 		addressGroupStore,
 		appliedToGroupStore,
 		internalNetworkPolicyStore,
@@ -182,6 +189,7 @@ func newControllerWithoutEventHandler(k8sObjects, crdObjects []runtime.Object) (
 		cgInformer:                 cgInformer,
 		cgLister:                   cgInformer.Lister(),
 		cgListerSynced:             cgInformer.Informer().HasSynced,
+		serviceLister:              informerFactory.Core().V1().Services().Lister(),
 		addressGroupStore:          addressGroupStore,
 		appliedToGroupStore:        appliedToGroupStore,
 		internalNetworkPolicyStore: internalNetworkPolicyStore,
@@ -190,6 +198,8 @@ func newControllerWithoutEventHandler(k8sObjects, crdObjects []runtime.Object) (
 		addressGroupQueue:          workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(minRetryDelay, maxRetryDelay), "addressGroup"),
 		internalNetworkPolicyQueue: workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(minRetryDelay, maxRetryDelay), "internalNetworkPolicy"),
 		internalGroupQueue:         workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(minRetryDelay, maxRetryDelay), "internalGroup"),
+		ruleScheduleQueue:          workqueue.NewNamedDelayingQueue("ruleSchedule"),
+		clock:                      clock.RealClock{},
 		groupingInterface:          groupEntityIndex,
 	}
 	npController.tierInformer.Informer().AddIndexers(tierIndexers)
@@ -2069,6 +2079,61 @@ func TestDeleteService(t *testing.T) {
 	assert.False(t, groupMembersUpdated.Has(memberPod))
 }
 
+func TestIngressToServicesReprocessedOnServiceEvents(t *testing.T) {
+	allowAction := v1alpha1.RuleActionAllow
+	cnp := &v1alpha1.ClusterNetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "cnpA", UID: "uidA"},
+		Spec: v1alpha1.ClusterNetworkPolicySpec{
+			AppliedTo: []v1alpha1.NetworkPolicyPeer{
+				{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}},
+			},
+			Priority: 10,
+			Ingress: []v1alpha1.Rule{
+				{
+					ToServices: []v1alpha1.NamespacedName{{Namespace: "test-ns", Name: "test-svc"}},
+					Action:     &allowAction,
+				},
+			},
+		},
+	}
+	localPolicy := corev1.ServiceInternalTrafficPolicyLocal
+	testSvc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-svc",
+			Namespace: "test-ns",
+		},
+		Spec: corev1.ServiceSpec{
+			Type:                  corev1.ServiceTypeClusterIP,
+			InternalTrafficPolicy: &localPolicy,
+		},
+	}
+	_, npc := newController()
+	getNonLocalIngressServices := func() []string {
+		obj, exists, _ := npc.internalNetworkPolicyStore.Get(internalNetworkPolicyKeyFunc(cnp))
+		require.True(t, exists)
+		return obj.(*antreatypes.NetworkPolicy).NonLocalIngressServices
+	}
+
+	npc.cnpStore.Add(cnp)
+	npc.addCNP(cnp)
+	assert.Equal(t, []string{"test-ns/test-svc: Service not found"}, getNonLocalIngressServices())
+
+	npc.serviceStore.Add(testSvc)
+	npc.addService(testSvc)
+	assert.Empty(t, getNonLocalIngressServices())
+
+	updatedSvc := testSvc.DeepCopy()
+	updatedSvc.Spec.Type = corev1.ServiceTypeNodePort
+	updatedSvc.Spec.ExternalTrafficPolicy = corev1.ServiceExternalTrafficPolicyTypeCluster
+	npc.serviceStore.Update(updatedSvc)
+	npc.updateService(testSvc, updatedSvc)
+	assert.Equal(t, []string{"test-ns/test-svc: externalTrafficPolicy is not Local"}, getNonLocalIngressServices())
+
+	npc.serviceStore.Delete(updatedSvc)
+	npc.deleteService(updatedSvc)
+	assert.Equal(t, []string{"test-ns/test-svc: Service not found"}, getNonLocalIngressServices())
+}
+
 func TestToGroupSelector(t *testing.T) {
 	pSelector := metav1.LabelSelector{}
 	pLabelSelector, _ := metav1.LabelSelectorAsSelector(&pSelector)
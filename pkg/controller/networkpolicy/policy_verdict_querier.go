@@ -0,0 +1,655 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"fmt"
+	"net"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+// PolicyVerdictQuerier handles requests for antctl query policy-verdict.
+type PolicyVerdictQuerier interface {
+	// QueryPolicyVerdict evaluates the NetworkPolicies known to the Controller
+	// against a hypothetical connection and returns the effective verdict,
+	// along with the rules which decide it. No traffic is generated.
+	QueryPolicyVerdict(query *PolicyVerdictQuery) (*PolicyVerdictResponse, error)
+}
+
+// PolicyVerdictQuery describes the connection to evaluate. Exactly one of
+// SourcePod, SourceNamespace (a hypothetical Pod with SourceLabels created in
+// that Namespace) and SourceIP must be set. Exactly one of DestinationPod,
+// DestinationService and DestinationIP must be set. DestinationNamespace is
+// used with DestinationPod and DestinationService.
+type PolicyVerdictQuery struct {
+	SourceNamespace      string
+	SourcePod            string
+	SourceLabels         map[string]string
+	SourceIP             string
+	DestinationNamespace string
+	DestinationPod       string
+	DestinationService   string
+	DestinationIP        string
+	// Protocol defaults to TCP.
+	Protocol controlplane.Protocol
+	// Port is the destination port, or the Service port when DestinationService
+	// is set. It is ignored for ICMP.
+	Port int32
+}
+
+// Verdict is the effective action applied to a connection.
+type Verdict string
+
+const (
+	VerdictAllow  Verdict = "Allow"
+	VerdictDrop   Verdict = "Drop"
+	VerdictReject Verdict = "Reject"
+)
+
+// PolicyVerdictResponse is the reply struct for antctl policy-verdict queries.
+// When the destination is a Service, there is one verdict per Service backend.
+type PolicyVerdictResponse struct {
+	Verdicts []ConnectionVerdict `json:"verdicts,omitempty"`
+}
+
+type ConnectionVerdict struct {
+	Source      string            `json:"source,omitempty"`
+	Destination string            `json:"destination,omitempty"`
+	Protocol    string            `json:"protocol,omitempty"`
+	Port        int32             `json:"port,omitempty"`
+	Verdict     Verdict           `json:"verdict,omitempty"`
+	Egress      *DirectionVerdict `json:"egress,omitempty"`
+	Ingress     *DirectionVerdict `json:"ingress,omitempty"`
+}
+
+// DirectionVerdict is the verdict of the policies applied to one end of the
+// connection: egress policies of the source or ingress policies of the
+// destination. Rule is nil when no rule decides the verdict, in which case
+// Reason explains it.
+type DirectionVerdict struct {
+	Verdict Verdict        `json:"verdict,omitempty"`
+	Rule    *RuleReference `json:"rule,omitempty"`
+	Reason  string         `json:"reason,omitempty"`
+}
+
+type RuleReference struct {
+	PolicyRef
+	PolicyType   controlplane.NetworkPolicyType `json:"policyType,omitempty"`
+	TierPriority *int32                         `json:"tierPriority,omitempty"`
+	Name         string                         `json:"name,omitempty"`
+	// RuleIndex is the index of the rule among the rules of the same
+	// direction in the policy.
+	RuleIndex int                    `json:"ruleIndex"`
+	Action    crdv1alpha1.RuleAction `json:"action,omitempty"`
+}
+
+// policyVerdictQuerier implements the PolicyVerdictQuerier interface.
+type policyVerdictQuerier struct {
+	networkPolicyController *NetworkPolicyController
+	podLister               corelisters.PodLister
+}
+
+// NewPolicyVerdictQuerier returns a new *policyVerdictQuerier.
+func NewPolicyVerdictQuerier(networkPolicyController *NetworkPolicyController, podLister corelisters.PodLister) *policyVerdictQuerier {
+	return &policyVerdictQuerier{
+		networkPolicyController: networkPolicyController,
+		podLister:               podLister,
+	}
+}
+
+// verdictEndpoint is one end of the evaluated connection. It is either a Pod,
+// which may be hypothetical (pod is nil), or an IP address outside the set of
+// Pods.
+type verdictEndpoint struct {
+	isPod     bool
+	pod       *corev1.Pod
+	namespace string
+	labels    labels.Set
+	ip        net.IP
+}
+
+func (e *verdictEndpoint) String() string {
+	if e.pod != nil {
+		return e.namespace + "/" + e.pod.Name
+	}
+	if e.isPod {
+		if len(e.labels) == 0 {
+			return e.namespace + "/<new Pod>"
+		}
+		return fmt.Sprintf("%s/<new Pod with labels %s>", e.namespace, e.labels.String())
+	}
+	return e.ip.String()
+}
+
+// candidateRule is a rule which applies to the evaluated endpoint.
+type candidateRule struct {
+	policy *antreatypes.NetworkPolicy
+	rule   *controlplane.NetworkPolicyRule
+	index  int
+}
+
+func (r *candidateRule) reference() *RuleReference {
+	action := crdv1alpha1.RuleActionAllow
+	if r.rule.Action != nil {
+		action = *r.rule.Action
+	}
+	return &RuleReference{
+		PolicyRef: PolicyRef{
+			Namespace: r.policy.SourceRef.Namespace,
+			Name:      r.policy.SourceRef.Name,
+			UID:       r.policy.SourceRef.UID,
+		},
+		PolicyType:   r.policy.SourceRef.Type,
+		TierPriority: r.policy.TierPriority,
+		Name:         r.rule.Name,
+		RuleIndex:    r.index,
+		Action:       action,
+	}
+}
+
+// QueryPolicyVerdict evaluates the connection described by query with the same
+// semantics as the datapath:
+//   - Antrea-native policies outside of the baseline Tier are evaluated first,
+//     ordered by Tier priority, policy priority and rule priority. The first
+//     matching rule decides, unless its action is Pass, in which case
+//     evaluation continues with K8s NetworkPolicies.
+//   - If the endpoint is isolated by K8s NetworkPolicies in this direction,
+//     the connection is allowed if any of their rules matches and dropped
+//     otherwise.
+//   - Otherwise the first matching rule of the baseline Tier decides and the
+//     connection is allowed if there is none.
+//
+// Egress policies are evaluated for the source and ingress policies for the
+// destination, and the connection is only allowed if both allow it. FQDN
+// peers are not evaluated as they are resolved by the Agents.
+func (q *policyVerdictQuerier) QueryPolicyVerdict(query *PolicyVerdictQuery) (*PolicyVerdictResponse, error) {
+	protocol := query.Protocol
+	if protocol == "" {
+		protocol = controlplane.ProtocolTCP
+	}
+	source, err := q.getSource(query)
+	if err != nil {
+		return nil, err
+	}
+	var svcRef *controlplane.ServiceReference
+	var destinations []*verdictEndpoint
+	var ports []int32
+	switch {
+	case query.DestinationPod != "":
+		pod, err := q.podLister.Pods(query.DestinationNamespace).Get(query.DestinationPod)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get destination Pod %s/%s: %w", query.DestinationNamespace, query.DestinationPod, err)
+		}
+		destination, err := q.podEndpoint(pod)
+		if err != nil {
+			return nil, err
+		}
+		destinations = append(destinations, destination)
+		ports = append(ports, query.Port)
+	case query.DestinationService != "":
+		svcRef = &controlplane.ServiceReference{Namespace: query.DestinationNamespace, Name: query.DestinationService}
+		destinations, ports, err = q.getServiceBackends(svcRef, protocol, query.Port)
+		if err != nil {
+			return nil, err
+		}
+	case query.DestinationIP != "":
+		ip := net.ParseIP(query.DestinationIP)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid destination IP %s", query.DestinationIP)
+		}
+		destinations = append(destinations, &verdictEndpoint{ip: ip})
+		ports = append(ports, query.Port)
+	default:
+		return nil, fmt.Errorf("destination must be provided")
+	}
+
+	policies := q.networkPolicyController.internalNetworkPolicyStore.List()
+	response := &PolicyVerdictResponse{Verdicts: make([]ConnectionVerdict, 0, len(destinations))}
+	for i, destination := range destinations {
+		port := ports[i]
+		if protocol == controlplane.ProtocolICMP {
+			port = 0
+		}
+		verdict := ConnectionVerdict{
+			Source:      source.String(),
+			Destination: destination.String(),
+			Protocol:    string(protocol),
+			Port:        port,
+		}
+		if source.isPod {
+			verdict.Egress = q.evaluate(policies, controlplane.DirectionOut, source, destination, svcRef, destination, protocol, port)
+		} else {
+			verdict.Egress = &DirectionVerdict{Verdict: VerdictAllow, Reason: "Source is not a Pod"}
+		}
+		if destination.isPod {
+			verdict.Ingress = q.evaluate(policies, controlplane.DirectionIn, destination, source, svcRef, destination, protocol, port)
+		} else {
+			verdict.Ingress = &DirectionVerdict{Verdict: VerdictAllow, Reason: "Destination is not a Pod"}
+		}
+		verdict.Verdict = verdict.Ingress.Verdict
+		if verdict.Egress.Verdict != VerdictAllow {
+			verdict.Verdict = verdict.Egress.Verdict
+		}
+		response.Verdicts = append(response.Verdicts, verdict)
+	}
+	return response, nil
+}
+
+func (q *policyVerdictQuerier) getSource(query *PolicyVerdictQuery) (*verdictEndpoint, error) {
+	switch {
+	case query.SourcePod != "":
+		pod, err := q.podLister.Pods(query.SourceNamespace).Get(query.SourcePod)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get source Pod %s/%s: %w", query.SourceNamespace, query.SourcePod, err)
+		}
+		return q.podEndpoint(pod)
+	case query.SourceIP != "":
+		ip := net.ParseIP(query.SourceIP)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid source IP %s", query.SourceIP)
+		}
+		return &verdictEndpoint{ip: ip}, nil
+	case query.SourceNamespace != "":
+		if _, err := q.networkPolicyController.namespaceLister.Get(query.SourceNamespace); err != nil {
+			return nil, fmt.Errorf("failed to get source Namespace %s: %w", query.SourceNamespace, err)
+		}
+		return &verdictEndpoint{isPod: true, namespace: query.SourceNamespace, labels: query.SourceLabels}, nil
+	}
+	return nil, fmt.Errorf("source must be provided")
+}
+
+func (q *policyVerdictQuerier) podEndpoint(pod *corev1.Pod) (*verdictEndpoint, error) {
+	if pod.Spec.HostNetwork {
+		return nil, fmt.Errorf("Pod %s/%s uses the host network and is not subject to NetworkPolicies", pod.Namespace, pod.Name)
+	}
+	return &verdictEndpoint{
+		isPod:     true,
+		pod:       pod,
+		namespace: pod.Namespace,
+		labels:    pod.Labels,
+		ip:        net.ParseIP(pod.Status.PodIP),
+	}, nil
+}
+
+// getServiceBackends returns the Pods selected by the Service and the target
+// port of each of them for the provided Service port.
+func (q *policyVerdictQuerier) getServiceBackends(svcRef *controlplane.ServiceReference, protocol controlplane.Protocol, port int32) ([]*verdictEndpoint, []int32, error) {
+	svc, err := q.networkPolicyController.serviceLister.Services(svcRef.Namespace).Get(svcRef.Name)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get destination Service %s/%s: %w", svcRef.Namespace, svcRef.Name, err)
+	}
+	if len(svc.Spec.Selector) == 0 {
+		return nil, nil, fmt.Errorf("Service %s/%s has no selector", svcRef.Namespace, svcRef.Name)
+	}
+	var svcPort *corev1.ServicePort
+	for i := range svc.Spec.Ports {
+		p := &svc.Spec.Ports[i]
+		if p.Port == port && protocolMatches(p.Protocol, protocol) {
+			svcPort = p
+			break
+		}
+	}
+	if svcPort == nil {
+		return nil, nil, fmt.Errorf("Service %s/%s has no port %d/%s", svcRef.Namespace, svcRef.Name, port, protocol)
+	}
+	pods, err := q.podLister.Pods(svcRef.Namespace).List(labels.SelectorFromSet(svc.Spec.Selector))
+	if err != nil {
+		return nil, nil, err
+	}
+	sort.Slice(pods, func(i, j int) bool { return pods[i].Name < pods[j].Name })
+	var backends []*verdictEndpoint
+	var targetPorts []int32
+	for _, pod := range pods {
+		if pod.Spec.HostNetwork || pod.Status.PodIP == "" {
+			continue
+		}
+		targetPort := svcPort.TargetPort.IntVal
+		if svcPort.TargetPort.Type == intstr.String {
+			targetPort = resolveContainerPort(pod, svcPort.TargetPort.StrVal, protocol)
+			if targetPort == 0 {
+				continue
+			}
+		} else if targetPort == 0 {
+			targetPort = svcPort.Port
+		}
+		backend, _ := q.podEndpoint(pod)
+		backends = append(backends, backend)
+		targetPorts = append(targetPorts, targetPort)
+	}
+	if len(backends) == 0 {
+		return nil, nil, fmt.Errorf("Service %s/%s has no backend Pod for port %d/%s", svcRef.Namespace, svcRef.Name, port, protocol)
+	}
+	return backends, targetPorts, nil
+}
+
+// evaluate returns the verdict of the policies applied to endpoint in the
+// provided direction for the connection to or from peer. dstPod is the
+// destination endpoint, used to resolve named ports.
+func (q *policyVerdictQuerier) evaluate(policies []interface{}, direction controlplane.Direction, endpoint, peer *verdictEndpoint,
+	svcRef *controlplane.ServiceReference, dstPod *verdictEndpoint, protocol controlplane.Protocol, port int32) *DirectionVerdict {
+	var antreaRules, k8sRules, baselineRules []*candidateRule
+	for _, obj := range policies {
+		policy := obj.(*antreatypes.NetworkPolicy)
+		inactiveRules := make(map[string]bool, len(policy.InactiveRules))
+		for _, name := range policy.InactiveRules {
+			inactiveRules[name] = true
+		}
+		index := 0
+		for i := range policy.Rules {
+			rule := &policy.Rules[i]
+			if rule.Direction != direction {
+				continue
+			}
+			index++
+			if rule.Name != "" && inactiveRules[rule.Name] {
+				continue
+			}
+			appliedToGroups := rule.AppliedToGroups
+			if len(appliedToGroups) == 0 {
+				appliedToGroups = policy.AppliedToGroups
+			}
+			if !q.appliedToGroupsSelect(appliedToGroups, endpoint) {
+				continue
+			}
+			candidate := &candidateRule{policy: policy, rule: rule, index: index - 1}
+			switch {
+			case policy.SourceRef.Type == controlplane.K8sNetworkPolicy:
+				k8sRules = append(k8sRules, candidate)
+			case policy.TierPriority != nil && *policy.TierPriority == BaselineTierPriority:
+				baselineRules = append(baselineRules, candidate)
+			default:
+				antreaRules = append(antreaRules, candidate)
+			}
+		}
+	}
+	sortAntreaRules(antreaRules)
+	sortAntreaRules(baselineRules)
+	sort.SliceStable(k8sRules, func(i, j int) bool {
+		return k8sRules[i].policy.SourceRef.ToString() < k8sRules[j].policy.SourceRef.ToString()
+	})
+
+	matches := func(r *candidateRule) bool {
+		return q.ruleMatches(r.rule, peer, svcRef, dstPod, protocol, port)
+	}
+	passed := false
+	for _, r := range antreaRules {
+		if !matches(r) {
+			continue
+		}
+		if r.rule.Action != nil && *r.rule.Action == crdv1alpha1.RuleActionPass {
+			passed = true
+			break
+		}
+		return &DirectionVerdict{Verdict: actionToVerdict(r.rule.Action), Rule: r.reference()}
+	}
+	if len(k8sRules) > 0 {
+		for _, r := range k8sRules {
+			if matches(r) {
+				return &DirectionVerdict{Verdict: VerdictAllow, Rule: r.reference()}
+			}
+		}
+		return &DirectionVerdict{
+			Verdict: VerdictDrop,
+			Reason:  fmt.Sprintf("Isolated by K8s NetworkPolicy %s/%s", k8sRules[0].policy.SourceRef.Namespace, k8sRules[0].policy.SourceRef.Name),
+		}
+	}
+	for _, r := range baselineRules {
+		if matches(r) {
+			return &DirectionVerdict{Verdict: actionToVerdict(r.rule.Action), Rule: r.reference()}
+		}
+	}
+	if passed {
+		return &DirectionVerdict{Verdict: VerdictAllow, Reason: "Passed by Antrea-native policies and no other rule matches"}
+	}
+	return &DirectionVerdict{Verdict: VerdictAllow, Reason: "No rule matches"}
+}
+
+// sortAntreaRules sorts rules in their order of evaluation in the datapath.
+func sortAntreaRules(rules []*candidateRule) {
+	sort.SliceStable(rules, func(i, j int) bool {
+		pi, pj := rules[i].policy, rules[j].policy
+		if *pi.TierPriority != *pj.TierPriority {
+			return *pi.TierPriority < *pj.TierPriority
+		}
+		if *pi.Priority != *pj.Priority {
+			return *pi.Priority < *pj.Priority
+		}
+		if rules[i].rule.Priority != rules[j].rule.Priority {
+			return rules[i].rule.Priority < rules[j].rule.Priority
+		}
+		return pi.Name < pj.Name
+	})
+}
+
+func actionToVerdict(action *crdv1alpha1.RuleAction) Verdict {
+	if action == nil {
+		return VerdictAllow
+	}
+	switch *action {
+	case crdv1alpha1.RuleActionDrop:
+		return VerdictDrop
+	case crdv1alpha1.RuleActionReject:
+		return VerdictReject
+	}
+	// Traffic under the rate limit of RateLimit rules is allowed.
+	return VerdictAllow
+}
+
+func (q *policyVerdictQuerier) ruleMatches(rule *controlplane.NetworkPolicyRule, peer *verdictEndpoint,
+	svcRef *controlplane.ServiceReference, dstPod *verdictEndpoint, protocol controlplane.Protocol, port int32) bool {
+	peers := rule.From
+	// The Services through which the traffic arrives are set in the To peer of
+	// ingress rules.
+	if rule.Direction == controlplane.DirectionOut || len(rule.To.ToServices) > 0 {
+		peers = rule.To
+	}
+	if !q.peerMatches(&peers, peer, svcRef) {
+		return false
+	}
+	// Services of rules with ToServices peers are ignored in the datapath.
+	if len(peers.ToServices) > 0 || len(rule.Services) == 0 {
+		return true
+	}
+	for i := range rule.Services {
+		if serviceMatches(&rule.Services[i], dstPod, protocol, port) {
+			return true
+		}
+	}
+	return false
+}
+
+func (q *policyVerdictQuerier) peerMatches(peer *controlplane.NetworkPolicyPeer, endpoint *verdictEndpoint, svcRef *controlplane.ServiceReference) bool {
+	if len(peer.ToServices) > 0 {
+		if svcRef == nil {
+			return false
+		}
+		for _, ref := range peer.ToServices {
+			if ref == *svcRef {
+				return true
+			}
+		}
+		return false
+	}
+	for _, name := range peer.AddressGroups {
+		obj, found, _ := q.networkPolicyController.addressGroupStore.Get(name)
+		if !found {
+			continue
+		}
+		addressGroup := obj.(*antreatypes.AddressGroup)
+		if endpoint.isPod {
+			if q.groupSelects(addressGroup.Name, &addressGroup.Selector, endpoint) {
+				return true
+			}
+		} else if memberSetHasIP(addressGroup.GroupMembers, endpoint.ip) {
+			return true
+		}
+	}
+	for i := range peer.IPBlocks {
+		if ipBlockMatches(&peer.IPBlocks[i], endpoint) {
+			return true
+		}
+	}
+	return false
+}
+
+func (q *policyVerdictQuerier) appliedToGroupsSelect(appliedToGroups []string, endpoint *verdictEndpoint) bool {
+	for _, name := range appliedToGroups {
+		obj, found, _ := q.networkPolicyController.appliedToGroupStore.Get(name)
+		if !found {
+			continue
+		}
+		appliedToGroup := obj.(*antreatypes.AppliedToGroup)
+		if q.groupSelects(appliedToGroup.Name, &appliedToGroup.Selector, endpoint) {
+			return true
+		}
+	}
+	return false
+}
+
+// groupSelects returns whether the AddressGroup or AppliedToGroup with the
+// provided name and selector selects the Pod endpoint. Groups created for
+// ClusterGroups have an empty selector and are named after the ClusterGroup,
+// in which case the selectors of the ClusterGroup and of its child groups are
+// used.
+func (q *policyVerdictQuerier) groupSelects(name string, selector *antreatypes.GroupSelector, endpoint *verdictEndpoint) bool {
+	if selector.NormalizedName != "" {
+		return q.selectorMatches(selector, endpoint)
+	}
+	obj, found, _ := q.networkPolicyController.internalGroupStore.Get(name)
+	if !found {
+		return false
+	}
+	group := obj.(*antreatypes.Group)
+	if group.Selector != nil && q.selectorMatches(group.Selector, endpoint) {
+		return true
+	}
+	for _, child := range group.ChildGroups {
+		if q.groupSelects(child, &antreatypes.GroupSelector{}, endpoint) {
+			return true
+		}
+	}
+	return false
+}
+
+// selectorMatches follows the semantics of the GroupEntityIndex for Pods.
+func (q *policyVerdictQuerier) selectorMatches(selector *antreatypes.GroupSelector, endpoint *verdictEndpoint) bool {
+	if selector.NodeSelector != nil || (selector.ExternalEntitySelector != nil && selector.PodSelector == nil) {
+		return false
+	}
+	if selector.Namespace != "" {
+		if selector.Namespace != endpoint.namespace {
+			return false
+		}
+	} else if selector.NamespaceSelector != nil {
+		if !selector.NamespaceSelector.Empty() {
+			namespace, err := q.networkPolicyController.namespaceLister.Get(endpoint.namespace)
+			if err != nil || !selector.NamespaceSelector.Matches(labels.Set(namespace.Labels)) {
+				return false
+			}
+		}
+	} else if selector.PodSelector == nil {
+		return false
+	}
+	return selector.PodSelector == nil || selector.PodSelector.Matches(endpoint.labels)
+}
+
+func memberSetHasIP(members controlplane.GroupMemberSet, ip net.IP) bool {
+	for _, member := range members {
+		for _, memberIP := range member.IPs {
+			if net.IP(memberIP).Equal(ip) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ipBlockMatches returns whether the IPBlock contains the endpoint's IP. The IP
+// of a hypothetical Pod is unknown, so only IPBlocks matching all addresses
+// are considered to contain it.
+func ipBlockMatches(ipBlock *controlplane.IPBlock, endpoint *verdictEndpoint) bool {
+	if endpoint.ip == nil {
+		return endpoint.isPod && ipBlock.CIDR.PrefixLength == 0 && len(ipBlock.Except) == 0
+	}
+	if !ipNetContains(&ipBlock.CIDR, endpoint.ip) {
+		return false
+	}
+	for i := range ipBlock.Except {
+		if ipNetContains(&ipBlock.Except[i], endpoint.ip) {
+			return false
+		}
+	}
+	return true
+}
+
+func ipNetContains(ipNet *controlplane.IPNet, ip net.IP) bool {
+	netIP, bits := net.IP(ipNet.IP), 8*net.IPv6len
+	if v4 := netIP.To4(); v4 != nil {
+		netIP, bits = v4, 8*net.IPv4len
+	}
+	if (netIP.To4() == nil) != (ip.To4() == nil) {
+		return false
+	}
+	return (&net.IPNet{IP: netIP, Mask: net.CIDRMask(int(ipNet.PrefixLength), bits)}).Contains(ip)
+}
+
+func serviceMatches(service *controlplane.Service, dstPod *verdictEndpoint, protocol controlplane.Protocol, port int32) bool {
+	serviceProtocol := controlplane.ProtocolTCP
+	if service.Protocol != nil {
+		serviceProtocol = *service.Protocol
+	}
+	if serviceProtocol != protocol {
+		return false
+	}
+	if protocol == controlplane.ProtocolICMP || service.Port == nil {
+		return true
+	}
+	if service.Port.Type == intstr.String {
+		return dstPod.pod != nil && resolveContainerPort(dstPod.pod, service.Port.StrVal, protocol) == port
+	}
+	if service.EndPort != nil {
+		return port >= service.Port.IntVal && port <= *service.EndPort
+	}
+	return port == service.Port.IntVal
+}
+
+// resolveContainerPort returns the number of the named container port of the
+// Pod, or 0 if there is none.
+func resolveContainerPort(pod *corev1.Pod, name string, protocol controlplane.Protocol) int32 {
+	for _, container := range pod.Spec.Containers {
+		for _, port := range container.Ports {
+			if port.Name == name && protocolMatches(port.Protocol, protocol) {
+				return port.ContainerPort
+			}
+		}
+	}
+	return 0
+}
+
+func protocolMatches(k8sProtocol corev1.Protocol, protocol controlplane.Protocol) bool {
+	if k8sProtocol == "" {
+		k8sProtocol = corev1.ProtocolTCP
+	}
+	return string(k8sProtocol) == string(protocol)
+}
@@ -0,0 +1,400 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	"antrea.io/antrea/pkg/controller/networkpolicy/store"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+func newVerdictTestPod(namespace, name, ip string, podLabels map[string]string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Labels: podLabels},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name:  "container-1",
+				Ports: []corev1.ContainerPort{{Name: "http", ContainerPort: 8080, Protocol: corev1.ProtocolTCP}},
+			}},
+		},
+		Status: corev1.PodStatus{PodIP: ip},
+	}
+}
+
+func newVerdictTestService(namespace, name string) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{"app": "web"},
+			Ports:    []corev1.ServicePort{{Port: 80, TargetPort: intstr.FromString("http"), Protocol: corev1.ProtocolTCP}},
+		},
+	}
+}
+
+func newPolicyVerdictQuerierForTest(t *testing.T) *policyVerdictQuerier {
+	namespaceIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	podIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	serviceIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, obj := range []interface{}{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns1", Labels: map[string]string{"env": "dev"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns2", Labels: map[string]string{"env": "prod"}}},
+	} {
+		require.NoError(t, namespaceIndexer.Add(obj))
+	}
+	for _, obj := range []interface{}{
+		newVerdictTestPod("ns1", "client", "10.0.1.1", map[string]string{"app": "client"}),
+		newVerdictTestPod("ns2", "web-0", "10.0.2.1", map[string]string{"app": "web"}),
+		newVerdictTestPod("ns2", "web-1", "10.0.2.2", map[string]string{"app": "web"}),
+	} {
+		require.NoError(t, podIndexer.Add(obj))
+	}
+	for _, obj := range []interface{}{newVerdictTestService("ns2", "web"), newVerdictTestService("ns2", "web-legacy")} {
+		require.NoError(t, serviceIndexer.Add(obj))
+	}
+
+	c := &NetworkPolicyController{
+		namespaceLister:            corelisters.NewNamespaceLister(namespaceIndexer),
+		serviceLister:              corelisters.NewServiceLister(serviceIndexer),
+		addressGroupStore:          store.NewAddressGroupStore(),
+		appliedToGroupStore:        store.NewAppliedToGroupStore(),
+		internalNetworkPolicyStore: store.NewNetworkPolicyStore(),
+		internalGroupStore:         store.NewGroupStore(),
+	}
+	webSelector := antreatypes.NewGroupSelector("ns2", &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}, nil, nil, nil)
+	devSelector := antreatypes.NewGroupSelector("", nil, &metav1.LabelSelector{MatchLabels: map[string]string{"env": "dev"}}, nil, nil)
+	clientSelector := antreatypes.NewGroupSelector("ns1", &metav1.LabelSelector{MatchLabels: map[string]string{"app": "client"}}, nil, nil, nil)
+	c.appliedToGroupStore.Create(&antreatypes.AppliedToGroup{Name: "atg-web", UID: "atg-web", Selector: *webSelector})
+	c.appliedToGroupStore.Create(&antreatypes.AppliedToGroup{Name: "atg-dev", UID: "atg-dev", Selector: *devSelector})
+	c.addressGroupStore.Create(&antreatypes.AddressGroup{Name: "ag-client", UID: "ag-client", Selector: *clientSelector})
+	// An AddressGroup created for ClusterGroup "cg-dev" which selects the dev Namespaces through a child group.
+	c.internalGroupStore.Create(&antreatypes.Group{Name: "cg-dev", UID: "cg-dev", ChildGroups: []string{"cg-dev-child"}})
+	c.internalGroupStore.Create(&antreatypes.Group{Name: "cg-dev-child", UID: "cg-dev-child", Selector: devSelector})
+	c.addressGroupStore.Create(&antreatypes.AddressGroup{Name: "cg-dev", UID: "cg-dev"})
+
+	tcp := controlplane.ProtocolTCP
+	udp := controlplane.ProtocolUDP
+	actionAllow := crdv1alpha1.RuleActionAllow
+	actionPass := crdv1alpha1.RuleActionPass
+	actionDrop := crdv1alpha1.RuleActionDrop
+	actionReject := crdv1alpha1.RuleActionReject
+	int32Ptr := func(i int32) *int32 { return &i }
+	float64Ptr := func(f float64) *float64 { return &f }
+	portPtr := func(p intstr.IntOrString) *intstr.IntOrString { return &p }
+	policies := []*antreatypes.NetworkPolicy{
+		{
+			Name:      "k8snp-allow-client",
+			UID:       "k8snp-allow-client",
+			SourceRef: &controlplane.NetworkPolicyReference{Type: controlplane.K8sNetworkPolicy, Namespace: "ns2", Name: "allow-client", UID: "uid1"},
+			Rules: []controlplane.NetworkPolicyRule{{
+				Direction: controlplane.DirectionIn,
+				From:      controlplane.NetworkPolicyPeer{AddressGroups: []string{"ag-client"}},
+				Services:  []controlplane.Service{{Protocol: &tcp, Port: portPtr(intstr.FromString("http"))}},
+			}},
+			AppliedToGroups: []string{"atg-web"},
+		},
+		{
+			Name:         "acnp-pass-dev",
+			UID:          "acnp-pass-dev",
+			SourceRef:    &controlplane.NetworkPolicyReference{Type: controlplane.AntreaClusterNetworkPolicy, Name: "pass-dev", UID: "uid2"},
+			TierPriority: int32Ptr(50),
+			Priority:     float64Ptr(1),
+			Rules: []controlplane.NetworkPolicyRule{{
+				Direction: controlplane.DirectionIn,
+				From:      controlplane.NetworkPolicyPeer{AddressGroups: []string{"cg-dev"}},
+				Services:  []controlplane.Service{{Protocol: &tcp, Port: portPtr(intstr.FromInt(9000)), EndPort: int32Ptr(9999)}},
+				Name:      "pass-9000-9999",
+				Action:    &actionPass,
+			}},
+			AppliedToGroups: []string{"atg-web"},
+		},
+		{
+			Name:         "acnp-drop-dev",
+			UID:          "acnp-drop-dev",
+			SourceRef:    &controlplane.NetworkPolicyReference{Type: controlplane.AntreaClusterNetworkPolicy, Name: "drop-dev", UID: "uid3"},
+			TierPriority: int32Ptr(DefaultTierPriority),
+			Priority:     float64Ptr(5),
+			Rules: []controlplane.NetworkPolicyRule{
+				{
+					Direction: controlplane.DirectionIn,
+					From:      controlplane.NetworkPolicyPeer{AddressGroups: []string{"cg-dev"}},
+					Services:  []controlplane.Service{{Protocol: &tcp, Port: portPtr(intstr.FromInt(7070))}},
+					Name:      "drop-7070",
+					Priority:  0,
+					Action:    &actionDrop,
+				},
+				{
+					Direction: controlplane.DirectionIn,
+					From:      controlplane.NetworkPolicyPeer{AddressGroups: []string{"cg-dev"}},
+					Name:      "drop-all",
+					Priority:  1,
+					Action:    &actionDrop,
+				},
+			},
+			AppliedToGroups: []string{"atg-web"},
+			InactiveRules:   []string{"drop-all"},
+		},
+		{
+			Name:         "acnp-reject-legacy",
+			UID:          "acnp-reject-legacy",
+			SourceRef:    &controlplane.NetworkPolicyReference{Type: controlplane.AntreaClusterNetworkPolicy, Name: "reject-legacy", UID: "uid4"},
+			TierPriority: int32Ptr(DefaultTierPriority),
+			Priority:     float64Ptr(1),
+			Rules: []controlplane.NetworkPolicyRule{{
+				Direction: controlplane.DirectionOut,
+				To:        controlplane.NetworkPolicyPeer{ToServices: []controlplane.ServiceReference{{Namespace: "ns2", Name: "web-legacy"}}},
+				Name:      "reject-legacy",
+				Action:    &actionReject,
+			}},
+			AppliedToGroups: []string{"atg-dev"},
+		},
+		{
+			Name:         "acnp-allow-legacy",
+			UID:          "acnp-allow-legacy",
+			SourceRef:    &controlplane.NetworkPolicyReference{Type: controlplane.AntreaClusterNetworkPolicy, Name: "allow-legacy", UID: "uid6"},
+			TierPriority: int32Ptr(DefaultTierPriority),
+			Priority:     float64Ptr(1),
+			Rules: []controlplane.NetworkPolicyRule{{
+				Direction: controlplane.DirectionIn,
+				To:        controlplane.NetworkPolicyPeer{ToServices: []controlplane.ServiceReference{{Namespace: "ns2", Name: "web-legacy"}}},
+				Name:      "allow-legacy",
+				Action:    &actionAllow,
+			}},
+			AppliedToGroups: []string{"atg-web"},
+		},
+		{
+			Name:         "acnp-baseline",
+			UID:          "acnp-baseline",
+			SourceRef:    &controlplane.NetworkPolicyReference{Type: controlplane.AntreaClusterNetworkPolicy, Name: "baseline", UID: "uid5"},
+			TierPriority: int32Ptr(BaselineTierPriority),
+			Priority:     float64Ptr(1),
+			Rules: []controlplane.NetworkPolicyRule{
+				{
+					Direction: controlplane.DirectionOut,
+					To: controlplane.NetworkPolicyPeer{IPBlocks: []controlplane.IPBlock{{
+						CIDR:   controlplane.IPNet{IP: ipStrToIPAddress("8.8.0.0"), PrefixLength: 16},
+						Except: []controlplane.IPNet{{IP: ipStrToIPAddress("8.8.4.0"), PrefixLength: 24}},
+					}}},
+					Services: []controlplane.Service{{Protocol: &udp, Port: portPtr(intstr.FromInt(53))}},
+					Name:     "drop-google-dns",
+					Action:   &actionDrop,
+				},
+				{
+					Direction: controlplane.DirectionIn,
+					From:      matchAllPeer,
+					Name:      "drop-all-ingress",
+					Action:    &actionDrop,
+				},
+			},
+			AppliedToGroups: []string{"atg-dev", "atg-web"},
+		},
+	}
+	for _, policy := range policies {
+		c.internalNetworkPolicyStore.Create(policy)
+	}
+	return NewPolicyVerdictQuerier(c, corelisters.NewPodLister(podIndexer))
+}
+
+func TestQueryPolicyVerdict(t *testing.T) {
+	k8sNPRule := &RuleReference{
+		PolicyRef:  PolicyRef{Namespace: "ns2", Name: "allow-client", UID: types.UID("uid1")},
+		PolicyType: controlplane.K8sNetworkPolicy,
+		RuleIndex:  0,
+		Action:     crdv1alpha1.RuleActionAllow,
+	}
+	defaultTierPriority := DefaultTierPriority
+	baselineTierPriority := BaselineTierPriority
+	noRuleMatches := &DirectionVerdict{Verdict: VerdictAllow, Reason: "No rule matches"}
+	isolated := &DirectionVerdict{Verdict: VerdictDrop, Reason: "Isolated by K8s NetworkPolicy ns2/allow-client"}
+	tests := []struct {
+		name             string
+		query            *PolicyVerdictQuery
+		expectedVerdicts []ConnectionVerdict
+		expectedErr      string
+	}{
+		{
+			name:  "allowed by K8s NetworkPolicy with named port",
+			query: &PolicyVerdictQuery{SourceNamespace: "ns1", SourcePod: "client", DestinationNamespace: "ns2", DestinationPod: "web-0", Port: 8080},
+			expectedVerdicts: []ConnectionVerdict{{
+				Source: "ns1/client", Destination: "ns2/web-0", Protocol: "TCP", Port: 8080, Verdict: VerdictAllow,
+				Egress:  noRuleMatches,
+				Ingress: &DirectionVerdict{Verdict: VerdictAllow, Rule: k8sNPRule},
+			}},
+		},
+		{
+			name:  "dropped by Antrea ClusterNetworkPolicy",
+			query: &PolicyVerdictQuery{SourceNamespace: "ns1", SourcePod: "client", DestinationNamespace: "ns2", DestinationPod: "web-0", Port: 7070},
+			expectedVerdicts: []ConnectionVerdict{{
+				Source: "ns1/client", Destination: "ns2/web-0", Protocol: "TCP", Port: 7070, Verdict: VerdictDrop,
+				Egress: noRuleMatches,
+				Ingress: &DirectionVerdict{Verdict: VerdictDrop, Rule: &RuleReference{
+					PolicyRef:    PolicyRef{Name: "drop-dev", UID: types.UID("uid3")},
+					PolicyType:   controlplane.AntreaClusterNetworkPolicy,
+					TierPriority: &defaultTierPriority,
+					Name:         "drop-7070",
+					RuleIndex:    0,
+					Action:       crdv1alpha1.RuleActionDrop,
+				}},
+			}},
+		},
+		{
+			name:  "passed to K8s NetworkPolicy isolation",
+			query: &PolicyVerdictQuery{SourceNamespace: "ns1", SourcePod: "client", DestinationNamespace: "ns2", DestinationPod: "web-0", Port: 9090},
+			expectedVerdicts: []ConnectionVerdict{{
+				Source: "ns1/client", Destination: "ns2/web-0", Protocol: "TCP", Port: 9090, Verdict: VerdictDrop,
+				Egress:  noRuleMatches,
+				Ingress: isolated,
+			}},
+		},
+		{
+			name:  "new Pod isolated by K8s NetworkPolicy",
+			query: &PolicyVerdictQuery{SourceNamespace: "ns1", DestinationNamespace: "ns2", DestinationPod: "web-1", Port: 8080},
+			expectedVerdicts: []ConnectionVerdict{{
+				Source: "ns1/<new Pod>", Destination: "ns2/web-1", Protocol: "TCP", Port: 8080, Verdict: VerdictDrop,
+				Egress:  noRuleMatches,
+				Ingress: isolated,
+			}},
+		},
+		{
+			name:  "new Pod with labels allowed by K8s NetworkPolicy",
+			query: &PolicyVerdictQuery{SourceNamespace: "ns1", SourceLabels: map[string]string{"app": "client"}, DestinationNamespace: "ns2", DestinationPod: "web-1", Port: 8080},
+			expectedVerdicts: []ConnectionVerdict{{
+				Source: "ns1/<new Pod with labels app=client>", Destination: "ns2/web-1", Protocol: "TCP", Port: 8080, Verdict: VerdictAllow,
+				Egress:  noRuleMatches,
+				Ingress: &DirectionVerdict{Verdict: VerdictAllow, Rule: k8sNPRule},
+			}},
+		},
+		{
+			name:  "Service backends",
+			query: &PolicyVerdictQuery{SourceNamespace: "ns1", SourcePod: "client", DestinationNamespace: "ns2", DestinationService: "web", Port: 80},
+			expectedVerdicts: []ConnectionVerdict{
+				{
+					Source: "ns1/client", Destination: "ns2/web-0", Protocol: "TCP", Port: 8080, Verdict: VerdictAllow,
+					Egress:  noRuleMatches,
+					Ingress: &DirectionVerdict{Verdict: VerdictAllow, Rule: k8sNPRule},
+				},
+				{
+					Source: "ns1/client", Destination: "ns2/web-1", Protocol: "TCP", Port: 8080, Verdict: VerdictAllow,
+					Egress:  noRuleMatches,
+					Ingress: &DirectionVerdict{Verdict: VerdictAllow, Rule: k8sNPRule},
+				},
+			},
+		},
+		{
+			name:  "rejected by ToServices rule",
+			query: &PolicyVerdictQuery{SourceNamespace: "ns1", SourcePod: "client", DestinationNamespace: "ns2", DestinationService: "web-legacy", Port: 80},
+			expectedVerdicts: func() []ConnectionVerdict {
+				egress := &DirectionVerdict{Verdict: VerdictReject, Rule: &RuleReference{
+					PolicyRef:    PolicyRef{Name: "reject-legacy", UID: types.UID("uid4")},
+					PolicyType:   controlplane.AntreaClusterNetworkPolicy,
+					TierPriority: &defaultTierPriority,
+					Name:         "reject-legacy",
+					RuleIndex:    0,
+					Action:       crdv1alpha1.RuleActionReject,
+				}}
+				// Traffic arriving through the Service is allowed by the
+				// ingress ToServices rule before K8s NetworkPolicies.
+				ingress := &DirectionVerdict{Verdict: VerdictAllow, Rule: &RuleReference{
+					PolicyRef:    PolicyRef{Name: "allow-legacy", UID: types.UID("uid6")},
+					PolicyType:   controlplane.AntreaClusterNetworkPolicy,
+					TierPriority: &defaultTierPriority,
+					Name:         "allow-legacy",
+					RuleIndex:    0,
+					Action:       crdv1alpha1.RuleActionAllow,
+				}}
+				return []ConnectionVerdict{
+					{
+						Source: "ns1/client", Destination: "ns2/web-0", Protocol: "TCP", Port: 8080, Verdict: VerdictReject,
+						Egress:  egress,
+						Ingress: ingress,
+					},
+					{
+						Source: "ns1/client", Destination: "ns2/web-1", Protocol: "TCP", Port: 8080, Verdict: VerdictReject,
+						Egress:  egress,
+						Ingress: ingress,
+					},
+				}
+			}(),
+		},
+		{
+			name:  "dropped by baseline Tier",
+			query: &PolicyVerdictQuery{SourceNamespace: "ns1", SourcePod: "client", DestinationIP: "8.8.8.8", Protocol: controlplane.ProtocolUDP, Port: 53},
+			expectedVerdicts: []ConnectionVerdict{{
+				Source: "ns1/client", Destination: "8.8.8.8", Protocol: "UDP", Port: 53, Verdict: VerdictDrop,
+				Egress: &DirectionVerdict{Verdict: VerdictDrop, Rule: &RuleReference{
+					PolicyRef:    PolicyRef{Name: "baseline", UID: types.UID("uid5")},
+					PolicyType:   controlplane.AntreaClusterNetworkPolicy,
+					TierPriority: &baselineTierPriority,
+					Name:         "drop-google-dns",
+					RuleIndex:    0,
+					Action:       crdv1alpha1.RuleActionDrop,
+				}},
+				Ingress: &DirectionVerdict{Verdict: VerdictAllow, Reason: "Destination is not a Pod"},
+			}},
+		},
+		{
+			name:  "IPBlock except",
+			query: &PolicyVerdictQuery{SourceNamespace: "ns1", SourcePod: "client", DestinationIP: "8.8.4.4", Protocol: controlplane.ProtocolUDP, Port: 53},
+			expectedVerdicts: []ConnectionVerdict{{
+				Source: "ns1/client", Destination: "8.8.4.4", Protocol: "UDP", Port: 53, Verdict: VerdictAllow,
+				Egress:  noRuleMatches,
+				Ingress: &DirectionVerdict{Verdict: VerdictAllow, Reason: "Destination is not a Pod"},
+			}},
+		},
+		{
+			name:  "external IP isolated by K8s NetworkPolicy",
+			query: &PolicyVerdictQuery{SourceIP: "192.168.1.1", DestinationNamespace: "ns2", DestinationPod: "web-0", Protocol: controlplane.ProtocolICMP},
+			expectedVerdicts: []ConnectionVerdict{{
+				Source: "192.168.1.1", Destination: "ns2/web-0", Protocol: "ICMP", Verdict: VerdictDrop,
+				Egress:  &DirectionVerdict{Verdict: VerdictAllow, Reason: "Source is not a Pod"},
+				Ingress: isolated,
+			}},
+		},
+		{
+			name:        "Pod not found",
+			query:       &PolicyVerdictQuery{SourceNamespace: "ns1", SourcePod: "foo", DestinationIP: "8.8.8.8", Port: 80},
+			expectedErr: "failed to get source Pod ns1/foo",
+		},
+		{
+			name:        "unknown Service port",
+			query:       &PolicyVerdictQuery{SourceNamespace: "ns1", SourcePod: "client", DestinationNamespace: "ns2", DestinationService: "web", Port: 443},
+			expectedErr: "Service ns2/web has no port 443/TCP",
+		},
+	}
+	q := newPolicyVerdictQuerierForTest(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := q.QueryPolicyVerdict(tt.query)
+			if tt.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedVerdicts, resp.Verdicts)
+		})
+	}
+}
@@ -0,0 +1,296 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+)
+
+const (
+	// ruleScheduleWorkers is the number of workers reprocessing policies when
+	// the schedule of one of their rules starts or ends.
+	ruleScheduleWorkers = 1
+)
+
+var weekdays = map[crdv1alpha1.Weekday]time.Weekday{
+	crdv1alpha1.Sunday:    time.Sunday,
+	crdv1alpha1.Monday:    time.Monday,
+	crdv1alpha1.Tuesday:   time.Tuesday,
+	crdv1alpha1.Wednesday: time.Wednesday,
+	crdv1alpha1.Thursday:  time.Thursday,
+	crdv1alpha1.Friday:    time.Friday,
+	crdv1alpha1.Saturday:  time.Saturday,
+}
+
+// parseTimeOfDay parses a time of day in the "HH:MM" format and returns the
+// hour and the minute.
+func parseTimeOfDay(value string) (int, int, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid time of day %q, must be in HH:MM format", value)
+	}
+	return t.Hour(), t.Minute(), nil
+}
+
+// getScheduleLocation returns the Location of the time zone of a RuleSchedule.
+func getScheduleLocation(schedule *crdv1alpha1.RuleSchedule) (*time.Location, error) {
+	if schedule.TimeZone == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(schedule.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time zone %q: %v", schedule.TimeZone, err)
+	}
+	return loc, nil
+}
+
+// parseCronWindow parses the cron expression and the duration of a CronWindow.
+func parseCronWindow(window *crdv1alpha1.CronWindow) (cron.Schedule, time.Duration, error) {
+	schedule, err := cron.ParseStandard(window.Schedule)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid cron expression %q: %v", window.Schedule, err)
+	}
+	duration, err := time.ParseDuration(window.Duration)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid duration %q: %v", window.Duration, err)
+	}
+	if duration <= 0 {
+		return nil, 0, fmt.Errorf("duration of a cron window must be positive")
+	}
+	return schedule, duration, nil
+}
+
+// validateRuleSchedule checks that all the fields of a RuleSchedule are valid.
+func validateRuleSchedule(schedule *crdv1alpha1.RuleSchedule) error {
+	if _, err := getScheduleLocation(schedule); err != nil {
+		return err
+	}
+	if len(schedule.Windows) == 0 && len(schedule.CronWindows) == 0 {
+		return fmt.Errorf("at least one time window must be specified in a rule schedule")
+	}
+	for _, window := range schedule.Windows {
+		startHour, startMinute, err := parseTimeOfDay(window.Start)
+		if err != nil {
+			return err
+		}
+		endHour, endMinute, err := parseTimeOfDay(window.End)
+		if err != nil {
+			return err
+		}
+		if startHour == endHour && startMinute == endMinute {
+			return fmt.Errorf("start and end of a time window cannot be the same")
+		}
+		for _, day := range window.Days {
+			if _, ok := weekdays[day]; !ok {
+				return fmt.Errorf("invalid day %q, must be one of Mon, Tue, Wed, Thu, Fri, Sat, Sun", day)
+			}
+		}
+	}
+	for idx := range schedule.CronWindows {
+		if _, _, err := parseCronWindow(&schedule.CronWindows[idx]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scheduleWindow is a time interval during which a rule is enforced.
+type scheduleWindow struct {
+	start time.Time
+	end   time.Time
+}
+
+// candidateWindows returns the windows of a RuleSchedule which may contain the
+// provided time, and the windows which start next.
+func candidateWindows(schedule *crdv1alpha1.RuleSchedule, now time.Time, loc *time.Location) []scheduleWindow {
+	var windows []scheduleWindow
+	localNow := now.In(loc)
+	for _, window := range schedule.Windows {
+		startHour, startMinute, err := parseTimeOfDay(window.Start)
+		if err != nil {
+			continue
+		}
+		endHour, endMinute, err := parseTimeOfDay(window.End)
+		if err != nil {
+			continue
+		}
+		days := map[time.Weekday]bool{}
+		for _, day := range window.Days {
+			days[weekdays[day]] = true
+		}
+		// A window which started yesterday may still be active, and a window
+		// may not start before next week if it is restricted to some days.
+		for offset := -1; offset <= 7; offset++ {
+			day := time.Date(localNow.Year(), localNow.Month(), localNow.Day()+offset, 0, 0, 0, 0, loc)
+			if len(days) > 0 && !days[day.Weekday()] {
+				continue
+			}
+			start := time.Date(day.Year(), day.Month(), day.Day(), startHour, startMinute, 0, 0, loc)
+			end := time.Date(day.Year(), day.Month(), day.Day(), endHour, endMinute, 0, 0, loc)
+			if !end.After(start) {
+				end = time.Date(day.Year(), day.Month(), day.Day()+1, endHour, endMinute, 0, 0, loc)
+			}
+			windows = append(windows, scheduleWindow{start: start, end: end})
+		}
+	}
+	for idx := range schedule.CronWindows {
+		cronSchedule, duration, err := parseCronWindow(&schedule.CronWindows[idx])
+		if err != nil {
+			continue
+		}
+		// The first window started after now-duration is the only one which
+		// may contain now and end first. The next one starts after now.
+		if start := cronSchedule.Next(localNow.Add(-duration)); !start.IsZero() && !start.After(now) {
+			windows = append(windows, scheduleWindow{start: start, end: start.Add(duration)})
+		}
+		if start := cronSchedule.Next(localNow); !start.IsZero() {
+			windows = append(windows, scheduleWindow{start: start, end: start.Add(duration)})
+		}
+	}
+	return windows
+}
+
+// evaluateRuleSchedule returns whether a RuleSchedule is active at the provided
+// time, the window during which it is active or, if it is inactive, the next
+// window, and the next time at which it may change state. An invalid
+// RuleSchedule is never active.
+func evaluateRuleSchedule(schedule *crdv1alpha1.RuleSchedule, now time.Time) (bool, scheduleWindow, time.Time) {
+	loc, err := getScheduleLocation(schedule)
+	if err != nil {
+		return false, scheduleWindow{}, time.Time{}
+	}
+	var active bool
+	var current, upcoming scheduleWindow
+	var next time.Time
+	updateNext := func(t time.Time) {
+		if t.After(now) && (next.IsZero() || t.Before(next)) {
+			next = t
+		}
+	}
+	for _, window := range candidateWindows(schedule, now, loc) {
+		if !now.Before(window.start) && now.Before(window.end) {
+			// Report the window which lasts the longest if several of them
+			// contain now.
+			if !active || window.end.After(current.end) {
+				current = window
+			}
+			active = true
+		} else if window.start.After(now) && (upcoming.start.IsZero() || window.start.Before(upcoming.start)) {
+			upcoming = window
+		}
+		updateNext(window.start)
+		updateNext(window.end)
+	}
+	if active {
+		return true, current, next
+	}
+	return false, upcoming, next
+}
+
+// getRuleScheduleStatus returns the state of the schedule of an Antrea-native
+// policy rule now, or nil if the rule has no schedule and is always active.
+func (n *NetworkPolicyController) getRuleScheduleStatus(rule *crdv1alpha1.Rule) *crdv1alpha1.RuleScheduleStatus {
+	if rule.Schedule == nil {
+		return nil
+	}
+	active, window, _ := evaluateRuleSchedule(rule.Schedule, n.clock.Now())
+	status := &crdv1alpha1.RuleScheduleStatus{
+		Name:   rule.Name,
+		Active: active,
+	}
+	if !window.start.IsZero() {
+		// The times are stored in the local time zone without sub-second
+		// precision, so that they compare equal to the ones read from the
+		// API server.
+		start := metav1.NewTime(window.start.Truncate(time.Second).Local())
+		end := metav1.NewTime(window.end.Truncate(time.Second).Local())
+		status.WindowStart, status.WindowEnd = &start, &end
+	}
+	return status
+}
+
+// nextRuleScheduleTransition returns the earliest time after now at which one
+// of the provided rules may become active or inactive, or the zero Time if none
+// of them has a schedule.
+func nextRuleScheduleTransition(now time.Time, ruleLists ...[]crdv1alpha1.Rule) time.Time {
+	var next time.Time
+	for _, rules := range ruleLists {
+		for idx := range rules {
+			if rules[idx].Schedule == nil {
+				continue
+			}
+			_, _, ruleNext := evaluateRuleSchedule(rules[idx].Schedule, now)
+			if !ruleNext.IsZero() && (next.IsZero() || ruleNext.Before(next)) {
+				next = ruleNext
+			}
+		}
+	}
+	return next
+}
+
+// enqueueRuleScheduleTransition makes sure that the policy referred to by
+// policyRef is reprocessed when one of its rules becomes active or inactive.
+// It must be called before the policy is processed, so that a transition
+// happening during the processing is not missed.
+func (n *NetworkPolicyController) enqueueRuleScheduleTransition(policyRef controlplane.NetworkPolicyReference, ruleLists ...[]crdv1alpha1.Rule) {
+	now := n.clock.Now()
+	if next := nextRuleScheduleTransition(now, ruleLists...); !next.IsZero() {
+		n.ruleScheduleQueue.AddAfter(policyRef, next.Sub(now))
+	}
+}
+
+func (n *NetworkPolicyController) ruleScheduleWorker() {
+	for n.processNextRuleScheduleWorkItem() {
+	}
+}
+
+// processNextRuleScheduleWorkItem reprocesses an Antrea-native policy whose
+// rules may have become active or inactive according to their schedules.
+func (n *NetworkPolicyController) processNextRuleScheduleWorkItem() bool {
+	obj, quit := n.ruleScheduleQueue.Get()
+	if quit {
+		return false
+	}
+	defer n.ruleScheduleQueue.Done(obj)
+
+	policyRef := obj.(controlplane.NetworkPolicyReference)
+	klog.V(2).InfoS("Reprocessing policy as the schedule of its rules changed", "policy", policyRef.ToString())
+	switch policyRef.Type {
+	case controlplane.AntreaClusterNetworkPolicy:
+		cnp, err := n.cnpLister.Get(policyRef.Name)
+		if err != nil || cnp.UID != policyRef.UID {
+			// The policy has been deleted or recreated, nothing to do.
+			return true
+		}
+		n.enqueueRuleScheduleTransition(policyRef, cnp.Spec.Ingress, cnp.Spec.Egress)
+		n.reprocessCNP(cnp, true)
+	case controlplane.AntreaNetworkPolicy:
+		anp, err := n.anpLister.NetworkPolicies(policyRef.Namespace).Get(policyRef.Name)
+		if err != nil || anp.UID != policyRef.UID {
+			return true
+		}
+		n.enqueueRuleScheduleTransition(policyRef, anp.Spec.Ingress, anp.Spec.Egress)
+		n.reprocessANP(anp)
+	}
+	return true
+}
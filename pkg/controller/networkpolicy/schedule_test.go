@@ -0,0 +1,374 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+func TestEvaluateRuleSchedule(t *testing.T) {
+	// 2022-06-01 is a Wednesday.
+	wednesdayNoon := time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name           string
+		schedule       *crdv1alpha1.RuleSchedule
+		now            time.Time
+		expectedActive bool
+		expectedWindow scheduleWindow
+		expectedNext   time.Time
+	}{
+		{
+			name: "inside daily window",
+			schedule: &crdv1alpha1.RuleSchedule{
+				Windows: []crdv1alpha1.TimeWindow{{Start: "09:00", End: "17:00"}},
+			},
+			now:            wednesdayNoon,
+			expectedActive: true,
+			expectedWindow: scheduleWindow{start: time.Date(2022, 6, 1, 9, 0, 0, 0, time.UTC), end: time.Date(2022, 6, 1, 17, 0, 0, 0, time.UTC)},
+			expectedNext:   time.Date(2022, 6, 1, 17, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "before daily window",
+			schedule: &crdv1alpha1.RuleSchedule{
+				Windows: []crdv1alpha1.TimeWindow{{Start: "13:00", End: "17:00"}},
+			},
+			now:            wednesdayNoon,
+			expectedActive: false,
+			expectedWindow: scheduleWindow{start: time.Date(2022, 6, 1, 13, 0, 0, 0, time.UTC), end: time.Date(2022, 6, 1, 17, 0, 0, 0, time.UTC)},
+			expectedNext:   time.Date(2022, 6, 1, 13, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "at the end of daily window",
+			schedule: &crdv1alpha1.RuleSchedule{
+				Windows: []crdv1alpha1.TimeWindow{{Start: "09:00", End: "12:00"}},
+			},
+			now:            wednesdayNoon,
+			expectedActive: false,
+			expectedWindow: scheduleWindow{start: time.Date(2022, 6, 2, 9, 0, 0, 0, time.UTC), end: time.Date(2022, 6, 2, 12, 0, 0, 0, time.UTC)},
+			expectedNext:   time.Date(2022, 6, 2, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "overnight window started yesterday",
+			schedule: &crdv1alpha1.RuleSchedule{
+				Windows: []crdv1alpha1.TimeWindow{{Start: "22:00", End: "13:00", Days: []crdv1alpha1.Weekday{crdv1alpha1.Tuesday}}},
+			},
+			now:            wednesdayNoon,
+			expectedActive: true,
+			expectedWindow: scheduleWindow{start: time.Date(2022, 5, 31, 22, 0, 0, 0, time.UTC), end: time.Date(2022, 6, 1, 13, 0, 0, 0, time.UTC)},
+			expectedNext:   time.Date(2022, 6, 1, 13, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "window on other days",
+			schedule: &crdv1alpha1.RuleSchedule{
+				Windows: []crdv1alpha1.TimeWindow{{Start: "00:00", End: "23:59", Days: []crdv1alpha1.Weekday{crdv1alpha1.Saturday, crdv1alpha1.Sunday}}},
+			},
+			now:            wednesdayNoon,
+			expectedActive: false,
+			expectedWindow: scheduleWindow{start: time.Date(2022, 6, 4, 0, 0, 0, 0, time.UTC), end: time.Date(2022, 6, 4, 23, 59, 0, 0, time.UTC)},
+			expectedNext:   time.Date(2022, 6, 4, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "window in another time zone",
+			schedule: &crdv1alpha1.RuleSchedule{
+				TimeZone: "Asia/Shanghai",
+				Windows:  []crdv1alpha1.TimeWindow{{Start: "18:00", End: "22:00"}},
+			},
+			now:            wednesdayNoon,
+			expectedActive: true,
+			expectedWindow: scheduleWindow{start: time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC), end: time.Date(2022, 6, 1, 14, 0, 0, 0, time.UTC)},
+			expectedNext:   time.Date(2022, 6, 1, 14, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "multiple windows",
+			schedule: &crdv1alpha1.RuleSchedule{
+				Windows: []crdv1alpha1.TimeWindow{
+					{Start: "08:00", End: "10:00"},
+					{Start: "12:30", End: "14:00"},
+				},
+			},
+			now:            wednesdayNoon,
+			expectedActive: false,
+			expectedWindow: scheduleWindow{start: time.Date(2022, 6, 1, 12, 30, 0, 0, time.UTC), end: time.Date(2022, 6, 1, 14, 0, 0, 0, time.UTC)},
+			expectedNext:   time.Date(2022, 6, 1, 12, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "inside cron window",
+			schedule: &crdv1alpha1.RuleSchedule{
+				CronWindows: []crdv1alpha1.CronWindow{{Schedule: "0 11 * * 1-5", Duration: "2h"}},
+			},
+			now:            wednesdayNoon,
+			expectedActive: true,
+			expectedWindow: scheduleWindow{start: time.Date(2022, 6, 1, 11, 0, 0, 0, time.UTC), end: time.Date(2022, 6, 1, 13, 0, 0, 0, time.UTC)},
+			expectedNext:   time.Date(2022, 6, 1, 13, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "before cron window",
+			schedule: &crdv1alpha1.RuleSchedule{
+				CronWindows: []crdv1alpha1.CronWindow{{Schedule: "0 1 * * *", Duration: "2h"}},
+			},
+			now:            wednesdayNoon,
+			expectedActive: false,
+			expectedWindow: scheduleWindow{start: time.Date(2022, 6, 2, 1, 0, 0, 0, time.UTC), end: time.Date(2022, 6, 2, 3, 0, 0, 0, time.UTC)},
+			expectedNext:   time.Date(2022, 6, 2, 1, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "cron window in another time zone",
+			schedule: &crdv1alpha1.RuleSchedule{
+				TimeZone:    "Asia/Shanghai",
+				CronWindows: []crdv1alpha1.CronWindow{{Schedule: "@daily", Duration: "1h"}},
+			},
+			now:            wednesdayNoon,
+			expectedActive: false,
+			expectedWindow: scheduleWindow{start: time.Date(2022, 6, 1, 16, 0, 0, 0, time.UTC), end: time.Date(2022, 6, 1, 17, 0, 0, 0, time.UTC)},
+			expectedNext:   time.Date(2022, 6, 1, 16, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "daily and cron windows",
+			schedule: &crdv1alpha1.RuleSchedule{
+				Windows:     []crdv1alpha1.TimeWindow{{Start: "09:00", End: "12:30"}},
+				CronWindows: []crdv1alpha1.CronWindow{{Schedule: "0 10 1 * *", Duration: "3h"}},
+			},
+			now:            wednesdayNoon,
+			expectedActive: true,
+			expectedWindow: scheduleWindow{start: time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC), end: time.Date(2022, 6, 1, 13, 0, 0, 0, time.UTC)},
+			expectedNext:   time.Date(2022, 6, 1, 12, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "invalid time zone",
+			schedule: &crdv1alpha1.RuleSchedule{
+				TimeZone: "Invalid/Zone",
+				Windows:  []crdv1alpha1.TimeWindow{{Start: "09:00", End: "17:00"}},
+			},
+			now:            wednesdayNoon,
+			expectedActive: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			active, window, next := evaluateRuleSchedule(tt.schedule, tt.now)
+			assert.Equal(t, tt.expectedActive, active)
+			assert.True(t, tt.expectedWindow.start.Equal(window.start), "expected window start %v, got %v", tt.expectedWindow.start, window.start)
+			assert.True(t, tt.expectedWindow.end.Equal(window.end), "expected window end %v, got %v", tt.expectedWindow.end, window.end)
+			assert.True(t, tt.expectedNext.Equal(next), "expected next transition %v, got %v", tt.expectedNext, next)
+		})
+	}
+}
+
+func TestProcessClusterNetworkPolicyWithSchedule(t *testing.T) {
+	allowAction := crdv1alpha1.RuleActionAllow
+	cnp := &crdv1alpha1.ClusterNetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "cnpA", UID: "uidA"},
+		Spec: crdv1alpha1.ClusterNetworkPolicySpec{
+			AppliedTo: []crdv1alpha1.NetworkPolicyPeer{
+				{PodSelector: &selectorA},
+			},
+			Priority: 10,
+			Ingress: []crdv1alpha1.Rule{
+				{
+					Name:   "business-hours",
+					Action: &allowAction,
+					From: []crdv1alpha1.NetworkPolicyPeer{
+						{PodSelector: &selectorB},
+					},
+					Schedule: &crdv1alpha1.RuleSchedule{
+						Windows: []crdv1alpha1.TimeWindow{{Start: "09:00", End: "17:00"}},
+					},
+				},
+				{
+					Name:   "always",
+					Action: &allowAction,
+					From: []crdv1alpha1.NetworkPolicyPeer{
+						{PodSelector: &selectorC},
+					},
+				},
+			},
+		},
+	}
+	_, c := newController()
+	fakeClock := clocktesting.NewFakeClock(time.Date(2022, 6, 1, 8, 0, 0, 0, time.UTC))
+	c.clock = fakeClock
+
+	policy := c.processClusterNetworkPolicy(cnp)
+	require.Len(t, policy.Rules, 1)
+	assert.Equal(t, "always", policy.Rules[0].Name)
+	// The priority of a rule must not be affected by the inactive rules before it.
+	assert.Equal(t, int32(1), policy.Rules[0].Priority)
+	assert.Equal(t, []string{"business-hours"}, policy.InactiveRules)
+	require.Len(t, policy.RuleSchedules, 1)
+	assert.Equal(t, "business-hours", policy.RuleSchedules[0].Name)
+	assert.False(t, policy.RuleSchedules[0].Active)
+	assert.True(t, time.Date(2022, 6, 1, 9, 0, 0, 0, time.UTC).Equal(policy.RuleSchedules[0].WindowStart.Time))
+	assert.True(t, time.Date(2022, 6, 1, 17, 0, 0, 0, time.UTC).Equal(policy.RuleSchedules[0].WindowEnd.Time))
+	// Processing the policy must not schedule its reprocessing.
+	assert.Equal(t, 0, c.ruleScheduleQueue.Len())
+
+	fakeClock.SetTime(time.Date(2022, 6, 1, 9, 0, 0, 0, time.UTC))
+	policy = c.processClusterNetworkPolicy(cnp)
+	require.Len(t, policy.Rules, 2)
+	assert.Equal(t, "business-hours", policy.Rules[0].Name)
+	assert.Equal(t, int32(0), policy.Rules[0].Priority)
+	assert.Empty(t, policy.InactiveRules)
+	require.Len(t, policy.RuleSchedules, 1)
+	assert.True(t, policy.RuleSchedules[0].Active)
+}
+
+func TestValidateRuleSchedule(t *testing.T) {
+	tests := []struct {
+		name        string
+		schedule    *crdv1alpha1.RuleSchedule
+		expectedErr string
+	}{
+		{
+			name:     "valid windows",
+			schedule: &crdv1alpha1.RuleSchedule{Windows: []crdv1alpha1.TimeWindow{{Start: "22:00", End: "06:00"}}},
+		},
+		{
+			name:     "valid cron windows",
+			schedule: &crdv1alpha1.RuleSchedule{CronWindows: []crdv1alpha1.CronWindow{{Schedule: "30 1 * * 1-5", Duration: "90m"}}},
+		},
+		{
+			name:        "no window",
+			schedule:    &crdv1alpha1.RuleSchedule{TimeZone: "UTC"},
+			expectedErr: "at least one time window must be specified in a rule schedule",
+		},
+		{
+			name:        "invalid cron expression",
+			schedule:    &crdv1alpha1.RuleSchedule{CronWindows: []crdv1alpha1.CronWindow{{Schedule: "0 25 * * *", Duration: "1h"}}},
+			expectedErr: "invalid cron expression",
+		},
+		{
+			name:        "invalid duration",
+			schedule:    &crdv1alpha1.RuleSchedule{CronWindows: []crdv1alpha1.CronWindow{{Schedule: "@hourly", Duration: "-1h"}}},
+			expectedErr: "duration of a cron window must be positive",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRuleSchedule(tt.schedule)
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestReprocessANPWithoutInternalNetworkPolicy(t *testing.T) {
+	allowAction := crdv1alpha1.RuleActionAllow
+	anp := &crdv1alpha1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "nsA", Name: "anpA", UID: "uidA"},
+		Spec: crdv1alpha1.NetworkPolicySpec{
+			AppliedTo: []crdv1alpha1.NetworkPolicyPeer{
+				{PodSelector: &selectorA},
+			},
+			Priority: 10,
+			Ingress: []crdv1alpha1.Rule{
+				{
+					Name:   "business-hours",
+					Action: &allowAction,
+					Schedule: &crdv1alpha1.RuleSchedule{
+						Windows: []crdv1alpha1.TimeWindow{{Start: "09:00", End: "17:00"}},
+					},
+				},
+			},
+		},
+	}
+	_, c := newController()
+	c.clock = clocktesting.NewFakeClock(time.Date(2022, 6, 1, 8, 0, 0, 0, time.UTC))
+
+	// Reprocessing a policy which has been deleted must not create it again.
+	c.reprocessANP(anp)
+	assert.Empty(t, c.internalNetworkPolicyStore.List())
+
+	c.addANP(anp)
+	obj, exists, _ := c.internalNetworkPolicyStore.Get(internalNetworkPolicyKeyFunc(anp))
+	require.True(t, exists)
+	assert.Empty(t, obj.(*antreatypes.NetworkPolicy).Rules)
+
+	c.clock = clocktesting.NewFakeClock(time.Date(2022, 6, 1, 9, 0, 0, 0, time.UTC))
+	c.reprocessANP(anp)
+	obj, exists, _ = c.internalNetworkPolicyStore.Get(internalNetworkPolicyKeyFunc(anp))
+	require.True(t, exists)
+	assert.Len(t, obj.(*antreatypes.NetworkPolicy).Rules, 1)
+	assert.Empty(t, obj.(*antreatypes.NetworkPolicy).InactiveRules)
+}
+
+func TestNextRuleScheduleTransition(t *testing.T) {
+	now := time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+	ingress := []crdv1alpha1.Rule{
+		{Schedule: &crdv1alpha1.RuleSchedule{Windows: []crdv1alpha1.TimeWindow{{Start: "09:00", End: "17:00"}}}},
+		{},
+	}
+	egress := []crdv1alpha1.Rule{
+		{Schedule: &crdv1alpha1.RuleSchedule{Windows: []crdv1alpha1.TimeWindow{{Start: "13:00", End: "14:00"}}}},
+	}
+	assert.True(t, nextRuleScheduleTransition(now).IsZero())
+	assert.True(t, nextRuleScheduleTransition(now, []crdv1alpha1.Rule{{}}).IsZero())
+	assert.Equal(t, time.Date(2022, 6, 1, 17, 0, 0, 0, time.UTC), nextRuleScheduleTransition(now, ingress))
+	assert.Equal(t, time.Date(2022, 6, 1, 13, 0, 0, 0, time.UTC), nextRuleScheduleTransition(now, ingress, egress))
+}
+
+func TestAddCNPWithScheduleEnqueuesTransition(t *testing.T) {
+	allowAction := crdv1alpha1.RuleActionAllow
+	cnp := &crdv1alpha1.ClusterNetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "cnpA", UID: "uidA"},
+		Spec: crdv1alpha1.ClusterNetworkPolicySpec{
+			AppliedTo: []crdv1alpha1.NetworkPolicyPeer{
+				{PodSelector: &selectorA},
+			},
+			Priority: 10,
+			Ingress: []crdv1alpha1.Rule{
+				{
+					Name:   "business-hours",
+					Action: &allowAction,
+					From: []crdv1alpha1.NetworkPolicyPeer{
+						{PodSelector: &selectorB},
+					},
+					Schedule: &crdv1alpha1.RuleSchedule{
+						Windows: []crdv1alpha1.TimeWindow{{Start: "09:00", End: "17:00"}},
+					},
+				},
+			},
+		},
+	}
+	_, c := newController()
+	fakeClock := clocktesting.NewFakeClock(time.Date(2022, 6, 1, 8, 0, 0, 0, time.UTC))
+	c.clock = fakeClock
+	c.ruleScheduleQueue = workqueue.NewDelayingQueueWithCustomClock(fakeClock, "ruleSchedule")
+	defer c.ruleScheduleQueue.ShutDown()
+
+	c.addCNP(cnp)
+	assert.Equal(t, 0, c.ruleScheduleQueue.Len())
+	// The policy should be reprocessed when the window starts.
+	fakeClock.Step(time.Hour)
+	assert.Eventually(t, func() bool {
+		return c.ruleScheduleQueue.Len() == 1
+	}, time.Second, 10*time.Millisecond)
+	obj, _ := c.ruleScheduleQueue.Get()
+	assert.Equal(t, clusterNetworkPolicyReference(cnp), obj.(controlplane.NetworkPolicyReference))
+	c.ruleScheduleQueue.Done(obj)
+}
@@ -16,10 +16,16 @@ package networkpolicy
 
 import (
 	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
@@ -38,13 +44,39 @@ import (
 	crdlisters "antrea.io/antrea/pkg/client/listers/crd/v1alpha1"
 	"antrea.io/antrea/pkg/controller/metrics"
 	antreatypes "antrea.io/antrea/pkg/controller/types"
+	"antrea.io/antrea/pkg/features"
+	policyv1alpha1 "antrea.io/antrea/third_party/networkpolicyapi/apis/v1alpha1"
+	policyclientset "antrea.io/antrea/third_party/networkpolicyapi/pkg/client/clientset/versioned"
+	policyinformers "antrea.io/antrea/third_party/networkpolicyapi/pkg/client/informers/externalversions/apis/v1alpha1"
+	policylisters "antrea.io/antrea/third_party/networkpolicyapi/pkg/client/listers/apis/v1alpha1"
 )
 
 const (
 	statusControllerName = "NetworkPolicyStatusController"
+
+	// maxFailedNodesInMessage is the maximum number of failed Nodes listed in the message of the
+	// RealizationFailure condition.
+	maxFailedNodesInMessage = 10
+	// multipleFailureReasons is the reason of the RealizationFailure condition when the Nodes failed
+	// for different reasons.
+	multipleFailureReasons = "MultipleReasons"
+	// ingressToServicesReasonNotLocal is the reason of the IngressToServicesNotLocal condition.
+	ingressToServicesReasonNotLocal = "TrafficPolicyNotLocal"
+
+	// adminPolicyRealizedCondition is the type of the condition reporting the realization of AdminNetworkPolicies
+	// and BaselineAdminNetworkPolicies, which have no phase.
+	adminPolicyRealizedCondition = "Realized"
+	// Reasons of the Realized condition.
+	adminPolicyReasonPending            = "Pending"
+	adminPolicyReasonRealizing          = "Realizing"
+	adminPolicyReasonRealized           = "Realized"
+	adminPolicyReasonRealizationFailure = "RealizationFailure"
+	adminPolicyReasonUnsupportedPeers   = "UnsupportedPeers"
 )
 
 // StatusController is responsible for synchronizing the status of Antrea ClusterNetworkPolicy and Antrea NetworkPolicy.
+// When the AdminNetworkPolicy feature is enabled, it also reports the realization of AdminNetworkPolicies and
+// BaselineAdminNetworkPolicies with a condition.
 type StatusController struct {
 	// npControlInterface knows how to update Antrea NetworkPolicy status.
 	npControlInterface networkPolicyControlInterface
@@ -71,15 +103,29 @@ type StatusController struct {
 	anpLister crdlisters.NetworkPolicyLister
 	// anpListerSynced is a function which returns true if the AntreaNetworkPolicies shared informer has been synced at least once.
 	anpListerSynced cache.InformerSynced
+	// adminNPListerSynced is a function which returns true if the AdminNetworkPolicies shared informer has been
+	// synced at least once. It is nil when the AdminNetworkPolicy feature is disabled.
+	adminNPListerSynced cache.InformerSynced
+	// banpListerSynced is a function which returns true if the BaselineAdminNetworkPolicies shared informer has been
+	// synced at least once. It is nil when the AdminNetworkPolicy feature is disabled.
+	banpListerSynced cache.InformerSynced
 }
 
-func NewStatusController(antreaClient antreaclientset.Interface, internalNetworkPolicyStore storage.Interface, cnpInformer crdinformers.ClusterNetworkPolicyInformer, anpInformer crdinformers.NetworkPolicyInformer) *StatusController {
+func NewStatusController(antreaClient antreaclientset.Interface,
+	policyClient policyclientset.Interface,
+	internalNetworkPolicyStore storage.Interface,
+	cnpInformer crdinformers.ClusterNetworkPolicyInformer,
+	anpInformer crdinformers.NetworkPolicyInformer,
+	adminNPInformer policyinformers.AdminNetworkPolicyInformer,
+	banpInformer policyinformers.BaselineAdminNetworkPolicyInformer) *StatusController {
+	npControl := &networkPolicyControl{
+		antreaClient: antreaClient,
+		policyClient: policyClient,
+		anpLister:    anpInformer.Lister(),
+		cnpLister:    cnpInformer.Lister(),
+	}
 	c := &StatusController{
-		npControlInterface: &networkPolicyControl{
-			antreaClient: antreaClient,
-			anpLister:    anpInformer.Lister(),
-			cnpLister:    cnpInformer.Lister(),
-		},
+		npControlInterface:         npControl,
 		queue:                      workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(minRetryDelay, maxRetryDelay), "networkpolicy"),
 		internalNetworkPolicyStore: internalNetworkPolicyStore,
 		statuses:                   map[string]map[string]*controlplane.NetworkPolicyNodeStatus{},
@@ -105,13 +151,31 @@ func NewStatusController(antreaClient antreaclientset.Interface, internalNetwork
 		},
 		resyncPeriod,
 	)
+	if features.DefaultFeatureGate.Enabled(features.AdminNetworkPolicy) {
+		npControl.adminNPLister = adminNPInformer.Lister()
+		npControl.banpLister = banpInformer.Lister()
+		c.adminNPListerSynced = adminNPInformer.Informer().HasSynced
+		c.banpListerSynced = banpInformer.Informer().HasSynced
+		adminNPInformer.Informer().AddEventHandlerWithResyncPeriod(
+			cache.ResourceEventHandlerFuncs{
+				UpdateFunc: c.updateAdminNP,
+			},
+			resyncPeriod,
+		)
+		banpInformer.Informer().AddEventHandlerWithResyncPeriod(
+			cache.ResourceEventHandlerFuncs{
+				UpdateFunc: c.updateBANP,
+			},
+			resyncPeriod,
+		)
+	}
 	return c
 }
 
 func (c *StatusController) updateCNP(old, cur interface{}) {
 	curCNP := cur.(*crdv1alpha1.ClusterNetworkPolicy)
 	oldCNP := old.(*crdv1alpha1.ClusterNetworkPolicy)
-	if oldCNP.Status == curCNP.Status {
+	if reflect.DeepEqual(oldCNP.Status, curCNP.Status) {
 		return
 	}
 	key := internalNetworkPolicyKeyFunc(oldCNP)
@@ -121,13 +185,33 @@ func (c *StatusController) updateCNP(old, cur interface{}) {
 func (c *StatusController) updateANP(old, cur interface{}) {
 	curANP := cur.(*crdv1alpha1.NetworkPolicy)
 	oldANP := old.(*crdv1alpha1.NetworkPolicy)
-	if oldANP.Status == curANP.Status {
+	if reflect.DeepEqual(oldANP.Status, curANP.Status) {
+		return
+	}
+	key := internalNetworkPolicyKeyFunc(oldANP)
+	c.queue.Add(key)
+}
+
+func (c *StatusController) updateAdminNP(old, cur interface{}) {
+	curANP := cur.(*policyv1alpha1.AdminNetworkPolicy)
+	oldANP := old.(*policyv1alpha1.AdminNetworkPolicy)
+	if reflect.DeepEqual(oldANP.Status, curANP.Status) {
 		return
 	}
 	key := internalNetworkPolicyKeyFunc(oldANP)
 	c.queue.Add(key)
 }
 
+func (c *StatusController) updateBANP(old, cur interface{}) {
+	curBANP := cur.(*policyv1alpha1.BaselineAdminNetworkPolicy)
+	oldBANP := old.(*policyv1alpha1.BaselineAdminNetworkPolicy)
+	if reflect.DeepEqual(oldBANP.Status, curBANP.Status) {
+		return
+	}
+	key := internalNetworkPolicyKeyFunc(oldBANP)
+	c.queue.Add(key)
+}
+
 func (c *StatusController) UpdateStatus(status *controlplane.NetworkPolicyStatus) error {
 	key := status.Name
 	_, found, _ := c.internalNetworkPolicyStore.Get(key)
@@ -165,20 +249,63 @@ func (c *StatusController) getNodeStatuses(key string) []*controlplane.NetworkPo
 	return statuses
 }
 
+// GetRealizationStatus returns the realization status of an Antrea-native policy aggregated from the statuses
+// reported by the Nodes. It returns nil for K8s NetworkPolicies and for policies that haven't been processed.
+func (c *StatusController) GetRealizationStatus(internalNP *antreatypes.NetworkPolicy) *controlplane.NetworkPolicyRealizationStatus {
+	if internalNP.SourceRef == nil || internalNP.SourceRef.Type == controlplane.K8sNetworkPolicy || internalNP.SpanMeta.NodeNames == nil {
+		return nil
+	}
+	return c.computeRealizationStatus(internalNP)
+}
+
+// computeRealizationStatus computes the realization status of the provided NetworkPolicy from the statuses reported
+// by the Nodes it spans. It doesn't modify the statuses, the ones of the Nodes which are no longer in its span are
+// deleted by syncHandler.
+func (c *StatusController) computeRealizationStatus(internalNP *antreatypes.NetworkPolicy) *controlplane.NetworkPolicyRealizationStatus {
+	status := &controlplane.NetworkPolicyRealizationStatus{
+		DesiredNodesRealized: int32(len(internalNP.SpanMeta.NodeNames)),
+	}
+	for _, nodeStatus := range c.getNodeStatuses(internalNP.Name) {
+		// The node is no longer in the span of this policy, ignore its status.
+		if !internalNP.NodeNames.Has(nodeStatus.NodeName) {
+			continue
+		}
+		// A failure reported for a previous generation doesn't tell whether the current generation can be realized.
+		if nodeStatus.Generation != internalNP.Generation {
+			continue
+		}
+		if nodeStatus.Reason != "" {
+			status.FailedNodes = append(status.FailedNodes, *nodeStatus)
+		} else {
+			status.CurrentNodesRealized += 1
+		}
+	}
+	sort.Slice(status.FailedNodes, func(i, j int) bool {
+		return status.FailedNodes[i].NodeName < status.FailedNodes[j].NodeName
+	})
+	return status
+}
+
 func (c *StatusController) clearStatuses(key string) {
 	c.statusesLock.Lock()
 	defer c.statusesLock.Unlock()
 	delete(c.statuses, key)
 }
 
-func (c *StatusController) deleteNodeStatus(key string, nodeName string) {
+// deleteStaleNodeStatuses deletes the statuses of the Nodes which are no longer in the span of the provided
+// NetworkPolicy.
+func (c *StatusController) deleteStaleNodeStatuses(internalNP *antreatypes.NetworkPolicy) {
 	c.statusesLock.Lock()
 	defer c.statusesLock.Unlock()
-	statusPerNode, exists := c.statuses[key]
+	statusPerNode, exists := c.statuses[internalNP.Name]
 	if !exists {
 		return
 	}
-	delete(statusPerNode, nodeName)
+	for nodeName := range statusPerNode {
+		if !internalNP.NodeNames.Has(nodeName) {
+			delete(statusPerNode, nodeName)
+		}
+	}
 }
 
 // Run begins watching and syncing of a StatusController.
@@ -188,7 +315,11 @@ func (c *StatusController) Run(stopCh <-chan struct{}) {
 	klog.Infof("Starting %s", statusControllerName)
 	defer klog.Infof("Shutting down %s", statusControllerName)
 
-	if !cache.WaitForNamedCacheSync(statusControllerName, stopCh, c.cnpListerSynced, c.anpListerSynced) {
+	cacheSyncs := []cache.InformerSynced{c.cnpListerSynced, c.anpListerSynced}
+	if c.adminNPListerSynced != nil {
+		cacheSyncs = append(cacheSyncs, c.adminNPListerSynced, c.banpListerSynced)
+	}
+	if !cache.WaitForNamedCacheSync(statusControllerName, stopCh, cacheSyncs...) {
 		return
 	}
 
@@ -267,6 +398,15 @@ func (c *StatusController) syncHandler(key string) error {
 		return nil
 	}
 	internalNP := internalNPObj.(*antreatypes.NetworkPolicy)
+	if internalNP.SpanMeta.NodeNames != nil {
+		c.deleteStaleNodeStatuses(internalNP)
+	}
+	switch internalNP.SourceRef.Type {
+	case controlplane.AdminNetworkPolicy:
+		return c.npControlInterface.UpdateAdminNetworkPolicyStatus(internalNP.SourceRef.Name, c.adminPolicyCondition(internalNP))
+	case controlplane.BaselineAdminNetworkPolicy:
+		return c.npControlInterface.UpdateBaselineAdminNetworkPolicyStatus(internalNP.SourceRef.Name, c.adminPolicyCondition(internalNP))
+	}
 
 	// It means the NetworkPolicy hasn't been processed once. Set it to Pending to differentiate from NetworkPolicies
 	// that spans 0 Node.
@@ -274,36 +414,32 @@ func (c *StatusController) syncHandler(key string) error {
 		status := &crdv1alpha1.NetworkPolicyStatus{
 			Phase:              crdv1alpha1.NetworkPolicyPending,
 			ObservedGeneration: internalNP.Generation,
+			InactiveRules:      internalNP.InactiveRules,
+			RuleSchedules:      internalNP.RuleSchedules,
+			Conditions:         ingressToServicesConditions(internalNP),
 		}
 		if internalNP.SourceRef.Type == controlplane.AntreaNetworkPolicy {
 			return c.npControlInterface.UpdateAntreaNetworkPolicyStatus(internalNP.SourceRef.Namespace, internalNP.SourceRef.Name, status)
 		}
 		return c.npControlInterface.UpdateAntreaClusterNetworkPolicyStatus(internalNP.SourceRef.Name, status)
 	}
-	desiredNodes := len(internalNP.SpanMeta.NodeNames)
-	currentNodes := 0
-	statuses := c.getNodeStatuses(key)
-	for _, status := range statuses {
-		// The node is no longer in the span of this policy, delete its status.
-		if !internalNP.NodeNames.Has(status.NodeName) {
-			c.deleteNodeStatus(key, status.NodeName)
-			continue
-		}
-		if status.Generation == internalNP.Generation {
-			currentNodes += 1
-		}
-	}
+	realizationStatus := c.computeRealizationStatus(internalNP)
 
 	phase := crdv1alpha1.NetworkPolicyRealizing
-	if currentNodes == desiredNodes {
+	if len(realizationStatus.FailedNodes) > 0 {
+		phase = crdv1alpha1.NetworkPolicyFailed
+	} else if realizationStatus.CurrentNodesRealized == realizationStatus.DesiredNodesRealized {
 		phase = crdv1alpha1.NetworkPolicyRealized
 	}
 
 	status := &crdv1alpha1.NetworkPolicyStatus{
 		Phase:                phase,
 		ObservedGeneration:   internalNP.Generation,
-		CurrentNodesRealized: int32(currentNodes),
-		DesiredNodesRealized: int32(desiredNodes),
+		CurrentNodesRealized: realizationStatus.CurrentNodesRealized,
+		DesiredNodesRealized: realizationStatus.DesiredNodesRealized,
+		InactiveRules:        internalNP.InactiveRules,
+		RuleSchedules:        internalNP.RuleSchedules,
+		Conditions:           append(realizationFailureConditions(realizationStatus.FailedNodes), ingressToServicesConditions(internalNP)...),
 	}
 	klog.V(2).Infof("Updating NetworkPolicy %s status: %v", internalNP.SourceRef.ToString(), status)
 	if internalNP.SourceRef.Type == controlplane.AntreaNetworkPolicy {
@@ -312,17 +448,121 @@ func (c *StatusController) syncHandler(key string) error {
 	return c.npControlInterface.UpdateAntreaClusterNetworkPolicyStatus(internalNP.SourceRef.Name, status)
 }
 
+// adminPolicyCondition returns the Realized condition of an AdminNetworkPolicy or a BaselineAdminNetworkPolicy.
+// A policy with rules which are not fully enforced because of unsupported peers is never reported as realized.
+// The LastTransitionTime of the condition is set when updating the status.
+func (c *StatusController) adminPolicyCondition(internalNP *antreatypes.NetworkPolicy) v1.Condition {
+	condition := v1.Condition{
+		Type:               adminPolicyRealizedCondition,
+		Status:             v1.ConditionFalse,
+		ObservedGeneration: internalNP.Generation,
+	}
+	if len(internalNP.UnsupportedRules) > 0 {
+		condition.Reason = adminPolicyReasonUnsupportedPeers
+		condition.Message = fmt.Sprintf("Peers selecting Namespaces by sameLabels or notSameLabels are not supported, rule(s) not fully enforced: %s", strings.Join(internalNP.UnsupportedRules, ", "))
+		return condition
+	}
+	if internalNP.SpanMeta.NodeNames == nil {
+		condition.Reason = adminPolicyReasonPending
+		condition.Message = "The policy has not been processed yet"
+		return condition
+	}
+	realizationStatus := c.computeRealizationStatus(internalNP)
+	if failureConditions := realizationFailureConditions(realizationStatus.FailedNodes); len(failureConditions) > 0 {
+		// The reasons reported by the Nodes are kept in the message, as they don't necessarily match the format
+		// of the reason of a metav1.Condition.
+		condition.Reason = adminPolicyReasonRealizationFailure
+		condition.Message = failureConditions[0].Message
+		return condition
+	}
+	if realizationStatus.CurrentNodesRealized == realizationStatus.DesiredNodesRealized {
+		condition.Status = v1.ConditionTrue
+		condition.Reason = adminPolicyReasonRealized
+	} else {
+		condition.Reason = adminPolicyReasonRealizing
+	}
+	condition.Message = fmt.Sprintf("Realized on %d/%d Node(s)", realizationStatus.CurrentNodesRealized, realizationStatus.DesiredNodesRealized)
+	return condition
+}
+
+// realizationFailureConditions returns the RealizationFailure condition summarizing the failures of the provided
+// Nodes, or nil if there is no failure. The LastTransitionTime of the condition is set when updating the status.
+func realizationFailureConditions(failedNodes []controlplane.NetworkPolicyNodeStatus) []crdv1alpha1.NetworkPolicyCondition {
+	if len(failedNodes) == 0 {
+		return nil
+	}
+	reason := failedNodes[0].Reason
+	failures := make([]string, 0, maxFailedNodesInMessage)
+	for i, nodeStatus := range failedNodes {
+		if nodeStatus.Reason != reason {
+			reason = multipleFailureReasons
+		}
+		if i < maxFailedNodesInMessage {
+			failures = append(failures, fmt.Sprintf("%s (%s): %s", nodeStatus.NodeName, nodeStatus.Reason, nodeStatus.Message))
+		}
+	}
+	message := fmt.Sprintf("Failed to realize on %d Node(s): %s", len(failedNodes), strings.Join(failures, "; "))
+	if len(failedNodes) > maxFailedNodesInMessage {
+		message += fmt.Sprintf("; and %d more", len(failedNodes)-maxFailedNodesInMessage)
+	}
+	return []crdv1alpha1.NetworkPolicyCondition{
+		{
+			Type:    crdv1alpha1.NetworkPolicyRealizationFailure,
+			Status:  corev1.ConditionTrue,
+			Reason:  reason,
+			Message: message,
+		},
+	}
+}
+
+// ingressToServicesConditions returns the IngressToServicesNotLocal condition listing the Services referred to in
+// ingress toServices whose traffic is not always load-balanced locally, or nil if there is none. The
+// LastTransitionTime of the condition is set when updating the status.
+func ingressToServicesConditions(internalNP *antreatypes.NetworkPolicy) []crdv1alpha1.NetworkPolicyCondition {
+	if len(internalNP.NonLocalIngressServices) == 0 {
+		return nil
+	}
+	return []crdv1alpha1.NetworkPolicyCondition{
+		{
+			Type:    crdv1alpha1.NetworkPolicyIngressToServicesNotLocal,
+			Status:  corev1.ConditionTrue,
+			Reason:  ingressToServicesReasonNotLocal,
+			Message: fmt.Sprintf("The traffic of the following Services load-balanced on other Nodes is not matched by ingress toServices: %s", strings.Join(internalNP.NonLocalIngressServices, "; ")),
+		},
+	}
+}
+
+// setConditionTransitionTimes sets the LastTransitionTime of the provided conditions. It is kept from the existing
+// condition of the same type if the status of the condition didn't change, and set to the current time otherwise.
+func setConditionTransitionTimes(conditions, existingConditions []crdv1alpha1.NetworkPolicyCondition) {
+	now := v1.Now()
+	for i := range conditions {
+		conditions[i].LastTransitionTime = now
+		for _, existing := range existingConditions {
+			if existing.Type == conditions[i].Type && existing.Status == conditions[i].Status {
+				conditions[i].LastTransitionTime = existing.LastTransitionTime
+				break
+			}
+		}
+	}
+}
+
 // networkPolicyControlInterface is an interface that knows how to update Antrea NetworkPolicy status.
 // It's created as an interface to allow testing.
 type networkPolicyControlInterface interface {
 	UpdateAntreaNetworkPolicyStatus(namespace, name string, status *crdv1alpha1.NetworkPolicyStatus) error
 	UpdateAntreaClusterNetworkPolicyStatus(name string, status *crdv1alpha1.NetworkPolicyStatus) error
+	UpdateAdminNetworkPolicyStatus(name string, condition v1.Condition) error
+	UpdateBaselineAdminNetworkPolicyStatus(name string, condition v1.Condition) error
 }
 
 type networkPolicyControl struct {
-	antreaClient antreaclientset.Interface
-	cnpLister    crdlisters.ClusterNetworkPolicyLister
-	anpLister    crdlisters.NetworkPolicyLister
+	antreaClient  antreaclientset.Interface
+	policyClient  policyclientset.Interface
+	cnpLister     crdlisters.ClusterNetworkPolicyLister
+	anpLister     crdlisters.NetworkPolicyLister
+	adminNPLister policylisters.AdminNetworkPolicyLister
+	banpLister    policylisters.BaselineAdminNetworkPolicyLister
 }
 
 func (c *networkPolicyControl) UpdateAntreaNetworkPolicyStatus(namespace, name string, status *crdv1alpha1.NetworkPolicyStatus) error {
@@ -331,7 +571,8 @@ func (c *networkPolicyControl) UpdateAntreaNetworkPolicyStatus(namespace, name s
 		klog.Infof("Didn't find the original Antrea NetworkPolicy %s/%s, skip updating status", namespace, name)
 		return nil
 	}
-	if anp.Status == *status {
+	setConditionTransitionTimes(status.Conditions, anp.Status.Conditions)
+	if reflect.DeepEqual(anp.Status, *status) {
 		return nil
 	}
 
@@ -363,8 +604,9 @@ func (c *networkPolicyControl) UpdateAntreaClusterNetworkPolicyStatus(name strin
 		klog.Infof("Didn't find the original Antrea ClusterNetworkPolicy %s, skip updating status", name)
 		return nil
 	}
+	setConditionTransitionTimes(status.Conditions, cnp.Status.Conditions)
 	// If the current status equals to the desired status, no need to update.
-	if cnp.Status == *status {
+	if reflect.DeepEqual(cnp.Status, *status) {
 		return nil
 	}
 
@@ -389,3 +631,75 @@ func (c *networkPolicyControl) UpdateAntreaClusterNetworkPolicyStatus(name strin
 	metrics.AntreaClusterNetworkPolicyStatusUpdates.Inc()
 	return updateErr
 }
+
+// setAdminPolicyCondition returns the conditions resulting from setting the provided condition in the existing
+// conditions, and whether they differ from the existing ones. The LastTransitionTime of the condition is only
+// updated when its status changes.
+func setAdminPolicyCondition(existingConditions []v1.Condition, condition v1.Condition) ([]v1.Condition, bool) {
+	conditions := make([]v1.Condition, len(existingConditions))
+	copy(conditions, existingConditions)
+	meta.SetStatusCondition(&conditions, condition)
+	return conditions, !reflect.DeepEqual(conditions, existingConditions)
+}
+
+func (c *networkPolicyControl) UpdateAdminNetworkPolicyStatus(name string, condition v1.Condition) error {
+	anp, err := c.adminNPLister.Get(name)
+	if err != nil {
+		klog.InfoS("Didn't find the original AdminNetworkPolicy, skip updating status", "adminNetworkPolicy", name)
+		return nil
+	}
+	if _, changed := setAdminPolicyCondition(anp.Status.Conditions, condition); !changed {
+		return nil
+	}
+
+	toUpdate := anp.DeepCopy()
+
+	var updateErr, getErr error
+	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		toUpdate.Status.Conditions, _ = setAdminPolicyCondition(toUpdate.Status.Conditions, condition)
+		klog.V(2).InfoS("Updating AdminNetworkPolicy", "adminNetworkPolicy", klog.KObj(toUpdate))
+		_, updateErr := c.policyClient.PolicyV1alpha1().AdminNetworkPolicies().UpdateStatus(context.TODO(), toUpdate, v1.UpdateOptions{})
+		if updateErr != nil && errors.IsConflict(updateErr) {
+			if toUpdate, getErr = c.policyClient.PolicyV1alpha1().AdminNetworkPolicies().Get(context.TODO(), name, v1.GetOptions{}); getErr != nil {
+				return getErr
+			}
+		}
+		// Return the error from UPDATE.
+		return updateErr
+	}); err != nil {
+		return err
+	}
+	klog.V(2).InfoS("Updated AdminNetworkPolicy", "adminNetworkPolicy", klog.KObj(toUpdate))
+	return updateErr
+}
+
+func (c *networkPolicyControl) UpdateBaselineAdminNetworkPolicyStatus(name string, condition v1.Condition) error {
+	banp, err := c.banpLister.Get(name)
+	if err != nil {
+		klog.InfoS("Didn't find the original BaselineAdminNetworkPolicy, skip updating status", "baselineAdminNetworkPolicy", name)
+		return nil
+	}
+	if _, changed := setAdminPolicyCondition(banp.Status.Conditions, condition); !changed {
+		return nil
+	}
+
+	toUpdate := banp.DeepCopy()
+
+	var updateErr, getErr error
+	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		toUpdate.Status.Conditions, _ = setAdminPolicyCondition(toUpdate.Status.Conditions, condition)
+		klog.V(2).InfoS("Updating BaselineAdminNetworkPolicy", "baselineAdminNetworkPolicy", klog.KObj(toUpdate))
+		_, updateErr := c.policyClient.PolicyV1alpha1().BaselineAdminNetworkPolicies().UpdateStatus(context.TODO(), toUpdate, v1.UpdateOptions{})
+		if updateErr != nil && errors.IsConflict(updateErr) {
+			if toUpdate, getErr = c.policyClient.PolicyV1alpha1().BaselineAdminNetworkPolicies().Get(context.TODO(), name, v1.GetOptions{}); getErr != nil {
+				return getErr
+			}
+		}
+		// Return the error from UPDATE.
+		return updateErr
+	}); err != nil {
+		return err
+	}
+	klog.V(2).InfoS("Updated BaselineAdminNetworkPolicy", "baselineAdminNetworkPolicy", klog.KObj(toUpdate))
+	return updateErr
+}
@@ -21,6 +21,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -38,8 +40,10 @@ import (
 
 type fakeNetworkPolicyControl struct {
 	sync.Mutex
-	anpStatus *crdv1alpha1.NetworkPolicyStatus
-	cnpStatus *crdv1alpha1.NetworkPolicyStatus
+	anpStatus        *crdv1alpha1.NetworkPolicyStatus
+	cnpStatus        *crdv1alpha1.NetworkPolicyStatus
+	adminNPCondition *v1.Condition
+	banpCondition    *v1.Condition
 }
 
 func (c *fakeNetworkPolicyControl) UpdateAntreaNetworkPolicyStatus(namespace, name string, status *crdv1alpha1.NetworkPolicyStatus) error {
@@ -56,6 +60,20 @@ func (c *fakeNetworkPolicyControl) UpdateAntreaClusterNetworkPolicyStatus(name s
 	return nil
 }
 
+func (c *fakeNetworkPolicyControl) UpdateAdminNetworkPolicyStatus(name string, condition v1.Condition) error {
+	c.Lock()
+	defer c.Unlock()
+	c.adminNPCondition = &condition
+	return nil
+}
+
+func (c *fakeNetworkPolicyControl) UpdateBaselineAdminNetworkPolicyStatus(name string, condition v1.Condition) error {
+	c.Lock()
+	defer c.Unlock()
+	c.banpCondition = &condition
+	return nil
+}
+
 func (c *fakeNetworkPolicyControl) getAntreaNetworkPolicyStatus() *crdv1alpha1.NetworkPolicyStatus {
 	c.Lock()
 	defer c.Unlock()
@@ -111,6 +129,13 @@ func newNetworkPolicyStatus(name string, nodeName string, generation int64) *con
 	}
 }
 
+func newFailedNetworkPolicyStatus(name string, nodeName string, generation int64, reason, message string) *controlplane.NetworkPolicyStatus {
+	status := newNetworkPolicyStatus(name, nodeName, generation)
+	status.Nodes[0].Reason = reason
+	status.Nodes[0].Message = message
+	return status
+}
+
 func toAntreaNetworkPolicy(inp *types.NetworkPolicy) runtime.Object {
 	if inp.SourceRef.Type == controlplane.AntreaNetworkPolicy {
 		return &crdv1alpha1.NetworkPolicy{
@@ -221,6 +246,49 @@ func TestCreateAntreaNetworkPolicy(t *testing.T) {
 				DesiredNodesRealized: 2,
 			},
 		},
+		{
+			name: "failed on some Nodes",
+			networkPolicy: []*types.NetworkPolicy{
+				newInternalNetworkPolicy("anp1", 3, []string{"node1", "node2", "node3"}, newAntreaNetworkPolicyReference("ns1", "anp1")),
+				newInternalNetworkPolicy("cnp1", 4, []string{"node1", "node2"}, newAntreaClusterNetworkPolicyReference("cnp1")),
+			},
+			collectedNetworkPolicyStatus: []*controlplane.NetworkPolicyStatus{
+				newNetworkPolicyStatus("anp1", "node1", 3),
+				newFailedNetworkPolicyStatus("anp1", "node2", 3, controlplane.NetworkPolicyNamedPortUnresolved, "rule1: named port http is not resolved"),
+				newFailedNetworkPolicyStatus("anp1", "node3", 3, controlplane.NetworkPolicyFlowInstallationFailed, "rule1: error adding flows"),
+				newFailedNetworkPolicyStatus("cnp1", "node1", 4, controlplane.NetworkPolicyFQDNResolutionFailed, "rule1: DNS query failed"),
+				// Failures of previous generations are ignored.
+				newFailedNetworkPolicyStatus("cnp1", "node2", 3, controlplane.NetworkPolicyFQDNResolutionFailed, "rule1: DNS query failed"),
+			},
+			expectedANPStatus: &crdv1alpha1.NetworkPolicyStatus{
+				Phase:                crdv1alpha1.NetworkPolicyFailed,
+				ObservedGeneration:   3,
+				CurrentNodesRealized: 1,
+				DesiredNodesRealized: 3,
+				Conditions: []crdv1alpha1.NetworkPolicyCondition{
+					{
+						Type:    crdv1alpha1.NetworkPolicyRealizationFailure,
+						Status:  corev1.ConditionTrue,
+						Reason:  multipleFailureReasons,
+						Message: "Failed to realize on 2 Node(s): node2 (NamedPortUnresolved): rule1: named port http is not resolved; node3 (FlowInstallationFailed): rule1: error adding flows",
+					},
+				},
+			},
+			expectedCNPStatus: &crdv1alpha1.NetworkPolicyStatus{
+				Phase:                crdv1alpha1.NetworkPolicyFailed,
+				ObservedGeneration:   4,
+				CurrentNodesRealized: 0,
+				DesiredNodesRealized: 2,
+				Conditions: []crdv1alpha1.NetworkPolicyCondition{
+					{
+						Type:    crdv1alpha1.NetworkPolicyRealizationFailure,
+						Status:  corev1.ConditionTrue,
+						Reason:  controlplane.NetworkPolicyFQDNResolutionFailed,
+						Message: "Failed to realize on 1 Node(s): node1 (FQDNResolutionFailed): rule1: DNS query failed",
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -325,6 +393,218 @@ func TestDeleteAntreaNetworkPolicy(t *testing.T) {
 	assert.Empty(t, statusController.getNodeStatuses(initialNetworkPolicy.Name))
 }
 
+func TestGetRealizationStatus(t *testing.T) {
+	anp1 := newInternalNetworkPolicy("anp1", 2, []string{"node1", "node2", "node3"}, newAntreaNetworkPolicyReference("ns1", "anp1"))
+	knp1 := newInternalNetworkPolicy("knp1", 1, []string{"node1"}, &controlplane.NetworkPolicyReference{
+		Type:      controlplane.K8sNetworkPolicy,
+		Namespace: "ns1",
+		Name:      "knp1",
+	})
+	statusController, _, _, networkPolicyStore, _ := newTestStatusController()
+	networkPolicyStore.Create(anp1)
+	statusController.UpdateStatus(newNetworkPolicyStatus("anp1", "node1", 2))
+	statusController.UpdateStatus(newFailedNetworkPolicyStatus("anp1", "node3", 2, controlplane.NetworkPolicyFlowInstallationFailed, "rule1: error adding flows"))
+	statusController.UpdateStatus(newFailedNetworkPolicyStatus("anp1", "node2", 2, controlplane.NetworkPolicyNamedPortUnresolved, "rule1: named port http is not resolved"))
+	// node4 is not in the span of the policy, its status is ignored.
+	statusController.UpdateStatus(newNetworkPolicyStatus("anp1", "node4", 2))
+
+	expectedStatus := &controlplane.NetworkPolicyRealizationStatus{
+		CurrentNodesRealized: 1,
+		DesiredNodesRealized: 3,
+		FailedNodes: []controlplane.NetworkPolicyNodeStatus{
+			{NodeName: "node2", Generation: 2, Reason: controlplane.NetworkPolicyNamedPortUnresolved, Message: "rule1: named port http is not resolved"},
+			{NodeName: "node3", Generation: 2, Reason: controlplane.NetworkPolicyFlowInstallationFailed, Message: "rule1: error adding flows"},
+		},
+	}
+	assert.Equal(t, expectedStatus, statusController.GetRealizationStatus(anp1))
+	assert.Nil(t, statusController.GetRealizationStatus(knp1))
+	// Getting the realization status must not delete the stale statuses, syncHandler does.
+	assert.Len(t, statusController.getNodeStatuses("anp1"), 4)
+	require.NoError(t, statusController.syncHandler("anp1"))
+	assert.Len(t, statusController.getNodeStatuses("anp1"), 3)
+	assert.Equal(t, expectedStatus, statusController.GetRealizationStatus(anp1))
+}
+
+func TestRealizationFailureConditions(t *testing.T) {
+	assert.Nil(t, realizationFailureConditions(nil))
+
+	var failedNodes []controlplane.NetworkPolicyNodeStatus
+	for i := 0; i < maxFailedNodesInMessage+2; i++ {
+		failedNodes = append(failedNodes, controlplane.NetworkPolicyNodeStatus{
+			NodeName: fmt.Sprintf("node%02d", i),
+			Reason:   controlplane.NetworkPolicyFlowInstallationFailed,
+			Message:  "error",
+		})
+	}
+	conditions := realizationFailureConditions(failedNodes)
+	assert.Len(t, conditions, 1)
+	assert.Equal(t, controlplane.NetworkPolicyFlowInstallationFailed, conditions[0].Reason)
+	assert.Equal(t, "Failed to realize on 12 Node(s): node00 (FlowInstallationFailed): error; node01 (FlowInstallationFailed): error; "+
+		"node02 (FlowInstallationFailed): error; node03 (FlowInstallationFailed): error; node04 (FlowInstallationFailed): error; "+
+		"node05 (FlowInstallationFailed): error; node06 (FlowInstallationFailed): error; node07 (FlowInstallationFailed): error; "+
+		"node08 (FlowInstallationFailed): error; node09 (FlowInstallationFailed): error; and 2 more", conditions[0].Message)
+}
+
+func TestIngressToServicesConditions(t *testing.T) {
+	assert.Nil(t, ingressToServicesConditions(&types.NetworkPolicy{}))
+
+	conditions := ingressToServicesConditions(&types.NetworkPolicy{
+		NonLocalIngressServices: []string{"ns1/svc1: Service not found", "ns1/svc2: internalTrafficPolicy is not Local"},
+	})
+	assert.Equal(t, []crdv1alpha1.NetworkPolicyCondition{
+		{
+			Type:    crdv1alpha1.NetworkPolicyIngressToServicesNotLocal,
+			Status:  corev1.ConditionTrue,
+			Reason:  ingressToServicesReasonNotLocal,
+			Message: "The traffic of the following Services load-balanced on other Nodes is not matched by ingress toServices: ns1/svc1: Service not found; ns1/svc2: internalTrafficPolicy is not Local",
+		},
+	}, conditions)
+}
+
+func TestSetConditionTransitionTimes(t *testing.T) {
+	lastTransitionTime := v1.NewTime(time.Now().Add(-time.Hour))
+	existingConditions := []crdv1alpha1.NetworkPolicyCondition{
+		{Type: crdv1alpha1.NetworkPolicyRealizationFailure, Status: corev1.ConditionTrue, LastTransitionTime: lastTransitionTime, Reason: "foo"},
+	}
+	conditions := []crdv1alpha1.NetworkPolicyCondition{
+		{Type: crdv1alpha1.NetworkPolicyRealizationFailure, Status: corev1.ConditionTrue, Reason: "bar"},
+	}
+	setConditionTransitionTimes(conditions, existingConditions)
+	assert.Equal(t, lastTransitionTime, conditions[0].LastTransitionTime)
+
+	setConditionTransitionTimes(conditions, nil)
+	assert.True(t, conditions[0].LastTransitionTime.After(lastTransitionTime.Time))
+}
+
+func TestAdminPolicyStatus(t *testing.T) {
+	adminNPRef := &controlplane.NetworkPolicyReference{Type: controlplane.AdminNetworkPolicy, Name: "anp1"}
+	banpRef := &controlplane.NetworkPolicyReference{Type: controlplane.BaselineAdminNetworkPolicy, Name: "default"}
+	unprocessedPolicy := newInternalNetworkPolicy("anp1", 1, nil, adminNPRef)
+	unprocessedPolicy.NodeNames = nil
+	unsupportedPolicy := newInternalNetworkPolicy("anp1", 1, []string{"node1"}, adminNPRef)
+	unsupportedPolicy.UnsupportedRules = []string{"allow-same-tenant"}
+	tests := []struct {
+		name                         string
+		networkPolicy                *types.NetworkPolicy
+		collectedNetworkPolicyStatus []*controlplane.NetworkPolicyStatus
+		expectedCondition            v1.Condition
+	}{
+		{
+			name:          "pending",
+			networkPolicy: unprocessedPolicy,
+			expectedCondition: v1.Condition{
+				Type:               adminPolicyRealizedCondition,
+				Status:             v1.ConditionFalse,
+				ObservedGeneration: 1,
+				Reason:             adminPolicyReasonPending,
+				Message:            "The policy has not been processed yet",
+			},
+		},
+		{
+			name:          "realizing",
+			networkPolicy: newInternalNetworkPolicy("anp1", 2, []string{"node1", "node2"}, adminNPRef),
+			collectedNetworkPolicyStatus: []*controlplane.NetworkPolicyStatus{
+				newNetworkPolicyStatus("anp1", "node1", 2),
+				newNetworkPolicyStatus("anp1", "node2", 1),
+			},
+			expectedCondition: v1.Condition{
+				Type:               adminPolicyRealizedCondition,
+				Status:             v1.ConditionFalse,
+				ObservedGeneration: 2,
+				Reason:             adminPolicyReasonRealizing,
+				Message:            "Realized on 1/2 Node(s)",
+			},
+		},
+		{
+			name:          "realized",
+			networkPolicy: newInternalNetworkPolicy("default", 1, []string{"node1", "node2"}, banpRef),
+			collectedNetworkPolicyStatus: []*controlplane.NetworkPolicyStatus{
+				newNetworkPolicyStatus("default", "node1", 1),
+				newNetworkPolicyStatus("default", "node2", 1),
+			},
+			expectedCondition: v1.Condition{
+				Type:               adminPolicyRealizedCondition,
+				Status:             v1.ConditionTrue,
+				ObservedGeneration: 1,
+				Reason:             adminPolicyReasonRealized,
+				Message:            "Realized on 2/2 Node(s)",
+			},
+		},
+		{
+			name:          "failed",
+			networkPolicy: newInternalNetworkPolicy("anp1", 1, []string{"node1", "node2"}, adminNPRef),
+			collectedNetworkPolicyStatus: []*controlplane.NetworkPolicyStatus{
+				newNetworkPolicyStatus("anp1", "node1", 1),
+				newFailedNetworkPolicyStatus("anp1", "node2", 1, controlplane.NetworkPolicyFlowInstallationFailed, "error"),
+			},
+			expectedCondition: v1.Condition{
+				Type:               adminPolicyRealizedCondition,
+				Status:             v1.ConditionFalse,
+				ObservedGeneration: 1,
+				Reason:             adminPolicyReasonRealizationFailure,
+				Message:            "Failed to realize on 1 Node(s): node2 (FlowInstallationFailed): error",
+			},
+		},
+		{
+			name:          "unsupported peers",
+			networkPolicy: unsupportedPolicy,
+			collectedNetworkPolicyStatus: []*controlplane.NetworkPolicyStatus{
+				newNetworkPolicyStatus("anp1", "node1", 1),
+			},
+			expectedCondition: v1.Condition{
+				Type:               adminPolicyRealizedCondition,
+				Status:             v1.ConditionFalse,
+				ObservedGeneration: 1,
+				Reason:             adminPolicyReasonUnsupportedPeers,
+				Message:            "Peers selecting Namespaces by sameLabels or notSameLabels are not supported, rule(s) not fully enforced: allow-same-tenant",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statusController, _, _, networkPolicyStore, networkPolicyControl := newTestStatusController()
+			networkPolicyStore.Create(tt.networkPolicy)
+			for _, status := range tt.collectedNetworkPolicyStatus {
+				statusController.UpdateStatus(status)
+			}
+			require.NoError(t, statusController.syncHandler(tt.networkPolicy.Name))
+			networkPolicyControl.Lock()
+			defer networkPolicyControl.Unlock()
+			if tt.networkPolicy.SourceRef.Type == controlplane.AdminNetworkPolicy {
+				assert.Nil(t, networkPolicyControl.banpCondition)
+				assert.Equal(t, &tt.expectedCondition, networkPolicyControl.adminNPCondition)
+			} else {
+				assert.Nil(t, networkPolicyControl.adminNPCondition)
+				assert.Equal(t, &tt.expectedCondition, networkPolicyControl.banpCondition)
+			}
+			assert.Nil(t, networkPolicyControl.anpStatus)
+			assert.Nil(t, networkPolicyControl.cnpStatus)
+		})
+	}
+}
+
+func TestSetAdminPolicyCondition(t *testing.T) {
+	lastTransitionTime := v1.NewTime(time.Now().Add(-time.Hour))
+	existingConditions := []v1.Condition{
+		{Type: adminPolicyRealizedCondition, Status: v1.ConditionFalse, LastTransitionTime: lastTransitionTime, Reason: adminPolicyReasonPending},
+	}
+	condition := v1.Condition{Type: adminPolicyRealizedCondition, Status: v1.ConditionFalse, Reason: adminPolicyReasonRealizing}
+	conditions, changed := setAdminPolicyCondition(existingConditions, condition)
+	assert.True(t, changed)
+	assert.Equal(t, lastTransitionTime, conditions[0].LastTransitionTime)
+	assert.Equal(t, adminPolicyReasonRealizing, conditions[0].Reason)
+	// The existing conditions are not modified.
+	assert.Equal(t, adminPolicyReasonPending, existingConditions[0].Reason)
+
+	_, changed = setAdminPolicyCondition(conditions, condition)
+	assert.False(t, changed)
+
+	condition.Status = v1.ConditionTrue
+	conditions, changed = setAdminPolicyCondition(conditions, condition)
+	assert.True(t, changed)
+	assert.True(t, conditions[0].LastTransitionTime.After(lastTransitionTime.Time))
+}
+
 // BenchmarkSyncHandler benchmarks syncHandler when the policy spans 1000 Nodes. Its current result is:
 // 70024 ns/op            8338 B/op          8 allocs/op
 func BenchmarkSyncHandler(b *testing.B) {
@@ -26,6 +26,7 @@ import (
 	"antrea.io/antrea/pkg/apiserver/storage"
 	"antrea.io/antrea/pkg/apiserver/storage/ram"
 	"antrea.io/antrea/pkg/controller/types"
+	"antrea.io/antrea/pkg/util/k8s"
 )
 
 const (
@@ -33,6 +34,7 @@ const (
 	AddressGroupIndex     = "addressGroup"
 	PerNamespaceRuleIndex = "hasPerNamespaceRule"
 	HasPerNamespaceRule   = "true"
+	IngressServiceIndex   = "ingressService"
 )
 
 // networkPolicyEvent implements storage.InternalEvent.
@@ -177,6 +179,24 @@ func NewNetworkPolicyStore() storage.Interface {
 			}
 			return []string{}, nil
 		},
+		// IngressServiceIndex indexes the NetworkPolicies by the Services
+		// referred to in ingress toServices, as "namespace/name".
+		IngressServiceIndex: func(obj interface{}) ([]string, error) {
+			fp, ok := obj.(*types.NetworkPolicy)
+			if !ok {
+				return []string{}, nil
+			}
+			var services []string
+			for _, rule := range fp.Rules {
+				if rule.Direction != controlplane.DirectionIn {
+					continue
+				}
+				for _, svcRef := range rule.To.ToServices {
+					services = append(services, k8s.NamespacedName(svcRef.Namespace, svcRef.Name))
+				}
+			}
+			return services, nil
+		},
 	}
 	return ram.NewStore(NetworkPolicyKeyFunc, indexers, genNetworkPolicyEvent, keyAndSpanSelectFunc, func() runtime.Object { return new(controlplane.NetworkPolicy) })
 }
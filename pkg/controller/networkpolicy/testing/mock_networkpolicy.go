@@ -14,7 +14,7 @@
 //
 
 // Code generated by MockGen. DO NOT EDIT.
-// Source: antrea.io/antrea/pkg/controller/networkpolicy (interfaces: EndpointQuerier)
+// Source: antrea.io/antrea/pkg/controller/networkpolicy (interfaces: EndpointQuerier,PolicyVerdictQuerier)
 
 // Package testing is a generated GoMock package.
 package testing
@@ -62,3 +62,41 @@ func (mr *MockEndpointQuerierMockRecorder) QueryNetworkPolicies(arg0, arg1 inter
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryNetworkPolicies", reflect.TypeOf((*MockEndpointQuerier)(nil).QueryNetworkPolicies), arg0, arg1)
 }
+
+// MockPolicyVerdictQuerier is a mock of PolicyVerdictQuerier interface
+type MockPolicyVerdictQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockPolicyVerdictQuerierMockRecorder
+}
+
+// MockPolicyVerdictQuerierMockRecorder is the mock recorder for MockPolicyVerdictQuerier
+type MockPolicyVerdictQuerierMockRecorder struct {
+	mock *MockPolicyVerdictQuerier
+}
+
+// NewMockPolicyVerdictQuerier creates a new mock instance
+func NewMockPolicyVerdictQuerier(ctrl *gomock.Controller) *MockPolicyVerdictQuerier {
+	mock := &MockPolicyVerdictQuerier{ctrl: ctrl}
+	mock.recorder = &MockPolicyVerdictQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockPolicyVerdictQuerier) EXPECT() *MockPolicyVerdictQuerierMockRecorder {
+	return m.recorder
+}
+
+// QueryPolicyVerdict mocks base method
+func (m *MockPolicyVerdictQuerier) QueryPolicyVerdict(arg0 *networkpolicy.PolicyVerdictQuery) (*networkpolicy.PolicyVerdictResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryPolicyVerdict", arg0)
+	ret0, _ := ret[0].(*networkpolicy.PolicyVerdictResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryPolicyVerdict indicates an expected call of QueryPolicyVerdict
+func (mr *MockPolicyVerdictQuerierMockRecorder) QueryPolicyVerdict(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryPolicyVerdict", reflect.TypeOf((*MockPolicyVerdictQuerier)(nil).QueryPolicyVerdict), arg0)
+}
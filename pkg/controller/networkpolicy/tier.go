@@ -36,6 +36,10 @@ var (
 	// DefaultTierPriority maintains the priority for the system generated default Tier.
 	// This is the lowest priority for tiers that will be enforced before K8s NetworkPolicies.
 	DefaultTierPriority = int32(250)
+	// AdminNetworkPolicyTierPriority maintains the priority of the Tier in which AdminNetworkPolicies
+	// are enforced. It is reserved, so that AdminNetworkPolicies are enforced after all the Antrea
+	// Tiers and before K8s NetworkPolicies.
+	AdminNetworkPolicyTierPriority = int32(251)
 	// BaselineTierPriority maintains the priority for the system generated baseline Tier.
 	// This is the tier that will be enforced after K8s NetworkPolicies.
 	BaselineTierPriority = int32(253)
@@ -74,7 +74,7 @@ var (
 	// reservedTierPriorities stores the reserved priority range from 251, 252, 254 and 255.
 	// The priority 250 is reserved for default Tier but not part of this set in order to be
 	// able to create the Tier by Antrea. Same for priority 253 which is reserved for the
-	// baseline tier.
+	// baseline tier. The priority 251 is used by the Tier in which AdminNetworkPolicies are enforced.
 	reservedTierPriorities = sets.NewInt32(int32(251), int32(252), int32(254), int32(255))
 	// reservedTierNames stores the set of Tier names which cannot be deleted
 	// since they are created by Antrea.
@@ -292,6 +292,56 @@ func (v *antreaPolicyValidator) validatePort(ingress, egress []crdv1alpha1.Rule)
 	return nil
 }
 
+// validateSchedule validates if the schedules of rules are valid.
+func (v *antreaPolicyValidator) validateSchedule(ingress, egress []crdv1alpha1.Rule) error {
+	isValid := func(rules []crdv1alpha1.Rule) error {
+		for _, rule := range rules {
+			if rule.Schedule == nil {
+				continue
+			}
+			if err := validateRuleSchedule(rule.Schedule); err != nil {
+				return fmt.Errorf("invalid schedule for rule %s: %v", rule.Name, err)
+			}
+		}
+		return nil
+	}
+	if err := isValid(ingress); err != nil {
+		return err
+	}
+	return isValid(egress)
+}
+
+// validateRateLimit validates that the rateLimit field is set if and only if the
+// action of a rule is RateLimit, and that exactly one positive rate is specified.
+func (v *antreaPolicyValidator) validateRateLimit(ingress, egress []crdv1alpha1.Rule) error {
+	isValid := func(rules []crdv1alpha1.Rule) error {
+		for _, rule := range rules {
+			isRateLimitAction := rule.Action != nil && *rule.Action == crdv1alpha1.RuleActionRateLimit
+			if !isRateLimitAction {
+				if rule.RateLimit != nil {
+					return fmt.Errorf("`rateLimit` can only be specified for rules with `RateLimit` action")
+				}
+				continue
+			}
+			if rule.RateLimit == nil {
+				return fmt.Errorf("`rateLimit` must be specified for rules with `RateLimit` action")
+			}
+			pps, bps := rule.RateLimit.PacketsPerSecond, rule.RateLimit.BitsPerSecond
+			if (pps == nil) == (bps == nil) {
+				return fmt.Errorf("exactly one of `packetsPerSecond` and `bitsPerSecond` must be specified in `rateLimit`")
+			}
+			if (pps != nil && *pps <= 0) || (bps != nil && *bps <= 0) {
+				return fmt.Errorf("the rate specified in `rateLimit` must be positive")
+			}
+		}
+		return nil
+	}
+	if err := isValid(ingress); err != nil {
+		return err
+	}
+	return isValid(egress)
+}
+
 // validateAntreaGroup validates the admission of a ClusterGroup resource
 func (v *NetworkPolicyValidator) validateAntreaGroup(curCG, oldCG *crdv1alpha2.ClusterGroup, op admv1.Operation, userInfo authenticationv1.UserInfo) (string, bool) {
 	allowed := true
@@ -430,6 +480,12 @@ func (v *antreaPolicyValidator) createValidate(curObj interface{}, userInfo auth
 	if err := v.validatePort(ingress, egress); err != nil {
 		return err.Error(), false
 	}
+	if err := v.validateSchedule(ingress, egress); err != nil {
+		return err.Error(), false
+	}
+	if err := v.validateRateLimit(ingress, egress); err != nil {
+		return err.Error(), false
+	}
 	return "", true
 }
 
@@ -533,6 +589,14 @@ func (v *antreaPolicyValidator) validatePeers(ingress, egress []crdv1alpha1.Rule
 		return "", true
 	}
 	for _, rule := range ingress {
+		if rule.ToServices != nil {
+			if !features.DefaultFeatureGate.Enabled(features.AntreaProxy) {
+				return fmt.Sprintf("`toServices` can only be used when AntreaProxy is enabled"), false
+			}
+			if (rule.From != nil && len(rule.From) > 0) || rule.Ports != nil || rule.Protocols != nil {
+				return fmt.Sprintf("`toServices` can't be used with `from`, `ports` or `protocols` in ingress rules"), false
+			}
+		}
 		msg, isValid := checkPeers(rule.From)
 		if !isValid {
 			return msg, false
@@ -646,8 +710,8 @@ func (v *antreaPolicyValidator) validateEgressMulticastAddress(egressRule []crdv
 				to.ExternalEntitySelector != nil || to.ServiceAccount != nil || to.NodeSelector != nil {
 				otherSelectors = true
 			}
-			if multicast && (*r.Action == crdv1alpha1.RuleActionPass || *r.Action == crdv1alpha1.RuleActionReject) {
-				return fmt.Sprintf("multicast does not support action Pass or Reject"), false
+			if multicast && (*r.Action == crdv1alpha1.RuleActionPass || *r.Action == crdv1alpha1.RuleActionReject || *r.Action == crdv1alpha1.RuleActionRateLimit) {
+				return fmt.Sprintf("multicast does not support action Pass, Reject or RateLimit"), false
 			}
 		}
 		if multicast && unicast {
@@ -683,8 +747,8 @@ func (v *antreaPolicyValidator) validateMulticastIGMP(ingressRules, egressRules
 				if !allowed {
 					return reason, allowed
 				}
-				if *r.Action == crdv1alpha1.RuleActionPass || *r.Action == crdv1alpha1.RuleActionReject {
-					return "protocol IGMP does not support Pass or Reject", false
+				if *r.Action == crdv1alpha1.RuleActionPass || *r.Action == crdv1alpha1.RuleActionReject || *r.Action == crdv1alpha1.RuleActionRateLimit {
+					return "protocol IGMP does not support Pass, Reject or RateLimit", false
 				}
 			}
 			if protocol.ICMP != nil {
@@ -755,6 +819,12 @@ func (v *antreaPolicyValidator) updateValidate(curObj, oldObj interface{}, userI
 	if err := v.validatePort(ingress, egress); err != nil {
 		return err.Error(), false
 	}
+	if err := v.validateSchedule(ingress, egress); err != nil {
+		return err.Error(), false
+	}
+	if err := v.validateRateLimit(ingress, egress); err != nil {
+		return err.Error(), false
+	}
 	reason, allowed = v.validateTierForPassAction(tier, ingress, egress)
 	if !allowed {
 		return reason, allowed
@@ -29,7 +29,10 @@ import (
 func TestValidateAntreaPolicy(t *testing.T) {
 	allowAction := crdv1alpha1.RuleActionAllow
 	passAction := crdv1alpha1.RuleActionPass
+	rateLimitAction := crdv1alpha1.RuleActionRateLimit
 	int32For80 := int32(80)
+	pps := int32(100)
+	bps := int64(1000000)
 
 	tests := []struct {
 		name           string
@@ -804,6 +807,105 @@ func TestValidateAntreaPolicy(t *testing.T) {
 			},
 			expectedReason: "",
 		},
+		{
+			name: "acnp-ingress-toservice-set-with-from",
+			policy: &crdv1alpha1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-ingress-toservice-set-with-from",
+				},
+				Spec: crdv1alpha1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1alpha1.NetworkPolicyPeer{
+						{
+							NamespaceSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1alpha1.Rule{
+						{
+							Action: &allowAction,
+							From: []crdv1alpha1.NetworkPolicyPeer{
+								{
+									NamespaceSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"foo2": "bar2"},
+									},
+								},
+							},
+							ToServices: []crdv1alpha1.NamespacedName{
+								{
+									Name:      "foo",
+									Namespace: "bar",
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedReason: "`toServices` can't be used with `from`, `ports` or `protocols` in ingress rules",
+		},
+		{
+			name: "acnp-ingress-toservice-set-with-ports",
+			policy: &crdv1alpha1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-ingress-toservice-set-with-ports",
+				},
+				Spec: crdv1alpha1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1alpha1.NetworkPolicyPeer{
+						{
+							NamespaceSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1alpha1.Rule{
+						{
+							Action: &allowAction,
+							Ports: []crdv1alpha1.NetworkPolicyPort{
+								{
+									Port: &int80,
+								},
+							},
+							ToServices: []crdv1alpha1.NamespacedName{
+								{
+									Name:      "foo",
+									Namespace: "bar",
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedReason: "`toServices` can't be used with `from`, `ports` or `protocols` in ingress rules",
+		},
+		{
+			name: "acnp-ingress-toservice-alone",
+			policy: &crdv1alpha1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-ingress-toservice-alone",
+				},
+				Spec: crdv1alpha1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1alpha1.NetworkPolicyPeer{
+						{
+							NamespaceSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1alpha1.Rule{
+						{
+							Action: &allowAction,
+							ToServices: []crdv1alpha1.NamespacedName{
+								{
+									Name:      "foo",
+									Namespace: "bar",
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedReason: "",
+		},
 		{
 			name: "acnp-invalid-fqdn",
 			policy: &crdv1alpha1.ClusterNetworkPolicy{
@@ -917,6 +1019,194 @@ func TestValidateAntreaPolicy(t *testing.T) {
 			},
 			expectedReason: "`endPort` should be greater than or equal to `port`",
 		},
+		{
+			name: "acnp-invalid-schedule-time",
+			policy: &crdv1alpha1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-invalid-schedule-time",
+				},
+				Spec: crdv1alpha1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1alpha1.NetworkPolicyPeer{
+						{
+							NamespaceSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1alpha1.Rule{
+						{
+							Name:   "rule1",
+							Action: &allowAction,
+							Schedule: &crdv1alpha1.RuleSchedule{
+								Windows: []crdv1alpha1.TimeWindow{
+									{Start: "9:00am", End: "17:00"},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedReason: "invalid schedule for rule rule1: invalid time of day \"9:00am\", must be in HH:MM format",
+		},
+		{
+			name: "acnp-invalid-schedule-day",
+			policy: &crdv1alpha1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-invalid-schedule-day",
+				},
+				Spec: crdv1alpha1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1alpha1.NetworkPolicyPeer{
+						{
+							NamespaceSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Egress: []crdv1alpha1.Rule{
+						{
+							Name:   "rule1",
+							Action: &allowAction,
+							Schedule: &crdv1alpha1.RuleSchedule{
+								Windows: []crdv1alpha1.TimeWindow{
+									{Start: "09:00", End: "17:00", Days: []crdv1alpha1.Weekday{"Monday"}},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedReason: "invalid schedule for rule rule1: invalid day \"Monday\", must be one of Mon, Tue, Wed, Thu, Fri, Sat, Sun",
+		},
+		{
+			name: "acnp-valid-schedule",
+			policy: &crdv1alpha1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-valid-schedule",
+				},
+				Spec: crdv1alpha1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1alpha1.NetworkPolicyPeer{
+						{
+							NamespaceSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1alpha1.Rule{
+						{
+							Name:   "rule1",
+							Action: &allowAction,
+							Schedule: &crdv1alpha1.RuleSchedule{
+								TimeZone: "UTC",
+								Windows: []crdv1alpha1.TimeWindow{
+									{Start: "22:00", End: "06:00", Days: []crdv1alpha1.Weekday{crdv1alpha1.Saturday, crdv1alpha1.Sunday}},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedReason: "",
+		},
+		{
+			name: "acnp-rate-limit-without-rate",
+			policy: &crdv1alpha1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-rate-limit-without-rate",
+				},
+				Spec: crdv1alpha1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1alpha1.NetworkPolicyPeer{
+						{
+							NamespaceSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1alpha1.Rule{
+						{
+							Name:      "rule1",
+							Action:    &rateLimitAction,
+							RateLimit: nil,
+						},
+					},
+				},
+			},
+			expectedReason: "`rateLimit` must be specified for rules with `RateLimit` action",
+		},
+		{
+			name: "acnp-rate-limit-with-allow-action",
+			policy: &crdv1alpha1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-rate-limit-with-allow-action",
+				},
+				Spec: crdv1alpha1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1alpha1.NetworkPolicyPeer{
+						{
+							NamespaceSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1alpha1.Rule{
+						{
+							Name:      "rule1",
+							Action:    &allowAction,
+							RateLimit: &crdv1alpha1.RateLimit{PacketsPerSecond: &pps},
+						},
+					},
+				},
+			},
+			expectedReason: "`rateLimit` can only be specified for rules with `RateLimit` action",
+		},
+		{
+			name: "acnp-rate-limit-with-both-rates",
+			policy: &crdv1alpha1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-rate-limit-with-both-rates",
+				},
+				Spec: crdv1alpha1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1alpha1.NetworkPolicyPeer{
+						{
+							NamespaceSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1alpha1.Rule{
+						{
+							Name:      "rule1",
+							Action:    &rateLimitAction,
+							RateLimit: &crdv1alpha1.RateLimit{PacketsPerSecond: &pps, BitsPerSecond: &bps},
+						},
+					},
+				},
+			},
+			expectedReason: "exactly one of `packetsPerSecond` and `bitsPerSecond` must be specified in `rateLimit`",
+		},
+		{
+			name: "acnp-valid-rate-limit",
+			policy: &crdv1alpha1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-valid-rate-limit",
+				},
+				Spec: crdv1alpha1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1alpha1.NetworkPolicyPeer{
+						{
+							NamespaceSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1alpha1.Rule{
+						{
+							Name:      "rule1",
+							Action:    &rateLimitAction,
+							RateLimit: &crdv1alpha1.RateLimit{BitsPerSecond: &bps},
+						},
+					},
+				},
+			},
+			expectedReason: "",
+		},
 		{
 			name: "acnp-named-port-with-endport-in-ports",
 			policy: &crdv1alpha1.ClusterNetworkPolicy{
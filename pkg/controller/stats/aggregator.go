@@ -19,11 +19,14 @@ import (
 	"sync"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	coreinformers "k8s.io/client-go/informers/core/v1"
 	networkinginformers "k8s.io/client-go/informers/networking/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
 
@@ -47,6 +50,8 @@ const (
 // - pkg/apiserver/registry/stats/antreaclusternetworkpolicystats.statsProvider
 // - pkg/apiserver/registry/stats/antreanetworkpolicystats.statsProvider
 // - pkg/apiserver/registry/stats/multicastgroup.statsProvider
+// - pkg/apiserver/registry/stats/podtrafficstats.statsProvider
+// - pkg/apiserver/registry/stats/namespacetrafficstats.statsProvider
 type Aggregator struct {
 	// networkPolicyStats caches the statistics of K8s NetworkPolicies collected from the antrea-agents.
 	networkPolicyStats cache.Indexer
@@ -59,6 +64,10 @@ type Aggregator struct {
 	// map[IP of multicast group]map[name of node]list of PodReference.
 	groupNodePodsMap      map[string]map[string][]statsv1alpha1.PodReference
 	groupNodePodsMapMutex sync.RWMutex
+	// podTrafficStats caches the traffic statistics of Pods collected from the antrea-agents.
+	podTrafficStats cache.Indexer
+	// namespaceTrafficStats caches the traffic statistics of Namespaces aggregated from the statistics of their Pods.
+	namespaceTrafficStats cache.Indexer
 	// dataCh is the channel that buffers the NodeSummaries sent by antrea-agents.
 	dataCh chan *controlplane.NodeStatsSummary
 	// npListerSynced is a function which returns true if the K8s NetworkPolicy shared informer has been synced at least once.
@@ -67,6 +76,14 @@ type Aggregator struct {
 	cnpListerSynced cache.InformerSynced
 	// anpListerSynced is a function which returns true if the Antrea NetworkPolicy shared informer has been synced at least once.
 	anpListerSynced cache.InformerSynced
+	// podLister is used to check whether the Pods reported by the antrea-agents still exist.
+	podLister corelisters.PodLister
+	// podListerSynced is a function which returns true if the Pod shared informer has been synced at least once.
+	podListerSynced cache.InformerSynced
+	// namespaceLister is used to check whether the Namespaces of the reported Pods still exist.
+	namespaceLister corelisters.NamespaceLister
+	// namespaceListerSynced is a function which returns true if the Namespace shared informer has been synced at least once.
+	namespaceListerSynced cache.InformerSynced
 }
 
 // uidIndexFunc is an index function that indexes based on an object's UID.
@@ -78,7 +95,7 @@ func uidIndexFunc(obj interface{}) ([]string, error) {
 	return []string{string(meta.GetUID())}, nil
 }
 
-func NewAggregator(networkPolicyInformer networkinginformers.NetworkPolicyInformer, cnpInformer crdinformers.ClusterNetworkPolicyInformer, anpInformer crdinformers.NetworkPolicyInformer) *Aggregator {
+func NewAggregator(networkPolicyInformer networkinginformers.NetworkPolicyInformer, cnpInformer crdinformers.ClusterNetworkPolicyInformer, anpInformer crdinformers.NetworkPolicyInformer, podInformer coreinformers.PodInformer, namespaceInformer coreinformers.NamespaceInformer) *Aggregator {
 	aggregator := &Aggregator{
 		networkPolicyStats: cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc, uidIndex: uidIndexFunc}),
 		dataCh:             make(chan *controlplane.NodeStatsSummary, 1000),
@@ -124,6 +141,31 @@ func NewAggregator(networkPolicyInformer networkinginformers.NetworkPolicyInform
 	if features.DefaultFeatureGate.Enabled(features.Multicast) {
 		aggregator.groupNodePodsMap = make(map[string]map[string][]statsv1alpha1.PodReference)
 	}
+	// Add handlers for Pod and Namespace events only if the feature is enabled.
+	// They are the source of truth of the PodTrafficStats and NamespaceTrafficStats, i.e., the stats are created when
+	// the first report of a Pod arrives, and are removed when the corresponding Pod or Namespace is removed.
+	if features.DefaultFeatureGate.Enabled(features.PodTrafficStats) {
+		aggregator.podTrafficStats = cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+		aggregator.namespaceTrafficStats = cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+		aggregator.podLister = podInformer.Lister()
+		aggregator.podListerSynced = podInformer.Informer().HasSynced
+		podInformer.Informer().AddEventHandlerWithResyncPeriod(
+			cache.ResourceEventHandlerFuncs{
+				DeleteFunc: aggregator.deletePod,
+			},
+			// Set resyncPeriod to 0 to disable resyncing.
+			0,
+		)
+		aggregator.namespaceLister = namespaceInformer.Lister()
+		aggregator.namespaceListerSynced = namespaceInformer.Informer().HasSynced
+		namespaceInformer.Informer().AddEventHandlerWithResyncPeriod(
+			cache.ResourceEventHandlerFuncs{
+				DeleteFunc: aggregator.deleteNamespace,
+			},
+			// Set resyncPeriod to 0 to disable resyncing.
+			0,
+		)
+	}
 	return aggregator
 }
 
@@ -248,6 +290,53 @@ func (a *Aggregator) deleteANP(obj interface{}) {
 	a.antreaNetworkPolicyStats.Delete(stats)
 }
 
+// deletePod handles Pod DELETE events and deletes corresponding PodTrafficStats objects.
+func (a *Aggregator) deletePod(obj interface{}) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			klog.Errorf("Error decoding object when deleting Pod, invalid type: %v", obj)
+			return
+		}
+		pod, ok = tombstone.Obj.(*corev1.Pod)
+		if !ok {
+			klog.Errorf("Error decoding object tombstone when deleting Pod, invalid type: %v", tombstone.Obj)
+			return
+		}
+	}
+	stats := &statsv1alpha1.PodTrafficStats{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pod.Name,
+			Namespace: pod.Namespace,
+		},
+	}
+	a.podTrafficStats.Delete(stats)
+}
+
+// deleteNamespace handles Namespace DELETE events and deletes corresponding NamespaceTrafficStats objects.
+func (a *Aggregator) deleteNamespace(obj interface{}) {
+	namespace, ok := obj.(*corev1.Namespace)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			klog.Errorf("Error decoding object when deleting Namespace, invalid type: %v", obj)
+			return
+		}
+		namespace, ok = tombstone.Obj.(*corev1.Namespace)
+		if !ok {
+			klog.Errorf("Error decoding object tombstone when deleting Namespace, invalid type: %v", tombstone.Obj)
+			return
+		}
+	}
+	stats := &statsv1alpha1.NamespaceTrafficStats{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: namespace.Name,
+		},
+	}
+	a.namespaceTrafficStats.Delete(stats)
+}
+
 func (a *Aggregator) ListAntreaClusterNetworkPolicyStats() []statsv1alpha1.AntreaClusterNetworkPolicyStats {
 	objs := a.antreaClusterNetworkPolicyStats.List()
 	stats := make([]statsv1alpha1.AntreaClusterNetworkPolicyStats, len(objs))
@@ -340,6 +429,46 @@ func (a *Aggregator) GetNetworkPolicyStats(namespace, name string) (*statsv1alph
 	return obj.(*statsv1alpha1.NetworkPolicyStats), true
 }
 
+func (a *Aggregator) ListPodTrafficStats(namespace string) []statsv1alpha1.PodTrafficStats {
+	var objs []interface{}
+	if namespace == "" {
+		objs = a.podTrafficStats.List()
+	} else {
+		objs, _ = a.podTrafficStats.ByIndex(cache.NamespaceIndex, namespace)
+	}
+
+	stats := make([]statsv1alpha1.PodTrafficStats, len(objs))
+	for i, obj := range objs {
+		stats[i] = *(obj.(*statsv1alpha1.PodTrafficStats))
+	}
+	return stats
+}
+
+func (a *Aggregator) GetPodTrafficStats(namespace, name string) (*statsv1alpha1.PodTrafficStats, bool) {
+	obj, exists, _ := a.podTrafficStats.GetByKey(k8s.NamespacedName(namespace, name))
+	if !exists {
+		return nil, false
+	}
+	return obj.(*statsv1alpha1.PodTrafficStats), true
+}
+
+func (a *Aggregator) ListNamespaceTrafficStats() []statsv1alpha1.NamespaceTrafficStats {
+	objs := a.namespaceTrafficStats.List()
+	stats := make([]statsv1alpha1.NamespaceTrafficStats, len(objs))
+	for i, obj := range objs {
+		stats[i] = *(obj.(*statsv1alpha1.NamespaceTrafficStats))
+	}
+	return stats
+}
+
+func (a *Aggregator) GetNamespaceTrafficStats(name string) (*statsv1alpha1.NamespaceTrafficStats, bool) {
+	obj, exists, _ := a.namespaceTrafficStats.GetByKey(name)
+	if !exists {
+		return nil, false
+	}
+	return obj.(*statsv1alpha1.NamespaceTrafficStats), true
+}
+
 // Collect collects the node summary asynchronously to avoid the competition for the statsLock and to save clients
 // from pending on it.
 func (a *Aggregator) Collect(summary *controlplane.NodeStatsSummary) {
@@ -356,6 +485,9 @@ func (a *Aggregator) Run(stopCh <-chan struct{}) {
 	if features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
 		cacheSyncs = append(cacheSyncs, a.cnpListerSynced, a.anpListerSynced)
 	}
+	if features.DefaultFeatureGate.Enabled(features.PodTrafficStats) {
+		cacheSyncs = append(cacheSyncs, a.podListerSynced, a.namespaceListerSynced)
+	}
 	if !cache.WaitForNamedCacheSync("stats aggregator", stopCh, cacheSyncs...) {
 		return
 	}
@@ -443,6 +575,59 @@ func (a *Aggregator) doCollect(summary *controlplane.NodeStatsSummary) {
 			}
 		}
 	}
+	if features.DefaultFeatureGate.Enabled(features.PodTrafficStats) {
+		for i := range summary.PodTraffic {
+			a.collectPodTrafficStats(&summary.PodTraffic[i])
+		}
+	}
+}
+
+// collectPodTrafficStats adds up the incremental traffic stats of a Pod to the PodTrafficStats of the Pod and the
+// NamespaceTrafficStats of its Namespace.
+func (a *Aggregator) collectPodTrafficStats(stats *controlplane.PodTrafficStats) {
+	namespace, name := stats.Pod.Namespace, stats.Pod.Name
+	// The Pod might have been removed, skip processing it if missing. Otherwise the stats would never be removed.
+	if _, err := a.podLister.Pods(namespace).Get(name); err != nil {
+		return
+	}
+	var curPodStats *statsv1alpha1.PodTrafficStats
+	obj, exists, _ := a.podTrafficStats.GetByKey(k8s.NamespacedName(namespace, name))
+	if exists {
+		// The object returned by cache is supposed to be read only, create a new object and update it.
+		curPodStats = obj.(*statsv1alpha1.PodTrafficStats).DeepCopy()
+	} else {
+		curPodStats = &statsv1alpha1.PodTrafficStats{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				// To indicate the duration that the stats cover, the CreationTimestamp is set to the time that the
+				// stats start, instead of the CreationTimestamp of the Pod.
+				CreationTimestamp: metav1.Time{Time: time.Now()},
+			},
+		}
+	}
+	addBreakdownUp(&curPodStats.Ingress, &stats.Ingress)
+	addBreakdownUp(&curPodStats.Egress, &stats.Egress)
+	a.podTrafficStats.Update(curPodStats)
+
+	if _, err := a.namespaceLister.Get(namespace); err != nil {
+		return
+	}
+	var curNamespaceStats *statsv1alpha1.NamespaceTrafficStats
+	obj, exists, _ = a.namespaceTrafficStats.GetByKey(namespace)
+	if exists {
+		curNamespaceStats = obj.(*statsv1alpha1.NamespaceTrafficStats).DeepCopy()
+	} else {
+		curNamespaceStats = &statsv1alpha1.NamespaceTrafficStats{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              namespace,
+				CreationTimestamp: metav1.Time{Time: time.Now()},
+			},
+		}
+	}
+	addBreakdownUp(&curNamespaceStats.Ingress, &stats.Ingress)
+	addBreakdownUp(&curNamespaceStats.Egress, &stats.Egress)
+	a.namespaceTrafficStats.Update(curNamespaceStats)
 }
 
 func addUp(stats *statsv1alpha1.TrafficStats, inc *statsv1alpha1.TrafficStats) {
@@ -451,6 +636,12 @@ func addUp(stats *statsv1alpha1.TrafficStats, inc *statsv1alpha1.TrafficStats) {
 	stats.Bytes += inc.Bytes
 }
 
+func addBreakdownUp(stats *statsv1alpha1.TrafficBreakdown, inc *statsv1alpha1.TrafficBreakdown) {
+	addUp(&stats.IntraNode, &inc.IntraNode)
+	addUp(&stats.InterNode, &inc.InterNode)
+	addUp(&stats.External, &inc.External)
+}
+
 func addRulesUp(ruleStats *[]statsv1alpha1.RuleTrafficStats, ruleSumStats *statsv1alpha1.TrafficStats, inc []statsv1alpha1.RuleTrafficStats) {
 	incMap := make(map[string]*statsv1alpha1.TrafficStats)
 	for i, v := range inc {
@@ -21,6 +21,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -522,7 +523,7 @@ func TestAggregatorCollectListGet(t *testing.T) {
 			informerFactory := informers.NewSharedInformerFactory(client, 12*time.Hour)
 			crdClient := fakeversioned.NewSimpleClientset(append(tt.existingAntreaClusterNetworkPolicies, tt.existingAntreaNetworkPolicies...)...)
 			crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, 12*time.Hour)
-			a := NewAggregator(informerFactory.Networking().V1().NetworkPolicies(), crdInformerFactory.Crd().V1alpha1().ClusterNetworkPolicies(), crdInformerFactory.Crd().V1alpha1().NetworkPolicies())
+			a := NewAggregator(informerFactory.Networking().V1().NetworkPolicies(), crdInformerFactory.Crd().V1alpha1().ClusterNetworkPolicies(), crdInformerFactory.Crd().V1alpha1().NetworkPolicies(), informerFactory.Core().V1().Pods(), informerFactory.Core().V1().Namespaces())
 			informerFactory.Start(stopCh)
 			crdInformerFactory.Start(stopCh)
 			expectedPolicyCount := len(tt.expectedNetworkPolicyStats) + len(tt.expectedAntreaClusterNetworkPolicyStats) + len(tt.expectedAntreaNetworkPolicyStats)
@@ -561,7 +562,7 @@ func TestDeleteNetworkPolicy(t *testing.T) {
 	informerFactory := informers.NewSharedInformerFactory(client, 12*time.Hour)
 	crdClient := fakeversioned.NewSimpleClientset(cnp1, anp1)
 	crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, 12*time.Hour)
-	a := NewAggregator(informerFactory.Networking().V1().NetworkPolicies(), crdInformerFactory.Crd().V1alpha1().ClusterNetworkPolicies(), crdInformerFactory.Crd().V1alpha1().NetworkPolicies())
+	a := NewAggregator(informerFactory.Networking().V1().NetworkPolicies(), crdInformerFactory.Crd().V1alpha1().ClusterNetworkPolicies(), crdInformerFactory.Crd().V1alpha1().NetworkPolicies(), informerFactory.Core().V1().Pods(), informerFactory.Core().V1().Namespaces())
 	informerFactory.Start(stopCh)
 	crdInformerFactory.Start(stopCh)
 
@@ -627,3 +628,98 @@ func TestDeleteNetworkPolicy(t *testing.T) {
 	})
 	assert.NoError(t, err)
 }
+
+func TestAggregatorPodTrafficStats(t *testing.T) {
+	defer featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.PodTrafficStats, true)()
+
+	ns1 := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "foo"}}
+	pod1 := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "pod1"}}
+	pod2 := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "pod2"}}
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	client := fake.NewSimpleClientset(ns1, pod1, pod2)
+	informerFactory := informers.NewSharedInformerFactory(client, 12*time.Hour)
+	crdClient := fakeversioned.NewSimpleClientset()
+	crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, 12*time.Hour)
+	a := NewAggregator(informerFactory.Networking().V1().NetworkPolicies(), crdInformerFactory.Crd().V1alpha1().ClusterNetworkPolicies(), crdInformerFactory.Crd().V1alpha1().NetworkPolicies(), informerFactory.Core().V1().Pods(), informerFactory.Core().V1().Namespaces())
+	informerFactory.Start(stopCh)
+	crdInformerFactory.Start(stopCh)
+
+	summaries := []*controlplane.NodeStatsSummary{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+			PodTraffic: []controlplane.PodTrafficStats{
+				{
+					Pod: controlplane.PodReference{Namespace: "foo", Name: "pod1"},
+					Ingress: statsv1alpha1.TrafficBreakdown{
+						IntraNode: statsv1alpha1.TrafficStats{Packets: 1, Bytes: 10},
+						External:  statsv1alpha1.TrafficStats{Packets: 2, Bytes: 20},
+					},
+					Egress: statsv1alpha1.TrafficBreakdown{
+						InterNode: statsv1alpha1.TrafficStats{Packets: 3, Bytes: 30},
+					},
+				},
+				{
+					// The Pod doesn't exist, the stats should be ignored.
+					Pod: controlplane.PodReference{Namespace: "foo", Name: "pod3"},
+					Egress: statsv1alpha1.TrafficBreakdown{
+						InterNode: statsv1alpha1.TrafficStats{Packets: 100, Bytes: 1000},
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-2"},
+			PodTraffic: []controlplane.PodTrafficStats{
+				{
+					Pod: controlplane.PodReference{Namespace: "foo", Name: "pod1"},
+					Ingress: statsv1alpha1.TrafficBreakdown{
+						IntraNode: statsv1alpha1.TrafficStats{Packets: 1, Bytes: 10},
+					},
+				},
+				{
+					Pod: controlplane.PodReference{Namespace: "foo", Name: "pod2"},
+					Egress: statsv1alpha1.TrafficBreakdown{
+						External: statsv1alpha1.TrafficStats{Packets: 5, Bytes: 50},
+					},
+				},
+			},
+		},
+	}
+	runWrapper(t, a, 0, summaries)
+
+	require.Equal(t, 2, len(a.ListPodTrafficStats("foo")))
+	assert.Equal(t, 0, len(a.ListPodTrafficStats("bar")))
+	pod1Stats, exists := a.GetPodTrafficStats("foo", "pod1")
+	require.True(t, exists)
+	assert.Equal(t, statsv1alpha1.TrafficBreakdown{
+		IntraNode: statsv1alpha1.TrafficStats{Packets: 2, Bytes: 20},
+		External:  statsv1alpha1.TrafficStats{Packets: 2, Bytes: 20},
+	}, pod1Stats.Ingress)
+	assert.Equal(t, statsv1alpha1.TrafficBreakdown{
+		InterNode: statsv1alpha1.TrafficStats{Packets: 3, Bytes: 30},
+	}, pod1Stats.Egress)
+	_, exists = a.GetPodTrafficStats("foo", "pod3")
+	assert.False(t, exists)
+
+	require.Equal(t, 1, len(a.ListNamespaceTrafficStats()))
+	nsStats, exists := a.GetNamespaceTrafficStats("foo")
+	require.True(t, exists)
+	assert.Equal(t, statsv1alpha1.TrafficBreakdown{
+		IntraNode: statsv1alpha1.TrafficStats{Packets: 2, Bytes: 20},
+		External:  statsv1alpha1.TrafficStats{Packets: 2, Bytes: 20},
+	}, nsStats.Ingress)
+	assert.Equal(t, statsv1alpha1.TrafficBreakdown{
+		InterNode: statsv1alpha1.TrafficStats{Packets: 3, Bytes: 30},
+		External:  statsv1alpha1.TrafficStats{Packets: 5, Bytes: 50},
+	}, nsStats.Egress)
+
+	client.CoreV1().Pods(pod1.Namespace).Delete(context.TODO(), pod1.Name, metav1.DeleteOptions{})
+	client.CoreV1().Namespaces().Delete(context.TODO(), ns1.Name, metav1.DeleteOptions{})
+	// Event handlers are asynchronous, it's supposed to finish very soon.
+	err := wait.PollImmediate(100*time.Millisecond, time.Second, func() (done bool, err error) {
+		return len(a.ListPodTrafficStats("")) == 1 && len(a.ListNamespaceTrafficStats()) == 0, nil
+	})
+	assert.NoError(t, err)
+}
@@ -56,8 +56,10 @@ func newController() *traceflowController {
 	crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, informerDefaultResync)
 	controller := NewTraceflowController(crdClient,
 		informerFactory.Core().V1().Pods(),
-		crdInformerFactory.Crd().V1alpha1().Traceflows())
+		crdInformerFactory.Crd().V1alpha1().Traceflows(),
+		crdInformerFactory.Crd().V1alpha1().PacketCaptures())
 	controller.traceflowListerSynced = alwaysReady
+	controller.packetCaptureListerSynced = alwaysReady
 	return &traceflowController{
 		controller,
 		client,
@@ -0,0 +1,333 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traceflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	crdinformers "antrea.io/antrea/pkg/client/informers/externalversions/crd/v1alpha1"
+	"antrea.io/antrea/pkg/util/k8s"
+)
+
+const (
+	// String set to PacketCaptureStatus.Reason.
+	packetCaptureTimeout = "PacketCapture timeout"
+
+	// Max number of packets that can be requested by a PacketCapture.
+	maxPacketCaptureNumber int32 = 10000
+)
+
+var (
+	// The Agent capturing the packets stops the capture and reports the result when the
+	// PacketCapture times out. The Controller only fails the PacketCapture if no result has
+	// been reported after this extra delay, e.g. when the Agent has been restarted.
+	packetCaptureTimeoutGracePeriod = 10 * time.Second
+)
+
+func (c *Controller) initPacketCapture(packetCaptureInformer crdinformers.PacketCaptureInformer) {
+	c.packetCaptureInformer = packetCaptureInformer
+	c.packetCaptureLister = packetCaptureInformer.Lister()
+	c.packetCaptureListerSynced = packetCaptureInformer.Informer().HasSynced
+	c.packetCaptureQueue = workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(minRetryDelay, maxRetryDelay), "packetcapture")
+	packetCaptureInformer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.addPacketCapture,
+			UpdateFunc: c.updatePacketCapture,
+			DeleteFunc: c.deletePacketCapture,
+		},
+		resyncPeriod,
+	)
+}
+
+// loadPacketCaptureTags loads the data plane tags of all running PacketCaptures into the
+// controller's cache. It must be called before any worker is started.
+func (c *Controller) loadPacketCaptureTags() {
+	pcs, err := c.packetCaptureLister.List(labels.Everything())
+	if err != nil {
+		klog.ErrorS(err, "Failed to list all PacketCaptures")
+		return
+	}
+	for _, pc := range pcs {
+		if pc.Status.Phase == crdv1alpha1.PacketCaptureRunning {
+			if err := c.occupyPacketCaptureTag(pc); err != nil {
+				klog.ErrorS(err, "Failed to load PacketCapture data plane tag", "PacketCapture", klog.KObj(pc))
+			}
+		}
+	}
+}
+
+func (c *Controller) runPacketCapture(stopCh <-chan struct{}) {
+	go wait.Until(c.checkPacketCaptureTimeout, timeoutCheckInterval, stopCh)
+
+	for i := 0; i < defaultWorkers; i++ {
+		go wait.Until(c.packetCaptureWorker, time.Second, stopCh)
+	}
+}
+
+func (c *Controller) enqueuePacketCapture(pc *crdv1alpha1.PacketCapture) {
+	c.packetCaptureQueue.Add(pc.Name)
+}
+
+func (c *Controller) addPacketCapture(obj interface{}) {
+	pc := obj.(*crdv1alpha1.PacketCapture)
+	klog.InfoS("Processing PacketCapture ADD event", "PacketCapture", klog.KObj(pc))
+	c.enqueuePacketCapture(pc)
+}
+
+func (c *Controller) updatePacketCapture(_, curObj interface{}) {
+	pc := curObj.(*crdv1alpha1.PacketCapture)
+	klog.InfoS("Processing PacketCapture UPDATE event", "PacketCapture", klog.KObj(pc))
+	c.enqueuePacketCapture(pc)
+}
+
+func (c *Controller) deletePacketCapture(old interface{}) {
+	pc, ok := old.(*crdv1alpha1.PacketCapture)
+	if !ok {
+		tombstone, ok := old.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			klog.Errorf("Error decoding object when deleting PacketCapture, invalid type: %v", old)
+			return
+		}
+		pc, ok = tombstone.Obj.(*crdv1alpha1.PacketCapture)
+		if !ok {
+			klog.Errorf("Error decoding object tombstone when deleting PacketCapture, invalid type: %v", tombstone.Obj)
+			return
+		}
+	}
+	klog.InfoS("Processing PacketCapture DELETE event", "PacketCapture", klog.KObj(pc))
+	c.deallocateTagForPC(pc)
+}
+
+func (c *Controller) checkPacketCaptureTimeout() {
+	c.runningTraceflowsMutex.Lock()
+	pcs := make([]string, 0, len(c.runningPacketCaptures))
+	for _, pcName := range c.runningPacketCaptures {
+		pcs = append(pcs, pcName)
+	}
+	c.runningTraceflowsMutex.Unlock()
+
+	for _, pcName := range pcs {
+		// Re-post all running PacketCapture requests to the work queue to
+		// be processed and checked for timeout.
+		c.packetCaptureQueue.Add(pcName)
+	}
+}
+
+func (c *Controller) packetCaptureWorker() {
+	for c.processPacketCaptureItem() {
+	}
+}
+
+// processPacketCaptureItem processes an item in the "packetcapture" work queue, in the same way
+// as processTraceflowItem does for Traceflows.
+func (c *Controller) processPacketCaptureItem() bool {
+	obj, quit := c.packetCaptureQueue.Get()
+	if quit {
+		return false
+	}
+	defer c.packetCaptureQueue.Done(obj)
+
+	key, ok := obj.(string)
+	if !ok {
+		c.packetCaptureQueue.Forget(obj)
+		klog.Errorf("Expected string in work queue but got %#v", obj)
+		return true
+	}
+	if err := c.syncPacketCapture(key); err != nil {
+		klog.ErrorS(err, "Error syncing PacketCapture", "PacketCapture", key)
+		c.packetCaptureQueue.AddRateLimited(key)
+	} else {
+		c.packetCaptureQueue.Forget(key)
+	}
+	return true
+}
+
+func (c *Controller) syncPacketCapture(name string) error {
+	startTime := time.Now()
+	defer func() {
+		klog.V(4).InfoS("Finished syncing PacketCapture", "PacketCapture", name, "duration", time.Since(startTime))
+	}()
+
+	pc, err := c.packetCaptureLister.Get(name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			// PacketCapture CRD has been deleted.
+			return nil
+		}
+		return err
+	}
+	switch pc.Status.Phase {
+	case "", crdv1alpha1.PacketCapturePending:
+		err = c.startPacketCapture(pc)
+	case crdv1alpha1.PacketCaptureRunning:
+		err = c.checkPacketCaptureStatus(pc)
+	case crdv1alpha1.PacketCaptureSucceeded, crdv1alpha1.PacketCaptureFailed:
+		// Deallocate tag when the Agent set PacketCapture status to Succeeded or Failed.
+		c.deallocateTagForPC(pc)
+	}
+	return err
+}
+
+func (c *Controller) startPacketCapture(pc *crdv1alpha1.PacketCapture) error {
+	if err := c.validatePacketCapture(pc); err != nil {
+		klog.ErrorS(err, "Invalid PacketCapture request", "PacketCapture", klog.KObj(pc))
+		return c.updatePacketCaptureStatus(pc, crdv1alpha1.PacketCaptureFailed, fmt.Sprintf("Invalid PacketCapture request, err: %+v", err), 0)
+	}
+	tag, err := c.allocatePacketCaptureTag(pc.Name)
+	if err != nil {
+		return err
+	}
+	if tag == 0 {
+		return nil
+	}
+
+	err = c.updatePacketCaptureStatus(pc, crdv1alpha1.PacketCaptureRunning, "", tag)
+	if err != nil {
+		c.deallocatePacketCaptureTag(pc.Name, tag)
+	}
+	return err
+}
+
+// checkPacketCaptureStatus is only called for PacketCaptures in the Running phase. The Agent
+// capturing the packets moves the PacketCapture to the Succeeded phase, so the Controller only
+// needs to take care of PacketCaptures for which no Agent ever reports.
+func (c *Controller) checkPacketCaptureStatus(pc *crdv1alpha1.PacketCapture) error {
+	timeout := time.Duration(crdv1alpha1.DefaultPacketCaptureTimeout) * time.Second
+	if pc.Spec.Timeout != 0 {
+		timeout = time.Duration(pc.Spec.Timeout) * time.Second
+	}
+	startTime := pc.CreationTimestamp.Time
+	if pc.Status.StartTime != nil {
+		startTime = pc.Status.StartTime.Time
+	}
+	if startTime.Add(timeout + packetCaptureTimeoutGracePeriod).Before(time.Now()) {
+		c.deallocateTagForPC(pc)
+		return c.updatePacketCaptureStatus(pc, crdv1alpha1.PacketCaptureFailed, packetCaptureTimeout, 0)
+	}
+	return nil
+}
+
+func (c *Controller) updatePacketCaptureStatus(pc *crdv1alpha1.PacketCapture, phase crdv1alpha1.PacketCapturePhase, reason string, dataPlaneTag uint8) error {
+	update := pc.DeepCopy()
+	update.Status.Phase = phase
+	if phase == crdv1alpha1.PacketCaptureRunning && pc.Status.StartTime == nil {
+		t := metav1.Now()
+		update.Status.StartTime = &t
+	}
+	update.Status.DataplaneTag = dataPlaneTag
+	if reason != "" {
+		update.Status.Reason = reason
+	}
+	_, err := c.client.CrdV1alpha1().PacketCaptures().UpdateStatus(context.TODO(), update, metav1.UpdateOptions{})
+	return err
+}
+
+func (c *Controller) occupyPacketCaptureTag(pc *crdv1alpha1.PacketCapture) error {
+	tag := pc.Status.DataplaneTag
+	if tag < minTagNum || tag > maxTagNum {
+		return errors.New("this PacketCapture CRD's data plane tag is out of range")
+	}
+
+	c.runningTraceflowsMutex.Lock()
+	defer c.runningTraceflowsMutex.Unlock()
+	if existingName, ok := c.runningPacketCaptures[tag]; ok && existingName == pc.Name {
+		return nil
+	}
+	if c.isTagInUse(tag) {
+		return errors.New("this PacketCapture CRD's data plane tag is already taken")
+	}
+	c.runningPacketCaptures[tag] = pc.Name
+	return nil
+}
+
+// allocatePacketCaptureTag allocates a tag which is not used by any Traceflow or PacketCapture.
+// If the PacketCapture request has been allocated with a tag already, 0 is returned. If all the
+// tags are in use, an error is returned.
+func (c *Controller) allocatePacketCaptureTag(name string) (uint8, error) {
+	c.runningTraceflowsMutex.Lock()
+	defer c.runningTraceflowsMutex.Unlock()
+
+	for _, n := range c.runningPacketCaptures {
+		if n == name {
+			// The PacketCapture request has been processed already.
+			return 0, nil
+		}
+	}
+	for i := minTagNum; i <= maxTagNum; i += tagStep {
+		if !c.isTagInUse(i) {
+			c.runningPacketCaptures[i] = name
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("number of on-going Traceflow and PacketCapture operations already reached the upper limit: %d", maxTagNum)
+}
+
+func (c *Controller) deallocateTagForPC(pc *crdv1alpha1.PacketCapture) {
+	if pc.Status.DataplaneTag != 0 {
+		c.deallocatePacketCaptureTag(pc.Name, pc.Status.DataplaneTag)
+	}
+}
+
+func (c *Controller) deallocatePacketCaptureTag(name string, tag uint8) {
+	c.runningTraceflowsMutex.Lock()
+	defer c.runningTraceflowsMutex.Unlock()
+	if existingName, ok := c.runningPacketCaptures[tag]; ok && existingName == name {
+		delete(c.runningPacketCaptures, tag)
+	}
+}
+
+func (c *Controller) validatePacketCapture(pc *crdv1alpha1.PacketCapture) error {
+	if pc.Spec.Source.Pod == "" && pc.Spec.Destination.Pod == "" {
+		return errors.New("at least one of source Pod and destination Pod must be specified")
+	}
+	if pc.Spec.Destination.Service != "" {
+		return errors.New("using Service as destination is not supported")
+	}
+	if pc.Spec.Number < 0 || pc.Spec.Number > maxPacketCaptureNumber {
+		return fmt.Errorf("number of packets to capture must be between 1 and %d", maxPacketCaptureNumber)
+	}
+	// Packets are captured on the Node of the source Pod, or of the destination Pod if no
+	// source Pod is specified.
+	namespace, name := pc.Spec.Source.Namespace, pc.Spec.Source.Pod
+	if name == "" {
+		namespace, name = pc.Spec.Destination.Namespace, pc.Spec.Destination.Pod
+	}
+	pod, err := c.podLister.Pods(namespace).Get(name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			err = fmt.Errorf("requested Pod %s not found", k8s.NamespacedName(namespace, name))
+		}
+		return err
+	}
+	if pod.Spec.HostNetwork {
+		return errors.New("capturing the packets of a hostNetwork Pod is not supported")
+	}
+	if pod.Spec.NodeName == "" {
+		return fmt.Errorf("requested Pod %s is not scheduled to any Node", k8s.NamespacedName(namespace, name))
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traceflow
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+)
+
+func TestPacketCapture(t *testing.T) {
+	// Check timeout more frequently.
+	timeoutCheckInterval = time.Second
+	packetCaptureTimeoutGracePeriod = 0
+
+	tfc := newController()
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	tfc.informerFactory.Start(stopCh)
+	tfc.crdInformerFactory.Start(stopCh)
+	tfc.informerFactory.WaitForCacheSync(stopCh)
+	tfc.crdInformerFactory.WaitForCacheSync(stopCh)
+	go tfc.Run(stopCh)
+
+	numRunningPacketCaptures := func() int {
+		tfc.runningTraceflowsMutex.Lock()
+		defer tfc.runningTraceflowsMutex.Unlock()
+		return len(tfc.runningPacketCaptures)
+	}
+
+	pod1 := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod1", Namespace: "ns1"},
+		Spec:       corev1.PodSpec{NodeName: "node1"},
+	}
+	tfc.kubeClient.CoreV1().Pods("ns1").Create(context.TODO(), &pod1, metav1.CreateOptions{})
+	createdPod, _ := tfc.waitForPodInNamespace("ns1", "pod1", time.Second)
+	require.NotNil(t, createdPod)
+
+	t.Run("succeededPacketCapture", func(t *testing.T) {
+		pc := crdv1alpha1.PacketCapture{
+			ObjectMeta: metav1.ObjectMeta{Name: "pc1", UID: "uid1"},
+			Spec: crdv1alpha1.PacketCaptureSpec{
+				Source:      crdv1alpha1.Source{Namespace: "ns1", Pod: "pod1"},
+				Destination: crdv1alpha1.Destination{IP: "10.10.1.2"},
+				Number:      10,
+			},
+		}
+		tfc.client.CrdV1alpha1().PacketCaptures().Create(context.TODO(), &pc, metav1.CreateOptions{})
+		res, _ := tfc.waitForPacketCapture("pc1", crdv1alpha1.PacketCaptureRunning, time.Second)
+		require.NotNil(t, res)
+		// DataplaneTag should be allocated by Controller.
+		assert.True(t, res.Status.DataplaneTag > 0)
+		assert.NotNil(t, res.Status.StartTime)
+		assert.Equal(t, 1, numRunningPacketCaptures())
+
+		// A Traceflow must not be allocated the same tag.
+		tfc.runningTraceflowsMutex.Lock()
+		assert.True(t, tfc.isTagInUse(res.Status.DataplaneTag))
+		tfc.runningTraceflowsMutex.Unlock()
+		tag, err := tfc.allocateTag("tf1")
+		require.NoError(t, err)
+		assert.NotEqual(t, res.Status.DataplaneTag, tag)
+		tfc.deallocateTag("tf1", tag)
+
+		// The Agent reports the result.
+		res.Status.Phase = crdv1alpha1.PacketCaptureSucceeded
+		res.Status.NumCapturedPackets = 10
+		tfc.client.CrdV1alpha1().PacketCaptures().UpdateStatus(context.TODO(), res, metav1.UpdateOptions{})
+		assert.NoError(t, wait.Poll(100*time.Millisecond, time.Second, func() (bool, error) {
+			return numRunningPacketCaptures() == 0, nil
+		}))
+		tfc.client.CrdV1alpha1().PacketCaptures().Delete(context.TODO(), "pc1", metav1.DeleteOptions{})
+	})
+
+	t.Run("timeoutPacketCapture", func(t *testing.T) {
+		pc := crdv1alpha1.PacketCapture{
+			ObjectMeta: metav1.ObjectMeta{Name: "pc2", UID: "uid2"},
+			Spec: crdv1alpha1.PacketCaptureSpec{
+				Destination: crdv1alpha1.Destination{Namespace: "ns1", Pod: "pod1"},
+				Timeout:     1,
+			},
+		}
+		startTime := time.Now()
+		tfc.client.CrdV1alpha1().PacketCaptures().Create(context.TODO(), &pc, metav1.CreateOptions{})
+		res, _ := tfc.waitForPacketCapture("pc2", crdv1alpha1.PacketCaptureRunning, time.Second)
+		require.NotNil(t, res)
+		res, _ = tfc.waitForPacketCapture("pc2", crdv1alpha1.PacketCaptureFailed, 5*time.Second)
+		require.NotNil(t, res)
+		assert.True(t, time.Since(startTime) >= time.Second)
+		assert.Equal(t, packetCaptureTimeout, res.Status.Reason)
+		assert.Equal(t, uint8(0), res.Status.DataplaneTag)
+		assert.Equal(t, 0, numRunningPacketCaptures())
+	})
+
+	t.Run("invalidPacketCapture", func(t *testing.T) {
+		pc := crdv1alpha1.PacketCapture{
+			ObjectMeta: metav1.ObjectMeta{Name: "pc3", UID: "uid3"},
+			Spec: crdv1alpha1.PacketCaptureSpec{
+				Source:      crdv1alpha1.Source{IP: "10.10.1.1"},
+				Destination: crdv1alpha1.Destination{IP: "10.10.1.2"},
+			},
+		}
+		tfc.client.CrdV1alpha1().PacketCaptures().Create(context.TODO(), &pc, metav1.CreateOptions{})
+		res, _ := tfc.waitForPacketCapture("pc3", crdv1alpha1.PacketCaptureFailed, time.Second)
+		require.NotNil(t, res)
+		// DataplaneTag should not be allocated by Controller.
+		assert.Equal(t, uint8(0), res.Status.DataplaneTag)
+		assert.Equal(t, 0, numRunningPacketCaptures())
+	})
+}
+
+func (tfc *traceflowController) waitForPacketCapture(name string, phase crdv1alpha1.PacketCapturePhase, timeout time.Duration) (*crdv1alpha1.PacketCapture, error) {
+	var pc *crdv1alpha1.PacketCapture
+	var err error
+	if err = wait.Poll(100*time.Millisecond, timeout, func() (bool, error) {
+		pc, err = tfc.client.CrdV1alpha1().PacketCaptures().Get(context.TODO(), name, metav1.GetOptions{})
+		if err != nil || pc.Status.Phase != phase {
+			return false, nil
+		}
+		return true, nil
+	}); err != nil {
+		return nil, err
+	}
+	return pc, nil
+}
@@ -20,6 +20,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
 )
 
 // SpanMeta describes the span information of an object.
@@ -103,4 +104,22 @@ type NetworkPolicy struct {
 	// to re-calculate affected Namespaces.
 	// It is set only for AntreaClusterNetworkPolicies with per-namespace rules.
 	PerNamespaceSelectors []labels.Selector
+	// InactiveRules is a list of names of the rules which are not enforced at
+	// the moment because they are outside of their scheduled time windows.
+	// It is set only for Antrea-native policies.
+	InactiveRules []string
+	// RuleSchedules is the state of the schedules of the rules which have one,
+	// including the time window during which each of them is enforced.
+	// It is set only for Antrea-native policies.
+	RuleSchedules []crdv1alpha1.RuleScheduleStatus
+	// UnsupportedRules is a list of names of the rules which are not fully
+	// enforced because some of their peers are not supported.
+	// It is set only for AdminNetworkPolicies and BaselineAdminNetworkPolicies.
+	UnsupportedRules []string
+	// NonLocalIngressServices is a list of the Services referred to in ingress
+	// toServices whose traffic is not always load-balanced on the Node of the
+	// selected Endpoint, each with the reason, e.g. "default/web: Service not
+	// found". Their traffic load-balanced on other Nodes is not matched.
+	// It is set only for Antrea-native policies.
+	NonLocalIngressServices []string
 }
@@ -103,6 +103,31 @@ const (
 	// alpha: v1.7
 	// Enable certificated-based authentication for IPsec.
 	IPsecCertAuth featuregate.Feature = "IPsecCertAuth"
+
+	// alpha: v1.7
+	// Enable shaping the bandwidth of Pods according to the kubernetes.io/ingress-bandwidth
+	// and kubernetes.io/egress-bandwidth annotations.
+	PodBandwidth featuregate.Feature = "PodBandwidth"
+
+	// alpha: v1.7
+	// Enable collecting and exposing the traffic statistics of Pods and Namespaces.
+	// It requires NetworkPolicyStats to be enabled as well.
+	PodTrafficStats featuregate.Feature = "PodTrafficStats"
+
+	// alpha: v1.7
+	// Enable measuring the latency and packet loss between Nodes by probing the gateway
+	// of each peer Node periodically.
+	NodeLatencyMonitor featuregate.Feature = "NodeLatencyMonitor"
+
+	// alpha: v1.7
+	// Enable capturing the packets exchanged by a Pod and writing them to a pcapng file
+	// on the Node, using the PacketCapture CRD API.
+	PacketCapture featuregate.Feature = "PacketCapture"
+
+	// alpha: v1.7
+	// Enable enforcing the upstream AdminNetworkPolicy and BaselineAdminNetworkPolicy
+	// APIs (policy.networking.k8s.io). It requires AntreaPolicy to be enabled as well.
+	AdminNetworkPolicy featuregate.Feature = "AdminNetworkPolicy"
 )
 
 var (
@@ -133,6 +158,11 @@ var (
 		ServiceExternalIP:  {Default: false, PreRelease: featuregate.Alpha},
 		TrafficControl:     {Default: false, PreRelease: featuregate.Alpha},
 		IPsecCertAuth:      {Default: false, PreRelease: featuregate.Alpha},
+		PodBandwidth:       {Default: false, PreRelease: featuregate.Alpha},
+		PodTrafficStats:    {Default: false, PreRelease: featuregate.Alpha},
+		NodeLatencyMonitor: {Default: false, PreRelease: featuregate.Alpha},
+		PacketCapture:      {Default: false, PreRelease: featuregate.Alpha},
+		AdminNetworkPolicy: {Default: false, PreRelease: featuregate.Alpha},
 	}
 
 	// UnsupportedFeaturesOnWindows records the features not supported on
@@ -146,12 +176,16 @@ var (
 	// can have different FeatureSpecs between Linux and Windows, we should
 	// still define a separate defaultAntreaFeatureGates map for Windows.
 	unsupportedFeaturesOnWindows = map[featuregate.Feature]struct{}{
-		Egress:            {},
-		AntreaIPAM:        {},
-		Multicast:         {},
-		SecondaryNetwork:  {},
-		ServiceExternalIP: {},
-		IPsecCertAuth:     {},
+		Egress:             {},
+		AntreaIPAM:         {},
+		Multicast:          {},
+		SecondaryNetwork:   {},
+		ServiceExternalIP:  {},
+		IPsecCertAuth:      {},
+		PodBandwidth:       {},
+		PodTrafficStats:    {},
+		NodeLatencyMonitor: {},
+		PacketCapture:      {},
 		// Multicluster feature is not validated on Windows yet. This can removed
 		// in the future if it's fully tested on Windows.
 		Multicluster: {},
@@ -32,6 +32,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/util/env"
 )
 
 const (
@@ -41,6 +43,11 @@ const (
 	ClientSecretNamespace = "flow-aggregator"
 	// #nosec G101: false positive triggered by variable name which includes "Secret"
 	ClientSecretName = "flow-aggregator-client-tls"
+	// CASecretName is the name of the Secret storing the CA certificate and
+	// key, which are shared by all the Flow Aggregator replicas so that the
+	// Flow Exporters can connect to any of them.
+	// #nosec G101: false positive triggered by variable name which includes "Secret"
+	CASecretName = "flow-aggregator-ca-tls"
 )
 
 var (
@@ -80,7 +87,85 @@ func generateCACertKey() (*x509.Certificate, *rsa.PrivateKey, []byte, error) {
 	return cert, caKey, caPEM.Bytes(), err
 }
 
-func generateCertKey(caCert *x509.Certificate, caKey *rsa.PrivateKey, isServer bool, flowAggregatorAddress string) ([]byte, []byte, error) {
+// getOrCreateCACertKey returns the CA certificate and key stored in the CA
+// Secret. If the Secret does not exist or the CA certificate has expired, a new
+// CA certificate and key are generated and stored in the Secret. When several
+// replicas race to create the Secret, the losers use the one created by the
+// winner.
+func getOrCreateCACertKey(k8sClient kubernetes.Interface) (*x509.Certificate, *rsa.PrivateKey, []byte, error) {
+	secret, err := k8sClient.CoreV1().Secrets(ClientSecretNamespace).Get(context.TODO(), CASecretName, metav1.GetOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, nil, nil, fmt.Errorf("error getting Secret %s: %v", CASecretName, err)
+	}
+	exists := err == nil
+	if exists {
+		caCert, caKey, err := parseCACertKey(secret.Data["tls.crt"], secret.Data["tls.key"])
+		if err == nil && time.Now().Before(caCert.NotAfter) {
+			return caCert, caKey, secret.Data["tls.crt"], nil
+		}
+		klog.InfoS("CA certificate in Secret is invalid or expired, generating a new one", "secret", CASecretName, "err", err)
+	}
+	caCert, caKey, caPEM, err := generateCACertKey()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	data := map[string][]byte{
+		"tls.crt": caPEM,
+		"tls.key": pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(caKey)}),
+	}
+	if exists {
+		secret.Data = data
+		if _, err := k8sClient.CoreV1().Secrets(ClientSecretNamespace).Update(context.TODO(), secret, metav1.UpdateOptions{}); err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to update Secret %s: %v", CASecretName, err)
+		}
+		return caCert, caKey, caPEM, nil
+	}
+	secret = &v1.Secret{
+		Data: data,
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      CASecretName,
+			Namespace: ClientSecretNamespace,
+			Labels: map[string]string{
+				"app": "flow-aggregator",
+			},
+		},
+		Type: v1.SecretTypeTLS,
+	}
+	if _, err := k8sClient.CoreV1().Secrets(ClientSecretNamespace).Create(context.TODO(), secret, metav1.CreateOptions{}); err != nil {
+		if errors.IsAlreadyExists(err) {
+			// Another replica created the Secret first.
+			return getOrCreateCACertKey(k8sClient)
+		}
+		return nil, nil, nil, fmt.Errorf("failed to create Secret %s: %v", CASecretName, err)
+	}
+	return caCert, caKey, caPEM, nil
+}
+
+func parseCACertKey(certPEM, keyPEM []byte) (*x509.Certificate, *rsa.PrivateKey, error) {
+	certBlock, _ := pem.Decode(certPEM)
+	if certBlock == nil {
+		return nil, nil, fmt.Errorf("no PEM data found in CA certificate")
+	}
+	cert, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyBlock, _ := pem.Decode(keyPEM)
+	if keyBlock == nil {
+		return nil, nil, fmt.Errorf("no PEM data found in CA key")
+	}
+	key, err := x509.ParsePKCS1PrivateKey(keyBlock.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cert, key, nil
+}
+
+// generateCertKey generates a certificate signed by the CA. For a server
+// certificate, podIPs are added to the IP addresses of the certificate, as the
+// Flow Exporters connect directly to the Flow Aggregator Pods when flow
+// records are sharded across replicas.
+func generateCertKey(caCert *x509.Certificate, caKey *rsa.PrivateKey, isServer bool, flowAggregatorAddress string, podIPs ...net.IP) ([]byte, []byte, error) {
 	var cert *x509.Certificate
 	if isServer {
 		cert = &x509.Certificate{
@@ -104,6 +189,7 @@ func generateCertKey(caCert *x509.Certificate, caKey *rsa.PrivateKey, isServer b
 			}
 			cert.IPAddresses = flowAggregatorIPs
 		}
+		cert.IPAddresses = append(cert.IPAddresses, podIPs...)
 	} else {
 		cert = &x509.Certificate{
 			SerialNumber: big.NewInt(3),
@@ -142,6 +228,27 @@ func generateCertKey(caCert *x509.Certificate, caKey *rsa.PrivateKey, isServer b
 	return certPEM.Bytes(), certKeyPEM.Bytes(), nil
 }
 
+// getPodIPs returns the IP addresses of the Flow Aggregator Pod, or nil if
+// they cannot be retrieved.
+func getPodIPs(k8sClient kubernetes.Interface) []net.IP {
+	podName, podNamespace := env.GetPodName(), env.GetPodNamespace()
+	if podName == "" || podNamespace == "" {
+		return nil
+	}
+	pod, err := k8sClient.CoreV1().Pods(podNamespace).Get(context.TODO(), podName, metav1.GetOptions{})
+	if err != nil {
+		klog.ErrorS(err, "Error when getting Flow Aggregator Pod, its IPs will not be included in the server certificate")
+		return nil
+	}
+	var ips []net.IP
+	for _, podIP := range pod.Status.PodIPs {
+		if ip := net.ParseIP(podIP.IP); ip != nil {
+			ips = append(ips, ip)
+		}
+	}
+	return ips
+}
+
 func syncCAAndClientCert(caCert, clientCert, clientKey []byte, k8sClient kubernetes.Interface) error {
 	klog.Info("Syncing CA certificate, client certificate and client key with ConfigMap")
 	caConfigMap, err := k8sClient.CoreV1().ConfigMaps(CAConfigMapNamespace).Get(context.TODO(), CAConfigMapName, metav1.GetOptions{})
@@ -0,0 +1,54 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flowaggregator
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGetOrCreateCACertKey(t *testing.T) {
+	k8sClient := fake.NewSimpleClientset()
+	caCert1, caKey1, caPEM1, err := getOrCreateCACertKey(k8sClient)
+	require.NoError(t, err)
+
+	// A second replica must get the same CA.
+	caCert2, caKey2, caPEM2, err := getOrCreateCACertKey(k8sClient)
+	require.NoError(t, err)
+	assert.Equal(t, caPEM1, caPEM2)
+	assert.True(t, caKey1.Equal(caKey2))
+	assert.Equal(t, caCert1.Subject.CommonName, caCert2.Subject.CommonName)
+
+	// Server certificates generated by different replicas are signed by the same CA.
+	podIP := net.ParseIP("10.10.1.2")
+	serverCert, _, err := generateCertKey(caCert2, caKey2, true, "127.0.0.1", podIP)
+	require.NoError(t, err)
+	block, _ := pem.Decode(serverCert)
+	require.NotNil(t, block)
+	cert, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+	roots := x509.NewCertPool()
+	require.True(t, roots.AppendCertsFromPEM(caPEM1))
+	_, err = cert.Verify(x509.VerifyOptions{Roots: roots})
+	require.NoError(t, err)
+	assert.NoError(t, cert.VerifyHostname("127.0.0.1"))
+	assert.NoError(t, cert.VerifyHostname(podIP.String()))
+}
@@ -77,9 +77,26 @@ const (
                    throughputFromSourceNode,
                    throughputFromDestinationNode,
                    reverseThroughputFromSourceNode,
-                   reverseThroughputFromDestinationNode) 
+                   reverseThroughputFromDestinationNode,
+                   egressName,
+                   egressIP,
+                   egressNodeName,
+                   destinationFQDN) 
                    VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, 
-                           ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+                           ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	dnsInsertQuery = `INSERT INTO dns_records (
+                   responseTime,
+                   sourcePodName,
+                   sourcePodNamespace,
+                   sourceNodeName,
+                   sourceIP,
+                   destinationIP,
+                   queryName,
+                   queryType,
+                   responseCode,
+                   answers,
+                   latencyMicroseconds) 
+                   VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
 )
 
 type ClickHouseExportProcess struct {
@@ -89,6 +106,8 @@ type ClickHouseExportProcess struct {
 	dsn string
 	// deque buffers flows records between batch commits.
 	deque *deque.Deque
+	// dnsDeque buffers DNS records between batch commits.
+	dnsDeque *deque.Deque
 	// mutex is for concurrency between adding and removing records from deque.
 	mutex sync.RWMutex
 	// queueSize is the max size of deque
@@ -184,6 +203,24 @@ type ClickHouseFlowRow struct {
 	throughputFromDestinationNode        uint64
 	reverseThroughputFromSourceNode      uint64
 	reverseThroughputFromDestinationNode uint64
+	egressName                           string
+	egressIP                             string
+	egressNodeName                       string
+	destinationFQDN                      string
+}
+
+type ClickHouseDNSRow struct {
+	responseTime        time.Time
+	sourcePodName       string
+	sourcePodNamespace  string
+	sourceNodeName      string
+	sourceIP            string
+	destinationIP       string
+	queryName           string
+	queryType           uint16
+	responseCode        uint8
+	answers             string
+	latencyMicroseconds uint32
 }
 
 func NewClickHouseClient(input ClickHouseInput) (*ClickHouseExportProcess, error) {
@@ -197,6 +234,7 @@ func NewClickHouseClient(input ClickHouseInput) (*ClickHouseExportProcess, error
 		db:             connect,
 		dsn:            dsn,
 		deque:          deque.New(),
+		dnsDeque:       deque.New(),
 		mutex:          sync.RWMutex{},
 		queueSize:      maxQueueSize,
 		commitInterval: input.CommitInterval,
@@ -218,6 +256,19 @@ func (ch *ClickHouseExportProcess) CacheSet(set ipfixentities.Set) {
 	ch.deque.PushBack(chRow)
 }
 
+// CacheDNSRecord buffers a DNS record exported by an Antrea Agent until the
+// next batch commit.
+func (ch *ClickHouseExportProcess) CacheDNSRecord(record ipfixentities.Record) {
+	dnsRow := ch.getClickHouseDNSRow(record)
+
+	ch.mutex.Lock()
+	defer ch.mutex.Unlock()
+	for ch.dnsDeque.Len() >= ch.queueSize {
+		ch.dnsDeque.PopFront()
+	}
+	ch.dnsDeque.PushBack(dnsRow)
+}
+
 func (ch *ClickHouseExportProcess) Start() {
 	go ch.flowRecordPeriodicCommit()
 	<-ch.stopCh
@@ -376,9 +427,63 @@ func (ch *ClickHouseExportProcess) getClickHouseFlowRow(record ipfixentities.Rec
 	if revTputFromDstNode, _, ok := record.GetInfoElementWithValue("reverseThroughputFromDestinationNode"); ok {
 		chFlowRow.reverseThroughputFromDestinationNode = revTputFromDstNode.GetUnsigned64Value()
 	}
+	if egressName, _, ok := record.GetInfoElementWithValue("egressName"); ok {
+		chFlowRow.egressName = egressName.GetStringValue()
+	}
+	if egressIP, _, ok := record.GetInfoElementWithValue("egressIP"); ok {
+		chFlowRow.egressIP = egressIP.GetStringValue()
+	}
+	if egressNodeName, _, ok := record.GetInfoElementWithValue("egressNodeName"); ok {
+		chFlowRow.egressNodeName = egressNodeName.GetStringValue()
+	}
+	if destinationFQDN, _, ok := record.GetInfoElementWithValue("destinationFQDN"); ok {
+		chFlowRow.destinationFQDN = destinationFQDN.GetStringValue()
+	}
 	return &chFlowRow
 }
 
+func (ch *ClickHouseExportProcess) getClickHouseDNSRow(record ipfixentities.Record) *ClickHouseDNSRow {
+	dnsRow := ClickHouseDNSRow{}
+	if flowEndSeconds, _, ok := record.GetInfoElementWithValue("flowEndSeconds"); ok {
+		dnsRow.responseTime = time.Unix(int64(flowEndSeconds.GetUnsigned32Value()), 0)
+	}
+	if sourcePodName, _, ok := record.GetInfoElementWithValue("sourcePodName"); ok {
+		dnsRow.sourcePodName = sourcePodName.GetStringValue()
+	}
+	if sourcePodNamespace, _, ok := record.GetInfoElementWithValue("sourcePodNamespace"); ok {
+		dnsRow.sourcePodNamespace = sourcePodNamespace.GetStringValue()
+	}
+	if sourceNodeName, _, ok := record.GetInfoElementWithValue("sourceNodeName"); ok {
+		dnsRow.sourceNodeName = sourceNodeName.GetStringValue()
+	}
+	if sourceIPv4, _, ok := record.GetInfoElementWithValue("sourceIPv4Address"); ok {
+		dnsRow.sourceIP = sourceIPv4.GetIPAddressValue().String()
+	} else if sourceIPv6, _, ok := record.GetInfoElementWithValue("sourceIPv6Address"); ok {
+		dnsRow.sourceIP = sourceIPv6.GetIPAddressValue().String()
+	}
+	if destinationIPv4, _, ok := record.GetInfoElementWithValue("destinationIPv4Address"); ok {
+		dnsRow.destinationIP = destinationIPv4.GetIPAddressValue().String()
+	} else if destinationIPv6, _, ok := record.GetInfoElementWithValue("destinationIPv6Address"); ok {
+		dnsRow.destinationIP = destinationIPv6.GetIPAddressValue().String()
+	}
+	if queryName, _, ok := record.GetInfoElementWithValue("dnsQueryName"); ok {
+		dnsRow.queryName = queryName.GetStringValue()
+	}
+	if queryType, _, ok := record.GetInfoElementWithValue("dnsQueryType"); ok {
+		dnsRow.queryType = queryType.GetUnsigned16Value()
+	}
+	if responseCode, _, ok := record.GetInfoElementWithValue("dnsResponseCode"); ok {
+		dnsRow.responseCode = responseCode.GetUnsigned8Value()
+	}
+	if answers, _, ok := record.GetInfoElementWithValue("dnsAnswers"); ok {
+		dnsRow.answers = answers.GetStringValue()
+	}
+	if latency, _, ok := record.GetInfoElementWithValue("dnsLatencyMicroseconds"); ok {
+		dnsRow.latencyMicroseconds = latency.GetUnsigned32Value()
+	}
+	return &dnsRow
+}
+
 func (ch *ClickHouseExportProcess) flowRecordPeriodicCommit() {
 	logTicker := time.NewTicker(time.Minute)
 	committedRec := 0
@@ -393,6 +498,9 @@ func (ch *ClickHouseExportProcess) flowRecordPeriodicCommit() {
 				committedRec += committed
 				klog.V(4).InfoS("Total number of records committed to DB", "count", committedRec)
 			}
+			if _, err := ch.batchCommitDNSRecords(); err != nil {
+				klog.ErrorS(err, "Error when doing last batchCommitDNSRecords")
+			}
 			ch.commitTicker.Stop()
 			logTicker.Stop()
 			return
@@ -401,6 +509,7 @@ func (ch *ClickHouseExportProcess) flowRecordPeriodicCommit() {
 			if err == nil {
 				committedRec += committed
 			}
+			ch.batchCommitDNSRecords()
 		case <-logTicker.C:
 			klog.V(4).InfoS("Total number of records committed to DB", "count", committedRec)
 			committedRec = 0
@@ -483,7 +592,11 @@ func (ch *ClickHouseExportProcess) batchCommitAll() (int, error) {
 			record.throughputFromSourceNode,
 			record.throughputFromDestinationNode,
 			record.reverseThroughputFromSourceNode,
-			record.reverseThroughputFromDestinationNode)
+			record.reverseThroughputFromDestinationNode,
+			record.egressName,
+			record.egressIP,
+			record.egressNodeName,
+			record.destinationFQDN)
 
 		if err != nil {
 			klog.ErrorS(err, "Error when adding record")
@@ -507,6 +620,63 @@ func (ch *ClickHouseExportProcess) batchCommitAll() (int, error) {
 	return currSize, nil
 }
 
+// batchCommitDNSRecords commits all DNS records cached in local deque in one
+// INSERT query. Returns the number of records successfully committed, and error
+// if encountered. Cached records will be removed only after successful commit.
+func (ch *ClickHouseExportProcess) batchCommitDNSRecords() (int, error) {
+	currSize := ch.dnsDeque.Len()
+	if currSize == 0 {
+		return 0, nil
+	}
+
+	var stmt *sql.Stmt
+	tx, err := ch.db.Begin()
+	if err == nil {
+		stmt, err = tx.Prepare(dnsInsertQuery)
+	}
+	if err != nil {
+		klog.ErrorS(err, "Error when preparing DNS records insert statement")
+		_ = tx.Rollback()
+		return 0, err
+	}
+
+	for i := 0; i < currSize; i++ {
+		record, ok := ch.dnsDeque.At(i).(*ClickHouseDNSRow)
+		if !ok {
+			continue
+		}
+		_, err := stmt.Exec(
+			record.responseTime,
+			record.sourcePodName,
+			record.sourcePodNamespace,
+			record.sourceNodeName,
+			record.sourceIP,
+			record.destinationIP,
+			record.queryName,
+			record.queryType,
+			record.responseCode,
+			record.answers,
+			record.latencyMicroseconds)
+		if err != nil {
+			klog.ErrorS(err, "Error when adding DNS record")
+			_ = tx.Rollback()
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		klog.ErrorS(err, "Error when committing DNS records")
+		return 0, err
+	}
+
+	ch.mutex.Lock()
+	defer ch.mutex.Unlock()
+	for i := 0; i < currSize; i++ {
+		ch.dnsDeque.PopFront()
+	}
+	return currSize, nil
+}
+
 func PrepareConnection(input ClickHouseInput) (string, *sql.DB, error) {
 	dsn, err := input.getDataSourceName()
 	if err != nil {
@@ -29,12 +29,13 @@ import (
 	"github.com/stretchr/testify/assert"
 	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
 	ipfixentitiestesting "github.com/vmware/go-ipfix/pkg/entities/testing"
-	"github.com/vmware/go-ipfix/pkg/registry"
 	ipfixregistry "github.com/vmware/go-ipfix/pkg/registry"
+
+	"antrea.io/antrea/pkg/ipfix"
 )
 
 func init() {
-	registry.LoadRegistry()
+	ipfix.LoadRegistry()
 }
 
 func TestGetDataSourceName(t *testing.T) {
@@ -131,6 +132,10 @@ func TestGetClickHouseFlowRow(t *testing.T) {
 		assert.Equal(t, uint64(15902813474), flowRow.throughputFromDestinationNode)
 		assert.Equal(t, uint64(12381345), flowRow.reverseThroughputFromSourceNode)
 		assert.Equal(t, uint64(12381346), flowRow.reverseThroughputFromDestinationNode)
+		assert.Equal(t, "test-egress", flowRow.egressName)
+		assert.Equal(t, "172.18.0.1", flowRow.egressIP)
+		assert.Equal(t, "k8s-node-worker", flowRow.egressNodeName)
+		assert.Equal(t, "www.example.com", flowRow.destinationFQDN)
 
 		if tc.isIPv4 {
 			assert.Equal(t, "10.10.0.79", flowRow.sourceIP)
@@ -327,6 +332,22 @@ func prepareMockRecord(mockRecord *ipfixentitiestesting.MockRecord, isIPv4 bool)
 	reverseThroughputFromDestinationNodeElem.SetUnsigned64Value(uint64(12381346))
 	mockRecord.EXPECT().GetInfoElementWithValue("reverseThroughputFromDestinationNode").Return(reverseThroughputFromDestinationNodeElem, 0, true)
 
+	egressNameElem := createElement("egressName", ipfixregistry.AntreaEnterpriseID)
+	egressNameElem.SetStringValue("test-egress")
+	mockRecord.EXPECT().GetInfoElementWithValue("egressName").Return(egressNameElem, 0, true)
+
+	egressIPElem := createElement("egressIP", ipfixregistry.AntreaEnterpriseID)
+	egressIPElem.SetStringValue("172.18.0.1")
+	mockRecord.EXPECT().GetInfoElementWithValue("egressIP").Return(egressIPElem, 0, true)
+
+	egressNodeNameElem := createElement("egressNodeName", ipfixregistry.AntreaEnterpriseID)
+	egressNodeNameElem.SetStringValue("k8s-node-worker")
+	mockRecord.EXPECT().GetInfoElementWithValue("egressNodeName").Return(egressNodeNameElem, 0, true)
+
+	destinationFQDNElem := createElement("destinationFQDN", ipfixregistry.AntreaEnterpriseID)
+	destinationFQDNElem.SetStringValue("www.example.com")
+	mockRecord.EXPECT().GetInfoElementWithValue("destinationFQDN").Return(destinationFQDNElem, 0, true)
+
 	if isIPv4 {
 		sourceIPv4Elem := createElement("sourceIPv4Address", ipfixregistry.IANAEnterpriseID)
 		sourceIPv4Elem.SetIPAddressValue(net.ParseIP("10.10.0.79"))
@@ -450,6 +471,10 @@ func TestBatchCommitAll(t *testing.T) {
 		throughputFromDestinationNode:        15902813474,
 		reverseThroughputFromSourceNode:      12381345,
 		reverseThroughputFromDestinationNode: 12381346,
+		egressName:                           "test-egress",
+		egressIP:                             "172.18.0.1",
+		egressNodeName:                       "k8s-node-worker",
+		destinationFQDN:                      "www.example.com",
 	}
 
 	chExportProc.deque.PushBack(&recordRow)
@@ -503,7 +528,11 @@ func TestBatchCommitAll(t *testing.T) {
 			15902813473,
 			15902813474,
 			12381345,
-			12381346).
+			12381346,
+			"test-egress",
+			"172.18.0.1",
+			"k8s-node-worker",
+			"www.example.com").
 		WillReturnResult(sqlmock.NewResult(0, 1))
 	mock.ExpectCommit()
 
@@ -591,3 +620,119 @@ func TestBatchCommitAllError(t *testing.T) {
 		t.Errorf("Exists unfulfilled expectations for db sql operation: %s", err)
 	}
 }
+
+func prepareMockDNSRecord(mockRecord *ipfixentitiestesting.MockRecord) {
+	flowEndSecElem := createElement("flowEndSeconds", ipfixregistry.IANAEnterpriseID)
+	flowEndSecElem.SetUnsigned32Value(uint32(1637706973))
+	mockRecord.EXPECT().GetInfoElementWithValue("flowEndSeconds").Return(flowEndSecElem, 0, true)
+
+	for name, value := range map[string]string{
+		"sourcePodName":      "perftest-a",
+		"sourcePodNamespace": "antrea-test",
+		"sourceNodeName":     "k8s-node-control-plane",
+		"dnsQueryName":       "www.example.com",
+		"dnsAnswers":         "example.com,93.184.216.34",
+	} {
+		elem := createElement(name, ipfixregistry.AntreaEnterpriseID)
+		elem.SetStringValue(value)
+		mockRecord.EXPECT().GetInfoElementWithValue(name).Return(elem, 0, true)
+	}
+
+	sourceIPv4Elem := createElement("sourceIPv4Address", ipfixregistry.IANAEnterpriseID)
+	sourceIPv4Elem.SetIPAddressValue(net.ParseIP("10.10.0.79"))
+	mockRecord.EXPECT().GetInfoElementWithValue("sourceIPv4Address").Return(sourceIPv4Elem, 0, true)
+	destinationIPv4Elem := createElement("destinationIPv4Address", ipfixregistry.IANAEnterpriseID)
+	destinationIPv4Elem.SetIPAddressValue(net.ParseIP("10.10.0.10"))
+	mockRecord.EXPECT().GetInfoElementWithValue("destinationIPv4Address").Return(destinationIPv4Elem, 0, true)
+
+	queryTypeElem := createElement("dnsQueryType", ipfixregistry.AntreaEnterpriseID)
+	queryTypeElem.SetUnsigned16Value(uint16(1))
+	mockRecord.EXPECT().GetInfoElementWithValue("dnsQueryType").Return(queryTypeElem, 0, true)
+	responseCodeElem := createElement("dnsResponseCode", ipfixregistry.AntreaEnterpriseID)
+	responseCodeElem.SetUnsigned8Value(uint8(0))
+	mockRecord.EXPECT().GetInfoElementWithValue("dnsResponseCode").Return(responseCodeElem, 0, true)
+	latencyElem := createElement("dnsLatencyMicroseconds", ipfixregistry.AntreaEnterpriseID)
+	latencyElem.SetUnsigned32Value(uint32(1500))
+	mockRecord.EXPECT().GetInfoElementWithValue("dnsLatencyMicroseconds").Return(latencyElem, 0, true)
+}
+
+func TestCacheDNSRecord(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	chExportProc := ClickHouseExportProcess{
+		dnsDeque:  deque.New(),
+		mutex:     sync.RWMutex{},
+		queueSize: 1,
+	}
+	mockRecord := ipfixentitiestesting.NewMockRecord(ctrl)
+	prepareMockDNSRecord(mockRecord)
+	chExportProc.CacheDNSRecord(mockRecord)
+	assert.Equal(t, 1, chExportProc.dnsDeque.Len())
+	assert.Equal(t, &ClickHouseDNSRow{
+		responseTime:        time.Unix(int64(1637706973), 0),
+		sourcePodName:       "perftest-a",
+		sourcePodNamespace:  "antrea-test",
+		sourceNodeName:      "k8s-node-control-plane",
+		sourceIP:            "10.10.0.79",
+		destinationIP:       "10.10.0.10",
+		queryName:           "www.example.com",
+		queryType:           1,
+		responseCode:        0,
+		answers:             "example.com,93.184.216.34",
+		latencyMicroseconds: 1500,
+	}, chExportProc.dnsDeque.At(0).(*ClickHouseDNSRow))
+}
+
+func TestBatchCommitDNSRecords(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	chExportProc := ClickHouseExportProcess{
+		db:       db,
+		dnsDeque: deque.New(),
+		mutex:    sync.RWMutex{},
+	}
+	dnsRow := ClickHouseDNSRow{
+		responseTime:        time.Unix(int64(1637706973), 0),
+		sourcePodName:       "perftest-a",
+		sourcePodNamespace:  "antrea-test",
+		sourceNodeName:      "k8s-node-control-plane",
+		sourceIP:            "10.10.0.79",
+		destinationIP:       "10.10.0.10",
+		queryName:           "www.example.com",
+		queryType:           1,
+		responseCode:        3,
+		answers:             "",
+		latencyMicroseconds: 1500,
+	}
+	chExportProc.dnsDeque.PushBack(&dnsRow)
+
+	mock.ExpectBegin()
+	mock.ExpectPrepare(dnsInsertQuery).ExpectExec().
+		WithArgs(
+			time.Unix(int64(1637706973), 0),
+			"perftest-a",
+			"antrea-test",
+			"k8s-node-control-plane",
+			"10.10.0.79",
+			"10.10.0.10",
+			"www.example.com",
+			1,
+			3,
+			"",
+			1500).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	count, err := chExportProc.batchCommitDNSRecords()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, 0, chExportProc.dnsDeque.Len())
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Exists unfulfilled expectations for db sql operation: %s", err)
+	}
+}
@@ -0,0 +1,522 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
+	ipfixregistry "github.com/vmware/go-ipfix/pkg/registry"
+	"github.com/xitongsys/parquet-go-source/buffer"
+	"github.com/xitongsys/parquet-go/reader"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	flowpb "antrea.io/antrea/pkg/apis/flow/v1alpha1"
+	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
+	"antrea.io/antrea/pkg/flowaggregator/options"
+)
+
+type fakeProducer struct {
+	mutex    sync.Mutex
+	err      error
+	produced []*sarama.ProducerMessage
+	closed   bool
+}
+
+func (p *fakeProducer) SendMessages(messages []*sarama.ProducerMessage) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.err != nil {
+		return p.err
+	}
+	p.produced = append(p.produced, messages...)
+	return nil
+}
+
+func (p *fakeProducer) Close() error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.closed = true
+	return nil
+}
+
+func TestKafkaExporter(t *testing.T) {
+	producer := &fakeProducer{}
+	connectErr := fmt.Errorf("brokers not available")
+	exp := newKafkaExporter(func() (kafkaProducer, error) {
+		if connectErr != nil {
+			return nil, connectErr
+		}
+		return producer, nil
+	}, "flows", "JSON", time.Hour)
+	exp.Start()
+	require.NoError(t, exp.AddRecord(createTestRecord(t, false)))
+	require.NoError(t, exp.AddRecord(createTestRecord(t, true)))
+
+	// The messages are kept when the brokers cannot be reached.
+	exp.flush()
+	assert.Len(t, exp.messages, 2)
+
+	// Only the messages which could not be delivered are kept.
+	connectErr = nil
+	producer.err = sarama.ProducerErrors{&sarama.ProducerError{Msg: exp.messages[1], Err: sarama.ErrNotLeaderForPartition}}
+	exp.flush()
+	require.Len(t, exp.messages, 1)
+	assert.Equal(t, "2001:0:3238:dfe1:63::fefb|2001:0:3238:dfe1:63::fefc|44752|5201|6", string(exp.messages[0].Key.(sarama.ByteEncoder)))
+
+	producer.mutex.Lock()
+	producer.err = nil
+	producer.mutex.Unlock()
+	// The remaining messages are flushed when stopping the exporter.
+	exp.Stop()
+	assert.True(t, producer.closed)
+	require.Len(t, producer.produced, 1)
+	assert.Equal(t, "flows", producer.produced[0].Topic)
+	var decoded map[string]interface{}
+	require.NoError(t, json.Unmarshal(producer.produced[0].Value.(sarama.ByteEncoder), &decoded))
+	assert.Equal(t, "perftest-a", decoded["sourcePodName"])
+}
+
+func TestKafkaExporterProtobuf(t *testing.T) {
+	producer := &fakeProducer{}
+	exp := newKafkaExporter(func() (kafkaProducer, error) { return producer, nil }, "flows", "Protobuf", time.Hour)
+	require.NoError(t, exp.AddRecord(createTestRecord(t, false)))
+	exp.flush()
+	require.Len(t, producer.produced, 1)
+	var decoded flowpb.FlowRecord
+	require.NoError(t, proto.Unmarshal(producer.produced[0].Value.(sarama.ByteEncoder), &decoded))
+	assert.Equal(t, "perftest-a", decoded.SourcePodName)
+	assert.Equal(t, uint32(44752), decoded.SourceTransportPort)
+}
+
+func TestFlowLoggerExporter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "flow-logger")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "flows.log")
+	compress := false
+	exp := NewFlowLoggerExporter(&options.Options{Config: &flowaggregatorconfig.FlowAggregatorConfig{
+		FlowLogger: flowaggregatorconfig.FlowLoggerConfig{Path: path, MaxSize: 1, MaxBackups: 1, Compress: &compress},
+	}})
+	exp.Start()
+	require.NoError(t, exp.AddRecord(createTestRecord(t, false)))
+	require.NoError(t, exp.AddRecord(createTestRecord(t, true)))
+	exp.Stop()
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	var sourceIPs []interface{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var decoded map[string]interface{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &decoded))
+		sourceIPs = append(sourceIPs, decoded["sourceIP"])
+	}
+	assert.Equal(t, []interface{}{"10.10.0.1", "2001:0:3238:dfe1:63::fefb"}, sourceIPs)
+}
+
+type fakeS3Uploader struct {
+	mutex    sync.Mutex
+	failures int
+	inputs   []*s3.PutObjectInput
+	data     [][]byte
+}
+
+func (u *fakeS3Uploader) Upload(ctx context.Context, input *s3.PutObjectInput, opts ...func(*manager.Uploader)) (*manager.UploadOutput, error) {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+	if u.failures > 0 {
+		u.failures--
+		return nil, fmt.Errorf("service unavailable")
+	}
+	data, err := ioutil.ReadAll(input.Body)
+	if err != nil {
+		return nil, err
+	}
+	u.inputs = append(u.inputs, input)
+	u.data = append(u.data, data)
+	return &manager.UploadOutput{}, nil
+}
+
+func TestS3ExporterCSV(t *testing.T) {
+	uploader := &fakeS3Uploader{failures: 1}
+	exp := newS3Exporter(uploader, "bucket", "antrea", "CSV", true, 2, time.Hour)
+
+	// The first upload fails, and the batch is kept for the next one.
+	require.NoError(t, exp.AddRecord(createTestRecord(t, false)))
+	require.NoError(t, exp.AddRecord(createTestRecord(t, true)))
+	exp.upload(false)
+	assert.Len(t, exp.pending, 1)
+	require.NoError(t, exp.AddRecord(createTestRecord(t, false)))
+	exp.upload(true)
+	assert.Empty(t, exp.pending)
+
+	require.Len(t, uploader.inputs, 2)
+	var rows [][][]string
+	for i, input := range uploader.inputs {
+		assert.Equal(t, "bucket", *input.Bucket)
+		assert.True(t, strings.HasPrefix(*input.Key, "antrea/records-"))
+		assert.True(t, strings.HasSuffix(*input.Key, ".csv.gz"))
+		gz, err := gzip.NewReader(bytes.NewReader(uploader.data[i]))
+		require.NoError(t, err)
+		r, err := csv.NewReader(gz).ReadAll()
+		require.NoError(t, err)
+		assert.Equal(t, csvHeader(), r[0])
+		rows = append(rows, r)
+	}
+	assert.Len(t, rows[0], 3)
+	assert.Equal(t, "2001:0:3238:dfe1:63::fefb", rows[0][2][fieldIndex("sourceIP")])
+	assert.Len(t, rows[1], 2)
+}
+
+func TestS3ExporterParquet(t *testing.T) {
+	uploader := &fakeS3Uploader{}
+	exp := newS3Exporter(uploader, "bucket", "", "Parquet", true, 10, time.Hour)
+	require.NoError(t, exp.AddRecord(createTestRecord(t, false)))
+	require.NoError(t, exp.AddRecord(createTestRecord(t, true)))
+	exp.upload(true)
+
+	require.Len(t, uploader.inputs, 1)
+	assert.True(t, strings.HasSuffix(*uploader.inputs[0].Key, ".parquet"))
+	file, err := buffer.NewBufferFile(uploader.data[0])
+	require.NoError(t, err)
+	pr, err := reader.NewParquetColumnReader(file, 1)
+	require.NoError(t, err)
+	defer pr.ReadStop()
+	require.Equal(t, int64(2), pr.GetNumRows())
+	sourceIPs, _, _, err := pr.ReadColumnByIndex(int64(fieldIndex("sourceIP")), 2)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"10.10.0.1", "2001:0:3238:dfe1:63::fefb"}, sourceIPs)
+	packets, _, _, err := pr.ReadColumnByIndex(int64(fieldIndex("packetTotalCount")), 2)
+	require.NoError(t, err)
+	assert.Equal(t, int64(823188), packets[0])
+}
+
+type fakeOTLPClient struct {
+	mutex   sync.Mutex
+	err     error
+	logs    []*otlpLogRecord
+	metrics [][]*otlpMetric
+	closed  bool
+}
+
+func (c *fakeOTLPClient) ExportLogs(ctx context.Context, logs []*otlpLogRecord) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.err != nil {
+		return c.err
+	}
+	c.logs = append(c.logs, logs...)
+	return nil
+}
+
+func (c *fakeOTLPClient) ExportMetrics(ctx context.Context, metrics []*otlpMetric) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.err != nil {
+		return c.err
+	}
+	c.metrics = append(c.metrics, metrics)
+	return nil
+}
+
+func (c *fakeOTLPClient) Close() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.closed = true
+	return nil
+}
+
+func createServiceTestRecord(t *testing.T, sourcePort uint16, flowEndReason uint8, octets, throughput uint64) ipfixentities.Record {
+	getElement := func(name string, enterpriseID uint32) *ipfixentities.InfoElement {
+		element, err := ipfixregistry.GetInfoElement(name, enterpriseID)
+		require.NoError(t, err)
+		return element
+	}
+	elements := []ipfixentities.InfoElementWithValue{
+		ipfixentities.NewDateTimeSecondsInfoElement(getElement("flowEndSeconds", ipfixregistry.IANAEnterpriseID), 1637706961),
+		ipfixentities.NewUnsigned8InfoElement(getElement("flowEndReason", ipfixregistry.IANAEnterpriseID), flowEndReason),
+		ipfixentities.NewUnsigned16InfoElement(getElement("sourceTransportPort", ipfixregistry.IANAEnterpriseID), sourcePort),
+		ipfixentities.NewUnsigned64InfoElement(getElement("octetDeltaCount", ipfixregistry.IANAEnterpriseID), octets),
+		ipfixentities.NewUnsigned64InfoElement(getElement("packetDeltaCount", ipfixregistry.IANAEnterpriseID), 10),
+		ipfixentities.NewStringInfoElement(getElement("sourcePodNamespace", ipfixregistry.AntreaEnterpriseID), "frontend"),
+		ipfixentities.NewStringInfoElement(getElement("destinationPodNamespace", ipfixregistry.AntreaEnterpriseID), "backend"),
+		ipfixentities.NewStringInfoElement(getElement("destinationServicePortName", ipfixregistry.AntreaEnterpriseID), "backend/api:http"),
+		ipfixentities.NewUnsigned64InfoElement(getElement("throughput", ipfixregistry.AntreaEnterpriseID), throughput),
+	}
+	record := ipfixentities.NewDataRecord(256, len(elements), 0, true)
+	for _, ie := range elements {
+		require.NoError(t, record.AddInfoElement(ie))
+	}
+	return record
+}
+
+func TestOTLPExporterLogs(t *testing.T) {
+	client := &fakeOTLPClient{err: fmt.Errorf("collector not available")}
+	exp := newOTLPExporter(client, "collector:4317", true, false, time.Hour, time.Minute)
+	exp.Start()
+	require.NoError(t, exp.AddRecord(createTestRecord(t, false)))
+	require.NoError(t, exp.AddRecord(createTestRecord(t, true)))
+
+	// The log records are kept when they cannot be exported.
+	exp.flushLogs()
+	assert.Len(t, exp.logs, 2)
+
+	client.mutex.Lock()
+	client.err = nil
+	client.mutex.Unlock()
+	// The remaining log records are exported when stopping the exporter.
+	exp.Stop()
+	assert.True(t, client.closed)
+	require.Len(t, client.logs, 2)
+	assert.Empty(t, client.metrics)
+	assert.Equal(t, "10.10.0.1|10.10.1.2|44752|5201|6", client.logs[0].body)
+	attributes := make(map[string]interface{})
+	for _, a := range client.logs[0].attributes {
+		attributes[a.key] = a.value
+	}
+	assert.Equal(t, "flow", attributes["event.name"])
+	assert.Equal(t, "perftest-a", attributes["sourcePodName"])
+	assert.Equal(t, int64(823188), attributes["packetTotalCount"])
+}
+
+func TestOTLPExporterMetrics(t *testing.T) {
+	client := &fakeOTLPClient{}
+	exp := newOTLPExporter(client, "collector:4317", false, true, time.Hour, time.Minute)
+	require.NoError(t, exp.AddRecord(createServiceTestRecord(t, 40000, ipfixregistry.ActiveTimeoutReason, 1000, 800)))
+	require.NoError(t, exp.AddRecord(createServiceTestRecord(t, 40001, ipfixregistry.ActiveTimeoutReason, 500, 400)))
+	// The last record of a flow removes it from the active flows.
+	require.NoError(t, exp.AddRecord(createServiceTestRecord(t, 40000, ipfixregistry.EndOfFlowReason, 200, 100)))
+	assert.Empty(t, exp.logs)
+
+	getMetric := func(metrics []*otlpMetric, name string) *otlpMetric {
+		for _, m := range metrics {
+			if m.name == name {
+				return m
+			}
+		}
+		require.Failf(t, "metric not found", "%s", name)
+		return nil
+	}
+	now := time.Now()
+	exp.exportMetricsOnce(now)
+	require.Len(t, client.metrics, 1)
+	metrics := client.metrics[0]
+	require.Len(t, metrics, 6)
+	bytes := getMetric(metrics, "antrea.flow.bytes")
+	require.Len(t, bytes.dataPoints, 1)
+	assert.Equal(t, int64(1700), bytes.dataPoints[0].value)
+	assert.True(t, bytes.sum)
+	assert.Equal(t, otlpAttribute{key: "destination_service", value: "backend/api:http"}, bytes.dataPoints[0].attributes[2])
+	packets := getMetric(metrics, "antrea.flow.packets")
+	assert.Equal(t, int64(30), packets.dataPoints[0].value)
+	throughput := getMetric(metrics, "antrea.flow.throughput")
+	assert.False(t, throughput.sum)
+	assert.Equal(t, float64(400), throughput.dataPoints[0].value)
+
+	// Active flows which are not updated anymore are ignored after the
+	// timeout, while the counters are kept.
+	exp.exportMetricsOnce(now.Add(2 * time.Minute))
+	require.Len(t, client.metrics, 2)
+	metrics = client.metrics[1]
+	assert.Equal(t, int64(1700), getMetric(metrics, "antrea.flow.bytes").dataPoints[0].value)
+	assert.Equal(t, float64(0), getMetric(metrics, "antrea.flow.throughput").dataPoints[0].value)
+	assert.Empty(t, exp.activeFlows)
+}
+
+// protoMessage is a decoded protobuf message, indexed by field number. The
+// values of the embedded messages and strings are []byte, the values of the
+// varint and fixed64 fields are uint64.
+type protoMessage map[protowire.Number][]interface{}
+
+func decodeProtoMessage(t *testing.T, b []byte) protoMessage {
+	m := make(protoMessage)
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		require.GreaterOrEqual(t, n, 0, "invalid tag")
+		b = b[n:]
+		var value interface{}
+		switch typ {
+		case protowire.VarintType:
+			value, n = protowire.ConsumeVarint(b)
+		case protowire.Fixed64Type:
+			value, n = protowire.ConsumeFixed64(b)
+		case protowire.BytesType:
+			value, n = protowire.ConsumeBytes(b)
+		default:
+			require.Failf(t, "unexpected wire type", "field %d has wire type %d", num, typ)
+		}
+		require.GreaterOrEqual(t, n, 0, "invalid value for field %d", num)
+		b = b[n:]
+		m[num] = append(m[num], value)
+	}
+	return m
+}
+
+func (m protoMessage) messages(t *testing.T, num protowire.Number) []protoMessage {
+	var messages []protoMessage
+	for _, v := range m[num] {
+		messages = append(messages, decodeProtoMessage(t, v.([]byte)))
+	}
+	return messages
+}
+
+func (m protoMessage) message(t *testing.T, num protowire.Number) protoMessage {
+	messages := m.messages(t, num)
+	require.Len(t, messages, 1, "field %d", num)
+	return messages[0]
+}
+
+func (m protoMessage) string(t *testing.T, num protowire.Number) string {
+	require.Len(t, m[num], 1, "field %d", num)
+	return string(m[num][0].([]byte))
+}
+
+func (m protoMessage) uint(t *testing.T, num protowire.Number) uint64 {
+	require.Len(t, m[num], 1, "field %d", num)
+	return m[num][0].(uint64)
+}
+
+// attributes decodes the KeyValue messages of a field. Only the string and
+// int values are decoded.
+func (m protoMessage) attributes(t *testing.T, num protowire.Number) map[string]interface{} {
+	attributes := make(map[string]interface{})
+	for _, kv := range m.messages(t, num) {
+		value := kv.message(t, 2)
+		if _, ok := value[1]; ok {
+			attributes[kv.string(t, 1)] = value.string(t, 1)
+		} else if _, ok := value[3]; ok {
+			attributes[kv.string(t, 1)] = int64(value.uint(t, 3))
+		}
+	}
+	return attributes
+}
+
+// TestOTLPExportRequests sends the requests to a gRPC server and decodes them
+// according to the OTLP protobuf definitions (opentelemetry-proto v0.19.0).
+func TestOTLPExportRequests(t *testing.T) {
+	requests := make(map[string][]byte)
+	var mutex sync.Mutex
+	server := grpc.NewServer(
+		grpc.ForceServerCodec(otlpCodec{}),
+		grpc.UnknownServiceHandler(func(srv interface{}, stream grpc.ServerStream) error {
+			method, _ := grpc.MethodFromServerStream(stream)
+			var req otlpRawMessage
+			if err := stream.RecvMsg(&req); err != nil {
+				return err
+			}
+			mutex.Lock()
+			defer mutex.Unlock()
+			requests[method] = req
+			return stream.SendMsg(otlpRawMessage(nil))
+		}),
+	)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	client := &grpcOTLPClient{
+		conn:     conn,
+		resource: []otlpAttribute{{key: "service.name", value: otlpServiceName}},
+	}
+	defer client.Close()
+	exp := newOTLPExporter(client, listener.Addr().String(), true, true, time.Hour, time.Minute)
+	require.NoError(t, exp.AddRecord(createServiceTestRecord(t, 40000, ipfixregistry.ActiveTimeoutReason, 1000, 800)))
+	exp.flushLogs()
+	exp.exportMetricsOnce(time.Now())
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	// ExportLogsServiceRequest.resource_logs
+	resourceLogs := decodeProtoMessage(t, requests[otlpLogsExportMethod]).message(t, 1)
+	// ResourceLogs.resource
+	assert.Equal(t, map[string]interface{}{"service.name": otlpServiceName}, resourceLogs.message(t, 1).attributes(t, 1))
+	// ResourceLogs.scope_logs
+	scopeLogs := resourceLogs.message(t, 2)
+	assert.Equal(t, otlpScopeName, scopeLogs.message(t, 1).string(t, 1))
+	// ScopeLogs.log_records
+	logRecord := scopeLogs.message(t, 2)
+	assert.Equal(t, uint64(1637706961*time.Second), logRecord.uint(t, 1))
+	assert.Equal(t, uint64(9), logRecord.uint(t, 2))
+	assert.Equal(t, "INFO", logRecord.string(t, 3))
+	// The name field (4) has been removed from LogRecord.
+	assert.NotContains(t, logRecord, protowire.Number(4))
+	assert.NotEmpty(t, logRecord.message(t, 5).string(t, 1))
+	logAttributes := logRecord.attributes(t, 6)
+	assert.Equal(t, "flow", logAttributes["event.name"])
+	assert.Equal(t, "backend", logAttributes["destinationPodNamespace"])
+	assert.Equal(t, int64(1000), logAttributes["octetDeltaCount"])
+	assert.NotZero(t, logRecord.uint(t, 11))
+
+	// ExportMetricsServiceRequest.resource_metrics
+	resourceMetrics := decodeProtoMessage(t, requests[otlpMetricsExportMethod]).message(t, 1)
+	// ResourceMetrics.scope_metrics
+	scopeMetrics := resourceMetrics.message(t, 2)
+	assert.Equal(t, otlpScopeName, scopeMetrics.message(t, 1).string(t, 1))
+	metrics := make(map[string]protoMessage)
+	for _, metric := range scopeMetrics.messages(t, 2) {
+		metrics[metric.string(t, 1)] = metric
+	}
+	require.Len(t, metrics, 6)
+	edgeAttributes := map[string]interface{}{
+		"source_namespace":      "frontend",
+		"destination_namespace": "backend",
+		"destination_service":   "backend/api:http",
+	}
+	// Metric.sum
+	sum := metrics["antrea.flow.bytes"].message(t, 7)
+	assert.Equal(t, uint64(2), sum.uint(t, 2))
+	assert.Equal(t, uint64(1), sum.uint(t, 3))
+	// Sum.data_points
+	dataPoint := sum.message(t, 1)
+	assert.NotZero(t, dataPoint.uint(t, 2))
+	assert.NotZero(t, dataPoint.uint(t, 3))
+	// NumberDataPoint.as_int
+	assert.Equal(t, uint64(1000), dataPoint.uint(t, 6))
+	// NumberDataPoint.attributes, which replace the removed labels (1).
+	assert.Equal(t, edgeAttributes, dataPoint.attributes(t, 7))
+	assert.NotContains(t, dataPoint, protowire.Number(1))
+	// Metric.gauge
+	dataPoint = metrics["antrea.flow.throughput"].message(t, 5).message(t, 1)
+	// NumberDataPoint.as_double
+	assert.Equal(t, float64(800), math.Float64frombits(dataPoint.uint(t, 4)))
+	assert.Equal(t, edgeAttributes, dataPoint.attributes(t, 7))
+}
@@ -0,0 +1,67 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"io"
+
+	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
+	"gopkg.in/natefinch/lumberjack.v2"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/flowaggregator/options"
+)
+
+// FlowLoggerExporter writes flow records to a local file, one JSON object per
+// line. The file is rotated when it reaches the configured size.
+type FlowLoggerExporter struct {
+	path   string
+	writer io.WriteCloser
+}
+
+func NewFlowLoggerExporter(opt *options.Options) *FlowLoggerExporter {
+	config := opt.Config.FlowLogger
+	return &FlowLoggerExporter{
+		path: config.Path,
+		// Use lumberjack log file rotation.
+		writer: &lumberjack.Logger{
+			Filename:   config.Path,
+			MaxSize:    config.MaxSize,
+			MaxBackups: config.MaxBackups,
+			MaxAge:     config.MaxAge,
+			Compress:   *config.Compress,
+		},
+	}
+}
+
+func (e *FlowLoggerExporter) Start() {
+	klog.InfoS("Starting flow logger", "path", e.path)
+}
+
+func (e *FlowLoggerExporter) Stop() {
+	if err := e.writer.Close(); err != nil {
+		klog.ErrorS(err, "Error when closing flow log file", "path", e.path)
+	}
+	klog.InfoS("Stopped flow logger", "path", e.path)
+}
+
+func (e *FlowLoggerExporter) AddRecord(record ipfixentities.Record) error {
+	line, err := newFlowRecord(record).toJSON()
+	if err != nil {
+		return err
+	}
+	_, err = e.writer.Write(append(line, '\n'))
+	return err
+}
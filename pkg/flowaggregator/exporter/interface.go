@@ -0,0 +1,66 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
+
+	"antrea.io/antrea/pkg/flowaggregator/options"
+)
+
+// Interface is implemented by the sinks the flow aggregator exports the
+// aggregated flow records to.
+type Interface interface {
+	// Start starts the background routines of the exporter, if any.
+	Start()
+	// Stop stops the exporter, flushing the records which are still buffered.
+	Stop()
+	// AddRecord exports a flow record. The record is modified by the flow
+	// aggregator after AddRecord returns, so exporters which buffer records
+	// must not keep a reference to it.
+	AddRecord(record ipfixentities.Record) error
+}
+
+// NewExporters creates the exporters enabled in the configuration, other than
+// the IPFIX collector and ClickHouse, which are managed by the flow aggregator
+// directly.
+func NewExporters(opt *options.Options) ([]Interface, error) {
+	var exporters []Interface
+	if opt.Config.Kafka.Enable {
+		exp, err := NewKafkaExporter(opt)
+		if err != nil {
+			return nil, err
+		}
+		exporters = append(exporters, exp)
+	}
+	if opt.Config.FlowLogger.Enable {
+		exporters = append(exporters, NewFlowLoggerExporter(opt))
+	}
+	if opt.Config.S3Uploader.Enable {
+		exp, err := NewS3Exporter(opt)
+		if err != nil {
+			return nil, err
+		}
+		exporters = append(exporters, exp)
+	}
+	if opt.Config.OTLP.Enable {
+		exp, err := NewOTLPExporter(opt)
+		if err != nil {
+			return nil, err
+		}
+		exporters = append(exporters, exp)
+	}
+	return exporters, nil
+}
@@ -0,0 +1,242 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	"github.com/Shopify/sarama"
+	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/flowaggregator/options"
+)
+
+const (
+	// maxKafkaBufferedMessages bounds the number of messages buffered while
+	// Kafka is unavailable. The oldest messages are dropped first.
+	maxKafkaBufferedMessages = 1 << 17
+	// kafkaBatchSize is the maximum number of messages produced at once. A
+	// flush is triggered whenever this number of messages has been buffered.
+	kafkaBatchSize = 1000
+	kafkaClientID  = "antrea-flow-aggregator"
+)
+
+// kafkaProducer is the subset of sarama.SyncProducer used by KafkaExporter.
+type kafkaProducer interface {
+	SendMessages(messages []*sarama.ProducerMessage) error
+	Close() error
+}
+
+// KafkaExporter produces flow records to a Kafka topic, encoded as JSON or as
+// Protobuf FlowRecord messages. The key of the messages is the 5-tuple of the
+// flow.
+type KafkaExporter struct {
+	// newProducer creates the producer, which connects to the brokers. It is
+	// called again at the next flush when it fails, so that the exporter can be
+	// started while Kafka is unavailable.
+	newProducer   func() (kafkaProducer, error)
+	producer      kafkaProducer
+	topic         string
+	recordFormat  string
+	flushInterval time.Duration
+
+	mutex      sync.Mutex
+	messages   []*sarama.ProducerMessage
+	numDropped int
+	flushCh    chan struct{}
+	stopCh     chan struct{}
+	stoppedCh  chan struct{}
+}
+
+func NewKafkaExporter(opt *options.Options) (*KafkaExporter, error) {
+	config := opt.Config.Kafka
+	saramaConfig := sarama.NewConfig()
+	saramaConfig.ClientID = kafkaClientID
+	// Messages are committed by all the in-sync replicas before being
+	// considered as produced.
+	saramaConfig.Producer.RequiredAcks = sarama.WaitForAll
+	saramaConfig.Producer.Return.Successes = true
+	saramaConfig.Producer.Partitioner = sarama.NewHashPartitioner
+	if config.TLS.Enable {
+		tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+		if config.TLS.CACertFile != "" {
+			caCert, err := ioutil.ReadFile(config.TLS.CACertFile)
+			if err != nil {
+				return nil, fmt.Errorf("error when reading Kafka CA certificate: %v", err)
+			}
+			tlsConfig.RootCAs = x509.NewCertPool()
+			if !tlsConfig.RootCAs.AppendCertsFromPEM(caCert) {
+				return nil, fmt.Errorf("no valid certificate found in %s", config.TLS.CACertFile)
+			}
+		}
+		saramaConfig.Net.TLS.Enable = true
+		saramaConfig.Net.TLS.Config = tlsConfig
+	}
+	if err := saramaConfig.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid Kafka producer configuration: %v", err)
+	}
+	newProducer := func() (kafkaProducer, error) {
+		return sarama.NewSyncProducer(config.Brokers, saramaConfig)
+	}
+	return newKafkaExporter(newProducer, config.Topic, config.RecordFormat, opt.KafkaFlushInterval), nil
+}
+
+func newKafkaExporter(newProducer func() (kafkaProducer, error), topic, recordFormat string, flushInterval time.Duration) *KafkaExporter {
+	return &KafkaExporter{
+		newProducer:   newProducer,
+		topic:         topic,
+		recordFormat:  recordFormat,
+		flushInterval: flushInterval,
+		flushCh:       make(chan struct{}, 1),
+		stopCh:        make(chan struct{}),
+		stoppedCh:     make(chan struct{}),
+	}
+}
+
+func (e *KafkaExporter) Start() {
+	klog.InfoS("Starting Kafka exporter", "topic", e.topic, "format", e.recordFormat)
+	go e.run()
+}
+
+func (e *KafkaExporter) Stop() {
+	close(e.stopCh)
+	<-e.stoppedCh
+	klog.InfoS("Stopped Kafka exporter", "topic", e.topic)
+}
+
+func (e *KafkaExporter) AddRecord(record ipfixentities.Record) error {
+	r := newFlowRecord(record)
+	var value []byte
+	var err error
+	if e.recordFormat == "Protobuf" {
+		value, err = r.toProtobuf()
+	} else {
+		value, err = r.toJSON()
+	}
+	if err != nil {
+		return err
+	}
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.messages = append(e.messages, &sarama.ProducerMessage{
+		Topic:     e.topic,
+		Key:       sarama.ByteEncoder(r.key()),
+		Value:     sarama.ByteEncoder(value),
+		Timestamp: time.Now(),
+	})
+	e.dropOldMessages()
+	// Checking for a multiple of the batch size avoids retrying for every new
+	// record while Kafka is unavailable.
+	if len(e.messages)%kafkaBatchSize == 0 {
+		select {
+		case e.flushCh <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+// dropOldMessages ensures that at most maxKafkaBufferedMessages are buffered.
+// It must be called with the mutex held.
+func (e *KafkaExporter) dropOldMessages() {
+	if n := len(e.messages) - maxKafkaBufferedMessages; n > 0 {
+		e.messages = e.messages[n:]
+		e.numDropped += n
+	}
+}
+
+func (e *KafkaExporter) run() {
+	defer close(e.stoppedCh)
+	ticker := time.NewTicker(e.flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-e.stopCh:
+			e.flush()
+			if e.producer != nil {
+				if err := e.producer.Close(); err != nil {
+					klog.ErrorS(err, "Error when closing Kafka producer")
+				}
+			}
+			return
+		case <-ticker.C:
+			e.flush()
+		case <-e.flushCh:
+			e.flush()
+		}
+	}
+}
+
+// flush produces the buffered messages. The messages which cannot be produced
+// are buffered again, to be retried at the next flush.
+func (e *KafkaExporter) flush() {
+	e.mutex.Lock()
+	messages := e.messages
+	e.messages = nil
+	numDropped := e.numDropped
+	e.numDropped = 0
+	e.mutex.Unlock()
+	if numDropped > 0 {
+		klog.InfoS("Dropped flow records as Kafka is not available", "count", numDropped)
+	}
+	if len(messages) == 0 {
+		return
+	}
+
+	requeue := func(failed []*sarama.ProducerMessage) {
+		e.mutex.Lock()
+		defer e.mutex.Unlock()
+		e.messages = append(failed, e.messages...)
+		e.dropOldMessages()
+	}
+	if e.producer == nil {
+		producer, err := e.newProducer()
+		if err != nil {
+			klog.ErrorS(err, "Error when connecting to Kafka")
+			requeue(messages)
+			return
+		}
+		e.producer = producer
+	}
+	for len(messages) > 0 {
+		n := len(messages)
+		if n > kafkaBatchSize {
+			n = kafkaBatchSize
+		}
+		if err := e.producer.SendMessages(messages[:n]); err != nil {
+			klog.ErrorS(err, "Error when producing flow records to Kafka", "topic", e.topic)
+			// Only the messages which could not be delivered are retried.
+			failed := messages[:n]
+			var producerErrs sarama.ProducerErrors
+			if errors.As(err, &producerErrs) {
+				failed = make([]*sarama.ProducerMessage, 0, len(producerErrs))
+				for _, producerErr := range producerErrs {
+					failed = append(failed, producerErr.Msg)
+				}
+			}
+			requeue(append(failed, messages[n:]...))
+			return
+		}
+		klog.V(4).InfoS("Produced flow records to Kafka", "count", n, "topic", e.topic)
+		messages = messages[n:]
+	}
+}
@@ -0,0 +1,464 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"sync"
+	"time"
+
+	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
+	ipfixregistry "github.com/vmware/go-ipfix/pkg/registry"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/flowaggregator/options"
+)
+
+const (
+	// maxOTLPBufferedLogs bounds the number of log records buffered while the
+	// collector is unavailable. The oldest log records are dropped first.
+	maxOTLPBufferedLogs = 1 << 17
+	// otlpBatchSize is the maximum number of log records exported at once. An
+	// export is triggered whenever this number of log records has been
+	// buffered.
+	otlpBatchSize = 1000
+	// otlpExportTimeout is the timeout of a single export request.
+	otlpExportTimeout = 10 * time.Second
+	// otlpServiceName is the value of the service.name resource attribute.
+	otlpServiceName = "flow-aggregator"
+	// otlpScopeName is the name of the instrumentation scope reported with
+	// the logs and metrics.
+	otlpScopeName = "antrea.io/antrea/pkg/flowaggregator"
+)
+
+type otlpClient interface {
+	ExportLogs(ctx context.Context, logs []*otlpLogRecord) error
+	ExportMetrics(ctx context.Context, metrics []*otlpMetric) error
+	Close() error
+}
+
+// grpcOTLPClient exports logs and metrics to the OTLP/gRPC receiver of an
+// OpenTelemetry collector.
+type grpcOTLPClient struct {
+	conn     *grpc.ClientConn
+	resource []otlpAttribute
+}
+
+func (c *grpcOTLPClient) ExportLogs(ctx context.Context, logs []*otlpLogRecord) error {
+	var resp otlpRawMessage
+	req := marshalExportLogsServiceRequest(c.resource, otlpScopeName, logs)
+	return c.conn.Invoke(ctx, otlpLogsExportMethod, req, &resp, grpc.ForceCodec(otlpCodec{}))
+}
+
+func (c *grpcOTLPClient) ExportMetrics(ctx context.Context, metrics []*otlpMetric) error {
+	var resp otlpRawMessage
+	req := marshalExportMetricsServiceRequest(c.resource, otlpScopeName, metrics)
+	return c.conn.Invoke(ctx, otlpMetricsExportMethod, req, &resp, grpc.ForceCodec(otlpCodec{}))
+}
+
+func (c *grpcOTLPClient) Close() error {
+	return c.conn.Close()
+}
+
+// serviceEdge identifies the traffic from the Pods of a Namespace to a
+// Service. destinationService is empty for the traffic which does not go
+// through a Service.
+type serviceEdge struct {
+	sourceNamespace      string
+	destinationNamespace string
+	destinationService   string
+}
+
+// edgeCounters holds the cumulative counters of a serviceEdge.
+type edgeCounters struct {
+	startTime      time.Time
+	octets         uint64
+	packets        uint64
+	reverseOctets  uint64
+	reversePackets uint64
+}
+
+// activeFlow holds the latest throughput reported for an active flow.
+type activeFlow struct {
+	edge              serviceEdge
+	throughput        uint64
+	reverseThroughput uint64
+	lastSeen          time.Time
+}
+
+// OTLPExporter exports the flow records to an OpenTelemetry collector, using
+// the OTLP/gRPC protocol. Every flow record is exported as a log record, and
+// service-to-service metrics are derived from the flow records: cumulative
+// bytes and packets, and the throughput of the active flows.
+type OTLPExporter struct {
+	client         otlpClient
+	endpoint       string
+	exportLogs     bool
+	exportMetrics  bool
+	exportInterval time.Duration
+	// flowTimeout is the time after which an active flow which has not been
+	// updated is ignored when computing the throughput.
+	flowTimeout time.Duration
+
+	mutex       sync.Mutex
+	logs        []*otlpLogRecord
+	numDropped  int
+	counters    map[serviceEdge]*edgeCounters
+	activeFlows map[string]*activeFlow
+	flushCh     chan struct{}
+	stopCh      chan struct{}
+	stoppedCh   chan struct{}
+}
+
+func NewOTLPExporter(opt *options.Options) (*OTLPExporter, error) {
+	config := opt.Config.OTLP
+	dialOption := grpc.WithInsecure()
+	if config.TLS.Enable {
+		tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+		if config.TLS.CACertFile != "" {
+			caCert, err := ioutil.ReadFile(config.TLS.CACertFile)
+			if err != nil {
+				return nil, fmt.Errorf("error when reading OTLP CA certificate: %v", err)
+			}
+			tlsConfig.RootCAs = x509.NewCertPool()
+			if !tlsConfig.RootCAs.AppendCertsFromPEM(caCert) {
+				return nil, fmt.Errorf("no valid certificate found in %s", config.TLS.CACertFile)
+			}
+		}
+		dialOption = grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))
+	}
+	// The connection is established in the background, and re-established
+	// whenever it is lost.
+	conn, err := grpc.Dial(config.Endpoint, dialOption)
+	if err != nil {
+		return nil, fmt.Errorf("error when creating OTLP connection to %s: %v", config.Endpoint, err)
+	}
+	client := &grpcOTLPClient{
+		conn:     conn,
+		resource: []otlpAttribute{{key: "service.name", value: otlpServiceName}},
+	}
+	return newOTLPExporter(client, config.Endpoint, *config.Logs, *config.Metrics, opt.OTLPExportInterval, 2*opt.ActiveFlowRecordTimeout), nil
+}
+
+func newOTLPExporter(client otlpClient, endpoint string, exportLogs, exportMetrics bool, exportInterval, flowTimeout time.Duration) *OTLPExporter {
+	return &OTLPExporter{
+		client:         client,
+		endpoint:       endpoint,
+		exportLogs:     exportLogs,
+		exportMetrics:  exportMetrics,
+		exportInterval: exportInterval,
+		flowTimeout:    flowTimeout,
+		counters:       make(map[serviceEdge]*edgeCounters),
+		activeFlows:    make(map[string]*activeFlow),
+		flushCh:        make(chan struct{}, 1),
+		stopCh:         make(chan struct{}),
+		stoppedCh:      make(chan struct{}),
+	}
+}
+
+func (e *OTLPExporter) Start() {
+	klog.InfoS("Starting OTLP exporter", "endpoint", e.endpoint, "logs", e.exportLogs, "metrics", e.exportMetrics)
+	go e.run()
+}
+
+func (e *OTLPExporter) Stop() {
+	close(e.stopCh)
+	<-e.stoppedCh
+	klog.InfoS("Stopped OTLP exporter", "endpoint", e.endpoint)
+}
+
+func (e *OTLPExporter) AddRecord(record ipfixentities.Record) error {
+	r := newFlowRecord(record)
+	now := time.Now()
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if e.exportMetrics {
+		e.updateMetrics(r, now)
+	}
+	if e.exportLogs {
+		e.logs = append(e.logs, r.toLogRecord(now))
+		e.dropOldLogs()
+		// Checking for a multiple of the batch size avoids retrying for every
+		// new record while the collector is unavailable.
+		if len(e.logs)%otlpBatchSize == 0 {
+			select {
+			case e.flushCh <- struct{}{}:
+			default:
+			}
+		}
+	}
+	return nil
+}
+
+// dropOldLogs ensures that at most maxOTLPBufferedLogs are buffered. It must
+// be called with the mutex held.
+func (e *OTLPExporter) dropOldLogs() {
+	if n := len(e.logs) - maxOTLPBufferedLogs; n > 0 {
+		e.logs = e.logs[n:]
+		e.numDropped += n
+	}
+}
+
+// updateMetrics adds the counters of a flow record to its serviceEdge and
+// records the throughput of the flow. It must be called with the mutex held.
+func (e *OTLPExporter) updateMetrics(r flowRecord, now time.Time) {
+	edge := serviceEdge{
+		sourceNamespace:      formatValue(r[fieldIndex("sourcePodNamespace")]),
+		destinationNamespace: formatValue(r[fieldIndex("destinationPodNamespace")]),
+		destinationService:   formatValue(r[fieldIndex("destinationServicePortName")]),
+	}
+	counters, ok := e.counters[edge]
+	if !ok {
+		counters = &edgeCounters{startTime: now}
+		e.counters[edge] = counters
+	}
+	counters.octets += uintValue(r[fieldIndex("octetDeltaCount")])
+	counters.packets += uintValue(r[fieldIndex("packetDeltaCount")])
+	counters.reverseOctets += uintValue(r[fieldIndex("reverseOctetDeltaCount")])
+	counters.reversePackets += uintValue(r[fieldIndex("reversePacketDeltaCount")])
+
+	key := string(r.key())
+	// Only the records exported on active timeout are followed by other
+	// records for the same flow.
+	if reason, ok := r[fieldIndex("flowEndReason")].(uint64); ok && reason != uint64(ipfixregistry.ActiveTimeoutReason) {
+		delete(e.activeFlows, key)
+		return
+	}
+	e.activeFlows[key] = &activeFlow{
+		edge:              edge,
+		throughput:        uintValue(r[fieldIndex("throughput")]),
+		reverseThroughput: uintValue(r[fieldIndex("reverseThroughput")]),
+		lastSeen:          now,
+	}
+}
+
+func uintValue(v interface{}) uint64 {
+	switch v := v.(type) {
+	case uint64:
+		return v
+	case int64:
+		return uint64(v)
+	}
+	return 0
+}
+
+// toLogRecord converts the record to an OTLP log record. The fields present in
+// the record are the attributes of the log record, and the event.name
+// attribute identifies the log records of the flows.
+func (r flowRecord) toLogRecord(now time.Time) *otlpLogRecord {
+	timestamp := now
+	if flowEnd, ok := r[fieldIndex("flowEndSeconds")].(uint64); ok && flowEnd > 0 {
+		timestamp = time.Unix(int64(flowEnd), 0)
+	}
+	attributes := make([]otlpAttribute, 0, len(r)+1)
+	attributes = append(attributes, otlpAttribute{key: "event.name", value: "flow"})
+	for i, v := range r {
+		switch v := v.(type) {
+		case uint64:
+			attributes = append(attributes, otlpAttribute{key: recordFields[i].name, value: int64(v)})
+		case int64, float64, bool, string:
+			attributes = append(attributes, otlpAttribute{key: recordFields[i].name, value: v})
+		}
+	}
+	return &otlpLogRecord{
+		timeUnixNano:         uint64(timestamp.UnixNano()),
+		observedTimeUnixNano: uint64(now.UnixNano()),
+		body:                 string(r.key()),
+		attributes:           attributes,
+	}
+}
+
+func (e *OTLPExporter) run() {
+	defer close(e.stoppedCh)
+	defer e.client.Close()
+	ticker := time.NewTicker(e.exportInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-e.stopCh:
+			e.flushLogs()
+			e.exportMetricsOnce(time.Now())
+			return
+		case <-ticker.C:
+			e.flushLogs()
+			e.exportMetricsOnce(time.Now())
+		case <-e.flushCh:
+			e.flushLogs()
+		}
+	}
+}
+
+// flushLogs exports the buffered log records. The log records which cannot be
+// exported are buffered again, to be retried at the next flush.
+func (e *OTLPExporter) flushLogs() {
+	e.mutex.Lock()
+	logs := e.logs
+	e.logs = nil
+	numDropped := e.numDropped
+	e.numDropped = 0
+	e.mutex.Unlock()
+	if numDropped > 0 {
+		klog.InfoS("Dropped flow records as the OTLP collector is not available", "count", numDropped)
+	}
+
+	for len(logs) > 0 {
+		n := len(logs)
+		if n > otlpBatchSize {
+			n = otlpBatchSize
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), otlpExportTimeout)
+		err := e.client.ExportLogs(ctx, logs[:n])
+		cancel()
+		if err != nil {
+			klog.ErrorS(err, "Error when exporting flow records to the OTLP collector", "endpoint", e.endpoint)
+			e.mutex.Lock()
+			e.logs = append(logs, e.logs...)
+			e.dropOldLogs()
+			e.mutex.Unlock()
+			return
+		}
+		klog.V(4).InfoS("Exported flow records to the OTLP collector", "count", n, "endpoint", e.endpoint)
+		logs = logs[n:]
+	}
+}
+
+// exportMetricsOnce exports the current value of the service-to-service
+// metrics. As the counters are cumulative, nothing is lost when an export
+// fails.
+func (e *OTLPExporter) exportMetricsOnce(now time.Time) {
+	if !e.exportMetrics {
+		return
+	}
+	metrics := e.collectMetrics(now)
+	if len(metrics) == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), otlpExportTimeout)
+	defer cancel()
+	if err := e.client.ExportMetrics(ctx, metrics); err != nil {
+		klog.ErrorS(err, "Error when exporting flow metrics to the OTLP collector", "endpoint", e.endpoint)
+		return
+	}
+	klog.V(4).InfoS("Exported flow metrics to the OTLP collector", "endpoint", e.endpoint)
+}
+
+// collectMetrics builds the metrics from the counters of the serviceEdges and
+// from the active flows. The active flows which have not been updated within
+// flowTimeout are removed.
+func (e *OTLPExporter) collectMetrics(now time.Time) []*otlpMetric {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if len(e.counters) == 0 {
+		return nil
+	}
+	edges := make([]serviceEdge, 0, len(e.counters))
+	for edge := range e.counters {
+		edges = append(edges, edge)
+	}
+	sort.Slice(edges, func(i, j int) bool {
+		a, b := edges[i], edges[j]
+		if a.sourceNamespace != b.sourceNamespace {
+			return a.sourceNamespace < b.sourceNamespace
+		}
+		if a.destinationNamespace != b.destinationNamespace {
+			return a.destinationNamespace < b.destinationNamespace
+		}
+		return a.destinationService < b.destinationService
+	})
+	throughputs := make(map[serviceEdge]*activeFlow)
+	for key, flow := range e.activeFlows {
+		if now.Sub(flow.lastSeen) > e.flowTimeout {
+			delete(e.activeFlows, key)
+			continue
+		}
+		t, ok := throughputs[flow.edge]
+		if !ok {
+			t = &activeFlow{}
+			throughputs[flow.edge] = t
+		}
+		t.throughput += flow.throughput
+		t.reverseThroughput += flow.reverseThroughput
+	}
+
+	timestamp := uint64(now.UnixNano())
+	sum := func(name, description, unit string, value func(*edgeCounters) uint64) *otlpMetric {
+		dataPoints := make([]otlpNumberDataPoint, 0, len(edges))
+		for _, edge := range edges {
+			counters := e.counters[edge]
+			dataPoints = append(dataPoints, otlpNumberDataPoint{
+				attributes:        edge.attributes(),
+				startTimeUnixNano: uint64(counters.startTime.UnixNano()),
+				timeUnixNano:      timestamp,
+				value:             int64(value(counters)),
+			})
+		}
+		return &otlpMetric{
+			name:        name,
+			description: description,
+			unit:        unit,
+			sum:         true,
+			dataPoints:  dataPoints,
+		}
+	}
+	gauge := func(name, description string, value func(*activeFlow) uint64) *otlpMetric {
+		dataPoints := make([]otlpNumberDataPoint, 0, len(edges))
+		for _, edge := range edges {
+			var v uint64
+			if t, ok := throughputs[edge]; ok {
+				v = value(t)
+			}
+			dataPoints = append(dataPoints, otlpNumberDataPoint{
+				attributes:   edge.attributes(),
+				timeUnixNano: timestamp,
+				value:        float64(v),
+			})
+		}
+		return &otlpMetric{
+			name:        name,
+			description: description,
+			unit:        "bit/s",
+			dataPoints:  dataPoints,
+		}
+	}
+	return []*otlpMetric{
+		sum("antrea.flow.bytes", "Bytes sent from the source to the destination", "By",
+			func(c *edgeCounters) uint64 { return c.octets }),
+		sum("antrea.flow.packets", "Packets sent from the source to the destination", "1",
+			func(c *edgeCounters) uint64 { return c.packets }),
+		sum("antrea.flow.reverse_bytes", "Bytes sent from the destination to the source", "By",
+			func(c *edgeCounters) uint64 { return c.reverseOctets }),
+		sum("antrea.flow.reverse_packets", "Packets sent from the destination to the source", "1",
+			func(c *edgeCounters) uint64 { return c.reversePackets }),
+		gauge("antrea.flow.throughput", "Throughput of the active flows from the source to the destination",
+			func(f *activeFlow) uint64 { return f.throughput }),
+		gauge("antrea.flow.reverse_throughput", "Throughput of the active flows from the destination to the source",
+			func(f *activeFlow) uint64 { return f.reverseThroughput }),
+	}
+}
+
+func (edge serviceEdge) attributes() []otlpAttribute {
+	return []otlpAttribute{
+		{key: "source_namespace", value: edge.sourceNamespace},
+		{key: "destination_namespace", value: edge.destinationNamespace},
+		{key: "destination_service", value: edge.destinationService},
+	}
+}
@@ -0,0 +1,289 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"fmt"
+	"math"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// The OTLP requests are encoded with protowire instead of the generated types
+// of go.opentelemetry.io/proto/otlp. That module is held at v0.7.0 by
+// go.opentelemetry.io/otel/exporters/otlp v0.20, which k8s.io/component-base
+// depends on, and v0.7.0 predates the stable OTLP data model: Sum and Gauge
+// metrics with NumberDataPoint attributes, and ScopeLogs / ScopeMetrics.
+// Importing a newer copy of the generated types is not possible either, as
+// both copies would register the same protobuf names. The field numbers below
+// are the ones of opentelemetry-proto v0.19.0, which are stable since the
+// OTLP 1.0 release.
+
+const (
+	otlpLogsExportMethod    = "/opentelemetry.proto.collector.logs.v1.LogsService/Export"
+	otlpMetricsExportMethod = "/opentelemetry.proto.collector.metrics.v1.MetricsService/Export"
+)
+
+// ExportLogsServiceRequest and ExportMetricsServiceRequest.
+const (
+	exportRequestResourceField protowire.Number = 1
+)
+
+// ResourceLogs and ResourceMetrics.
+const (
+	resourceDataResourceField protowire.Number = 1
+	resourceDataScopeField    protowire.Number = 2
+)
+
+// ScopeLogs and ScopeMetrics.
+const (
+	scopeDataScopeField protowire.Number = 1
+	scopeDataItemsField protowire.Number = 2
+)
+
+// Resource, InstrumentationScope, KeyValue and AnyValue.
+const (
+	resourceAttributesField protowire.Number = 1
+	scopeNameField          protowire.Number = 1
+	keyValueKeyField        protowire.Number = 1
+	keyValueValueField      protowire.Number = 2
+	anyValueStringField     protowire.Number = 1
+	anyValueBoolField       protowire.Number = 2
+	anyValueIntField        protowire.Number = 3
+	anyValueDoubleField     protowire.Number = 4
+)
+
+// LogRecord.
+const (
+	logRecordTimeField         protowire.Number = 1
+	logRecordSeverityField     protowire.Number = 2
+	logRecordSeverityTextField protowire.Number = 3
+	logRecordBodyField         protowire.Number = 5
+	logRecordAttributesField   protowire.Number = 6
+	logRecordObservedTimeField protowire.Number = 11
+	severityNumberInfo                          = 9
+)
+
+// Metric, Gauge, Sum and NumberDataPoint.
+const (
+	metricNameField                  protowire.Number = 1
+	metricDescriptionField           protowire.Number = 2
+	metricUnitField                  protowire.Number = 3
+	metricGaugeField                 protowire.Number = 5
+	metricSumField                   protowire.Number = 7
+	dataPointsField                  protowire.Number = 1
+	sumAggregationTemporalityField   protowire.Number = 2
+	sumIsMonotonicField              protowire.Number = 3
+	numberDataPointStartTimeField    protowire.Number = 2
+	numberDataPointTimeField         protowire.Number = 3
+	numberDataPointAsDoubleField     protowire.Number = 4
+	numberDataPointAsIntField        protowire.Number = 6
+	numberDataPointAttributesField   protowire.Number = 7
+	aggregationTemporalityCumulative                  = 2
+)
+
+// otlpAttribute is an OTLP KeyValue. The value is a string, an int64, a
+// float64 or a bool.
+type otlpAttribute struct {
+	key   string
+	value interface{}
+}
+
+// otlpLogRecord is an OTLP LogRecord with severity INFO and a string body.
+type otlpLogRecord struct {
+	timeUnixNano         uint64
+	observedTimeUnixNano uint64
+	body                 string
+	attributes           []otlpAttribute
+}
+
+// otlpMetric is an OTLP Metric whose data is either a monotonic cumulative
+// Sum or a Gauge.
+type otlpMetric struct {
+	name        string
+	description string
+	unit        string
+	// sum is true for a Sum, and false for a Gauge.
+	sum        bool
+	dataPoints []otlpNumberDataPoint
+}
+
+// otlpNumberDataPoint is an OTLP NumberDataPoint. The value is an int64 or a
+// float64.
+type otlpNumberDataPoint struct {
+	attributes        []otlpAttribute
+	startTimeUnixNano uint64
+	timeUnixNano      uint64
+	value             interface{}
+}
+
+// otlpRawMessage is an encoded protobuf message, which is sent and received
+// as is by otlpCodec.
+type otlpRawMessage []byte
+
+// otlpCodec is the gRPC codec for the OTLP requests encoded by this file. It
+// uses the "proto" name so that the content-subtype is the one expected by
+// the collector.
+type otlpCodec struct{}
+
+func (otlpCodec) Marshal(v interface{}) ([]byte, error) {
+	m, ok := v.(otlpRawMessage)
+	if !ok {
+		return nil, fmt.Errorf("unexpected message type %T", v)
+	}
+	return m, nil
+}
+
+func (otlpCodec) Unmarshal(data []byte, v interface{}) error {
+	m, ok := v.(*otlpRawMessage)
+	if !ok {
+		return fmt.Errorf("unexpected message type %T", v)
+	}
+	*m = append((*m)[:0], data...)
+	return nil
+}
+
+func (otlpCodec) Name() string {
+	return "proto"
+}
+
+// marshalExportLogsServiceRequest encodes an ExportLogsServiceRequest with a
+// single ResourceLogs and a single ScopeLogs.
+func marshalExportLogsServiceRequest(resource []otlpAttribute, scope string, logs []*otlpLogRecord) otlpRawMessage {
+	var scopeLogs []byte
+	scopeLogs = appendMessage(scopeLogs, scopeDataScopeField, appendString(nil, scopeNameField, scope))
+	for _, l := range logs {
+		scopeLogs = appendMessage(scopeLogs, scopeDataItemsField, l.marshal())
+	}
+	return marshalExportRequest(resource, scopeLogs)
+}
+
+// marshalExportMetricsServiceRequest encodes an ExportMetricsServiceRequest
+// with a single ResourceMetrics and a single ScopeMetrics.
+func marshalExportMetricsServiceRequest(resource []otlpAttribute, scope string, metrics []*otlpMetric) otlpRawMessage {
+	var scopeMetrics []byte
+	scopeMetrics = appendMessage(scopeMetrics, scopeDataScopeField, appendString(nil, scopeNameField, scope))
+	for _, m := range metrics {
+		scopeMetrics = appendMessage(scopeMetrics, scopeDataItemsField, m.marshal())
+	}
+	return marshalExportRequest(resource, scopeMetrics)
+}
+
+// marshalExportRequest encodes an export request, given its encoded
+// ScopeLogs or ScopeMetrics. The logs and metrics requests share the same
+// layout.
+func marshalExportRequest(resource []otlpAttribute, scopeData []byte) otlpRawMessage {
+	var resourceData []byte
+	resourceData = appendMessage(resourceData, resourceDataResourceField, appendAttributes(nil, resourceAttributesField, resource))
+	resourceData = appendMessage(resourceData, resourceDataScopeField, scopeData)
+	return appendMessage(nil, exportRequestResourceField, resourceData)
+}
+
+func (l *otlpLogRecord) marshal() []byte {
+	var b []byte
+	b = appendFixed64(b, logRecordTimeField, l.timeUnixNano)
+	b = protowire.AppendTag(b, logRecordSeverityField, protowire.VarintType)
+	b = protowire.AppendVarint(b, severityNumberInfo)
+	b = appendString(b, logRecordSeverityTextField, "INFO")
+	b = appendMessage(b, logRecordBodyField, appendString(nil, anyValueStringField, l.body))
+	b = appendAttributes(b, logRecordAttributesField, l.attributes)
+	b = appendFixed64(b, logRecordObservedTimeField, l.observedTimeUnixNano)
+	return b
+}
+
+func (m *otlpMetric) marshal() []byte {
+	var data []byte
+	for i := range m.dataPoints {
+		data = appendMessage(data, dataPointsField, m.dataPoints[i].marshal())
+	}
+	var b []byte
+	b = appendString(b, metricNameField, m.name)
+	b = appendString(b, metricDescriptionField, m.description)
+	b = appendString(b, metricUnitField, m.unit)
+	if !m.sum {
+		return appendMessage(b, metricGaugeField, data)
+	}
+	data = protowire.AppendTag(data, sumAggregationTemporalityField, protowire.VarintType)
+	data = protowire.AppendVarint(data, aggregationTemporalityCumulative)
+	data = protowire.AppendTag(data, sumIsMonotonicField, protowire.VarintType)
+	data = protowire.AppendVarint(data, protowire.EncodeBool(true))
+	return appendMessage(b, metricSumField, data)
+}
+
+func (p *otlpNumberDataPoint) marshal() []byte {
+	var b []byte
+	if p.startTimeUnixNano != 0 {
+		b = appendFixed64(b, numberDataPointStartTimeField, p.startTimeUnixNano)
+	}
+	b = appendFixed64(b, numberDataPointTimeField, p.timeUnixNano)
+	switch v := p.value.(type) {
+	case int64:
+		b = appendFixed64(b, numberDataPointAsIntField, uint64(v))
+	case float64:
+		b = appendFixed64(b, numberDataPointAsDoubleField, math.Float64bits(v))
+	}
+	return appendAttributes(b, numberDataPointAttributesField, p.attributes)
+}
+
+// appendAttributes appends the attributes as repeated KeyValue messages.
+// Attributes with an unsupported value type are skipped.
+func appendAttributes(b []byte, num protowire.Number, attributes []otlpAttribute) []byte {
+	for _, a := range attributes {
+		var value []byte
+		switch v := a.value.(type) {
+		case string:
+			// The value is set even when the string is empty.
+			value = protowire.AppendTag(value, anyValueStringField, protowire.BytesType)
+			value = protowire.AppendString(value, v)
+		case bool:
+			value = protowire.AppendTag(value, anyValueBoolField, protowire.VarintType)
+			value = protowire.AppendVarint(value, protowire.EncodeBool(v))
+		case int64:
+			value = protowire.AppendTag(value, anyValueIntField, protowire.VarintType)
+			value = protowire.AppendVarint(value, uint64(v))
+		case float64:
+			value = appendFixed64(value, anyValueDoubleField, math.Float64bits(v))
+		default:
+			continue
+		}
+		var kv []byte
+		kv = appendString(kv, keyValueKeyField, a.key)
+		kv = appendMessage(kv, keyValueValueField, value)
+		b = appendMessage(b, num, kv)
+	}
+	return b
+}
+
+// appendString appends a string field. As in proto3, an empty string is not
+// encoded.
+func appendString(b []byte, num protowire.Number, s string) []byte {
+	if s == "" {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendString(b, s)
+}
+
+func appendFixed64(b []byte, num protowire.Number, v uint64) []byte {
+	b = protowire.AppendTag(b, num, protowire.Fixed64Type)
+	return protowire.AppendFixed64(b, v)
+}
+
+// appendMessage appends an embedded message, given its encoding. Empty
+// messages are still encoded, as the presence of a message field matters.
+func appendMessage(b []byte, num protowire.Number, m []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, m)
+}
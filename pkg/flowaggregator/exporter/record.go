@@ -0,0 +1,283 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	flowpb "antrea.io/antrea/pkg/apis/flow/v1alpha1"
+)
+
+// recordField is a field of the flow records produced by the exporters. Like
+// the ClickHouse flows table, the IPv4 and IPv6 variants of IPFIX Information
+// Elements are merged into a single field.
+type recordField struct {
+	name string
+	// ieNames are the names of the Information Elements the value of the field
+	// is read from. The first one present in the IPFIX record is used.
+	ieNames []string
+}
+
+// recordFields lists the fields of the flow records. The position of a field
+// in the list, starting from 1, is its field number in the FlowRecord message
+// defined in pkg/apis/flow/v1alpha1/flow.proto, which also defines the type of
+// the field. New fields must be appended to the list.
+var recordFields = []recordField{
+	{"flowStartSeconds", nil},
+	{"flowEndSeconds", nil},
+	{"flowEndSecondsFromSourceNode", nil},
+	{"flowEndSecondsFromDestinationNode", nil},
+	{"flowEndReason", nil},
+	{"sourceIP", []string{"sourceIPv4Address", "sourceIPv6Address"}},
+	{"destinationIP", []string{"destinationIPv4Address", "destinationIPv6Address"}},
+	{"sourceTransportPort", nil},
+	{"destinationTransportPort", nil},
+	{"protocolIdentifier", nil},
+	{"packetTotalCount", nil},
+	{"octetTotalCount", nil},
+	{"packetDeltaCount", nil},
+	{"octetDeltaCount", nil},
+	{"reversePacketTotalCount", nil},
+	{"reverseOctetTotalCount", nil},
+	{"reversePacketDeltaCount", nil},
+	{"reverseOctetDeltaCount", nil},
+	{"sourcePodName", nil},
+	{"sourcePodNamespace", nil},
+	{"sourceNodeName", nil},
+	{"destinationPodName", nil},
+	{"destinationPodNamespace", nil},
+	{"destinationNodeName", nil},
+	{"destinationClusterIP", []string{"destinationClusterIPv4", "destinationClusterIPv6"}},
+	{"destinationServicePort", nil},
+	{"destinationServicePortName", nil},
+	{"ingressNetworkPolicyName", nil},
+	{"ingressNetworkPolicyNamespace", nil},
+	{"ingressNetworkPolicyRuleName", nil},
+	{"ingressNetworkPolicyRuleAction", nil},
+	{"ingressNetworkPolicyType", nil},
+	{"egressNetworkPolicyName", nil},
+	{"egressNetworkPolicyNamespace", nil},
+	{"egressNetworkPolicyRuleName", nil},
+	{"egressNetworkPolicyRuleAction", nil},
+	{"egressNetworkPolicyType", nil},
+	{"tcpState", nil},
+	{"flowType", nil},
+	{"sourcePodLabels", nil},
+	{"destinationPodLabels", nil},
+	{"throughput", nil},
+	{"reverseThroughput", nil},
+	{"throughputFromSourceNode", nil},
+	{"throughputFromDestinationNode", nil},
+	{"reverseThroughputFromSourceNode", nil},
+	{"reverseThroughputFromDestinationNode", nil},
+	{"egressName", nil},
+	{"egressIP", nil},
+	{"egressNodeName", nil},
+	{"destinationFQDN", nil},
+}
+
+// flowRecord holds the values of a flow record in the order of recordFields.
+// Values are uint64, int64, float64, bool or string, and nil for the fields
+// missing from the IPFIX record.
+type flowRecord []interface{}
+
+func newFlowRecord(record ipfixentities.Record) flowRecord {
+	r := make(flowRecord, len(recordFields))
+	for i, field := range recordFields {
+		ieNames := field.ieNames
+		if ieNames == nil {
+			ieNames = []string{field.name}
+		}
+		for _, ieName := range ieNames {
+			if ie, _, exist := record.GetInfoElementWithValue(ieName); exist {
+				r[i] = getIEValue(ie)
+				break
+			}
+		}
+	}
+	return r
+}
+
+func getIEValue(ie ipfixentities.InfoElementWithValue) interface{} {
+	switch ie.GetDataType() {
+	case ipfixentities.Unsigned8:
+		return uint64(ie.GetUnsigned8Value())
+	case ipfixentities.Unsigned16:
+		return uint64(ie.GetUnsigned16Value())
+	case ipfixentities.Unsigned32, ipfixentities.DateTimeSeconds:
+		return uint64(ie.GetUnsigned32Value())
+	case ipfixentities.Unsigned64, ipfixentities.DateTimeMilliseconds:
+		return ie.GetUnsigned64Value()
+	case ipfixentities.Signed8:
+		return int64(ie.GetSigned8Value())
+	case ipfixentities.Signed16:
+		return int64(ie.GetSigned16Value())
+	case ipfixentities.Signed32:
+		return int64(ie.GetSigned32Value())
+	case ipfixentities.Signed64:
+		return ie.GetSigned64Value()
+	case ipfixentities.Float32:
+		return float64(ie.GetFloat32Value())
+	case ipfixentities.Float64:
+		return ie.GetFloat64Value()
+	case ipfixentities.Boolean:
+		return ie.GetBooleanValue()
+	case ipfixentities.String:
+		return ie.GetStringValue()
+	case ipfixentities.MacAddress:
+		return ie.GetMacAddressValue().String()
+	case ipfixentities.Ipv4Address, ipfixentities.Ipv6Address:
+		return ie.GetIPAddressValue().String()
+	}
+	return nil
+}
+
+// key returns the 5-tuple of the flow. It is used as the key of the Kafka
+// messages, so that all the records of a flow go to the same partition.
+func (r flowRecord) key() []byte {
+	var key []byte
+	for _, name := range []string{"sourceIP", "destinationIP", "sourceTransportPort", "destinationTransportPort", "protocolIdentifier"} {
+		key = append(key, formatValue(r[fieldIndex(name)])...)
+		key = append(key, '|')
+	}
+	return key[:len(key)-1]
+}
+
+func fieldIndex(name string) int {
+	for i := range recordFields {
+		if recordFields[i].name == name {
+			return i
+		}
+	}
+	panic(fmt.Sprintf("unknown flow record field %s", name))
+}
+
+// toJSON encodes the record as a JSON object. Missing fields are omitted.
+func (r flowRecord) toJSON() ([]byte, error) {
+	m := make(map[string]interface{}, len(r))
+	for i, v := range r {
+		if v != nil {
+			m[recordFields[i].name] = v
+		}
+	}
+	return json.Marshal(m)
+}
+
+// csvHeader returns the header of the CSV files, i.e. the names of the fields.
+func csvHeader() []string {
+	header := make([]string, len(recordFields))
+	for i := range recordFields {
+		header[i] = recordFields[i].name
+	}
+	return header
+}
+
+// toCSV returns the values of the record as a CSV row. Missing fields are
+// empty.
+func (r flowRecord) toCSV() []string {
+	row := make([]string, len(r))
+	for i, v := range r {
+		row[i] = formatValue(v)
+	}
+	return row
+}
+
+func formatValue(v interface{}) string {
+	switch v := v.(type) {
+	case uint64:
+		return strconv.FormatUint(v, 10)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case string:
+		return v
+	}
+	return ""
+}
+
+// recordFieldDescriptor returns the descriptor of the FlowRecord message field
+// corresponding to the field at index i of recordFields.
+func recordFieldDescriptor(i int) protoreflect.FieldDescriptor {
+	return (&flowpb.FlowRecord{}).ProtoReflect().Descriptor().Fields().ByNumber(protoreflect.FieldNumber(i + 1))
+}
+
+// toProtobuf encodes the record as a FlowRecord message. Missing fields are
+// omitted.
+func (r flowRecord) toProtobuf() ([]byte, error) {
+	m := &flowpb.FlowRecord{}
+	pm := m.ProtoReflect()
+	for i, v := range r {
+		if v == nil {
+			continue
+		}
+		fd := recordFieldDescriptor(i)
+		switch fd.Kind() {
+		case protoreflect.Uint32Kind:
+			if u, ok := v.(uint64); ok {
+				pm.Set(fd, protoreflect.ValueOfUint32(uint32(u)))
+			}
+		case protoreflect.Uint64Kind:
+			if u, ok := v.(uint64); ok {
+				pm.Set(fd, protoreflect.ValueOfUint64(u))
+			}
+		case protoreflect.StringKind:
+			pm.Set(fd, protoreflect.ValueOfString(formatValue(v)))
+		}
+	}
+	return proto.Marshal(m)
+}
+
+// parquetSchema returns the schema of the Parquet files, in the metadata format
+// of github.com/xitongsys/parquet-go. All the fields are optional.
+func parquetSchema() []string {
+	schema := make([]string, len(recordFields))
+	for i := range recordFields {
+		parquetType := "type=BYTE_ARRAY, convertedtype=UTF8"
+		if kind := recordFieldDescriptor(i).Kind(); kind == protoreflect.Uint32Kind || kind == protoreflect.Uint64Kind {
+			parquetType = "type=INT64, convertedtype=UINT_64"
+		}
+		schema[i] = fmt.Sprintf("name=%s, %s, repetitiontype=OPTIONAL", recordFields[i].name, parquetType)
+	}
+	return schema
+}
+
+// toParquet returns the values of the record as a Parquet row matching
+// parquetSchema. Missing fields are nil.
+func (r flowRecord) toParquet() []interface{} {
+	row := make([]interface{}, len(r))
+	for i, v := range r {
+		if v == nil {
+			continue
+		}
+		if kind := recordFieldDescriptor(i).Kind(); kind == protoreflect.Uint32Kind || kind == protoreflect.Uint64Kind {
+			if u, ok := v.(uint64); ok {
+				// Unsigned values are stored in INT64 columns.
+				row[i] = int64(u)
+			}
+		} else {
+			row[i] = formatValue(v)
+		}
+	}
+	return row
+}
@@ -0,0 +1,142 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"encoding/json"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
+	ipfixregistry "github.com/vmware/go-ipfix/pkg/registry"
+	"google.golang.org/protobuf/proto"
+
+	flowpb "antrea.io/antrea/pkg/apis/flow/v1alpha1"
+	"antrea.io/antrea/pkg/ipfix"
+)
+
+func init() {
+	ipfix.LoadRegistry()
+}
+
+func createTestRecord(t *testing.T, isIPv6 bool) ipfixentities.Record {
+	getElement := func(name string, enterpriseID uint32) *ipfixentities.InfoElement {
+		element, err := ipfixregistry.GetInfoElement(name, enterpriseID)
+		require.NoError(t, err)
+		return element
+	}
+	sourceIP, destinationIP := net.ParseIP("10.10.0.1"), net.ParseIP("10.10.1.2")
+	sourceIPName, destinationIPName := "sourceIPv4Address", "destinationIPv4Address"
+	if isIPv6 {
+		sourceIP, destinationIP = net.ParseIP("2001:0:3238:dfe1:63::fefb"), net.ParseIP("2001:0:3238:dfe1:63::fefc")
+		sourceIPName, destinationIPName = "sourceIPv6Address", "destinationIPv6Address"
+	}
+	elements := []ipfixentities.InfoElementWithValue{
+		ipfixentities.NewDateTimeSecondsInfoElement(getElement("flowStartSeconds", ipfixregistry.IANAEnterpriseID), 1637706961),
+		ipfixentities.NewIPAddressInfoElement(getElement(sourceIPName, ipfixregistry.IANAEnterpriseID), sourceIP),
+		ipfixentities.NewIPAddressInfoElement(getElement(destinationIPName, ipfixregistry.IANAEnterpriseID), destinationIP),
+		ipfixentities.NewUnsigned16InfoElement(getElement("sourceTransportPort", ipfixregistry.IANAEnterpriseID), 44752),
+		ipfixentities.NewUnsigned16InfoElement(getElement("destinationTransportPort", ipfixregistry.IANAEnterpriseID), 5201),
+		ipfixentities.NewUnsigned8InfoElement(getElement("protocolIdentifier", ipfixregistry.IANAEnterpriseID), 6),
+		ipfixentities.NewUnsigned64InfoElement(getElement("packetTotalCount", ipfixregistry.IANAEnterpriseID), 823188),
+		ipfixentities.NewStringInfoElement(getElement("sourcePodName", ipfixregistry.AntreaEnterpriseID), "perftest-a"),
+		ipfixentities.NewStringInfoElement(getElement("egressIP", ipfixregistry.AntreaEnterpriseID), "172.18.0.1"),
+	}
+	record := ipfixentities.NewDataRecord(256, len(elements), 0, true)
+	for _, ie := range elements {
+		require.NoError(t, record.AddInfoElement(ie))
+	}
+	return record
+}
+
+func TestNewFlowRecord(t *testing.T) {
+	for _, isIPv6 := range []bool{false, true} {
+		r := newFlowRecord(createTestRecord(t, isIPv6))
+		require.Len(t, r, len(recordFields))
+		sourceIP, destinationIP := "10.10.0.1", "10.10.1.2"
+		if isIPv6 {
+			sourceIP, destinationIP = "2001:0:3238:dfe1:63::fefb", "2001:0:3238:dfe1:63::fefc"
+		}
+		assert.Equal(t, uint64(1637706961), r[fieldIndex("flowStartSeconds")])
+		assert.Equal(t, sourceIP, r[fieldIndex("sourceIP")])
+		assert.Equal(t, destinationIP, r[fieldIndex("destinationIP")])
+		assert.Equal(t, uint64(6), r[fieldIndex("protocolIdentifier")])
+		assert.Equal(t, "perftest-a", r[fieldIndex("sourcePodName")])
+		assert.Nil(t, r[fieldIndex("destinationPodName")])
+		assert.Equal(t, "172.18.0.1", r[fieldIndex("egressIP")])
+		assert.Equal(t, sourceIP+"|"+destinationIP+"|44752|5201|6", string(r.key()))
+	}
+}
+
+func TestFlowRecordEncoding(t *testing.T) {
+	r := newFlowRecord(createTestRecord(t, false))
+
+	data, err := r.toJSON()
+	require.NoError(t, err)
+	var decoded map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, map[string]interface{}{
+		"flowStartSeconds":         float64(1637706961),
+		"sourceIP":                 "10.10.0.1",
+		"destinationIP":            "10.10.1.2",
+		"sourceTransportPort":      float64(44752),
+		"destinationTransportPort": float64(5201),
+		"protocolIdentifier":       float64(6),
+		"packetTotalCount":         float64(823188),
+		"sourcePodName":            "perftest-a",
+		"egressIP":                 "172.18.0.1",
+	}, decoded)
+
+	row := r.toCSV()
+	require.Len(t, row, len(csvHeader()))
+	assert.Equal(t, "1637706961", row[0])
+	assert.Equal(t, "", row[1])
+	assert.Equal(t, "10.10.0.1", row[fieldIndex("sourceIP")])
+
+	data, err = r.toProtobuf()
+	require.NoError(t, err)
+	var decodedRecord flowpb.FlowRecord
+	require.NoError(t, proto.Unmarshal(data, &decodedRecord))
+	assert.True(t, proto.Equal(&flowpb.FlowRecord{
+		FlowStartSeconds:         1637706961,
+		SourceIp:                 "10.10.0.1",
+		DestinationIp:            "10.10.1.2",
+		SourceTransportPort:      44752,
+		DestinationTransportPort: 5201,
+		ProtocolIdentifier:       6,
+		PacketTotalCount:         823188,
+		SourcePodName:            "perftest-a",
+		EgressIp:                 "172.18.0.1",
+	}, &decodedRecord), "Unexpected FlowRecord %v", &decodedRecord)
+
+	parquetRow := r.toParquet()
+	require.Len(t, parquetRow, len(parquetSchema()))
+	assert.Equal(t, int64(1637706961), parquetRow[0])
+	assert.Nil(t, parquetRow[1])
+	assert.Equal(t, "10.10.0.1", parquetRow[fieldIndex("sourceIP")])
+}
+
+func TestRecordFieldsMatchFlowRecord(t *testing.T) {
+	fields := (&flowpb.FlowRecord{}).ProtoReflect().Descriptor().Fields()
+	require.Equal(t, len(recordFields), fields.Len())
+	for i := range recordFields {
+		fd := recordFieldDescriptor(i)
+		require.NotNil(t, fd, "Missing FlowRecord field for %s", recordFields[i].name)
+		assert.Equal(t, strings.ToLower(recordFields[i].name), strings.ReplaceAll(string(fd.Name()), "_", ""))
+	}
+}
@@ -0,0 +1,317 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/uuid"
+	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/writer"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/flowaggregator/options"
+)
+
+const (
+	// maxS3PendingBatches bounds the number of batches kept for retry while the
+	// object storage is unavailable. The oldest batches are dropped first.
+	maxS3PendingBatches = 5
+	s3UploadTimeout     = 60 * time.Second
+)
+
+// s3Batch is a file being built from flow records.
+type s3Batch interface {
+	write(r flowRecord) error
+	// close completes the file and returns its content.
+	close() ([]byte, error)
+}
+
+// csvBatch is a CSV file with a header row, optionally compressed with gzip.
+type csvBatch struct {
+	buf        bytes.Buffer
+	gzipWriter *gzip.Writer
+	csvWriter  *csv.Writer
+}
+
+func newCSVBatch(compress bool) *csvBatch {
+	b := &csvBatch{}
+	var w io.Writer = &b.buf
+	if compress {
+		b.gzipWriter = gzip.NewWriter(&b.buf)
+		w = b.gzipWriter
+	}
+	b.csvWriter = csv.NewWriter(w)
+	b.csvWriter.Write(csvHeader())
+	return b
+}
+
+func (b *csvBatch) write(r flowRecord) error {
+	return b.csvWriter.Write(r.toCSV())
+}
+
+func (b *csvBatch) close() ([]byte, error) {
+	b.csvWriter.Flush()
+	if err := b.csvWriter.Error(); err != nil {
+		return nil, err
+	}
+	if b.gzipWriter != nil {
+		if err := b.gzipWriter.Close(); err != nil {
+			return nil, err
+		}
+	}
+	return b.buf.Bytes(), nil
+}
+
+// parquetBatch is a Parquet file, with columns compressed with Snappy.
+type parquetBatch struct {
+	buf    bytes.Buffer
+	writer *writer.CSVWriter
+}
+
+func newParquetBatch(compress bool) (*parquetBatch, error) {
+	b := &parquetBatch{}
+	w, err := writer.NewCSVWriterFromWriter(parquetSchema(), &b.buf, 1)
+	if err != nil {
+		return nil, err
+	}
+	w.CompressionType = parquet.CompressionCodec_UNCOMPRESSED
+	if compress {
+		w.CompressionType = parquet.CompressionCodec_SNAPPY
+	}
+	b.writer = w
+	return b, nil
+}
+
+func (b *parquetBatch) write(r flowRecord) error {
+	return b.writer.Write(r.toParquet())
+}
+
+func (b *parquetBatch) close() ([]byte, error) {
+	if err := b.writer.WriteStop(); err != nil {
+		return nil, err
+	}
+	return b.buf.Bytes(), nil
+}
+
+// s3Uploader is the subset of manager.Uploader used by S3Exporter.
+type s3Uploader interface {
+	Upload(ctx context.Context, input *s3.PutObjectInput, opts ...func(*manager.Uploader)) (*manager.UploadOutput, error)
+}
+
+// S3Exporter uploads flow records to S3-compatible object storage as CSV
+// files, optionally compressed with gzip, or as Parquet files. A file is
+// uploaded every upload interval, or as soon as it contains the maximum number
+// of records.
+type S3Exporter struct {
+	uploader          s3Uploader
+	bucketName        string
+	bucketPrefix      string
+	recordFormat      string
+	compress          bool
+	maxRecordsPerFile int32
+	uploadInterval    time.Duration
+
+	mutex      sync.Mutex
+	batch      s3Batch
+	numRecords int32
+	pending    [][]byte
+	uploadCh   chan struct{}
+	stopCh     chan struct{}
+	stoppedCh  chan struct{}
+}
+
+// NewS3Exporter creates an S3Exporter. The credentials are resolved from the
+// default credential chain of the AWS SDK, e.g. from the AWS_ACCESS_KEY_ID,
+// AWS_SECRET_ACCESS_KEY and optional AWS_SESSION_TOKEN environment variables.
+func NewS3Exporter(opt *options.Options) (*S3Exporter, error) {
+	config := opt.Config.S3Uploader
+	awsConfig, err := awsconfig.LoadDefaultConfig(context.TODO(), awsconfig.WithRegion(config.Region))
+	if err != nil {
+		return nil, fmt.Errorf("error when loading AWS configuration: %v", err)
+	}
+	client := s3.NewFromConfig(awsConfig, func(o *s3.Options) {
+		if config.Endpoint != "" {
+			// S3-compatible services generally do not support
+			// virtual-hosted-style URLs, which AWS S3 uses by default.
+			o.EndpointResolver = s3.EndpointResolverFromURL(config.Endpoint, func(e *aws.Endpoint) {
+				e.HostnameImmutable = true
+			})
+			o.UsePathStyle = true
+		}
+	})
+	return newS3Exporter(manager.NewUploader(client), config.BucketName, config.BucketPrefix, config.RecordFormat, *config.Compress, config.MaxRecordsPerFile, opt.S3UploadInterval), nil
+}
+
+func newS3Exporter(uploader s3Uploader, bucketName, bucketPrefix, recordFormat string, compress bool, maxRecordsPerFile int32, uploadInterval time.Duration) *S3Exporter {
+	return &S3Exporter{
+		uploader:          uploader,
+		bucketName:        bucketName,
+		bucketPrefix:      bucketPrefix,
+		recordFormat:      recordFormat,
+		compress:          compress,
+		maxRecordsPerFile: maxRecordsPerFile,
+		uploadInterval:    uploadInterval,
+		uploadCh:          make(chan struct{}, 1),
+		stopCh:            make(chan struct{}),
+		stoppedCh:         make(chan struct{}),
+	}
+}
+
+func (e *S3Exporter) Start() {
+	klog.InfoS("Starting S3 uploader", "bucket", e.bucketName, "prefix", e.bucketPrefix, "format", e.recordFormat)
+	go e.run()
+}
+
+func (e *S3Exporter) Stop() {
+	close(e.stopCh)
+	<-e.stoppedCh
+	klog.InfoS("Stopped S3 uploader", "bucket", e.bucketName)
+}
+
+func (e *S3Exporter) AddRecord(record ipfixentities.Record) error {
+	r := newFlowRecord(record)
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if e.batch == nil {
+		batch, err := e.newBatch()
+		if err != nil {
+			return err
+		}
+		e.batch = batch
+		e.numRecords = 0
+	}
+	if err := e.batch.write(r); err != nil {
+		return err
+	}
+	e.numRecords++
+	if e.numRecords >= e.maxRecordsPerFile {
+		e.closeBatch()
+		select {
+		case e.uploadCh <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+func (e *S3Exporter) newBatch() (s3Batch, error) {
+	if e.recordFormat == "Parquet" {
+		return newParquetBatch(e.compress)
+	}
+	return newCSVBatch(e.compress), nil
+}
+
+// closeBatch moves the current batch to the pending batches. It must be called
+// with the mutex held.
+func (e *S3Exporter) closeBatch() {
+	if e.batch == nil {
+		return
+	}
+	data, err := e.batch.close()
+	e.batch = nil
+	if err != nil {
+		klog.ErrorS(err, "Error when writing flow records batch")
+		return
+	}
+	e.pending = append(e.pending, data)
+	if n := len(e.pending) - maxS3PendingBatches; n > 0 {
+		klog.InfoS("Dropped flow records batches as object storage is not available", "count", n)
+		e.pending = e.pending[n:]
+	}
+}
+
+func (e *S3Exporter) run() {
+	defer close(e.stoppedCh)
+	ticker := time.NewTicker(e.uploadInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-e.stopCh:
+			e.upload(true)
+			return
+		case <-ticker.C:
+			e.upload(true)
+		case <-e.uploadCh:
+			e.upload(false)
+		}
+	}
+}
+
+// upload uploads the pending batches, after closing the current batch if
+// closeCurrent is true. Batches which cannot be uploaded are kept to be
+// retried at the next upload.
+func (e *S3Exporter) upload(closeCurrent bool) {
+	e.mutex.Lock()
+	if closeCurrent {
+		e.closeBatch()
+	}
+	pending := e.pending
+	e.pending = nil
+	e.mutex.Unlock()
+
+	for i, data := range pending {
+		if err := e.putObject(e.newObjectKey(), data); err != nil {
+			klog.ErrorS(err, "Error when uploading flow records to object storage", "bucket", e.bucketName)
+			e.mutex.Lock()
+			e.pending = append(pending[i:], e.pending...)
+			e.mutex.Unlock()
+			return
+		}
+	}
+}
+
+func (e *S3Exporter) newObjectKey() string {
+	ext := ".csv"
+	if e.recordFormat == "Parquet" {
+		ext = ".parquet"
+	} else if e.compress {
+		ext += ".gz"
+	}
+	name := fmt.Sprintf("records-%s-%s%s", time.Now().UTC().Format("20060102-150405"), uuid.New().String()[:8], ext)
+	return path.Join(e.bucketPrefix, name)
+}
+
+func (e *S3Exporter) putObject(key string, data []byte) error {
+	contentType := "text/csv"
+	if e.recordFormat == "Parquet" {
+		contentType = "application/octet-stream"
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), s3UploadTimeout)
+	defer cancel()
+	if _, err := e.uploader.Upload(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(e.bucketName),
+		Key:         aws.String(key),
+		Body:        bytes.NewReader(data),
+		ContentType: aws.String(contentType),
+	}); err != nil {
+		return fmt.Errorf("error when uploading object %s: %v", key, err)
+	}
+	klog.V(2).InfoS("Uploaded flow records to object storage", "bucket", e.bucketName, "key", key, "bytes", len(data))
+	return nil
+}
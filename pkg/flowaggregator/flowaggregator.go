@@ -22,6 +22,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"reflect"
 	"sync"
 	"time"
 
@@ -42,6 +43,7 @@ import (
 	"antrea.io/antrea/pkg/clusteridentity"
 	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
 	"antrea.io/antrea/pkg/flowaggregator/clickhouseclient"
+	flowexporter "antrea.io/antrea/pkg/flowaggregator/exporter"
 	"antrea.io/antrea/pkg/flowaggregator/options"
 	"antrea.io/antrea/pkg/flowaggregator/querier"
 	"antrea.io/antrea/pkg/ipfix"
@@ -89,6 +91,10 @@ var (
 		"egressNetworkPolicyRuleAction",
 		"tcpState",
 		"flowType",
+		"egressName",
+		"egressIP",
+		"egressNodeName",
+		"destinationFQDN",
 	}
 	antreaInfoElementsIPv4 = append(antreaInfoElementsCommon, []string{"destinationClusterIPv4"}...)
 	antreaInfoElementsIPv6 = append(antreaInfoElementsCommon, []string{"destinationClusterIPv6"}...)
@@ -188,6 +194,9 @@ const (
 	udpTransport         = "udp"
 	tcpTransport         = "tcp"
 	collectorAddress     = "0.0.0.0:4739"
+	// dnsRecordChanSize is the size of the buffer of DNS records waiting to
+	// be exported to ClickHouse.
+	dnsRecordChanSize = 1024
 
 	// PodInfo index name for Pod cache.
 	podInfoIndex = "podInfo"
@@ -201,8 +210,27 @@ const (
 	enableClickHouse
 	disableClickHouse
 	disableFlowCollector
+	updateExporters
 )
 
+// exportersConfig holds the configuration of the sinks managed through the
+// exporter interface.
+type exportersConfig struct {
+	kafka      flowaggregatorconfig.KafkaConfig
+	flowLogger flowaggregatorconfig.FlowLoggerConfig
+	s3Uploader flowaggregatorconfig.S3UploaderConfig
+	otlp       flowaggregatorconfig.OTLPConfig
+}
+
+func newExportersConfig(opt *options.Options) exportersConfig {
+	return exportersConfig{
+		kafka:      opt.Config.Kafka,
+		flowLogger: opt.Config.FlowLogger,
+		s3Uploader: opt.Config.S3Uploader,
+		otlp:       opt.Config.OTLP,
+	}
+}
+
 type updateMsg struct {
 	param updateParam
 	value interface{}
@@ -215,6 +243,8 @@ type flowAggregator struct {
 	collectingProcess           ipfix.IPFIXCollectingProcess
 	aggregationProcess          ipfix.IPFIXAggregationProcess
 	dbExportProcess             *clickhouseclient.ClickHouseExportProcess
+	exporters                   []flowexporter.Interface
+	exportersConfig             exportersConfig
 	activeFlowRecordTimeout     time.Duration
 	inactiveFlowRecordTimeout   time.Duration
 	exportingProcess            ipfix.IPFIXExportingProcess
@@ -235,6 +265,11 @@ type flowAggregator struct {
 	configWatcher               *fsnotify.Watcher
 	configData                  []byte
 	APIServer                   flowaggregatorconfig.APIServerConfig
+	// aggregationMsgCh receives the messages of the collecting process which
+	// are not DNS records, and feeds the aggregation process.
+	aggregationMsgCh chan *ipfixentities.Message
+	// dnsRecordCh receives the DNS records exported by the Antrea Agents.
+	dnsRecordCh chan ipfixentities.Record
 }
 
 func NewFlowAggregator(
@@ -303,6 +338,7 @@ func NewFlowAggregator(
 		configWatcher:               configWatcher,
 		configData:                  data,
 		APIServer:                   opt.Config.APIServer,
+		exportersConfig:             newExportersConfig(opt),
 	}
 	err = fa.InitCollectingProcess()
 	if err != nil {
@@ -327,6 +363,10 @@ func NewFlowAggregator(
 			return nil, fmt.Errorf("error when creating db export process: %v", err)
 		}
 	}
+	fa.exporters, err = flowexporter.NewExporters(opt)
+	if err != nil {
+		return nil, fmt.Errorf("error when creating exporters: %v", err)
+	}
 	podInformer.Informer().AddIndexers(cache.Indexers{podInfoIndex: podInfoIndexFunc})
 	return fa, nil
 }
@@ -385,11 +425,11 @@ func podInfoIndexFunc(obj interface{}) ([]string, error) {
 func (fa *flowAggregator) InitCollectingProcess() error {
 	var cpInput collector.CollectorInput
 	if fa.aggregatorTransportProtocol == flowaggregatorconfig.AggregatorTransportProtocolTLS {
-		parentCert, privateKey, caCert, err := generateCACertKey()
+		parentCert, privateKey, caCert, err := getOrCreateCACertKey(fa.k8sClient)
 		if err != nil {
-			return fmt.Errorf("error when generating CA certificate: %v", err)
+			return fmt.Errorf("error when getting CA certificate: %v", err)
 		}
-		serverCert, serverKey, err := generateCertKey(parentCert, privateKey, true, fa.flowAggregatorAddress)
+		serverCert, serverKey, err := generateCertKey(parentCert, privateKey, true, fa.flowAggregatorAddress, getPodIPs(fa.k8sClient)...)
 		if err != nil {
 			return fmt.Errorf("error when creating server certificate: %v", err)
 		}
@@ -438,8 +478,10 @@ func (fa *flowAggregator) InitCollectingProcess() error {
 
 func (fa *flowAggregator) InitAggregationProcess() error {
 	var err error
+	fa.aggregationMsgCh = make(chan *ipfixentities.Message)
+	fa.dnsRecordCh = make(chan ipfixentities.Record, dnsRecordChanSize)
 	apInput := ipfixintermediate.AggregationInput{
-		MessageChan:           fa.collectingProcess.GetMsgChan(),
+		MessageChan:           fa.aggregationMsgCh,
 		WorkerNum:             aggregationWorkerNum,
 		CorrelateFields:       correlateFields,
 		ActiveExpiryTimeout:   fa.activeFlowRecordTimeout,
@@ -523,17 +565,62 @@ func (fa *flowAggregator) Run(stopCh <-chan struct{}, wg *sync.WaitGroup) {
 	defer wg.Done()
 	go fa.collectingProcess.Start()
 	defer fa.collectingProcess.Stop()
+	go fa.dispatchMessages(stopCh)
 	go fa.aggregationProcess.Start()
 	defer fa.aggregationProcess.Stop()
 	if fa.dbExportProcess != nil {
 		go fa.dbExportProcess.Start()
 		defer fa.dbExportProcess.Stop()
 	}
+	for _, exp := range fa.exporters {
+		exp.Start()
+	}
 	go fa.flowExportLoop(stopCh)
 	go fa.watchConfiguration(stopCh)
 	<-stopCh
 }
 
+// dispatchMessages sends the DNS records received by the collecting process to
+// dnsRecordCh, and all other messages to the aggregation process. DNS records
+// are not aggregated, and are only exported to ClickHouse.
+func (fa *flowAggregator) dispatchMessages(stopCh <-chan struct{}) {
+	msgCh := fa.collectingProcess.GetMsgChan()
+	for {
+		select {
+		case <-stopCh:
+			return
+		case msg, ok := <-msgCh:
+			if !ok {
+				return
+			}
+			if set := msg.GetSet(); set.GetSetType() == ipfixentities.Data && isDNSSet(set) {
+				for _, record := range set.GetRecords() {
+					select {
+					case fa.dnsRecordCh <- record:
+					case <-stopCh:
+						return
+					}
+				}
+				continue
+			}
+			select {
+			case fa.aggregationMsgCh <- msg:
+			case <-stopCh:
+				return
+			}
+		}
+	}
+}
+
+func isDNSSet(set ipfixentities.Set) bool {
+	records := set.GetRecords()
+	if len(records) == 0 {
+		return false
+	}
+	_, _, ok := records[0].GetInfoElementWithValue("dnsQueryName")
+	return ok
+}
+
 func (fa *flowAggregator) flowExportLoop(stopCh <-chan struct{}) {
 	expireTimer := time.NewTimer(fa.activeFlowRecordTimeout)
 	logTicker := time.NewTicker(time.Minute)
@@ -543,6 +630,9 @@ func (fa *flowAggregator) flowExportLoop(stopCh <-chan struct{}) {
 			if fa.exportingProcess != nil {
 				fa.exportingProcess.CloseConnToCollector()
 			}
+			for _, exp := range fa.exporters {
+				exp.Stop()
+			}
 			expireTimer.Stop()
 			return
 		case <-expireTimer.C:
@@ -570,6 +660,10 @@ func (fa *flowAggregator) flowExportLoop(stopCh <-chan struct{}) {
 			}
 			// Get the new expiry and reset the timer.
 			expireTimer.Reset(fa.aggregationProcess.GetExpiryFromExpirePriorityQueue())
+		case record := <-fa.dnsRecordCh:
+			if fa.dbExportProcess != nil {
+				fa.dbExportProcess.CacheDNSRecord(record)
+			}
 		case <-logTicker.C:
 			// Add visibility of processing stats of Flow Aggregator
 			klog.V(4).InfoS("Total number of records received", "count", fa.collectingProcess.GetNumRecordsReceived())
@@ -639,6 +733,21 @@ func (fa *flowAggregator) flowExportLoop(stopCh <-chan struct{}) {
 					fa.dbExportProcess = nil
 					klog.InfoS("Clickhouse disabled")
 				}
+			case updateExporters:
+				klog.InfoS("Updating exporters")
+				// Stopping an exporter flushes its buffered records, which
+				// may block for a while if its sink is not available. The
+				// previous exporters are stopped in the background so that
+				// records keep being collected and exported meanwhile.
+				go func(exporters []flowexporter.Interface) {
+					for _, exp := range exporters {
+						exp.Stop()
+					}
+				}(fa.exporters)
+				fa.exporters = msg.value.([]flowexporter.Interface)
+				for _, exp := range fa.exporters {
+					exp.Start()
+				}
 			}
 		}
 	}
@@ -676,6 +785,11 @@ func (fa *flowAggregator) sendFlowKeyRecord(key ipfixintermediate.FlowKey, recor
 	if fa.dbExportProcess != nil {
 		fa.dbExportProcess.CacheSet(fa.set)
 	}
+	for _, exp := range fa.exporters {
+		if err := exp.AddRecord(record.Record); err != nil {
+			klog.ErrorS(err, "Error when exporting flow record")
+		}
+	}
 	if err := fa.aggregationProcess.ResetStatAndThroughputElementsInRecord(record.Record); err != nil {
 		return err
 	}
@@ -1001,4 +1115,16 @@ func (fa *flowAggregator) updateFlowAggregator(opt *options.Options) {
 			}
 		}
 	}
+	if config := newExportersConfig(opt); !reflect.DeepEqual(config, fa.exportersConfig) {
+		exporters, err := flowexporter.NewExporters(opt)
+		if err != nil {
+			klog.ErrorS(err, "Error when creating exporters")
+			return
+		}
+		fa.exportersConfig = config
+		fa.updateCh <- updateMsg{
+			param: updateExporters,
+			value: exporters,
+		}
+	}
 }
@@ -17,6 +17,7 @@ package flowaggregator
 import (
 	"bytes"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"sync"
@@ -37,7 +38,9 @@ import (
 
 	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
 	"antrea.io/antrea/pkg/flowaggregator/clickhouseclient"
+	flowexporter "antrea.io/antrea/pkg/flowaggregator/exporter"
 	"antrea.io/antrea/pkg/flowaggregator/options"
+	"antrea.io/antrea/pkg/ipfix"
 	ipfixtest "antrea.io/antrea/pkg/ipfix/testing"
 )
 
@@ -51,7 +54,7 @@ const (
 )
 
 func init() {
-	ipfixregistry.LoadRegistry()
+	ipfix.LoadRegistry()
 }
 
 func TestFlowAggregator_sendFlowKeyRecord(t *testing.T) {
@@ -370,3 +373,99 @@ func TestFlowAggregator_watchConfiguration(t *testing.T) {
 		})
 	}
 }
+
+func TestFlowAggregator_updateExporters(t *testing.T) {
+	dir, err := ioutil.TempDir("", "flow-aggregator")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	loadConfig := func(flowLoggerEnable bool) *options.Options {
+		config := fmt.Sprintf(`
+clickHouse:
+  enable: true
+flowLogger:
+  enable: %t
+  path: %s
+`, flowLoggerEnable, filepath.Join(dir, "flows.log"))
+		opt, err := options.LoadConfig([]byte(config))
+		require.NoError(t, err)
+		return opt
+	}
+
+	opt := loadConfig(false)
+	flowAggregator := &flowAggregator{
+		updateCh:        make(chan updateMsg, 100),
+		dbExportProcess: &clickhouseclient.ClickHouseExportProcess{},
+		exportersConfig: newExportersConfig(opt),
+	}
+	getExportersUpdate := func() []updateMsg {
+		var msgs []updateMsg
+		for len(flowAggregator.updateCh) > 0 {
+			if msg := <-flowAggregator.updateCh; msg.param == updateExporters {
+				msgs = append(msgs, msg)
+			}
+		}
+		return msgs
+	}
+
+	flowAggregator.updateFlowAggregator(opt)
+	assert.Empty(t, getExportersUpdate())
+
+	flowAggregator.updateFlowAggregator(loadConfig(true))
+	msgs := getExportersUpdate()
+	require.Len(t, msgs, 1)
+	exporters := msgs[0].value.([]flowexporter.Interface)
+	require.Len(t, exporters, 1)
+	assert.IsType(t, &flowexporter.FlowLoggerExporter{}, exporters[0])
+
+	flowAggregator.updateFlowAggregator(loadConfig(true))
+	assert.Empty(t, getExportersUpdate())
+}
+
+func TestFlowAggregator_dispatchMessages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockCollectingProcess := ipfixtest.NewMockIPFIXCollectingProcess(ctrl)
+	msgCh := make(chan *ipfixentities.Message)
+	mockCollectingProcess.EXPECT().GetMsgChan().Return(msgCh)
+	flowAggregator := &flowAggregator{
+		collectingProcess: mockCollectingProcess,
+		aggregationMsgCh:  make(chan *ipfixentities.Message, 1),
+		dnsRecordCh:       make(chan ipfixentities.Record, 1),
+	}
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go flowAggregator.dispatchMessages(stopCh)
+
+	newMessage := func(isDNS bool) (*ipfixentities.Message, ipfixentities.Record) {
+		mockRecord := ipfixentitiestesting.NewMockRecord(ctrl)
+		if isDNS {
+			mockRecord.EXPECT().GetInfoElementWithValue("dnsQueryName").Return(createElement("dnsQueryName", ipfixregistry.AntreaEnterpriseID), 0, true)
+		} else {
+			mockRecord.EXPECT().GetInfoElementWithValue("dnsQueryName").Return(nil, 0, false)
+		}
+		mockSet := ipfixentitiestesting.NewMockSet(ctrl)
+		mockSet.EXPECT().GetSetType().Return(ipfixentities.Data)
+		mockSet.EXPECT().GetRecords().Return([]ipfixentities.Record{mockRecord}).AnyTimes()
+		msg := ipfixentities.NewMessage(true)
+		msg.AddSet(mockSet)
+		return msg, mockRecord
+	}
+
+	dnsMsg, dnsRecord := newMessage(true)
+	msgCh <- dnsMsg
+	select {
+	case record := <-flowAggregator.dnsRecordCh:
+		assert.Equal(t, dnsRecord, record)
+	case <-time.After(time.Second):
+		t.Fatal("DNS record was not dispatched")
+	}
+
+	flowMsg, _ := newMessage(false)
+	msgCh <- flowMsg
+	select {
+	case msg := <-flowAggregator.aggregationMsgCh:
+		assert.Equal(t, flowMsg, msg)
+	case <-time.After(time.Second):
+		t.Fatal("flow record message was not dispatched")
+	}
+}
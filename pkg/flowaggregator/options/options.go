@@ -40,6 +40,12 @@ type Options struct {
 	ExternalFlowCollectorProto string
 	// clickHouseCommitInterval flow records batch commit interval to clickhouse in the flow aggregator
 	ClickHouseCommitInterval time.Duration
+	// kafkaFlushInterval is the maximum interval between two batches of flow records produced to Kafka
+	KafkaFlushInterval time.Duration
+	// s3UploadInterval is the interval between two uploads of flow records to S3-compatible storage
+	S3UploadInterval time.Duration
+	// otlpExportInterval is the interval between two exports to the OpenTelemetry collector
+	OTLPExportInterval time.Duration
 }
 
 func LoadConfig(configBytes []byte) (*Options, error) {
@@ -52,8 +58,9 @@ func LoadConfig(configBytes []byte) (*Options, error) {
 	if opt.Config.FlowCollector.Enable && opt.Config.FlowCollector.Address == "" {
 		return nil, fmt.Errorf("external flow collector enabled without providing address")
 	}
-	if !opt.Config.FlowCollector.Enable && !opt.Config.ClickHouse.Enable {
-		return nil, fmt.Errorf("external flow collector or ClickHouse should be configured")
+	if !opt.Config.FlowCollector.Enable && !opt.Config.ClickHouse.Enable && !opt.Config.Kafka.Enable &&
+		!opt.Config.FlowLogger.Enable && !opt.Config.S3Uploader.Enable && !opt.Config.OTLP.Enable {
+		return nil, fmt.Errorf("external flow collector, ClickHouse, Kafka, flow logger, S3 uploader or OTLP should be configured")
 	}
 	// Validate common parameters
 	var err error
@@ -95,5 +102,61 @@ func LoadConfig(configBytes []byte) (*Options, error) {
 				opt.Config.ClickHouse.CommitInterval, flowaggregatorconfig.MinClickHouseCommitInterval)
 		}
 	}
+	// Validate Kafka specific parameters
+	if opt.Config.Kafka.Enable {
+		if len(opt.Config.Kafka.Brokers) == 0 {
+			return nil, fmt.Errorf("Kafka enabled without providing brokers")
+		}
+		if opt.Config.Kafka.RecordFormat != "JSON" && opt.Config.Kafka.RecordFormat != "Protobuf" {
+			return nil, fmt.Errorf("Kafka record format %s is not supported", opt.Config.Kafka.RecordFormat)
+		}
+		opt.KafkaFlushInterval, err = time.ParseDuration(opt.Config.Kafka.FlushInterval)
+		if err != nil {
+			return nil, err
+		}
+		if opt.KafkaFlushInterval <= 0 {
+			return nil, fmt.Errorf("Kafka flushInterval %s must be positive", opt.Config.Kafka.FlushInterval)
+		}
+	}
+	// Validate S3 uploader specific parameters
+	if opt.Config.S3Uploader.Enable {
+		if opt.Config.S3Uploader.BucketName == "" {
+			return nil, fmt.Errorf("S3 uploader enabled without providing bucket name")
+		}
+		if opt.Config.S3Uploader.RecordFormat != "CSV" && opt.Config.S3Uploader.RecordFormat != "Parquet" {
+			return nil, fmt.Errorf("S3 uploader record format %s is not supported", opt.Config.S3Uploader.RecordFormat)
+		}
+		if opt.Config.S3Uploader.MaxRecordsPerFile < 0 {
+			return nil, fmt.Errorf("S3 uploader maxRecordsPerFile must not be negative")
+		}
+		opt.S3UploadInterval, err = time.ParseDuration(opt.Config.S3Uploader.UploadInterval)
+		if err != nil {
+			return nil, err
+		}
+		if opt.S3UploadInterval < flowaggregatorconfig.MinS3UploadInterval {
+			return nil, fmt.Errorf("uploadInterval %s is too small: shortest supported interval is %s",
+				opt.Config.S3Uploader.UploadInterval, flowaggregatorconfig.MinS3UploadInterval)
+		}
+	}
+	// Validate OTLP specific parameters
+	if opt.Config.OTLP.Enable {
+		if opt.Config.OTLP.Endpoint == "" {
+			return nil, fmt.Errorf("OTLP enabled without providing endpoint")
+		}
+		if _, _, err := net.SplitHostPort(opt.Config.OTLP.Endpoint); err != nil {
+			return nil, fmt.Errorf("invalid OTLP endpoint %s: %v", opt.Config.OTLP.Endpoint, err)
+		}
+		if !*opt.Config.OTLP.Logs && !*opt.Config.OTLP.Metrics {
+			return nil, fmt.Errorf("OTLP enabled with both logs and metrics disabled")
+		}
+		opt.OTLPExportInterval, err = time.ParseDuration(opt.Config.OTLP.ExportInterval)
+		if err != nil {
+			return nil, err
+		}
+		if opt.OTLPExportInterval < flowaggregatorconfig.MinOTLPExportInterval {
+			return nil, fmt.Errorf("exportInterval %s is too small: shortest supported interval is %s",
+				opt.Config.OTLP.ExportInterval, flowaggregatorconfig.MinOTLPExportInterval)
+		}
+	}
 	return &opt, nil
 }
@@ -0,0 +1,126 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policyrecommendation
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// Flows dropped or rejected by a NetworkPolicy rule are not used to recommend
+// policies, as the recommended policies would allow them.
+const selectFlowsQuery = `SELECT DISTINCT
+    sourceIP,
+    sourcePodNamespace,
+    sourcePodLabels,
+    destinationIP,
+    destinationPodNamespace,
+    destinationPodLabels,
+    destinationTransportPort,
+    protocolIdentifier,
+    flowType
+FROM flows
+WHERE ingressNetworkPolicyRuleAction NOT IN (2, 3)
+    AND egressNetworkPolicyRuleAction NOT IN (2, 3)`
+
+const insertRecommendationQuery = `INSERT INTO recommendations (id, type, timeCreated, yamls) VALUES (?, ?, ?, ?)`
+
+// FlowQuery selects the flow records read from ClickHouse.
+type FlowQuery struct {
+	// StartTime and EndTime filter flow records by their end time. Zero
+	// values are ignored.
+	StartTime time.Time
+	EndTime   time.Time
+	// Limit is the maximum number of distinct flows read. 0 means no limit.
+	Limit uint
+}
+
+func (q *FlowQuery) sql() (string, []interface{}) {
+	var sb strings.Builder
+	var args []interface{}
+	sb.WriteString(selectFlowsQuery)
+	if !q.StartTime.IsZero() {
+		sb.WriteString("\n    AND flowEndSeconds >= ?")
+		args = append(args, q.StartTime)
+	}
+	if !q.EndTime.IsZero() {
+		sb.WriteString("\n    AND flowEndSeconds < ?")
+		args = append(args, q.EndTime)
+	}
+	if q.Limit > 0 {
+		sb.WriteString(fmt.Sprintf("\nLIMIT %d", q.Limit))
+	}
+	return sb.String(), args
+}
+
+// ReadFlows reads the flows selected by query from the flows table of the
+// ClickHouse database.
+func ReadFlows(db *sql.DB, query FlowQuery) ([]Flow, error) {
+	q, args := query.sql()
+	rows, err := db.Query(q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error when querying flows from ClickHouse: %v", err)
+	}
+	defer rows.Close()
+	var flows []Flow
+	for rows.Next() {
+		var flow Flow
+		var sourcePodLabels, destinationPodLabels string
+		if err := rows.Scan(&flow.SourceIP, &flow.SourcePodNamespace, &sourcePodLabels, &flow.DestinationIP,
+			&flow.DestinationPodNamespace, &destinationPodLabels, &flow.DestinationPort, &flow.Protocol, &flow.FlowType); err != nil {
+			return nil, fmt.Errorf("error when scanning flow row: %v", err)
+		}
+		// Invalid labels should not prevent the recommendation for other
+		// flows; the workload is then selected by its Namespace only.
+		if flow.SourcePodLabels, err = ParseLabels(sourcePodLabels); err != nil {
+			klog.ErrorS(err, "Ignoring source Pod labels", "namespace", flow.SourcePodNamespace)
+		}
+		if flow.DestinationPodLabels, err = ParseLabels(destinationPodLabels); err != nil {
+			klog.ErrorS(err, "Ignoring destination Pod labels", "namespace", flow.DestinationPodNamespace)
+		}
+		flows = append(flows, flow)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error when reading flow rows: %v", err)
+	}
+	return flows, nil
+}
+
+// StoreRecommendation saves the YAML manifest of recommended policies in the
+// recommendations table of the ClickHouse database.
+func StoreRecommendation(db *sql.DB, id string, policyType PolicyType, yamls string) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("error when starting transaction: %v", err)
+	}
+	stmt, err := tx.Prepare(insertRecommendationQuery)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("error when preparing insert statement: %v", err)
+	}
+	defer stmt.Close()
+	if _, err := stmt.Exec(id, string(policyType), time.Now(), yamls); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("error when inserting recommendation: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error when committing recommendation: %v", err)
+	}
+	return nil
+}
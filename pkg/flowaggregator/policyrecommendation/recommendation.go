@@ -0,0 +1,624 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package policyrecommendation generates NetworkPolicies which allow exactly
+// the traffic observed in the flow records collected by the Flow Aggregator.
+package policyrecommendation
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"net"
+	"sort"
+	"strings"
+
+	ipfixregistry "github.com/vmware/go-ipfix/pkg/registry"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/yaml"
+
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+)
+
+// PolicyType is the type of the recommended policies.
+type PolicyType string
+
+const (
+	// PolicyTypeK8sNetworkPolicy recommends K8s NetworkPolicies. The default
+	// isolation of K8s NetworkPolicies drops the traffic which is not observed.
+	PolicyTypeK8sNetworkPolicy PolicyType = "k8s-np"
+	// PolicyTypeAntreaNetworkPolicy recommends Antrea NetworkPolicies allowing
+	// the observed traffic and, for each Namespace, an Antrea NetworkPolicy with
+	// a lower priority dropping all other traffic.
+	PolicyTypeAntreaNetworkPolicy PolicyType = "anp"
+	// PolicyTypeAntreaClusterNetworkPolicy recommends Antrea
+	// ClusterNetworkPolicies allowing the observed traffic and a Baseline
+	// ClusterNetworkPolicy dropping all other traffic.
+	PolicyTypeAntreaClusterNetworkPolicy PolicyType = "acnp"
+)
+
+// IsolationMethod determines how workloads are selected in recommended policies.
+type IsolationMethod string
+
+const (
+	// IsolationMethodNamespace selects workloads by their Namespace only: all
+	// Pods in a Namespace share the same policies, and peers are allow-listed by
+	// Namespace.
+	IsolationMethodNamespace IsolationMethod = "namespace"
+	// IsolationMethodLabel selects workloads by their Namespace and Pod labels,
+	// and peers are allow-listed by Namespace and Pod labels.
+	IsolationMethodLabel IsolationMethod = "label"
+)
+
+const (
+	namespaceNameLabelKey = "kubernetes.io/metadata.name"
+
+	allowPolicyPriority = 5
+	dropPolicyPriority  = 10
+	applicationTier     = "application"
+	baselineTier        = "baseline"
+)
+
+// DefaultNamespaceAllowList is the list of Namespaces for which no policy is
+// recommended by default.
+var DefaultNamespaceAllowList = []string{"kube-system", "flow-aggregator", "flow-visibility"}
+
+// DefaultIgnoredLabels is the list of label keys added by K8s controllers, which
+// change with every rollout and are not used in recommended selectors.
+var DefaultIgnoredLabels = []string{"pod-template-hash", "controller-revision-hash", "pod-template-generation"}
+
+// Flow is the subset of a flow record used to recommend policies.
+type Flow struct {
+	SourceIP                string
+	SourcePodNamespace      string
+	SourcePodLabels         map[string]string
+	DestinationIP           string
+	DestinationPodNamespace string
+	DestinationPodLabels    map[string]string
+	DestinationPort         uint16
+	Protocol                uint8
+	FlowType                uint8
+}
+
+// Options configures how policies are recommended.
+type Options struct {
+	PolicyType      PolicyType
+	IsolationMethod IsolationMethod
+	// NamespaceAllowList is the list of Namespaces for which no policy is
+	// recommended. Workloads in these Namespaces can still be peers of the
+	// recommended rules.
+	NamespaceAllowList []string
+	// IgnoredLabels is the list of label keys which are not used in selectors.
+	IgnoredLabels []string
+}
+
+// Validate returns an error if the policy type or the isolation method is not
+// supported.
+func (o Options) Validate() error {
+	switch o.PolicyType {
+	case PolicyTypeK8sNetworkPolicy, PolicyTypeAntreaNetworkPolicy, PolicyTypeAntreaClusterNetworkPolicy:
+	default:
+		return fmt.Errorf("unsupported policy type %q", o.PolicyType)
+	}
+	switch o.IsolationMethod {
+	case IsolationMethodNamespace, IsolationMethodLabel:
+	default:
+		return fmt.Errorf("unsupported isolation method %q", o.IsolationMethod)
+	}
+	return nil
+}
+
+// workload is a group of Pods selected by a recommended policy or rule.
+type workload struct {
+	namespace string
+	labels    map[string]string
+}
+
+func (w workload) key() string {
+	keys := make([]string, 0, len(w.labels))
+	for k := range w.labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var sb strings.Builder
+	sb.WriteString(w.namespace)
+	for _, k := range keys {
+		sb.WriteString(fmt.Sprintf(",%s=%s", k, w.labels[k]))
+	}
+	return sb.String()
+}
+
+// peer is the other end of the traffic observed for a workload. Exactly one of
+// workload and cidr is set.
+type peer struct {
+	workload *workload
+	cidr     string
+}
+
+func (p peer) key() string {
+	if p.workload != nil {
+		return "workload:" + p.workload.key()
+	}
+	return "cidr:" + p.cidr
+}
+
+// service is the destination port of the observed traffic. port is 0 for
+// protocols which do not have ports.
+type service struct {
+	protocol uint8
+	port     uint16
+}
+
+type ruleSet map[string]*observedRule
+
+type observedRule struct {
+	peer     peer
+	services map[service]struct{}
+}
+
+func (rs ruleSet) add(p peer, s service) {
+	k := p.key()
+	r, ok := rs[k]
+	if !ok {
+		r = &observedRule{peer: p, services: map[service]struct{}{}}
+		rs[k] = r
+	}
+	r.services[s] = struct{}{}
+}
+
+// sorted returns the rules ordered by peer key, so that the recommendation is
+// stable for a given set of flows.
+func (rs ruleSet) sorted() []*observedRule {
+	keys := make([]string, 0, len(rs))
+	for k := range rs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	rules := make([]*observedRule, 0, len(rs))
+	for _, k := range keys {
+		rules = append(rules, rs[k])
+	}
+	return rules
+}
+
+type appliedToGroup struct {
+	workload workload
+	ingress  ruleSet
+	egress   ruleSet
+}
+
+type recommender struct {
+	options          Options
+	allowedNamespace sets.String
+	ignoredLabels    sets.String
+	groups           map[string]*appliedToGroup
+}
+
+// Recommend returns the policies allowing the traffic of the provided flows.
+// Policies are returned in a stable order.
+func Recommend(flows []Flow, options Options) ([]runtime.Object, error) {
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+	r := &recommender{
+		options:          options,
+		allowedNamespace: sets.NewString(options.NamespaceAllowList...),
+		ignoredLabels:    sets.NewString(options.IgnoredLabels...),
+		groups:           map[string]*appliedToGroup{},
+	}
+	for i := range flows {
+		r.addFlow(&flows[i])
+	}
+	return r.policies(), nil
+}
+
+func (r *recommender) workload(namespace string, labels map[string]string) workload {
+	w := workload{namespace: namespace, labels: map[string]string{}}
+	if r.options.IsolationMethod == IsolationMethodLabel {
+		for k, v := range labels {
+			if !r.ignoredLabels.Has(k) {
+				w.labels[k] = v
+			}
+		}
+	}
+	return w
+}
+
+func (r *recommender) group(w workload) *appliedToGroup {
+	k := w.key()
+	g, ok := r.groups[k]
+	if !ok {
+		g = &appliedToGroup{workload: w, ingress: ruleSet{}, egress: ruleSet{}}
+		r.groups[k] = g
+	}
+	return g
+}
+
+func ipToCIDR(ip string) string {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return ""
+	}
+	if parsed.To4() != nil {
+		return ip + "/32"
+	}
+	return ip + "/128"
+}
+
+func (r *recommender) addFlow(flow *Flow) {
+	s := service{protocol: flow.Protocol, port: flow.DestinationPort}
+	if flow.Protocol != ipfixProtocolTCP && flow.Protocol != ipfixProtocolUDP && flow.Protocol != ipfixProtocolSCTP {
+		s.port = 0
+	}
+	var src, dst peer
+	if flow.SourcePodNamespace != "" {
+		w := r.workload(flow.SourcePodNamespace, flow.SourcePodLabels)
+		src.workload = &w
+	} else {
+		src.cidr = ipToCIDR(flow.SourceIP)
+	}
+	if flow.DestinationPodNamespace != "" {
+		w := r.workload(flow.DestinationPodNamespace, flow.DestinationPodLabels)
+		dst.workload = &w
+	} else {
+		dst.cidr = ipToCIDR(flow.DestinationIP)
+	}
+	// A destination which is not a Pod in an intra-cluster flow is a Service
+	// without Endpoint, which cannot be selected by a rule.
+	if dst.workload == nil && flow.FlowType != ipfixregistry.FlowTypeToExternal {
+		return
+	}
+	if (src.workload == nil && src.cidr == "") || (dst.workload == nil && dst.cidr == "") {
+		return
+	}
+	if src.workload != nil && !r.allowedNamespace.Has(src.workload.namespace) {
+		r.group(*src.workload).egress.add(dst, s)
+	}
+	if dst.workload != nil && !r.allowedNamespace.Has(dst.workload.namespace) {
+		r.group(*dst.workload).ingress.add(src, s)
+	}
+}
+
+const (
+	ipfixProtocolICMP   uint8 = 1
+	ipfixProtocolTCP    uint8 = 6
+	ipfixProtocolUDP    uint8 = 17
+	ipfixProtocolICMPv6 uint8 = 58
+	ipfixProtocolSCTP   uint8 = 132
+)
+
+var protocolNames = map[uint8]corev1.Protocol{
+	ipfixProtocolTCP:  corev1.ProtocolTCP,
+	ipfixProtocolUDP:  corev1.ProtocolUDP,
+	ipfixProtocolSCTP: corev1.ProtocolSCTP,
+}
+
+func (r *recommender) sortedGroups() []*appliedToGroup {
+	keys := make([]string, 0, len(r.groups))
+	for k := range r.groups {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	groups := make([]*appliedToGroup, 0, len(keys))
+	for _, k := range keys {
+		groups = append(groups, r.groups[k])
+	}
+	return groups
+}
+
+func (r *recommender) policies() []runtime.Object {
+	var objs []runtime.Object
+	namespaces := sets.NewString()
+	for _, g := range r.sortedGroups() {
+		namespaces.Insert(g.workload.namespace)
+		name := policyName(r.options.PolicyType, g.workload)
+		switch r.options.PolicyType {
+		case PolicyTypeK8sNetworkPolicy:
+			objs = append(objs, k8sNetworkPolicy(name, g))
+		case PolicyTypeAntreaNetworkPolicy:
+			objs = append(objs, antreaNetworkPolicy(name, g))
+		case PolicyTypeAntreaClusterNetworkPolicy:
+			objs = append(objs, antreaClusterNetworkPolicy(name, g))
+		}
+	}
+	if namespaces.Len() == 0 {
+		return objs
+	}
+	switch r.options.PolicyType {
+	case PolicyTypeAntreaNetworkPolicy:
+		for _, ns := range namespaces.List() {
+			objs = append(objs, antreaNetworkPolicyDropAll(ns))
+		}
+	case PolicyTypeAntreaClusterNetworkPolicy:
+		objs = append(objs, antreaClusterNetworkPolicyDropAll(namespaces.List()))
+	}
+	return objs
+}
+
+// policyName generates a name which is stable for a given workload, so that
+// recommending policies again for the same workloads generates updates of the
+// existing policies.
+func policyName(policyType PolicyType, w workload) string {
+	h := fnv.New32a()
+	h.Write([]byte(w.key()))
+	if policyType == PolicyTypeAntreaClusterNetworkPolicy {
+		return fmt.Sprintf("recommend-allow-acnp-%s-%08x", w.namespace, h.Sum32())
+	}
+	return fmt.Sprintf("recommend-allow-%s-%08x", policyType, h.Sum32())
+}
+
+func podSelector(w *workload) *metav1.LabelSelector {
+	selector := &metav1.LabelSelector{}
+	if len(w.labels) > 0 {
+		selector.MatchLabels = w.labels
+	}
+	return selector
+}
+
+func namespaceSelector(namespaces ...string) *metav1.LabelSelector {
+	if len(namespaces) == 1 {
+		return &metav1.LabelSelector{MatchLabels: map[string]string{namespaceNameLabelKey: namespaces[0]}}
+	}
+	return &metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{{
+			Key:      namespaceNameLabelKey,
+			Operator: metav1.LabelSelectorOpIn,
+			Values:   namespaces,
+		}},
+	}
+}
+
+// sortedServices returns the services of a rule ordered by protocol and port.
+func sortedServices(services map[service]struct{}) []service {
+	sorted := make([]service, 0, len(services))
+	for s := range services {
+		sorted = append(sorted, s)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].protocol != sorted[j].protocol {
+			return sorted[i].protocol < sorted[j].protocol
+		}
+		return sorted[i].port < sorted[j].port
+	})
+	return sorted
+}
+
+func k8sPeer(p peer) networkingv1.NetworkPolicyPeer {
+	if p.workload == nil {
+		return networkingv1.NetworkPolicyPeer{IPBlock: &networkingv1.IPBlock{CIDR: p.cidr}}
+	}
+	return networkingv1.NetworkPolicyPeer{
+		PodSelector:       podSelector(p.workload),
+		NamespaceSelector: namespaceSelector(p.workload.namespace),
+	}
+}
+
+// k8sPorts returns the ports of a K8s NetworkPolicy rule. K8s NetworkPolicies
+// cannot select protocols without ports (e.g. ICMP), in which case the rule
+// allows all traffic with the peer.
+func k8sPorts(services map[service]struct{}) []networkingv1.NetworkPolicyPort {
+	var ports []networkingv1.NetworkPolicyPort
+	for _, s := range sortedServices(services) {
+		protocol, ok := protocolNames[s.protocol]
+		if !ok {
+			return nil
+		}
+		port := intstr.FromInt(int(s.port))
+		ports = append(ports, networkingv1.NetworkPolicyPort{Protocol: &protocol, Port: &port})
+	}
+	return ports
+}
+
+func k8sNetworkPolicy(name string, g *appliedToGroup) *networkingv1.NetworkPolicy {
+	np := &networkingv1.NetworkPolicy{
+		TypeMeta: metav1.TypeMeta{Kind: "NetworkPolicy", APIVersion: "networking.k8s.io/v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: g.workload.namespace,
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: *podSelector(&g.workload),
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+		},
+	}
+	for _, r := range g.ingress.sorted() {
+		np.Spec.Ingress = append(np.Spec.Ingress, networkingv1.NetworkPolicyIngressRule{
+			From:  []networkingv1.NetworkPolicyPeer{k8sPeer(r.peer)},
+			Ports: k8sPorts(r.services),
+		})
+	}
+	for _, r := range g.egress.sorted() {
+		np.Spec.Egress = append(np.Spec.Egress, networkingv1.NetworkPolicyEgressRule{
+			To:    []networkingv1.NetworkPolicyPeer{k8sPeer(r.peer)},
+			Ports: k8sPorts(r.services),
+		})
+	}
+	return np
+}
+
+func antreaPeer(p peer) crdv1alpha1.NetworkPolicyPeer {
+	if p.workload == nil {
+		return crdv1alpha1.NetworkPolicyPeer{IPBlock: &crdv1alpha1.IPBlock{CIDR: p.cidr}}
+	}
+	return crdv1alpha1.NetworkPolicyPeer{
+		PodSelector:       podSelector(p.workload),
+		NamespaceSelector: namespaceSelector(p.workload.namespace),
+	}
+}
+
+// antreaRules returns the Antrea NetworkPolicy rules allowing the traffic
+// with a peer. Antrea NetworkPolicies can select ICMP traffic, but a rule
+// cannot select both ports and ICMP, hence up to two rules are returned.
+func antreaRules(r *observedRule, ingress bool) []crdv1alpha1.Rule {
+	allow := crdv1alpha1.RuleActionAllow
+	var ports []crdv1alpha1.NetworkPolicyPort
+	var protocols []crdv1alpha1.NetworkPolicyProtocol
+	anyProtocol := false
+	for _, s := range sortedServices(r.services) {
+		if protocol, ok := protocolNames[s.protocol]; ok {
+			port := intstr.FromInt(int(s.port))
+			ports = append(ports, crdv1alpha1.NetworkPolicyPort{Protocol: &protocol, Port: &port})
+		} else if s.protocol == ipfixProtocolICMP || s.protocol == ipfixProtocolICMPv6 {
+			if len(protocols) == 0 {
+				protocols = append(protocols, crdv1alpha1.NetworkPolicyProtocol{ICMP: &crdv1alpha1.ICMPProtocol{}})
+			}
+		} else {
+			anyProtocol = true
+		}
+	}
+	var rules []crdv1alpha1.Rule
+	if anyProtocol {
+		rules = append(rules, crdv1alpha1.Rule{Action: &allow})
+	} else {
+		if len(ports) > 0 {
+			rules = append(rules, crdv1alpha1.Rule{Action: &allow, Ports: ports})
+		}
+		if len(protocols) > 0 {
+			rules = append(rules, crdv1alpha1.Rule{Action: &allow, Protocols: protocols})
+		}
+	}
+	peers := []crdv1alpha1.NetworkPolicyPeer{antreaPeer(r.peer)}
+	for i := range rules {
+		if ingress {
+			rules[i].From = peers
+		} else {
+			rules[i].To = peers
+		}
+	}
+	return rules
+}
+
+func antreaIngressEgressRules(g *appliedToGroup) (ingress, egress []crdv1alpha1.Rule) {
+	for _, r := range g.ingress.sorted() {
+		ingress = append(ingress, antreaRules(r, true)...)
+	}
+	for _, r := range g.egress.sorted() {
+		egress = append(egress, antreaRules(r, false)...)
+	}
+	return ingress, egress
+}
+
+func antreaNetworkPolicy(name string, g *appliedToGroup) *crdv1alpha1.NetworkPolicy {
+	ingress, egress := antreaIngressEgressRules(g)
+	return &crdv1alpha1.NetworkPolicy{
+		TypeMeta: metav1.TypeMeta{Kind: "NetworkPolicy", APIVersion: crdv1alpha1.SchemeGroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: g.workload.namespace,
+		},
+		Spec: crdv1alpha1.NetworkPolicySpec{
+			Tier:      applicationTier,
+			Priority:  allowPolicyPriority,
+			AppliedTo: []crdv1alpha1.NetworkPolicyPeer{{PodSelector: podSelector(&g.workload)}},
+			Ingress:   ingress,
+			Egress:    egress,
+		},
+	}
+}
+
+func antreaClusterNetworkPolicy(name string, g *appliedToGroup) *crdv1alpha1.ClusterNetworkPolicy {
+	ingress, egress := antreaIngressEgressRules(g)
+	return &crdv1alpha1.ClusterNetworkPolicy{
+		TypeMeta:   metav1.TypeMeta{Kind: "ClusterNetworkPolicy", APIVersion: crdv1alpha1.SchemeGroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: crdv1alpha1.ClusterNetworkPolicySpec{
+			Tier:     applicationTier,
+			Priority: allowPolicyPriority,
+			AppliedTo: []crdv1alpha1.NetworkPolicyPeer{{
+				PodSelector:       podSelector(&g.workload),
+				NamespaceSelector: namespaceSelector(g.workload.namespace),
+			}},
+			Ingress: ingress,
+			Egress:  egress,
+		},
+	}
+}
+
+func dropAllRules() (ingress, egress []crdv1alpha1.Rule) {
+	drop := crdv1alpha1.RuleActionDrop
+	return []crdv1alpha1.Rule{{Action: &drop}}, []crdv1alpha1.Rule{{Action: &drop}}
+}
+
+func antreaNetworkPolicyDropAll(namespace string) *crdv1alpha1.NetworkPolicy {
+	ingress, egress := dropAllRules()
+	return &crdv1alpha1.NetworkPolicy{
+		TypeMeta: metav1.TypeMeta{Kind: "NetworkPolicy", APIVersion: crdv1alpha1.SchemeGroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "recommend-drop-anp",
+			Namespace: namespace,
+		},
+		Spec: crdv1alpha1.NetworkPolicySpec{
+			Tier:      applicationTier,
+			Priority:  dropPolicyPriority,
+			AppliedTo: []crdv1alpha1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{}}},
+			Ingress:   ingress,
+			Egress:    egress,
+		},
+	}
+}
+
+func antreaClusterNetworkPolicyDropAll(namespaces []string) *crdv1alpha1.ClusterNetworkPolicy {
+	ingress, egress := dropAllRules()
+	return &crdv1alpha1.ClusterNetworkPolicy{
+		TypeMeta:   metav1.TypeMeta{Kind: "ClusterNetworkPolicy", APIVersion: crdv1alpha1.SchemeGroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{Name: "recommend-drop-acnp"},
+		Spec: crdv1alpha1.ClusterNetworkPolicySpec{
+			Tier:      baselineTier,
+			Priority:  dropPolicyPriority,
+			AppliedTo: []crdv1alpha1.NetworkPolicyPeer{{NamespaceSelector: namespaceSelector(namespaces...)}},
+			Ingress:   ingress,
+			Egress:    egress,
+		},
+	}
+}
+
+// ToYAML returns the multi-document YAML manifest of the provided policies.
+// The status and the creation timestamp of the policies are omitted.
+func ToYAML(objs []runtime.Object) (string, error) {
+	docs := make([]string, 0, len(objs))
+	for _, obj := range objs {
+		u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return "", fmt.Errorf("error when converting policy to unstructured: %v", err)
+		}
+		delete(u, "status")
+		if metadata, ok := u["metadata"].(map[string]interface{}); ok {
+			delete(metadata, "creationTimestamp")
+		}
+		b, err := yaml.Marshal(u)
+		if err != nil {
+			return "", fmt.Errorf("error when marshalling policy: %v", err)
+		}
+		docs = append(docs, string(b))
+	}
+	return strings.Join(docs, "---\n"), nil
+}
+
+// ParseLabels parses the Pod labels as stored in flow records, i.e. the JSON
+// encoding of the label map. An empty string is parsed as no label.
+func ParseLabels(labels string) (map[string]string, error) {
+	if labels == "" {
+		return nil, nil
+	}
+	m := map[string]string{}
+	if err := json.Unmarshal([]byte(labels), &m); err != nil {
+		return nil, fmt.Errorf("invalid Pod labels %q: %v", labels, err)
+	}
+	return m, nil
+}
@@ -0,0 +1,284 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policyrecommendation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ipfixregistry "github.com/vmware/go-ipfix/pkg/registry"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+)
+
+var (
+	webLabels = map[string]string{"app": "web", "pod-template-hash": "abcde"}
+	dbLabels  = map[string]string{"app": "db"}
+
+	webToDBFlow = Flow{
+		SourceIP:                "10.10.0.1",
+		SourcePodNamespace:      "ns1",
+		SourcePodLabels:         webLabels,
+		DestinationIP:           "10.10.1.1",
+		DestinationPodNamespace: "ns2",
+		DestinationPodLabels:    dbLabels,
+		DestinationPort:         5432,
+		Protocol:                ipfixProtocolTCP,
+		FlowType:                ipfixregistry.FlowTypeInterNode,
+	}
+	webToExternalFlow = Flow{
+		SourceIP:           "10.10.0.1",
+		SourcePodNamespace: "ns1",
+		SourcePodLabels:    webLabels,
+		DestinationIP:      "8.8.8.8",
+		DestinationPort:    443,
+		Protocol:           ipfixProtocolTCP,
+		FlowType:           ipfixregistry.FlowTypeToExternal,
+	}
+	webToDNSFlow = Flow{
+		SourceIP:                "10.10.0.1",
+		SourcePodNamespace:      "ns1",
+		SourcePodLabels:         webLabels,
+		DestinationIP:           "10.10.0.2",
+		DestinationPodNamespace: "kube-system",
+		DestinationPodLabels:    map[string]string{"k8s-app": "kube-dns"},
+		DestinationPort:         53,
+		Protocol:                ipfixProtocolUDP,
+		FlowType:                ipfixregistry.FlowTypeIntraNode,
+	}
+)
+
+func newProtocol(p corev1.Protocol) *corev1.Protocol {
+	return &p
+}
+
+func newPort(port int) *intstr.IntOrString {
+	p := intstr.FromInt(port)
+	return &p
+}
+
+func TestRecommendK8sNetworkPolicy(t *testing.T) {
+	objs, err := Recommend([]Flow{webToDBFlow, webToExternalFlow, webToDNSFlow}, Options{
+		PolicyType:         PolicyTypeK8sNetworkPolicy,
+		IsolationMethod:    IsolationMethodLabel,
+		NamespaceAllowList: DefaultNamespaceAllowList,
+		IgnoredLabels:      DefaultIgnoredLabels,
+	})
+	require.NoError(t, err)
+	require.Len(t, objs, 2)
+
+	web := objs[0].(*networkingv1.NetworkPolicy)
+	assert.Equal(t, "ns1", web.Namespace)
+	assert.Equal(t, metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}, web.Spec.PodSelector)
+	assert.Empty(t, web.Spec.Ingress)
+	assert.Equal(t, []networkingv1.NetworkPolicyEgressRule{
+		{
+			Ports: []networkingv1.NetworkPolicyPort{{Protocol: newProtocol(corev1.ProtocolTCP), Port: newPort(443)}},
+			To:    []networkingv1.NetworkPolicyPeer{{IPBlock: &networkingv1.IPBlock{CIDR: "8.8.8.8/32"}}},
+		},
+		{
+			Ports: []networkingv1.NetworkPolicyPort{{Protocol: newProtocol(corev1.ProtocolUDP), Port: newPort(53)}},
+			To: []networkingv1.NetworkPolicyPeer{{
+				PodSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{"k8s-app": "kube-dns"}},
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{namespaceNameLabelKey: "kube-system"}},
+			}},
+		},
+		{
+			Ports: []networkingv1.NetworkPolicyPort{{Protocol: newProtocol(corev1.ProtocolTCP), Port: newPort(5432)}},
+			To: []networkingv1.NetworkPolicyPeer{{
+				PodSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{namespaceNameLabelKey: "ns2"}},
+			}},
+		},
+	}, web.Spec.Egress)
+
+	db := objs[1].(*networkingv1.NetworkPolicy)
+	assert.Equal(t, "ns2", db.Namespace)
+	assert.Empty(t, db.Spec.Egress)
+	assert.Equal(t, []networkingv1.NetworkPolicyIngressRule{
+		{
+			Ports: []networkingv1.NetworkPolicyPort{{Protocol: newProtocol(corev1.ProtocolTCP), Port: newPort(5432)}},
+			From: []networkingv1.NetworkPolicyPeer{{
+				PodSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{namespaceNameLabelKey: "ns1"}},
+			}},
+		},
+	}, db.Spec.Ingress)
+	assert.ElementsMatch(t, []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress}, db.Spec.PolicyTypes)
+}
+
+func TestRecommendNamespaceIsolation(t *testing.T) {
+	secondFlow := webToDBFlow
+	secondFlow.SourcePodLabels = map[string]string{"app": "api"}
+	secondFlow.DestinationPort = 5433
+	objs, err := Recommend([]Flow{webToDBFlow, secondFlow}, Options{
+		PolicyType:      PolicyTypeAntreaNetworkPolicy,
+		IsolationMethod: IsolationMethodNamespace,
+	})
+	require.NoError(t, err)
+	// One allow policy for each Namespace, and one drop policy for each Namespace.
+	require.Len(t, objs, 4)
+
+	allow := crdv1alpha1.RuleActionAllow
+	ns2 := objs[1].(*crdv1alpha1.NetworkPolicy)
+	assert.Equal(t, "ns2", ns2.Namespace)
+	assert.Equal(t, applicationTier, ns2.Spec.Tier)
+	assert.Equal(t, []crdv1alpha1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{}}}, ns2.Spec.AppliedTo)
+	assert.Equal(t, []crdv1alpha1.Rule{{
+		Action: &allow,
+		Ports: []crdv1alpha1.NetworkPolicyPort{
+			{Protocol: newProtocol(corev1.ProtocolTCP), Port: newPort(5432)},
+			{Protocol: newProtocol(corev1.ProtocolTCP), Port: newPort(5433)},
+		},
+		From: []crdv1alpha1.NetworkPolicyPeer{{
+			PodSelector:       &metav1.LabelSelector{},
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{namespaceNameLabelKey: "ns1"}},
+		}},
+	}}, ns2.Spec.Ingress)
+
+	for i, ns := range []string{"ns1", "ns2"} {
+		drop := objs[2+i].(*crdv1alpha1.NetworkPolicy)
+		assert.Equal(t, ns, drop.Namespace)
+		assert.Equal(t, "recommend-drop-anp", drop.Name)
+		assert.Greater(t, drop.Spec.Priority, ns2.Spec.Priority)
+	}
+}
+
+func TestRecommendAntreaClusterNetworkPolicy(t *testing.T) {
+	icmpFlow := webToDBFlow
+	icmpFlow.Protocol = ipfixProtocolICMP
+	icmpFlow.DestinationPort = 0
+	objs, err := Recommend([]Flow{webToDBFlow, icmpFlow}, Options{
+		PolicyType:      PolicyTypeAntreaClusterNetworkPolicy,
+		IsolationMethod: IsolationMethodLabel,
+		IgnoredLabels:   DefaultIgnoredLabels,
+	})
+	require.NoError(t, err)
+	require.Len(t, objs, 3)
+
+	web := objs[0].(*crdv1alpha1.ClusterNetworkPolicy)
+	assert.Equal(t, []crdv1alpha1.NetworkPolicyPeer{{
+		PodSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+		NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{namespaceNameLabelKey: "ns1"}},
+	}}, web.Spec.AppliedTo)
+	// TCP ports and ICMP cannot be selected by the same rule.
+	require.Len(t, web.Spec.Egress, 2)
+	assert.NotEmpty(t, web.Spec.Egress[0].Ports)
+	assert.Equal(t, []crdv1alpha1.NetworkPolicyProtocol{{ICMP: &crdv1alpha1.ICMPProtocol{}}}, web.Spec.Egress[1].Protocols)
+
+	drop := objs[2].(*crdv1alpha1.ClusterNetworkPolicy)
+	assert.Equal(t, baselineTier, drop.Spec.Tier)
+	assert.Equal(t, []crdv1alpha1.NetworkPolicyPeer{{
+		NamespaceSelector: &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{{
+				Key:      namespaceNameLabelKey,
+				Operator: metav1.LabelSelectorOpIn,
+				Values:   []string{"ns1", "ns2"},
+			}},
+		},
+	}}, drop.Spec.AppliedTo)
+}
+
+func TestRecommendStableNames(t *testing.T) {
+	options := Options{PolicyType: PolicyTypeK8sNetworkPolicy, IsolationMethod: IsolationMethodLabel}
+	objs1, err := Recommend([]Flow{webToDBFlow, webToExternalFlow}, options)
+	require.NoError(t, err)
+	objs2, err := Recommend([]Flow{webToExternalFlow, webToDBFlow}, options)
+	require.NoError(t, err)
+	assert.Equal(t, objs1, objs2)
+}
+
+func TestRecommendInvalidOptions(t *testing.T) {
+	assert.NoError(t, Options{PolicyType: PolicyTypeAntreaNetworkPolicy, IsolationMethod: IsolationMethodNamespace}.Validate())
+	assert.EqualError(t, Options{PolicyType: "foo", IsolationMethod: IsolationMethodLabel}.Validate(), `unsupported policy type "foo"`)
+	assert.EqualError(t, Options{PolicyType: PolicyTypeK8sNetworkPolicy, IsolationMethod: "foo"}.Validate(), `unsupported isolation method "foo"`)
+	_, err := Recommend(nil, Options{PolicyType: "foo", IsolationMethod: IsolationMethodLabel})
+	assert.Error(t, err)
+}
+
+func TestToYAML(t *testing.T) {
+	objs, err := Recommend([]Flow{webToExternalFlow}, Options{
+		PolicyType:      PolicyTypeAntreaNetworkPolicy,
+		IsolationMethod: IsolationMethodNamespace,
+	})
+	require.NoError(t, err)
+	yamls, err := ToYAML(objs)
+	require.NoError(t, err)
+	assert.Contains(t, yamls, "kind: NetworkPolicy")
+	assert.Contains(t, yamls, "cidr: 8.8.8.8/32")
+	assert.Contains(t, yamls, "---\n")
+	assert.NotContains(t, yamls, "status")
+	assert.NotContains(t, yamls, "creationTimestamp")
+}
+
+func TestParseLabels(t *testing.T) {
+	labels, err := ParseLabels(`{"app":"web","tier":"frontend"}`)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"app": "web", "tier": "frontend"}, labels)
+	labels, err = ParseLabels("")
+	require.NoError(t, err)
+	assert.Nil(t, labels)
+	_, err = ParseLabels("app=web")
+	assert.Error(t, err)
+}
+
+func TestReadFlows(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	startTime := time.Unix(1650000000, 0)
+	query := FlowQuery{StartTime: startTime, Limit: 10}
+	expectedQuery := selectFlowsQuery + "\n    AND flowEndSeconds >= ?\nLIMIT 10"
+	rows := sqlmock.NewRows([]string{"sourceIP", "sourcePodNamespace", "sourcePodLabels", "destinationIP",
+		"destinationPodNamespace", "destinationPodLabels", "destinationTransportPort", "protocolIdentifier", "flowType"}).
+		AddRow("10.10.0.1", "ns1", `{"app":"web"}`, "8.8.8.8", "", "", 443, 6, 3)
+	mock.ExpectQuery(expectedQuery).WithArgs(startTime).WillReturnRows(rows)
+
+	flows, err := ReadFlows(db, query)
+	require.NoError(t, err)
+	assert.Equal(t, []Flow{{
+		SourceIP:           "10.10.0.1",
+		SourcePodNamespace: "ns1",
+		SourcePodLabels:    map[string]string{"app": "web"},
+		DestinationIP:      "8.8.8.8",
+		DestinationPort:    443,
+		Protocol:           ipfixProtocolTCP,
+		FlowType:           ipfixregistry.FlowTypeToExternal,
+	}}, flows)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStoreRecommendation(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectPrepare(insertRecommendationQuery).ExpectExec().
+		WithArgs("id", "k8s-np", sqlmock.AnyArg(), "yamls").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	require.NoError(t, StoreRecommendation(db, "id", PolicyTypeK8sNetworkPolicy, "yamls"))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -15,10 +15,30 @@
 package ipfix
 
 import (
+	"fmt"
+
 	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
 	ipfixregistry "github.com/vmware/go-ipfix/pkg/registry"
+	"k8s.io/klog/v2"
 )
 
+// antreaInfoElements are the Antrea Information Elements which are not yet
+// part of the Antrea registry of the go-ipfix version in use. They are
+// registered when the registry is loaded, so that they can be both exported
+// and collected.
+// TODO: remove them once they are added to the go-ipfix Antrea registry.
+var antreaInfoElements = []*ipfixentities.InfoElement{
+	ipfixentities.NewInfoElement("egressName", 153, ipfixentities.String, ipfixregistry.AntreaEnterpriseID, ipfixentities.VariableLength),
+	ipfixentities.NewInfoElement("egressIP", 154, ipfixentities.String, ipfixregistry.AntreaEnterpriseID, ipfixentities.VariableLength),
+	ipfixentities.NewInfoElement("egressNodeName", 155, ipfixentities.String, ipfixregistry.AntreaEnterpriseID, ipfixentities.VariableLength),
+	ipfixentities.NewInfoElement("dnsQueryName", 156, ipfixentities.String, ipfixregistry.AntreaEnterpriseID, ipfixentities.VariableLength),
+	ipfixentities.NewInfoElement("dnsQueryType", 157, ipfixentities.Unsigned16, ipfixregistry.AntreaEnterpriseID, 2),
+	ipfixentities.NewInfoElement("dnsResponseCode", 158, ipfixentities.Unsigned8, ipfixregistry.AntreaEnterpriseID, 1),
+	ipfixentities.NewInfoElement("dnsAnswers", 159, ipfixentities.String, ipfixregistry.AntreaEnterpriseID, ipfixentities.VariableLength),
+	ipfixentities.NewInfoElement("dnsLatencyMicroseconds", 160, ipfixentities.Unsigned32, ipfixregistry.AntreaEnterpriseID, 4),
+	ipfixentities.NewInfoElement("destinationFQDN", 161, ipfixentities.String, ipfixregistry.AntreaEnterpriseID, ipfixentities.VariableLength),
+}
+
 var _ IPFIXRegistry = new(ipfixRegistry)
 
 // IPFIXRegistry interface is added to facilitate unit testing without involving the code from go-ipfix library.
@@ -34,9 +54,38 @@ func NewIPFIXRegistry() *ipfixRegistry {
 }
 
 func (reg *ipfixRegistry) LoadRegistry() {
-	ipfixregistry.LoadRegistry()
+	LoadRegistry()
 }
 
 func (reg *ipfixRegistry) GetInfoElement(name string, enterpriseID uint32) (*ipfixentities.InfoElement, error) {
 	return ipfixregistry.GetInfoElement(name, enterpriseID)
 }
+
+// LoadRegistry loads the go-ipfix registry, including the Antrea Information
+// Elements which are not yet part of it.
+func LoadRegistry() {
+	ipfixregistry.LoadRegistry()
+	for _, ie := range antreaInfoElements {
+		if err := registerInfoElement(ie); err != nil {
+			klog.ErrorS(err, "Failed to register Antrea Information Element", "name", ie.Name, "elementID", ie.ElementId)
+		}
+	}
+}
+
+// registerInfoElement adds an Information Element to the go-ipfix registry,
+// unless it is already part of it. An error is returned if its name or its
+// element ID is already used by a different Information Element, as the
+// collecting process looks up Information Elements by ID while the exporting
+// process looks them up by name.
+func registerInfoElement(ie *ipfixentities.InfoElement) error {
+	if existing, err := ipfixregistry.GetInfoElementFromID(ie.ElementId, ie.EnterpriseId); err == nil {
+		if existing.Name == ie.Name && existing.DataType == ie.DataType {
+			return nil
+		}
+		return fmt.Errorf("element ID %d is already used by Information Element %s", ie.ElementId, existing.Name)
+	}
+	if existing, err := ipfixregistry.GetInfoElement(ie.Name, ie.EnterpriseId); err == nil {
+		return fmt.Errorf("Information Element %s is already registered with element ID %d", ie.Name, existing.ElementId)
+	}
+	return ipfixregistry.PutInfoElement(*ie, ie.EnterpriseId)
+}
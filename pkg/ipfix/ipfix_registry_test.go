@@ -0,0 +1,107 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipfix
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
+	ipfixregistry "github.com/vmware/go-ipfix/pkg/registry"
+)
+
+func TestLoadRegistry(t *testing.T) {
+	NewIPFIXRegistry().LoadRegistry()
+	for _, expected := range antreaInfoElements {
+		ie, err := ipfixregistry.GetInfoElement(expected.Name, ipfixregistry.AntreaEnterpriseID)
+		require.NoError(t, err)
+		assert.Equal(t, expected, ie)
+		// The collecting process looks up Information Elements by ID.
+		ie, err = ipfixregistry.GetInfoElementFromID(expected.ElementId, ipfixregistry.AntreaEnterpriseID)
+		require.NoError(t, err)
+		assert.Equal(t, expected, ie)
+	}
+	// Existing Information Elements are not affected.
+	ie, err := ipfixregistry.GetInfoElement("flowType", ipfixregistry.AntreaEnterpriseID)
+	require.NoError(t, err)
+	assert.Equal(t, uint16(137), ie.ElementId)
+}
+
+func TestRegisterInfoElement(t *testing.T) {
+	LoadRegistry()
+	tests := []struct {
+		name        string
+		ie          *ipfixentities.InfoElement
+		expectedErr string
+	}{
+		{
+			name: "already registered",
+			ie:   ipfixentities.NewInfoElement("flowType", 137, ipfixentities.Unsigned8, ipfixregistry.AntreaEnterpriseID, 1),
+		},
+		{
+			name:        "element ID conflict",
+			ie:          ipfixentities.NewInfoElement("newElement", 137, ipfixentities.String, ipfixregistry.AntreaEnterpriseID, ipfixentities.VariableLength),
+			expectedErr: "element ID 137 is already used by Information Element flowType",
+		},
+		{
+			name:        "name conflict",
+			ie:          ipfixentities.NewInfoElement("flowType", 65000, ipfixentities.Unsigned8, ipfixregistry.AntreaEnterpriseID, 1),
+			expectedErr: "Information Element flowType is already registered with element ID 137",
+		},
+		{
+			name: "new element",
+			ie:   ipfixentities.NewInfoElement("newElement", 65000, ipfixentities.String, ipfixregistry.AntreaEnterpriseID, ipfixentities.VariableLength),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := registerInfoElement(tt.ie)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			ie, err := ipfixregistry.GetInfoElementFromID(tt.ie.ElementId, tt.ie.EnterpriseId)
+			require.NoError(t, err)
+			assert.Equal(t, tt.ie.Name, ie.Name)
+		})
+	}
+	// Reset the registry for the other tests.
+	LoadRegistry()
+}
+
+// TestDNSInfoElements checks the Information Elements of DNS visibility, whose
+// IDs and types must not change as they are used by collectors.
+func TestDNSInfoElements(t *testing.T) {
+	NewIPFIXRegistry().LoadRegistry()
+	for _, expected := range []struct {
+		name     string
+		id       uint16
+		dataType ipfixentities.IEDataType
+	}{
+		{"dnsQueryName", 156, ipfixentities.String},
+		{"dnsQueryType", 157, ipfixentities.Unsigned16},
+		{"dnsResponseCode", 158, ipfixentities.Unsigned8},
+		{"dnsAnswers", 159, ipfixentities.String},
+		{"dnsLatencyMicroseconds", 160, ipfixentities.Unsigned32},
+		{"destinationFQDN", 161, ipfixentities.String},
+	} {
+		ie, err := ipfixregistry.GetInfoElementFromID(expected.id, ipfixregistry.AntreaEnterpriseID)
+		require.NoError(t, err)
+		assert.Equal(t, expected.name, ie.Name)
+		assert.Equal(t, expected.dataType, ie.DataType)
+	}
+}
@@ -1046,6 +1046,30 @@ func (br *OVSBridge) SetPortExternalIDs(portName string, externalIDs map[string]
 	return nil
 }
 
+// SetInterfaceIngressPolicing sets the maximum rate in kbps, and the maximum
+// burst size in kb, of the traffic received by OVS from the interface. The
+// traffic exceeding the rate is dropped. A rate of 0 disables policing.
+func (br *OVSBridge) SetInterfaceIngressPolicing(name string, rate, burst int64) Error {
+	tx := br.ovsdb.Transaction(openvSwitchSchema)
+
+	tx.Update(dbtransaction.Update{
+		Table: "Interface",
+		Where: [][]interface{}{{"name", "==", name}},
+		Row: map[string]interface{}{
+			"ingress_policing_rate":  rate,
+			"ingress_policing_burst": burst,
+		},
+	})
+
+	_, err, temporary := tx.Commit()
+	if err != nil {
+		klog.Error("Transaction failed: ", err)
+		return NewTransactionError(err, temporary)
+	}
+
+	return nil
+}
+
 func (br *OVSBridge) SetInterfaceMTU(name string, MTU int) error {
 	tx := br.ovsdb.Transaction(openvSwitchSchema)
 
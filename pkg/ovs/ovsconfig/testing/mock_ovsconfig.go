@@ -425,6 +425,20 @@ func (mr *MockOVSBridgeClientMockRecorder) SetExternalIDs(arg0 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetExternalIDs", reflect.TypeOf((*MockOVSBridgeClient)(nil).SetExternalIDs), arg0)
 }
 
+// SetInterfaceIngressPolicing mocks base method
+func (m *MockOVSBridgeClient) SetInterfaceIngressPolicing(arg0 string, arg1, arg2 int64) ovsconfig.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetInterfaceIngressPolicing", arg0, arg1, arg2)
+	ret0, _ := ret[0].(ovsconfig.Error)
+	return ret0
+}
+
+// SetInterfaceIngressPolicing indicates an expected call of SetInterfaceIngressPolicing
+func (mr *MockOVSBridgeClientMockRecorder) SetInterfaceIngressPolicing(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetInterfaceIngressPolicing", reflect.TypeOf((*MockOVSBridgeClient)(nil).SetInterfaceIngressPolicing), arg0, arg1, arg2)
+}
+
 // SetInterfaceMTU mocks base method
 func (m *MockOVSBridgeClient) SetInterfaceMTU(arg0 string, arg1 int) error {
 	m.ctrl.T.Helper()
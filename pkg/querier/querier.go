@@ -55,6 +55,12 @@ type AgentMulticastInfoQuerier interface {
 	GetPodStats(podName string, podNamespace string) *multicast.PodTrafficStats
 }
 
+type EgressQuerier interface {
+	// GetEgress returns the name of the effective Egress applied to the Pod, its Egress IP and the name of the
+	// Node holding the Egress IP. An error is returned if no Egress is applied to the Pod.
+	GetEgress(podNamespace, podName string) (egressName, egressIP, egressNode string, err error)
+}
+
 type ControllerNetworkPolicyInfoQuerier interface {
 	NetworkPolicyInfoQuerier
 	GetConnectedAgentNum() int
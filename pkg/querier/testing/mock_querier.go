@@ -14,7 +14,7 @@
 //
 
 // Code generated by MockGen. DO NOT EDIT.
-// Source: antrea.io/antrea/pkg/querier (interfaces: AgentNetworkPolicyInfoQuerier,AgentMulticastInfoQuerier)
+// Source: antrea.io/antrea/pkg/querier (interfaces: AgentNetworkPolicyInfoQuerier,AgentMulticastInfoQuerier,EgressQuerier)
 
 // Package testing is a generated GoMock package.
 package testing
@@ -272,3 +272,43 @@ func (mr *MockAgentMulticastInfoQuerierMockRecorder) GetPodStats(arg0, arg1 inte
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPodStats", reflect.TypeOf((*MockAgentMulticastInfoQuerier)(nil).GetPodStats), arg0, arg1)
 }
+
+// MockEgressQuerier is a mock of EgressQuerier interface
+type MockEgressQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockEgressQuerierMockRecorder
+}
+
+// MockEgressQuerierMockRecorder is the mock recorder for MockEgressQuerier
+type MockEgressQuerierMockRecorder struct {
+	mock *MockEgressQuerier
+}
+
+// NewMockEgressQuerier creates a new mock instance
+func NewMockEgressQuerier(ctrl *gomock.Controller) *MockEgressQuerier {
+	mock := &MockEgressQuerier{ctrl: ctrl}
+	mock.recorder = &MockEgressQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockEgressQuerier) EXPECT() *MockEgressQuerierMockRecorder {
+	return m.recorder
+}
+
+// GetEgress mocks base method
+func (m *MockEgressQuerier) GetEgress(arg0, arg1 string) (string, string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEgress", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(string)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// GetEgress indicates an expected call of GetEgress
+func (mr *MockEgressQuerierMockRecorder) GetEgress(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEgress", reflect.TypeOf((*MockEgressQuerier)(nil).GetEgress), arg0, arg1)
+}
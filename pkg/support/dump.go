@@ -28,6 +28,7 @@ import (
 
 	"github.com/spf13/afero"
 	"gopkg.in/yaml.v2"
+	"k8s.io/klog/v2"
 	"k8s.io/utils/exec"
 
 	agentquerier "antrea.io/antrea/pkg/agent/querier"
@@ -62,6 +63,13 @@ type AgentDumper interface {
 
 	// DumpOVSPorts should create file that contains OF port descriptions under the basedir.
 	DumpOVSPorts(basedir string) error
+	// DumpOVSDatapath should create files that contain the conntrack entries
+	// and the statistics of the OVS datapath under the basedir. The entries
+	// which cannot be dumped are logged and skipped.
+	DumpOVSDatapath(basedir string) error
+	// DumpOVSDB should create a file that contains the contents of the OVSDB
+	// under the basedir. It is skipped if the OVSDB cannot be dumped.
+	DumpOVSDB(basedir string) error
 }
 
 // ControllerDumper is the interface for dumping runtime information of the
@@ -328,6 +336,37 @@ func (d *agentDumper) DumpOVSPorts(basedir string) error {
 	return writeFile(d.fs, filepath.Join(basedir, "ovsports"), "ports", []byte(strings.Join(portData, "\n")))
 }
 
+func (d *agentDumper) DumpOVSDatapath(basedir string) error {
+	for _, item := range []struct {
+		name string
+		cmd  string
+		args []string
+	}{
+		{name: "conntrack", cmd: "dpctl/dump-conntrack", args: []string{"-m"}},
+		{name: "ovs-datapath", cmd: "dpctl/show", args: []string{"-s"}},
+	} {
+		output, execErr := d.ovsCtlClient.RunAppctlCmd(item.cmd, false, item.args...)
+		if execErr != nil {
+			// The other information is still useful without it.
+			klog.ErrorS(execErr, "Failed to dump OVS datapath information, skipping it", "item", item.name)
+			continue
+		}
+		if err := writeFile(d.fs, filepath.Join(basedir, item.name), item.name, output); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *agentDumper) DumpOVSDB(basedir string) error {
+	output, err := d.executor.Command("ovsdb-client", "-f", "list", "dump").CombinedOutput()
+	if err != nil {
+		klog.ErrorS(err, "Failed to dump OVSDB, skipping it", "output", string(output))
+		return nil
+	}
+	return writeFile(d.fs, filepath.Join(basedir, "ovsdb"), "ovsdb", output)
+}
+
 func NewAgentDumper(fs afero.Fs, executor exec.Interface, ovsCtlClient ovsctl.OVSCtlClient, aq agentquerier.AgentQuerier, npq querier.AgentNetworkPolicyInfoQuerier, since string, v4Enabled, v6Enabled bool) AgentDumper {
 	return &agentDumper{
 		fs:           fs,
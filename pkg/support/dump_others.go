@@ -22,6 +22,8 @@ import (
 	"path"
 	"path/filepath"
 
+	"k8s.io/klog/v2"
+
 	"antrea.io/antrea/pkg/agent/util/iptables"
 	"antrea.io/antrea/pkg/util/logdir"
 )
@@ -40,6 +42,9 @@ func (d *agentDumper) DumpHostNetworkInfo(basedir string) error {
 	if err := d.dumpIPTables(basedir); err != nil {
 		return err
 	}
+	if err := d.dumpIPSet(basedir); err != nil {
+		return err
+	}
 	if err := d.dumpIPToolInfo(basedir); err != nil {
 		return err
 	}
@@ -58,16 +63,43 @@ func (d *agentDumper) dumpIPTables(basedir string) error {
 	return writeFile(d.fs, filepath.Join(basedir, "iptables"), "iptables", data)
 }
 
+func (d *agentDumper) dumpIPSet(basedir string) error {
+	output, err := d.executor.Command("ipset", "list").CombinedOutput()
+	if err != nil {
+		// The other host network information is still useful without it.
+		klog.ErrorS(err, "Failed to dump ipset, skipping it", "output", string(output))
+		return nil
+	}
+	return writeFile(d.fs, filepath.Join(basedir, "ipset"), "ipset", output)
+}
+
 func (d *agentDumper) dumpIPToolInfo(basedir string) error {
-	dump := func(name string) error {
-		output, err := d.executor.Command("ip", name).CombinedOutput()
+	dump := func(name string, args ...string) error {
+		output, err := d.executor.Command("ip", args...).CombinedOutput()
 		if err != nil {
 			return fmt.Errorf("error when dumping %s: %w", name, err)
 		}
 		return writeFile(d.fs, filepath.Join(basedir, name), name, output)
 	}
-	for _, item := range []string{"route", "link", "address"} {
-		if err := dump(item); err != nil {
+	type ipToolItem struct {
+		name string
+		args []string
+	}
+	items := []ipToolItem{
+		{name: "route", args: []string{"route"}},
+		{name: "route-tables", args: []string{"route", "show", "table", "all"}},
+		{name: "rule", args: []string{"rule"}},
+		{name: "link", args: []string{"link"}},
+		{name: "address", args: []string{"address"}},
+	}
+	if d.v6Enabled {
+		items = append(items,
+			ipToolItem{name: "route-tables-ipv6", args: []string{"-6", "route", "show", "table", "all"}},
+			ipToolItem{name: "rule-ipv6", args: []string{"-6", "rule"}},
+		)
+	}
+	for _, item := range items {
+		if err := dump(item.name, item.args...); err != nil {
 			return err
 		}
 	}
@@ -63,6 +63,17 @@ func ParseFlowCollectorAddr(addr string, defaultPort string, defaultProtocol str
 	return host, port, proto, nil
 }
 
+// ParseFlowCollectorService parses the host of the flow collector address as
+// the DNS name of a Service, "<NAME>.<NAMESPACE>.svc" optionally followed by
+// the cluster domain, and returns the Namespace and name of the Service.
+func ParseFlowCollectorService(host string) (string, string, error) {
+	parts := strings.Split(strings.TrimSuffix(host, "."), ".")
+	if len(parts) < 3 || parts[0] == "" || parts[1] == "" || parts[2] != "svc" {
+		return "", "", fmt.Errorf("flow collector host %s is not the DNS name of a Service", host)
+	}
+	return parts[1], parts[0], nil
+}
+
 // ParseFlowIntervalString parses the flow poll or export interval input string for flow exporter and aggregator
 func ParseFlowIntervalString(intervalString string) (time.Duration, error) {
 	flowInterval, err := time.ParseDuration(intervalString)
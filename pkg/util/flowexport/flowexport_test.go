@@ -95,6 +95,44 @@ func TestParseFlowCollectorAddr(t *testing.T) {
 	}
 }
 
+func TestParseFlowCollectorService(t *testing.T) {
+	testcases := []struct {
+		host              string
+		expectedNamespace string
+		expectedName      string
+		expectedError     error
+	}{
+		{
+			host:              "flow-aggregator.flow-aggregator.svc",
+			expectedNamespace: "flow-aggregator",
+			expectedName:      "flow-aggregator",
+		},
+		{
+			host:              "fa.monitoring.svc.cluster.local",
+			expectedNamespace: "monitoring",
+			expectedName:      "fa",
+		},
+		{
+			host:          "10.96.10.10",
+			expectedError: fmt.Errorf("flow collector host 10.96.10.10 is not the DNS name of a Service"),
+		},
+		{
+			host:          "flow-aggregator.flow-aggregator",
+			expectedError: fmt.Errorf("flow collector host flow-aggregator.flow-aggregator is not the DNS name of a Service"),
+		},
+	}
+	for _, tc := range testcases {
+		namespace, name, err := ParseFlowCollectorService(tc.host)
+		if tc.expectedError != nil {
+			assert.Equal(t, tc.expectedError, err)
+		} else {
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedNamespace, namespace)
+			assert.Equal(t, tc.expectedName, name)
+		}
+	}
+}
+
 func TestParseFlowIntervalString(t *testing.T) {
 	testcases := []struct {
 		// input
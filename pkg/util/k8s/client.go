@@ -26,6 +26,7 @@ import (
 
 	mcclientset "antrea.io/antrea/multicluster/pkg/client/clientset/versioned"
 	crdclientset "antrea.io/antrea/pkg/client/clientset/versioned"
+	policyclientset "antrea.io/antrea/third_party/networkpolicyapi/pkg/client/clientset/versioned"
 )
 
 // CreateClients creates kube clients from the given config.
@@ -79,6 +80,16 @@ func CreateNetworkAttachDefClient(config componentbaseconfig.ClientConnectionCon
 
 }
 
+// CreateNetworkPolicyAPIClient creates a client for the AdminNetworkPolicy API (policy.networking.k8s.io) from
+// the given config.
+func CreateNetworkPolicyAPIClient(config componentbaseconfig.ClientConnectionConfiguration, kubeAPIServerOverride string) (policyclientset.Interface, error) {
+	kubeConfig, err := createRestConfig(config, kubeAPIServerOverride)
+	if err != nil {
+		return nil, err
+	}
+	return policyclientset.NewForConfig(kubeConfig)
+}
+
 func createRestConfig(config componentbaseconfig.ClientConnectionConfiguration, kubeAPIServerOverride string) (*rest.Config, error) {
 	var kubeConfig *rest.Config
 	var err error
@@ -0,0 +1,134 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pcapng implements a minimal writer for the pcapng capture file
+// format, as described in https://datatracker.ietf.org/doc/draft-ietf-opsawg-pcapng/.
+// Only what is needed to store Ethernet frames captured on a single interface
+// is supported: one Section Header Block, one Interface Description Block and
+// any number of Enhanced Packet Blocks.
+package pcapng
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"time"
+)
+
+const (
+	blockTypeSectionHeader   uint32 = 0x0A0D0D0A
+	blockTypeInterfaceDesc   uint32 = 0x00000001
+	blockTypeEnhancedPacket  uint32 = 0x00000006
+	byteOrderMagic           uint32 = 0x1A2B3C4D
+	linkTypeEthernet         uint16 = 1
+	optionEndOfOpt           uint16 = 0
+	optionIfTsResol          uint16 = 9
+	sectionHeaderBlockLength        = 28
+	// The Interface Description Block includes the if_tsresol option (4
+	// bytes of option header + 1 byte of value padded to 4) and the
+	// opt_endofopt option.
+	interfaceDescBlockLength = 20 + 8 + 4
+	// Length of the Enhanced Packet Block without the packet data.
+	enhancedPacketBlockBaseLength = 32
+	// Timestamps are stored in nanoseconds (if_tsresol = 9).
+	tsResolNanoseconds uint8 = 9
+)
+
+// DefaultSnapLen is the maximum number of bytes stored for each packet when
+// no other value is provided to NewWriter.
+const DefaultSnapLen uint32 = 65535
+
+// Writer writes packets to an io.Writer in the pcapng format. It is not safe
+// for concurrent use.
+type Writer struct {
+	w       io.Writer
+	snapLen uint32
+}
+
+// NewWriter writes the pcapng section header and the description of a single
+// Ethernet interface to w, and returns a Writer which can be used to append
+// packets to the section. A snapLen of 0 means DefaultSnapLen.
+func NewWriter(w io.Writer, snapLen uint32) (*Writer, error) {
+	if snapLen == 0 {
+		snapLen = DefaultSnapLen
+	}
+	pw := &Writer{w: w, snapLen: snapLen}
+	if err := pw.writeSectionHeader(); err != nil {
+		return nil, fmt.Errorf("error when writing section header block: %w", err)
+	}
+	if err := pw.writeInterfaceDescription(); err != nil {
+		return nil, fmt.Errorf("error when writing interface description block: %w", err)
+	}
+	return pw, nil
+}
+
+func (w *Writer) writeSectionHeader() error {
+	b := make([]byte, sectionHeaderBlockLength)
+	binary.LittleEndian.PutUint32(b[0:], blockTypeSectionHeader)
+	binary.LittleEndian.PutUint32(b[4:], sectionHeaderBlockLength)
+	binary.LittleEndian.PutUint32(b[8:], byteOrderMagic)
+	// Version 1.0.
+	binary.LittleEndian.PutUint16(b[12:], 1)
+	binary.LittleEndian.PutUint16(b[14:], 0)
+	// Section length is not specified.
+	binary.LittleEndian.PutUint64(b[16:], 0xFFFFFFFFFFFFFFFF)
+	binary.LittleEndian.PutUint32(b[24:], sectionHeaderBlockLength)
+	_, err := w.w.Write(b)
+	return err
+}
+
+func (w *Writer) writeInterfaceDescription() error {
+	b := make([]byte, interfaceDescBlockLength)
+	binary.LittleEndian.PutUint32(b[0:], blockTypeInterfaceDesc)
+	binary.LittleEndian.PutUint32(b[4:], interfaceDescBlockLength)
+	binary.LittleEndian.PutUint16(b[8:], linkTypeEthernet)
+	binary.LittleEndian.PutUint32(b[12:], w.snapLen)
+	binary.LittleEndian.PutUint16(b[16:], optionIfTsResol)
+	binary.LittleEndian.PutUint16(b[18:], 1)
+	b[20] = tsResolNanoseconds
+	binary.LittleEndian.PutUint16(b[24:], optionEndOfOpt)
+	binary.LittleEndian.PutUint16(b[26:], 0)
+	binary.LittleEndian.PutUint32(b[28:], interfaceDescBlockLength)
+	_, err := w.w.Write(b)
+	return err
+}
+
+// WritePacket appends an Ethernet frame captured at time ts to the section.
+// Frames longer than the snapshot length are truncated, in which case the
+// original length is still recorded.
+func (w *Writer) WritePacket(ts time.Time, data []byte) error {
+	origLen := uint32(len(data))
+	capLen := origLen
+	if capLen > w.snapLen {
+		capLen = w.snapLen
+	}
+	paddedLen := (capLen + 3) &^ 3
+	blockLen := enhancedPacketBlockBaseLength + paddedLen
+	b := make([]byte, blockLen)
+	binary.LittleEndian.PutUint32(b[0:], blockTypeEnhancedPacket)
+	binary.LittleEndian.PutUint32(b[4:], blockLen)
+	// Interface ID 0 is the only interface of the section.
+	binary.LittleEndian.PutUint32(b[8:], 0)
+	tsNano := uint64(ts.UnixNano())
+	binary.LittleEndian.PutUint32(b[12:], uint32(tsNano>>32))
+	binary.LittleEndian.PutUint32(b[16:], uint32(tsNano))
+	binary.LittleEndian.PutUint32(b[20:], capLen)
+	binary.LittleEndian.PutUint32(b[24:], origLen)
+	copy(b[28:], data[:capLen])
+	binary.LittleEndian.PutUint32(b[blockLen-4:], blockLen)
+	if _, err := w.w.Write(b); err != nil {
+		return fmt.Errorf("error when writing enhanced packet block: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pcapng
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type block struct {
+	blockType uint32
+	body      []byte
+}
+
+// readBlocks splits a pcapng stream into blocks, checking that the leading and
+// trailing block lengths match.
+func readBlocks(t *testing.T, data []byte) []block {
+	var blocks []block
+	for len(data) > 0 {
+		require.GreaterOrEqual(t, len(data), 12)
+		blockType := binary.LittleEndian.Uint32(data[0:])
+		blockLen := binary.LittleEndian.Uint32(data[4:])
+		require.Zero(t, blockLen%4, "block length must be a multiple of 4")
+		require.LessOrEqual(t, int(blockLen), len(data))
+		require.Equal(t, blockLen, binary.LittleEndian.Uint32(data[blockLen-4:]))
+		blocks = append(blocks, block{blockType: blockType, body: data[8 : blockLen-4]})
+		data = data[blockLen:]
+	}
+	return blocks
+}
+
+func TestWriter(t *testing.T) {
+	tests := []struct {
+		name            string
+		snapLen         uint32
+		packets         [][]byte
+		expectedSnapLen uint32
+		expectedCapLens []uint32
+	}{
+		{
+			name:            "no packet",
+			expectedSnapLen: DefaultSnapLen,
+		},
+		{
+			name:            "aligned and unaligned packets",
+			packets:         [][]byte{bytes.Repeat([]byte{0xaa}, 64), bytes.Repeat([]byte{0xbb}, 61)},
+			expectedSnapLen: DefaultSnapLen,
+			expectedCapLens: []uint32{64, 61},
+		},
+		{
+			name:            "truncated packet",
+			snapLen:         10,
+			packets:         [][]byte{bytes.Repeat([]byte{0xcc}, 20)},
+			expectedSnapLen: 10,
+			expectedCapLens: []uint32{10},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			w, err := NewWriter(&buf, tt.snapLen)
+			require.NoError(t, err)
+			ts := time.Unix(1650000000, 123456789)
+			for _, p := range tt.packets {
+				require.NoError(t, w.WritePacket(ts, p))
+			}
+
+			blocks := readBlocks(t, buf.Bytes())
+			require.Len(t, blocks, 2+len(tt.packets))
+
+			shb := blocks[0]
+			assert.Equal(t, blockTypeSectionHeader, shb.blockType)
+			assert.Equal(t, byteOrderMagic, binary.LittleEndian.Uint32(shb.body[0:]))
+			assert.Equal(t, uint16(1), binary.LittleEndian.Uint16(shb.body[4:]))
+
+			idb := blocks[1]
+			assert.Equal(t, blockTypeInterfaceDesc, idb.blockType)
+			assert.Equal(t, linkTypeEthernet, binary.LittleEndian.Uint16(idb.body[0:]))
+			assert.Equal(t, tt.expectedSnapLen, binary.LittleEndian.Uint32(idb.body[4:]))
+			assert.Equal(t, optionIfTsResol, binary.LittleEndian.Uint16(idb.body[8:]))
+			assert.Equal(t, tsResolNanoseconds, idb.body[12])
+
+			for i, p := range tt.packets {
+				epb := blocks[2+i]
+				assert.Equal(t, blockTypeEnhancedPacket, epb.blockType)
+				tsNano := uint64(binary.LittleEndian.Uint32(epb.body[4:]))<<32 | uint64(binary.LittleEndian.Uint32(epb.body[8:]))
+				assert.Equal(t, uint64(ts.UnixNano()), tsNano)
+				capLen := binary.LittleEndian.Uint32(epb.body[12:])
+				assert.Equal(t, tt.expectedCapLens[i], capLen)
+				assert.Equal(t, uint32(len(p)), binary.LittleEndian.Uint32(epb.body[16:]))
+				assert.Equal(t, p[:capLen], epb.body[20:20+capLen])
+			}
+		})
+	}
+}
@@ -0,0 +1,307 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redact
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/spf13/afero"
+	"gopkg.in/yaml.v2"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	redactedIPPrefix    = "ip"
+	redactedPodPrefix   = "pod"
+	redactedLabelPrefix = "label"
+	redactedIfacePrefix = "iface"
+	// podInterfacePrefixLength is the maximum length of the Pod name prefix
+	// of the OVS interface names of Pods, see
+	// pkg/agent/util.GenerateContainerInterfaceName.
+	podInterfacePrefixLength = 8
+	// redactedTokenLength is the number of hex digits of the HMAC kept in a
+	// token.
+	redactedTokenLength = 12
+)
+
+var (
+	// ipCandidateRegex matches the strings which look like IP addresses. The
+	// matched strings are validated before being redacted, so that MAC
+	// addresses and timestamps are kept.
+	ipCandidateRegex = regexp.MustCompile(`(?:[0-9A-Fa-f]{0,4}:){2,7}[0-9A-Fa-f]{0,4}(?:\d{1,3}(?:\.\d{1,3}){3})?|\d{1,3}(?:\.\d{1,3}){3}`)
+	// wordRegex matches the strings which can be Pod names.
+	wordRegex = regexp.MustCompile(`[A-Za-z0-9][A-Za-z0-9_.-]*`)
+	// podInterfaceNameRegex matches the OVS interface names of Pods, which
+	// are made of a prefix of the Pod name and of a hash of the container ID.
+	podInterfaceNameRegex = regexp.MustCompile(`^(.+)-[0-9a-f]{7}$`)
+	// labelKeys are the keys of the YAML maps whose values are label values.
+	// Annotation values are redacted as well, as they may include the labels,
+	// e.g. kubectl.kubernetes.io/last-applied-configuration.
+	labelKeys = sets.NewString("labels", "matchlabels", "nodeselector", "annotations")
+	// noRedactFiles are the files of a bundle which are not text.
+	noRedactFiles = sets.NewString("memprofile")
+)
+
+// Redactor replaces the IP addresses, Pod names, OVS interface names of Pods
+// and label values in the support bundles with tokens derived from them with
+// HMAC-SHA256. The same
+// value is always replaced with the same token as long as the same key is
+// used, so that the bundles of different components collected with the same
+// key can still be correlated.
+type Redactor struct {
+	key      []byte
+	mutex    sync.RWMutex
+	podNames sets.String
+	// podInterfacePrefixes are the prefixes of the OVS interface names of
+	// the registered Pods.
+	podInterfacePrefixes sets.String
+}
+
+// NewRedactor creates a Redactor with the given key.
+func NewRedactor(key string) *Redactor {
+	return &Redactor{
+		key:                  []byte(key),
+		podNames:             sets.NewString(),
+		podInterfacePrefixes: sets.NewString(),
+	}
+}
+
+// AddPodNames registers the Pod names which should be redacted wherever they
+// appear, along with the OVS interface names derived from them.
+func (r *Redactor) AddPodNames(names ...string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for _, name := range names {
+		if name != "" {
+			r.podNames.Insert(name)
+			r.podInterfacePrefixes.Insert(podInterfacePrefix(name))
+		}
+	}
+}
+
+// podInterfacePrefix returns the prefix of the OVS interface names of the Pod,
+// which is generated in the same way as in
+// pkg/agent/util.GenerateContainerInterfaceName.
+func podInterfacePrefix(podName string) string {
+	if len(podName) > podInterfacePrefixLength {
+		return strings.TrimLeft(podName[:podInterfacePrefixLength], "-")
+	}
+	return podName
+}
+
+func (r *Redactor) token(prefix, value string) string {
+	mac := hmac.New(sha256.New, r.key)
+	mac.Write([]byte(prefix + "/" + value))
+	return fmt.Sprintf("%s-%s", prefix, hex.EncodeToString(mac.Sum(nil))[:redactedTokenLength])
+}
+
+func keepIP(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsUnspecified() || ip.IsMulticast() || ip.Equal(net.IPv4bcast)
+}
+
+// RedactText replaces the IP addresses, the registered Pod names and their OVS
+// interface names in s.
+func (r *Redactor) RedactText(s string) string {
+	s = ipCandidateRegex.ReplaceAllStringFunc(s, func(candidate string) string {
+		ip := net.ParseIP(candidate)
+		if ip == nil || keepIP(ip) {
+			return candidate
+		}
+		return r.token(redactedIPPrefix, ip.String())
+	})
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	if r.podNames.Len() == 0 {
+		return s
+	}
+	return wordRegex.ReplaceAllStringFunc(s, func(word string) string {
+		// Trailing dots are not part of the word when it ends a sentence.
+		name := strings.TrimRight(word, ".")
+		if r.podNames.Has(name) {
+			return r.token(redactedPodPrefix, name) + word[len(name):]
+		}
+		if match := podInterfaceNameRegex.FindStringSubmatch(name); match != nil && r.podInterfacePrefixes.Has(match[1]) {
+			return r.token(redactedIfacePrefix, name) + word[len(name):]
+		}
+		return word
+	})
+}
+
+// collectPodNames registers the names of the Pods in the YAML object: the
+// names of Pod objects, of Pod references and the values of "podName" keys.
+func (r *Redactor) collectPodNames(obj interface{}) {
+	switch o := obj.(type) {
+	case map[interface{}]interface{}:
+		if kind, _ := o["kind"].(string); kind == "Pod" {
+			if metadata, ok := o["metadata"].(map[interface{}]interface{}); ok {
+				name, _ := metadata["name"].(string)
+				r.AddPodNames(name)
+			}
+		}
+		for k, v := range o {
+			key, _ := k.(string)
+			switch strings.ToLower(key) {
+			case "podname":
+				name, _ := v.(string)
+				r.AddPodNames(name)
+			case "pod":
+				if ref, ok := v.(map[interface{}]interface{}); ok {
+					name, _ := ref["name"].(string)
+					r.AddPodNames(name)
+				}
+			}
+			r.collectPodNames(v)
+		}
+	case []interface{}:
+		for _, v := range o {
+			r.collectPodNames(v)
+		}
+	}
+}
+
+// redactYAML replaces the label values and the strings in the YAML object.
+func (r *Redactor) redactYAML(obj interface{}, isLabels bool) interface{} {
+	switch o := obj.(type) {
+	case map[interface{}]interface{}:
+		redacted := make(map[interface{}]interface{}, len(o))
+		for k, v := range o {
+			key, _ := k.(string)
+			if isLabels {
+				if value, ok := v.(string); ok {
+					redacted[k] = r.token(redactedLabelPrefix, value)
+					continue
+				}
+			}
+			redacted[r.redactYAML(k, false)] = r.redactYAML(v, labelKeys.Has(strings.ToLower(key)))
+		}
+		return redacted
+	case []interface{}:
+		redacted := make([]interface{}, len(o))
+		for i, v := range o {
+			redacted[i] = r.redactYAML(v, false)
+		}
+		return redacted
+	case string:
+		return r.RedactText(o)
+	default:
+		return o
+	}
+}
+
+// parseYAMLDocuments parses data as a stream of YAML documents. It returns
+// false if data is not YAML or if any document is not a map or a list, which
+// is the case of text files like logs.
+func parseYAMLDocuments(data []byte) ([]interface{}, bool) {
+	var docs []interface{}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		var doc interface{}
+		if err := decoder.Decode(&doc); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, false
+		}
+		switch doc.(type) {
+		case map[interface{}]interface{}, []interface{}, nil:
+			docs = append(docs, doc)
+		default:
+			return nil, false
+		}
+	}
+	return docs, len(docs) > 0
+}
+
+// Redact returns data with the IP addresses, the Pod names and the label values
+// replaced. When data is YAML, the Pod names in it are registered first, and
+// the label values are replaced; otherwise only the IP addresses and the
+// registered Pod names are replaced.
+func (r *Redactor) Redact(data []byte) ([]byte, error) {
+	docs, isYAML := parseYAMLDocuments(data)
+	if !isYAML {
+		return []byte(r.RedactText(string(data))), nil
+	}
+	for _, doc := range docs {
+		r.collectPodNames(doc)
+	}
+	var buf bytes.Buffer
+	for i, doc := range docs {
+		if i > 0 {
+			buf.WriteString("---\n")
+		}
+		if doc == nil {
+			continue
+		}
+		out, err := yaml.Marshal(r.redactYAML(doc, false))
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(out)
+	}
+	return buf.Bytes(), nil
+}
+
+// RedactDir redacts all the files under dir in place. The Pod names found in
+// the YAML files are registered before any file is redacted, so that they are
+// also replaced in the other files, e.g. logs.
+func (r *Redactor) RedactDir(fs afero.Fs, dir string) error {
+	var files []string
+	err := afero.Walk(fs, dir, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() || noRedactFiles.Has(info.Name()) {
+			return nil
+		}
+		files = append(files, filePath)
+		data, err := afero.ReadFile(fs, filePath)
+		if err != nil {
+			return err
+		}
+		if docs, isYAML := parseYAMLDocuments(data); isYAML {
+			for _, doc := range docs {
+				r.collectPodNames(doc)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error when reading files to redact: %w", err)
+	}
+	for _, filePath := range files {
+		data, err := afero.ReadFile(fs, filePath)
+		if err != nil {
+			return fmt.Errorf("error when reading file %s to redact: %w", filePath, err)
+		}
+		redacted, err := r.Redact(data)
+		if err != nil {
+			return fmt.Errorf("error when redacting file %s: %w", filePath, err)
+		}
+		if err := afero.WriteFile(fs, filePath, redacted, 0644); err != nil {
+			return fmt.Errorf("error when writing redacted file %s: %w", filePath, err)
+		}
+	}
+	return nil
+}
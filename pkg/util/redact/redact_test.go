@@ -0,0 +1,148 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redact
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactText(t *testing.T) {
+	r := NewRedactor("key")
+	r.AddPodNames("web-0", "frontend-7d4b9c-xz2lq")
+	ip1 := r.token(redactedIPPrefix, "10.10.0.5")
+	ip2 := r.token(redactedIPPrefix, "fd00:10:96::a")
+	pod := r.token(redactedPodPrefix, "web-0")
+	iface1 := r.token(redactedIfacePrefix, "web-0-8f3c2a1")
+	iface2 := r.token(redactedIfacePrefix, "frontend-5b0e9d4")
+
+	for name, tc := range map[string]struct {
+		text     string
+		expected string
+	}{
+		"IPv4": {
+			text:     "table=70, priority=200,ip,nw_dst=10.10.0.5 actions=goto_table:80",
+			expected: "table=70, priority=200,ip,nw_dst=" + ip1 + " actions=goto_table:80",
+		},
+		"IPv4 CIDR and port": {
+			text:     "10.10.0.5/24 via 10.10.0.5:8080",
+			expected: ip1 + "/24 via " + ip1 + ":8080",
+		},
+		"IPv6": {
+			text:     "ipv6,ipv6_dst=fd00:10:96::a",
+			expected: "ipv6,ipv6_dst=" + ip2,
+		},
+		"Kept addresses": {
+			text:     "127.0.0.1 0.0.0.0 ::1 :: 255.255.255.255 224.0.0.22",
+			expected: "127.0.0.1 0.0.0.0 ::1 :: 255.255.255.255 224.0.0.22",
+		},
+		"MAC and timestamp": {
+			text:     "I0817 06:55:10.804384 dl_dst=0a:58:0a:0a:00:05",
+			expected: "I0817 06:55:10.804384 dl_dst=0a:58:0a:0a:00:05",
+		},
+		"Pod names": {
+			text:     "Pod default/web-0 is ready. Pod web-01 is not. Deleted web-0.",
+			expected: "Pod default/" + pod + " is ready. Pod web-01 is not. Deleted " + pod + ".",
+		},
+		"Interface names": {
+			text:     "port 5: web-0-8f3c2a1, port 6: frontend-5b0e9d4, port 7: backend-5b0e9d4, port 8: antrea-gw0",
+			expected: "port 5: " + iface1 + ", port 6: " + iface2 + ", port 7: backend-5b0e9d4, port 8: antrea-gw0",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, r.RedactText(tc.text))
+		})
+	}
+}
+
+func TestRedactorDeterministic(t *testing.T) {
+	r1 := NewRedactor("key1")
+	r2 := NewRedactor("key1")
+	r3 := NewRedactor("key2")
+	assert.Equal(t, r1.RedactText("10.0.0.1"), r2.RedactText("10.0.0.1"))
+	assert.NotEqual(t, r1.RedactText("10.0.0.1"), r1.RedactText("10.0.0.2"))
+	assert.NotEqual(t, r1.RedactText("10.0.0.1"), r3.RedactText("10.0.0.1"))
+	// The same address is redacted the same way regardless of its format.
+	assert.Equal(t, r1.RedactText("fd00::0:1"), r1.RedactText("fd00::1"))
+}
+
+func TestRedactYAML(t *testing.T) {
+	r := NewRedactor("key")
+	data := []byte(`apiVersion: v1
+kind: Pod
+metadata:
+  name: web-0
+  labels:
+    app: web
+spec:
+  nodeName: node1
+status:
+  podIP: 10.10.0.5
+---
+groupMembers:
+- pod:
+    name: db-0
+    namespace: default
+  ips:
+  - 10.10.1.3
+`)
+	redacted, err := r.Redact(data)
+	require.NoError(t, err)
+	assert.Equal(t, `apiVersion: v1
+kind: Pod
+metadata:
+  labels:
+    app: `+r.token(redactedLabelPrefix, "web")+`
+  name: `+r.token(redactedPodPrefix, "web-0")+`
+spec:
+  nodeName: node1
+status:
+  podIP: `+r.token(redactedIPPrefix, "10.10.0.5")+`
+---
+groupMembers:
+- ips:
+  - `+r.token(redactedIPPrefix, "10.10.1.3")+`
+  pod:
+    name: `+r.token(redactedPodPrefix, "db-0")+`
+    namespace: default
+`, string(redacted))
+}
+
+func TestRedactDir(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	dir := "/bundle"
+	require.NoError(t, afero.WriteFile(fs, filepath.Join(dir, "logs", "agent", "antrea-agent.log"), []byte("I0817 06:55:10.804384 1 pod_configuration.go:270] Configured interfaces for Pod default/web-0 with IP 10.10.0.5\n"), 0644))
+	require.NoError(t, afero.WriteFile(fs, filepath.Join(dir, "networkpolicies"), []byte("- appliedto:\n  - pod:\n      name: web-0\n      namespace: default\n"), 0644))
+	require.NoError(t, afero.WriteFile(fs, filepath.Join(dir, "memprofile"), []byte("10.10.0.5"), 0644))
+
+	r := NewRedactor("key")
+	require.NoError(t, r.RedactDir(fs, dir))
+	pod := r.token(redactedPodPrefix, "web-0")
+	ip := r.token(redactedIPPrefix, "10.10.0.5")
+
+	data, err := afero.ReadFile(fs, filepath.Join(dir, "logs", "agent", "antrea-agent.log"))
+	require.NoError(t, err)
+	assert.Equal(t, "I0817 06:55:10.804384 1 pod_configuration.go:270] Configured interfaces for Pod default/"+pod+" with IP "+ip+"\n", string(data))
+	data, err = afero.ReadFile(fs, filepath.Join(dir, "networkpolicies"))
+	require.NoError(t, err)
+	assert.Equal(t, "- appliedto:\n  - pod:\n      name: "+pod+"\n      namespace: default\n", string(data))
+	data, err = afero.ReadFile(fs, filepath.Join(dir, "memprofile"))
+	require.NoError(t, err)
+	assert.Equal(t, "10.10.0.5", string(data))
+}
@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
+	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
@@ -3590,7 +3591,7 @@ func checkANPStatus(t *testing.T, data *TestData, anp *crdv1alpha1.NetworkPolicy
 		if err != nil {
 			return false, err
 		}
-		return anp.Status == expectedStatus, nil
+		return reflect.DeepEqual(anp.Status, expectedStatus), nil
 	})
 	assert.NoError(t, err, "Antrea NetworkPolicy failed to reach expected status")
 	return anp
@@ -3603,7 +3604,7 @@ func checkACNPStatus(t *testing.T, data *TestData, acnp *crdv1alpha1.ClusterNetw
 		if err != nil {
 			return false, err
 		}
-		return acnp.Status == expectedStatus, nil
+		return reflect.DeepEqual(acnp.Status, expectedStatus), nil
 	})
 	assert.NoError(t, err, "Antrea ClusterNetworkPolicy failed to reach expected status")
 	return acnp
@@ -1430,5 +1430,9 @@ type ClickHouseFullRow struct {
 	ThroughputFromDestinationNode        uint64    `json:"throughputFromDestinationNode,string"`
 	ReverseThroughputFromSourceNode      uint64    `json:"reverseThroughputFromSourceNode,string"`
 	ReverseThroughputFromDestinationNode uint64    `json:"reverseThroughputFromDestinationNode,string"`
+	EgressName                           string    `json:"egressName"`
+	EgressIP                             string    `json:"egressIP"`
+	EgressNodeName                       string    `json:"egressNodeName"`
+	DestinationFQDN                      string    `json:"destinationFQDN"`
 	Trusted                              uint8     `json:"trusted"`
 }
@@ -0,0 +1,87 @@
+// Copyright 2023 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	time "time"
+
+	apisv1alpha1 "antrea.io/antrea/third_party/networkpolicyapi/apis/v1alpha1"
+	versioned "antrea.io/antrea/third_party/networkpolicyapi/pkg/client/clientset/versioned"
+	internalinterfaces "antrea.io/antrea/third_party/networkpolicyapi/pkg/client/informers/externalversions/internalinterfaces"
+	v1alpha1 "antrea.io/antrea/third_party/networkpolicyapi/pkg/client/listers/apis/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// BaselineAdminNetworkPolicyInformer provides access to a shared informer and lister for
+// BaselineAdminNetworkPolicies.
+type BaselineAdminNetworkPolicyInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1alpha1.BaselineAdminNetworkPolicyLister
+}
+
+type baselineAdminNetworkPolicyInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// NewBaselineAdminNetworkPolicyInformer constructs a new informer for BaselineAdminNetworkPolicy type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewBaselineAdminNetworkPolicyInformer(client versioned.Interface, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredBaselineAdminNetworkPolicyInformer(client, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredBaselineAdminNetworkPolicyInformer constructs a new informer for BaselineAdminNetworkPolicy type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredBaselineAdminNetworkPolicyInformer(client versioned.Interface, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.PolicyV1alpha1().BaselineAdminNetworkPolicies().List(context.TODO(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.PolicyV1alpha1().BaselineAdminNetworkPolicies().Watch(context.TODO(), options)
+			},
+		},
+		&apisv1alpha1.BaselineAdminNetworkPolicy{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *baselineAdminNetworkPolicyInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredBaselineAdminNetworkPolicyInformer(client, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *baselineAdminNetworkPolicyInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&apisv1alpha1.BaselineAdminNetworkPolicy{}, f.defaultInformer)
+}
+
+func (f *baselineAdminNetworkPolicyInformer) Lister() v1alpha1.BaselineAdminNetworkPolicyLister {
+	return v1alpha1.NewBaselineAdminNetworkPolicyLister(f.Informer().GetIndexer())
+}